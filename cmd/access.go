@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/luoling8192/mindwave/ent/chatgrant"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/access"
+)
+
+func runAccess(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("access subcommand is required", "available", []string{"grants", "grant", "revoke", "log"})
+		return
+	}
+
+	switch args[0] {
+	case "grants":
+		runAccessGrants(ctx, client, args[1:])
+	case "grant":
+		runAccessGrant(ctx, client, args[1:])
+	case "revoke":
+		runAccessRevoke(ctx, client, args[1:])
+	case "log":
+		runAccessLog(ctx, client, args[1:])
+	default:
+		slog.Error("unknown access subcommand", "subcommand", args[0])
+	}
+}
+
+// runAccessGrants prints the chats granted to API users, all of them or
+// those of the given users.
+func runAccessGrants(ctx context.Context, client *datastore.Client, subjects []string) {
+	query := client.ChatGrant.Query()
+	if len(subjects) > 0 {
+		query = query.Where(chatgrant.SubjectIn(subjects...))
+	}
+	grants, err := query.
+		Order(chatgrant.BySubject(), chatgrant.ByChatID()).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query chat grants", "error", err)
+		return
+	}
+
+	for _, g := range grants {
+		fmt.Printf("%s %s %s granted_by=%s updated_at=%s\n", g.Subject, g.ChatID, g.Role, g.GrantedBy, formatMillis(g.UpdatedAt))
+	}
+}
+
+func runAccessGrant(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("access grant", flag.ExitOnError)
+	role := fs.String("role", string(auth.RoleViewer), "role in the chats: viewer, or curator to read raw messages")
+	_ = fs.Parse(args)
+
+	if fs.NArg() < 2 {
+		slog.Error("usage: access grant [-role R] <api user> <chat id>...")
+		return
+	}
+
+	chatIDs := fs.Args()[1:]
+	if err := access.Grant(ctx, client, fs.Arg(0), chatIDs, auth.Role(*role), currentActor()); err != nil {
+		slog.Error("failed to grant chats", "error", err)
+		return
+	}
+	slog.Info("Chats granted", "subject", fs.Arg(0), "role", *role, "chats", len(chatIDs))
+}
+
+func runAccessRevoke(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) < 2 {
+		slog.Error("usage: access revoke <api user> <chat id>...")
+		return
+	}
+
+	revoked, err := access.Revoke(ctx, client, args[0], args[1:])
+	if err != nil {
+		slog.Error("failed to revoke chat grants", "error", err)
+		return
+	}
+	slog.Info("Chat grants revoked", "subject", args[0], "grants", revoked)
+}
+
+// runAccessLog prints the recorded reads of raw messages, newest first.
+func runAccessLog(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("access log", flag.ExitOnError)
+	subject := fs.String("subject", "", "only reads by this API user")
+	chatID := fs.String("chat", "", "only reads of messages in this chat")
+	since := fs.Duration("since", 0, "only reads in this window, e.g. 24h")
+	limit := fs.Int("limit", 50, "maximum number of reads")
+	_ = fs.Parse(args)
+
+	filter := access.LogFilter{Subject: *subject, ChatID: *chatID, Limit: *limit}
+	if *since > 0 {
+		filter.Since = time.Now().Add(-*since)
+	}
+	entries, err := access.Log(ctx, client, filter)
+	if err != nil {
+		slog.Error("failed to query access log", "error", err)
+		return
+	}
+
+	for _, e := range entries {
+		fmt.Printf("%s %s %s/%s %s messages=%d chats=%s query=%q\n",
+			formatMillis(e.CreatedAt), e.Subject, e.Method, e.Role, e.Endpoint,
+			len(e.MessageIds), strings.Join(e.ChatIds, ","), e.Query)
+	}
+}
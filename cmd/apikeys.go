@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+
+	"github.com/luoling8192/mindwave/ent/apikey"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/datastore"
+)
+
+func runAPIKeys(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("apikeys subcommand is required", "available", []string{"list", "create", "revoke"})
+		return
+	}
+
+	switch args[0] {
+	case "list":
+		runAPIKeysList(ctx, client, args[1:])
+	case "create":
+		runAPIKeysCreate(ctx, client, args[1:])
+	case "revoke":
+		runAPIKeysRevoke(ctx, client, args[1:])
+	default:
+		slog.Error("unknown apikeys subcommand", "subcommand", args[0])
+	}
+}
+
+// runAPIKeysList prints the API keys, all of them or those of the given
+// users. Only the prefix of a key is stored.
+func runAPIKeysList(ctx context.Context, client *datastore.Client, subjects []string) {
+	query := client.APIKey.Query()
+	if len(subjects) > 0 {
+		query = query.Where(apikey.SubjectIn(subjects...))
+	}
+	keys, err := query.
+		Order(apikey.BySubject(), apikey.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query api keys", "error", err)
+		return
+	}
+
+	for _, k := range keys {
+		status := "active"
+		if k.RevokedAt > 0 {
+			status = "revoked_at=" + formatMillis(k.RevokedAt)
+		}
+		lastUsed := "never"
+		if k.LastUsedAt > 0 {
+			lastUsed = formatMillis(k.LastUsedAt)
+		}
+		fmt.Printf("%s %s %s %s... %q last_used=%s %s\n", k.ID, k.Subject, k.Role, k.Prefix, k.Name, lastUsed, status)
+	}
+}
+
+// runAPIKeysCreate issues a key and prints it, it cannot be shown again.
+func runAPIKeysCreate(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("apikeys create", flag.ExitOnError)
+	role := fs.String("role", string(auth.RoleViewer), "role of the key: viewer, curator or admin")
+	name := fs.String("name", "", "what the key is for")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		slog.Error("usage: apikeys create [-role R] [-name N] <api user>")
+		return
+	}
+	r, err := auth.ParseRole(*role)
+	if err != nil {
+		slog.Error("invalid role", "error", err)
+		return
+	}
+
+	key, row, err := auth.IssueKey(ctx, client, fs.Arg(0), *name, r)
+	if err != nil {
+		slog.Error("failed to create api key", "error", err)
+		return
+	}
+	slog.Info("API key created, it is not shown again", "id", row.ID, "subject", row.Subject, "role", row.Role)
+	fmt.Println(key)
+}
+
+func runAPIKeysRevoke(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("usage: apikeys revoke <api key id>...")
+		return
+	}
+	ids, err := parseUUIDs(args)
+	if err != nil {
+		slog.Error("failed to parse api key ids", "error", err)
+		return
+	}
+
+	revoked, err := auth.RevokeKeys(ctx, client, ids)
+	if err != nil {
+		slog.Error("failed to revoke api keys", "error", err)
+		return
+	}
+	slog.Info("API keys revoked", "keys", revoked)
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/graph"
+	"github.com/luoling8192/mindwave/internal/services/ask"
+	"github.com/luoling8192/mindwave/internal/services/search"
+)
+
+func runAsk(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("ask", flag.ExitOnError)
+	conversation := fs.String("conversation", "", "continue the conversation with this id")
+	chatID := fs.String("chat", "", "only use evidence from this chat id")
+	limit := fs.Int("limit", 8, "number of messages retrieved for each question")
+	asJSON := fs.Bool("json", false, "print answers as JSON")
+	_ = fs.Parse(args)
+
+	conversationID := uuid.Nil
+	if *conversation != "" {
+		var err error
+		conversationID, err = uuid.Parse(*conversation)
+		if err != nil {
+			slog.Error("invalid conversation id", "error", err)
+			return
+		}
+	}
+
+	llmClient, err := newLLMClient()
+	if err != nil {
+		slog.Error("failed to create llm client", "error", err)
+		return
+	}
+
+	searcher := newSearcherOrNil(client)
+	if searcher == nil {
+		slog.Error("ask requires search to be configured")
+		return
+	}
+
+	asker, err := ask.NewAsker(client, llmClient, searcher, newGraphWriterOrNil(client))
+	if err != nil {
+		slog.Error("failed to create asker", "error", err)
+		return
+	}
+
+	answer := func(question string) bool {
+		a, err := asker.Ask(ctx, ask.Options{
+			ConversationID: conversationID,
+			Question:       question,
+			ChatID:         *chatID,
+			Limit:          *limit,
+		})
+		if err != nil {
+			slog.Error("failed to answer question", "error", err)
+			return false
+		}
+		conversationID = a.ConversationID
+
+		if *asJSON {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(a); err != nil {
+				slog.Error("failed to encode answer", "error", err)
+			}
+			return true
+		}
+
+		fmt.Println(a.Text)
+		if len(a.Citations) > 0 {
+			fmt.Println()
+			for _, m := range a.Citations {
+				fmt.Printf("  [msg:%s] %s\n", m.ID, formatMessageLine(m))
+			}
+		}
+		fmt.Printf("\nconversation=%s\n", a.ConversationID)
+		return true
+	}
+
+	if question := strings.Join(fs.Args(), " "); question != "" {
+		answer(question)
+		return
+	}
+
+	// Without a question on the command line, keep asking follow-ups in one conversation.
+	scanner := bufio.NewScanner(os.Stdin)
+	fmt.Print("> ")
+	for scanner.Scan() {
+		if question := strings.TrimSpace(scanner.Text()); question != "" {
+			answer(question)
+		}
+		fmt.Print("> ")
+	}
+	if err := scanner.Err(); err != nil {
+		slog.Error("failed to read question", "error", err)
+	}
+}
+
+// newGraphWriterOrNil builds a graph writer for read paths that work without the graph.
+func newGraphWriterOrNil(client *datastore.Client) *graph.Writer {
+	graphWriter, err := newGraphWriter(client)
+	if err != nil {
+		slog.Warn("graph disabled, failed to create graph writer", "error", err)
+		return nil
+	}
+	return graphWriter
+}
+
+// newAskerOrNil builds an asker for servers, which keep running without it
+// when search is not configured.
+func newAskerOrNil(client *datastore.Client, searcher *search.Searcher) *ask.Asker {
+	if searcher == nil {
+		return nil
+	}
+
+	llmClient, err := newLLMClient()
+	if err != nil {
+		slog.Warn("ask disabled, failed to create llm client", "error", err)
+		return nil
+	}
+
+	asker, err := ask.NewAsker(client, llmClient, searcher, newGraphWriterOrNil(client))
+	if err != nil {
+		slog.Warn("ask disabled, failed to create asker", "error", err)
+		return nil
+	}
+
+	return asker
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/luoling8192/mindwave/internal/agent"
+)
+
+// llmCacheStore is a completion cache that can drop its expired entries and
+// the entries mentioning a forgotten identity.
+type llmCacheStore interface {
+	agent.Cache
+	Prune(ctx context.Context) (int, error)
+	DeleteMentioning(ctx context.Context, texts []string) (int, error)
+}
+
+func runCache(ctx context.Context, args []string) {
+	if len(args) == 0 {
+		slog.Error("cache subcommand is required", "available", []string{"prune"})
+		return
+	}
+
+	switch args[0] {
+	case "prune":
+		runCachePrune(ctx)
+	default:
+		slog.Error("unknown cache subcommand", "subcommand", args[0])
+	}
+}
+
+func runCachePrune(ctx context.Context) {
+	if llmCache == nil {
+		slog.Error("llm cache is off, set LLM_CACHE to postgres or disk")
+		return
+	}
+
+	n, err := llmCache.Prune(ctx)
+	if err != nil {
+		slog.Error("failed to prune llm cache", "error", err)
+		return
+	}
+	slog.Info("Expired cache entries pruned", "count", n)
+}
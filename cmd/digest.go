@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/publish"
+	"github.com/luoling8192/mindwave/internal/services/digest"
+	"github.com/nekomeowww/fo"
+	"github.com/samber/lo"
+)
+
+const defaultDigestDir = "digests"
+
+func runDigest(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("digest", flag.ExitOnError)
+	chat := fs.String("chat", "", "chat id or name to digest")
+	all := fs.Bool("all", false, "digest every joined chat")
+	period := fs.String("period", string(digest.PeriodDay), "digest period: day or week")
+	date := fs.String("date", "", "a day within the period (2006-01-02), defaults to the last complete period")
+	out := fs.String("out", fo.May(lo.Coalesce(os.Getenv("DIGEST_DIR"), defaultDigestDir)), "directory to write digests and feeds to")
+	baseURL := fs.String("base-url", os.Getenv("DIGEST_BASE_URL"), "URL the output directory is served from, used for feed links")
+	messageURL := fs.String("message-url", fo.May(lo.Coalesce(os.Getenv("DIGEST_MESSAGE_URL"), digest.DefaultMessageURL)), "evidence link template with {chat_id}, {message_id} and {id}")
+	stdout := fs.Bool("stdout", false, "print the Markdown digest instead of writing files")
+	publishTo := fs.String("publish", "", "comma separated publishers to post the digest with: telegram, webhook")
+	_ = fs.Parse(args)
+
+	publishers, err := newPublishers(*publishTo)
+	if err != nil {
+		slog.Error("failed to create publishers", "error", err)
+		return
+	}
+
+	if *chat == "" && !*all {
+		slog.Error("-chat or -all is required")
+		return
+	}
+
+	day := time.Now()
+	switch {
+	case *date != "":
+		day, err = time.ParseInLocation(time.DateOnly, *date, time.Local)
+		if err != nil {
+			slog.Error("failed to parse date", "error", err)
+			return
+		}
+	case digest.Period(*period) == digest.PeriodWeek:
+		day = day.AddDate(0, 0, -7)
+	default:
+		day = day.AddDate(0, 0, -1)
+	}
+
+	chats := []string{*chat}
+	if *all {
+		joinedChats, err := client.JoinedChat.Query().All(ctx)
+		if err != nil {
+			slog.Error("failed to get joined chats", "error", err)
+			return
+		}
+		chats = lo.Map(joinedChats, func(jc *ent.JoinedChat, _ int) string { return jc.ChatID })
+	}
+
+	for _, chatID := range chats {
+		d, err := digest.Build(ctx, client, digest.Options{
+			Chat:       chatID,
+			Period:     digest.Period(*period),
+			Date:       day,
+			MessageURL: *messageURL,
+		})
+		if err != nil {
+			slog.Error("failed to build digest", "chat", chatID, "error", err)
+			continue
+		}
+		// Chats that were quiet for the whole period get no digest with -all.
+		if *all && d.MessageCount == 0 && d.EventCount == 0 {
+			continue
+		}
+
+		for _, publisher := range publishers {
+			sent, err := publish.Deliver(ctx, client, publisher, d)
+			if err != nil {
+				slog.Error("failed to publish digest", "chat", d.ChatID, "publisher", publisher.Name(), "error", err)
+				continue
+			}
+			slog.Info("Digest published", "chat", d.ChatID, "period", d.Key(), "publisher", publisher.Name(), "already_sent", !sent)
+		}
+
+		if *stdout {
+			markdown, err := d.Markdown()
+			if err != nil {
+				slog.Error("failed to render digest", "chat", chatID, "error", err)
+				continue
+			}
+			fmt.Println(markdown)
+			continue
+		}
+
+		paths, err := digest.WriteFiles(*out, *baseURL, d)
+		if err != nil {
+			slog.Error("failed to write digest", "chat", chatID, "error", err)
+			continue
+		}
+		slog.Info("Digest written", "chat", d.ChatID, "period", d.Key(), "events", d.EventCount, "files", paths)
+	}
+}
+
+// newPublishers builds the named publishers from their environment
+// configuration.
+func newPublishers(names string) ([]publish.Publisher, error) {
+	publishers := make([]publish.Publisher, 0)
+	for _, name := range lo.Compact(lo.Map(strings.Split(names, ","), func(n string, _ int) string { return strings.TrimSpace(n) })) {
+		switch name {
+		case "telegram":
+			telegram, err := publish.NewTelegram(os.Getenv("TELEGRAM_API_URL"), os.Getenv("TELEGRAM_BOT_TOKEN"))
+			if err != nil {
+				return nil, err
+			}
+			publishers = append(publishers, telegram)
+		case "webhook":
+			format := fo.May(lo.Coalesce(os.Getenv("DIGEST_WEBHOOK_FORMAT"), string(publish.WebhookFormatSlack)))
+			webhook, err := publish.NewWebhook(os.Getenv("DIGEST_WEBHOOK_URL"), publish.WebhookFormat(format))
+			if err != nil {
+				return nil, err
+			}
+			publishers = append(publishers, webhook)
+		default:
+			return nil, fmt.Errorf("unknown publisher %q, expected telegram or webhook", name)
+		}
+	}
+	return publishers, nil
+}
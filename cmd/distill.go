@@ -0,0 +1,306 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/job"
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/graph"
+	"github.com/luoling8192/mindwave/internal/jobs"
+	"github.com/luoling8192/mindwave/internal/redact"
+	"github.com/luoling8192/mindwave/internal/services/distill"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/luoling8192/mindwave/internal/services/tokenize"
+	"github.com/nekomeowww/fo"
+	"github.com/samber/lo"
+)
+
+func runDistill(ctx context.Context, client *datastore.Client) {
+	count, err := client.ChatMessage.Query().Where(chatmessage.ContentNEQ(""), chatmessage.DeletedAt(0), owners.Canonical()).Count(ctx)
+	if err != nil {
+		slog.Error("failed to get chat messages", "error", err)
+		return
+	}
+
+	slog.Info("Chat messages", "count", count)
+
+	joinedChats, err := client.JoinedChat.Query().All(ctx)
+	if err != nil {
+		slog.Error("failed to get joined chats", "error", err)
+		return
+	}
+
+	slog.Info("Joined chats", "count", len(joinedChats))
+
+	grouped := []struct {
+		InChatID string `json:"in_chat_id"`
+		Count    int    `json:"count"`
+	}{}
+
+	err = client.ChatMessage.Query().
+		Where(chatmessage.DeletedAt(0), owners.Canonical()).
+		GroupBy(chatmessage.FieldInChatID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &grouped)
+	if err != nil {
+		slog.Error("failed to group chat messages", "error", err)
+	}
+
+	sort.Slice(grouped, func(i, j int) bool {
+		return grouped[i].Count > grouped[j].Count
+	})
+
+	options := make([]string, len(grouped))
+	for i, g := range grouped {
+		joinedChat, ok := lo.Find(joinedChats, func(jc *ent.JoinedChat) bool {
+			return jc.ChatID == g.InChatID
+		})
+		if !ok {
+			continue
+		}
+
+		options[i] = fmt.Sprintf(
+			"[%d] %s (%s, %s) - %d msgs",
+			i,
+			g.InChatID,
+			joinedChat.ChatName,
+			joinedChat.ChatType,
+			g.Count,
+		)
+	}
+
+	var selectedIdx int
+	err = survey.AskOne(&survey.Select{
+		Message: "Select a chat to inspect:",
+		Options: options,
+		Default: 0,
+	}, &selectedIdx)
+	if err != nil {
+		slog.Error("selection aborted", "error", err)
+		return
+	}
+
+	var dayCount string
+	err = survey.AskOne(&survey.Input{
+		Message: "Select a time range to inspect (day):",
+		Default: "1",
+	}, &dayCount)
+	if err != nil {
+		slog.Error("selection aborted", "error", err)
+		return
+	}
+
+	dayCountInt, err := strconv.Atoi(dayCount)
+	if err != nil {
+		slog.Error("failed to parse day count", "error", err)
+		return
+	}
+
+	stages, err := newDistillStages(ctx, client)
+	if err != nil {
+		slog.Error("failed to set up distill", "error", err)
+		return
+	}
+
+	chatID := grouped[selectedIdx].InChatID
+	now := time.Now()
+	ids := make([]uuid.UUID, 0, dayCountInt)
+	for day := range dayCountInt {
+		start := now.Add(-time.Duration(day) * hoursPerDay)
+		end := start.Add(hoursPerDay)
+
+		j, err := enqueueDistill(ctx, client, chatID, start, end)
+		if err != nil {
+			slog.Error("failed to enqueue distill job", "error", err)
+			return
+		}
+		ids = append(ids, j.ID)
+	}
+
+	// Run the jobs here, a worker started with `serve worker` retries the
+	// ones that failed.
+	worker := jobs.NewWorker(client, stages.handlers(), jobs.WorkerOptions{Concurrency: dayCountInt})
+	if err := worker.Drain(ctx, ids); err != nil {
+		slog.Error("failed to run distill jobs", "error", err)
+		return
+	}
+	if ctx.Err() != nil {
+		slog.Warn("Distill interrupted, unfinished jobs are left to serve worker")
+		return
+	}
+
+	remaining, err := client.Job.Query().
+		Where(job.IDIn(ids...), job.StatusNEQ(job.StatusSucceeded)).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query distill jobs", "error", err)
+		return
+	}
+	for _, j := range remaining {
+		slog.Warn("Distill job did not succeed", "job_id", j.ID, "status", j.Status, "attempts", j.Attempts, "error", j.LastError)
+	}
+	slog.Info("Distill finished", "jobs", len(ids), "succeeded", len(ids)-len(remaining))
+}
+
+// enqueueDistill enqueues distilling a chat window, keyed by the window so it
+// is not enqueued twice.
+func enqueueDistill(ctx context.Context, client *datastore.Client, chatID string, start, end time.Time) (*ent.Job, error) {
+	return jobs.Enqueue(ctx, client, jobs.KindDistill, jobs.DistillPayload{
+		ChatID: chatID,
+		Start:  start.Unix(),
+		End:    end.Unix(),
+	}, jobs.EnqueueOptions{
+		Key: fmt.Sprintf("distill:%s:%d:%d", chatID, start.Unix(), end.Unix()),
+	})
+}
+
+// distiller distills the messages of a chat between start and end.
+type distiller func(ctx context.Context, chatID string, start, end time.Time) ([]agent.ExtractedItem, error)
+
+// distillStages are the LLM, graph and deduplication stages of distill, shared
+// by the distill command and the job worker.
+type distillStages struct {
+	client       *datastore.Client
+	llmClient    *agent.LLMClient
+	graphWriter  *graph.Writer
+	deduplicator *distill.Deduplicator
+	// tokenizer runs tokenize jobs, only workers load it.
+	tokenizer *tokenize.Tokenizer
+	options   distill.Options
+}
+
+// newDistillStages sets up the stages of distill from the environment.
+func newDistillStages(ctx context.Context, client *datastore.Client) (*distillStages, error) {
+	llmClient, err := newLLMClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create llm client: %w", err)
+	}
+
+	graphWriter, err := newGraphWriter(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create graph writer: %w", err)
+	}
+	if err := graphWriter.EnsureGraph(ctx); err != nil {
+		return nil, fmt.Errorf("failed to ensure graph exists: %w", err)
+	}
+
+	deduplicator, err := newDeduplicator(client, llmClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event deduplicator: %w", err)
+	}
+
+	matchThreshold := 0.0
+	if value := os.Getenv("PARTICIPANT_MATCH_THRESHOLD"); value != "" {
+		matchThreshold, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PARTICIPANT_MATCH_THRESHOLD: %w", err)
+		}
+	}
+
+	budget, err := monthlyBudget()
+	if err != nil {
+		return nil, err
+	}
+
+	redactor, err := newRedactor()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create redactor: %w", err)
+	}
+
+	return &distillStages{
+		client:       client,
+		llmClient:    llmClient,
+		graphWriter:  graphWriter,
+		deduplicator: deduplicator,
+		options: distill.Options{
+			Deduplicator:   deduplicator,
+			MatchThreshold: matchThreshold,
+			DeferFailures:  true,
+			UpdateProfiles: true,
+			MonthlyBudget:  budget,
+			Redactor:       redactor,
+		},
+	}, nil
+}
+
+func (s *distillStages) distill(ctx context.Context, chatID string, start, end time.Time) ([]agent.ExtractedItem, error) {
+	grouped := []struct {
+		InChatID string `json:"in_chat_id"`
+		Count    int    `json:"count"`
+	}{{InChatID: chatID}}
+
+	return distill.DistillOneRound(ctx, s.client, grouped, 0, start, end, s.llmClient, s.graphWriter, s.options)
+}
+
+// newDistiller returns a distiller running every stage of distill in this
+// process, for schedulers that do not hand windows to the job queue.
+func newDistiller(ctx context.Context, client *datastore.Client) (distiller, error) {
+	stages, err := newDistillStages(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return stages.distill, nil
+}
+
+// newRedactor builds the redaction stage from the built-in detectors and the
+// config REDACTION_CONFIG points to, REDACTION=off turns it off.
+func newRedactor() (*redact.Redactor, error) {
+	if os.Getenv("REDACTION") == "off" {
+		return nil, nil
+	}
+
+	var config redact.Config
+	if path := os.Getenv("REDACTION_CONFIG"); path != "" {
+		var err error
+		config, err = redact.LoadConfig(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return redact.New(config)
+}
+
+// newDeduplicator builds the event deduplicator from the environment. It
+// returns nil when EVENT_DEDUP_MODE is "off".
+func newDeduplicator(client *datastore.Client, llmClient *agent.LLMClient) (*distill.Deduplicator, error) {
+	mode := fo.May(lo.Coalesce(os.Getenv("EVENT_DEDUP_MODE"), string(distill.DedupModeMerge)))
+	if mode == "off" {
+		return nil, nil
+	}
+
+	threshold := defaultDedupThreshold
+	if value := os.Getenv("EVENT_DEDUP_THRESHOLD"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EVENT_DEDUP_THRESHOLD: %w", err)
+		}
+		threshold = parsed
+	}
+
+	window := defaultDedupWindow
+	if value := os.Getenv("EVENT_DEDUP_WINDOW"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EVENT_DEDUP_WINDOW: %w", err)
+		}
+		window = parsed
+	}
+
+	embeddingModel, err := embeddingModelFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return distill.NewDeduplicator(client, llmClient, embeddingModel, distill.DedupMode(mode), threshold, window)
+}
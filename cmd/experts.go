@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/experts"
+	"github.com/luoling8192/mindwave/internal/services/search"
+	"github.com/samber/lo"
+)
+
+func runExperts(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("experts", flag.ExitOnError)
+	limit := fs.Int("limit", 10, "maximum number of people")
+	evidence := fs.Int("evidence", 3, "number of evidence events to show per person")
+	halfLife := fs.Duration("half-life", experts.DefaultHalfLife, "age at which an event counts half")
+	asJSON := fs.Bool("json", false, "print experts as JSON")
+	_ = fs.Parse(args)
+
+	topic := strings.Join(fs.Args(), " ")
+	if topic == "" {
+		slog.Error("topic is required")
+		return
+	}
+
+	tokenizer, err := newTokenizer()
+	if err != nil {
+		slog.Error("failed to create tokenizer", "error", err)
+		return
+	}
+
+	found, err := experts.NewFinder(client, tokenizer.Tokenize).Find(ctx, experts.Options{
+		Topic:         topic,
+		Limit:         *limit,
+		EvidenceLimit: *evidence,
+		HalfLife:      *halfLife,
+	})
+	if err != nil {
+		slog.Error("failed to find experts", "error", err)
+		return
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(found); err != nil {
+			slog.Error("failed to encode experts", "error", err)
+		}
+		return
+	}
+
+	if len(found) == 0 {
+		fmt.Printf("Nobody found for %q\n", topic)
+		return
+	}
+	for i, expert := range found {
+		fmt.Printf("#%d %s score=%.3f events=%d recency=%.2f centrality=%.2f last_seen=%s person=%s\n",
+			i+1,
+			expert.DisplayName,
+			expert.Score,
+			expert.Signals.Frequency,
+			expert.Signals.Recency,
+			expert.Signals.Centrality,
+			time.Unix(expert.LastSeen, 0).Format("2006-01-02"),
+			expert.PersonID,
+		)
+		fmt.Printf("    identities: %s\n", strings.Join(lo.Map(expert.Identities, func(i *ent.Identity, _ int) string {
+			return fmt.Sprintf("%s/%s %q", i.Platform, i.PlatformUserID, i.DisplayName)
+		}), "; "))
+		for _, e := range expert.Evidence {
+			fmt.Printf("    [%s] %.3f %s (%s) event=%s\n",
+				time.Unix(e.Event.PlatformTimestamp, 0).Format("2006-01-02"),
+				e.Weight,
+				e.Event.Name,
+				strings.Join(e.Event.Tags, ","),
+				e.Event.ID,
+			)
+		}
+		fmt.Println()
+	}
+}
+
+// newFinder builds an expert finder that splits topics like searcher, or on
+// whitespace when search is not configured.
+func newFinder(client *datastore.Client, searcher *search.Searcher) *experts.Finder {
+	if searcher == nil {
+		return experts.NewFinder(client, nil)
+	}
+	return experts.NewFinder(client, searcher.Tokenize)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/persons"
+	"github.com/nekomeowww/fo"
+	"github.com/samber/lo"
+)
+
+// runForget removes an identity from everything distilled from its messages
+// and prints what was removed, the same counts go to the persons audit log.
+func runForget(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("forget", flag.ExitOnError)
+	deleteMessages := fs.Bool("delete-messages", false, "delete the messages of the identity instead of anonymizing them")
+	dryRun := fs.Bool("dry-run", false, "report what would be forgotten without changing anything")
+	reason := fs.String("reason", "", "why the identity is forgotten, e.g. the request it answers")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		slog.Error("exactly one identity is required, as an id or platform:user_id")
+		return
+	}
+	identityID, err := resolveIdentity(ctx, client, fs.Arg(0))
+	if err != nil {
+		slog.Error("failed to resolve identity", "identity", fs.Arg(0), "error", err)
+		return
+	}
+
+	graphWriter, err := newGraphWriter(client)
+	if err != nil {
+		slog.Error("failed to create graph writer", "error", err)
+		return
+	}
+
+	report, err := persons.Forget(ctx, client, graphWriter, identityID, persons.ForgetOptions{
+		DeleteMessages: *deleteMessages,
+		DryRun:         *dryRun,
+		Actor:          currentActor(),
+		Reason:         *reason,
+		Cache:          llmCache,
+		DigestDir:      fo.May(lo.Coalesce(os.Getenv("DIGEST_DIR"), defaultDigestDir)),
+	})
+	if err != nil {
+		slog.Error("failed to forget identity", "identity_id", identityID, "error", err)
+		return
+	}
+
+	printForgetReport(report)
+}
+
+// resolveIdentity accepts an identity id or platform:user_id.
+func resolveIdentity(ctx context.Context, client *datastore.Client, value string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(value); err == nil {
+		return id, nil
+	}
+
+	platform, userID, ok := strings.Cut(value, ":")
+	if !ok {
+		return uuid.Nil, fmt.Errorf("expected an id or platform:user_id, got %q", value)
+	}
+	return client.Identity.Query().
+		Where(identity.PlatformEQ(platform), identity.PlatformUserIDEQ(userID)).
+		OnlyID(ctx)
+}
+
+func printForgetReport(r *persons.ForgetReport) {
+	if r.DryRun {
+		fmt.Println("dry run, nothing was changed")
+	}
+	fmt.Printf("identity %s %s/%s\n", r.IdentityID, r.Platform, r.PlatformUserID)
+	fmt.Printf("  names: %s\n", strings.Join(r.Names, ", "))
+	fmt.Printf("  chats: %s\n", strings.Join(r.Chats, ", "))
+	switch {
+	case r.PersonID == nil:
+		fmt.Println("  person: none")
+	case r.PersonDeleted:
+		fmt.Printf("  person: %s deleted with its graph node\n", r.PersonID)
+	default:
+		fmt.Printf("  person: %s kept for its other identities, unlinked from their events\n", r.PersonID)
+	}
+	fmt.Printf("  events unlinked: %d\n", r.EventsUnlinked)
+	fmt.Printf("  events with names removed: %d\n", r.EventsScrubbed)
+	fmt.Printf("  profile versions deleted: %d\n", r.ProfilesDeleted)
+	fmt.Printf("  summaries with names removed: %d\n", r.SummariesScrubbed)
+	fmt.Printf("  messages deleted: %d\n", r.MessagesDeleted)
+	fmt.Printf("  messages anonymized with vectors cleared: %d\n", r.MessagesAnonymized)
+	fmt.Printf("  replies anonymized: %d\n", r.RepliesAnonymized)
+	fmt.Printf("  ask turns deleted: %d\n", r.AskTurnsDeleted)
+	fmt.Printf("  ask turns with names removed: %d\n", r.AskTurnsScrubbed)
+	fmt.Printf("  event vectors cleared for re-embedding: %d\n", r.EventVectorsCleared)
+	fmt.Printf("  distill run outputs cleared: %d\n", r.DistillRunsCleared)
+	if !r.DryRun {
+		fmt.Printf("  cached completions deleted: %d\n", r.CacheEntriesDeleted)
+		fmt.Printf("  digest files with names removed: %d\n", r.DigestFilesScrubbed)
+	}
+	for _, e := range r.GraphErrors {
+		fmt.Printf("  graph error: %s\n", e)
+	}
+	for _, e := range r.PurgeErrors {
+		fmt.Printf("  purge error: %s\n", e)
+	}
+	if !r.DryRun {
+		fmt.Printf("  audit log entry: %s\n", r.AuditLogID)
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/luoling8192/mindwave/ent/job"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/jobs"
+	"github.com/luoling8192/mindwave/internal/services/distill"
+	"github.com/luoling8192/mindwave/internal/services/profiles"
+	"github.com/luoling8192/mindwave/internal/services/tokenize"
+)
+
+const defaultJobsLimit = 50
+
+func runJobs(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("jobs subcommand is required", "available", []string{"list", "retry", "cancel"})
+		return
+	}
+
+	switch args[0] {
+	case "list":
+		runJobsList(ctx, client, args[1:])
+	case "retry":
+		runJobsRetry(ctx, client, args[1:])
+	case "cancel":
+		runJobsCancel(ctx, client, args[1:])
+	default:
+		slog.Error("unknown jobs subcommand", "subcommand", args[0])
+	}
+}
+
+func runJobsList(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("jobs list", flag.ExitOnError)
+	status := fs.String("status", "", "only list jobs with this status: pending, running, succeeded, dead or cancelled")
+	kind := fs.String("kind", "", "only list jobs of this kind: distill, embed, graph_sync, profile or tokenize")
+	limit := fs.Int("limit", defaultJobsLimit, "maximum number of jobs to list")
+	_ = fs.Parse(args)
+
+	query := client.Job.Query()
+	if *status != "" {
+		if err := job.StatusValidator(job.Status(*status)); err != nil {
+			slog.Error("invalid status", "error", err)
+			return
+		}
+		query.Where(job.StatusEQ(job.Status(*status)))
+	}
+	if *kind != "" {
+		if err := job.KindValidator(job.Kind(*kind)); err != nil {
+			slog.Error("invalid kind", "error", err)
+			return
+		}
+		query.Where(job.KindEQ(job.Kind(*kind)))
+	}
+
+	list, err := query.
+		Order(job.ByCreatedAt(sql.OrderDesc())).
+		Limit(*limit).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query jobs", "error", err)
+		return
+	}
+
+	for _, j := range list {
+		fmt.Printf("%s %s status=%s attempts=%d/%d run_at=%s created_at=%s payload=%s\n",
+			j.ID, j.Kind, j.Status, j.Attempts, j.MaxAttempts, formatMillis(j.RunAt), formatMillis(j.CreatedAt), j.Payload)
+		if j.LastError != "" {
+			fmt.Printf("  last_error: %s\n", j.LastError)
+		}
+	}
+}
+
+func runJobsRetry(ctx context.Context, client *datastore.Client, args []string) {
+	ids, err := parseUUIDs(args)
+	if err != nil || len(ids) == 0 {
+		slog.Error("usage: jobs retry <job id>...", "error", err)
+		return
+	}
+
+	n, err := jobs.Retry(ctx, client, ids...)
+	if err != nil {
+		slog.Error("failed to retry jobs", "error", err)
+		return
+	}
+	slog.Info("Jobs requeued", "count", n)
+}
+
+func runJobsCancel(ctx context.Context, client *datastore.Client, args []string) {
+	ids, err := parseUUIDs(args)
+	if err != nil || len(ids) == 0 {
+		slog.Error("usage: jobs cancel <job id>...", "error", err)
+		return
+	}
+
+	n, err := jobs.Cancel(ctx, client, ids...)
+	if err != nil {
+		slog.Error("failed to cancel jobs", "error", err)
+		return
+	}
+	slog.Info("Jobs cancelled", "count", n)
+}
+
+// handlers are the job handlers running the stages of distill and the
+// tokenization of new messages.
+func (s *distillStages) handlers() map[job.Kind]jobs.Handler {
+	handlers := map[job.Kind]jobs.Handler{
+		jobs.KindDistill: func(ctx context.Context, payload json.RawMessage) error {
+			var p jobs.DistillPayload
+			if err := json.Unmarshal(payload, &p); err != nil {
+				return err
+			}
+			_, err := s.distill(ctx, p.ChatID, time.Unix(p.Start, 0), time.Unix(p.End, 0))
+			return err
+		},
+		jobs.KindGraphSync: func(ctx context.Context, payload json.RawMessage) error {
+			var p jobs.EventsPayload
+			if err := json.Unmarshal(payload, &p); err != nil {
+				return err
+			}
+			return distill.SyncEvents(ctx, s.client, s.graphWriter, p.EventIDs)
+		},
+		jobs.KindProfile: func(ctx context.Context, payload json.RawMessage) error {
+			var p jobs.ProfilePayload
+			if err := json.Unmarshal(payload, &p); err != nil {
+				return err
+			}
+			// ErrBusy fails the job so it is retried after the running
+			// update, which may have started before these events.
+			_, err := profiles.NewBuilder(s.client, s.llmClient).Update(ctx, p.IdentityID)
+			if errors.Is(err, profiles.ErrOptedOut) {
+				return nil
+			}
+			return err
+		},
+	}
+	if s.tokenizer != nil {
+		handlers[jobs.KindTokenize] = func(ctx context.Context, payload json.RawMessage) error {
+			var p jobs.MessagesPayload
+			if err := json.Unmarshal(payload, &p); err != nil {
+				return err
+			}
+			return tokenize.Messages(ctx, s.client, s.tokenizer, p.MessageIDs)
+		}
+	}
+	if s.deduplicator != nil {
+		handlers[jobs.KindEmbed] = func(ctx context.Context, payload json.RawMessage) error {
+			var p jobs.EventsPayload
+			if err := json.Unmarshal(payload, &p); err != nil {
+				return err
+			}
+			return s.deduplicator.EmbedEvents(ctx, p.EventIDs)
+		}
+	}
+	return handlers
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/luoling8192/mindwave/ent/owneraccountlink"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+)
+
+func runOwners(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("owners subcommand is required", "available", []string{"list", "links", "link", "unlink"})
+		return
+	}
+
+	switch args[0] {
+	case "list":
+		runOwnersList(ctx, client)
+	case "links":
+		runOwnersLinks(ctx, client, args[1:])
+	case "link":
+		runOwnersLink(ctx, client, args[1:])
+	case "unlink":
+		runOwnersUnlink(ctx, client, args[1:])
+	default:
+		slog.Error("unknown owners subcommand", "subcommand", args[0])
+	}
+}
+
+// runOwnersList prints the crawling accounts and what each of them stored.
+func runOwnersList(ctx context.Context, client *datastore.Client) {
+	accounts, err := owners.Accounts(ctx, client)
+	if err != nil {
+		slog.Error("failed to query owner accounts", "error", err)
+		return
+	}
+
+	for _, a := range accounts {
+		fmt.Printf("%s chats=%d messages=%d\n", a.ID, a.Chats, a.Messages)
+	}
+}
+
+// runOwnersLinks prints the owner accounts linked to API users, all of them
+// or those of the given users.
+func runOwnersLinks(ctx context.Context, client *datastore.Client, subjects []string) {
+	query := client.OwnerAccountLink.Query()
+	if len(subjects) > 0 {
+		query = query.Where(owneraccountlink.SubjectIn(subjects...))
+	}
+	links, err := query.
+		Order(owneraccountlink.BySubject(), owneraccountlink.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query owner account links", "error", err)
+		return
+	}
+
+	for _, l := range links {
+		fmt.Printf("%s %s linked_at=%s\n", l.Subject, l.OwnerAccountID, formatMillis(l.CreatedAt))
+	}
+}
+
+func runOwnersLink(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) < 2 {
+		slog.Error("usage: owners link <api user> <owner account id>...")
+		return
+	}
+	ownerIDs, err := parseUUIDs(args[1:])
+	if err != nil {
+		slog.Error("failed to parse owner account ids", "error", err)
+		return
+	}
+
+	if err := owners.Link(ctx, client, args[0], ownerIDs); err != nil {
+		slog.Error("failed to link owner accounts", "error", err)
+		return
+	}
+	slog.Info("Owner accounts linked", "subject", args[0], "count", len(ownerIDs))
+}
+
+func runOwnersUnlink(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) < 2 {
+		slog.Error("usage: owners unlink <api user> <owner account id>...")
+		return
+	}
+	ownerIDs, err := parseUUIDs(args[1:])
+	if err != nil {
+		slog.Error("failed to parse owner account ids", "error", err)
+		return
+	}
+
+	n, err := owners.Unlink(ctx, client, args[0], ownerIDs)
+	if err != nil {
+		slog.Error("failed to unlink owner accounts", "error", err)
+		return
+	}
+	slog.Info("Owner accounts unlinked", "subject", args[0], "count", n)
+}
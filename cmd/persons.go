@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/personauditlog"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/graph"
+	"github.com/luoling8192/mindwave/internal/services/persons"
+	"github.com/nekomeowww/fo"
+	"github.com/samber/lo"
+)
+
+func runPersons(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("persons subcommand is required", "available", []string{"review", "merge", "split", "log", "opt-out", "opt-in"})
+		return
+	}
+
+	graphWriter, err := newGraphWriter(client)
+	if err != nil {
+		slog.Error("failed to create graph writer", "error", err)
+		return
+	}
+
+	switch args[0] {
+	case "review":
+		runPersonsReview(ctx, client, graphWriter, args[1:])
+	case "merge":
+		runPersonsMerge(ctx, client, graphWriter, args[1:])
+	case "split":
+		runPersonsSplit(ctx, client, graphWriter, args[1:])
+	case "log":
+		runPersonsLog(ctx, client, args[1:])
+	case "opt-out":
+		runPersonsOptOut(ctx, client, args[1:], true)
+	case "opt-in":
+		runPersonsOptOut(ctx, client, args[1:], false)
+	default:
+		slog.Error("unknown persons subcommand", "subcommand", args[0])
+	}
+}
+
+func runPersonsReview(ctx context.Context, client *datastore.Client, graphWriter *graph.Writer, args []string) {
+	fs := flag.NewFlagSet("persons review", flag.ExitOnError)
+	threshold := fs.Float64("threshold", 0.6, "minimum proposal score")
+	limit := fs.Int("limit", 50, "maximum number of proposals to review")
+	_ = fs.Parse(args)
+
+	proposals, err := persons.ProposeMerges(ctx, client, persons.ReviewOptions{
+		Threshold: *threshold,
+		Limit:     *limit,
+	})
+	if err != nil {
+		slog.Error("failed to propose merges", "error", err)
+		return
+	}
+
+	slog.Info("Merge proposals", "count", len(proposals))
+
+	for _, proposal := range proposals {
+		fmt.Printf("\n%s\n%s\nscore=%.3f name=%.3f username=%.0f co_activity=%.3f\n",
+			formatIdentity(proposal.A),
+			formatIdentity(proposal.B),
+			proposal.Score,
+			proposal.Signals.NameSimilarity,
+			proposal.Signals.UsernameMatch,
+			proposal.Signals.CoActivity,
+		)
+
+		var answer string
+		err := survey.AskOne(&survey.Select{
+			Message: "Merge these identities?",
+			Options: []string{"skip", "merge", "quit"},
+			Default: "skip",
+		}, &answer)
+		if err != nil {
+			slog.Error("selection aborted", "error", err)
+			return
+		}
+
+		switch answer {
+		case "quit":
+			return
+		case "merge":
+			p, err := persons.Merge(ctx, client, graphWriter,
+				[]uuid.UUID{proposal.A.ID, proposal.B.ID},
+				currentActor(), "accepted review proposal", proposal.Score)
+			if err != nil {
+				slog.Error("failed to merge identities", "error", err)
+				continue
+			}
+			slog.Info("Identities merged", "person_id", p.ID)
+		}
+	}
+}
+
+func runPersonsMerge(ctx context.Context, client *datastore.Client, graphWriter *graph.Writer, args []string) {
+	fs := flag.NewFlagSet("persons merge", flag.ExitOnError)
+	reason := fs.String("reason", "", "why the identities are merged")
+	_ = fs.Parse(args)
+
+	identityIDs, err := parseUUIDs(fs.Args())
+	if err != nil {
+		slog.Error("failed to parse identity ids", "error", err)
+		return
+	}
+
+	p, err := persons.Merge(ctx, client, graphWriter, identityIDs, currentActor(), *reason, 0)
+	if err != nil {
+		slog.Error("failed to merge identities", "error", err)
+		return
+	}
+
+	slog.Info("Identities merged", "person_id", p.ID, "identities", len(identityIDs))
+}
+
+func runPersonsSplit(ctx context.Context, client *datastore.Client, graphWriter *graph.Writer, args []string) {
+	fs := flag.NewFlagSet("persons split", flag.ExitOnError)
+	reason := fs.String("reason", "", "why the identity is split off")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		slog.Error("exactly one identity id is required")
+		return
+	}
+	identityID, err := uuid.Parse(fs.Arg(0))
+	if err != nil {
+		slog.Error("failed to parse identity id", "error", err)
+		return
+	}
+
+	p, err := persons.Split(ctx, client, graphWriter, identityID, currentActor(), *reason)
+	if err != nil {
+		slog.Error("failed to split identity", "error", err)
+		return
+	}
+
+	slog.Info("Identity split", "person_id", p.ID, "identity_id", identityID)
+}
+
+// runPersonsOptOut sets whether identities are left out of distill and
+// profiles. What was distilled before stays until the identity is forgotten.
+func runPersonsOptOut(ctx context.Context, client *datastore.Client, args []string, optedOut bool) {
+	identityIDs, err := parseUUIDs(args)
+	if err != nil {
+		slog.Error("failed to parse identity ids", "error", err)
+		return
+	}
+
+	for _, id := range identityIDs {
+		ident, err := persons.SetOptOut(ctx, client, id, optedOut)
+		if err != nil {
+			slog.Error("failed to set opt-out", "identity_id", id, "error", err)
+			continue
+		}
+		slog.Info("Identity updated", "identity_id", ident.ID, "opted_out", ident.OptedOut)
+	}
+	if optedOut {
+		slog.Info("Existing events and profiles are kept, use forget to remove them")
+	}
+}
+
+func runPersonsLog(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("persons log", flag.ExitOnError)
+	limit := fs.Int("limit", 50, "number of entries to show")
+	_ = fs.Parse(args)
+
+	entries, err := client.PersonAuditLog.Query().
+		Order(personauditlog.ByCreatedAt(sql.OrderDesc())).
+		Limit(*limit).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query audit log", "error", err)
+		return
+	}
+
+	for _, entry := range entries {
+		fmt.Printf("%s %-6s person=%s sources=%v identities=%v actor=%s score=%.3f reason=%q\n",
+			formatMillis(entry.CreatedAt),
+			entry.Action,
+			entry.PersonID,
+			entry.SourcePersonIds,
+			entry.IdentityIds,
+			entry.Actor,
+			entry.Score,
+			entry.Reason,
+		)
+		if len(entry.Counts) > 0 {
+			fmt.Printf("  counts=%v\n", entry.Counts)
+		}
+	}
+}
+
+func formatIdentity(i *ent.Identity) string {
+	s := fmt.Sprintf("  %s  %s/%s  %q (@%s) alt=%v", i.ID, i.Platform, i.PlatformUserID, i.DisplayName, i.Username, i.AltIds)
+	if i.OptedOut {
+		s += " opted_out"
+	}
+	return s
+}
+
+func parseUUIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q: %w", value, err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func formatMillis(ms int64) string {
+	return time.UnixMilli(ms).Format("2006-01-02 15:04:05")
+}
+
+// currentActor names the operator recorded in audit logs.
+func currentActor() string {
+	return fo.May(lo.Coalesce(os.Getenv("MINDWAVE_ACTOR"), os.Getenv("USER"), "unknown"))
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/profiles"
+)
+
+func runProfile(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("profile subcommand is required", "available", []string{"update", "show", "history"})
+		return
+	}
+
+	switch args[0] {
+	case "update":
+		runProfileUpdate(ctx, client, args[1:])
+	case "show":
+		runProfileShow(ctx, client, args[1:])
+	case "history":
+		runProfileHistory(ctx, client, args[1:])
+	default:
+		slog.Error("unknown profile subcommand", "subcommand", args[0])
+	}
+}
+
+func runProfileUpdate(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("profile update", flag.ExitOnError)
+	all := fs.Bool("all", false, "update the profiles of all identities with events")
+	_ = fs.Parse(args)
+
+	if !*all && fs.NArg() == 0 {
+		slog.Error("identity ids or -all are required")
+		return
+	}
+
+	ids, err := parseUUIDs(fs.Args())
+	if err != nil {
+		slog.Error("failed to parse identity ids", "error", err)
+		return
+	}
+
+	llmClient, err := newLLMClient()
+	if err != nil {
+		slog.Error("failed to create llm client", "error", err)
+		return
+	}
+	builder := profiles.NewBuilder(client, llmClient)
+
+	if *all {
+		updated, err := builder.UpdateAll(ctx)
+		if err != nil {
+			slog.Error("failed to update profiles", "error", err)
+			return
+		}
+		slog.Info("Profiles updated", "count", updated)
+		return
+	}
+
+	for _, id := range ids {
+		p, err := builder.Update(ctx, id)
+		if err != nil {
+			slog.Error("failed to update profile", "identity_id", id, "error", err)
+			continue
+		}
+		if p == nil {
+			slog.Info("No events linked to identity", "identity_id", id)
+			continue
+		}
+		fmt.Printf("identity=%s version=%d events=%d\n", id, p.Version, p.EventCount)
+	}
+}
+
+func runProfileShow(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("profile show", flag.ExitOnError)
+	version := fs.Int("version", 0, "show this version instead of the latest")
+	sources := fs.Bool("sources", false, "list the source events of each claim")
+	asJSON := fs.Bool("json", false, "print the profile as JSON")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		slog.Error("exactly one identity id is required")
+		return
+	}
+	identityID, err := uuid.Parse(fs.Arg(0))
+	if err != nil {
+		slog.Error("invalid identity id", "error", err)
+		return
+	}
+
+	var p *ent.Profile
+	if *version > 0 {
+		p, err = client.Profile.Query().
+			Where(profile.IdentityID(identityID), profile.Version(*version)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			p, err = nil, nil
+		}
+	} else {
+		p, err = profiles.Latest(ctx, client, identityID)
+	}
+	if err != nil {
+		slog.Error("failed to load profile", "error", err)
+		return
+	}
+	if p == nil {
+		fmt.Println("No profile found")
+		return
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(p); err != nil {
+			slog.Error("failed to encode profile", "error", err)
+		}
+		return
+	}
+
+	fmt.Printf("identity=%s version=%d events=%d model=%s created=%s\n\n", identityID, p.Version, p.EventCount, p.Model, formatMillis(p.CreatedAt))
+	if !*sources {
+		fmt.Print(p.Content)
+		return
+	}
+	for _, claim := range p.Claims {
+		fmt.Printf("[%s] %s\n", claim.Section, claim.Text)
+		for _, id := range claim.EventIDs {
+			fmt.Printf("    event=%s\n", id)
+		}
+	}
+}
+
+func runProfileHistory(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("profile history", flag.ExitOnError)
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		slog.Error("exactly one identity id is required")
+		return
+	}
+	identityID, err := uuid.Parse(fs.Arg(0))
+	if err != nil {
+		slog.Error("invalid identity id", "error", err)
+		return
+	}
+
+	versions, err := client.Profile.Query().
+		Where(profile.IdentityID(identityID)).
+		Order(profile.ByVersion(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query profiles", "error", err)
+		return
+	}
+
+	for _, p := range versions {
+		fmt.Printf("%s version=%d events=%d claims=%d model=%s\n",
+			formatMillis(p.CreatedAt),
+			p.Version,
+			p.EventCount,
+			len(p.Claims),
+			p.Model,
+		)
+	}
+}
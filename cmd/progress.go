@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/luoling8192/mindwave/internal/agent"
+	"golang.org/x/term"
+)
+
+const progressRedrawInterval = 100 * time.Millisecond
+
+// progressPrinter shows the tokens received by streaming LLM calls on a status
+// line at the bottom of the terminal. Log lines are written through it so the
+// status line is cleared before and redrawn after each of them.
+type progressPrinter struct {
+	logs   io.Writer
+	status io.Writer
+
+	mu     sync.Mutex
+	calls  map[uint64]progressCall
+	drawn  bool
+	redraw time.Time
+}
+
+type progressCall struct {
+	stage   string
+	tokens  int
+	started time.Time
+}
+
+// newProgressPrinter returns nil when stderr is not a terminal, progress is
+// only shown to people watching.
+func newProgressPrinter(logs io.Writer) *progressPrinter {
+	if !term.IsTerminal(int(os.Stderr.Fd())) {
+		return nil
+	}
+	return &progressPrinter{
+		logs:   logs,
+		status: os.Stderr,
+		calls:  make(map[uint64]progressCall),
+	}
+}
+
+// Update is an agent.ProgressFunc.
+func (p *progressPrinter) Update(event agent.ProgressEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if event.Done {
+		delete(p.calls, event.CallID)
+	} else {
+		call, ok := p.calls[event.CallID]
+		if !ok {
+			call = progressCall{stage: event.Stage, started: time.Now()}
+		}
+		call.tokens = event.Tokens
+		p.calls[event.CallID] = call
+	}
+
+	// Redraw at most every interval, but always when a call starts or ends.
+	if !event.Done && event.Tokens > 0 && time.Since(p.redraw) < progressRedrawInterval {
+		return
+	}
+	p.clear()
+	p.draw()
+}
+
+func (p *progressPrinter) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.clear()
+	n, err := p.logs.Write(b)
+	p.draw()
+	return n, err
+}
+
+func (p *progressPrinter) clear() {
+	if p.drawn {
+		fmt.Fprint(p.status, "\r\033[K")
+		p.drawn = false
+	}
+}
+
+func (p *progressPrinter) draw() {
+	if len(p.calls) == 0 {
+		return
+	}
+
+	ids := make([]uint64, 0, len(p.calls))
+	for id := range p.calls {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		call := p.calls[id]
+		parts = append(parts, fmt.Sprintf("%s %d tokens %s", call.stage, call.tokens, time.Since(call.started).Truncate(time.Second)))
+	}
+	fmt.Fprintf(p.status, "\r\033[K[llm] %s", strings.Join(parts, " | "))
+	p.drawn = true
+	p.redraw = time.Now()
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/luoling8192/mindwave/ent/chatretention"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/retention"
+)
+
+func runRetention(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("retention subcommand is required", "available", []string{"list", "set", "remove", "run"})
+		return
+	}
+
+	switch args[0] {
+	case "list":
+		runRetentionList(ctx, client)
+	case "set":
+		runRetentionSet(ctx, client, args[1:])
+	case "remove":
+		runRetentionRemove(ctx, client, args[1:])
+	case "run":
+		runRetentionRun(ctx, client, args[1:])
+	default:
+		slog.Error("unknown retention subcommand", "subcommand", args[0])
+	}
+}
+
+func runRetentionList(ctx context.Context, client *datastore.Client) {
+	policies, err := client.ChatRetention.Query().
+		Order(chatretention.ByChatID()).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query retention policies", "error", err)
+		return
+	}
+
+	for _, p := range policies {
+		last := "-"
+		if p.LastRunAt > 0 {
+			last = formatMillis(p.LastRunAt)
+		}
+		fmt.Printf("%s days=%d mode=%s enabled=%t last_run_at=%s\n", p.ChatID, p.Days, p.Mode, p.Enabled, last)
+	}
+}
+
+func runRetentionSet(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("retention set", flag.ExitOnError)
+	days := fs.Int("days", 0, "purge messages older than this many days")
+	mode := fs.String("mode", string(chatretention.DefaultMode), "content blanks old messages and keeps vectors, events and summaries, all deletes them all with the ask turns and profiles drawn from them")
+	disabled := fs.Bool("disabled", false, "store the policy without applying it")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		slog.Error("usage: retention set -days N [-mode content|all] [-disabled] <chat id>")
+		return
+	}
+
+	policy, err := retention.Set(ctx, client, fs.Arg(0), *days, chatretention.Mode(*mode), !*disabled)
+	if err != nil {
+		slog.Error("failed to store retention policy", "error", err)
+		return
+	}
+	slog.Info("Retention policy stored", "chat_id", policy.ChatID, "days", policy.Days, "mode", policy.Mode, "enabled", policy.Enabled)
+}
+
+func runRetentionRemove(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("chat ids are required")
+		return
+	}
+
+	n, err := client.ChatRetention.Delete().
+		Where(chatretention.ChatIDIn(args...)).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("failed to remove retention policies", "error", err)
+		return
+	}
+	slog.Info("Retention policies removed", "count", n)
+}
+
+// runRetentionRun applies every enabled policy once, what serve janitor does
+// on every interval.
+func runRetentionRun(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("retention run", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "report what would be purged without changing anything")
+	_ = fs.Parse(args)
+
+	graphWriter, err := newGraphWriter(client)
+	if err != nil {
+		slog.Error("failed to create graph writer", "error", err)
+		return
+	}
+
+	reports, err := retention.Run(ctx, client, graphWriter, time.Now(), *dryRun)
+	if *dryRun {
+		fmt.Println("dry run, nothing was changed")
+	}
+	for _, report := range reports {
+		printRetentionReport(report)
+	}
+	if err != nil {
+		slog.Error("failed to apply retention policies", "error", err)
+	}
+}
+
+func printRetentionReport(report *retention.Report) {
+	fmt.Printf("chat %s mode=%s cutoff=%s\n", report.ChatID, report.Mode, report.Cutoff.Format(time.DateTime))
+	switch report.Mode {
+	case chatretention.ModeContent:
+		fmt.Printf("  messages blanked:  %d\n", report.MessagesBlanked)
+	case chatretention.ModeAll:
+		fmt.Printf("  messages deleted:  %d\n", report.MessagesDeleted)
+		fmt.Printf("  events deleted:    %d\n", report.EventsDeleted)
+		fmt.Printf("  summaries deleted: %d\n", report.SummariesDeleted)
+		fmt.Printf("  ask turns deleted: %d\n", report.AskTurnsDeleted)
+		fmt.Printf("  profiles deleted:  %d\n", report.ProfilesDeleted)
+	}
+	for _, e := range report.GraphErrors {
+		fmt.Printf("  graph error: %s\n", e)
+	}
+}
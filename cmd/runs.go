@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/luoling8192/mindwave/ent/distillrun"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/distill"
+	"github.com/luoling8192/mindwave/schema"
+)
+
+const defaultRunsLimit = 50
+
+func runRuns(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("runs subcommand is required", "available", []string{"list", "usage"})
+		return
+	}
+
+	switch args[0] {
+	case "list":
+		runRunsList(ctx, client, args[1:])
+	case "usage":
+		runRunsUsage(ctx, client, args[1:])
+	default:
+		slog.Error("unknown runs subcommand", "subcommand", args[0])
+	}
+}
+
+func runRunsList(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("runs list", flag.ExitOnError)
+	chatID := fs.String("chat", "", "only list runs of this chat")
+	limit := fs.Int("limit", defaultRunsLimit, "maximum number of runs to list")
+	_ = fs.Parse(args)
+
+	query := client.DistillRun.Query()
+	if *chatID != "" {
+		query.Where(distillrun.InChatID(*chatID))
+	}
+	runs, err := query.
+		Order(distillrun.ByCreatedAt(sql.OrderDesc())).
+		Limit(*limit).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query distill runs", "error", err)
+		return
+	}
+
+	for _, r := range runs {
+		fmt.Printf("%s %s %s..%s status=%s messages=%d items=%d events=%d prompt_tokens=%d completion_tokens=%d cost=%.4f\n",
+			r.ID,
+			r.InChatID,
+			time.Unix(r.SpanStart, 0).Format(time.DateTime),
+			time.Unix(r.SpanEnd, 0).Format(time.DateTime),
+			r.Status,
+			r.MessageCount,
+			r.ItemCount,
+			r.EventCount,
+			r.PromptTokens,
+			r.CompletionTokens,
+			r.Cost,
+		)
+		if r.Error != "" {
+			fmt.Printf("  error: %s\n", r.Error)
+		}
+		if r.PartialOutput != "" {
+			fmt.Printf("  partial_output: %s\n", r.PartialOutput)
+		}
+	}
+}
+
+func runRunsUsage(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("runs usage", flag.ExitOnError)
+	month := fs.String("month", time.Now().UTC().Format("2006-01"), "calendar month to sum up, in UTC")
+	_ = fs.Parse(args)
+
+	start, err := time.Parse("2006-01", *month)
+	if err != nil {
+		slog.Error("invalid month, expected YYYY-MM", "month", *month)
+		return
+	}
+	end := start.AddDate(0, 1, 0)
+
+	runs, err := client.DistillRun.Query().
+		Where(
+			distillrun.CreatedAtGTE(start.UnixMilli()),
+			distillrun.CreatedAtLT(end.UnixMilli()),
+		).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query distill runs", "error", err)
+		return
+	}
+
+	var total schema.StageUsage
+	byStage := make(map[string]schema.StageUsage)
+	for _, r := range runs {
+		total.PromptTokens += r.PromptTokens
+		total.CompletionTokens += r.CompletionTokens
+		total.Cost += r.Cost
+		for stage, u := range r.StageUsage {
+			s := byStage[stage]
+			s.PromptTokens += u.PromptTokens
+			s.CompletionTokens += u.CompletionTokens
+			s.Cost += u.Cost
+			byStage[stage] = s
+		}
+	}
+
+	fmt.Printf("%s runs=%d prompt_tokens=%d completion_tokens=%d cost=%.4f\n",
+		*month, len(runs), total.PromptTokens, total.CompletionTokens, total.Cost)
+
+	stages := make([]string, 0, len(byStage))
+	for stage := range byStage {
+		stages = append(stages, stage)
+	}
+	sort.Strings(stages)
+	for _, stage := range stages {
+		u := byStage[stage]
+		fmt.Printf("  %s prompt_tokens=%d completion_tokens=%d cost=%.4f\n", stage, u.PromptTokens, u.CompletionTokens, u.Cost)
+	}
+
+	budget, err := monthlyBudget()
+	if err != nil {
+		slog.Error("failed to load budget", "error", err)
+		return
+	}
+	if budget > 0 && distill.MonthStart(time.Now()).Equal(start) {
+		fmt.Printf("budget=%.4f remaining=%.4f\n", budget, max(budget-total.Cost, 0))
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/luoling8192/mindwave/ent/chatschedule"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/scheduler"
+	"github.com/samber/lo"
+)
+
+func runSchedules(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("schedules subcommand is required", "available", []string{"list", "set", "remove"})
+		return
+	}
+
+	switch args[0] {
+	case "list":
+		runSchedulesList(ctx, client)
+	case "set":
+		runSchedulesSet(ctx, client, args[1:])
+	case "remove":
+		runSchedulesRemove(ctx, client, args[1:])
+	default:
+		slog.Error("unknown schedules subcommand", "subcommand", args[0])
+	}
+}
+
+func runSchedulesList(ctx context.Context, client *datastore.Client) {
+	schedules, err := client.ChatSchedule.Query().
+		Order(chatschedule.ByChatID()).
+		All(ctx)
+	if err != nil {
+		slog.Error("failed to query schedules", "error", err)
+		return
+	}
+
+	now := time.Now()
+	for _, s := range schedules {
+		next := "-"
+		if sched, err := scheduler.ParseCron(s.Cron); err == nil {
+			next = sched.Next(now).Format(time.DateTime)
+		}
+		last := "-"
+		if s.LastWindowEnd > 0 {
+			last = formatMillis(s.LastWindowEnd)
+		}
+		fmt.Printf("%s cron=%q enabled=%t last_window_end=%s next=%s\n", s.ChatID, s.Cron, s.Enabled, last, next)
+	}
+}
+
+func runSchedulesSet(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("schedules set", flag.ExitOnError)
+	disabled := fs.Bool("disabled", false, "store the schedule without running it")
+	_ = fs.Parse(args)
+
+	if fs.NArg() < 2 {
+		slog.Error("usage: schedules set [-disabled] <chat id> <cron expression>")
+		return
+	}
+
+	config := scheduler.ScheduleConfig{
+		ChatID:  fs.Arg(0),
+		Cron:    strings.Join(fs.Args()[1:], " "),
+		Enabled: lo.ToPtr(!*disabled),
+	}
+	if err := scheduler.Sync(ctx, client, []scheduler.ScheduleConfig{config}); err != nil {
+		slog.Error("failed to store schedule", "error", err)
+		return
+	}
+	slog.Info("Schedule stored", "chat_id", config.ChatID, "cron", config.Cron, "enabled", *config.Enabled)
+}
+
+func runSchedulesRemove(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("chat ids are required")
+		return
+	}
+
+	n, err := client.ChatSchedule.Delete().
+		Where(chatschedule.ChatIDIn(args...)).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("failed to remove schedules", "error", err)
+		return
+	}
+	slog.Info("Schedules removed", "count", n)
+}
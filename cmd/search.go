@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/search"
+	"github.com/samber/lo"
+)
+
+func runSearch(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	mode := fs.String("mode", string(search.ModeHybrid), "search mode: hybrid, semantic or keyword")
+	chatID := fs.String("chat", "", "only search in this chat id")
+	senderID := fs.String("sender", "", "only search messages from this platform user id")
+	senderName := fs.String("sender-name", "", "only search messages from this display name")
+	platform := fs.String("platform", "", "only search messages from this platform")
+	owner := fs.String("owner", "", "search the copies stored by this owner account id instead of one copy of every message")
+	since := fs.String("since", "", "only search messages at or after this time (2006-01-02 or RFC3339)")
+	until := fs.String("until", "", "only search messages at or before this time (2006-01-02 or RFC3339)")
+	limit := fs.Int("limit", 10, "maximum number of hits")
+	contextSize := fs.Int("context", 2, "number of surrounding messages to show on each side, 0 for none")
+	asJSON := fs.Bool("json", false, "print hits as JSON")
+	_ = fs.Parse(args)
+
+	query := strings.Join(fs.Args(), " ")
+	if query == "" {
+		slog.Error("search query is required")
+		return
+	}
+
+	searchMode, err := search.ParseMode(*mode)
+	if err != nil {
+		slog.Error("failed to parse mode", "error", err)
+		return
+	}
+
+	var ownerID uuid.UUID
+	if *owner != "" {
+		ownerID, err = uuid.Parse(*owner)
+		if err != nil {
+			slog.Error("failed to parse owner account id", "error", err)
+			return
+		}
+	}
+
+	sinceTime, err := parseTimeFlag(*since)
+	if err != nil {
+		slog.Error("failed to parse since", "error", err)
+		return
+	}
+	untilTime, err := parseTimeFlag(*until)
+	if err != nil {
+		slog.Error("failed to parse until", "error", err)
+		return
+	}
+
+	llmClient, err := newLLMClient()
+	if err != nil {
+		slog.Error("failed to create llm client", "error", err)
+		return
+	}
+
+	embeddingModel, err := embeddingModelFromEnv()
+	if err != nil {
+		slog.Error("failed to load embedding model", "error", err)
+		return
+	}
+
+	tokenizer, err := newTokenizer()
+	if err != nil {
+		slog.Error("failed to create tokenizer", "error", err)
+		return
+	}
+
+	searcher, err := search.NewSearcher(client, llmClient, embeddingModel, tokenizer.Tokenize)
+	if err != nil {
+		slog.Error("failed to create searcher", "error", err)
+		return
+	}
+
+	hits, err := searcher.Search(ctx, search.Options{
+		Query: query,
+		Mode:  searchMode,
+		Filter: search.Filter{
+			ChatID:     *chatID,
+			SenderID:   *senderID,
+			SenderName: *senderName,
+			Platform:   *platform,
+			Since:      sinceTime,
+			Until:      untilTime,
+
+			OwnerAccountID: ownerID,
+		},
+		Limit:       *limit,
+		ContextSize: *contextSize,
+	})
+	if err != nil {
+		slog.Error("failed to search messages", "error", err)
+		return
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(lo.Map(hits, func(h search.Hit, _ int) searchHit { return newSearchHit(h) })); err != nil {
+			slog.Error("failed to encode hits", "error", err)
+		}
+		return
+	}
+
+	for i, hit := range hits {
+		fmt.Printf("#%d score=%.4f vector_rank=%d keyword_rank=%d chat=%s id=%s\n",
+			i+1, hit.Score, hit.VectorRank, hit.KeywordRank, hit.Message.InChatID, hit.Message.ID)
+		for _, m := range hit.Before {
+			fmt.Println("    " + formatMessageLine(m))
+		}
+		fmt.Println("  > " + formatMessageLine(hit.Message))
+		for _, m := range hit.After {
+			fmt.Println("    " + formatMessageLine(m))
+		}
+		fmt.Println()
+	}
+}
+
+// searchHit is a hit as printed by -json, without the vectors and
+// bookkeeping columns of the message.
+type searchHit struct {
+	ID        uuid.UUID `json:"id"`
+	Chat      string    `json:"chat"`
+	Timestamp time.Time `json:"timestamp"`
+	FromName  string    `json:"from_name"`
+	Content   string    `json:"content"`
+	Score     float64   `json:"score"`
+}
+
+func newSearchHit(h search.Hit) searchHit {
+	return searchHit{
+		ID:        h.Message.ID,
+		Chat:      h.Message.InChatID,
+		Timestamp: time.Unix(h.Message.PlatformTimestamp, 0),
+		FromName:  h.Message.FromName,
+		Content:   h.Message.Content,
+		Score:     h.Score,
+	}
+}
+
+func formatMessageLine(m *ent.ChatMessage) string {
+	return fmt.Sprintf("[%s] %s: %s",
+		time.Unix(m.PlatformTimestamp, 0).Format("2006-01-02 15:04:05"),
+		m.FromName,
+		m.Content,
+	)
+}
+
+func parseTimeFlag(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
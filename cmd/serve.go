@@ -0,0 +1,397 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/agent/providertest"
+	"github.com/luoling8192/mindwave/internal/api"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/jobs"
+	"github.com/luoling8192/mindwave/internal/mcpserver"
+	"github.com/luoling8192/mindwave/internal/publish/publishtest"
+	"github.com/luoling8192/mindwave/internal/services/retention"
+	"github.com/luoling8192/mindwave/internal/services/scheduler"
+	"github.com/luoling8192/mindwave/internal/services/search"
+	"github.com/nekomeowww/fo"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultAPIAddr = ":8080"
+	defaultMCPAddr = ":8081"
+
+	defaultStandinAddr    = "127.0.0.1:8089"
+	defaultLLMStandinAddr = "127.0.0.1:8090"
+)
+
+func runServe(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("serve mode is required", "available", []string{"api", "mcp", "scheduler", "worker", "janitor", "publish-standin", "llm-standin"})
+		return
+	}
+
+	switch args[0] {
+	case "api":
+		runServeAPI(ctx, client, args[1:])
+	case "mcp":
+		runServeMCP(ctx, client, args[1:])
+	case "scheduler":
+		runServeScheduler(ctx, client, args[1:])
+	case "worker":
+		runServeWorker(ctx, client, args[1:])
+	case "janitor":
+		runServeJanitor(ctx, client, args[1:])
+	case "publish-standin":
+		runServePublishStandin(ctx, args[1:])
+	case "llm-standin":
+		runServeLLMStandin(ctx, args[1:])
+	default:
+		slog.Error("unknown serve mode", "mode", args[0])
+	}
+}
+
+func runServeAPI(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("serve api", flag.ExitOnError)
+	addr := fs.String("addr", fo.May(lo.Coalesce(os.Getenv("API_ADDR"), defaultAPIAddr)), "address to listen on")
+	newAuth := authFlags(fs, true)
+	_ = fs.Parse(args)
+
+	authenticator, err := newAuth(ctx, client)
+	if err != nil {
+		slog.Error("failed to set up authentication", "error", err)
+		return
+	}
+
+	searcher := newSearcherOrNil(client)
+	server := api.NewServer(client, searcher, newAskerOrNil(client, searcher), newFinder(client, searcher), api.Options{
+		Auth: authenticator,
+	})
+	if err := server.ListenAndServe(ctx, *addr); err != nil {
+		slog.Error("api server failed", "error", err)
+	}
+}
+
+func runServeMCP(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("serve mcp", flag.ExitOnError)
+	transport := fs.String("transport", "stdio", "transport to serve: stdio or http")
+	addr := fs.String("addr", fo.May(lo.Coalesce(os.Getenv("MCP_ADDR"), defaultMCPAddr)), "address to listen on with the http transport")
+	newAuth := authFlags(fs, false)
+	_ = fs.Parse(args)
+
+	var opts mcpserver.Options
+	if *transport == "http" {
+		var err error
+		if opts.Auth, err = newAuth(ctx, client); err != nil {
+			slog.Error("failed to set up authentication", "error", err)
+			return
+		}
+	}
+
+	searcher := newSearcherOrNil(client)
+	server := mcpserver.NewServer(client, searcher, newFinder(client, searcher), opts)
+	var err error
+	switch *transport {
+	case "stdio":
+		err = server.ServeStdio(ctx)
+	case "http":
+		err = server.ListenAndServe(ctx, *addr)
+	default:
+		slog.Error("unknown mcp transport", "transport", *transport)
+		return
+	}
+	if err != nil {
+		slog.Error("mcp server failed", "error", err)
+	}
+}
+
+func runServeScheduler(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("serve scheduler", flag.ExitOnError)
+	configPath := fs.String("config", os.Getenv("SCHEDULER_CONFIG"), "JSON file with schedules to store before starting")
+	concurrency := fs.Int("concurrency", 2, "maximum number of distill jobs running at once")
+	catchUp := fs.Int("catch-up", 48, "maximum number of missed windows distilled per chat after downtime")
+	tick := fs.Duration("tick", time.Minute, "how often to check schedules and retry leadership")
+	inProcess := fs.Bool("in-process", false, "distill windows here instead of handing them to the job queue for serve worker")
+	_ = fs.Parse(args)
+
+	if *configPath != "" {
+		config, err := scheduler.LoadConfig(*configPath)
+		if err != nil {
+			slog.Error("failed to load scheduler config", "error", err)
+			return
+		}
+		if err := scheduler.Sync(ctx, client, config.Schedules); err != nil {
+			slog.Error("failed to store configured schedules", "error", err)
+			return
+		}
+	}
+
+	distillWindow := distiller(func(ctx context.Context, chatID string, start, end time.Time) ([]agent.ExtractedItem, error) {
+		_, err := enqueueDistill(ctx, client, chatID, start, end)
+		return nil, err
+	})
+	if *inProcess {
+		var err error
+		distillWindow, err = newDistiller(ctx, client)
+		if err != nil {
+			slog.Error("failed to set up distill", "error", err)
+			return
+		}
+	}
+
+	s := scheduler.New(client, func(ctx context.Context, chatID string, start, end time.Time) error {
+		items, err := distillWindow(ctx, chatID, start, end)
+		if err == nil {
+			slog.Info("Distilled window", "chat_id", chatID, "items", len(items), "enqueued", !*inProcess)
+		}
+		return err
+	}, scheduler.Options{
+		LockKey:     schedulerLockKey(),
+		Concurrency: *concurrency,
+		MaxCatchUp:  *catchUp,
+		Tick:        *tick,
+	})
+	if err := s.Run(ctx); err != nil {
+		slog.Error("scheduler failed", "error", err)
+	}
+}
+
+// runServeWorker runs queued distill, embed, graph sync, profile and tokenize
+// jobs. Any number of workers can run against the same database.
+func runServeWorker(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("serve worker", flag.ExitOnError)
+	concurrency := fs.Int("concurrency", 2, "maximum number of jobs running at once")
+	poll := fs.Duration("poll", 5*time.Second, "how long to wait before looking for jobs again when the queue is empty")
+	lease := fs.Duration("lease", 30*time.Minute, "how long an attempt may run before the job is claimed again")
+	backoff := fs.Duration("backoff", 30*time.Second, "delay before retrying a failed job, doubled with every attempt")
+	maxBackoff := fs.Duration("max-backoff", 30*time.Minute, "maximum delay before retrying a failed job")
+	_ = fs.Parse(args)
+
+	stages, err := newDistillStages(ctx, client)
+	if err != nil {
+		slog.Error("failed to set up distill", "error", err)
+		return
+	}
+	stages.tokenizer, err = newTokenizer()
+	if err != nil {
+		slog.Error("failed to create tokenizer", "error", err)
+		return
+	}
+
+	worker := jobs.NewWorker(client, stages.handlers(), jobs.WorkerOptions{
+		Concurrency:  *concurrency,
+		PollInterval: *poll,
+		Lease:        *lease,
+		BaseBackoff:  *backoff,
+		MaxBackoff:   *maxBackoff,
+	})
+	if err := worker.Run(ctx); err != nil {
+		slog.Error("job worker failed", "error", err)
+	}
+}
+
+// runServeJanitor applies the retention policies of all chats on every
+// interval. Purges are idempotent, replicas need no leader.
+func runServeJanitor(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("serve janitor", flag.ExitOnError)
+	interval := fs.Duration("interval", time.Hour, "how often to apply the retention policies")
+	dryRun := fs.Bool("dry-run", false, "log what would be purged without changing anything")
+	_ = fs.Parse(args)
+
+	graphWriter, err := newGraphWriter(client)
+	if err != nil {
+		slog.Error("failed to create graph writer", "error", err)
+		return
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		reports, err := retention.Run(ctx, client, graphWriter, time.Now(), *dryRun)
+		if err != nil {
+			slog.Error("failed to apply retention policies", "error", err)
+		}
+		for _, r := range reports {
+			slog.Info("Applied retention policy",
+				"chat_id", r.ChatID,
+				"mode", r.Mode,
+				"cutoff", r.Cutoff,
+				"dry_run", r.DryRun,
+				"messages_blanked", r.MessagesBlanked,
+				"messages_deleted", r.MessagesDeleted,
+				"events_deleted", r.EventsDeleted,
+				"summaries_deleted", r.SummariesDeleted,
+				"ask_turns_deleted", r.AskTurnsDeleted,
+				"profiles_deleted", r.ProfilesDeleted,
+				"graph_errors", len(r.GraphErrors),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runServePublishStandin serves a local stand-in for the Telegram Bot API and
+// chat webhooks that logs posts instead of delivering them, for trying out
+// digest publishing with TELEGRAM_API_URL or DIGEST_WEBHOOK_URL pointed at it.
+func runServePublishStandin(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("serve publish-standin", flag.ExitOnError)
+	addr := fs.String("addr", defaultStandinAddr, "address to listen on")
+	_ = fs.Parse(args)
+
+	handler := &publishtest.Handler{
+		OnMessage: func(m publishtest.Message) {
+			slog.Info("Message received", "path", m.Path, "chat_id", m.ChatID, "length", len(m.Text))
+			fmt.Println(m.Text)
+		},
+	}
+	server := &http.Server{Addr: *addr, Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	slog.Info("Starting publish stand-in", "addr", *addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("publish stand-in failed", "error", err)
+	}
+}
+
+// runServeLLMStandin serves a local stand-in for the Anthropic and Ollama
+// APIs that echoes prompts and hashes texts into vectors, for trying out a
+// providers config offline.
+func runServeLLMStandin(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("serve llm-standin", flag.ExitOnError)
+	addr := fs.String("addr", defaultLLMStandinAddr, "address to listen on")
+	dimensions := fs.Int("dimensions", 0, "size of the embeddings, defaults to EMBEDDING_DIMENSIONS")
+	_ = fs.Parse(args)
+
+	if *dimensions == 0 {
+		model, err := embeddingModelFromEnv()
+		if err != nil {
+			slog.Error("failed to load embedding model", "error", err)
+			return
+		}
+		*dimensions = model.Dimensions
+	}
+
+	handler := &providertest.Handler{Dimensions: *dimensions}
+	server := &http.Server{Addr: *addr, Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	slog.Info("Starting LLM stand-in", "addr", *addr, "dimensions", *dimensions)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("LLM stand-in failed", "error", err)
+	}
+}
+
+// authFlags registers the authentication flags of a server and returns a
+// function building its authenticator, nil when authentication is off. It is
+// on unless -auth=off turns it off explicitly. userHeader adds -user-header,
+// trusting a header set by a proxy in front of the server.
+func authFlags(fs *flag.FlagSet, userHeader bool) func(context.Context, *datastore.Client) (*auth.Authenticator, error) {
+	mode := fs.String("auth", fo.May(lo.Coalesce(os.Getenv("API_AUTH"), "on")), "on requires API keys or bearer tokens, off serves every chat to anyone")
+	header, headerRole := new(string), new(string)
+	if userHeader {
+		header = fs.String("user-header", os.Getenv("API_USER_HEADER"), "header naming the API user, set by a trusted proxy")
+		headerRole = fs.String("user-header-role", os.Getenv("API_USER_HEADER_ROLE"), "role of users named by -user-header, defaults to curator")
+	}
+	jwksFile := fs.String("jwks-file", os.Getenv("API_JWKS_FILE"), "local JWKS file with the keys of bearer tokens")
+	jwksURL := fs.String("jwks-url", os.Getenv("API_JWKS_URL"), "URL of the JWKS with the keys of bearer tokens")
+	issuer := fs.String("oidc-issuer", os.Getenv("API_OIDC_ISSUER"), "OIDC issuer required of bearer tokens, its JWKS is discovered without -jwks-file or -jwks-url")
+	audience := fs.String("jwt-audience", os.Getenv("API_JWT_AUDIENCE"), "audience required of bearer tokens")
+	roleClaim := fs.String("jwt-role-claim", os.Getenv("API_JWT_ROLE_CLAIM"), "claim carrying the role of bearer tokens, defaults to roles")
+
+	return func(ctx context.Context, client *datastore.Client) (*auth.Authenticator, error) {
+		withTokens := *jwksFile != "" || *jwksURL != "" || *issuer != ""
+		switch *mode {
+		case "on":
+		case "off":
+			if withTokens || *header != "" {
+				return nil, errors.New("-auth=off conflicts with the user header and bearer token settings")
+			}
+			slog.Warn("AUTHENTICATION IS OFF: every chat and its raw messages are served to anyone reaching the server, and reads are logged without a caller")
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("invalid -auth %q, want on or off", *mode)
+		}
+
+		opts := auth.Options{UserHeader: *header}
+		if *headerRole != "" {
+			role, err := auth.ParseRole(*headerRole)
+			if err != nil {
+				return nil, err
+			}
+			opts.HeaderRole = role
+		}
+		var err error
+		if withTokens {
+			opts.Tokens, err = auth.NewTokenVerifier(ctx, auth.TokenOptions{
+				JWKSFile:  *jwksFile,
+				JWKSURL:   *jwksURL,
+				Issuer:    *issuer,
+				Audience:  *audience,
+				RoleClaim: *roleClaim,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return auth.NewAuthenticator(client, opts), nil
+	}
+}
+
+// schedulerLockKey gives the schedulers of every workspace their own leader.
+func schedulerLockKey() int64 {
+	if currentWorkspace == nil {
+		return scheduler.DefaultLockKey
+	}
+	return scheduler.DefaultLockKey ^ int64(binary.BigEndian.Uint64(currentWorkspace.ID[:8]))
+}
+
+// newSearcherOrNil builds a searcher for servers, which keep running without
+// search when the LLM endpoint is not configured.
+func newSearcherOrNil(client *datastore.Client) *search.Searcher {
+	llmClient, err := newLLMClient()
+	if err != nil {
+		slog.Warn("search disabled, failed to create llm client", "error", err)
+		return nil
+	}
+
+	embeddingModel, err := embeddingModelFromEnv()
+	if err != nil {
+		slog.Warn("search disabled, failed to load embedding model", "error", err)
+		return nil
+	}
+
+	tokenizer, err := newTokenizer()
+	if err != nil {
+		slog.Warn("search disabled, failed to create tokenizer", "error", err)
+		return nil
+	}
+
+	searcher, err := search.NewSearcher(client, llmClient, embeddingModel, tokenizer.Tokenize)
+	if err != nil {
+		slog.Warn("search disabled, failed to create searcher", "error", err)
+		return nil
+	}
+
+	return searcher
+}
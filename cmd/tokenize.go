@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"time"
+
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/tokenize"
+)
+
+func runTokenize(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("tokenize", flag.ExitOnError)
+	batchSize := fs.Int("batch", 500, "number of messages tokenized per batch")
+	follow := fs.Bool("follow", false, "keep running and tokenize newly ingested messages, for deployments without a job worker")
+	interval := fs.Duration("interval", time.Minute, "polling interval when following")
+	_ = fs.Parse(args)
+
+	tokenizer, err := newTokenizer()
+	if err != nil {
+		slog.Error("failed to create tokenizer", "error", err)
+		return
+	}
+
+	if *follow {
+		if err := tokenize.Follow(ctx, client, tokenizer, *batchSize, *interval); err != nil {
+			slog.Error("tokenize worker stopped", "error", err)
+		}
+		return
+	}
+
+	count, err := tokenize.Backfill(ctx, client, tokenizer, *batchSize)
+	if err != nil {
+		slog.Error("failed to backfill jieba tokens", "error", err, "tokenized", count)
+		return
+	}
+
+	slog.Info("Backfill finished", "tokenized", count)
+}
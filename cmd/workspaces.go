@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/graph"
+	"github.com/luoling8192/mindwave/internal/services/workspaces"
+)
+
+func runWorkspaces(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) == 0 {
+		slog.Error("workspaces subcommand is required", "available", []string{"list", "create", "update", "assign"})
+		return
+	}
+
+	switch args[0] {
+	case "list":
+		runWorkspacesList(ctx, client)
+	case "create":
+		runWorkspacesCreate(ctx, client, args[1:])
+	case "update":
+		runWorkspacesUpdate(ctx, client, args[1:])
+	case "assign":
+		runWorkspacesAssign(ctx, client, args[1:])
+	default:
+		slog.Error("unknown workspaces subcommand", "subcommand", args[0])
+	}
+}
+
+func runWorkspacesList(ctx context.Context, client *datastore.Client) {
+	list, err := workspaces.List(ctx, client)
+	if err != nil {
+		slog.Error("failed to query workspaces", "error", err)
+		return
+	}
+
+	for _, w := range list {
+		fmt.Printf("%s %q graph=%s own_llm=%t budget=%.2f\n", w.Slug, w.Name, w.GraphName, len(w.LlmProviders) > 0, w.LlmMonthlyBudget)
+	}
+}
+
+func runWorkspacesCreate(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("workspaces create", flag.ExitOnError)
+	name := fs.String("name", "", "display name")
+	graphName := fs.String("graph", "", "AGE graph name, defaults to mindwave_<slug>")
+	providers := fs.String("providers", "", "providers config file in the format of LLM_PROVIDERS")
+	budget := fs.Float64("budget", 0, "monthly LLM budget in USD, 0 uses LLM_MONTHLY_BUDGET")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		slog.Error("usage: workspaces create [-name N] [-graph G] [-providers file] [-budget USD] <slug>")
+		return
+	}
+
+	config, err := readProvidersFile(*providers)
+	if err != nil {
+		slog.Error("failed to read providers config", "error", err)
+		return
+	}
+
+	w, err := workspaces.Create(ctx, client, workspaces.Settings{
+		Slug:          fs.Arg(0),
+		Name:          *name,
+		GraphName:     *graphName,
+		LLMProviders:  config,
+		MonthlyBudget: *budget,
+	})
+	if err != nil {
+		slog.Error("failed to create workspace", "error", err)
+		return
+	}
+
+	graphWriter, err := graph.NewWriter(client, w.GraphName)
+	if err != nil {
+		slog.Error("failed to create graph writer", "error", err)
+		return
+	}
+	if err := graphWriter.EnsureGraph(ctx); err != nil {
+		slog.Error("failed to create graph", "graph", w.GraphName, "error", err)
+		return
+	}
+	slog.Info("Workspace created", "slug", w.Slug, "graph", w.GraphName)
+}
+
+func runWorkspacesUpdate(ctx context.Context, client *datastore.Client, args []string) {
+	fs := flag.NewFlagSet("workspaces update", flag.ExitOnError)
+	name := fs.String("name", "", "display name")
+	providers := fs.String("providers", "", "providers config file in the format of LLM_PROVIDERS, empty to use the environment")
+	budget := fs.Float64("budget", 0, "monthly LLM budget in USD, 0 uses LLM_MONTHLY_BUDGET")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		slog.Error("usage: workspaces update [-name N] [-providers file] [-budget USD] <slug>")
+		return
+	}
+
+	w, err := workspaces.Get(ctx, client, fs.Arg(0))
+	if err != nil {
+		slog.Error("failed to load workspace", "error", err)
+		return
+	}
+	if w == nil {
+		slog.Error("the default workspace is configured by the environment")
+		return
+	}
+
+	var changes workspaces.Changes
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "name":
+			changes.Name = name
+		case "providers":
+			changes.ClearLLM = *providers == ""
+		case "budget":
+			changes.MonthlyBudget = budget
+		}
+	})
+	changes.LLMProviders, err = readProvidersFile(*providers)
+	if err != nil {
+		slog.Error("failed to read providers config", "error", err)
+		return
+	}
+
+	w, err = workspaces.Update(ctx, client, w.ID, changes)
+	if err != nil {
+		slog.Error("failed to update workspace", "error", err)
+		return
+	}
+	slog.Info("Workspace updated", "slug", w.Slug)
+}
+
+// runWorkspacesAssign moves chats into a workspace, default moves them back
+// to the default one.
+func runWorkspacesAssign(ctx context.Context, client *datastore.Client, args []string) {
+	if len(args) < 2 {
+		slog.Error("usage: workspaces assign <slug> <chat id>...")
+		return
+	}
+
+	w, err := workspaces.Get(ctx, client, args[0])
+	if err != nil {
+		slog.Error("failed to load workspace", "error", err)
+		return
+	}
+	workspaceID := uuid.Nil
+	if w != nil {
+		workspaceID = w.ID
+	}
+
+	assignment, err := workspaces.Assign(ctx, client, workspaceID, args[1:])
+	if err != nil {
+		slog.Error("failed to assign chats", "error", err)
+		return
+	}
+	slog.Info("Chats assigned", "workspace", args[0], "chats", assignment.Chats, "messages", assignment.Messages)
+}
+
+// readProvidersFile reads a providers config file, no file reads nothing.
+func readProvidersFile(path string) (json.RawMessage, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return os.ReadFile(path)
+}
@@ -0,0 +1,979 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/accesslog"
+)
+
+// AccessLogCreate is the builder for creating a AccessLog entity.
+type AccessLogCreate struct {
+	config
+	mutation *AccessLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *AccessLogCreate) SetWorkspaceID(v uuid.UUID) *AccessLogCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *AccessLogCreate) SetNillableWorkspaceID(v *uuid.UUID) *AccessLogCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *AccessLogCreate) SetSubject(v string) *AccessLogCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_c *AccessLogCreate) SetNillableSubject(v *string) *AccessLogCreate {
+	if v != nil {
+		_c.SetSubject(*v)
+	}
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *AccessLogCreate) SetMethod(v string) *AccessLogCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_c *AccessLogCreate) SetNillableMethod(v *string) *AccessLogCreate {
+	if v != nil {
+		_c.SetMethod(*v)
+	}
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *AccessLogCreate) SetRole(v string) *AccessLogCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *AccessLogCreate) SetNillableRole(v *string) *AccessLogCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_c *AccessLogCreate) SetEndpoint(v string) *AccessLogCreate {
+	_c.mutation.SetEndpoint(v)
+	return _c
+}
+
+// SetQuery sets the "query" field.
+func (_c *AccessLogCreate) SetQuery(v string) *AccessLogCreate {
+	_c.mutation.SetQuery(v)
+	return _c
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_c *AccessLogCreate) SetNillableQuery(v *string) *AccessLogCreate {
+	if v != nil {
+		_c.SetQuery(*v)
+	}
+	return _c
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (_c *AccessLogCreate) SetChatIds(v []string) *AccessLogCreate {
+	_c.mutation.SetChatIds(v)
+	return _c
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (_c *AccessLogCreate) SetMessageIds(v []uuid.UUID) *AccessLogCreate {
+	_c.mutation.SetMessageIds(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccessLogCreate) SetCreatedAt(v int64) *AccessLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccessLogCreate) SetNillableCreatedAt(v *int64) *AccessLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccessLogCreate) SetID(v uuid.UUID) *AccessLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AccessLogCreate) SetNillableID(v *uuid.UUID) *AccessLogCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AccessLogMutation object of the builder.
+func (_c *AccessLogCreate) Mutation() *AccessLogMutation {
+	return _c.mutation
+}
+
+// Save creates the AccessLog in the database.
+func (_c *AccessLogCreate) Save(ctx context.Context) (*AccessLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccessLogCreate) SaveX(ctx context.Context) *AccessLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccessLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccessLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccessLogCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := accesslog.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		v := accesslog.DefaultSubject
+		_c.mutation.SetSubject(v)
+	}
+	if _, ok := _c.mutation.Method(); !ok {
+		v := accesslog.DefaultMethod
+		_c.mutation.SetMethod(v)
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		v := accesslog.DefaultRole
+		_c.mutation.SetRole(v)
+	}
+	if _, ok := _c.mutation.Query(); !ok {
+		v := accesslog.DefaultQuery
+		_c.mutation.SetQuery(v)
+	}
+	if _, ok := _c.mutation.ChatIds(); !ok {
+		v := accesslog.DefaultChatIds
+		_c.mutation.SetChatIds(v)
+	}
+	if _, ok := _c.mutation.MessageIds(); !ok {
+		v := accesslog.DefaultMessageIds
+		_c.mutation.SetMessageIds(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accesslog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := accesslog.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccessLogCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "AccessLog.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "AccessLog.subject"`)}
+	}
+	if _, ok := _c.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "AccessLog.method"`)}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "AccessLog.role"`)}
+	}
+	if _, ok := _c.mutation.Endpoint(); !ok {
+		return &ValidationError{Name: "endpoint", err: errors.New(`ent: missing required field "AccessLog.endpoint"`)}
+	}
+	if _, ok := _c.mutation.Query(); !ok {
+		return &ValidationError{Name: "query", err: errors.New(`ent: missing required field "AccessLog.query"`)}
+	}
+	if _, ok := _c.mutation.ChatIds(); !ok {
+		return &ValidationError{Name: "chat_ids", err: errors.New(`ent: missing required field "AccessLog.chat_ids"`)}
+	}
+	if _, ok := _c.mutation.MessageIds(); !ok {
+		return &ValidationError{Name: "message_ids", err: errors.New(`ent: missing required field "AccessLog.message_ids"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccessLog.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AccessLogCreate) sqlSave(ctx context.Context) (*AccessLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccessLogCreate) createSpec() (*AccessLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accesslog.Table, sqlgraph.NewFieldSpec(accesslog.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.AccessLog
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(accesslog.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(accesslog.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(accesslog.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(accesslog.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.Endpoint(); ok {
+		_spec.SetField(accesslog.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = value
+	}
+	if value, ok := _c.mutation.Query(); ok {
+		_spec.SetField(accesslog.FieldQuery, field.TypeString, value)
+		_node.Query = value
+	}
+	if value, ok := _c.mutation.ChatIds(); ok {
+		_spec.SetField(accesslog.FieldChatIds, field.TypeJSON, value)
+		_node.ChatIds = value
+	}
+	if value, ok := _c.mutation.MessageIds(); ok {
+		_spec.SetField(accesslog.FieldMessageIds, field.TypeJSON, value)
+		_node.MessageIds = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accesslog.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccessLog.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccessLogUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccessLogCreate) OnConflict(opts ...sql.ConflictOption) *AccessLogUpsertOne {
+	_c.conflict = opts
+	return &AccessLogUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccessLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccessLogCreate) OnConflictColumns(columns ...string) *AccessLogUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccessLogUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AccessLogUpsertOne is the builder for "upsert"-ing
+	//  one AccessLog node.
+	AccessLogUpsertOne struct {
+		create *AccessLogCreate
+	}
+
+	// AccessLogUpsert is the "OnConflict" setter.
+	AccessLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *AccessLogUpsert) SetWorkspaceID(v uuid.UUID) *AccessLogUpsert {
+	u.Set(accesslog.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *AccessLogUpsert) UpdateWorkspaceID() *AccessLogUpsert {
+	u.SetExcluded(accesslog.FieldWorkspaceID)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *AccessLogUpsert) SetSubject(v string) *AccessLogUpsert {
+	u.Set(accesslog.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *AccessLogUpsert) UpdateSubject() *AccessLogUpsert {
+	u.SetExcluded(accesslog.FieldSubject)
+	return u
+}
+
+// SetMethod sets the "method" field.
+func (u *AccessLogUpsert) SetMethod(v string) *AccessLogUpsert {
+	u.Set(accesslog.FieldMethod, v)
+	return u
+}
+
+// UpdateMethod sets the "method" field to the value that was provided on create.
+func (u *AccessLogUpsert) UpdateMethod() *AccessLogUpsert {
+	u.SetExcluded(accesslog.FieldMethod)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *AccessLogUpsert) SetRole(v string) *AccessLogUpsert {
+	u.Set(accesslog.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *AccessLogUpsert) UpdateRole() *AccessLogUpsert {
+	u.SetExcluded(accesslog.FieldRole)
+	return u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *AccessLogUpsert) SetEndpoint(v string) *AccessLogUpsert {
+	u.Set(accesslog.FieldEndpoint, v)
+	return u
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *AccessLogUpsert) UpdateEndpoint() *AccessLogUpsert {
+	u.SetExcluded(accesslog.FieldEndpoint)
+	return u
+}
+
+// SetQuery sets the "query" field.
+func (u *AccessLogUpsert) SetQuery(v string) *AccessLogUpsert {
+	u.Set(accesslog.FieldQuery, v)
+	return u
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *AccessLogUpsert) UpdateQuery() *AccessLogUpsert {
+	u.SetExcluded(accesslog.FieldQuery)
+	return u
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (u *AccessLogUpsert) SetChatIds(v []string) *AccessLogUpsert {
+	u.Set(accesslog.FieldChatIds, v)
+	return u
+}
+
+// UpdateChatIds sets the "chat_ids" field to the value that was provided on create.
+func (u *AccessLogUpsert) UpdateChatIds() *AccessLogUpsert {
+	u.SetExcluded(accesslog.FieldChatIds)
+	return u
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (u *AccessLogUpsert) SetMessageIds(v []uuid.UUID) *AccessLogUpsert {
+	u.Set(accesslog.FieldMessageIds, v)
+	return u
+}
+
+// UpdateMessageIds sets the "message_ids" field to the value that was provided on create.
+func (u *AccessLogUpsert) UpdateMessageIds() *AccessLogUpsert {
+	u.SetExcluded(accesslog.FieldMessageIds)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AccessLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accesslog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccessLogUpsertOne) UpdateNewValues() *AccessLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accesslog.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accesslog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccessLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccessLogUpsertOne) Ignore() *AccessLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccessLogUpsertOne) DoNothing() *AccessLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccessLogCreate.OnConflict
+// documentation for more info.
+func (u *AccessLogUpsertOne) Update(set func(*AccessLogUpsert)) *AccessLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccessLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *AccessLogUpsertOne) SetWorkspaceID(v uuid.UUID) *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *AccessLogUpsertOne) UpdateWorkspaceID() *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *AccessLogUpsertOne) SetSubject(v string) *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *AccessLogUpsertOne) UpdateSubject() *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetMethod sets the "method" field.
+func (u *AccessLogUpsertOne) SetMethod(v string) *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetMethod(v)
+	})
+}
+
+// UpdateMethod sets the "method" field to the value that was provided on create.
+func (u *AccessLogUpsertOne) UpdateMethod() *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateMethod()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *AccessLogUpsertOne) SetRole(v string) *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *AccessLogUpsertOne) UpdateRole() *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *AccessLogUpsertOne) SetEndpoint(v string) *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetEndpoint(v)
+	})
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *AccessLogUpsertOne) UpdateEndpoint() *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateEndpoint()
+	})
+}
+
+// SetQuery sets the "query" field.
+func (u *AccessLogUpsertOne) SetQuery(v string) *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetQuery(v)
+	})
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *AccessLogUpsertOne) UpdateQuery() *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateQuery()
+	})
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (u *AccessLogUpsertOne) SetChatIds(v []string) *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetChatIds(v)
+	})
+}
+
+// UpdateChatIds sets the "chat_ids" field to the value that was provided on create.
+func (u *AccessLogUpsertOne) UpdateChatIds() *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateChatIds()
+	})
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (u *AccessLogUpsertOne) SetMessageIds(v []uuid.UUID) *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetMessageIds(v)
+	})
+}
+
+// UpdateMessageIds sets the "message_ids" field to the value that was provided on create.
+func (u *AccessLogUpsertOne) UpdateMessageIds() *AccessLogUpsertOne {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateMessageIds()
+	})
+}
+
+// Exec executes the query.
+func (u *AccessLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccessLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccessLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccessLogUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AccessLogUpsertOne.ID is not supported by MySQL driver. Use AccessLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccessLogUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccessLogCreateBulk is the builder for creating many AccessLog entities in bulk.
+type AccessLogCreateBulk struct {
+	config
+	err      error
+	builders []*AccessLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccessLog entities in the database.
+func (_c *AccessLogCreateBulk) Save(ctx context.Context) ([]*AccessLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccessLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccessLogCreateBulk) SaveX(ctx context.Context) []*AccessLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccessLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccessLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccessLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccessLogUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccessLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccessLogUpsertBulk {
+	_c.conflict = opts
+	return &AccessLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccessLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccessLogCreateBulk) OnConflictColumns(columns ...string) *AccessLogUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccessLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// AccessLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccessLog nodes.
+type AccessLogUpsertBulk struct {
+	create *AccessLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccessLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accesslog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccessLogUpsertBulk) UpdateNewValues() *AccessLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accesslog.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accesslog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccessLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccessLogUpsertBulk) Ignore() *AccessLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccessLogUpsertBulk) DoNothing() *AccessLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccessLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccessLogUpsertBulk) Update(set func(*AccessLogUpsert)) *AccessLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccessLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *AccessLogUpsertBulk) SetWorkspaceID(v uuid.UUID) *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *AccessLogUpsertBulk) UpdateWorkspaceID() *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *AccessLogUpsertBulk) SetSubject(v string) *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *AccessLogUpsertBulk) UpdateSubject() *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetMethod sets the "method" field.
+func (u *AccessLogUpsertBulk) SetMethod(v string) *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetMethod(v)
+	})
+}
+
+// UpdateMethod sets the "method" field to the value that was provided on create.
+func (u *AccessLogUpsertBulk) UpdateMethod() *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateMethod()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *AccessLogUpsertBulk) SetRole(v string) *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *AccessLogUpsertBulk) UpdateRole() *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *AccessLogUpsertBulk) SetEndpoint(v string) *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetEndpoint(v)
+	})
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *AccessLogUpsertBulk) UpdateEndpoint() *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateEndpoint()
+	})
+}
+
+// SetQuery sets the "query" field.
+func (u *AccessLogUpsertBulk) SetQuery(v string) *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetQuery(v)
+	})
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *AccessLogUpsertBulk) UpdateQuery() *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateQuery()
+	})
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (u *AccessLogUpsertBulk) SetChatIds(v []string) *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetChatIds(v)
+	})
+}
+
+// UpdateChatIds sets the "chat_ids" field to the value that was provided on create.
+func (u *AccessLogUpsertBulk) UpdateChatIds() *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateChatIds()
+	})
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (u *AccessLogUpsertBulk) SetMessageIds(v []uuid.UUID) *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.SetMessageIds(v)
+	})
+}
+
+// UpdateMessageIds sets the "message_ids" field to the value that was provided on create.
+func (u *AccessLogUpsertBulk) UpdateMessageIds() *AccessLogUpsertBulk {
+	return u.Update(func(s *AccessLogUpsert) {
+		s.UpdateMessageIds()
+	})
+}
+
+// Exec executes the query.
+func (u *AccessLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccessLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccessLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccessLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/accesslog"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// AccessLogUpdate is the builder for updating AccessLog entities.
+type AccessLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessLogMutation
+}
+
+// Where appends a list predicates to the AccessLogUpdate builder.
+func (_u *AccessLogUpdate) Where(ps ...predicate.AccessLog) *AccessLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *AccessLogUpdate) SetWorkspaceID(v uuid.UUID) *AccessLogUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *AccessLogUpdate) SetNillableWorkspaceID(v *uuid.UUID) *AccessLogUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *AccessLogUpdate) SetSubject(v string) *AccessLogUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *AccessLogUpdate) SetNillableSubject(v *string) *AccessLogUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *AccessLogUpdate) SetMethod(v string) *AccessLogUpdate {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *AccessLogUpdate) SetNillableMethod(v *string) *AccessLogUpdate {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *AccessLogUpdate) SetRole(v string) *AccessLogUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *AccessLogUpdate) SetNillableRole(v *string) *AccessLogUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_u *AccessLogUpdate) SetEndpoint(v string) *AccessLogUpdate {
+	_u.mutation.SetEndpoint(v)
+	return _u
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (_u *AccessLogUpdate) SetNillableEndpoint(v *string) *AccessLogUpdate {
+	if v != nil {
+		_u.SetEndpoint(*v)
+	}
+	return _u
+}
+
+// SetQuery sets the "query" field.
+func (_u *AccessLogUpdate) SetQuery(v string) *AccessLogUpdate {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *AccessLogUpdate) SetNillableQuery(v *string) *AccessLogUpdate {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (_u *AccessLogUpdate) SetChatIds(v []string) *AccessLogUpdate {
+	_u.mutation.SetChatIds(v)
+	return _u
+}
+
+// AppendChatIds appends value to the "chat_ids" field.
+func (_u *AccessLogUpdate) AppendChatIds(v []string) *AccessLogUpdate {
+	_u.mutation.AppendChatIds(v)
+	return _u
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (_u *AccessLogUpdate) SetMessageIds(v []uuid.UUID) *AccessLogUpdate {
+	_u.mutation.SetMessageIds(v)
+	return _u
+}
+
+// AppendMessageIds appends value to the "message_ids" field.
+func (_u *AccessLogUpdate) AppendMessageIds(v []uuid.UUID) *AccessLogUpdate {
+	_u.mutation.AppendMessageIds(v)
+	return _u
+}
+
+// Mutation returns the AccessLogMutation object of the builder.
+func (_u *AccessLogUpdate) Mutation() *AccessLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccessLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccessLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccessLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccessLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AccessLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accesslog.Table, accesslog.Columns, sqlgraph.NewFieldSpec(accesslog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(accesslog.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(accesslog.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(accesslog.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(accesslog.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Endpoint(); ok {
+		_spec.SetField(accesslog.FieldEndpoint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(accesslog.FieldQuery, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChatIds(); ok {
+		_spec.SetField(accesslog.FieldChatIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChatIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesslog.FieldChatIds, value)
+		})
+	}
+	if value, ok := _u.mutation.MessageIds(); ok {
+		_spec.SetField(accesslog.FieldMessageIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMessageIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesslog.FieldMessageIds, value)
+		})
+	}
+	_spec.Node.Schema = _u.schemaConfig.AccessLog
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesslog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccessLogUpdateOne is the builder for updating a single AccessLog entity.
+type AccessLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessLogMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *AccessLogUpdateOne) SetWorkspaceID(v uuid.UUID) *AccessLogUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *AccessLogUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *AccessLogUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *AccessLogUpdateOne) SetSubject(v string) *AccessLogUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *AccessLogUpdateOne) SetNillableSubject(v *string) *AccessLogUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *AccessLogUpdateOne) SetMethod(v string) *AccessLogUpdateOne {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *AccessLogUpdateOne) SetNillableMethod(v *string) *AccessLogUpdateOne {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *AccessLogUpdateOne) SetRole(v string) *AccessLogUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *AccessLogUpdateOne) SetNillableRole(v *string) *AccessLogUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_u *AccessLogUpdateOne) SetEndpoint(v string) *AccessLogUpdateOne {
+	_u.mutation.SetEndpoint(v)
+	return _u
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (_u *AccessLogUpdateOne) SetNillableEndpoint(v *string) *AccessLogUpdateOne {
+	if v != nil {
+		_u.SetEndpoint(*v)
+	}
+	return _u
+}
+
+// SetQuery sets the "query" field.
+func (_u *AccessLogUpdateOne) SetQuery(v string) *AccessLogUpdateOne {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *AccessLogUpdateOne) SetNillableQuery(v *string) *AccessLogUpdateOne {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (_u *AccessLogUpdateOne) SetChatIds(v []string) *AccessLogUpdateOne {
+	_u.mutation.SetChatIds(v)
+	return _u
+}
+
+// AppendChatIds appends value to the "chat_ids" field.
+func (_u *AccessLogUpdateOne) AppendChatIds(v []string) *AccessLogUpdateOne {
+	_u.mutation.AppendChatIds(v)
+	return _u
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (_u *AccessLogUpdateOne) SetMessageIds(v []uuid.UUID) *AccessLogUpdateOne {
+	_u.mutation.SetMessageIds(v)
+	return _u
+}
+
+// AppendMessageIds appends value to the "message_ids" field.
+func (_u *AccessLogUpdateOne) AppendMessageIds(v []uuid.UUID) *AccessLogUpdateOne {
+	_u.mutation.AppendMessageIds(v)
+	return _u
+}
+
+// Mutation returns the AccessLogMutation object of the builder.
+func (_u *AccessLogUpdateOne) Mutation() *AccessLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AccessLogUpdate builder.
+func (_u *AccessLogUpdateOne) Where(ps ...predicate.AccessLog) *AccessLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccessLogUpdateOne) Select(field string, fields ...string) *AccessLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccessLog entity.
+func (_u *AccessLogUpdateOne) Save(ctx context.Context) (*AccessLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccessLogUpdateOne) SaveX(ctx context.Context) *AccessLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccessLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccessLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AccessLogUpdateOne) sqlSave(ctx context.Context) (_node *AccessLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accesslog.Table, accesslog.Columns, sqlgraph.NewFieldSpec(accesslog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accesslog.FieldID)
+		for _, f := range fields {
+			if !accesslog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accesslog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(accesslog.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(accesslog.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(accesslog.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(accesslog.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Endpoint(); ok {
+		_spec.SetField(accesslog.FieldEndpoint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(accesslog.FieldQuery, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChatIds(); ok {
+		_spec.SetField(accesslog.FieldChatIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChatIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesslog.FieldChatIds, value)
+		})
+	}
+	if value, ok := _u.mutation.MessageIds(); ok {
+		_spec.SetField(accesslog.FieldMessageIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMessageIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesslog.FieldMessageIds, value)
+		})
+	}
+	_spec.Node.Schema = _u.schemaConfig.AccessLog
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &AccessLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesslog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/askturn"
+)
+
+// AskTurn is the model entity for the AskTurn schema.
+type AskTurn struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID uuid.UUID `json:"conversation_id,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// Question holds the value of the "question" field.
+	Question string `json:"question,omitempty"`
+	// StandaloneQuestion holds the value of the "standalone_question" field.
+	StandaloneQuestion string `json:"standalone_question,omitempty"`
+	// Answer holds the value of the "answer" field.
+	Answer string `json:"answer,omitempty"`
+	// Refused holds the value of the "refused" field.
+	Refused bool `json:"refused,omitempty"`
+	// CitedMessageIds holds the value of the "cited_message_ids" field.
+	CitedMessageIds []uuid.UUID `json:"cited_message_ids,omitempty"`
+	// EventIds holds the value of the "event_ids" field.
+	EventIds []uuid.UUID `json:"event_ids,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AskTurn) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case askturn.FieldCitedMessageIds, askturn.FieldEventIds:
+			values[i] = new([]byte)
+		case askturn.FieldRefused:
+			values[i] = new(sql.NullBool)
+		case askturn.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case askturn.FieldSubject, askturn.FieldQuestion, askturn.FieldStandaloneQuestion, askturn.FieldAnswer, askturn.FieldModel:
+			values[i] = new(sql.NullString)
+		case askturn.FieldID, askturn.FieldWorkspaceID, askturn.FieldConversationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AskTurn fields.
+func (_m *AskTurn) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case askturn.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case askturn.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
+		case askturn.FieldConversationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value != nil {
+				_m.ConversationID = *value
+			}
+		case askturn.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case askturn.FieldQuestion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question", values[i])
+			} else if value.Valid {
+				_m.Question = value.String
+			}
+		case askturn.FieldStandaloneQuestion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field standalone_question", values[i])
+			} else if value.Valid {
+				_m.StandaloneQuestion = value.String
+			}
+		case askturn.FieldAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer", values[i])
+			} else if value.Valid {
+				_m.Answer = value.String
+			}
+		case askturn.FieldRefused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field refused", values[i])
+			} else if value.Valid {
+				_m.Refused = value.Bool
+			}
+		case askturn.FieldCitedMessageIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cited_message_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CitedMessageIds); err != nil {
+					return fmt.Errorf("unmarshal field cited_message_ids: %w", err)
+				}
+			}
+		case askturn.FieldEventIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EventIds); err != nil {
+					return fmt.Errorf("unmarshal field event_ids: %w", err)
+				}
+			}
+		case askturn.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case askturn.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AskTurn.
+// This includes values selected through modifiers, order, etc.
+func (_m *AskTurn) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AskTurn.
+// Note that you need to call AskTurn.Unwrap() before calling this method if this AskTurn
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AskTurn) Update() *AskTurnUpdateOne {
+	return NewAskTurnClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AskTurn entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AskTurn) Unwrap() *AskTurn {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AskTurn is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AskTurn) String() string {
+	var builder strings.Builder
+	builder.WriteString("AskTurn(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConversationID))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("question=")
+	builder.WriteString(_m.Question)
+	builder.WriteString(", ")
+	builder.WriteString("standalone_question=")
+	builder.WriteString(_m.StandaloneQuestion)
+	builder.WriteString(", ")
+	builder.WriteString("answer=")
+	builder.WriteString(_m.Answer)
+	builder.WriteString(", ")
+	builder.WriteString("refused=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Refused))
+	builder.WriteString(", ")
+	builder.WriteString("cited_message_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CitedMessageIds))
+	builder.WriteString(", ")
+	builder.WriteString("event_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventIds))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AskTurns is a parsable slice of AskTurn.
+type AskTurns []*AskTurn
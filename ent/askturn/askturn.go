@@ -0,0 +1,143 @@
+// Code generated by ent, DO NOT EDIT.
+
+package askturn
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the askturn type in the database.
+	Label = "ask_turn"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldConversationID holds the string denoting the conversation_id field in the database.
+	FieldConversationID = "conversation_id"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldQuestion holds the string denoting the question field in the database.
+	FieldQuestion = "question"
+	// FieldStandaloneQuestion holds the string denoting the standalone_question field in the database.
+	FieldStandaloneQuestion = "standalone_question"
+	// FieldAnswer holds the string denoting the answer field in the database.
+	FieldAnswer = "answer"
+	// FieldRefused holds the string denoting the refused field in the database.
+	FieldRefused = "refused"
+	// FieldCitedMessageIds holds the string denoting the cited_message_ids field in the database.
+	FieldCitedMessageIds = "cited_message_ids"
+	// FieldEventIds holds the string denoting the event_ids field in the database.
+	FieldEventIds = "event_ids"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the askturn in the database.
+	Table = "ask_turns"
+)
+
+// Columns holds all SQL columns for askturn fields.
+var Columns = []string{
+	FieldID,
+	FieldWorkspaceID,
+	FieldConversationID,
+	FieldSubject,
+	FieldQuestion,
+	FieldStandaloneQuestion,
+	FieldAnswer,
+	FieldRefused,
+	FieldCitedMessageIds,
+	FieldEventIds,
+	FieldModel,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultWorkspaceID holds the default value on creation for the "workspace_id" field.
+	DefaultWorkspaceID func() uuid.UUID
+	// DefaultSubject holds the default value on creation for the "subject" field.
+	DefaultSubject string
+	// DefaultQuestion holds the default value on creation for the "question" field.
+	DefaultQuestion string
+	// DefaultStandaloneQuestion holds the default value on creation for the "standalone_question" field.
+	DefaultStandaloneQuestion string
+	// DefaultAnswer holds the default value on creation for the "answer" field.
+	DefaultAnswer string
+	// DefaultRefused holds the default value on creation for the "refused" field.
+	DefaultRefused bool
+	// DefaultCitedMessageIds holds the default value on creation for the "cited_message_ids" field.
+	DefaultCitedMessageIds []uuid.UUID
+	// DefaultEventIds holds the default value on creation for the "event_ids" field.
+	DefaultEventIds []uuid.UUID
+	// DefaultModel holds the default value on creation for the "model" field.
+	DefaultModel string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the AskTurn queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByConversationID orders the results by the conversation_id field.
+func ByConversationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConversationID, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByQuestion orders the results by the question field.
+func ByQuestion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestion, opts...).ToFunc()
+}
+
+// ByStandaloneQuestion orders the results by the standalone_question field.
+func ByStandaloneQuestion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStandaloneQuestion, opts...).ToFunc()
+}
+
+// ByAnswer orders the results by the answer field.
+func ByAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswer, opts...).ToFunc()
+}
+
+// ByRefused orders the results by the refused field.
+func ByRefused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefused, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
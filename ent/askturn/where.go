@@ -0,0 +1,569 @@
+// Code generated by ent, DO NOT EDIT.
+
+package askturn
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// ConversationID applies equality check predicate on the "conversation_id" field. It's identical to ConversationIDEQ.
+func ConversationID(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldConversationID, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldSubject, v))
+}
+
+// Question applies equality check predicate on the "question" field. It's identical to QuestionEQ.
+func Question(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldQuestion, v))
+}
+
+// StandaloneQuestion applies equality check predicate on the "standalone_question" field. It's identical to StandaloneQuestionEQ.
+func StandaloneQuestion(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldStandaloneQuestion, v))
+}
+
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldAnswer, v))
+}
+
+// Refused applies equality check predicate on the "refused" field. It's identical to RefusedEQ.
+func Refused(v bool) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldRefused, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldModel, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
+// ConversationIDEQ applies the EQ predicate on the "conversation_id" field.
+func ConversationIDEQ(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ConversationIDNEQ applies the NEQ predicate on the "conversation_id" field.
+func ConversationIDNEQ(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNEQ(FieldConversationID, v))
+}
+
+// ConversationIDIn applies the In predicate on the "conversation_id" field.
+func ConversationIDIn(vs ...uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldIn(FieldConversationID, vs...))
+}
+
+// ConversationIDNotIn applies the NotIn predicate on the "conversation_id" field.
+func ConversationIDNotIn(vs ...uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNotIn(FieldConversationID, vs...))
+}
+
+// ConversationIDGT applies the GT predicate on the "conversation_id" field.
+func ConversationIDGT(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGT(FieldConversationID, v))
+}
+
+// ConversationIDGTE applies the GTE predicate on the "conversation_id" field.
+func ConversationIDGTE(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGTE(FieldConversationID, v))
+}
+
+// ConversationIDLT applies the LT predicate on the "conversation_id" field.
+func ConversationIDLT(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLT(FieldConversationID, v))
+}
+
+// ConversationIDLTE applies the LTE predicate on the "conversation_id" field.
+func ConversationIDLTE(v uuid.UUID) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLTE(FieldConversationID, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// QuestionEQ applies the EQ predicate on the "question" field.
+func QuestionEQ(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldQuestion, v))
+}
+
+// QuestionNEQ applies the NEQ predicate on the "question" field.
+func QuestionNEQ(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNEQ(FieldQuestion, v))
+}
+
+// QuestionIn applies the In predicate on the "question" field.
+func QuestionIn(vs ...string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldIn(FieldQuestion, vs...))
+}
+
+// QuestionNotIn applies the NotIn predicate on the "question" field.
+func QuestionNotIn(vs ...string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNotIn(FieldQuestion, vs...))
+}
+
+// QuestionGT applies the GT predicate on the "question" field.
+func QuestionGT(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGT(FieldQuestion, v))
+}
+
+// QuestionGTE applies the GTE predicate on the "question" field.
+func QuestionGTE(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGTE(FieldQuestion, v))
+}
+
+// QuestionLT applies the LT predicate on the "question" field.
+func QuestionLT(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLT(FieldQuestion, v))
+}
+
+// QuestionLTE applies the LTE predicate on the "question" field.
+func QuestionLTE(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLTE(FieldQuestion, v))
+}
+
+// QuestionContains applies the Contains predicate on the "question" field.
+func QuestionContains(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldContains(FieldQuestion, v))
+}
+
+// QuestionHasPrefix applies the HasPrefix predicate on the "question" field.
+func QuestionHasPrefix(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldHasPrefix(FieldQuestion, v))
+}
+
+// QuestionHasSuffix applies the HasSuffix predicate on the "question" field.
+func QuestionHasSuffix(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldHasSuffix(FieldQuestion, v))
+}
+
+// QuestionEqualFold applies the EqualFold predicate on the "question" field.
+func QuestionEqualFold(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEqualFold(FieldQuestion, v))
+}
+
+// QuestionContainsFold applies the ContainsFold predicate on the "question" field.
+func QuestionContainsFold(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldContainsFold(FieldQuestion, v))
+}
+
+// StandaloneQuestionEQ applies the EQ predicate on the "standalone_question" field.
+func StandaloneQuestionEQ(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldStandaloneQuestion, v))
+}
+
+// StandaloneQuestionNEQ applies the NEQ predicate on the "standalone_question" field.
+func StandaloneQuestionNEQ(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNEQ(FieldStandaloneQuestion, v))
+}
+
+// StandaloneQuestionIn applies the In predicate on the "standalone_question" field.
+func StandaloneQuestionIn(vs ...string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldIn(FieldStandaloneQuestion, vs...))
+}
+
+// StandaloneQuestionNotIn applies the NotIn predicate on the "standalone_question" field.
+func StandaloneQuestionNotIn(vs ...string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNotIn(FieldStandaloneQuestion, vs...))
+}
+
+// StandaloneQuestionGT applies the GT predicate on the "standalone_question" field.
+func StandaloneQuestionGT(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGT(FieldStandaloneQuestion, v))
+}
+
+// StandaloneQuestionGTE applies the GTE predicate on the "standalone_question" field.
+func StandaloneQuestionGTE(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGTE(FieldStandaloneQuestion, v))
+}
+
+// StandaloneQuestionLT applies the LT predicate on the "standalone_question" field.
+func StandaloneQuestionLT(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLT(FieldStandaloneQuestion, v))
+}
+
+// StandaloneQuestionLTE applies the LTE predicate on the "standalone_question" field.
+func StandaloneQuestionLTE(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLTE(FieldStandaloneQuestion, v))
+}
+
+// StandaloneQuestionContains applies the Contains predicate on the "standalone_question" field.
+func StandaloneQuestionContains(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldContains(FieldStandaloneQuestion, v))
+}
+
+// StandaloneQuestionHasPrefix applies the HasPrefix predicate on the "standalone_question" field.
+func StandaloneQuestionHasPrefix(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldHasPrefix(FieldStandaloneQuestion, v))
+}
+
+// StandaloneQuestionHasSuffix applies the HasSuffix predicate on the "standalone_question" field.
+func StandaloneQuestionHasSuffix(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldHasSuffix(FieldStandaloneQuestion, v))
+}
+
+// StandaloneQuestionEqualFold applies the EqualFold predicate on the "standalone_question" field.
+func StandaloneQuestionEqualFold(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEqualFold(FieldStandaloneQuestion, v))
+}
+
+// StandaloneQuestionContainsFold applies the ContainsFold predicate on the "standalone_question" field.
+func StandaloneQuestionContainsFold(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldContainsFold(FieldStandaloneQuestion, v))
+}
+
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldContainsFold(FieldAnswer, v))
+}
+
+// RefusedEQ applies the EQ predicate on the "refused" field.
+func RefusedEQ(v bool) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldRefused, v))
+}
+
+// RefusedNEQ applies the NEQ predicate on the "refused" field.
+func RefusedNEQ(v bool) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNEQ(FieldRefused, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldContainsFold(FieldModel, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.AskTurn {
+	return predicate.AskTurn(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AskTurn) predicate.AskTurn {
+	return predicate.AskTurn(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AskTurn) predicate.AskTurn {
+	return predicate.AskTurn(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AskTurn) predicate.AskTurn {
+	return predicate.AskTurn(sql.NotPredicates(p))
+}
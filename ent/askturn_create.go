@@ -0,0 +1,1109 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/askturn"
+)
+
+// AskTurnCreate is the builder for creating a AskTurn entity.
+type AskTurnCreate struct {
+	config
+	mutation *AskTurnMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *AskTurnCreate) SetWorkspaceID(v uuid.UUID) *AskTurnCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *AskTurnCreate) SetNillableWorkspaceID(v *uuid.UUID) *AskTurnCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *AskTurnCreate) SetConversationID(v uuid.UUID) *AskTurnCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *AskTurnCreate) SetSubject(v string) *AskTurnCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_c *AskTurnCreate) SetNillableSubject(v *string) *AskTurnCreate {
+	if v != nil {
+		_c.SetSubject(*v)
+	}
+	return _c
+}
+
+// SetQuestion sets the "question" field.
+func (_c *AskTurnCreate) SetQuestion(v string) *AskTurnCreate {
+	_c.mutation.SetQuestion(v)
+	return _c
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_c *AskTurnCreate) SetNillableQuestion(v *string) *AskTurnCreate {
+	if v != nil {
+		_c.SetQuestion(*v)
+	}
+	return _c
+}
+
+// SetStandaloneQuestion sets the "standalone_question" field.
+func (_c *AskTurnCreate) SetStandaloneQuestion(v string) *AskTurnCreate {
+	_c.mutation.SetStandaloneQuestion(v)
+	return _c
+}
+
+// SetNillableStandaloneQuestion sets the "standalone_question" field if the given value is not nil.
+func (_c *AskTurnCreate) SetNillableStandaloneQuestion(v *string) *AskTurnCreate {
+	if v != nil {
+		_c.SetStandaloneQuestion(*v)
+	}
+	return _c
+}
+
+// SetAnswer sets the "answer" field.
+func (_c *AskTurnCreate) SetAnswer(v string) *AskTurnCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_c *AskTurnCreate) SetNillableAnswer(v *string) *AskTurnCreate {
+	if v != nil {
+		_c.SetAnswer(*v)
+	}
+	return _c
+}
+
+// SetRefused sets the "refused" field.
+func (_c *AskTurnCreate) SetRefused(v bool) *AskTurnCreate {
+	_c.mutation.SetRefused(v)
+	return _c
+}
+
+// SetNillableRefused sets the "refused" field if the given value is not nil.
+func (_c *AskTurnCreate) SetNillableRefused(v *bool) *AskTurnCreate {
+	if v != nil {
+		_c.SetRefused(*v)
+	}
+	return _c
+}
+
+// SetCitedMessageIds sets the "cited_message_ids" field.
+func (_c *AskTurnCreate) SetCitedMessageIds(v []uuid.UUID) *AskTurnCreate {
+	_c.mutation.SetCitedMessageIds(v)
+	return _c
+}
+
+// SetEventIds sets the "event_ids" field.
+func (_c *AskTurnCreate) SetEventIds(v []uuid.UUID) *AskTurnCreate {
+	_c.mutation.SetEventIds(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *AskTurnCreate) SetModel(v string) *AskTurnCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_c *AskTurnCreate) SetNillableModel(v *string) *AskTurnCreate {
+	if v != nil {
+		_c.SetModel(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AskTurnCreate) SetCreatedAt(v int64) *AskTurnCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AskTurnCreate) SetNillableCreatedAt(v *int64) *AskTurnCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AskTurnCreate) SetID(v uuid.UUID) *AskTurnCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AskTurnCreate) SetNillableID(v *uuid.UUID) *AskTurnCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AskTurnMutation object of the builder.
+func (_c *AskTurnCreate) Mutation() *AskTurnMutation {
+	return _c.mutation
+}
+
+// Save creates the AskTurn in the database.
+func (_c *AskTurnCreate) Save(ctx context.Context) (*AskTurn, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AskTurnCreate) SaveX(ctx context.Context) *AskTurn {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AskTurnCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AskTurnCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AskTurnCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := askturn.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		v := askturn.DefaultSubject
+		_c.mutation.SetSubject(v)
+	}
+	if _, ok := _c.mutation.Question(); !ok {
+		v := askturn.DefaultQuestion
+		_c.mutation.SetQuestion(v)
+	}
+	if _, ok := _c.mutation.StandaloneQuestion(); !ok {
+		v := askturn.DefaultStandaloneQuestion
+		_c.mutation.SetStandaloneQuestion(v)
+	}
+	if _, ok := _c.mutation.Answer(); !ok {
+		v := askturn.DefaultAnswer
+		_c.mutation.SetAnswer(v)
+	}
+	if _, ok := _c.mutation.Refused(); !ok {
+		v := askturn.DefaultRefused
+		_c.mutation.SetRefused(v)
+	}
+	if _, ok := _c.mutation.CitedMessageIds(); !ok {
+		v := askturn.DefaultCitedMessageIds
+		_c.mutation.SetCitedMessageIds(v)
+	}
+	if _, ok := _c.mutation.EventIds(); !ok {
+		v := askturn.DefaultEventIds
+		_c.mutation.SetEventIds(v)
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		v := askturn.DefaultModel
+		_c.mutation.SetModel(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := askturn.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := askturn.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AskTurnCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "AskTurn.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "AskTurn.conversation_id"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "AskTurn.subject"`)}
+	}
+	if _, ok := _c.mutation.Question(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required field "AskTurn.question"`)}
+	}
+	if _, ok := _c.mutation.StandaloneQuestion(); !ok {
+		return &ValidationError{Name: "standalone_question", err: errors.New(`ent: missing required field "AskTurn.standalone_question"`)}
+	}
+	if _, ok := _c.mutation.Answer(); !ok {
+		return &ValidationError{Name: "answer", err: errors.New(`ent: missing required field "AskTurn.answer"`)}
+	}
+	if _, ok := _c.mutation.Refused(); !ok {
+		return &ValidationError{Name: "refused", err: errors.New(`ent: missing required field "AskTurn.refused"`)}
+	}
+	if _, ok := _c.mutation.CitedMessageIds(); !ok {
+		return &ValidationError{Name: "cited_message_ids", err: errors.New(`ent: missing required field "AskTurn.cited_message_ids"`)}
+	}
+	if _, ok := _c.mutation.EventIds(); !ok {
+		return &ValidationError{Name: "event_ids", err: errors.New(`ent: missing required field "AskTurn.event_ids"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "AskTurn.model"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AskTurn.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AskTurnCreate) sqlSave(ctx context.Context) (*AskTurn, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AskTurnCreate) createSpec() (*AskTurn, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AskTurn{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(askturn.Table, sqlgraph.NewFieldSpec(askturn.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.AskTurn
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(askturn.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.ConversationID(); ok {
+		_spec.SetField(askturn.FieldConversationID, field.TypeUUID, value)
+		_node.ConversationID = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(askturn.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Question(); ok {
+		_spec.SetField(askturn.FieldQuestion, field.TypeString, value)
+		_node.Question = value
+	}
+	if value, ok := _c.mutation.StandaloneQuestion(); ok {
+		_spec.SetField(askturn.FieldStandaloneQuestion, field.TypeString, value)
+		_node.StandaloneQuestion = value
+	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(askturn.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	if value, ok := _c.mutation.Refused(); ok {
+		_spec.SetField(askturn.FieldRefused, field.TypeBool, value)
+		_node.Refused = value
+	}
+	if value, ok := _c.mutation.CitedMessageIds(); ok {
+		_spec.SetField(askturn.FieldCitedMessageIds, field.TypeJSON, value)
+		_node.CitedMessageIds = value
+	}
+	if value, ok := _c.mutation.EventIds(); ok {
+		_spec.SetField(askturn.FieldEventIds, field.TypeJSON, value)
+		_node.EventIds = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(askturn.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(askturn.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AskTurn.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AskTurnUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AskTurnCreate) OnConflict(opts ...sql.ConflictOption) *AskTurnUpsertOne {
+	_c.conflict = opts
+	return &AskTurnUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AskTurn.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AskTurnCreate) OnConflictColumns(columns ...string) *AskTurnUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AskTurnUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AskTurnUpsertOne is the builder for "upsert"-ing
+	//  one AskTurn node.
+	AskTurnUpsertOne struct {
+		create *AskTurnCreate
+	}
+
+	// AskTurnUpsert is the "OnConflict" setter.
+	AskTurnUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *AskTurnUpsert) SetWorkspaceID(v uuid.UUID) *AskTurnUpsert {
+	u.Set(askturn.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *AskTurnUpsert) UpdateWorkspaceID() *AskTurnUpsert {
+	u.SetExcluded(askturn.FieldWorkspaceID)
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *AskTurnUpsert) SetConversationID(v uuid.UUID) *AskTurnUpsert {
+	u.Set(askturn.FieldConversationID, v)
+	return u
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *AskTurnUpsert) UpdateConversationID() *AskTurnUpsert {
+	u.SetExcluded(askturn.FieldConversationID)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *AskTurnUpsert) SetSubject(v string) *AskTurnUpsert {
+	u.Set(askturn.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *AskTurnUpsert) UpdateSubject() *AskTurnUpsert {
+	u.SetExcluded(askturn.FieldSubject)
+	return u
+}
+
+// SetQuestion sets the "question" field.
+func (u *AskTurnUpsert) SetQuestion(v string) *AskTurnUpsert {
+	u.Set(askturn.FieldQuestion, v)
+	return u
+}
+
+// UpdateQuestion sets the "question" field to the value that was provided on create.
+func (u *AskTurnUpsert) UpdateQuestion() *AskTurnUpsert {
+	u.SetExcluded(askturn.FieldQuestion)
+	return u
+}
+
+// SetStandaloneQuestion sets the "standalone_question" field.
+func (u *AskTurnUpsert) SetStandaloneQuestion(v string) *AskTurnUpsert {
+	u.Set(askturn.FieldStandaloneQuestion, v)
+	return u
+}
+
+// UpdateStandaloneQuestion sets the "standalone_question" field to the value that was provided on create.
+func (u *AskTurnUpsert) UpdateStandaloneQuestion() *AskTurnUpsert {
+	u.SetExcluded(askturn.FieldStandaloneQuestion)
+	return u
+}
+
+// SetAnswer sets the "answer" field.
+func (u *AskTurnUpsert) SetAnswer(v string) *AskTurnUpsert {
+	u.Set(askturn.FieldAnswer, v)
+	return u
+}
+
+// UpdateAnswer sets the "answer" field to the value that was provided on create.
+func (u *AskTurnUpsert) UpdateAnswer() *AskTurnUpsert {
+	u.SetExcluded(askturn.FieldAnswer)
+	return u
+}
+
+// SetRefused sets the "refused" field.
+func (u *AskTurnUpsert) SetRefused(v bool) *AskTurnUpsert {
+	u.Set(askturn.FieldRefused, v)
+	return u
+}
+
+// UpdateRefused sets the "refused" field to the value that was provided on create.
+func (u *AskTurnUpsert) UpdateRefused() *AskTurnUpsert {
+	u.SetExcluded(askturn.FieldRefused)
+	return u
+}
+
+// SetCitedMessageIds sets the "cited_message_ids" field.
+func (u *AskTurnUpsert) SetCitedMessageIds(v []uuid.UUID) *AskTurnUpsert {
+	u.Set(askturn.FieldCitedMessageIds, v)
+	return u
+}
+
+// UpdateCitedMessageIds sets the "cited_message_ids" field to the value that was provided on create.
+func (u *AskTurnUpsert) UpdateCitedMessageIds() *AskTurnUpsert {
+	u.SetExcluded(askturn.FieldCitedMessageIds)
+	return u
+}
+
+// SetEventIds sets the "event_ids" field.
+func (u *AskTurnUpsert) SetEventIds(v []uuid.UUID) *AskTurnUpsert {
+	u.Set(askturn.FieldEventIds, v)
+	return u
+}
+
+// UpdateEventIds sets the "event_ids" field to the value that was provided on create.
+func (u *AskTurnUpsert) UpdateEventIds() *AskTurnUpsert {
+	u.SetExcluded(askturn.FieldEventIds)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *AskTurnUpsert) SetModel(v string) *AskTurnUpsert {
+	u.Set(askturn.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *AskTurnUpsert) UpdateModel() *AskTurnUpsert {
+	u.SetExcluded(askturn.FieldModel)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AskTurn.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(askturn.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AskTurnUpsertOne) UpdateNewValues() *AskTurnUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(askturn.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(askturn.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AskTurn.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AskTurnUpsertOne) Ignore() *AskTurnUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AskTurnUpsertOne) DoNothing() *AskTurnUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AskTurnCreate.OnConflict
+// documentation for more info.
+func (u *AskTurnUpsertOne) Update(set func(*AskTurnUpsert)) *AskTurnUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AskTurnUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *AskTurnUpsertOne) SetWorkspaceID(v uuid.UUID) *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *AskTurnUpsertOne) UpdateWorkspaceID() *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *AskTurnUpsertOne) SetConversationID(v uuid.UUID) *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *AskTurnUpsertOne) UpdateConversationID() *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *AskTurnUpsertOne) SetSubject(v string) *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *AskTurnUpsertOne) UpdateSubject() *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetQuestion sets the "question" field.
+func (u *AskTurnUpsertOne) SetQuestion(v string) *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetQuestion(v)
+	})
+}
+
+// UpdateQuestion sets the "question" field to the value that was provided on create.
+func (u *AskTurnUpsertOne) UpdateQuestion() *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateQuestion()
+	})
+}
+
+// SetStandaloneQuestion sets the "standalone_question" field.
+func (u *AskTurnUpsertOne) SetStandaloneQuestion(v string) *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetStandaloneQuestion(v)
+	})
+}
+
+// UpdateStandaloneQuestion sets the "standalone_question" field to the value that was provided on create.
+func (u *AskTurnUpsertOne) UpdateStandaloneQuestion() *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateStandaloneQuestion()
+	})
+}
+
+// SetAnswer sets the "answer" field.
+func (u *AskTurnUpsertOne) SetAnswer(v string) *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetAnswer(v)
+	})
+}
+
+// UpdateAnswer sets the "answer" field to the value that was provided on create.
+func (u *AskTurnUpsertOne) UpdateAnswer() *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateAnswer()
+	})
+}
+
+// SetRefused sets the "refused" field.
+func (u *AskTurnUpsertOne) SetRefused(v bool) *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetRefused(v)
+	})
+}
+
+// UpdateRefused sets the "refused" field to the value that was provided on create.
+func (u *AskTurnUpsertOne) UpdateRefused() *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateRefused()
+	})
+}
+
+// SetCitedMessageIds sets the "cited_message_ids" field.
+func (u *AskTurnUpsertOne) SetCitedMessageIds(v []uuid.UUID) *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetCitedMessageIds(v)
+	})
+}
+
+// UpdateCitedMessageIds sets the "cited_message_ids" field to the value that was provided on create.
+func (u *AskTurnUpsertOne) UpdateCitedMessageIds() *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateCitedMessageIds()
+	})
+}
+
+// SetEventIds sets the "event_ids" field.
+func (u *AskTurnUpsertOne) SetEventIds(v []uuid.UUID) *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetEventIds(v)
+	})
+}
+
+// UpdateEventIds sets the "event_ids" field to the value that was provided on create.
+func (u *AskTurnUpsertOne) UpdateEventIds() *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateEventIds()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *AskTurnUpsertOne) SetModel(v string) *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *AskTurnUpsertOne) UpdateModel() *AskTurnUpsertOne {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// Exec executes the query.
+func (u *AskTurnUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AskTurnCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AskTurnUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AskTurnUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AskTurnUpsertOne.ID is not supported by MySQL driver. Use AskTurnUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AskTurnUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AskTurnCreateBulk is the builder for creating many AskTurn entities in bulk.
+type AskTurnCreateBulk struct {
+	config
+	err      error
+	builders []*AskTurnCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AskTurn entities in the database.
+func (_c *AskTurnCreateBulk) Save(ctx context.Context) ([]*AskTurn, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AskTurn, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AskTurnMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AskTurnCreateBulk) SaveX(ctx context.Context) []*AskTurn {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AskTurnCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AskTurnCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AskTurn.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AskTurnUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AskTurnCreateBulk) OnConflict(opts ...sql.ConflictOption) *AskTurnUpsertBulk {
+	_c.conflict = opts
+	return &AskTurnUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AskTurn.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AskTurnCreateBulk) OnConflictColumns(columns ...string) *AskTurnUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AskTurnUpsertBulk{
+		create: _c,
+	}
+}
+
+// AskTurnUpsertBulk is the builder for "upsert"-ing
+// a bulk of AskTurn nodes.
+type AskTurnUpsertBulk struct {
+	create *AskTurnCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AskTurn.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(askturn.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AskTurnUpsertBulk) UpdateNewValues() *AskTurnUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(askturn.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(askturn.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AskTurn.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AskTurnUpsertBulk) Ignore() *AskTurnUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AskTurnUpsertBulk) DoNothing() *AskTurnUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AskTurnCreateBulk.OnConflict
+// documentation for more info.
+func (u *AskTurnUpsertBulk) Update(set func(*AskTurnUpsert)) *AskTurnUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AskTurnUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *AskTurnUpsertBulk) SetWorkspaceID(v uuid.UUID) *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *AskTurnUpsertBulk) UpdateWorkspaceID() *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *AskTurnUpsertBulk) SetConversationID(v uuid.UUID) *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *AskTurnUpsertBulk) UpdateConversationID() *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *AskTurnUpsertBulk) SetSubject(v string) *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *AskTurnUpsertBulk) UpdateSubject() *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetQuestion sets the "question" field.
+func (u *AskTurnUpsertBulk) SetQuestion(v string) *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetQuestion(v)
+	})
+}
+
+// UpdateQuestion sets the "question" field to the value that was provided on create.
+func (u *AskTurnUpsertBulk) UpdateQuestion() *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateQuestion()
+	})
+}
+
+// SetStandaloneQuestion sets the "standalone_question" field.
+func (u *AskTurnUpsertBulk) SetStandaloneQuestion(v string) *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetStandaloneQuestion(v)
+	})
+}
+
+// UpdateStandaloneQuestion sets the "standalone_question" field to the value that was provided on create.
+func (u *AskTurnUpsertBulk) UpdateStandaloneQuestion() *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateStandaloneQuestion()
+	})
+}
+
+// SetAnswer sets the "answer" field.
+func (u *AskTurnUpsertBulk) SetAnswer(v string) *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetAnswer(v)
+	})
+}
+
+// UpdateAnswer sets the "answer" field to the value that was provided on create.
+func (u *AskTurnUpsertBulk) UpdateAnswer() *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateAnswer()
+	})
+}
+
+// SetRefused sets the "refused" field.
+func (u *AskTurnUpsertBulk) SetRefused(v bool) *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetRefused(v)
+	})
+}
+
+// UpdateRefused sets the "refused" field to the value that was provided on create.
+func (u *AskTurnUpsertBulk) UpdateRefused() *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateRefused()
+	})
+}
+
+// SetCitedMessageIds sets the "cited_message_ids" field.
+func (u *AskTurnUpsertBulk) SetCitedMessageIds(v []uuid.UUID) *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetCitedMessageIds(v)
+	})
+}
+
+// UpdateCitedMessageIds sets the "cited_message_ids" field to the value that was provided on create.
+func (u *AskTurnUpsertBulk) UpdateCitedMessageIds() *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateCitedMessageIds()
+	})
+}
+
+// SetEventIds sets the "event_ids" field.
+func (u *AskTurnUpsertBulk) SetEventIds(v []uuid.UUID) *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetEventIds(v)
+	})
+}
+
+// UpdateEventIds sets the "event_ids" field to the value that was provided on create.
+func (u *AskTurnUpsertBulk) UpdateEventIds() *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateEventIds()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *AskTurnUpsertBulk) SetModel(v string) *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *AskTurnUpsertBulk) UpdateModel() *AskTurnUpsertBulk {
+	return u.Update(func(s *AskTurnUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// Exec executes the query.
+func (u *AskTurnUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AskTurnCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AskTurnCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AskTurnUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/askturn"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// AskTurnUpdate is the builder for updating AskTurn entities.
+type AskTurnUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AskTurnMutation
+}
+
+// Where appends a list predicates to the AskTurnUpdate builder.
+func (_u *AskTurnUpdate) Where(ps ...predicate.AskTurn) *AskTurnUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *AskTurnUpdate) SetWorkspaceID(v uuid.UUID) *AskTurnUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *AskTurnUpdate) SetNillableWorkspaceID(v *uuid.UUID) *AskTurnUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *AskTurnUpdate) SetConversationID(v uuid.UUID) *AskTurnUpdate {
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *AskTurnUpdate) SetNillableConversationID(v *uuid.UUID) *AskTurnUpdate {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *AskTurnUpdate) SetSubject(v string) *AskTurnUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *AskTurnUpdate) SetNillableSubject(v *string) *AskTurnUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetQuestion sets the "question" field.
+func (_u *AskTurnUpdate) SetQuestion(v string) *AskTurnUpdate {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *AskTurnUpdate) SetNillableQuestion(v *string) *AskTurnUpdate {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetStandaloneQuestion sets the "standalone_question" field.
+func (_u *AskTurnUpdate) SetStandaloneQuestion(v string) *AskTurnUpdate {
+	_u.mutation.SetStandaloneQuestion(v)
+	return _u
+}
+
+// SetNillableStandaloneQuestion sets the "standalone_question" field if the given value is not nil.
+func (_u *AskTurnUpdate) SetNillableStandaloneQuestion(v *string) *AskTurnUpdate {
+	if v != nil {
+		_u.SetStandaloneQuestion(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *AskTurnUpdate) SetAnswer(v string) *AskTurnUpdate {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *AskTurnUpdate) SetNillableAnswer(v *string) *AskTurnUpdate {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetRefused sets the "refused" field.
+func (_u *AskTurnUpdate) SetRefused(v bool) *AskTurnUpdate {
+	_u.mutation.SetRefused(v)
+	return _u
+}
+
+// SetNillableRefused sets the "refused" field if the given value is not nil.
+func (_u *AskTurnUpdate) SetNillableRefused(v *bool) *AskTurnUpdate {
+	if v != nil {
+		_u.SetRefused(*v)
+	}
+	return _u
+}
+
+// SetCitedMessageIds sets the "cited_message_ids" field.
+func (_u *AskTurnUpdate) SetCitedMessageIds(v []uuid.UUID) *AskTurnUpdate {
+	_u.mutation.SetCitedMessageIds(v)
+	return _u
+}
+
+// AppendCitedMessageIds appends value to the "cited_message_ids" field.
+func (_u *AskTurnUpdate) AppendCitedMessageIds(v []uuid.UUID) *AskTurnUpdate {
+	_u.mutation.AppendCitedMessageIds(v)
+	return _u
+}
+
+// SetEventIds sets the "event_ids" field.
+func (_u *AskTurnUpdate) SetEventIds(v []uuid.UUID) *AskTurnUpdate {
+	_u.mutation.SetEventIds(v)
+	return _u
+}
+
+// AppendEventIds appends value to the "event_ids" field.
+func (_u *AskTurnUpdate) AppendEventIds(v []uuid.UUID) *AskTurnUpdate {
+	_u.mutation.AppendEventIds(v)
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *AskTurnUpdate) SetModel(v string) *AskTurnUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *AskTurnUpdate) SetNillableModel(v *string) *AskTurnUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AskTurnMutation object of the builder.
+func (_u *AskTurnUpdate) Mutation() *AskTurnMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AskTurnUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AskTurnUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AskTurnUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AskTurnUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AskTurnUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(askturn.Table, askturn.Columns, sqlgraph.NewFieldSpec(askturn.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(askturn.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ConversationID(); ok {
+		_spec.SetField(askturn.FieldConversationID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(askturn.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Question(); ok {
+		_spec.SetField(askturn.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StandaloneQuestion(); ok {
+		_spec.SetField(askturn.FieldStandaloneQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(askturn.FieldAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Refused(); ok {
+		_spec.SetField(askturn.FieldRefused, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CitedMessageIds(); ok {
+		_spec.SetField(askturn.FieldCitedMessageIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCitedMessageIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, askturn.FieldCitedMessageIds, value)
+		})
+	}
+	if value, ok := _u.mutation.EventIds(); ok {
+		_spec.SetField(askturn.FieldEventIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEventIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, askturn.FieldEventIds, value)
+		})
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(askturn.FieldModel, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.AskTurn
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{askturn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AskTurnUpdateOne is the builder for updating a single AskTurn entity.
+type AskTurnUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AskTurnMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *AskTurnUpdateOne) SetWorkspaceID(v uuid.UUID) *AskTurnUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *AskTurnUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *AskTurnUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *AskTurnUpdateOne) SetConversationID(v uuid.UUID) *AskTurnUpdateOne {
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *AskTurnUpdateOne) SetNillableConversationID(v *uuid.UUID) *AskTurnUpdateOne {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *AskTurnUpdateOne) SetSubject(v string) *AskTurnUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *AskTurnUpdateOne) SetNillableSubject(v *string) *AskTurnUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetQuestion sets the "question" field.
+func (_u *AskTurnUpdateOne) SetQuestion(v string) *AskTurnUpdateOne {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *AskTurnUpdateOne) SetNillableQuestion(v *string) *AskTurnUpdateOne {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetStandaloneQuestion sets the "standalone_question" field.
+func (_u *AskTurnUpdateOne) SetStandaloneQuestion(v string) *AskTurnUpdateOne {
+	_u.mutation.SetStandaloneQuestion(v)
+	return _u
+}
+
+// SetNillableStandaloneQuestion sets the "standalone_question" field if the given value is not nil.
+func (_u *AskTurnUpdateOne) SetNillableStandaloneQuestion(v *string) *AskTurnUpdateOne {
+	if v != nil {
+		_u.SetStandaloneQuestion(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *AskTurnUpdateOne) SetAnswer(v string) *AskTurnUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *AskTurnUpdateOne) SetNillableAnswer(v *string) *AskTurnUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetRefused sets the "refused" field.
+func (_u *AskTurnUpdateOne) SetRefused(v bool) *AskTurnUpdateOne {
+	_u.mutation.SetRefused(v)
+	return _u
+}
+
+// SetNillableRefused sets the "refused" field if the given value is not nil.
+func (_u *AskTurnUpdateOne) SetNillableRefused(v *bool) *AskTurnUpdateOne {
+	if v != nil {
+		_u.SetRefused(*v)
+	}
+	return _u
+}
+
+// SetCitedMessageIds sets the "cited_message_ids" field.
+func (_u *AskTurnUpdateOne) SetCitedMessageIds(v []uuid.UUID) *AskTurnUpdateOne {
+	_u.mutation.SetCitedMessageIds(v)
+	return _u
+}
+
+// AppendCitedMessageIds appends value to the "cited_message_ids" field.
+func (_u *AskTurnUpdateOne) AppendCitedMessageIds(v []uuid.UUID) *AskTurnUpdateOne {
+	_u.mutation.AppendCitedMessageIds(v)
+	return _u
+}
+
+// SetEventIds sets the "event_ids" field.
+func (_u *AskTurnUpdateOne) SetEventIds(v []uuid.UUID) *AskTurnUpdateOne {
+	_u.mutation.SetEventIds(v)
+	return _u
+}
+
+// AppendEventIds appends value to the "event_ids" field.
+func (_u *AskTurnUpdateOne) AppendEventIds(v []uuid.UUID) *AskTurnUpdateOne {
+	_u.mutation.AppendEventIds(v)
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *AskTurnUpdateOne) SetModel(v string) *AskTurnUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *AskTurnUpdateOne) SetNillableModel(v *string) *AskTurnUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AskTurnMutation object of the builder.
+func (_u *AskTurnUpdateOne) Mutation() *AskTurnMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AskTurnUpdate builder.
+func (_u *AskTurnUpdateOne) Where(ps ...predicate.AskTurn) *AskTurnUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AskTurnUpdateOne) Select(field string, fields ...string) *AskTurnUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AskTurn entity.
+func (_u *AskTurnUpdateOne) Save(ctx context.Context) (*AskTurn, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AskTurnUpdateOne) SaveX(ctx context.Context) *AskTurn {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AskTurnUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AskTurnUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AskTurnUpdateOne) sqlSave(ctx context.Context) (_node *AskTurn, err error) {
+	_spec := sqlgraph.NewUpdateSpec(askturn.Table, askturn.Columns, sqlgraph.NewFieldSpec(askturn.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AskTurn.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, askturn.FieldID)
+		for _, f := range fields {
+			if !askturn.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != askturn.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(askturn.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ConversationID(); ok {
+		_spec.SetField(askturn.FieldConversationID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(askturn.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Question(); ok {
+		_spec.SetField(askturn.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StandaloneQuestion(); ok {
+		_spec.SetField(askturn.FieldStandaloneQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(askturn.FieldAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Refused(); ok {
+		_spec.SetField(askturn.FieldRefused, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CitedMessageIds(); ok {
+		_spec.SetField(askturn.FieldCitedMessageIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCitedMessageIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, askturn.FieldCitedMessageIds, value)
+		})
+	}
+	if value, ok := _u.mutation.EventIds(); ok {
+		_spec.SetField(askturn.FieldEventIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEventIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, askturn.FieldEventIds, value)
+		})
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(askturn.FieldModel, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.AskTurn
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &AskTurn{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{askturn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chatgrant
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldSubject, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldChatID, v))
+}
+
+// GrantedBy applies equality check predicate on the "granted_by" field. It's identical to GrantedByEQ.
+func GrantedBy(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLTE(FieldChatID, v))
+}
+
+// ChatIDContains applies the Contains predicate on the "chat_id" field.
+func ChatIDContains(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldContains(FieldChatID, v))
+}
+
+// ChatIDHasPrefix applies the HasPrefix predicate on the "chat_id" field.
+func ChatIDHasPrefix(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldHasPrefix(FieldChatID, v))
+}
+
+// ChatIDHasSuffix applies the HasSuffix predicate on the "chat_id" field.
+func ChatIDHasSuffix(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldHasSuffix(FieldChatID, v))
+}
+
+// ChatIDEqualFold applies the EqualFold predicate on the "chat_id" field.
+func ChatIDEqualFold(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEqualFold(FieldChatID, v))
+}
+
+// ChatIDContainsFold applies the ContainsFold predicate on the "chat_id" field.
+func ChatIDContainsFold(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldContainsFold(FieldChatID, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// GrantedByEQ applies the EQ predicate on the "granted_by" field.
+func GrantedByEQ(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// GrantedByNEQ applies the NEQ predicate on the "granted_by" field.
+func GrantedByNEQ(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNEQ(FieldGrantedBy, v))
+}
+
+// GrantedByIn applies the In predicate on the "granted_by" field.
+func GrantedByIn(vs ...string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByNotIn applies the NotIn predicate on the "granted_by" field.
+func GrantedByNotIn(vs ...string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNotIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByGT applies the GT predicate on the "granted_by" field.
+func GrantedByGT(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGT(FieldGrantedBy, v))
+}
+
+// GrantedByGTE applies the GTE predicate on the "granted_by" field.
+func GrantedByGTE(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGTE(FieldGrantedBy, v))
+}
+
+// GrantedByLT applies the LT predicate on the "granted_by" field.
+func GrantedByLT(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLT(FieldGrantedBy, v))
+}
+
+// GrantedByLTE applies the LTE predicate on the "granted_by" field.
+func GrantedByLTE(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLTE(FieldGrantedBy, v))
+}
+
+// GrantedByContains applies the Contains predicate on the "granted_by" field.
+func GrantedByContains(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldContains(FieldGrantedBy, v))
+}
+
+// GrantedByHasPrefix applies the HasPrefix predicate on the "granted_by" field.
+func GrantedByHasPrefix(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldHasPrefix(FieldGrantedBy, v))
+}
+
+// GrantedByHasSuffix applies the HasSuffix predicate on the "granted_by" field.
+func GrantedByHasSuffix(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldHasSuffix(FieldGrantedBy, v))
+}
+
+// GrantedByEqualFold applies the EqualFold predicate on the "granted_by" field.
+func GrantedByEqualFold(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEqualFold(FieldGrantedBy, v))
+}
+
+// GrantedByContainsFold applies the ContainsFold predicate on the "granted_by" field.
+func GrantedByContainsFold(v string) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldContainsFold(FieldGrantedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChatGrant) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChatGrant) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChatGrant) predicate.ChatGrant {
+	return predicate.ChatGrant(sql.NotPredicates(p))
+}
@@ -0,0 +1,888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/chatgrant"
+)
+
+// ChatGrantCreate is the builder for creating a ChatGrant entity.
+type ChatGrantCreate struct {
+	config
+	mutation *ChatGrantMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *ChatGrantCreate) SetWorkspaceID(v uuid.UUID) *ChatGrantCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *ChatGrantCreate) SetNillableWorkspaceID(v *uuid.UUID) *ChatGrantCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *ChatGrantCreate) SetSubject(v string) *ChatGrantCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *ChatGrantCreate) SetChatID(v string) *ChatGrantCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *ChatGrantCreate) SetRole(v chatgrant.Role) *ChatGrantCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *ChatGrantCreate) SetNillableRole(v *chatgrant.Role) *ChatGrantCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_c *ChatGrantCreate) SetGrantedBy(v string) *ChatGrantCreate {
+	_c.mutation.SetGrantedBy(v)
+	return _c
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_c *ChatGrantCreate) SetNillableGrantedBy(v *string) *ChatGrantCreate {
+	if v != nil {
+		_c.SetGrantedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChatGrantCreate) SetCreatedAt(v int64) *ChatGrantCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChatGrantCreate) SetNillableCreatedAt(v *int64) *ChatGrantCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ChatGrantCreate) SetUpdatedAt(v int64) *ChatGrantCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ChatGrantCreate) SetNillableUpdatedAt(v *int64) *ChatGrantCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChatGrantCreate) SetID(v uuid.UUID) *ChatGrantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ChatGrantCreate) SetNillableID(v *uuid.UUID) *ChatGrantCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ChatGrantMutation object of the builder.
+func (_c *ChatGrantCreate) Mutation() *ChatGrantMutation {
+	return _c.mutation
+}
+
+// Save creates the ChatGrant in the database.
+func (_c *ChatGrantCreate) Save(ctx context.Context) (*ChatGrant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChatGrantCreate) SaveX(ctx context.Context) *ChatGrant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatGrantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatGrantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChatGrantCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := chatgrant.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		v := chatgrant.DefaultRole
+		_c.mutation.SetRole(v)
+	}
+	if _, ok := _c.mutation.GrantedBy(); !ok {
+		v := chatgrant.DefaultGrantedBy
+		_c.mutation.SetGrantedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := chatgrant.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := chatgrant.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := chatgrant.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChatGrantCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "ChatGrant.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "ChatGrant.subject"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := chatgrant.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "ChatGrant.subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "ChatGrant.chat_id"`)}
+	}
+	if v, ok := _c.mutation.ChatID(); ok {
+		if err := chatgrant.ChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`ent: validator failed for field "ChatGrant.chat_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "ChatGrant.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := chatgrant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ChatGrant.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GrantedBy(); !ok {
+		return &ValidationError{Name: "granted_by", err: errors.New(`ent: missing required field "ChatGrant.granted_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatGrant.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ChatGrant.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ChatGrantCreate) sqlSave(ctx context.Context) (*ChatGrant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChatGrantCreate) createSpec() (*ChatGrant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChatGrant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chatgrant.Table, sqlgraph.NewFieldSpec(chatgrant.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.ChatGrant
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatgrant.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(chatgrant.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(chatgrant.FieldChatID, field.TypeString, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(chatgrant.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.GrantedBy(); ok {
+		_spec.SetField(chatgrant.FieldGrantedBy, field.TypeString, value)
+		_node.GrantedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(chatgrant.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatgrant.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatGrant.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatGrantUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatGrantCreate) OnConflict(opts ...sql.ConflictOption) *ChatGrantUpsertOne {
+	_c.conflict = opts
+	return &ChatGrantUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatGrant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatGrantCreate) OnConflictColumns(columns ...string) *ChatGrantUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatGrantUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ChatGrantUpsertOne is the builder for "upsert"-ing
+	//  one ChatGrant node.
+	ChatGrantUpsertOne struct {
+		create *ChatGrantCreate
+	}
+
+	// ChatGrantUpsert is the "OnConflict" setter.
+	ChatGrantUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatGrantUpsert) SetWorkspaceID(v uuid.UUID) *ChatGrantUpsert {
+	u.Set(chatgrant.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatGrantUpsert) UpdateWorkspaceID() *ChatGrantUpsert {
+	u.SetExcluded(chatgrant.FieldWorkspaceID)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *ChatGrantUpsert) SetSubject(v string) *ChatGrantUpsert {
+	u.Set(chatgrant.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *ChatGrantUpsert) UpdateSubject() *ChatGrantUpsert {
+	u.SetExcluded(chatgrant.FieldSubject)
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatGrantUpsert) SetChatID(v string) *ChatGrantUpsert {
+	u.Set(chatgrant.FieldChatID, v)
+	return u
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatGrantUpsert) UpdateChatID() *ChatGrantUpsert {
+	u.SetExcluded(chatgrant.FieldChatID)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *ChatGrantUpsert) SetRole(v chatgrant.Role) *ChatGrantUpsert {
+	u.Set(chatgrant.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ChatGrantUpsert) UpdateRole() *ChatGrantUpsert {
+	u.SetExcluded(chatgrant.FieldRole)
+	return u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *ChatGrantUpsert) SetGrantedBy(v string) *ChatGrantUpsert {
+	u.Set(chatgrant.FieldGrantedBy, v)
+	return u
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *ChatGrantUpsert) UpdateGrantedBy() *ChatGrantUpsert {
+	u.SetExcluded(chatgrant.FieldGrantedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatGrantUpsert) SetUpdatedAt(v int64) *ChatGrantUpsert {
+	u.Set(chatgrant.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatGrantUpsert) UpdateUpdatedAt() *ChatGrantUpsert {
+	u.SetExcluded(chatgrant.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChatGrantUpsert) AddUpdatedAt(v int64) *ChatGrantUpsert {
+	u.Add(chatgrant.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ChatGrant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatgrant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatGrantUpsertOne) UpdateNewValues() *ChatGrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(chatgrant.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(chatgrant.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatGrant.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChatGrantUpsertOne) Ignore() *ChatGrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatGrantUpsertOne) DoNothing() *ChatGrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatGrantCreate.OnConflict
+// documentation for more info.
+func (u *ChatGrantUpsertOne) Update(set func(*ChatGrantUpsert)) *ChatGrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatGrantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatGrantUpsertOne) SetWorkspaceID(v uuid.UUID) *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatGrantUpsertOne) UpdateWorkspaceID() *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *ChatGrantUpsertOne) SetSubject(v string) *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *ChatGrantUpsertOne) UpdateSubject() *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatGrantUpsertOne) SetChatID(v string) *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatGrantUpsertOne) UpdateChatID() *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *ChatGrantUpsertOne) SetRole(v chatgrant.Role) *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ChatGrantUpsertOne) UpdateRole() *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *ChatGrantUpsertOne) SetGrantedBy(v string) *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetGrantedBy(v)
+	})
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *ChatGrantUpsertOne) UpdateGrantedBy() *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateGrantedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatGrantUpsertOne) SetUpdatedAt(v int64) *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChatGrantUpsertOne) AddUpdatedAt(v int64) *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatGrantUpsertOne) UpdateUpdatedAt() *ChatGrantUpsertOne {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatGrantUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatGrantCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatGrantUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChatGrantUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChatGrantUpsertOne.ID is not supported by MySQL driver. Use ChatGrantUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChatGrantUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChatGrantCreateBulk is the builder for creating many ChatGrant entities in bulk.
+type ChatGrantCreateBulk struct {
+	config
+	err      error
+	builders []*ChatGrantCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ChatGrant entities in the database.
+func (_c *ChatGrantCreateBulk) Save(ctx context.Context) ([]*ChatGrant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChatGrant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatGrantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChatGrantCreateBulk) SaveX(ctx context.Context) []*ChatGrant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatGrantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatGrantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatGrant.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatGrantUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatGrantCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChatGrantUpsertBulk {
+	_c.conflict = opts
+	return &ChatGrantUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatGrant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatGrantCreateBulk) OnConflictColumns(columns ...string) *ChatGrantUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatGrantUpsertBulk{
+		create: _c,
+	}
+}
+
+// ChatGrantUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChatGrant nodes.
+type ChatGrantUpsertBulk struct {
+	create *ChatGrantCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChatGrant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatgrant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatGrantUpsertBulk) UpdateNewValues() *ChatGrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(chatgrant.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(chatgrant.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatGrant.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChatGrantUpsertBulk) Ignore() *ChatGrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatGrantUpsertBulk) DoNothing() *ChatGrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatGrantCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChatGrantUpsertBulk) Update(set func(*ChatGrantUpsert)) *ChatGrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatGrantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatGrantUpsertBulk) SetWorkspaceID(v uuid.UUID) *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatGrantUpsertBulk) UpdateWorkspaceID() *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *ChatGrantUpsertBulk) SetSubject(v string) *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *ChatGrantUpsertBulk) UpdateSubject() *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatGrantUpsertBulk) SetChatID(v string) *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatGrantUpsertBulk) UpdateChatID() *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *ChatGrantUpsertBulk) SetRole(v chatgrant.Role) *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ChatGrantUpsertBulk) UpdateRole() *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *ChatGrantUpsertBulk) SetGrantedBy(v string) *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetGrantedBy(v)
+	})
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *ChatGrantUpsertBulk) UpdateGrantedBy() *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateGrantedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatGrantUpsertBulk) SetUpdatedAt(v int64) *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChatGrantUpsertBulk) AddUpdatedAt(v int64) *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatGrantUpsertBulk) UpdateUpdatedAt() *ChatGrantUpsertBulk {
+	return u.Update(func(s *ChatGrantUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatGrantUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChatGrantCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatGrantCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatGrantUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/chatgrant"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ChatGrantUpdate is the builder for updating ChatGrant entities.
+type ChatGrantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChatGrantMutation
+}
+
+// Where appends a list predicates to the ChatGrantUpdate builder.
+func (_u *ChatGrantUpdate) Where(ps ...predicate.ChatGrant) *ChatGrantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *ChatGrantUpdate) SetWorkspaceID(v uuid.UUID) *ChatGrantUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *ChatGrantUpdate) SetNillableWorkspaceID(v *uuid.UUID) *ChatGrantUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ChatGrantUpdate) SetSubject(v string) *ChatGrantUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ChatGrantUpdate) SetNillableSubject(v *string) *ChatGrantUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatGrantUpdate) SetChatID(v string) *ChatGrantUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatGrantUpdate) SetNillableChatID(v *string) *ChatGrantUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *ChatGrantUpdate) SetRole(v chatgrant.Role) *ChatGrantUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *ChatGrantUpdate) SetNillableRole(v *chatgrant.Role) *ChatGrantUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *ChatGrantUpdate) SetGrantedBy(v string) *ChatGrantUpdate {
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *ChatGrantUpdate) SetNillableGrantedBy(v *string) *ChatGrantUpdate {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatGrantUpdate) SetUpdatedAt(v int64) *ChatGrantUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *ChatGrantUpdate) AddUpdatedAt(v int64) *ChatGrantUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ChatGrantMutation object of the builder.
+func (_u *ChatGrantUpdate) Mutation() *ChatGrantMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatGrantUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatGrantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatGrantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatGrantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatGrantUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatgrant.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatGrantUpdate) check() error {
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := chatgrant.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "ChatGrant.subject": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChatID(); ok {
+		if err := chatgrant.ChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`ent: validator failed for field "ChatGrant.chat_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := chatgrant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ChatGrant.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChatGrantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatgrant.Table, chatgrant.Columns, sqlgraph.NewFieldSpec(chatgrant.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatgrant.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(chatgrant.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(chatgrant.FieldChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(chatgrant.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(chatgrant.FieldGrantedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatgrant.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(chatgrant.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.ChatGrant
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatgrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatGrantUpdateOne is the builder for updating a single ChatGrant entity.
+type ChatGrantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChatGrantMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *ChatGrantUpdateOne) SetWorkspaceID(v uuid.UUID) *ChatGrantUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *ChatGrantUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *ChatGrantUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ChatGrantUpdateOne) SetSubject(v string) *ChatGrantUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ChatGrantUpdateOne) SetNillableSubject(v *string) *ChatGrantUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatGrantUpdateOne) SetChatID(v string) *ChatGrantUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatGrantUpdateOne) SetNillableChatID(v *string) *ChatGrantUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *ChatGrantUpdateOne) SetRole(v chatgrant.Role) *ChatGrantUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *ChatGrantUpdateOne) SetNillableRole(v *chatgrant.Role) *ChatGrantUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *ChatGrantUpdateOne) SetGrantedBy(v string) *ChatGrantUpdateOne {
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *ChatGrantUpdateOne) SetNillableGrantedBy(v *string) *ChatGrantUpdateOne {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatGrantUpdateOne) SetUpdatedAt(v int64) *ChatGrantUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *ChatGrantUpdateOne) AddUpdatedAt(v int64) *ChatGrantUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ChatGrantMutation object of the builder.
+func (_u *ChatGrantUpdateOne) Mutation() *ChatGrantMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChatGrantUpdate builder.
+func (_u *ChatGrantUpdateOne) Where(ps ...predicate.ChatGrant) *ChatGrantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatGrantUpdateOne) Select(field string, fields ...string) *ChatGrantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChatGrant entity.
+func (_u *ChatGrantUpdateOne) Save(ctx context.Context) (*ChatGrant, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatGrantUpdateOne) SaveX(ctx context.Context) *ChatGrant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatGrantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatGrantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatGrantUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatgrant.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatGrantUpdateOne) check() error {
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := chatgrant.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "ChatGrant.subject": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChatID(); ok {
+		if err := chatgrant.ChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`ent: validator failed for field "ChatGrant.chat_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := chatgrant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ChatGrant.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChatGrantUpdateOne) sqlSave(ctx context.Context) (_node *ChatGrant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatgrant.Table, chatgrant.Columns, sqlgraph.NewFieldSpec(chatgrant.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatGrant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatgrant.FieldID)
+		for _, f := range fields {
+			if !chatgrant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatgrant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatgrant.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(chatgrant.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(chatgrant.FieldChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(chatgrant.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(chatgrant.FieldGrantedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatgrant.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(chatgrant.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.ChatGrant
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &ChatGrant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatgrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
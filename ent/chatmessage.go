@@ -19,6 +19,8 @@ type ChatMessage struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 	// Platform holds the value of the "platform" field.
 	Platform string `json:"platform,omitempty"`
 	// PlatformMessageID holds the value of the "platform_message_id" field.
@@ -53,6 +55,8 @@ type ChatMessage struct {
 	ContentVector768 *pgvector.Vector `json:"content_vector_768,omitempty"`
 	// JiebaTokens holds the value of the "jieba_tokens" field.
 	JiebaTokens []string `json:"jieba_tokens,omitempty"`
+	// TokenizedAt holds the value of the "tokenized_at" field.
+	TokenizedAt int64 `json:"tokenized_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt int64 `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -75,11 +79,11 @@ func (*ChatMessage) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case chatmessage.FieldIsReply:
 			values[i] = new(sql.NullBool)
-		case chatmessage.FieldPlatformTimestamp, chatmessage.FieldCreatedAt, chatmessage.FieldUpdatedAt, chatmessage.FieldDeletedAt:
+		case chatmessage.FieldPlatformTimestamp, chatmessage.FieldTokenizedAt, chatmessage.FieldCreatedAt, chatmessage.FieldUpdatedAt, chatmessage.FieldDeletedAt:
 			values[i] = new(sql.NullInt64)
 		case chatmessage.FieldPlatform, chatmessage.FieldPlatformMessageID, chatmessage.FieldFromID, chatmessage.FieldFromName, chatmessage.FieldInChatID, chatmessage.FieldInChatType, chatmessage.FieldContent, chatmessage.FieldReplyToName, chatmessage.FieldReplyToID:
 			values[i] = new(sql.NullString)
-		case chatmessage.FieldID:
+		case chatmessage.FieldID, chatmessage.FieldWorkspaceID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -102,6 +106,12 @@ func (_m *ChatMessage) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case chatmessage.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
 		case chatmessage.FieldPlatform:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field platform", values[i])
@@ -211,6 +221,12 @@ func (_m *ChatMessage) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field jieba_tokens: %w", err)
 				}
 			}
+		case chatmessage.FieldTokenizedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tokenized_at", values[i])
+			} else if value.Valid {
+				_m.TokenizedAt = value.Int64
+			}
 		case chatmessage.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -265,6 +281,9 @@ func (_m *ChatMessage) String() string {
 	var builder strings.Builder
 	builder.WriteString("ChatMessage(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
 	builder.WriteString("platform=")
 	builder.WriteString(_m.Platform)
 	builder.WriteString(", ")
@@ -326,6 +345,9 @@ func (_m *ChatMessage) String() string {
 	builder.WriteString("jieba_tokens=")
 	builder.WriteString(fmt.Sprintf("%v", _m.JiebaTokens))
 	builder.WriteString(", ")
+	builder.WriteString("tokenized_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TokenizedAt))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
 	builder.WriteString(", ")
@@ -12,6 +12,8 @@ const (
 	Label = "chat_message"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
 	// FieldPlatform holds the string denoting the platform field in the database.
 	FieldPlatform = "platform"
 	// FieldPlatformMessageID holds the string denoting the platform_message_id field in the database.
@@ -46,6 +48,8 @@ const (
 	FieldContentVector768 = "content_vector_768"
 	// FieldJiebaTokens holds the string denoting the jieba_tokens field in the database.
 	FieldJiebaTokens = "jieba_tokens"
+	// FieldTokenizedAt holds the string denoting the tokenized_at field in the database.
+	FieldTokenizedAt = "tokenized_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -59,6 +63,7 @@ const (
 // Columns holds all SQL columns for chatmessage fields.
 var Columns = []string{
 	FieldID,
+	FieldWorkspaceID,
 	FieldPlatform,
 	FieldPlatformMessageID,
 	FieldFromID,
@@ -76,6 +81,7 @@ var Columns = []string{
 	FieldContentVector1024,
 	FieldContentVector768,
 	FieldJiebaTokens,
+	FieldTokenizedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
@@ -92,6 +98,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultWorkspaceID holds the default value on creation for the "workspace_id" field.
+	DefaultWorkspaceID func() uuid.UUID
 	// DefaultPlatform holds the default value on creation for the "platform" field.
 	DefaultPlatform string
 	// PlatformValidator is a validator for the "platform" field. It is called by the builders before save.
@@ -134,6 +142,8 @@ var (
 	DefaultPlatformTimestamp int64
 	// DefaultJiebaTokens holds the default value on creation for the "jieba_tokens" field.
 	DefaultJiebaTokens []string
+	// DefaultTokenizedAt holds the default value on creation for the "tokenized_at" field.
+	DefaultTokenizedAt int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() int64
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -154,6 +164,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
 // ByPlatform orders the results by the platform field.
 func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
@@ -234,6 +249,11 @@ func ByContentVector768(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContentVector768, opts...).ToFunc()
 }
 
+// ByTokenizedAt orders the results by the tokenized_at field.
+func ByTokenizedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenizedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -54,6 +54,11 @@ func IDLTE(id uuid.UUID) predicate.ChatMessage {
 	return predicate.ChatMessage(sql.FieldLTE(FieldID, id))
 }
 
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
 // Platform applies equality check predicate on the "platform" field. It's identical to PlatformEQ.
 func Platform(v string) predicate.ChatMessage {
 	return predicate.ChatMessage(sql.FieldEQ(FieldPlatform, v))
@@ -134,6 +139,11 @@ func ContentVector768(v pgvector.Vector) predicate.ChatMessage {
 	return predicate.ChatMessage(sql.FieldEQ(FieldContentVector768, v))
 }
 
+// TokenizedAt applies equality check predicate on the "tokenized_at" field. It's identical to TokenizedAtEQ.
+func TokenizedAt(v int64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldTokenizedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v int64) predicate.ChatMessage {
 	return predicate.ChatMessage(sql.FieldEQ(FieldCreatedAt, v))
@@ -149,6 +159,46 @@ func DeletedAt(v int64) predicate.ChatMessage {
 	return predicate.ChatMessage(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
 // PlatformEQ applies the EQ predicate on the "platform" field.
 func PlatformEQ(v string) predicate.ChatMessage {
 	return predicate.ChatMessage(sql.FieldEQ(FieldPlatform, v))
@@ -1004,6 +1054,46 @@ func ContentVector768LTE(v pgvector.Vector) predicate.ChatMessage {
 	return predicate.ChatMessage(sql.FieldLTE(FieldContentVector768, v))
 }
 
+// TokenizedAtEQ applies the EQ predicate on the "tokenized_at" field.
+func TokenizedAtEQ(v int64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldTokenizedAt, v))
+}
+
+// TokenizedAtNEQ applies the NEQ predicate on the "tokenized_at" field.
+func TokenizedAtNEQ(v int64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldTokenizedAt, v))
+}
+
+// TokenizedAtIn applies the In predicate on the "tokenized_at" field.
+func TokenizedAtIn(vs ...int64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldTokenizedAt, vs...))
+}
+
+// TokenizedAtNotIn applies the NotIn predicate on the "tokenized_at" field.
+func TokenizedAtNotIn(vs ...int64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldTokenizedAt, vs...))
+}
+
+// TokenizedAtGT applies the GT predicate on the "tokenized_at" field.
+func TokenizedAtGT(v int64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldTokenizedAt, v))
+}
+
+// TokenizedAtGTE applies the GTE predicate on the "tokenized_at" field.
+func TokenizedAtGTE(v int64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldTokenizedAt, v))
+}
+
+// TokenizedAtLT applies the LT predicate on the "tokenized_at" field.
+func TokenizedAtLT(v int64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldTokenizedAt, v))
+}
+
+// TokenizedAtLTE applies the LTE predicate on the "tokenized_at" field.
+func TokenizedAtLTE(v int64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldTokenizedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v int64) predicate.ChatMessage {
 	return predicate.ChatMessage(sql.FieldEQ(FieldCreatedAt, v))
@@ -24,6 +24,20 @@ type ChatMessageCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *ChatMessageCreate) SetWorkspaceID(v uuid.UUID) *ChatMessageCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableWorkspaceID(v *uuid.UUID) *ChatMessageCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
 // SetPlatform sets the "platform" field.
 func (_c *ChatMessageCreate) SetPlatform(v string) *ChatMessageCreate {
 	_c.mutation.SetPlatform(v)
@@ -230,6 +244,20 @@ func (_c *ChatMessageCreate) SetJiebaTokens(v []string) *ChatMessageCreate {
 	return _c
 }
 
+// SetTokenizedAt sets the "tokenized_at" field.
+func (_c *ChatMessageCreate) SetTokenizedAt(v int64) *ChatMessageCreate {
+	_c.mutation.SetTokenizedAt(v)
+	return _c
+}
+
+// SetNillableTokenizedAt sets the "tokenized_at" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableTokenizedAt(v *int64) *ChatMessageCreate {
+	if v != nil {
+		_c.SetTokenizedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ChatMessageCreate) SetCreatedAt(v int64) *ChatMessageCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -321,6 +349,10 @@ func (_c *ChatMessageCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *ChatMessageCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := chatmessage.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
 	if _, ok := _c.mutation.Platform(); !ok {
 		v := chatmessage.DefaultPlatform
 		_c.mutation.SetPlatform(v)
@@ -369,6 +401,10 @@ func (_c *ChatMessageCreate) defaults() {
 		v := chatmessage.DefaultJiebaTokens
 		_c.mutation.SetJiebaTokens(v)
 	}
+	if _, ok := _c.mutation.TokenizedAt(); !ok {
+		v := chatmessage.DefaultTokenizedAt
+		_c.mutation.SetTokenizedAt(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := chatmessage.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -389,6 +425,9 @@ func (_c *ChatMessageCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *ChatMessageCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "ChatMessage.workspace_id"`)}
+	}
 	if _, ok := _c.mutation.Platform(); !ok {
 		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "ChatMessage.platform"`)}
 	}
@@ -479,6 +518,9 @@ func (_c *ChatMessageCreate) check() error {
 	if _, ok := _c.mutation.JiebaTokens(); !ok {
 		return &ValidationError{Name: "jieba_tokens", err: errors.New(`ent: missing required field "ChatMessage.jieba_tokens"`)}
 	}
+	if _, ok := _c.mutation.TokenizedAt(); !ok {
+		return &ValidationError{Name: "tokenized_at", err: errors.New(`ent: missing required field "ChatMessage.tokenized_at"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatMessage.created_at"`)}
 	}
@@ -525,6 +567,10 @@ func (_c *ChatMessageCreate) createSpec() (*ChatMessage, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatmessage.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
 	if value, ok := _c.mutation.Platform(); ok {
 		_spec.SetField(chatmessage.FieldPlatform, field.TypeString, value)
 		_node.Platform = value
@@ -593,6 +639,10 @@ func (_c *ChatMessageCreate) createSpec() (*ChatMessage, *sqlgraph.CreateSpec) {
 		_spec.SetField(chatmessage.FieldJiebaTokens, field.TypeJSON, value)
 		_node.JiebaTokens = value
 	}
+	if value, ok := _c.mutation.TokenizedAt(); ok {
+		_spec.SetField(chatmessage.FieldTokenizedAt, field.TypeInt64, value)
+		_node.TokenizedAt = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(chatmessage.FieldCreatedAt, field.TypeInt64, value)
 		_node.CreatedAt = value
@@ -612,7 +662,7 @@ func (_c *ChatMessageCreate) createSpec() (*ChatMessage, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.ChatMessage.Create().
-//		SetPlatform(v).
+//		SetWorkspaceID(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -621,7 +671,7 @@ func (_c *ChatMessageCreate) createSpec() (*ChatMessage, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.ChatMessageUpsert) {
-//			SetPlatform(v+v).
+//			SetWorkspaceID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *ChatMessageCreate) OnConflict(opts ...sql.ConflictOption) *ChatMessageUpsertOne {
@@ -657,6 +707,18 @@ type (
 	}
 )
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatMessageUpsert) SetWorkspaceID(v uuid.UUID) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateWorkspaceID() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldWorkspaceID)
+	return u
+}
+
 // SetPlatform sets the "platform" field.
 func (u *ChatMessageUpsert) SetPlatform(v string) *ChatMessageUpsert {
 	u.Set(chatmessage.FieldPlatform, v)
@@ -879,6 +941,24 @@ func (u *ChatMessageUpsert) UpdateJiebaTokens() *ChatMessageUpsert {
 	return u
 }
 
+// SetTokenizedAt sets the "tokenized_at" field.
+func (u *ChatMessageUpsert) SetTokenizedAt(v int64) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldTokenizedAt, v)
+	return u
+}
+
+// UpdateTokenizedAt sets the "tokenized_at" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateTokenizedAt() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldTokenizedAt)
+	return u
+}
+
+// AddTokenizedAt adds v to the "tokenized_at" field.
+func (u *ChatMessageUpsert) AddTokenizedAt(v int64) *ChatMessageUpsert {
+	u.Add(chatmessage.FieldTokenizedAt, v)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *ChatMessageUpsert) SetCreatedAt(v int64) *ChatMessageUpsert {
 	u.Set(chatmessage.FieldCreatedAt, v)
@@ -981,6 +1061,20 @@ func (u *ChatMessageUpsertOne) Update(set func(*ChatMessageUpsert)) *ChatMessage
 	return u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatMessageUpsertOne) SetWorkspaceID(v uuid.UUID) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateWorkspaceID() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
 // SetPlatform sets the "platform" field.
 func (u *ChatMessageUpsertOne) SetPlatform(v string) *ChatMessageUpsertOne {
 	return u.Update(func(s *ChatMessageUpsert) {
@@ -1240,6 +1334,27 @@ func (u *ChatMessageUpsertOne) UpdateJiebaTokens() *ChatMessageUpsertOne {
 	})
 }
 
+// SetTokenizedAt sets the "tokenized_at" field.
+func (u *ChatMessageUpsertOne) SetTokenizedAt(v int64) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetTokenizedAt(v)
+	})
+}
+
+// AddTokenizedAt adds v to the "tokenized_at" field.
+func (u *ChatMessageUpsertOne) AddTokenizedAt(v int64) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.AddTokenizedAt(v)
+	})
+}
+
+// UpdateTokenizedAt sets the "tokenized_at" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateTokenizedAt() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateTokenizedAt()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *ChatMessageUpsertOne) SetCreatedAt(v int64) *ChatMessageUpsertOne {
 	return u.Update(func(s *ChatMessageUpsert) {
@@ -1439,7 +1554,7 @@ func (_c *ChatMessageCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.ChatMessageUpsert) {
-//			SetPlatform(v+v).
+//			SetWorkspaceID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *ChatMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChatMessageUpsertBulk {
@@ -1518,6 +1633,20 @@ func (u *ChatMessageUpsertBulk) Update(set func(*ChatMessageUpsert)) *ChatMessag
 	return u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatMessageUpsertBulk) SetWorkspaceID(v uuid.UUID) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateWorkspaceID() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
 // SetPlatform sets the "platform" field.
 func (u *ChatMessageUpsertBulk) SetPlatform(v string) *ChatMessageUpsertBulk {
 	return u.Update(func(s *ChatMessageUpsert) {
@@ -1777,6 +1906,27 @@ func (u *ChatMessageUpsertBulk) UpdateJiebaTokens() *ChatMessageUpsertBulk {
 	})
 }
 
+// SetTokenizedAt sets the "tokenized_at" field.
+func (u *ChatMessageUpsertBulk) SetTokenizedAt(v int64) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetTokenizedAt(v)
+	})
+}
+
+// AddTokenizedAt adds v to the "tokenized_at" field.
+func (u *ChatMessageUpsertBulk) AddTokenizedAt(v int64) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.AddTokenizedAt(v)
+	})
+}
+
+// UpdateTokenizedAt sets the "tokenized_at" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateTokenizedAt() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateTokenizedAt()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *ChatMessageUpsertBulk) SetCreatedAt(v int64) *ChatMessageUpsertBulk {
 	return u.Update(func(s *ChatMessageUpsert) {
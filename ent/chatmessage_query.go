@@ -266,12 +266,12 @@ func (_q *ChatMessageQuery) Clone() *ChatMessageQuery {
 // Example:
 //
 //	var v []struct {
-//		Platform string `json:"platform,omitempty"`
+//		WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.ChatMessage.Query().
-//		GroupBy(chatmessage.FieldPlatform).
+//		GroupBy(chatmessage.FieldWorkspaceID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ChatMessageQuery) GroupBy(field string, fields ...string) *ChatMessageGroupBy {
@@ -289,11 +289,11 @@ func (_q *ChatMessageQuery) GroupBy(field string, fields ...string) *ChatMessage
 // Example:
 //
 //	var v []struct {
-//		Platform string `json:"platform,omitempty"`
+//		WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 //	}
 //
 //	client.ChatMessage.Query().
-//		Select(chatmessage.FieldPlatform).
+//		Select(chatmessage.FieldWorkspaceID).
 //		Scan(ctx, &v)
 func (_q *ChatMessageQuery) Select(fields ...string) *ChatMessageSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -31,6 +31,20 @@ func (_u *ChatMessageUpdate) Where(ps ...predicate.ChatMessage) *ChatMessageUpda
 	return _u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *ChatMessageUpdate) SetWorkspaceID(v uuid.UUID) *ChatMessageUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableWorkspaceID(v *uuid.UUID) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
 // SetPlatform sets the "platform" field.
 func (_u *ChatMessageUpdate) SetPlatform(v string) *ChatMessageUpdate {
 	_u.mutation.SetPlatform(v)
@@ -286,6 +300,27 @@ func (_u *ChatMessageUpdate) AppendJiebaTokens(v []string) *ChatMessageUpdate {
 	return _u
 }
 
+// SetTokenizedAt sets the "tokenized_at" field.
+func (_u *ChatMessageUpdate) SetTokenizedAt(v int64) *ChatMessageUpdate {
+	_u.mutation.ResetTokenizedAt()
+	_u.mutation.SetTokenizedAt(v)
+	return _u
+}
+
+// SetNillableTokenizedAt sets the "tokenized_at" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableTokenizedAt(v *int64) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetTokenizedAt(*v)
+	}
+	return _u
+}
+
+// AddTokenizedAt adds value to the "tokenized_at" field.
+func (_u *ChatMessageUpdate) AddTokenizedAt(v int64) *ChatMessageUpdate {
+	_u.mutation.AddTokenizedAt(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *ChatMessageUpdate) SetCreatedAt(v int64) *ChatMessageUpdate {
 	_u.mutation.ResetCreatedAt()
@@ -444,6 +479,9 @@ func (_u *ChatMessageUpdate) sqlSave(ctx context.Context) (_node int, err error)
 			}
 		}
 	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatmessage.FieldWorkspaceID, field.TypeUUID, value)
+	}
 	if value, ok := _u.mutation.Platform(); ok {
 		_spec.SetField(chatmessage.FieldPlatform, field.TypeString, value)
 	}
@@ -509,6 +547,12 @@ func (_u *ChatMessageUpdate) sqlSave(ctx context.Context) (_node int, err error)
 			sqljson.Append(u, chatmessage.FieldJiebaTokens, value)
 		})
 	}
+	if value, ok := _u.mutation.TokenizedAt(); ok {
+		_spec.SetField(chatmessage.FieldTokenizedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTokenizedAt(); ok {
+		_spec.AddField(chatmessage.FieldTokenizedAt, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(chatmessage.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -549,6 +593,20 @@ type ChatMessageUpdateOne struct {
 	mutation *ChatMessageMutation
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *ChatMessageUpdateOne) SetWorkspaceID(v uuid.UUID) *ChatMessageUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
 // SetPlatform sets the "platform" field.
 func (_u *ChatMessageUpdateOne) SetPlatform(v string) *ChatMessageUpdateOne {
 	_u.mutation.SetPlatform(v)
@@ -804,6 +862,27 @@ func (_u *ChatMessageUpdateOne) AppendJiebaTokens(v []string) *ChatMessageUpdate
 	return _u
 }
 
+// SetTokenizedAt sets the "tokenized_at" field.
+func (_u *ChatMessageUpdateOne) SetTokenizedAt(v int64) *ChatMessageUpdateOne {
+	_u.mutation.ResetTokenizedAt()
+	_u.mutation.SetTokenizedAt(v)
+	return _u
+}
+
+// SetNillableTokenizedAt sets the "tokenized_at" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableTokenizedAt(v *int64) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetTokenizedAt(*v)
+	}
+	return _u
+}
+
+// AddTokenizedAt adds value to the "tokenized_at" field.
+func (_u *ChatMessageUpdateOne) AddTokenizedAt(v int64) *ChatMessageUpdateOne {
+	_u.mutation.AddTokenizedAt(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *ChatMessageUpdateOne) SetCreatedAt(v int64) *ChatMessageUpdateOne {
 	_u.mutation.ResetCreatedAt()
@@ -992,6 +1071,9 @@ func (_u *ChatMessageUpdateOne) sqlSave(ctx context.Context) (_node *ChatMessage
 			}
 		}
 	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatmessage.FieldWorkspaceID, field.TypeUUID, value)
+	}
 	if value, ok := _u.mutation.Platform(); ok {
 		_spec.SetField(chatmessage.FieldPlatform, field.TypeString, value)
 	}
@@ -1057,6 +1139,12 @@ func (_u *ChatMessageUpdateOne) sqlSave(ctx context.Context) (_node *ChatMessage
 			sqljson.Append(u, chatmessage.FieldJiebaTokens, value)
 		})
 	}
+	if value, ok := _u.mutation.TokenizedAt(); ok {
+		_spec.SetField(chatmessage.FieldTokenizedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTokenizedAt(); ok {
+		_spec.AddField(chatmessage.FieldTokenizedAt, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(chatmessage.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -0,0 +1,988 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/chatretention"
+)
+
+// ChatRetentionCreate is the builder for creating a ChatRetention entity.
+type ChatRetentionCreate struct {
+	config
+	mutation *ChatRetentionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *ChatRetentionCreate) SetWorkspaceID(v uuid.UUID) *ChatRetentionCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *ChatRetentionCreate) SetNillableWorkspaceID(v *uuid.UUID) *ChatRetentionCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *ChatRetentionCreate) SetChatID(v string) *ChatRetentionCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetDays sets the "days" field.
+func (_c *ChatRetentionCreate) SetDays(v int) *ChatRetentionCreate {
+	_c.mutation.SetDays(v)
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *ChatRetentionCreate) SetMode(v chatretention.Mode) *ChatRetentionCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_c *ChatRetentionCreate) SetNillableMode(v *chatretention.Mode) *ChatRetentionCreate {
+	if v != nil {
+		_c.SetMode(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ChatRetentionCreate) SetEnabled(v bool) *ChatRetentionCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ChatRetentionCreate) SetNillableEnabled(v *bool) *ChatRetentionCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *ChatRetentionCreate) SetLastRunAt(v int64) *ChatRetentionCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *ChatRetentionCreate) SetNillableLastRunAt(v *int64) *ChatRetentionCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChatRetentionCreate) SetCreatedAt(v int64) *ChatRetentionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChatRetentionCreate) SetNillableCreatedAt(v *int64) *ChatRetentionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ChatRetentionCreate) SetUpdatedAt(v int64) *ChatRetentionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ChatRetentionCreate) SetNillableUpdatedAt(v *int64) *ChatRetentionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChatRetentionCreate) SetID(v uuid.UUID) *ChatRetentionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ChatRetentionCreate) SetNillableID(v *uuid.UUID) *ChatRetentionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ChatRetentionMutation object of the builder.
+func (_c *ChatRetentionCreate) Mutation() *ChatRetentionMutation {
+	return _c.mutation
+}
+
+// Save creates the ChatRetention in the database.
+func (_c *ChatRetentionCreate) Save(ctx context.Context) (*ChatRetention, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChatRetentionCreate) SaveX(ctx context.Context) *ChatRetention {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatRetentionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatRetentionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChatRetentionCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := chatretention.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		v := chatretention.DefaultMode
+		_c.mutation.SetMode(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := chatretention.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.LastRunAt(); !ok {
+		v := chatretention.DefaultLastRunAt
+		_c.mutation.SetLastRunAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := chatretention.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := chatretention.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := chatretention.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChatRetentionCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "ChatRetention.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "ChatRetention.chat_id"`)}
+	}
+	if _, ok := _c.mutation.Days(); !ok {
+		return &ValidationError{Name: "days", err: errors.New(`ent: missing required field "ChatRetention.days"`)}
+	}
+	if v, ok := _c.mutation.Days(); ok {
+		if err := chatretention.DaysValidator(v); err != nil {
+			return &ValidationError{Name: "days", err: fmt.Errorf(`ent: validator failed for field "ChatRetention.days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "ChatRetention.mode"`)}
+	}
+	if v, ok := _c.mutation.Mode(); ok {
+		if err := chatretention.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "ChatRetention.mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ChatRetention.enabled"`)}
+	}
+	if _, ok := _c.mutation.LastRunAt(); !ok {
+		return &ValidationError{Name: "last_run_at", err: errors.New(`ent: missing required field "ChatRetention.last_run_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatRetention.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ChatRetention.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ChatRetentionCreate) sqlSave(ctx context.Context) (*ChatRetention, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChatRetentionCreate) createSpec() (*ChatRetention, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChatRetention{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chatretention.Table, sqlgraph.NewFieldSpec(chatretention.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.ChatRetention
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatretention.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(chatretention.FieldChatID, field.TypeString, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.Days(); ok {
+		_spec.SetField(chatretention.FieldDays, field.TypeInt, value)
+		_node.Days = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(chatretention.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(chatretention.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(chatretention.FieldLastRunAt, field.TypeInt64, value)
+		_node.LastRunAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(chatretention.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatretention.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatRetention.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatRetentionUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatRetentionCreate) OnConflict(opts ...sql.ConflictOption) *ChatRetentionUpsertOne {
+	_c.conflict = opts
+	return &ChatRetentionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatRetention.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatRetentionCreate) OnConflictColumns(columns ...string) *ChatRetentionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatRetentionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ChatRetentionUpsertOne is the builder for "upsert"-ing
+	//  one ChatRetention node.
+	ChatRetentionUpsertOne struct {
+		create *ChatRetentionCreate
+	}
+
+	// ChatRetentionUpsert is the "OnConflict" setter.
+	ChatRetentionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatRetentionUpsert) SetWorkspaceID(v uuid.UUID) *ChatRetentionUpsert {
+	u.Set(chatretention.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatRetentionUpsert) UpdateWorkspaceID() *ChatRetentionUpsert {
+	u.SetExcluded(chatretention.FieldWorkspaceID)
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatRetentionUpsert) SetChatID(v string) *ChatRetentionUpsert {
+	u.Set(chatretention.FieldChatID, v)
+	return u
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatRetentionUpsert) UpdateChatID() *ChatRetentionUpsert {
+	u.SetExcluded(chatretention.FieldChatID)
+	return u
+}
+
+// SetDays sets the "days" field.
+func (u *ChatRetentionUpsert) SetDays(v int) *ChatRetentionUpsert {
+	u.Set(chatretention.FieldDays, v)
+	return u
+}
+
+// UpdateDays sets the "days" field to the value that was provided on create.
+func (u *ChatRetentionUpsert) UpdateDays() *ChatRetentionUpsert {
+	u.SetExcluded(chatretention.FieldDays)
+	return u
+}
+
+// AddDays adds v to the "days" field.
+func (u *ChatRetentionUpsert) AddDays(v int) *ChatRetentionUpsert {
+	u.Add(chatretention.FieldDays, v)
+	return u
+}
+
+// SetMode sets the "mode" field.
+func (u *ChatRetentionUpsert) SetMode(v chatretention.Mode) *ChatRetentionUpsert {
+	u.Set(chatretention.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *ChatRetentionUpsert) UpdateMode() *ChatRetentionUpsert {
+	u.SetExcluded(chatretention.FieldMode)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ChatRetentionUpsert) SetEnabled(v bool) *ChatRetentionUpsert {
+	u.Set(chatretention.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ChatRetentionUpsert) UpdateEnabled() *ChatRetentionUpsert {
+	u.SetExcluded(chatretention.FieldEnabled)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ChatRetentionUpsert) SetLastRunAt(v int64) *ChatRetentionUpsert {
+	u.Set(chatretention.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ChatRetentionUpsert) UpdateLastRunAt() *ChatRetentionUpsert {
+	u.SetExcluded(chatretention.FieldLastRunAt)
+	return u
+}
+
+// AddLastRunAt adds v to the "last_run_at" field.
+func (u *ChatRetentionUpsert) AddLastRunAt(v int64) *ChatRetentionUpsert {
+	u.Add(chatretention.FieldLastRunAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatRetentionUpsert) SetUpdatedAt(v int64) *ChatRetentionUpsert {
+	u.Set(chatretention.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatRetentionUpsert) UpdateUpdatedAt() *ChatRetentionUpsert {
+	u.SetExcluded(chatretention.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChatRetentionUpsert) AddUpdatedAt(v int64) *ChatRetentionUpsert {
+	u.Add(chatretention.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ChatRetention.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatretention.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatRetentionUpsertOne) UpdateNewValues() *ChatRetentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(chatretention.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(chatretention.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatRetention.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChatRetentionUpsertOne) Ignore() *ChatRetentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatRetentionUpsertOne) DoNothing() *ChatRetentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatRetentionCreate.OnConflict
+// documentation for more info.
+func (u *ChatRetentionUpsertOne) Update(set func(*ChatRetentionUpsert)) *ChatRetentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatRetentionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatRetentionUpsertOne) SetWorkspaceID(v uuid.UUID) *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatRetentionUpsertOne) UpdateWorkspaceID() *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatRetentionUpsertOne) SetChatID(v string) *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatRetentionUpsertOne) UpdateChatID() *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetDays sets the "days" field.
+func (u *ChatRetentionUpsertOne) SetDays(v int) *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetDays(v)
+	})
+}
+
+// AddDays adds v to the "days" field.
+func (u *ChatRetentionUpsertOne) AddDays(v int) *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.AddDays(v)
+	})
+}
+
+// UpdateDays sets the "days" field to the value that was provided on create.
+func (u *ChatRetentionUpsertOne) UpdateDays() *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateDays()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *ChatRetentionUpsertOne) SetMode(v chatretention.Mode) *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *ChatRetentionUpsertOne) UpdateMode() *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ChatRetentionUpsertOne) SetEnabled(v bool) *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ChatRetentionUpsertOne) UpdateEnabled() *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ChatRetentionUpsertOne) SetLastRunAt(v int64) *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// AddLastRunAt adds v to the "last_run_at" field.
+func (u *ChatRetentionUpsertOne) AddLastRunAt(v int64) *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.AddLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ChatRetentionUpsertOne) UpdateLastRunAt() *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatRetentionUpsertOne) SetUpdatedAt(v int64) *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChatRetentionUpsertOne) AddUpdatedAt(v int64) *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatRetentionUpsertOne) UpdateUpdatedAt() *ChatRetentionUpsertOne {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatRetentionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatRetentionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatRetentionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChatRetentionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChatRetentionUpsertOne.ID is not supported by MySQL driver. Use ChatRetentionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChatRetentionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChatRetentionCreateBulk is the builder for creating many ChatRetention entities in bulk.
+type ChatRetentionCreateBulk struct {
+	config
+	err      error
+	builders []*ChatRetentionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ChatRetention entities in the database.
+func (_c *ChatRetentionCreateBulk) Save(ctx context.Context) ([]*ChatRetention, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChatRetention, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatRetentionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChatRetentionCreateBulk) SaveX(ctx context.Context) []*ChatRetention {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatRetentionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatRetentionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatRetention.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatRetentionUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatRetentionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChatRetentionUpsertBulk {
+	_c.conflict = opts
+	return &ChatRetentionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatRetention.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatRetentionCreateBulk) OnConflictColumns(columns ...string) *ChatRetentionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatRetentionUpsertBulk{
+		create: _c,
+	}
+}
+
+// ChatRetentionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChatRetention nodes.
+type ChatRetentionUpsertBulk struct {
+	create *ChatRetentionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChatRetention.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatretention.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatRetentionUpsertBulk) UpdateNewValues() *ChatRetentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(chatretention.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(chatretention.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatRetention.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChatRetentionUpsertBulk) Ignore() *ChatRetentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatRetentionUpsertBulk) DoNothing() *ChatRetentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatRetentionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChatRetentionUpsertBulk) Update(set func(*ChatRetentionUpsert)) *ChatRetentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatRetentionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatRetentionUpsertBulk) SetWorkspaceID(v uuid.UUID) *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatRetentionUpsertBulk) UpdateWorkspaceID() *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatRetentionUpsertBulk) SetChatID(v string) *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatRetentionUpsertBulk) UpdateChatID() *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetDays sets the "days" field.
+func (u *ChatRetentionUpsertBulk) SetDays(v int) *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetDays(v)
+	})
+}
+
+// AddDays adds v to the "days" field.
+func (u *ChatRetentionUpsertBulk) AddDays(v int) *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.AddDays(v)
+	})
+}
+
+// UpdateDays sets the "days" field to the value that was provided on create.
+func (u *ChatRetentionUpsertBulk) UpdateDays() *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateDays()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *ChatRetentionUpsertBulk) SetMode(v chatretention.Mode) *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *ChatRetentionUpsertBulk) UpdateMode() *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ChatRetentionUpsertBulk) SetEnabled(v bool) *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ChatRetentionUpsertBulk) UpdateEnabled() *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ChatRetentionUpsertBulk) SetLastRunAt(v int64) *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// AddLastRunAt adds v to the "last_run_at" field.
+func (u *ChatRetentionUpsertBulk) AddLastRunAt(v int64) *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.AddLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ChatRetentionUpsertBulk) UpdateLastRunAt() *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatRetentionUpsertBulk) SetUpdatedAt(v int64) *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChatRetentionUpsertBulk) AddUpdatedAt(v int64) *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatRetentionUpsertBulk) UpdateUpdatedAt() *ChatRetentionUpsertBulk {
+	return u.Update(func(s *ChatRetentionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatRetentionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChatRetentionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatRetentionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatRetentionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
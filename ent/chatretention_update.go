@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/chatretention"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ChatRetentionUpdate is the builder for updating ChatRetention entities.
+type ChatRetentionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChatRetentionMutation
+}
+
+// Where appends a list predicates to the ChatRetentionUpdate builder.
+func (_u *ChatRetentionUpdate) Where(ps ...predicate.ChatRetention) *ChatRetentionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *ChatRetentionUpdate) SetWorkspaceID(v uuid.UUID) *ChatRetentionUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *ChatRetentionUpdate) SetNillableWorkspaceID(v *uuid.UUID) *ChatRetentionUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatRetentionUpdate) SetChatID(v string) *ChatRetentionUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatRetentionUpdate) SetNillableChatID(v *string) *ChatRetentionUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetDays sets the "days" field.
+func (_u *ChatRetentionUpdate) SetDays(v int) *ChatRetentionUpdate {
+	_u.mutation.ResetDays()
+	_u.mutation.SetDays(v)
+	return _u
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (_u *ChatRetentionUpdate) SetNillableDays(v *int) *ChatRetentionUpdate {
+	if v != nil {
+		_u.SetDays(*v)
+	}
+	return _u
+}
+
+// AddDays adds value to the "days" field.
+func (_u *ChatRetentionUpdate) AddDays(v int) *ChatRetentionUpdate {
+	_u.mutation.AddDays(v)
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *ChatRetentionUpdate) SetMode(v chatretention.Mode) *ChatRetentionUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *ChatRetentionUpdate) SetNillableMode(v *chatretention.Mode) *ChatRetentionUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ChatRetentionUpdate) SetEnabled(v bool) *ChatRetentionUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ChatRetentionUpdate) SetNillableEnabled(v *bool) *ChatRetentionUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ChatRetentionUpdate) SetLastRunAt(v int64) *ChatRetentionUpdate {
+	_u.mutation.ResetLastRunAt()
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ChatRetentionUpdate) SetNillableLastRunAt(v *int64) *ChatRetentionUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// AddLastRunAt adds value to the "last_run_at" field.
+func (_u *ChatRetentionUpdate) AddLastRunAt(v int64) *ChatRetentionUpdate {
+	_u.mutation.AddLastRunAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatRetentionUpdate) SetUpdatedAt(v int64) *ChatRetentionUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *ChatRetentionUpdate) AddUpdatedAt(v int64) *ChatRetentionUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ChatRetentionMutation object of the builder.
+func (_u *ChatRetentionUpdate) Mutation() *ChatRetentionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatRetentionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatRetentionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatRetentionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatRetentionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatRetentionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatretention.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatRetentionUpdate) check() error {
+	if v, ok := _u.mutation.Days(); ok {
+		if err := chatretention.DaysValidator(v); err != nil {
+			return &ValidationError{Name: "days", err: fmt.Errorf(`ent: validator failed for field "ChatRetention.days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := chatretention.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "ChatRetention.mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChatRetentionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatretention.Table, chatretention.Columns, sqlgraph.NewFieldSpec(chatretention.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatretention.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(chatretention.FieldChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Days(); ok {
+		_spec.SetField(chatretention.FieldDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDays(); ok {
+		_spec.AddField(chatretention.FieldDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(chatretention.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(chatretention.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(chatretention.FieldLastRunAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastRunAt(); ok {
+		_spec.AddField(chatretention.FieldLastRunAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatretention.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(chatretention.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.ChatRetention
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatretention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatRetentionUpdateOne is the builder for updating a single ChatRetention entity.
+type ChatRetentionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChatRetentionMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *ChatRetentionUpdateOne) SetWorkspaceID(v uuid.UUID) *ChatRetentionUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *ChatRetentionUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *ChatRetentionUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatRetentionUpdateOne) SetChatID(v string) *ChatRetentionUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatRetentionUpdateOne) SetNillableChatID(v *string) *ChatRetentionUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetDays sets the "days" field.
+func (_u *ChatRetentionUpdateOne) SetDays(v int) *ChatRetentionUpdateOne {
+	_u.mutation.ResetDays()
+	_u.mutation.SetDays(v)
+	return _u
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (_u *ChatRetentionUpdateOne) SetNillableDays(v *int) *ChatRetentionUpdateOne {
+	if v != nil {
+		_u.SetDays(*v)
+	}
+	return _u
+}
+
+// AddDays adds value to the "days" field.
+func (_u *ChatRetentionUpdateOne) AddDays(v int) *ChatRetentionUpdateOne {
+	_u.mutation.AddDays(v)
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *ChatRetentionUpdateOne) SetMode(v chatretention.Mode) *ChatRetentionUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *ChatRetentionUpdateOne) SetNillableMode(v *chatretention.Mode) *ChatRetentionUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ChatRetentionUpdateOne) SetEnabled(v bool) *ChatRetentionUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ChatRetentionUpdateOne) SetNillableEnabled(v *bool) *ChatRetentionUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ChatRetentionUpdateOne) SetLastRunAt(v int64) *ChatRetentionUpdateOne {
+	_u.mutation.ResetLastRunAt()
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ChatRetentionUpdateOne) SetNillableLastRunAt(v *int64) *ChatRetentionUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// AddLastRunAt adds value to the "last_run_at" field.
+func (_u *ChatRetentionUpdateOne) AddLastRunAt(v int64) *ChatRetentionUpdateOne {
+	_u.mutation.AddLastRunAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatRetentionUpdateOne) SetUpdatedAt(v int64) *ChatRetentionUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *ChatRetentionUpdateOne) AddUpdatedAt(v int64) *ChatRetentionUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ChatRetentionMutation object of the builder.
+func (_u *ChatRetentionUpdateOne) Mutation() *ChatRetentionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChatRetentionUpdate builder.
+func (_u *ChatRetentionUpdateOne) Where(ps ...predicate.ChatRetention) *ChatRetentionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatRetentionUpdateOne) Select(field string, fields ...string) *ChatRetentionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChatRetention entity.
+func (_u *ChatRetentionUpdateOne) Save(ctx context.Context) (*ChatRetention, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatRetentionUpdateOne) SaveX(ctx context.Context) *ChatRetention {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatRetentionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatRetentionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatRetentionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatretention.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatRetentionUpdateOne) check() error {
+	if v, ok := _u.mutation.Days(); ok {
+		if err := chatretention.DaysValidator(v); err != nil {
+			return &ValidationError{Name: "days", err: fmt.Errorf(`ent: validator failed for field "ChatRetention.days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := chatretention.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "ChatRetention.mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChatRetentionUpdateOne) sqlSave(ctx context.Context) (_node *ChatRetention, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatretention.Table, chatretention.Columns, sqlgraph.NewFieldSpec(chatretention.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatRetention.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatretention.FieldID)
+		for _, f := range fields {
+			if !chatretention.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatretention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatretention.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(chatretention.FieldChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Days(); ok {
+		_spec.SetField(chatretention.FieldDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDays(); ok {
+		_spec.AddField(chatretention.FieldDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(chatretention.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(chatretention.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(chatretention.FieldLastRunAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastRunAt(); ok {
+		_spec.AddField(chatretention.FieldLastRunAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatretention.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(chatretention.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.ChatRetention
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &ChatRetention{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatretention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
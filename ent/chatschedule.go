@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/chatschedule"
+)
+
+// ChatSchedule is the model entity for the ChatSchedule schema.
+type ChatSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID string `json:"chat_id,omitempty"`
+	// Cron holds the value of the "cron" field.
+	Cron string `json:"cron,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// LastWindowEnd holds the value of the "last_window_end" field.
+	LastWindowEnd int64 `json:"last_window_end,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChatSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chatschedule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case chatschedule.FieldLastWindowEnd, chatschedule.FieldCreatedAt, chatschedule.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case chatschedule.FieldChatID, chatschedule.FieldCron:
+			values[i] = new(sql.NullString)
+		case chatschedule.FieldID, chatschedule.FieldWorkspaceID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChatSchedule fields.
+func (_m *ChatSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chatschedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case chatschedule.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
+		case chatschedule.FieldChatID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				_m.ChatID = value.String
+			}
+		case chatschedule.FieldCron:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron", values[i])
+			} else if value.Valid {
+				_m.Cron = value.String
+			}
+		case chatschedule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case chatschedule.FieldLastWindowEnd:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_window_end", values[i])
+			} else if value.Valid {
+				_m.LastWindowEnd = value.Int64
+			}
+		case chatschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case chatschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChatSchedule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChatSchedule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ChatSchedule.
+// Note that you need to call ChatSchedule.Unwrap() before calling this method if this ChatSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChatSchedule) Update() *ChatScheduleUpdateOne {
+	return NewChatScheduleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChatSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChatSchedule) Unwrap() *ChatSchedule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChatSchedule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChatSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChatSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(_m.ChatID)
+	builder.WriteString(", ")
+	builder.WriteString("cron=")
+	builder.WriteString(_m.Cron)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("last_window_end=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastWindowEnd))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChatSchedules is a parsable slice of ChatSchedule.
+type ChatSchedules []*ChatSchedule
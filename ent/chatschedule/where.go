@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chatschedule
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldChatID, v))
+}
+
+// Cron applies equality check predicate on the "cron" field. It's identical to CronEQ.
+func Cron(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldCron, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// LastWindowEnd applies equality check predicate on the "last_window_end" field. It's identical to LastWindowEndEQ.
+func LastWindowEnd(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldLastWindowEnd, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLTE(FieldChatID, v))
+}
+
+// ChatIDContains applies the Contains predicate on the "chat_id" field.
+func ChatIDContains(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldContains(FieldChatID, v))
+}
+
+// ChatIDHasPrefix applies the HasPrefix predicate on the "chat_id" field.
+func ChatIDHasPrefix(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldHasPrefix(FieldChatID, v))
+}
+
+// ChatIDHasSuffix applies the HasSuffix predicate on the "chat_id" field.
+func ChatIDHasSuffix(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldHasSuffix(FieldChatID, v))
+}
+
+// ChatIDEqualFold applies the EqualFold predicate on the "chat_id" field.
+func ChatIDEqualFold(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEqualFold(FieldChatID, v))
+}
+
+// ChatIDContainsFold applies the ContainsFold predicate on the "chat_id" field.
+func ChatIDContainsFold(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldContainsFold(FieldChatID, v))
+}
+
+// CronEQ applies the EQ predicate on the "cron" field.
+func CronEQ(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldCron, v))
+}
+
+// CronNEQ applies the NEQ predicate on the "cron" field.
+func CronNEQ(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNEQ(FieldCron, v))
+}
+
+// CronIn applies the In predicate on the "cron" field.
+func CronIn(vs ...string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldIn(FieldCron, vs...))
+}
+
+// CronNotIn applies the NotIn predicate on the "cron" field.
+func CronNotIn(vs ...string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNotIn(FieldCron, vs...))
+}
+
+// CronGT applies the GT predicate on the "cron" field.
+func CronGT(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGT(FieldCron, v))
+}
+
+// CronGTE applies the GTE predicate on the "cron" field.
+func CronGTE(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGTE(FieldCron, v))
+}
+
+// CronLT applies the LT predicate on the "cron" field.
+func CronLT(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLT(FieldCron, v))
+}
+
+// CronLTE applies the LTE predicate on the "cron" field.
+func CronLTE(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLTE(FieldCron, v))
+}
+
+// CronContains applies the Contains predicate on the "cron" field.
+func CronContains(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldContains(FieldCron, v))
+}
+
+// CronHasPrefix applies the HasPrefix predicate on the "cron" field.
+func CronHasPrefix(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldHasPrefix(FieldCron, v))
+}
+
+// CronHasSuffix applies the HasSuffix predicate on the "cron" field.
+func CronHasSuffix(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldHasSuffix(FieldCron, v))
+}
+
+// CronEqualFold applies the EqualFold predicate on the "cron" field.
+func CronEqualFold(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEqualFold(FieldCron, v))
+}
+
+// CronContainsFold applies the ContainsFold predicate on the "cron" field.
+func CronContainsFold(v string) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldContainsFold(FieldCron, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// LastWindowEndEQ applies the EQ predicate on the "last_window_end" field.
+func LastWindowEndEQ(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldLastWindowEnd, v))
+}
+
+// LastWindowEndNEQ applies the NEQ predicate on the "last_window_end" field.
+func LastWindowEndNEQ(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNEQ(FieldLastWindowEnd, v))
+}
+
+// LastWindowEndIn applies the In predicate on the "last_window_end" field.
+func LastWindowEndIn(vs ...int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldIn(FieldLastWindowEnd, vs...))
+}
+
+// LastWindowEndNotIn applies the NotIn predicate on the "last_window_end" field.
+func LastWindowEndNotIn(vs ...int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNotIn(FieldLastWindowEnd, vs...))
+}
+
+// LastWindowEndGT applies the GT predicate on the "last_window_end" field.
+func LastWindowEndGT(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGT(FieldLastWindowEnd, v))
+}
+
+// LastWindowEndGTE applies the GTE predicate on the "last_window_end" field.
+func LastWindowEndGTE(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGTE(FieldLastWindowEnd, v))
+}
+
+// LastWindowEndLT applies the LT predicate on the "last_window_end" field.
+func LastWindowEndLT(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLT(FieldLastWindowEnd, v))
+}
+
+// LastWindowEndLTE applies the LTE predicate on the "last_window_end" field.
+func LastWindowEndLTE(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLTE(FieldLastWindowEnd, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChatSchedule) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChatSchedule) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChatSchedule) predicate.ChatSchedule {
+	return predicate.ChatSchedule(sql.NotPredicates(p))
+}
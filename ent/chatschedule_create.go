@@ -0,0 +1,893 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/chatschedule"
+)
+
+// ChatScheduleCreate is the builder for creating a ChatSchedule entity.
+type ChatScheduleCreate struct {
+	config
+	mutation *ChatScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *ChatScheduleCreate) SetWorkspaceID(v uuid.UUID) *ChatScheduleCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *ChatScheduleCreate) SetNillableWorkspaceID(v *uuid.UUID) *ChatScheduleCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *ChatScheduleCreate) SetChatID(v string) *ChatScheduleCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetCron sets the "cron" field.
+func (_c *ChatScheduleCreate) SetCron(v string) *ChatScheduleCreate {
+	_c.mutation.SetCron(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ChatScheduleCreate) SetEnabled(v bool) *ChatScheduleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ChatScheduleCreate) SetNillableEnabled(v *bool) *ChatScheduleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetLastWindowEnd sets the "last_window_end" field.
+func (_c *ChatScheduleCreate) SetLastWindowEnd(v int64) *ChatScheduleCreate {
+	_c.mutation.SetLastWindowEnd(v)
+	return _c
+}
+
+// SetNillableLastWindowEnd sets the "last_window_end" field if the given value is not nil.
+func (_c *ChatScheduleCreate) SetNillableLastWindowEnd(v *int64) *ChatScheduleCreate {
+	if v != nil {
+		_c.SetLastWindowEnd(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChatScheduleCreate) SetCreatedAt(v int64) *ChatScheduleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChatScheduleCreate) SetNillableCreatedAt(v *int64) *ChatScheduleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ChatScheduleCreate) SetUpdatedAt(v int64) *ChatScheduleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ChatScheduleCreate) SetNillableUpdatedAt(v *int64) *ChatScheduleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChatScheduleCreate) SetID(v uuid.UUID) *ChatScheduleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ChatScheduleCreate) SetNillableID(v *uuid.UUID) *ChatScheduleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ChatScheduleMutation object of the builder.
+func (_c *ChatScheduleCreate) Mutation() *ChatScheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the ChatSchedule in the database.
+func (_c *ChatScheduleCreate) Save(ctx context.Context) (*ChatSchedule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChatScheduleCreate) SaveX(ctx context.Context) *ChatSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatScheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatScheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChatScheduleCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := chatschedule.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := chatschedule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.LastWindowEnd(); !ok {
+		v := chatschedule.DefaultLastWindowEnd
+		_c.mutation.SetLastWindowEnd(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := chatschedule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := chatschedule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := chatschedule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChatScheduleCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "ChatSchedule.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "ChatSchedule.chat_id"`)}
+	}
+	if _, ok := _c.mutation.Cron(); !ok {
+		return &ValidationError{Name: "cron", err: errors.New(`ent: missing required field "ChatSchedule.cron"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ChatSchedule.enabled"`)}
+	}
+	if _, ok := _c.mutation.LastWindowEnd(); !ok {
+		return &ValidationError{Name: "last_window_end", err: errors.New(`ent: missing required field "ChatSchedule.last_window_end"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatSchedule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ChatSchedule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ChatScheduleCreate) sqlSave(ctx context.Context) (*ChatSchedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChatScheduleCreate) createSpec() (*ChatSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChatSchedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chatschedule.Table, sqlgraph.NewFieldSpec(chatschedule.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.ChatSchedule
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatschedule.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(chatschedule.FieldChatID, field.TypeString, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.Cron(); ok {
+		_spec.SetField(chatschedule.FieldCron, field.TypeString, value)
+		_node.Cron = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(chatschedule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.LastWindowEnd(); ok {
+		_spec.SetField(chatschedule.FieldLastWindowEnd, field.TypeInt64, value)
+		_node.LastWindowEnd = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(chatschedule.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatschedule.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatSchedule.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatScheduleUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatScheduleCreate) OnConflict(opts ...sql.ConflictOption) *ChatScheduleUpsertOne {
+	_c.conflict = opts
+	return &ChatScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatScheduleCreate) OnConflictColumns(columns ...string) *ChatScheduleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ChatScheduleUpsertOne is the builder for "upsert"-ing
+	//  one ChatSchedule node.
+	ChatScheduleUpsertOne struct {
+		create *ChatScheduleCreate
+	}
+
+	// ChatScheduleUpsert is the "OnConflict" setter.
+	ChatScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatScheduleUpsert) SetWorkspaceID(v uuid.UUID) *ChatScheduleUpsert {
+	u.Set(chatschedule.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatScheduleUpsert) UpdateWorkspaceID() *ChatScheduleUpsert {
+	u.SetExcluded(chatschedule.FieldWorkspaceID)
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatScheduleUpsert) SetChatID(v string) *ChatScheduleUpsert {
+	u.Set(chatschedule.FieldChatID, v)
+	return u
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatScheduleUpsert) UpdateChatID() *ChatScheduleUpsert {
+	u.SetExcluded(chatschedule.FieldChatID)
+	return u
+}
+
+// SetCron sets the "cron" field.
+func (u *ChatScheduleUpsert) SetCron(v string) *ChatScheduleUpsert {
+	u.Set(chatschedule.FieldCron, v)
+	return u
+}
+
+// UpdateCron sets the "cron" field to the value that was provided on create.
+func (u *ChatScheduleUpsert) UpdateCron() *ChatScheduleUpsert {
+	u.SetExcluded(chatschedule.FieldCron)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ChatScheduleUpsert) SetEnabled(v bool) *ChatScheduleUpsert {
+	u.Set(chatschedule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ChatScheduleUpsert) UpdateEnabled() *ChatScheduleUpsert {
+	u.SetExcluded(chatschedule.FieldEnabled)
+	return u
+}
+
+// SetLastWindowEnd sets the "last_window_end" field.
+func (u *ChatScheduleUpsert) SetLastWindowEnd(v int64) *ChatScheduleUpsert {
+	u.Set(chatschedule.FieldLastWindowEnd, v)
+	return u
+}
+
+// UpdateLastWindowEnd sets the "last_window_end" field to the value that was provided on create.
+func (u *ChatScheduleUpsert) UpdateLastWindowEnd() *ChatScheduleUpsert {
+	u.SetExcluded(chatschedule.FieldLastWindowEnd)
+	return u
+}
+
+// AddLastWindowEnd adds v to the "last_window_end" field.
+func (u *ChatScheduleUpsert) AddLastWindowEnd(v int64) *ChatScheduleUpsert {
+	u.Add(chatschedule.FieldLastWindowEnd, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatScheduleUpsert) SetUpdatedAt(v int64) *ChatScheduleUpsert {
+	u.Set(chatschedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatScheduleUpsert) UpdateUpdatedAt() *ChatScheduleUpsert {
+	u.SetExcluded(chatschedule.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChatScheduleUpsert) AddUpdatedAt(v int64) *ChatScheduleUpsert {
+	u.Add(chatschedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ChatSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatScheduleUpsertOne) UpdateNewValues() *ChatScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(chatschedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(chatschedule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatSchedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChatScheduleUpsertOne) Ignore() *ChatScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatScheduleUpsertOne) DoNothing() *ChatScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatScheduleCreate.OnConflict
+// documentation for more info.
+func (u *ChatScheduleUpsertOne) Update(set func(*ChatScheduleUpsert)) *ChatScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatScheduleUpsertOne) SetWorkspaceID(v uuid.UUID) *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatScheduleUpsertOne) UpdateWorkspaceID() *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatScheduleUpsertOne) SetChatID(v string) *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatScheduleUpsertOne) UpdateChatID() *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetCron sets the "cron" field.
+func (u *ChatScheduleUpsertOne) SetCron(v string) *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetCron(v)
+	})
+}
+
+// UpdateCron sets the "cron" field to the value that was provided on create.
+func (u *ChatScheduleUpsertOne) UpdateCron() *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateCron()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ChatScheduleUpsertOne) SetEnabled(v bool) *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ChatScheduleUpsertOne) UpdateEnabled() *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastWindowEnd sets the "last_window_end" field.
+func (u *ChatScheduleUpsertOne) SetLastWindowEnd(v int64) *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetLastWindowEnd(v)
+	})
+}
+
+// AddLastWindowEnd adds v to the "last_window_end" field.
+func (u *ChatScheduleUpsertOne) AddLastWindowEnd(v int64) *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.AddLastWindowEnd(v)
+	})
+}
+
+// UpdateLastWindowEnd sets the "last_window_end" field to the value that was provided on create.
+func (u *ChatScheduleUpsertOne) UpdateLastWindowEnd() *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateLastWindowEnd()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatScheduleUpsertOne) SetUpdatedAt(v int64) *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChatScheduleUpsertOne) AddUpdatedAt(v int64) *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatScheduleUpsertOne) UpdateUpdatedAt() *ChatScheduleUpsertOne {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChatScheduleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChatScheduleUpsertOne.ID is not supported by MySQL driver. Use ChatScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChatScheduleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChatScheduleCreateBulk is the builder for creating many ChatSchedule entities in bulk.
+type ChatScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*ChatScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ChatSchedule entities in the database.
+func (_c *ChatScheduleCreateBulk) Save(ctx context.Context) ([]*ChatSchedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChatSchedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChatScheduleCreateBulk) SaveX(ctx context.Context) []*ChatSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatSchedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatScheduleUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChatScheduleUpsertBulk {
+	_c.conflict = opts
+	return &ChatScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatScheduleCreateBulk) OnConflictColumns(columns ...string) *ChatScheduleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// ChatScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChatSchedule nodes.
+type ChatScheduleUpsertBulk struct {
+	create *ChatScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChatSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatScheduleUpsertBulk) UpdateNewValues() *ChatScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(chatschedule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(chatschedule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatSchedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChatScheduleUpsertBulk) Ignore() *ChatScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatScheduleUpsertBulk) DoNothing() *ChatScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChatScheduleUpsertBulk) Update(set func(*ChatScheduleUpsert)) *ChatScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ChatScheduleUpsertBulk) SetWorkspaceID(v uuid.UUID) *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ChatScheduleUpsertBulk) UpdateWorkspaceID() *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatScheduleUpsertBulk) SetChatID(v string) *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatScheduleUpsertBulk) UpdateChatID() *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetCron sets the "cron" field.
+func (u *ChatScheduleUpsertBulk) SetCron(v string) *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetCron(v)
+	})
+}
+
+// UpdateCron sets the "cron" field to the value that was provided on create.
+func (u *ChatScheduleUpsertBulk) UpdateCron() *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateCron()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ChatScheduleUpsertBulk) SetEnabled(v bool) *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ChatScheduleUpsertBulk) UpdateEnabled() *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastWindowEnd sets the "last_window_end" field.
+func (u *ChatScheduleUpsertBulk) SetLastWindowEnd(v int64) *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetLastWindowEnd(v)
+	})
+}
+
+// AddLastWindowEnd adds v to the "last_window_end" field.
+func (u *ChatScheduleUpsertBulk) AddLastWindowEnd(v int64) *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.AddLastWindowEnd(v)
+	})
+}
+
+// UpdateLastWindowEnd sets the "last_window_end" field to the value that was provided on create.
+func (u *ChatScheduleUpsertBulk) UpdateLastWindowEnd() *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateLastWindowEnd()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatScheduleUpsertBulk) SetUpdatedAt(v int64) *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChatScheduleUpsertBulk) AddUpdatedAt(v int64) *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatScheduleUpsertBulk) UpdateUpdatedAt() *ChatScheduleUpsertBulk {
+	return u.Update(func(s *ChatScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChatScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/chatschedule"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ChatScheduleUpdate is the builder for updating ChatSchedule entities.
+type ChatScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChatScheduleMutation
+}
+
+// Where appends a list predicates to the ChatScheduleUpdate builder.
+func (_u *ChatScheduleUpdate) Where(ps ...predicate.ChatSchedule) *ChatScheduleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *ChatScheduleUpdate) SetWorkspaceID(v uuid.UUID) *ChatScheduleUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *ChatScheduleUpdate) SetNillableWorkspaceID(v *uuid.UUID) *ChatScheduleUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatScheduleUpdate) SetChatID(v string) *ChatScheduleUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatScheduleUpdate) SetNillableChatID(v *string) *ChatScheduleUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetCron sets the "cron" field.
+func (_u *ChatScheduleUpdate) SetCron(v string) *ChatScheduleUpdate {
+	_u.mutation.SetCron(v)
+	return _u
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (_u *ChatScheduleUpdate) SetNillableCron(v *string) *ChatScheduleUpdate {
+	if v != nil {
+		_u.SetCron(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ChatScheduleUpdate) SetEnabled(v bool) *ChatScheduleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ChatScheduleUpdate) SetNillableEnabled(v *bool) *ChatScheduleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetLastWindowEnd sets the "last_window_end" field.
+func (_u *ChatScheduleUpdate) SetLastWindowEnd(v int64) *ChatScheduleUpdate {
+	_u.mutation.ResetLastWindowEnd()
+	_u.mutation.SetLastWindowEnd(v)
+	return _u
+}
+
+// SetNillableLastWindowEnd sets the "last_window_end" field if the given value is not nil.
+func (_u *ChatScheduleUpdate) SetNillableLastWindowEnd(v *int64) *ChatScheduleUpdate {
+	if v != nil {
+		_u.SetLastWindowEnd(*v)
+	}
+	return _u
+}
+
+// AddLastWindowEnd adds value to the "last_window_end" field.
+func (_u *ChatScheduleUpdate) AddLastWindowEnd(v int64) *ChatScheduleUpdate {
+	_u.mutation.AddLastWindowEnd(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatScheduleUpdate) SetUpdatedAt(v int64) *ChatScheduleUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *ChatScheduleUpdate) AddUpdatedAt(v int64) *ChatScheduleUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ChatScheduleMutation object of the builder.
+func (_u *ChatScheduleUpdate) Mutation() *ChatScheduleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatScheduleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatScheduleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatScheduleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ChatScheduleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(chatschedule.Table, chatschedule.Columns, sqlgraph.NewFieldSpec(chatschedule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatschedule.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(chatschedule.FieldChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Cron(); ok {
+		_spec.SetField(chatschedule.FieldCron, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(chatschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastWindowEnd(); ok {
+		_spec.SetField(chatschedule.FieldLastWindowEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastWindowEnd(); ok {
+		_spec.AddField(chatschedule.FieldLastWindowEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatschedule.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(chatschedule.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.ChatSchedule
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatScheduleUpdateOne is the builder for updating a single ChatSchedule entity.
+type ChatScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChatScheduleMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *ChatScheduleUpdateOne) SetWorkspaceID(v uuid.UUID) *ChatScheduleUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *ChatScheduleUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *ChatScheduleUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatScheduleUpdateOne) SetChatID(v string) *ChatScheduleUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatScheduleUpdateOne) SetNillableChatID(v *string) *ChatScheduleUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetCron sets the "cron" field.
+func (_u *ChatScheduleUpdateOne) SetCron(v string) *ChatScheduleUpdateOne {
+	_u.mutation.SetCron(v)
+	return _u
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (_u *ChatScheduleUpdateOne) SetNillableCron(v *string) *ChatScheduleUpdateOne {
+	if v != nil {
+		_u.SetCron(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ChatScheduleUpdateOne) SetEnabled(v bool) *ChatScheduleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ChatScheduleUpdateOne) SetNillableEnabled(v *bool) *ChatScheduleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetLastWindowEnd sets the "last_window_end" field.
+func (_u *ChatScheduleUpdateOne) SetLastWindowEnd(v int64) *ChatScheduleUpdateOne {
+	_u.mutation.ResetLastWindowEnd()
+	_u.mutation.SetLastWindowEnd(v)
+	return _u
+}
+
+// SetNillableLastWindowEnd sets the "last_window_end" field if the given value is not nil.
+func (_u *ChatScheduleUpdateOne) SetNillableLastWindowEnd(v *int64) *ChatScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastWindowEnd(*v)
+	}
+	return _u
+}
+
+// AddLastWindowEnd adds value to the "last_window_end" field.
+func (_u *ChatScheduleUpdateOne) AddLastWindowEnd(v int64) *ChatScheduleUpdateOne {
+	_u.mutation.AddLastWindowEnd(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatScheduleUpdateOne) SetUpdatedAt(v int64) *ChatScheduleUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *ChatScheduleUpdateOne) AddUpdatedAt(v int64) *ChatScheduleUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ChatScheduleMutation object of the builder.
+func (_u *ChatScheduleUpdateOne) Mutation() *ChatScheduleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChatScheduleUpdate builder.
+func (_u *ChatScheduleUpdateOne) Where(ps ...predicate.ChatSchedule) *ChatScheduleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatScheduleUpdateOne) Select(field string, fields ...string) *ChatScheduleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChatSchedule entity.
+func (_u *ChatScheduleUpdateOne) Save(ctx context.Context) (*ChatSchedule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatScheduleUpdateOne) SaveX(ctx context.Context) *ChatSchedule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatScheduleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ChatScheduleUpdateOne) sqlSave(ctx context.Context) (_node *ChatSchedule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(chatschedule.Table, chatschedule.Columns, sqlgraph.NewFieldSpec(chatschedule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatschedule.FieldID)
+		for _, f := range fields {
+			if !chatschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(chatschedule.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(chatschedule.FieldChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Cron(); ok {
+		_spec.SetField(chatschedule.FieldCron, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(chatschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastWindowEnd(); ok {
+		_spec.SetField(chatschedule.FieldLastWindowEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastWindowEnd(); ok {
+		_spec.AddField(chatschedule.FieldLastWindowEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatschedule.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(chatschedule.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.ChatSchedule
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &ChatSchedule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -16,10 +16,26 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/luoling8192/mindwave/ent/accesslog"
+	"github.com/luoling8192/mindwave/ent/apikey"
+	"github.com/luoling8192/mindwave/ent/askturn"
+	"github.com/luoling8192/mindwave/ent/chatgrant"
 	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/chatretention"
+	"github.com/luoling8192/mindwave/ent/chatschedule"
+	"github.com/luoling8192/mindwave/ent/digestdelivery"
+	"github.com/luoling8192/mindwave/ent/distillrun"
 	"github.com/luoling8192/mindwave/ent/event"
 	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/job"
 	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/ent/llmcacheentry"
+	"github.com/luoling8192/mindwave/ent/owneraccountlink"
+	"github.com/luoling8192/mindwave/ent/person"
+	"github.com/luoling8192/mindwave/ent/personauditlog"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/ent/summary"
+	"github.com/luoling8192/mindwave/ent/workspace"
 
 	stdsql "database/sql"
 
@@ -31,14 +47,46 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// AccessLog is the client for interacting with the AccessLog builders.
+	AccessLog *AccessLogClient
+	// AskTurn is the client for interacting with the AskTurn builders.
+	AskTurn *AskTurnClient
+	// ChatGrant is the client for interacting with the ChatGrant builders.
+	ChatGrant *ChatGrantClient
 	// ChatMessage is the client for interacting with the ChatMessage builders.
 	ChatMessage *ChatMessageClient
+	// ChatRetention is the client for interacting with the ChatRetention builders.
+	ChatRetention *ChatRetentionClient
+	// ChatSchedule is the client for interacting with the ChatSchedule builders.
+	ChatSchedule *ChatScheduleClient
+	// DigestDelivery is the client for interacting with the DigestDelivery builders.
+	DigestDelivery *DigestDeliveryClient
+	// DistillRun is the client for interacting with the DistillRun builders.
+	DistillRun *DistillRunClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
 	// Identity is the client for interacting with the Identity builders.
 	Identity *IdentityClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// JoinedChat is the client for interacting with the JoinedChat builders.
 	JoinedChat *JoinedChatClient
+	// LLMCacheEntry is the client for interacting with the LLMCacheEntry builders.
+	LLMCacheEntry *LLMCacheEntryClient
+	// OwnerAccountLink is the client for interacting with the OwnerAccountLink builders.
+	OwnerAccountLink *OwnerAccountLinkClient
+	// Person is the client for interacting with the Person builders.
+	Person *PersonClient
+	// PersonAuditLog is the client for interacting with the PersonAuditLog builders.
+	PersonAuditLog *PersonAuditLogClient
+	// Profile is the client for interacting with the Profile builders.
+	Profile *ProfileClient
+	// Summary is the client for interacting with the Summary builders.
+	Summary *SummaryClient
+	// Workspace is the client for interacting with the Workspace builders.
+	Workspace *WorkspaceClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -50,10 +98,26 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
+	c.AccessLog = NewAccessLogClient(c.config)
+	c.AskTurn = NewAskTurnClient(c.config)
+	c.ChatGrant = NewChatGrantClient(c.config)
 	c.ChatMessage = NewChatMessageClient(c.config)
+	c.ChatRetention = NewChatRetentionClient(c.config)
+	c.ChatSchedule = NewChatScheduleClient(c.config)
+	c.DigestDelivery = NewDigestDeliveryClient(c.config)
+	c.DistillRun = NewDistillRunClient(c.config)
 	c.Event = NewEventClient(c.config)
 	c.Identity = NewIdentityClient(c.config)
+	c.Job = NewJobClient(c.config)
 	c.JoinedChat = NewJoinedChatClient(c.config)
+	c.LLMCacheEntry = NewLLMCacheEntryClient(c.config)
+	c.OwnerAccountLink = NewOwnerAccountLinkClient(c.config)
+	c.Person = NewPersonClient(c.config)
+	c.PersonAuditLog = NewPersonAuditLogClient(c.config)
+	c.Profile = NewProfileClient(c.config)
+	c.Summary = NewSummaryClient(c.config)
+	c.Workspace = NewWorkspaceClient(c.config)
 }
 
 type (
@@ -146,12 +210,28 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		ChatMessage: NewChatMessageClient(cfg),
-		Event:       NewEventClient(cfg),
-		Identity:    NewIdentityClient(cfg),
-		JoinedChat:  NewJoinedChatClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		APIKey:           NewAPIKeyClient(cfg),
+		AccessLog:        NewAccessLogClient(cfg),
+		AskTurn:          NewAskTurnClient(cfg),
+		ChatGrant:        NewChatGrantClient(cfg),
+		ChatMessage:      NewChatMessageClient(cfg),
+		ChatRetention:    NewChatRetentionClient(cfg),
+		ChatSchedule:     NewChatScheduleClient(cfg),
+		DigestDelivery:   NewDigestDeliveryClient(cfg),
+		DistillRun:       NewDistillRunClient(cfg),
+		Event:            NewEventClient(cfg),
+		Identity:         NewIdentityClient(cfg),
+		Job:              NewJobClient(cfg),
+		JoinedChat:       NewJoinedChatClient(cfg),
+		LLMCacheEntry:    NewLLMCacheEntryClient(cfg),
+		OwnerAccountLink: NewOwnerAccountLinkClient(cfg),
+		Person:           NewPersonClient(cfg),
+		PersonAuditLog:   NewPersonAuditLogClient(cfg),
+		Profile:          NewProfileClient(cfg),
+		Summary:          NewSummaryClient(cfg),
+		Workspace:        NewWorkspaceClient(cfg),
 	}, nil
 }
 
@@ -169,19 +249,35 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		ChatMessage: NewChatMessageClient(cfg),
-		Event:       NewEventClient(cfg),
-		Identity:    NewIdentityClient(cfg),
-		JoinedChat:  NewJoinedChatClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		APIKey:           NewAPIKeyClient(cfg),
+		AccessLog:        NewAccessLogClient(cfg),
+		AskTurn:          NewAskTurnClient(cfg),
+		ChatGrant:        NewChatGrantClient(cfg),
+		ChatMessage:      NewChatMessageClient(cfg),
+		ChatRetention:    NewChatRetentionClient(cfg),
+		ChatSchedule:     NewChatScheduleClient(cfg),
+		DigestDelivery:   NewDigestDeliveryClient(cfg),
+		DistillRun:       NewDistillRunClient(cfg),
+		Event:            NewEventClient(cfg),
+		Identity:         NewIdentityClient(cfg),
+		Job:              NewJobClient(cfg),
+		JoinedChat:       NewJoinedChatClient(cfg),
+		LLMCacheEntry:    NewLLMCacheEntryClient(cfg),
+		OwnerAccountLink: NewOwnerAccountLinkClient(cfg),
+		Person:           NewPersonClient(cfg),
+		PersonAuditLog:   NewPersonAuditLogClient(cfg),
+		Profile:          NewProfileClient(cfg),
+		Summary:          NewSummaryClient(cfg),
+		Workspace:        NewWorkspaceClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ChatMessage.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -203,138 +299,2439 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.ChatMessage.Use(hooks...)
-	c.Event.Use(hooks...)
-	c.Identity.Use(hooks...)
-	c.JoinedChat.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.APIKey, c.AccessLog, c.AskTurn, c.ChatGrant, c.ChatMessage, c.ChatRetention,
+		c.ChatSchedule, c.DigestDelivery, c.DistillRun, c.Event, c.Identity, c.Job,
+		c.JoinedChat, c.LLMCacheEntry, c.OwnerAccountLink, c.Person, c.PersonAuditLog,
+		c.Profile, c.Summary, c.Workspace,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.ChatMessage.Intercept(interceptors...)
-	c.Event.Intercept(interceptors...)
-	c.Identity.Intercept(interceptors...)
-	c.JoinedChat.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.APIKey, c.AccessLog, c.AskTurn, c.ChatGrant, c.ChatMessage, c.ChatRetention,
+		c.ChatSchedule, c.DigestDelivery, c.DistillRun, c.Event, c.Identity, c.Job,
+		c.JoinedChat, c.LLMCacheEntry, c.OwnerAccountLink, c.Person, c.PersonAuditLog,
+		c.Profile, c.Summary, c.Workspace,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
+	case *AccessLogMutation:
+		return c.AccessLog.mutate(ctx, m)
+	case *AskTurnMutation:
+		return c.AskTurn.mutate(ctx, m)
+	case *ChatGrantMutation:
+		return c.ChatGrant.mutate(ctx, m)
 	case *ChatMessageMutation:
 		return c.ChatMessage.mutate(ctx, m)
+	case *ChatRetentionMutation:
+		return c.ChatRetention.mutate(ctx, m)
+	case *ChatScheduleMutation:
+		return c.ChatSchedule.mutate(ctx, m)
+	case *DigestDeliveryMutation:
+		return c.DigestDelivery.mutate(ctx, m)
+	case *DistillRunMutation:
+		return c.DistillRun.mutate(ctx, m)
 	case *EventMutation:
 		return c.Event.mutate(ctx, m)
 	case *IdentityMutation:
 		return c.Identity.mutate(ctx, m)
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
 	case *JoinedChatMutation:
 		return c.JoinedChat.mutate(ctx, m)
+	case *LLMCacheEntryMutation:
+		return c.LLMCacheEntry.mutate(ctx, m)
+	case *OwnerAccountLinkMutation:
+		return c.OwnerAccountLink.mutate(ctx, m)
+	case *PersonMutation:
+		return c.Person.mutate(ctx, m)
+	case *PersonAuditLogMutation:
+		return c.PersonAuditLog.mutate(ctx, m)
+	case *ProfileMutation:
+		return c.Profile.mutate(ctx, m)
+	case *SummaryMutation:
+		return c.Summary.mutate(ctx, m)
+	case *WorkspaceMutation:
+		return c.Workspace.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// ChatMessageClient is a client for the ChatMessage schema.
-type ChatMessageClient struct {
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
+	config
+}
+
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
+}
+
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeyClient) UpdateOne(_m *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(_m))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeyClient) UpdateOneID(id uuid.UUID) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeyClient) DeleteOne(_m *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeyClient) DeleteOneID(id uuid.UUID) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id uuid.UUID) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeyClient) GetX(ctx context.Context, id uuid.UUID) *APIKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeyClient) Hooks() []Hook {
+	return c.hooks.APIKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
+}
+
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIKey mutation op: %q", m.Op())
+	}
+}
+
+// AccessLogClient is a client for the AccessLog schema.
+type AccessLogClient struct {
+	config
+}
+
+// NewAccessLogClient returns a client for the AccessLog from the given config.
+func NewAccessLogClient(c config) *AccessLogClient {
+	return &AccessLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accesslog.Hooks(f(g(h())))`.
+func (c *AccessLogClient) Use(hooks ...Hook) {
+	c.hooks.AccessLog = append(c.hooks.AccessLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accesslog.Intercept(f(g(h())))`.
+func (c *AccessLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessLog = append(c.inters.AccessLog, interceptors...)
+}
+
+// Create returns a builder for creating a AccessLog entity.
+func (c *AccessLogClient) Create() *AccessLogCreate {
+	mutation := newAccessLogMutation(c.config, OpCreate)
+	return &AccessLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccessLog entities.
+func (c *AccessLogClient) CreateBulk(builders ...*AccessLogCreate) *AccessLogCreateBulk {
+	return &AccessLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccessLogClient) MapCreateBulk(slice any, setFunc func(*AccessLogCreate, int)) *AccessLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccessLogCreateBulk{err: fmt.Errorf("calling to AccessLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccessLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccessLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccessLog.
+func (c *AccessLogClient) Update() *AccessLogUpdate {
+	mutation := newAccessLogMutation(c.config, OpUpdate)
+	return &AccessLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccessLogClient) UpdateOne(_m *AccessLog) *AccessLogUpdateOne {
+	mutation := newAccessLogMutation(c.config, OpUpdateOne, withAccessLog(_m))
+	return &AccessLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccessLogClient) UpdateOneID(id uuid.UUID) *AccessLogUpdateOne {
+	mutation := newAccessLogMutation(c.config, OpUpdateOne, withAccessLogID(id))
+	return &AccessLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccessLog.
+func (c *AccessLogClient) Delete() *AccessLogDelete {
+	mutation := newAccessLogMutation(c.config, OpDelete)
+	return &AccessLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccessLogClient) DeleteOne(_m *AccessLog) *AccessLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccessLogClient) DeleteOneID(id uuid.UUID) *AccessLogDeleteOne {
+	builder := c.Delete().Where(accesslog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccessLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AccessLog.
+func (c *AccessLogClient) Query() *AccessLogQuery {
+	return &AccessLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccessLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccessLog entity by its id.
+func (c *AccessLogClient) Get(ctx context.Context, id uuid.UUID) (*AccessLog, error) {
+	return c.Query().Where(accesslog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccessLogClient) GetX(ctx context.Context, id uuid.UUID) *AccessLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AccessLogClient) Hooks() []Hook {
+	return c.hooks.AccessLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccessLogClient) Interceptors() []Interceptor {
+	return c.inters.AccessLog
+}
+
+func (c *AccessLogClient) mutate(ctx context.Context, m *AccessLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccessLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccessLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccessLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccessLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccessLog mutation op: %q", m.Op())
+	}
+}
+
+// AskTurnClient is a client for the AskTurn schema.
+type AskTurnClient struct {
+	config
+}
+
+// NewAskTurnClient returns a client for the AskTurn from the given config.
+func NewAskTurnClient(c config) *AskTurnClient {
+	return &AskTurnClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `askturn.Hooks(f(g(h())))`.
+func (c *AskTurnClient) Use(hooks ...Hook) {
+	c.hooks.AskTurn = append(c.hooks.AskTurn, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `askturn.Intercept(f(g(h())))`.
+func (c *AskTurnClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AskTurn = append(c.inters.AskTurn, interceptors...)
+}
+
+// Create returns a builder for creating a AskTurn entity.
+func (c *AskTurnClient) Create() *AskTurnCreate {
+	mutation := newAskTurnMutation(c.config, OpCreate)
+	return &AskTurnCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AskTurn entities.
+func (c *AskTurnClient) CreateBulk(builders ...*AskTurnCreate) *AskTurnCreateBulk {
+	return &AskTurnCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AskTurnClient) MapCreateBulk(slice any, setFunc func(*AskTurnCreate, int)) *AskTurnCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AskTurnCreateBulk{err: fmt.Errorf("calling to AskTurnClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AskTurnCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AskTurnCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AskTurn.
+func (c *AskTurnClient) Update() *AskTurnUpdate {
+	mutation := newAskTurnMutation(c.config, OpUpdate)
+	return &AskTurnUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AskTurnClient) UpdateOne(_m *AskTurn) *AskTurnUpdateOne {
+	mutation := newAskTurnMutation(c.config, OpUpdateOne, withAskTurn(_m))
+	return &AskTurnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AskTurnClient) UpdateOneID(id uuid.UUID) *AskTurnUpdateOne {
+	mutation := newAskTurnMutation(c.config, OpUpdateOne, withAskTurnID(id))
+	return &AskTurnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AskTurn.
+func (c *AskTurnClient) Delete() *AskTurnDelete {
+	mutation := newAskTurnMutation(c.config, OpDelete)
+	return &AskTurnDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AskTurnClient) DeleteOne(_m *AskTurn) *AskTurnDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AskTurnClient) DeleteOneID(id uuid.UUID) *AskTurnDeleteOne {
+	builder := c.Delete().Where(askturn.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AskTurnDeleteOne{builder}
+}
+
+// Query returns a query builder for AskTurn.
+func (c *AskTurnClient) Query() *AskTurnQuery {
+	return &AskTurnQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAskTurn},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AskTurn entity by its id.
+func (c *AskTurnClient) Get(ctx context.Context, id uuid.UUID) (*AskTurn, error) {
+	return c.Query().Where(askturn.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AskTurnClient) GetX(ctx context.Context, id uuid.UUID) *AskTurn {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AskTurnClient) Hooks() []Hook {
+	return c.hooks.AskTurn
+}
+
+// Interceptors returns the client interceptors.
+func (c *AskTurnClient) Interceptors() []Interceptor {
+	return c.inters.AskTurn
+}
+
+func (c *AskTurnClient) mutate(ctx context.Context, m *AskTurnMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AskTurnCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AskTurnUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AskTurnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AskTurnDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AskTurn mutation op: %q", m.Op())
+	}
+}
+
+// ChatGrantClient is a client for the ChatGrant schema.
+type ChatGrantClient struct {
+	config
+}
+
+// NewChatGrantClient returns a client for the ChatGrant from the given config.
+func NewChatGrantClient(c config) *ChatGrantClient {
+	return &ChatGrantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chatgrant.Hooks(f(g(h())))`.
+func (c *ChatGrantClient) Use(hooks ...Hook) {
+	c.hooks.ChatGrant = append(c.hooks.ChatGrant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chatgrant.Intercept(f(g(h())))`.
+func (c *ChatGrantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatGrant = append(c.inters.ChatGrant, interceptors...)
+}
+
+// Create returns a builder for creating a ChatGrant entity.
+func (c *ChatGrantClient) Create() *ChatGrantCreate {
+	mutation := newChatGrantMutation(c.config, OpCreate)
+	return &ChatGrantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChatGrant entities.
+func (c *ChatGrantClient) CreateBulk(builders ...*ChatGrantCreate) *ChatGrantCreateBulk {
+	return &ChatGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatGrantClient) MapCreateBulk(slice any, setFunc func(*ChatGrantCreate, int)) *ChatGrantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatGrantCreateBulk{err: fmt.Errorf("calling to ChatGrantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatGrantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChatGrant.
+func (c *ChatGrantClient) Update() *ChatGrantUpdate {
+	mutation := newChatGrantMutation(c.config, OpUpdate)
+	return &ChatGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatGrantClient) UpdateOne(_m *ChatGrant) *ChatGrantUpdateOne {
+	mutation := newChatGrantMutation(c.config, OpUpdateOne, withChatGrant(_m))
+	return &ChatGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatGrantClient) UpdateOneID(id uuid.UUID) *ChatGrantUpdateOne {
+	mutation := newChatGrantMutation(c.config, OpUpdateOne, withChatGrantID(id))
+	return &ChatGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChatGrant.
+func (c *ChatGrantClient) Delete() *ChatGrantDelete {
+	mutation := newChatGrantMutation(c.config, OpDelete)
+	return &ChatGrantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatGrantClient) DeleteOne(_m *ChatGrant) *ChatGrantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatGrantClient) DeleteOneID(id uuid.UUID) *ChatGrantDeleteOne {
+	builder := c.Delete().Where(chatgrant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatGrantDeleteOne{builder}
+}
+
+// Query returns a query builder for ChatGrant.
+func (c *ChatGrantClient) Query() *ChatGrantQuery {
+	return &ChatGrantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChatGrant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChatGrant entity by its id.
+func (c *ChatGrantClient) Get(ctx context.Context, id uuid.UUID) (*ChatGrant, error) {
+	return c.Query().Where(chatgrant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatGrantClient) GetX(ctx context.Context, id uuid.UUID) *ChatGrant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChatGrantClient) Hooks() []Hook {
+	return c.hooks.ChatGrant
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatGrantClient) Interceptors() []Interceptor {
+	return c.inters.ChatGrant
+}
+
+func (c *ChatGrantClient) mutate(ctx context.Context, m *ChatGrantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatGrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatGrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChatGrant mutation op: %q", m.Op())
+	}
+}
+
+// ChatMessageClient is a client for the ChatMessage schema.
+type ChatMessageClient struct {
+	config
+}
+
+// NewChatMessageClient returns a client for the ChatMessage from the given config.
+func NewChatMessageClient(c config) *ChatMessageClient {
+	return &ChatMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chatmessage.Hooks(f(g(h())))`.
+func (c *ChatMessageClient) Use(hooks ...Hook) {
+	c.hooks.ChatMessage = append(c.hooks.ChatMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chatmessage.Intercept(f(g(h())))`.
+func (c *ChatMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatMessage = append(c.inters.ChatMessage, interceptors...)
+}
+
+// Create returns a builder for creating a ChatMessage entity.
+func (c *ChatMessageClient) Create() *ChatMessageCreate {
+	mutation := newChatMessageMutation(c.config, OpCreate)
+	return &ChatMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChatMessage entities.
+func (c *ChatMessageClient) CreateBulk(builders ...*ChatMessageCreate) *ChatMessageCreateBulk {
+	return &ChatMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatMessageClient) MapCreateBulk(slice any, setFunc func(*ChatMessageCreate, int)) *ChatMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatMessageCreateBulk{err: fmt.Errorf("calling to ChatMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChatMessage.
+func (c *ChatMessageClient) Update() *ChatMessageUpdate {
+	mutation := newChatMessageMutation(c.config, OpUpdate)
+	return &ChatMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatMessageClient) UpdateOne(_m *ChatMessage) *ChatMessageUpdateOne {
+	mutation := newChatMessageMutation(c.config, OpUpdateOne, withChatMessage(_m))
+	return &ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatMessageClient) UpdateOneID(id uuid.UUID) *ChatMessageUpdateOne {
+	mutation := newChatMessageMutation(c.config, OpUpdateOne, withChatMessageID(id))
+	return &ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChatMessage.
+func (c *ChatMessageClient) Delete() *ChatMessageDelete {
+	mutation := newChatMessageMutation(c.config, OpDelete)
+	return &ChatMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatMessageClient) DeleteOne(_m *ChatMessage) *ChatMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatMessageClient) DeleteOneID(id uuid.UUID) *ChatMessageDeleteOne {
+	builder := c.Delete().Where(chatmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for ChatMessage.
+func (c *ChatMessageClient) Query() *ChatMessageQuery {
+	return &ChatMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChatMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChatMessage entity by its id.
+func (c *ChatMessageClient) Get(ctx context.Context, id uuid.UUID) (*ChatMessage, error) {
+	return c.Query().Where(chatmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatMessageClient) GetX(ctx context.Context, id uuid.UUID) *ChatMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChatMessageClient) Hooks() []Hook {
+	return c.hooks.ChatMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatMessageClient) Interceptors() []Interceptor {
+	return c.inters.ChatMessage
+}
+
+func (c *ChatMessageClient) mutate(ctx context.Context, m *ChatMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChatMessage mutation op: %q", m.Op())
+	}
+}
+
+// ChatRetentionClient is a client for the ChatRetention schema.
+type ChatRetentionClient struct {
+	config
+}
+
+// NewChatRetentionClient returns a client for the ChatRetention from the given config.
+func NewChatRetentionClient(c config) *ChatRetentionClient {
+	return &ChatRetentionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chatretention.Hooks(f(g(h())))`.
+func (c *ChatRetentionClient) Use(hooks ...Hook) {
+	c.hooks.ChatRetention = append(c.hooks.ChatRetention, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chatretention.Intercept(f(g(h())))`.
+func (c *ChatRetentionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatRetention = append(c.inters.ChatRetention, interceptors...)
+}
+
+// Create returns a builder for creating a ChatRetention entity.
+func (c *ChatRetentionClient) Create() *ChatRetentionCreate {
+	mutation := newChatRetentionMutation(c.config, OpCreate)
+	return &ChatRetentionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChatRetention entities.
+func (c *ChatRetentionClient) CreateBulk(builders ...*ChatRetentionCreate) *ChatRetentionCreateBulk {
+	return &ChatRetentionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatRetentionClient) MapCreateBulk(slice any, setFunc func(*ChatRetentionCreate, int)) *ChatRetentionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatRetentionCreateBulk{err: fmt.Errorf("calling to ChatRetentionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatRetentionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatRetentionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChatRetention.
+func (c *ChatRetentionClient) Update() *ChatRetentionUpdate {
+	mutation := newChatRetentionMutation(c.config, OpUpdate)
+	return &ChatRetentionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatRetentionClient) UpdateOne(_m *ChatRetention) *ChatRetentionUpdateOne {
+	mutation := newChatRetentionMutation(c.config, OpUpdateOne, withChatRetention(_m))
+	return &ChatRetentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatRetentionClient) UpdateOneID(id uuid.UUID) *ChatRetentionUpdateOne {
+	mutation := newChatRetentionMutation(c.config, OpUpdateOne, withChatRetentionID(id))
+	return &ChatRetentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChatRetention.
+func (c *ChatRetentionClient) Delete() *ChatRetentionDelete {
+	mutation := newChatRetentionMutation(c.config, OpDelete)
+	return &ChatRetentionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatRetentionClient) DeleteOne(_m *ChatRetention) *ChatRetentionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatRetentionClient) DeleteOneID(id uuid.UUID) *ChatRetentionDeleteOne {
+	builder := c.Delete().Where(chatretention.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatRetentionDeleteOne{builder}
+}
+
+// Query returns a query builder for ChatRetention.
+func (c *ChatRetentionClient) Query() *ChatRetentionQuery {
+	return &ChatRetentionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChatRetention},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChatRetention entity by its id.
+func (c *ChatRetentionClient) Get(ctx context.Context, id uuid.UUID) (*ChatRetention, error) {
+	return c.Query().Where(chatretention.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatRetentionClient) GetX(ctx context.Context, id uuid.UUID) *ChatRetention {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChatRetentionClient) Hooks() []Hook {
+	return c.hooks.ChatRetention
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatRetentionClient) Interceptors() []Interceptor {
+	return c.inters.ChatRetention
+}
+
+func (c *ChatRetentionClient) mutate(ctx context.Context, m *ChatRetentionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatRetentionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatRetentionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatRetentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatRetentionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChatRetention mutation op: %q", m.Op())
+	}
+}
+
+// ChatScheduleClient is a client for the ChatSchedule schema.
+type ChatScheduleClient struct {
+	config
+}
+
+// NewChatScheduleClient returns a client for the ChatSchedule from the given config.
+func NewChatScheduleClient(c config) *ChatScheduleClient {
+	return &ChatScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chatschedule.Hooks(f(g(h())))`.
+func (c *ChatScheduleClient) Use(hooks ...Hook) {
+	c.hooks.ChatSchedule = append(c.hooks.ChatSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chatschedule.Intercept(f(g(h())))`.
+func (c *ChatScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatSchedule = append(c.inters.ChatSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a ChatSchedule entity.
+func (c *ChatScheduleClient) Create() *ChatScheduleCreate {
+	mutation := newChatScheduleMutation(c.config, OpCreate)
+	return &ChatScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChatSchedule entities.
+func (c *ChatScheduleClient) CreateBulk(builders ...*ChatScheduleCreate) *ChatScheduleCreateBulk {
+	return &ChatScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatScheduleClient) MapCreateBulk(slice any, setFunc func(*ChatScheduleCreate, int)) *ChatScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatScheduleCreateBulk{err: fmt.Errorf("calling to ChatScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChatSchedule.
+func (c *ChatScheduleClient) Update() *ChatScheduleUpdate {
+	mutation := newChatScheduleMutation(c.config, OpUpdate)
+	return &ChatScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatScheduleClient) UpdateOne(_m *ChatSchedule) *ChatScheduleUpdateOne {
+	mutation := newChatScheduleMutation(c.config, OpUpdateOne, withChatSchedule(_m))
+	return &ChatScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatScheduleClient) UpdateOneID(id uuid.UUID) *ChatScheduleUpdateOne {
+	mutation := newChatScheduleMutation(c.config, OpUpdateOne, withChatScheduleID(id))
+	return &ChatScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChatSchedule.
+func (c *ChatScheduleClient) Delete() *ChatScheduleDelete {
+	mutation := newChatScheduleMutation(c.config, OpDelete)
+	return &ChatScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatScheduleClient) DeleteOne(_m *ChatSchedule) *ChatScheduleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatScheduleClient) DeleteOneID(id uuid.UUID) *ChatScheduleDeleteOne {
+	builder := c.Delete().Where(chatschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for ChatSchedule.
+func (c *ChatScheduleClient) Query() *ChatScheduleQuery {
+	return &ChatScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChatSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChatSchedule entity by its id.
+func (c *ChatScheduleClient) Get(ctx context.Context, id uuid.UUID) (*ChatSchedule, error) {
+	return c.Query().Where(chatschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatScheduleClient) GetX(ctx context.Context, id uuid.UUID) *ChatSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChatScheduleClient) Hooks() []Hook {
+	return c.hooks.ChatSchedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatScheduleClient) Interceptors() []Interceptor {
+	return c.inters.ChatSchedule
+}
+
+func (c *ChatScheduleClient) mutate(ctx context.Context, m *ChatScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChatSchedule mutation op: %q", m.Op())
+	}
+}
+
+// DigestDeliveryClient is a client for the DigestDelivery schema.
+type DigestDeliveryClient struct {
+	config
+}
+
+// NewDigestDeliveryClient returns a client for the DigestDelivery from the given config.
+func NewDigestDeliveryClient(c config) *DigestDeliveryClient {
+	return &DigestDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `digestdelivery.Hooks(f(g(h())))`.
+func (c *DigestDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.DigestDelivery = append(c.hooks.DigestDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `digestdelivery.Intercept(f(g(h())))`.
+func (c *DigestDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DigestDelivery = append(c.inters.DigestDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a DigestDelivery entity.
+func (c *DigestDeliveryClient) Create() *DigestDeliveryCreate {
+	mutation := newDigestDeliveryMutation(c.config, OpCreate)
+	return &DigestDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DigestDelivery entities.
+func (c *DigestDeliveryClient) CreateBulk(builders ...*DigestDeliveryCreate) *DigestDeliveryCreateBulk {
+	return &DigestDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DigestDeliveryClient) MapCreateBulk(slice any, setFunc func(*DigestDeliveryCreate, int)) *DigestDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DigestDeliveryCreateBulk{err: fmt.Errorf("calling to DigestDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DigestDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DigestDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DigestDelivery.
+func (c *DigestDeliveryClient) Update() *DigestDeliveryUpdate {
+	mutation := newDigestDeliveryMutation(c.config, OpUpdate)
+	return &DigestDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DigestDeliveryClient) UpdateOne(_m *DigestDelivery) *DigestDeliveryUpdateOne {
+	mutation := newDigestDeliveryMutation(c.config, OpUpdateOne, withDigestDelivery(_m))
+	return &DigestDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DigestDeliveryClient) UpdateOneID(id uuid.UUID) *DigestDeliveryUpdateOne {
+	mutation := newDigestDeliveryMutation(c.config, OpUpdateOne, withDigestDeliveryID(id))
+	return &DigestDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DigestDelivery.
+func (c *DigestDeliveryClient) Delete() *DigestDeliveryDelete {
+	mutation := newDigestDeliveryMutation(c.config, OpDelete)
+	return &DigestDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DigestDeliveryClient) DeleteOne(_m *DigestDelivery) *DigestDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DigestDeliveryClient) DeleteOneID(id uuid.UUID) *DigestDeliveryDeleteOne {
+	builder := c.Delete().Where(digestdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DigestDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for DigestDelivery.
+func (c *DigestDeliveryClient) Query() *DigestDeliveryQuery {
+	return &DigestDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDigestDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DigestDelivery entity by its id.
+func (c *DigestDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*DigestDelivery, error) {
+	return c.Query().Where(digestdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DigestDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *DigestDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DigestDeliveryClient) Hooks() []Hook {
+	return c.hooks.DigestDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *DigestDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.DigestDelivery
+}
+
+func (c *DigestDeliveryClient) mutate(ctx context.Context, m *DigestDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DigestDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DigestDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DigestDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DigestDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DigestDelivery mutation op: %q", m.Op())
+	}
+}
+
+// DistillRunClient is a client for the DistillRun schema.
+type DistillRunClient struct {
+	config
+}
+
+// NewDistillRunClient returns a client for the DistillRun from the given config.
+func NewDistillRunClient(c config) *DistillRunClient {
+	return &DistillRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `distillrun.Hooks(f(g(h())))`.
+func (c *DistillRunClient) Use(hooks ...Hook) {
+	c.hooks.DistillRun = append(c.hooks.DistillRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `distillrun.Intercept(f(g(h())))`.
+func (c *DistillRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DistillRun = append(c.inters.DistillRun, interceptors...)
+}
+
+// Create returns a builder for creating a DistillRun entity.
+func (c *DistillRunClient) Create() *DistillRunCreate {
+	mutation := newDistillRunMutation(c.config, OpCreate)
+	return &DistillRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DistillRun entities.
+func (c *DistillRunClient) CreateBulk(builders ...*DistillRunCreate) *DistillRunCreateBulk {
+	return &DistillRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DistillRunClient) MapCreateBulk(slice any, setFunc func(*DistillRunCreate, int)) *DistillRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DistillRunCreateBulk{err: fmt.Errorf("calling to DistillRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DistillRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DistillRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DistillRun.
+func (c *DistillRunClient) Update() *DistillRunUpdate {
+	mutation := newDistillRunMutation(c.config, OpUpdate)
+	return &DistillRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DistillRunClient) UpdateOne(_m *DistillRun) *DistillRunUpdateOne {
+	mutation := newDistillRunMutation(c.config, OpUpdateOne, withDistillRun(_m))
+	return &DistillRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DistillRunClient) UpdateOneID(id uuid.UUID) *DistillRunUpdateOne {
+	mutation := newDistillRunMutation(c.config, OpUpdateOne, withDistillRunID(id))
+	return &DistillRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DistillRun.
+func (c *DistillRunClient) Delete() *DistillRunDelete {
+	mutation := newDistillRunMutation(c.config, OpDelete)
+	return &DistillRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DistillRunClient) DeleteOne(_m *DistillRun) *DistillRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DistillRunClient) DeleteOneID(id uuid.UUID) *DistillRunDeleteOne {
+	builder := c.Delete().Where(distillrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DistillRunDeleteOne{builder}
+}
+
+// Query returns a query builder for DistillRun.
+func (c *DistillRunClient) Query() *DistillRunQuery {
+	return &DistillRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDistillRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DistillRun entity by its id.
+func (c *DistillRunClient) Get(ctx context.Context, id uuid.UUID) (*DistillRun, error) {
+	return c.Query().Where(distillrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DistillRunClient) GetX(ctx context.Context, id uuid.UUID) *DistillRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DistillRunClient) Hooks() []Hook {
+	return c.hooks.DistillRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *DistillRunClient) Interceptors() []Interceptor {
+	return c.inters.DistillRun
+}
+
+func (c *DistillRunClient) mutate(ctx context.Context, m *DistillRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DistillRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DistillRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DistillRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DistillRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DistillRun mutation op: %q", m.Op())
+	}
+}
+
+// EventClient is a client for the Event schema.
+type EventClient struct {
+	config
+}
+
+// NewEventClient returns a client for the Event from the given config.
+func NewEventClient(c config) *EventClient {
+	return &EventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `event.Hooks(f(g(h())))`.
+func (c *EventClient) Use(hooks ...Hook) {
+	c.hooks.Event = append(c.hooks.Event, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `event.Intercept(f(g(h())))`.
+func (c *EventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Event = append(c.inters.Event, interceptors...)
+}
+
+// Create returns a builder for creating a Event entity.
+func (c *EventClient) Create() *EventCreate {
+	mutation := newEventMutation(c.config, OpCreate)
+	return &EventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Event entities.
+func (c *EventClient) CreateBulk(builders ...*EventCreate) *EventCreateBulk {
+	return &EventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventClient) MapCreateBulk(slice any, setFunc func(*EventCreate, int)) *EventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventCreateBulk{err: fmt.Errorf("calling to EventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Event.
+func (c *EventClient) Update() *EventUpdate {
+	mutation := newEventMutation(c.config, OpUpdate)
+	return &EventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventClient) UpdateOne(_m *Event) *EventUpdateOne {
+	mutation := newEventMutation(c.config, OpUpdateOne, withEvent(_m))
+	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventClient) UpdateOneID(id uuid.UUID) *EventUpdateOne {
+	mutation := newEventMutation(c.config, OpUpdateOne, withEventID(id))
+	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Event.
+func (c *EventClient) Delete() *EventDelete {
+	mutation := newEventMutation(c.config, OpDelete)
+	return &EventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventClient) DeleteOne(_m *Event) *EventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventClient) DeleteOneID(id uuid.UUID) *EventDeleteOne {
+	builder := c.Delete().Where(event.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventDeleteOne{builder}
+}
+
+// Query returns a query builder for Event.
+func (c *EventClient) Query() *EventQuery {
+	return &EventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Event entity by its id.
+func (c *EventClient) Get(ctx context.Context, id uuid.UUID) (*Event, error) {
+	return c.Query().Where(event.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventClient) GetX(ctx context.Context, id uuid.UUID) *Event {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryIdentities queries the identities edge of a Event.
+func (c *EventClient) QueryIdentities(_m *Event) *IdentityQuery {
+	query := (&IdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.To(identity.Table, identity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, event.IdentitiesTable, event.IdentitiesPrimaryKey...),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.Identity
+		step.Edge.Schema = schemaConfig.IdentityEvents
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContinues queries the continues edge of a Event.
+func (c *EventClient) QueryContinues(_m *Event) *EventQuery {
+	query := (&EventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, event.ContinuesTable, event.ContinuesColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.Event
+		step.Edge.Schema = schemaConfig.Event
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContinuations queries the continuations edge of a Event.
+func (c *EventClient) QueryContinuations(_m *Event) *EventQuery {
+	query := (&EventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.ContinuationsTable, event.ContinuationsColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.Event
+		step.Edge.Schema = schemaConfig.Event
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EventClient) Hooks() []Hook {
+	return c.hooks.Event
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventClient) Interceptors() []Interceptor {
+	return c.inters.Event
+}
+
+func (c *EventClient) mutate(ctx context.Context, m *EventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Event mutation op: %q", m.Op())
+	}
+}
+
+// IdentityClient is a client for the Identity schema.
+type IdentityClient struct {
+	config
+}
+
+// NewIdentityClient returns a client for the Identity from the given config.
+func NewIdentityClient(c config) *IdentityClient {
+	return &IdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `identity.Hooks(f(g(h())))`.
+func (c *IdentityClient) Use(hooks ...Hook) {
+	c.hooks.Identity = append(c.hooks.Identity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `identity.Intercept(f(g(h())))`.
+func (c *IdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Identity = append(c.inters.Identity, interceptors...)
+}
+
+// Create returns a builder for creating a Identity entity.
+func (c *IdentityClient) Create() *IdentityCreate {
+	mutation := newIdentityMutation(c.config, OpCreate)
+	return &IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Identity entities.
+func (c *IdentityClient) CreateBulk(builders ...*IdentityCreate) *IdentityCreateBulk {
+	return &IdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdentityClient) MapCreateBulk(slice any, setFunc func(*IdentityCreate, int)) *IdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdentityCreateBulk{err: fmt.Errorf("calling to IdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Identity.
+func (c *IdentityClient) Update() *IdentityUpdate {
+	mutation := newIdentityMutation(c.config, OpUpdate)
+	return &IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdentityClient) UpdateOne(_m *Identity) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentity(_m))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdentityClient) UpdateOneID(id uuid.UUID) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentityID(id))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Identity.
+func (c *IdentityClient) Delete() *IdentityDelete {
+	mutation := newIdentityMutation(c.config, OpDelete)
+	return &IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdentityClient) DeleteOne(_m *Identity) *IdentityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdentityClient) DeleteOneID(id uuid.UUID) *IdentityDeleteOne {
+	builder := c.Delete().Where(identity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for Identity.
+func (c *IdentityClient) Query() *IdentityQuery {
+	return &IdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Identity entity by its id.
+func (c *IdentityClient) Get(ctx context.Context, id uuid.UUID) (*Identity, error) {
+	return c.Query().Where(identity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdentityClient) GetX(ctx context.Context, id uuid.UUID) *Identity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEvents queries the events edge of a Identity.
+func (c *IdentityClient) QueryEvents(_m *Identity) *EventQuery {
+	query := (&EventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identity.Table, identity.FieldID, id),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, identity.EventsTable, identity.EventsPrimaryKey...),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.Event
+		step.Edge.Schema = schemaConfig.IdentityEvents
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfiles queries the profiles edge of a Identity.
+func (c *IdentityClient) QueryProfiles(_m *Identity) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identity.Table, identity.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, identity.ProfilesTable, identity.ProfilesColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.Profile
+		step.Edge.Schema = schemaConfig.Profile
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPerson queries the person edge of a Identity.
+func (c *IdentityClient) QueryPerson(_m *Identity) *PersonQuery {
+	query := (&PersonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identity.Table, identity.FieldID, id),
+			sqlgraph.To(person.Table, person.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, identity.PersonTable, identity.PersonColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.Person
+		step.Edge.Schema = schemaConfig.Identity
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IdentityClient) Hooks() []Hook {
+	return c.hooks.Identity
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdentityClient) Interceptors() []Interceptor {
+	return c.inters.Identity
+}
+
+func (c *IdentityClient) mutate(ctx context.Context, m *IdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Identity mutation op: %q", m.Op())
+	}
+}
+
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(_m *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(_m))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id uuid.UUID) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(_m *Job) *JobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id uuid.UUID) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id uuid.UUID) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id uuid.UUID) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
+// JoinedChatClient is a client for the JoinedChat schema.
+type JoinedChatClient struct {
+	config
+}
+
+// NewJoinedChatClient returns a client for the JoinedChat from the given config.
+func NewJoinedChatClient(c config) *JoinedChatClient {
+	return &JoinedChatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `joinedchat.Hooks(f(g(h())))`.
+func (c *JoinedChatClient) Use(hooks ...Hook) {
+	c.hooks.JoinedChat = append(c.hooks.JoinedChat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `joinedchat.Intercept(f(g(h())))`.
+func (c *JoinedChatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JoinedChat = append(c.inters.JoinedChat, interceptors...)
+}
+
+// Create returns a builder for creating a JoinedChat entity.
+func (c *JoinedChatClient) Create() *JoinedChatCreate {
+	mutation := newJoinedChatMutation(c.config, OpCreate)
+	return &JoinedChatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JoinedChat entities.
+func (c *JoinedChatClient) CreateBulk(builders ...*JoinedChatCreate) *JoinedChatCreateBulk {
+	return &JoinedChatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JoinedChatClient) MapCreateBulk(slice any, setFunc func(*JoinedChatCreate, int)) *JoinedChatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JoinedChatCreateBulk{err: fmt.Errorf("calling to JoinedChatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JoinedChatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JoinedChatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JoinedChat.
+func (c *JoinedChatClient) Update() *JoinedChatUpdate {
+	mutation := newJoinedChatMutation(c.config, OpUpdate)
+	return &JoinedChatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JoinedChatClient) UpdateOne(_m *JoinedChat) *JoinedChatUpdateOne {
+	mutation := newJoinedChatMutation(c.config, OpUpdateOne, withJoinedChat(_m))
+	return &JoinedChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JoinedChatClient) UpdateOneID(id uuid.UUID) *JoinedChatUpdateOne {
+	mutation := newJoinedChatMutation(c.config, OpUpdateOne, withJoinedChatID(id))
+	return &JoinedChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JoinedChat.
+func (c *JoinedChatClient) Delete() *JoinedChatDelete {
+	mutation := newJoinedChatMutation(c.config, OpDelete)
+	return &JoinedChatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JoinedChatClient) DeleteOne(_m *JoinedChat) *JoinedChatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JoinedChatClient) DeleteOneID(id uuid.UUID) *JoinedChatDeleteOne {
+	builder := c.Delete().Where(joinedchat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JoinedChatDeleteOne{builder}
+}
+
+// Query returns a query builder for JoinedChat.
+func (c *JoinedChatClient) Query() *JoinedChatQuery {
+	return &JoinedChatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJoinedChat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JoinedChat entity by its id.
+func (c *JoinedChatClient) Get(ctx context.Context, id uuid.UUID) (*JoinedChat, error) {
+	return c.Query().Where(joinedchat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JoinedChatClient) GetX(ctx context.Context, id uuid.UUID) *JoinedChat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JoinedChatClient) Hooks() []Hook {
+	return c.hooks.JoinedChat
+}
+
+// Interceptors returns the client interceptors.
+func (c *JoinedChatClient) Interceptors() []Interceptor {
+	return c.inters.JoinedChat
+}
+
+func (c *JoinedChatClient) mutate(ctx context.Context, m *JoinedChatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JoinedChatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JoinedChatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JoinedChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JoinedChatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JoinedChat mutation op: %q", m.Op())
+	}
+}
+
+// LLMCacheEntryClient is a client for the LLMCacheEntry schema.
+type LLMCacheEntryClient struct {
+	config
+}
+
+// NewLLMCacheEntryClient returns a client for the LLMCacheEntry from the given config.
+func NewLLMCacheEntryClient(c config) *LLMCacheEntryClient {
+	return &LLMCacheEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `llmcacheentry.Hooks(f(g(h())))`.
+func (c *LLMCacheEntryClient) Use(hooks ...Hook) {
+	c.hooks.LLMCacheEntry = append(c.hooks.LLMCacheEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `llmcacheentry.Intercept(f(g(h())))`.
+func (c *LLMCacheEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LLMCacheEntry = append(c.inters.LLMCacheEntry, interceptors...)
+}
+
+// Create returns a builder for creating a LLMCacheEntry entity.
+func (c *LLMCacheEntryClient) Create() *LLMCacheEntryCreate {
+	mutation := newLLMCacheEntryMutation(c.config, OpCreate)
+	return &LLMCacheEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LLMCacheEntry entities.
+func (c *LLMCacheEntryClient) CreateBulk(builders ...*LLMCacheEntryCreate) *LLMCacheEntryCreateBulk {
+	return &LLMCacheEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LLMCacheEntryClient) MapCreateBulk(slice any, setFunc func(*LLMCacheEntryCreate, int)) *LLMCacheEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LLMCacheEntryCreateBulk{err: fmt.Errorf("calling to LLMCacheEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LLMCacheEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LLMCacheEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LLMCacheEntry.
+func (c *LLMCacheEntryClient) Update() *LLMCacheEntryUpdate {
+	mutation := newLLMCacheEntryMutation(c.config, OpUpdate)
+	return &LLMCacheEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LLMCacheEntryClient) UpdateOne(_m *LLMCacheEntry) *LLMCacheEntryUpdateOne {
+	mutation := newLLMCacheEntryMutation(c.config, OpUpdateOne, withLLMCacheEntry(_m))
+	return &LLMCacheEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LLMCacheEntryClient) UpdateOneID(id uuid.UUID) *LLMCacheEntryUpdateOne {
+	mutation := newLLMCacheEntryMutation(c.config, OpUpdateOne, withLLMCacheEntryID(id))
+	return &LLMCacheEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LLMCacheEntry.
+func (c *LLMCacheEntryClient) Delete() *LLMCacheEntryDelete {
+	mutation := newLLMCacheEntryMutation(c.config, OpDelete)
+	return &LLMCacheEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LLMCacheEntryClient) DeleteOne(_m *LLMCacheEntry) *LLMCacheEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LLMCacheEntryClient) DeleteOneID(id uuid.UUID) *LLMCacheEntryDeleteOne {
+	builder := c.Delete().Where(llmcacheentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LLMCacheEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for LLMCacheEntry.
+func (c *LLMCacheEntryClient) Query() *LLMCacheEntryQuery {
+	return &LLMCacheEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLLMCacheEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LLMCacheEntry entity by its id.
+func (c *LLMCacheEntryClient) Get(ctx context.Context, id uuid.UUID) (*LLMCacheEntry, error) {
+	return c.Query().Where(llmcacheentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LLMCacheEntryClient) GetX(ctx context.Context, id uuid.UUID) *LLMCacheEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LLMCacheEntryClient) Hooks() []Hook {
+	return c.hooks.LLMCacheEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *LLMCacheEntryClient) Interceptors() []Interceptor {
+	return c.inters.LLMCacheEntry
+}
+
+func (c *LLMCacheEntryClient) mutate(ctx context.Context, m *LLMCacheEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LLMCacheEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LLMCacheEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LLMCacheEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LLMCacheEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LLMCacheEntry mutation op: %q", m.Op())
+	}
+}
+
+// OwnerAccountLinkClient is a client for the OwnerAccountLink schema.
+type OwnerAccountLinkClient struct {
+	config
+}
+
+// NewOwnerAccountLinkClient returns a client for the OwnerAccountLink from the given config.
+func NewOwnerAccountLinkClient(c config) *OwnerAccountLinkClient {
+	return &OwnerAccountLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `owneraccountlink.Hooks(f(g(h())))`.
+func (c *OwnerAccountLinkClient) Use(hooks ...Hook) {
+	c.hooks.OwnerAccountLink = append(c.hooks.OwnerAccountLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `owneraccountlink.Intercept(f(g(h())))`.
+func (c *OwnerAccountLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OwnerAccountLink = append(c.inters.OwnerAccountLink, interceptors...)
+}
+
+// Create returns a builder for creating a OwnerAccountLink entity.
+func (c *OwnerAccountLinkClient) Create() *OwnerAccountLinkCreate {
+	mutation := newOwnerAccountLinkMutation(c.config, OpCreate)
+	return &OwnerAccountLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OwnerAccountLink entities.
+func (c *OwnerAccountLinkClient) CreateBulk(builders ...*OwnerAccountLinkCreate) *OwnerAccountLinkCreateBulk {
+	return &OwnerAccountLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OwnerAccountLinkClient) MapCreateBulk(slice any, setFunc func(*OwnerAccountLinkCreate, int)) *OwnerAccountLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OwnerAccountLinkCreateBulk{err: fmt.Errorf("calling to OwnerAccountLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OwnerAccountLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OwnerAccountLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OwnerAccountLink.
+func (c *OwnerAccountLinkClient) Update() *OwnerAccountLinkUpdate {
+	mutation := newOwnerAccountLinkMutation(c.config, OpUpdate)
+	return &OwnerAccountLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OwnerAccountLinkClient) UpdateOne(_m *OwnerAccountLink) *OwnerAccountLinkUpdateOne {
+	mutation := newOwnerAccountLinkMutation(c.config, OpUpdateOne, withOwnerAccountLink(_m))
+	return &OwnerAccountLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OwnerAccountLinkClient) UpdateOneID(id uuid.UUID) *OwnerAccountLinkUpdateOne {
+	mutation := newOwnerAccountLinkMutation(c.config, OpUpdateOne, withOwnerAccountLinkID(id))
+	return &OwnerAccountLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OwnerAccountLink.
+func (c *OwnerAccountLinkClient) Delete() *OwnerAccountLinkDelete {
+	mutation := newOwnerAccountLinkMutation(c.config, OpDelete)
+	return &OwnerAccountLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OwnerAccountLinkClient) DeleteOne(_m *OwnerAccountLink) *OwnerAccountLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OwnerAccountLinkClient) DeleteOneID(id uuid.UUID) *OwnerAccountLinkDeleteOne {
+	builder := c.Delete().Where(owneraccountlink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OwnerAccountLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for OwnerAccountLink.
+func (c *OwnerAccountLinkClient) Query() *OwnerAccountLinkQuery {
+	return &OwnerAccountLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOwnerAccountLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OwnerAccountLink entity by its id.
+func (c *OwnerAccountLinkClient) Get(ctx context.Context, id uuid.UUID) (*OwnerAccountLink, error) {
+	return c.Query().Where(owneraccountlink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OwnerAccountLinkClient) GetX(ctx context.Context, id uuid.UUID) *OwnerAccountLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OwnerAccountLinkClient) Hooks() []Hook {
+	return c.hooks.OwnerAccountLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *OwnerAccountLinkClient) Interceptors() []Interceptor {
+	return c.inters.OwnerAccountLink
+}
+
+func (c *OwnerAccountLinkClient) mutate(ctx context.Context, m *OwnerAccountLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OwnerAccountLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OwnerAccountLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OwnerAccountLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OwnerAccountLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OwnerAccountLink mutation op: %q", m.Op())
+	}
+}
+
+// PersonClient is a client for the Person schema.
+type PersonClient struct {
+	config
+}
+
+// NewPersonClient returns a client for the Person from the given config.
+func NewPersonClient(c config) *PersonClient {
+	return &PersonClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `person.Hooks(f(g(h())))`.
+func (c *PersonClient) Use(hooks ...Hook) {
+	c.hooks.Person = append(c.hooks.Person, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `person.Intercept(f(g(h())))`.
+func (c *PersonClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Person = append(c.inters.Person, interceptors...)
+}
+
+// Create returns a builder for creating a Person entity.
+func (c *PersonClient) Create() *PersonCreate {
+	mutation := newPersonMutation(c.config, OpCreate)
+	return &PersonCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Person entities.
+func (c *PersonClient) CreateBulk(builders ...*PersonCreate) *PersonCreateBulk {
+	return &PersonCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PersonClient) MapCreateBulk(slice any, setFunc func(*PersonCreate, int)) *PersonCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PersonCreateBulk{err: fmt.Errorf("calling to PersonClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PersonCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PersonCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Person.
+func (c *PersonClient) Update() *PersonUpdate {
+	mutation := newPersonMutation(c.config, OpUpdate)
+	return &PersonUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PersonClient) UpdateOne(_m *Person) *PersonUpdateOne {
+	mutation := newPersonMutation(c.config, OpUpdateOne, withPerson(_m))
+	return &PersonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PersonClient) UpdateOneID(id uuid.UUID) *PersonUpdateOne {
+	mutation := newPersonMutation(c.config, OpUpdateOne, withPersonID(id))
+	return &PersonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Person.
+func (c *PersonClient) Delete() *PersonDelete {
+	mutation := newPersonMutation(c.config, OpDelete)
+	return &PersonDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PersonClient) DeleteOne(_m *Person) *PersonDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PersonClient) DeleteOneID(id uuid.UUID) *PersonDeleteOne {
+	builder := c.Delete().Where(person.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PersonDeleteOne{builder}
+}
+
+// Query returns a query builder for Person.
+func (c *PersonClient) Query() *PersonQuery {
+	return &PersonQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePerson},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Person entity by its id.
+func (c *PersonClient) Get(ctx context.Context, id uuid.UUID) (*Person, error) {
+	return c.Query().Where(person.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PersonClient) GetX(ctx context.Context, id uuid.UUID) *Person {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryIdentities queries the identities edge of a Person.
+func (c *PersonClient) QueryIdentities(_m *Person) *IdentityQuery {
+	query := (&IdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(person.Table, person.FieldID, id),
+			sqlgraph.To(identity.Table, identity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, person.IdentitiesTable, person.IdentitiesColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.Identity
+		step.Edge.Schema = schemaConfig.Identity
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PersonClient) Hooks() []Hook {
+	return c.hooks.Person
+}
+
+// Interceptors returns the client interceptors.
+func (c *PersonClient) Interceptors() []Interceptor {
+	return c.inters.Person
+}
+
+func (c *PersonClient) mutate(ctx context.Context, m *PersonMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PersonCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PersonUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PersonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PersonDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Person mutation op: %q", m.Op())
+	}
+}
+
+// PersonAuditLogClient is a client for the PersonAuditLog schema.
+type PersonAuditLogClient struct {
 	config
 }
 
-// NewChatMessageClient returns a client for the ChatMessage from the given config.
-func NewChatMessageClient(c config) *ChatMessageClient {
-	return &ChatMessageClient{config: c}
+// NewPersonAuditLogClient returns a client for the PersonAuditLog from the given config.
+func NewPersonAuditLogClient(c config) *PersonAuditLogClient {
+	return &PersonAuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `chatmessage.Hooks(f(g(h())))`.
-func (c *ChatMessageClient) Use(hooks ...Hook) {
-	c.hooks.ChatMessage = append(c.hooks.ChatMessage, hooks...)
+// A call to `Use(f, g, h)` equals to `personauditlog.Hooks(f(g(h())))`.
+func (c *PersonAuditLogClient) Use(hooks ...Hook) {
+	c.hooks.PersonAuditLog = append(c.hooks.PersonAuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `chatmessage.Intercept(f(g(h())))`.
-func (c *ChatMessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ChatMessage = append(c.inters.ChatMessage, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `personauditlog.Intercept(f(g(h())))`.
+func (c *PersonAuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PersonAuditLog = append(c.inters.PersonAuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a ChatMessage entity.
-func (c *ChatMessageClient) Create() *ChatMessageCreate {
-	mutation := newChatMessageMutation(c.config, OpCreate)
-	return &ChatMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PersonAuditLog entity.
+func (c *PersonAuditLogClient) Create() *PersonAuditLogCreate {
+	mutation := newPersonAuditLogMutation(c.config, OpCreate)
+	return &PersonAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ChatMessage entities.
-func (c *ChatMessageClient) CreateBulk(builders ...*ChatMessageCreate) *ChatMessageCreateBulk {
-	return &ChatMessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PersonAuditLog entities.
+func (c *PersonAuditLogClient) CreateBulk(builders ...*PersonAuditLogCreate) *PersonAuditLogCreateBulk {
+	return &PersonAuditLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ChatMessageClient) MapCreateBulk(slice any, setFunc func(*ChatMessageCreate, int)) *ChatMessageCreateBulk {
+func (c *PersonAuditLogClient) MapCreateBulk(slice any, setFunc func(*PersonAuditLogCreate, int)) *PersonAuditLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ChatMessageCreateBulk{err: fmt.Errorf("calling to ChatMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PersonAuditLogCreateBulk{err: fmt.Errorf("calling to PersonAuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ChatMessageCreate, rv.Len())
+	builders := make([]*PersonAuditLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ChatMessageCreateBulk{config: c.config, builders: builders}
+	return &PersonAuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ChatMessage.
-func (c *ChatMessageClient) Update() *ChatMessageUpdate {
-	mutation := newChatMessageMutation(c.config, OpUpdate)
-	return &ChatMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PersonAuditLog.
+func (c *PersonAuditLogClient) Update() *PersonAuditLogUpdate {
+	mutation := newPersonAuditLogMutation(c.config, OpUpdate)
+	return &PersonAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ChatMessageClient) UpdateOne(_m *ChatMessage) *ChatMessageUpdateOne {
-	mutation := newChatMessageMutation(c.config, OpUpdateOne, withChatMessage(_m))
-	return &ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PersonAuditLogClient) UpdateOne(_m *PersonAuditLog) *PersonAuditLogUpdateOne {
+	mutation := newPersonAuditLogMutation(c.config, OpUpdateOne, withPersonAuditLog(_m))
+	return &PersonAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ChatMessageClient) UpdateOneID(id uuid.UUID) *ChatMessageUpdateOne {
-	mutation := newChatMessageMutation(c.config, OpUpdateOne, withChatMessageID(id))
-	return &ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PersonAuditLogClient) UpdateOneID(id uuid.UUID) *PersonAuditLogUpdateOne {
+	mutation := newPersonAuditLogMutation(c.config, OpUpdateOne, withPersonAuditLogID(id))
+	return &PersonAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ChatMessage.
-func (c *ChatMessageClient) Delete() *ChatMessageDelete {
-	mutation := newChatMessageMutation(c.config, OpDelete)
-	return &ChatMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PersonAuditLog.
+func (c *PersonAuditLogClient) Delete() *PersonAuditLogDelete {
+	mutation := newPersonAuditLogMutation(c.config, OpDelete)
+	return &PersonAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ChatMessageClient) DeleteOne(_m *ChatMessage) *ChatMessageDeleteOne {
+func (c *PersonAuditLogClient) DeleteOne(_m *PersonAuditLog) *PersonAuditLogDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ChatMessageClient) DeleteOneID(id uuid.UUID) *ChatMessageDeleteOne {
-	builder := c.Delete().Where(chatmessage.ID(id))
+func (c *PersonAuditLogClient) DeleteOneID(id uuid.UUID) *PersonAuditLogDeleteOne {
+	builder := c.Delete().Where(personauditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ChatMessageDeleteOne{builder}
+	return &PersonAuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for ChatMessage.
-func (c *ChatMessageClient) Query() *ChatMessageQuery {
-	return &ChatMessageQuery{
+// Query returns a query builder for PersonAuditLog.
+func (c *PersonAuditLogClient) Query() *PersonAuditLogQuery {
+	return &PersonAuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeChatMessage},
+		ctx:    &QueryContext{Type: TypePersonAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ChatMessage entity by its id.
-func (c *ChatMessageClient) Get(ctx context.Context, id uuid.UUID) (*ChatMessage, error) {
-	return c.Query().Where(chatmessage.ID(id)).Only(ctx)
+// Get returns a PersonAuditLog entity by its id.
+func (c *PersonAuditLogClient) Get(ctx context.Context, id uuid.UUID) (*PersonAuditLog, error) {
+	return c.Query().Where(personauditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ChatMessageClient) GetX(ctx context.Context, id uuid.UUID) *ChatMessage {
+func (c *PersonAuditLogClient) GetX(ctx context.Context, id uuid.UUID) *PersonAuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -343,131 +2740,131 @@ func (c *ChatMessageClient) GetX(ctx context.Context, id uuid.UUID) *ChatMessage
 }
 
 // Hooks returns the client hooks.
-func (c *ChatMessageClient) Hooks() []Hook {
-	return c.hooks.ChatMessage
+func (c *PersonAuditLogClient) Hooks() []Hook {
+	return c.hooks.PersonAuditLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *ChatMessageClient) Interceptors() []Interceptor {
-	return c.inters.ChatMessage
+func (c *PersonAuditLogClient) Interceptors() []Interceptor {
+	return c.inters.PersonAuditLog
 }
 
-func (c *ChatMessageClient) mutate(ctx context.Context, m *ChatMessageMutation) (Value, error) {
+func (c *PersonAuditLogClient) mutate(ctx context.Context, m *PersonAuditLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ChatMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PersonAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ChatMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PersonAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PersonAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ChatMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PersonAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ChatMessage mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PersonAuditLog mutation op: %q", m.Op())
 	}
 }
 
-// EventClient is a client for the Event schema.
-type EventClient struct {
+// ProfileClient is a client for the Profile schema.
+type ProfileClient struct {
 	config
 }
 
-// NewEventClient returns a client for the Event from the given config.
-func NewEventClient(c config) *EventClient {
-	return &EventClient{config: c}
+// NewProfileClient returns a client for the Profile from the given config.
+func NewProfileClient(c config) *ProfileClient {
+	return &ProfileClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `event.Hooks(f(g(h())))`.
-func (c *EventClient) Use(hooks ...Hook) {
-	c.hooks.Event = append(c.hooks.Event, hooks...)
+// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
+func (c *ProfileClient) Use(hooks ...Hook) {
+	c.hooks.Profile = append(c.hooks.Profile, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `event.Intercept(f(g(h())))`.
-func (c *EventClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Event = append(c.inters.Event, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
+func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Profile = append(c.inters.Profile, interceptors...)
 }
 
-// Create returns a builder for creating a Event entity.
-func (c *EventClient) Create() *EventCreate {
-	mutation := newEventMutation(c.config, OpCreate)
-	return &EventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Profile entity.
+func (c *ProfileClient) Create() *ProfileCreate {
+	mutation := newProfileMutation(c.config, OpCreate)
+	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Event entities.
-func (c *EventClient) CreateBulk(builders ...*EventCreate) *EventCreateBulk {
-	return &EventCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Profile entities.
+func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
+	return &ProfileCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EventClient) MapCreateBulk(slice any, setFunc func(*EventCreate, int)) *EventCreateBulk {
+func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EventCreateBulk{err: fmt.Errorf("calling to EventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EventCreate, rv.Len())
+	builders := make([]*ProfileCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EventCreateBulk{config: c.config, builders: builders}
+	return &ProfileCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Event.
-func (c *EventClient) Update() *EventUpdate {
-	mutation := newEventMutation(c.config, OpUpdate)
-	return &EventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Profile.
+func (c *ProfileClient) Update() *ProfileUpdate {
+	mutation := newProfileMutation(c.config, OpUpdate)
+	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EventClient) UpdateOne(_m *Event) *EventUpdateOne {
-	mutation := newEventMutation(c.config, OpUpdateOne, withEvent(_m))
-	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProfileClient) UpdateOne(_m *Profile) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(_m))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EventClient) UpdateOneID(id uuid.UUID) *EventUpdateOne {
-	mutation := newEventMutation(c.config, OpUpdateOne, withEventID(id))
-	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProfileClient) UpdateOneID(id uuid.UUID) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Event.
-func (c *EventClient) Delete() *EventDelete {
-	mutation := newEventMutation(c.config, OpDelete)
-	return &EventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Profile.
+func (c *ProfileClient) Delete() *ProfileDelete {
+	mutation := newProfileMutation(c.config, OpDelete)
+	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EventClient) DeleteOne(_m *Event) *EventDeleteOne {
+func (c *ProfileClient) DeleteOne(_m *Profile) *ProfileDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EventClient) DeleteOneID(id uuid.UUID) *EventDeleteOne {
-	builder := c.Delete().Where(event.ID(id))
+func (c *ProfileClient) DeleteOneID(id uuid.UUID) *ProfileDeleteOne {
+	builder := c.Delete().Where(profile.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EventDeleteOne{builder}
+	return &ProfileDeleteOne{builder}
 }
 
-// Query returns a query builder for Event.
-func (c *EventClient) Query() *EventQuery {
-	return &EventQuery{
+// Query returns a query builder for Profile.
+func (c *ProfileClient) Query() *ProfileQuery {
+	return &ProfileQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEvent},
+		ctx:    &QueryContext{Type: TypeProfile},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Event entity by its id.
-func (c *EventClient) Get(ctx context.Context, id uuid.UUID) (*Event, error) {
-	return c.Query().Where(event.ID(id)).Only(ctx)
+// Get returns a Profile entity by its id.
+func (c *ProfileClient) Get(ctx context.Context, id uuid.UUID) (*Profile, error) {
+	return c.Query().Where(profile.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EventClient) GetX(ctx context.Context, id uuid.UUID) *Event {
+func (c *ProfileClient) GetX(ctx context.Context, id uuid.UUID) *Profile {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -475,19 +2872,19 @@ func (c *EventClient) GetX(ctx context.Context, id uuid.UUID) *Event {
 	return obj
 }
 
-// QueryIdentities queries the identities edge of a Event.
-func (c *EventClient) QueryIdentities(_m *Event) *IdentityQuery {
+// QueryIdentity queries the identity edge of a Profile.
+func (c *ProfileClient) QueryIdentity(_m *Profile) *IdentityQuery {
 	query := (&IdentityClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.From(profile.Table, profile.FieldID, id),
 			sqlgraph.To(identity.Table, identity.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, event.IdentitiesTable, event.IdentitiesPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.M2O, true, profile.IdentityTable, profile.IdentityColumn),
 		)
 		schemaConfig := _m.schemaConfig
 		step.To.Schema = schemaConfig.Identity
-		step.Edge.Schema = schemaConfig.IdentityEvents
+		step.Edge.Schema = schemaConfig.Profile
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
@@ -495,131 +2892,131 @@ func (c *EventClient) QueryIdentities(_m *Event) *IdentityQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *EventClient) Hooks() []Hook {
-	return c.hooks.Event
+func (c *ProfileClient) Hooks() []Hook {
+	return c.hooks.Profile
 }
 
 // Interceptors returns the client interceptors.
-func (c *EventClient) Interceptors() []Interceptor {
-	return c.inters.Event
+func (c *ProfileClient) Interceptors() []Interceptor {
+	return c.inters.Profile
 }
 
-func (c *EventClient) mutate(ctx context.Context, m *EventMutation) (Value, error) {
+func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Event mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Profile mutation op: %q", m.Op())
 	}
 }
 
-// IdentityClient is a client for the Identity schema.
-type IdentityClient struct {
+// SummaryClient is a client for the Summary schema.
+type SummaryClient struct {
 	config
 }
 
-// NewIdentityClient returns a client for the Identity from the given config.
-func NewIdentityClient(c config) *IdentityClient {
-	return &IdentityClient{config: c}
+// NewSummaryClient returns a client for the Summary from the given config.
+func NewSummaryClient(c config) *SummaryClient {
+	return &SummaryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `identity.Hooks(f(g(h())))`.
-func (c *IdentityClient) Use(hooks ...Hook) {
-	c.hooks.Identity = append(c.hooks.Identity, hooks...)
+// A call to `Use(f, g, h)` equals to `summary.Hooks(f(g(h())))`.
+func (c *SummaryClient) Use(hooks ...Hook) {
+	c.hooks.Summary = append(c.hooks.Summary, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `identity.Intercept(f(g(h())))`.
-func (c *IdentityClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Identity = append(c.inters.Identity, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `summary.Intercept(f(g(h())))`.
+func (c *SummaryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Summary = append(c.inters.Summary, interceptors...)
 }
 
-// Create returns a builder for creating a Identity entity.
-func (c *IdentityClient) Create() *IdentityCreate {
-	mutation := newIdentityMutation(c.config, OpCreate)
-	return &IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Summary entity.
+func (c *SummaryClient) Create() *SummaryCreate {
+	mutation := newSummaryMutation(c.config, OpCreate)
+	return &SummaryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Identity entities.
-func (c *IdentityClient) CreateBulk(builders ...*IdentityCreate) *IdentityCreateBulk {
-	return &IdentityCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Summary entities.
+func (c *SummaryClient) CreateBulk(builders ...*SummaryCreate) *SummaryCreateBulk {
+	return &SummaryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IdentityClient) MapCreateBulk(slice any, setFunc func(*IdentityCreate, int)) *IdentityCreateBulk {
+func (c *SummaryClient) MapCreateBulk(slice any, setFunc func(*SummaryCreate, int)) *SummaryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IdentityCreateBulk{err: fmt.Errorf("calling to IdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SummaryCreateBulk{err: fmt.Errorf("calling to SummaryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IdentityCreate, rv.Len())
+	builders := make([]*SummaryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IdentityCreateBulk{config: c.config, builders: builders}
+	return &SummaryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Identity.
-func (c *IdentityClient) Update() *IdentityUpdate {
-	mutation := newIdentityMutation(c.config, OpUpdate)
-	return &IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Summary.
+func (c *SummaryClient) Update() *SummaryUpdate {
+	mutation := newSummaryMutation(c.config, OpUpdate)
+	return &SummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IdentityClient) UpdateOne(_m *Identity) *IdentityUpdateOne {
-	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentity(_m))
-	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SummaryClient) UpdateOne(_m *Summary) *SummaryUpdateOne {
+	mutation := newSummaryMutation(c.config, OpUpdateOne, withSummary(_m))
+	return &SummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IdentityClient) UpdateOneID(id uuid.UUID) *IdentityUpdateOne {
-	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentityID(id))
-	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SummaryClient) UpdateOneID(id uuid.UUID) *SummaryUpdateOne {
+	mutation := newSummaryMutation(c.config, OpUpdateOne, withSummaryID(id))
+	return &SummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Identity.
-func (c *IdentityClient) Delete() *IdentityDelete {
-	mutation := newIdentityMutation(c.config, OpDelete)
-	return &IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Summary.
+func (c *SummaryClient) Delete() *SummaryDelete {
+	mutation := newSummaryMutation(c.config, OpDelete)
+	return &SummaryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IdentityClient) DeleteOne(_m *Identity) *IdentityDeleteOne {
+func (c *SummaryClient) DeleteOne(_m *Summary) *SummaryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IdentityClient) DeleteOneID(id uuid.UUID) *IdentityDeleteOne {
-	builder := c.Delete().Where(identity.ID(id))
+func (c *SummaryClient) DeleteOneID(id uuid.UUID) *SummaryDeleteOne {
+	builder := c.Delete().Where(summary.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IdentityDeleteOne{builder}
+	return &SummaryDeleteOne{builder}
 }
 
-// Query returns a query builder for Identity.
-func (c *IdentityClient) Query() *IdentityQuery {
-	return &IdentityQuery{
+// Query returns a query builder for Summary.
+func (c *SummaryClient) Query() *SummaryQuery {
+	return &SummaryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIdentity},
+		ctx:    &QueryContext{Type: TypeSummary},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Identity entity by its id.
-func (c *IdentityClient) Get(ctx context.Context, id uuid.UUID) (*Identity, error) {
-	return c.Query().Where(identity.ID(id)).Only(ctx)
+// Get returns a Summary entity by its id.
+func (c *SummaryClient) Get(ctx context.Context, id uuid.UUID) (*Summary, error) {
+	return c.Query().Where(summary.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IdentityClient) GetX(ctx context.Context, id uuid.UUID) *Identity {
+func (c *SummaryClient) GetX(ctx context.Context, id uuid.UUID) *Summary {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -627,151 +3024,132 @@ func (c *IdentityClient) GetX(ctx context.Context, id uuid.UUID) *Identity {
 	return obj
 }
 
-// QueryEvents queries the events edge of a Identity.
-func (c *IdentityClient) QueryEvents(_m *Identity) *EventQuery {
-	query := (&EventClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(identity.Table, identity.FieldID, id),
-			sqlgraph.To(event.Table, event.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, identity.EventsTable, identity.EventsPrimaryKey...),
-		)
-		schemaConfig := _m.schemaConfig
-		step.To.Schema = schemaConfig.Event
-		step.Edge.Schema = schemaConfig.IdentityEvents
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *IdentityClient) Hooks() []Hook {
-	return c.hooks.Identity
+func (c *SummaryClient) Hooks() []Hook {
+	return c.hooks.Summary
 }
 
 // Interceptors returns the client interceptors.
-func (c *IdentityClient) Interceptors() []Interceptor {
-	return c.inters.Identity
+func (c *SummaryClient) Interceptors() []Interceptor {
+	return c.inters.Summary
 }
 
-func (c *IdentityClient) mutate(ctx context.Context, m *IdentityMutation) (Value, error) {
+func (c *SummaryClient) mutate(ctx context.Context, m *SummaryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SummaryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Identity mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Summary mutation op: %q", m.Op())
 	}
 }
 
-// JoinedChatClient is a client for the JoinedChat schema.
-type JoinedChatClient struct {
+// WorkspaceClient is a client for the Workspace schema.
+type WorkspaceClient struct {
 	config
 }
 
-// NewJoinedChatClient returns a client for the JoinedChat from the given config.
-func NewJoinedChatClient(c config) *JoinedChatClient {
-	return &JoinedChatClient{config: c}
+// NewWorkspaceClient returns a client for the Workspace from the given config.
+func NewWorkspaceClient(c config) *WorkspaceClient {
+	return &WorkspaceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `joinedchat.Hooks(f(g(h())))`.
-func (c *JoinedChatClient) Use(hooks ...Hook) {
-	c.hooks.JoinedChat = append(c.hooks.JoinedChat, hooks...)
+// A call to `Use(f, g, h)` equals to `workspace.Hooks(f(g(h())))`.
+func (c *WorkspaceClient) Use(hooks ...Hook) {
+	c.hooks.Workspace = append(c.hooks.Workspace, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `joinedchat.Intercept(f(g(h())))`.
-func (c *JoinedChatClient) Intercept(interceptors ...Interceptor) {
-	c.inters.JoinedChat = append(c.inters.JoinedChat, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `workspace.Intercept(f(g(h())))`.
+func (c *WorkspaceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Workspace = append(c.inters.Workspace, interceptors...)
 }
 
-// Create returns a builder for creating a JoinedChat entity.
-func (c *JoinedChatClient) Create() *JoinedChatCreate {
-	mutation := newJoinedChatMutation(c.config, OpCreate)
-	return &JoinedChatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Workspace entity.
+func (c *WorkspaceClient) Create() *WorkspaceCreate {
+	mutation := newWorkspaceMutation(c.config, OpCreate)
+	return &WorkspaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of JoinedChat entities.
-func (c *JoinedChatClient) CreateBulk(builders ...*JoinedChatCreate) *JoinedChatCreateBulk {
-	return &JoinedChatCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Workspace entities.
+func (c *WorkspaceClient) CreateBulk(builders ...*WorkspaceCreate) *WorkspaceCreateBulk {
+	return &WorkspaceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *JoinedChatClient) MapCreateBulk(slice any, setFunc func(*JoinedChatCreate, int)) *JoinedChatCreateBulk {
+func (c *WorkspaceClient) MapCreateBulk(slice any, setFunc func(*WorkspaceCreate, int)) *WorkspaceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &JoinedChatCreateBulk{err: fmt.Errorf("calling to JoinedChatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WorkspaceCreateBulk{err: fmt.Errorf("calling to WorkspaceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*JoinedChatCreate, rv.Len())
+	builders := make([]*WorkspaceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &JoinedChatCreateBulk{config: c.config, builders: builders}
+	return &WorkspaceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for JoinedChat.
-func (c *JoinedChatClient) Update() *JoinedChatUpdate {
-	mutation := newJoinedChatMutation(c.config, OpUpdate)
-	return &JoinedChatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Workspace.
+func (c *WorkspaceClient) Update() *WorkspaceUpdate {
+	mutation := newWorkspaceMutation(c.config, OpUpdate)
+	return &WorkspaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *JoinedChatClient) UpdateOne(_m *JoinedChat) *JoinedChatUpdateOne {
-	mutation := newJoinedChatMutation(c.config, OpUpdateOne, withJoinedChat(_m))
-	return &JoinedChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WorkspaceClient) UpdateOne(_m *Workspace) *WorkspaceUpdateOne {
+	mutation := newWorkspaceMutation(c.config, OpUpdateOne, withWorkspace(_m))
+	return &WorkspaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *JoinedChatClient) UpdateOneID(id uuid.UUID) *JoinedChatUpdateOne {
-	mutation := newJoinedChatMutation(c.config, OpUpdateOne, withJoinedChatID(id))
-	return &JoinedChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WorkspaceClient) UpdateOneID(id uuid.UUID) *WorkspaceUpdateOne {
+	mutation := newWorkspaceMutation(c.config, OpUpdateOne, withWorkspaceID(id))
+	return &WorkspaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for JoinedChat.
-func (c *JoinedChatClient) Delete() *JoinedChatDelete {
-	mutation := newJoinedChatMutation(c.config, OpDelete)
-	return &JoinedChatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Workspace.
+func (c *WorkspaceClient) Delete() *WorkspaceDelete {
+	mutation := newWorkspaceMutation(c.config, OpDelete)
+	return &WorkspaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *JoinedChatClient) DeleteOne(_m *JoinedChat) *JoinedChatDeleteOne {
+func (c *WorkspaceClient) DeleteOne(_m *Workspace) *WorkspaceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *JoinedChatClient) DeleteOneID(id uuid.UUID) *JoinedChatDeleteOne {
-	builder := c.Delete().Where(joinedchat.ID(id))
+func (c *WorkspaceClient) DeleteOneID(id uuid.UUID) *WorkspaceDeleteOne {
+	builder := c.Delete().Where(workspace.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &JoinedChatDeleteOne{builder}
+	return &WorkspaceDeleteOne{builder}
 }
 
-// Query returns a query builder for JoinedChat.
-func (c *JoinedChatClient) Query() *JoinedChatQuery {
-	return &JoinedChatQuery{
+// Query returns a query builder for Workspace.
+func (c *WorkspaceClient) Query() *WorkspaceQuery {
+	return &WorkspaceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeJoinedChat},
+		ctx:    &QueryContext{Type: TypeWorkspace},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a JoinedChat entity by its id.
-func (c *JoinedChatClient) Get(ctx context.Context, id uuid.UUID) (*JoinedChat, error) {
-	return c.Query().Where(joinedchat.ID(id)).Only(ctx)
+// Get returns a Workspace entity by its id.
+func (c *WorkspaceClient) Get(ctx context.Context, id uuid.UUID) (*Workspace, error) {
+	return c.Query().Where(workspace.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *JoinedChatClient) GetX(ctx context.Context, id uuid.UUID) *JoinedChat {
+func (c *WorkspaceClient) GetX(ctx context.Context, id uuid.UUID) *Workspace {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -780,37 +3158,43 @@ func (c *JoinedChatClient) GetX(ctx context.Context, id uuid.UUID) *JoinedChat {
 }
 
 // Hooks returns the client hooks.
-func (c *JoinedChatClient) Hooks() []Hook {
-	return c.hooks.JoinedChat
+func (c *WorkspaceClient) Hooks() []Hook {
+	return c.hooks.Workspace
 }
 
 // Interceptors returns the client interceptors.
-func (c *JoinedChatClient) Interceptors() []Interceptor {
-	return c.inters.JoinedChat
+func (c *WorkspaceClient) Interceptors() []Interceptor {
+	return c.inters.Workspace
 }
 
-func (c *JoinedChatClient) mutate(ctx context.Context, m *JoinedChatMutation) (Value, error) {
+func (c *WorkspaceClient) mutate(ctx context.Context, m *WorkspaceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&JoinedChatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkspaceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&JoinedChatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkspaceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&JoinedChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkspaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&JoinedChatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WorkspaceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown JoinedChat mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Workspace mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ChatMessage, Event, Identity, JoinedChat []ent.Hook
+		APIKey, AccessLog, AskTurn, ChatGrant, ChatMessage, ChatRetention, ChatSchedule,
+		DigestDelivery, DistillRun, Event, Identity, Job, JoinedChat, LLMCacheEntry,
+		OwnerAccountLink, Person, PersonAuditLog, Profile, Summary,
+		Workspace []ent.Hook
 	}
 	inters struct {
-		ChatMessage, Event, Identity, JoinedChat []ent.Interceptor
+		APIKey, AccessLog, AskTurn, ChatGrant, ChatMessage, ChatRetention, ChatSchedule,
+		DigestDelivery, DistillRun, Event, Identity, Job, JoinedChat, LLMCacheEntry,
+		OwnerAccountLink, Person, PersonAuditLog, Profile, Summary,
+		Workspace []ent.Interceptor
 	}
 )
 
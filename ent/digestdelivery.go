@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/digestdelivery"
+)
+
+// DigestDelivery is the model entity for the DigestDelivery schema.
+type DigestDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+	// Publisher holds the value of the "publisher" field.
+	Publisher string `json:"publisher,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID string `json:"chat_id,omitempty"`
+	// DigestKey holds the value of the "digest_key" field.
+	DigestKey string `json:"digest_key,omitempty"`
+	// Status holds the value of the "status" field.
+	Status digestdelivery.Status `json:"status,omitempty"`
+	// PartsTotal holds the value of the "parts_total" field.
+	PartsTotal int `json:"parts_total,omitempty"`
+	// PartsSent holds the value of the "parts_sent" field.
+	PartsSent int `json:"parts_sent,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt int64 `json:"sent_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DigestDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case digestdelivery.FieldPartsTotal, digestdelivery.FieldPartsSent, digestdelivery.FieldSentAt, digestdelivery.FieldCreatedAt, digestdelivery.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case digestdelivery.FieldPublisher, digestdelivery.FieldChatID, digestdelivery.FieldDigestKey, digestdelivery.FieldStatus, digestdelivery.FieldError:
+			values[i] = new(sql.NullString)
+		case digestdelivery.FieldID, digestdelivery.FieldWorkspaceID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DigestDelivery fields.
+func (_m *DigestDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case digestdelivery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case digestdelivery.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
+		case digestdelivery.FieldPublisher:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publisher", values[i])
+			} else if value.Valid {
+				_m.Publisher = value.String
+			}
+		case digestdelivery.FieldChatID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				_m.ChatID = value.String
+			}
+		case digestdelivery.FieldDigestKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest_key", values[i])
+			} else if value.Valid {
+				_m.DigestKey = value.String
+			}
+		case digestdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = digestdelivery.Status(value.String)
+			}
+		case digestdelivery.FieldPartsTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parts_total", values[i])
+			} else if value.Valid {
+				_m.PartsTotal = int(value.Int64)
+			}
+		case digestdelivery.FieldPartsSent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parts_sent", values[i])
+			} else if value.Valid {
+				_m.PartsSent = int(value.Int64)
+			}
+		case digestdelivery.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case digestdelivery.FieldSentAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = value.Int64
+			}
+		case digestdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case digestdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DigestDelivery.
+// This includes values selected through modifiers, order, etc.
+func (_m *DigestDelivery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DigestDelivery.
+// Note that you need to call DigestDelivery.Unwrap() before calling this method if this DigestDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DigestDelivery) Update() *DigestDeliveryUpdateOne {
+	return NewDigestDeliveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DigestDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DigestDelivery) Unwrap() *DigestDelivery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DigestDelivery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DigestDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("DigestDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("publisher=")
+	builder.WriteString(_m.Publisher)
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(_m.ChatID)
+	builder.WriteString(", ")
+	builder.WriteString("digest_key=")
+	builder.WriteString(_m.DigestKey)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("parts_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PartsTotal))
+	builder.WriteString(", ")
+	builder.WriteString("parts_sent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PartsSent))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("sent_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SentAt))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DigestDeliveries is a parsable slice of DigestDelivery.
+type DigestDeliveries []*DigestDelivery
@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package digestdelivery
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the digestdelivery type in the database.
+	Label = "digest_delivery"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldPublisher holds the string denoting the publisher field in the database.
+	FieldPublisher = "publisher"
+	// FieldChatID holds the string denoting the chat_id field in the database.
+	FieldChatID = "chat_id"
+	// FieldDigestKey holds the string denoting the digest_key field in the database.
+	FieldDigestKey = "digest_key"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPartsTotal holds the string denoting the parts_total field in the database.
+	FieldPartsTotal = "parts_total"
+	// FieldPartsSent holds the string denoting the parts_sent field in the database.
+	FieldPartsSent = "parts_sent"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldSentAt holds the string denoting the sent_at field in the database.
+	FieldSentAt = "sent_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the digestdelivery in the database.
+	Table = "digest_deliveries"
+)
+
+// Columns holds all SQL columns for digestdelivery fields.
+var Columns = []string{
+	FieldID,
+	FieldWorkspaceID,
+	FieldPublisher,
+	FieldChatID,
+	FieldDigestKey,
+	FieldStatus,
+	FieldPartsTotal,
+	FieldPartsSent,
+	FieldError,
+	FieldSentAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultWorkspaceID holds the default value on creation for the "workspace_id" field.
+	DefaultWorkspaceID func() uuid.UUID
+	// DefaultPartsTotal holds the default value on creation for the "parts_total" field.
+	DefaultPartsTotal int
+	// DefaultPartsSent holds the default value on creation for the "parts_sent" field.
+	DefaultPartsSent int
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
+	// DefaultSentAt holds the default value on creation for the "sent_at" field.
+	DefaultSentAt int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() int64
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending Status = "pending"
+	StatusSent    Status = "sent"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusSent:
+		return nil
+	default:
+		return fmt.Errorf("digestdelivery: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the DigestDelivery queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByPublisher orders the results by the publisher field.
+func ByPublisher(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublisher, opts...).ToFunc()
+}
+
+// ByChatID orders the results by the chat_id field.
+func ByChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatID, opts...).ToFunc()
+}
+
+// ByDigestKey orders the results by the digest_key field.
+func ByDigestKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigestKey, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPartsTotal orders the results by the parts_total field.
+func ByPartsTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPartsTotal, opts...).ToFunc()
+}
+
+// ByPartsSent orders the results by the parts_sent field.
+func ByPartsSent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPartsSent, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// BySentAt orders the results by the sent_at field.
+func BySentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
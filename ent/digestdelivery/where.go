@@ -0,0 +1,639 @@
+// Code generated by ent, DO NOT EDIT.
+
+package digestdelivery
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// Publisher applies equality check predicate on the "publisher" field. It's identical to PublisherEQ.
+func Publisher(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldPublisher, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldChatID, v))
+}
+
+// DigestKey applies equality check predicate on the "digest_key" field. It's identical to DigestKeyEQ.
+func DigestKey(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldDigestKey, v))
+}
+
+// PartsTotal applies equality check predicate on the "parts_total" field. It's identical to PartsTotalEQ.
+func PartsTotal(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldPartsTotal, v))
+}
+
+// PartsSent applies equality check predicate on the "parts_sent" field. It's identical to PartsSentEQ.
+func PartsSent(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldPartsSent, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldError, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldSentAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
+// PublisherEQ applies the EQ predicate on the "publisher" field.
+func PublisherEQ(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldPublisher, v))
+}
+
+// PublisherNEQ applies the NEQ predicate on the "publisher" field.
+func PublisherNEQ(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldPublisher, v))
+}
+
+// PublisherIn applies the In predicate on the "publisher" field.
+func PublisherIn(vs ...string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldPublisher, vs...))
+}
+
+// PublisherNotIn applies the NotIn predicate on the "publisher" field.
+func PublisherNotIn(vs ...string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldPublisher, vs...))
+}
+
+// PublisherGT applies the GT predicate on the "publisher" field.
+func PublisherGT(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldPublisher, v))
+}
+
+// PublisherGTE applies the GTE predicate on the "publisher" field.
+func PublisherGTE(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldPublisher, v))
+}
+
+// PublisherLT applies the LT predicate on the "publisher" field.
+func PublisherLT(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldPublisher, v))
+}
+
+// PublisherLTE applies the LTE predicate on the "publisher" field.
+func PublisherLTE(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldPublisher, v))
+}
+
+// PublisherContains applies the Contains predicate on the "publisher" field.
+func PublisherContains(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldContains(FieldPublisher, v))
+}
+
+// PublisherHasPrefix applies the HasPrefix predicate on the "publisher" field.
+func PublisherHasPrefix(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldHasPrefix(FieldPublisher, v))
+}
+
+// PublisherHasSuffix applies the HasSuffix predicate on the "publisher" field.
+func PublisherHasSuffix(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldHasSuffix(FieldPublisher, v))
+}
+
+// PublisherEqualFold applies the EqualFold predicate on the "publisher" field.
+func PublisherEqualFold(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEqualFold(FieldPublisher, v))
+}
+
+// PublisherContainsFold applies the ContainsFold predicate on the "publisher" field.
+func PublisherContainsFold(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldContainsFold(FieldPublisher, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldChatID, v))
+}
+
+// ChatIDContains applies the Contains predicate on the "chat_id" field.
+func ChatIDContains(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldContains(FieldChatID, v))
+}
+
+// ChatIDHasPrefix applies the HasPrefix predicate on the "chat_id" field.
+func ChatIDHasPrefix(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldHasPrefix(FieldChatID, v))
+}
+
+// ChatIDHasSuffix applies the HasSuffix predicate on the "chat_id" field.
+func ChatIDHasSuffix(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldHasSuffix(FieldChatID, v))
+}
+
+// ChatIDEqualFold applies the EqualFold predicate on the "chat_id" field.
+func ChatIDEqualFold(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEqualFold(FieldChatID, v))
+}
+
+// ChatIDContainsFold applies the ContainsFold predicate on the "chat_id" field.
+func ChatIDContainsFold(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldContainsFold(FieldChatID, v))
+}
+
+// DigestKeyEQ applies the EQ predicate on the "digest_key" field.
+func DigestKeyEQ(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldDigestKey, v))
+}
+
+// DigestKeyNEQ applies the NEQ predicate on the "digest_key" field.
+func DigestKeyNEQ(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldDigestKey, v))
+}
+
+// DigestKeyIn applies the In predicate on the "digest_key" field.
+func DigestKeyIn(vs ...string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldDigestKey, vs...))
+}
+
+// DigestKeyNotIn applies the NotIn predicate on the "digest_key" field.
+func DigestKeyNotIn(vs ...string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldDigestKey, vs...))
+}
+
+// DigestKeyGT applies the GT predicate on the "digest_key" field.
+func DigestKeyGT(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldDigestKey, v))
+}
+
+// DigestKeyGTE applies the GTE predicate on the "digest_key" field.
+func DigestKeyGTE(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldDigestKey, v))
+}
+
+// DigestKeyLT applies the LT predicate on the "digest_key" field.
+func DigestKeyLT(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldDigestKey, v))
+}
+
+// DigestKeyLTE applies the LTE predicate on the "digest_key" field.
+func DigestKeyLTE(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldDigestKey, v))
+}
+
+// DigestKeyContains applies the Contains predicate on the "digest_key" field.
+func DigestKeyContains(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldContains(FieldDigestKey, v))
+}
+
+// DigestKeyHasPrefix applies the HasPrefix predicate on the "digest_key" field.
+func DigestKeyHasPrefix(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldHasPrefix(FieldDigestKey, v))
+}
+
+// DigestKeyHasSuffix applies the HasSuffix predicate on the "digest_key" field.
+func DigestKeyHasSuffix(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldHasSuffix(FieldDigestKey, v))
+}
+
+// DigestKeyEqualFold applies the EqualFold predicate on the "digest_key" field.
+func DigestKeyEqualFold(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEqualFold(FieldDigestKey, v))
+}
+
+// DigestKeyContainsFold applies the ContainsFold predicate on the "digest_key" field.
+func DigestKeyContainsFold(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldContainsFold(FieldDigestKey, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// PartsTotalEQ applies the EQ predicate on the "parts_total" field.
+func PartsTotalEQ(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldPartsTotal, v))
+}
+
+// PartsTotalNEQ applies the NEQ predicate on the "parts_total" field.
+func PartsTotalNEQ(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldPartsTotal, v))
+}
+
+// PartsTotalIn applies the In predicate on the "parts_total" field.
+func PartsTotalIn(vs ...int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldPartsTotal, vs...))
+}
+
+// PartsTotalNotIn applies the NotIn predicate on the "parts_total" field.
+func PartsTotalNotIn(vs ...int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldPartsTotal, vs...))
+}
+
+// PartsTotalGT applies the GT predicate on the "parts_total" field.
+func PartsTotalGT(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldPartsTotal, v))
+}
+
+// PartsTotalGTE applies the GTE predicate on the "parts_total" field.
+func PartsTotalGTE(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldPartsTotal, v))
+}
+
+// PartsTotalLT applies the LT predicate on the "parts_total" field.
+func PartsTotalLT(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldPartsTotal, v))
+}
+
+// PartsTotalLTE applies the LTE predicate on the "parts_total" field.
+func PartsTotalLTE(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldPartsTotal, v))
+}
+
+// PartsSentEQ applies the EQ predicate on the "parts_sent" field.
+func PartsSentEQ(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldPartsSent, v))
+}
+
+// PartsSentNEQ applies the NEQ predicate on the "parts_sent" field.
+func PartsSentNEQ(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldPartsSent, v))
+}
+
+// PartsSentIn applies the In predicate on the "parts_sent" field.
+func PartsSentIn(vs ...int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldPartsSent, vs...))
+}
+
+// PartsSentNotIn applies the NotIn predicate on the "parts_sent" field.
+func PartsSentNotIn(vs ...int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldPartsSent, vs...))
+}
+
+// PartsSentGT applies the GT predicate on the "parts_sent" field.
+func PartsSentGT(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldPartsSent, v))
+}
+
+// PartsSentGTE applies the GTE predicate on the "parts_sent" field.
+func PartsSentGTE(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldPartsSent, v))
+}
+
+// PartsSentLT applies the LT predicate on the "parts_sent" field.
+func PartsSentLT(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldPartsSent, v))
+}
+
+// PartsSentLTE applies the LTE predicate on the "parts_sent" field.
+func PartsSentLTE(v int) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldPartsSent, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldContainsFold(FieldError, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldSentAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DigestDelivery) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DigestDelivery) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DigestDelivery) predicate.DigestDelivery {
+	return predicate.DigestDelivery(sql.NotPredicates(p))
+}
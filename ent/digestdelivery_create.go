@@ -0,0 +1,1084 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/digestdelivery"
+)
+
+// DigestDeliveryCreate is the builder for creating a DigestDelivery entity.
+type DigestDeliveryCreate struct {
+	config
+	mutation *DigestDeliveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *DigestDeliveryCreate) SetWorkspaceID(v uuid.UUID) *DigestDeliveryCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *DigestDeliveryCreate) SetNillableWorkspaceID(v *uuid.UUID) *DigestDeliveryCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetPublisher sets the "publisher" field.
+func (_c *DigestDeliveryCreate) SetPublisher(v string) *DigestDeliveryCreate {
+	_c.mutation.SetPublisher(v)
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *DigestDeliveryCreate) SetChatID(v string) *DigestDeliveryCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetDigestKey sets the "digest_key" field.
+func (_c *DigestDeliveryCreate) SetDigestKey(v string) *DigestDeliveryCreate {
+	_c.mutation.SetDigestKey(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DigestDeliveryCreate) SetStatus(v digestdelivery.Status) *DigestDeliveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *DigestDeliveryCreate) SetNillableStatus(v *digestdelivery.Status) *DigestDeliveryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPartsTotal sets the "parts_total" field.
+func (_c *DigestDeliveryCreate) SetPartsTotal(v int) *DigestDeliveryCreate {
+	_c.mutation.SetPartsTotal(v)
+	return _c
+}
+
+// SetNillablePartsTotal sets the "parts_total" field if the given value is not nil.
+func (_c *DigestDeliveryCreate) SetNillablePartsTotal(v *int) *DigestDeliveryCreate {
+	if v != nil {
+		_c.SetPartsTotal(*v)
+	}
+	return _c
+}
+
+// SetPartsSent sets the "parts_sent" field.
+func (_c *DigestDeliveryCreate) SetPartsSent(v int) *DigestDeliveryCreate {
+	_c.mutation.SetPartsSent(v)
+	return _c
+}
+
+// SetNillablePartsSent sets the "parts_sent" field if the given value is not nil.
+func (_c *DigestDeliveryCreate) SetNillablePartsSent(v *int) *DigestDeliveryCreate {
+	if v != nil {
+		_c.SetPartsSent(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *DigestDeliveryCreate) SetError(v string) *DigestDeliveryCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *DigestDeliveryCreate) SetNillableError(v *string) *DigestDeliveryCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *DigestDeliveryCreate) SetSentAt(v int64) *DigestDeliveryCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *DigestDeliveryCreate) SetNillableSentAt(v *int64) *DigestDeliveryCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DigestDeliveryCreate) SetCreatedAt(v int64) *DigestDeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DigestDeliveryCreate) SetNillableCreatedAt(v *int64) *DigestDeliveryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DigestDeliveryCreate) SetUpdatedAt(v int64) *DigestDeliveryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DigestDeliveryCreate) SetNillableUpdatedAt(v *int64) *DigestDeliveryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DigestDeliveryCreate) SetID(v uuid.UUID) *DigestDeliveryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DigestDeliveryCreate) SetNillableID(v *uuid.UUID) *DigestDeliveryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DigestDeliveryMutation object of the builder.
+func (_c *DigestDeliveryCreate) Mutation() *DigestDeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the DigestDelivery in the database.
+func (_c *DigestDeliveryCreate) Save(ctx context.Context) (*DigestDelivery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DigestDeliveryCreate) SaveX(ctx context.Context) *DigestDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DigestDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DigestDeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DigestDeliveryCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := digestdelivery.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := digestdelivery.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.PartsTotal(); !ok {
+		v := digestdelivery.DefaultPartsTotal
+		_c.mutation.SetPartsTotal(v)
+	}
+	if _, ok := _c.mutation.PartsSent(); !ok {
+		v := digestdelivery.DefaultPartsSent
+		_c.mutation.SetPartsSent(v)
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		v := digestdelivery.DefaultError
+		_c.mutation.SetError(v)
+	}
+	if _, ok := _c.mutation.SentAt(); !ok {
+		v := digestdelivery.DefaultSentAt
+		_c.mutation.SetSentAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := digestdelivery.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := digestdelivery.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := digestdelivery.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DigestDeliveryCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "DigestDelivery.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.Publisher(); !ok {
+		return &ValidationError{Name: "publisher", err: errors.New(`ent: missing required field "DigestDelivery.publisher"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "DigestDelivery.chat_id"`)}
+	}
+	if _, ok := _c.mutation.DigestKey(); !ok {
+		return &ValidationError{Name: "digest_key", err: errors.New(`ent: missing required field "DigestDelivery.digest_key"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DigestDelivery.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := digestdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DigestDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PartsTotal(); !ok {
+		return &ValidationError{Name: "parts_total", err: errors.New(`ent: missing required field "DigestDelivery.parts_total"`)}
+	}
+	if _, ok := _c.mutation.PartsSent(); !ok {
+		return &ValidationError{Name: "parts_sent", err: errors.New(`ent: missing required field "DigestDelivery.parts_sent"`)}
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "DigestDelivery.error"`)}
+	}
+	if _, ok := _c.mutation.SentAt(); !ok {
+		return &ValidationError{Name: "sent_at", err: errors.New(`ent: missing required field "DigestDelivery.sent_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DigestDelivery.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DigestDelivery.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DigestDeliveryCreate) sqlSave(ctx context.Context) (*DigestDelivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DigestDeliveryCreate) createSpec() (*DigestDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DigestDelivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(digestdelivery.Table, sqlgraph.NewFieldSpec(digestdelivery.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.DigestDelivery
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(digestdelivery.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.Publisher(); ok {
+		_spec.SetField(digestdelivery.FieldPublisher, field.TypeString, value)
+		_node.Publisher = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(digestdelivery.FieldChatID, field.TypeString, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.DigestKey(); ok {
+		_spec.SetField(digestdelivery.FieldDigestKey, field.TypeString, value)
+		_node.DigestKey = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(digestdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PartsTotal(); ok {
+		_spec.SetField(digestdelivery.FieldPartsTotal, field.TypeInt, value)
+		_node.PartsTotal = value
+	}
+	if value, ok := _c.mutation.PartsSent(); ok {
+		_spec.SetField(digestdelivery.FieldPartsSent, field.TypeInt, value)
+		_node.PartsSent = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(digestdelivery.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(digestdelivery.FieldSentAt, field.TypeInt64, value)
+		_node.SentAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(digestdelivery.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(digestdelivery.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DigestDelivery.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DigestDeliveryUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DigestDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *DigestDeliveryUpsertOne {
+	_c.conflict = opts
+	return &DigestDeliveryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DigestDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DigestDeliveryCreate) OnConflictColumns(columns ...string) *DigestDeliveryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DigestDeliveryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DigestDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one DigestDelivery node.
+	DigestDeliveryUpsertOne struct {
+		create *DigestDeliveryCreate
+	}
+
+	// DigestDeliveryUpsert is the "OnConflict" setter.
+	DigestDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *DigestDeliveryUpsert) SetWorkspaceID(v uuid.UUID) *DigestDeliveryUpsert {
+	u.Set(digestdelivery.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *DigestDeliveryUpsert) UpdateWorkspaceID() *DigestDeliveryUpsert {
+	u.SetExcluded(digestdelivery.FieldWorkspaceID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DigestDeliveryUpsert) SetStatus(v digestdelivery.Status) *DigestDeliveryUpsert {
+	u.Set(digestdelivery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DigestDeliveryUpsert) UpdateStatus() *DigestDeliveryUpsert {
+	u.SetExcluded(digestdelivery.FieldStatus)
+	return u
+}
+
+// SetPartsTotal sets the "parts_total" field.
+func (u *DigestDeliveryUpsert) SetPartsTotal(v int) *DigestDeliveryUpsert {
+	u.Set(digestdelivery.FieldPartsTotal, v)
+	return u
+}
+
+// UpdatePartsTotal sets the "parts_total" field to the value that was provided on create.
+func (u *DigestDeliveryUpsert) UpdatePartsTotal() *DigestDeliveryUpsert {
+	u.SetExcluded(digestdelivery.FieldPartsTotal)
+	return u
+}
+
+// AddPartsTotal adds v to the "parts_total" field.
+func (u *DigestDeliveryUpsert) AddPartsTotal(v int) *DigestDeliveryUpsert {
+	u.Add(digestdelivery.FieldPartsTotal, v)
+	return u
+}
+
+// SetPartsSent sets the "parts_sent" field.
+func (u *DigestDeliveryUpsert) SetPartsSent(v int) *DigestDeliveryUpsert {
+	u.Set(digestdelivery.FieldPartsSent, v)
+	return u
+}
+
+// UpdatePartsSent sets the "parts_sent" field to the value that was provided on create.
+func (u *DigestDeliveryUpsert) UpdatePartsSent() *DigestDeliveryUpsert {
+	u.SetExcluded(digestdelivery.FieldPartsSent)
+	return u
+}
+
+// AddPartsSent adds v to the "parts_sent" field.
+func (u *DigestDeliveryUpsert) AddPartsSent(v int) *DigestDeliveryUpsert {
+	u.Add(digestdelivery.FieldPartsSent, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *DigestDeliveryUpsert) SetError(v string) *DigestDeliveryUpsert {
+	u.Set(digestdelivery.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DigestDeliveryUpsert) UpdateError() *DigestDeliveryUpsert {
+	u.SetExcluded(digestdelivery.FieldError)
+	return u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *DigestDeliveryUpsert) SetSentAt(v int64) *DigestDeliveryUpsert {
+	u.Set(digestdelivery.FieldSentAt, v)
+	return u
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *DigestDeliveryUpsert) UpdateSentAt() *DigestDeliveryUpsert {
+	u.SetExcluded(digestdelivery.FieldSentAt)
+	return u
+}
+
+// AddSentAt adds v to the "sent_at" field.
+func (u *DigestDeliveryUpsert) AddSentAt(v int64) *DigestDeliveryUpsert {
+	u.Add(digestdelivery.FieldSentAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DigestDeliveryUpsert) SetUpdatedAt(v int64) *DigestDeliveryUpsert {
+	u.Set(digestdelivery.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DigestDeliveryUpsert) UpdateUpdatedAt() *DigestDeliveryUpsert {
+	u.SetExcluded(digestdelivery.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *DigestDeliveryUpsert) AddUpdatedAt(v int64) *DigestDeliveryUpsert {
+	u.Add(digestdelivery.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DigestDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(digestdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DigestDeliveryUpsertOne) UpdateNewValues() *DigestDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(digestdelivery.FieldID)
+		}
+		if _, exists := u.create.mutation.Publisher(); exists {
+			s.SetIgnore(digestdelivery.FieldPublisher)
+		}
+		if _, exists := u.create.mutation.ChatID(); exists {
+			s.SetIgnore(digestdelivery.FieldChatID)
+		}
+		if _, exists := u.create.mutation.DigestKey(); exists {
+			s.SetIgnore(digestdelivery.FieldDigestKey)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(digestdelivery.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DigestDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DigestDeliveryUpsertOne) Ignore() *DigestDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DigestDeliveryUpsertOne) DoNothing() *DigestDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DigestDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *DigestDeliveryUpsertOne) Update(set func(*DigestDeliveryUpsert)) *DigestDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DigestDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *DigestDeliveryUpsertOne) SetWorkspaceID(v uuid.UUID) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertOne) UpdateWorkspaceID() *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DigestDeliveryUpsertOne) SetStatus(v digestdelivery.Status) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertOne) UpdateStatus() *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPartsTotal sets the "parts_total" field.
+func (u *DigestDeliveryUpsertOne) SetPartsTotal(v int) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetPartsTotal(v)
+	})
+}
+
+// AddPartsTotal adds v to the "parts_total" field.
+func (u *DigestDeliveryUpsertOne) AddPartsTotal(v int) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.AddPartsTotal(v)
+	})
+}
+
+// UpdatePartsTotal sets the "parts_total" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertOne) UpdatePartsTotal() *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdatePartsTotal()
+	})
+}
+
+// SetPartsSent sets the "parts_sent" field.
+func (u *DigestDeliveryUpsertOne) SetPartsSent(v int) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetPartsSent(v)
+	})
+}
+
+// AddPartsSent adds v to the "parts_sent" field.
+func (u *DigestDeliveryUpsertOne) AddPartsSent(v int) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.AddPartsSent(v)
+	})
+}
+
+// UpdatePartsSent sets the "parts_sent" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertOne) UpdatePartsSent() *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdatePartsSent()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DigestDeliveryUpsertOne) SetError(v string) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertOne) UpdateError() *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdateError()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *DigestDeliveryUpsertOne) SetSentAt(v int64) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// AddSentAt adds v to the "sent_at" field.
+func (u *DigestDeliveryUpsertOne) AddSentAt(v int64) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.AddSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertOne) UpdateSentAt() *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DigestDeliveryUpsertOne) SetUpdatedAt(v int64) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *DigestDeliveryUpsertOne) AddUpdatedAt(v int64) *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertOne) UpdateUpdatedAt() *DigestDeliveryUpsertOne {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DigestDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DigestDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DigestDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DigestDeliveryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DigestDeliveryUpsertOne.ID is not supported by MySQL driver. Use DigestDeliveryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DigestDeliveryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DigestDeliveryCreateBulk is the builder for creating many DigestDelivery entities in bulk.
+type DigestDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*DigestDeliveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DigestDelivery entities in the database.
+func (_c *DigestDeliveryCreateBulk) Save(ctx context.Context) ([]*DigestDelivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DigestDelivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DigestDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DigestDeliveryCreateBulk) SaveX(ctx context.Context) []*DigestDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DigestDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DigestDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DigestDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DigestDeliveryUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DigestDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *DigestDeliveryUpsertBulk {
+	_c.conflict = opts
+	return &DigestDeliveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DigestDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DigestDeliveryCreateBulk) OnConflictColumns(columns ...string) *DigestDeliveryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DigestDeliveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// DigestDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of DigestDelivery nodes.
+type DigestDeliveryUpsertBulk struct {
+	create *DigestDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DigestDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(digestdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DigestDeliveryUpsertBulk) UpdateNewValues() *DigestDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(digestdelivery.FieldID)
+			}
+			if _, exists := b.mutation.Publisher(); exists {
+				s.SetIgnore(digestdelivery.FieldPublisher)
+			}
+			if _, exists := b.mutation.ChatID(); exists {
+				s.SetIgnore(digestdelivery.FieldChatID)
+			}
+			if _, exists := b.mutation.DigestKey(); exists {
+				s.SetIgnore(digestdelivery.FieldDigestKey)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(digestdelivery.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DigestDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DigestDeliveryUpsertBulk) Ignore() *DigestDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DigestDeliveryUpsertBulk) DoNothing() *DigestDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DigestDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *DigestDeliveryUpsertBulk) Update(set func(*DigestDeliveryUpsert)) *DigestDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DigestDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *DigestDeliveryUpsertBulk) SetWorkspaceID(v uuid.UUID) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertBulk) UpdateWorkspaceID() *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DigestDeliveryUpsertBulk) SetStatus(v digestdelivery.Status) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertBulk) UpdateStatus() *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPartsTotal sets the "parts_total" field.
+func (u *DigestDeliveryUpsertBulk) SetPartsTotal(v int) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetPartsTotal(v)
+	})
+}
+
+// AddPartsTotal adds v to the "parts_total" field.
+func (u *DigestDeliveryUpsertBulk) AddPartsTotal(v int) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.AddPartsTotal(v)
+	})
+}
+
+// UpdatePartsTotal sets the "parts_total" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertBulk) UpdatePartsTotal() *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdatePartsTotal()
+	})
+}
+
+// SetPartsSent sets the "parts_sent" field.
+func (u *DigestDeliveryUpsertBulk) SetPartsSent(v int) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetPartsSent(v)
+	})
+}
+
+// AddPartsSent adds v to the "parts_sent" field.
+func (u *DigestDeliveryUpsertBulk) AddPartsSent(v int) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.AddPartsSent(v)
+	})
+}
+
+// UpdatePartsSent sets the "parts_sent" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertBulk) UpdatePartsSent() *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdatePartsSent()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DigestDeliveryUpsertBulk) SetError(v string) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertBulk) UpdateError() *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdateError()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *DigestDeliveryUpsertBulk) SetSentAt(v int64) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// AddSentAt adds v to the "sent_at" field.
+func (u *DigestDeliveryUpsertBulk) AddSentAt(v int64) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.AddSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertBulk) UpdateSentAt() *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DigestDeliveryUpsertBulk) SetUpdatedAt(v int64) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *DigestDeliveryUpsertBulk) AddUpdatedAt(v int64) *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DigestDeliveryUpsertBulk) UpdateUpdatedAt() *DigestDeliveryUpsertBulk {
+	return u.Update(func(s *DigestDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DigestDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DigestDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DigestDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DigestDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
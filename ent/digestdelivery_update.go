@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/digestdelivery"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// DigestDeliveryUpdate is the builder for updating DigestDelivery entities.
+type DigestDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DigestDeliveryMutation
+}
+
+// Where appends a list predicates to the DigestDeliveryUpdate builder.
+func (_u *DigestDeliveryUpdate) Where(ps ...predicate.DigestDelivery) *DigestDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *DigestDeliveryUpdate) SetWorkspaceID(v uuid.UUID) *DigestDeliveryUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *DigestDeliveryUpdate) SetNillableWorkspaceID(v *uuid.UUID) *DigestDeliveryUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DigestDeliveryUpdate) SetStatus(v digestdelivery.Status) *DigestDeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DigestDeliveryUpdate) SetNillableStatus(v *digestdelivery.Status) *DigestDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPartsTotal sets the "parts_total" field.
+func (_u *DigestDeliveryUpdate) SetPartsTotal(v int) *DigestDeliveryUpdate {
+	_u.mutation.ResetPartsTotal()
+	_u.mutation.SetPartsTotal(v)
+	return _u
+}
+
+// SetNillablePartsTotal sets the "parts_total" field if the given value is not nil.
+func (_u *DigestDeliveryUpdate) SetNillablePartsTotal(v *int) *DigestDeliveryUpdate {
+	if v != nil {
+		_u.SetPartsTotal(*v)
+	}
+	return _u
+}
+
+// AddPartsTotal adds value to the "parts_total" field.
+func (_u *DigestDeliveryUpdate) AddPartsTotal(v int) *DigestDeliveryUpdate {
+	_u.mutation.AddPartsTotal(v)
+	return _u
+}
+
+// SetPartsSent sets the "parts_sent" field.
+func (_u *DigestDeliveryUpdate) SetPartsSent(v int) *DigestDeliveryUpdate {
+	_u.mutation.ResetPartsSent()
+	_u.mutation.SetPartsSent(v)
+	return _u
+}
+
+// SetNillablePartsSent sets the "parts_sent" field if the given value is not nil.
+func (_u *DigestDeliveryUpdate) SetNillablePartsSent(v *int) *DigestDeliveryUpdate {
+	if v != nil {
+		_u.SetPartsSent(*v)
+	}
+	return _u
+}
+
+// AddPartsSent adds value to the "parts_sent" field.
+func (_u *DigestDeliveryUpdate) AddPartsSent(v int) *DigestDeliveryUpdate {
+	_u.mutation.AddPartsSent(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DigestDeliveryUpdate) SetError(v string) *DigestDeliveryUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DigestDeliveryUpdate) SetNillableError(v *string) *DigestDeliveryUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *DigestDeliveryUpdate) SetSentAt(v int64) *DigestDeliveryUpdate {
+	_u.mutation.ResetSentAt()
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *DigestDeliveryUpdate) SetNillableSentAt(v *int64) *DigestDeliveryUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// AddSentAt adds value to the "sent_at" field.
+func (_u *DigestDeliveryUpdate) AddSentAt(v int64) *DigestDeliveryUpdate {
+	_u.mutation.AddSentAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DigestDeliveryUpdate) SetUpdatedAt(v int64) *DigestDeliveryUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *DigestDeliveryUpdate) AddUpdatedAt(v int64) *DigestDeliveryUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DigestDeliveryMutation object of the builder.
+func (_u *DigestDeliveryUpdate) Mutation() *DigestDeliveryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DigestDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DigestDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DigestDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DigestDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DigestDeliveryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := digestdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DigestDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := digestdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DigestDelivery.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DigestDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(digestdelivery.Table, digestdelivery.Columns, sqlgraph.NewFieldSpec(digestdelivery.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(digestdelivery.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(digestdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PartsTotal(); ok {
+		_spec.SetField(digestdelivery.FieldPartsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartsTotal(); ok {
+		_spec.AddField(digestdelivery.FieldPartsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PartsSent(); ok {
+		_spec.SetField(digestdelivery.FieldPartsSent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartsSent(); ok {
+		_spec.AddField(digestdelivery.FieldPartsSent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(digestdelivery.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(digestdelivery.FieldSentAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSentAt(); ok {
+		_spec.AddField(digestdelivery.FieldSentAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(digestdelivery.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(digestdelivery.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.DigestDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{digestdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DigestDeliveryUpdateOne is the builder for updating a single DigestDelivery entity.
+type DigestDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DigestDeliveryMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *DigestDeliveryUpdateOne) SetWorkspaceID(v uuid.UUID) *DigestDeliveryUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *DigestDeliveryUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *DigestDeliveryUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DigestDeliveryUpdateOne) SetStatus(v digestdelivery.Status) *DigestDeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DigestDeliveryUpdateOne) SetNillableStatus(v *digestdelivery.Status) *DigestDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPartsTotal sets the "parts_total" field.
+func (_u *DigestDeliveryUpdateOne) SetPartsTotal(v int) *DigestDeliveryUpdateOne {
+	_u.mutation.ResetPartsTotal()
+	_u.mutation.SetPartsTotal(v)
+	return _u
+}
+
+// SetNillablePartsTotal sets the "parts_total" field if the given value is not nil.
+func (_u *DigestDeliveryUpdateOne) SetNillablePartsTotal(v *int) *DigestDeliveryUpdateOne {
+	if v != nil {
+		_u.SetPartsTotal(*v)
+	}
+	return _u
+}
+
+// AddPartsTotal adds value to the "parts_total" field.
+func (_u *DigestDeliveryUpdateOne) AddPartsTotal(v int) *DigestDeliveryUpdateOne {
+	_u.mutation.AddPartsTotal(v)
+	return _u
+}
+
+// SetPartsSent sets the "parts_sent" field.
+func (_u *DigestDeliveryUpdateOne) SetPartsSent(v int) *DigestDeliveryUpdateOne {
+	_u.mutation.ResetPartsSent()
+	_u.mutation.SetPartsSent(v)
+	return _u
+}
+
+// SetNillablePartsSent sets the "parts_sent" field if the given value is not nil.
+func (_u *DigestDeliveryUpdateOne) SetNillablePartsSent(v *int) *DigestDeliveryUpdateOne {
+	if v != nil {
+		_u.SetPartsSent(*v)
+	}
+	return _u
+}
+
+// AddPartsSent adds value to the "parts_sent" field.
+func (_u *DigestDeliveryUpdateOne) AddPartsSent(v int) *DigestDeliveryUpdateOne {
+	_u.mutation.AddPartsSent(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DigestDeliveryUpdateOne) SetError(v string) *DigestDeliveryUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DigestDeliveryUpdateOne) SetNillableError(v *string) *DigestDeliveryUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *DigestDeliveryUpdateOne) SetSentAt(v int64) *DigestDeliveryUpdateOne {
+	_u.mutation.ResetSentAt()
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *DigestDeliveryUpdateOne) SetNillableSentAt(v *int64) *DigestDeliveryUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// AddSentAt adds value to the "sent_at" field.
+func (_u *DigestDeliveryUpdateOne) AddSentAt(v int64) *DigestDeliveryUpdateOne {
+	_u.mutation.AddSentAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DigestDeliveryUpdateOne) SetUpdatedAt(v int64) *DigestDeliveryUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *DigestDeliveryUpdateOne) AddUpdatedAt(v int64) *DigestDeliveryUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DigestDeliveryMutation object of the builder.
+func (_u *DigestDeliveryUpdateOne) Mutation() *DigestDeliveryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DigestDeliveryUpdate builder.
+func (_u *DigestDeliveryUpdateOne) Where(ps ...predicate.DigestDelivery) *DigestDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DigestDeliveryUpdateOne) Select(field string, fields ...string) *DigestDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DigestDelivery entity.
+func (_u *DigestDeliveryUpdateOne) Save(ctx context.Context) (*DigestDelivery, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DigestDeliveryUpdateOne) SaveX(ctx context.Context) *DigestDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DigestDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DigestDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DigestDeliveryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := digestdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DigestDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := digestdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DigestDelivery.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DigestDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *DigestDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(digestdelivery.Table, digestdelivery.Columns, sqlgraph.NewFieldSpec(digestdelivery.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DigestDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, digestdelivery.FieldID)
+		for _, f := range fields {
+			if !digestdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != digestdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(digestdelivery.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(digestdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PartsTotal(); ok {
+		_spec.SetField(digestdelivery.FieldPartsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartsTotal(); ok {
+		_spec.AddField(digestdelivery.FieldPartsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PartsSent(); ok {
+		_spec.SetField(digestdelivery.FieldPartsSent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartsSent(); ok {
+		_spec.AddField(digestdelivery.FieldPartsSent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(digestdelivery.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(digestdelivery.FieldSentAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSentAt(); ok {
+		_spec.AddField(digestdelivery.FieldSentAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(digestdelivery.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(digestdelivery.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.DigestDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &DigestDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{digestdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
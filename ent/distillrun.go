@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/distillrun"
+	"github.com/luoling8192/mindwave/schema"
+)
+
+// DistillRun is the model entity for the DistillRun schema.
+type DistillRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+	// InChatID holds the value of the "in_chat_id" field.
+	InChatID string `json:"in_chat_id,omitempty"`
+	// SpanStart holds the value of the "span_start" field.
+	SpanStart int64 `json:"span_start,omitempty"`
+	// SpanEnd holds the value of the "span_end" field.
+	SpanEnd int64 `json:"span_end,omitempty"`
+	// Status holds the value of the "status" field.
+	Status distillrun.Status `json:"status,omitempty"`
+	// MessageCount holds the value of the "message_count" field.
+	MessageCount int `json:"message_count,omitempty"`
+	// ItemCount holds the value of the "item_count" field.
+	ItemCount int `json:"item_count,omitempty"`
+	// EventCount holds the value of the "event_count" field.
+	EventCount int `json:"event_count,omitempty"`
+	// PromptTokens holds the value of the "prompt_tokens" field.
+	PromptTokens int `json:"prompt_tokens,omitempty"`
+	// CompletionTokens holds the value of the "completion_tokens" field.
+	CompletionTokens int `json:"completion_tokens,omitempty"`
+	// Cost holds the value of the "cost" field.
+	Cost float64 `json:"cost,omitempty"`
+	// StageUsage holds the value of the "stage_usage" field.
+	StageUsage map[string]schema.StageUsage `json:"stage_usage,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// PartialOutput holds the value of the "partial_output" field.
+	PartialOutput string `json:"partial_output,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt int64 `json:"finished_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DistillRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case distillrun.FieldStageUsage:
+			values[i] = new([]byte)
+		case distillrun.FieldCost:
+			values[i] = new(sql.NullFloat64)
+		case distillrun.FieldSpanStart, distillrun.FieldSpanEnd, distillrun.FieldMessageCount, distillrun.FieldItemCount, distillrun.FieldEventCount, distillrun.FieldPromptTokens, distillrun.FieldCompletionTokens, distillrun.FieldFinishedAt, distillrun.FieldCreatedAt, distillrun.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case distillrun.FieldInChatID, distillrun.FieldStatus, distillrun.FieldError, distillrun.FieldPartialOutput:
+			values[i] = new(sql.NullString)
+		case distillrun.FieldID, distillrun.FieldWorkspaceID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DistillRun fields.
+func (_m *DistillRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case distillrun.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case distillrun.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
+		case distillrun.FieldInChatID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field in_chat_id", values[i])
+			} else if value.Valid {
+				_m.InChatID = value.String
+			}
+		case distillrun.FieldSpanStart:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field span_start", values[i])
+			} else if value.Valid {
+				_m.SpanStart = value.Int64
+			}
+		case distillrun.FieldSpanEnd:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field span_end", values[i])
+			} else if value.Valid {
+				_m.SpanEnd = value.Int64
+			}
+		case distillrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = distillrun.Status(value.String)
+			}
+		case distillrun.FieldMessageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_count", values[i])
+			} else if value.Valid {
+				_m.MessageCount = int(value.Int64)
+			}
+		case distillrun.FieldItemCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_count", values[i])
+			} else if value.Valid {
+				_m.ItemCount = int(value.Int64)
+			}
+		case distillrun.FieldEventCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_count", values[i])
+			} else if value.Valid {
+				_m.EventCount = int(value.Int64)
+			}
+		case distillrun.FieldPromptTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_tokens", values[i])
+			} else if value.Valid {
+				_m.PromptTokens = int(value.Int64)
+			}
+		case distillrun.FieldCompletionTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completion_tokens", values[i])
+			} else if value.Valid {
+				_m.CompletionTokens = int(value.Int64)
+			}
+		case distillrun.FieldCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				_m.Cost = value.Float64
+			}
+		case distillrun.FieldStageUsage:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field stage_usage", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.StageUsage); err != nil {
+					return fmt.Errorf("unmarshal field stage_usage: %w", err)
+				}
+			}
+		case distillrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case distillrun.FieldPartialOutput:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field partial_output", values[i])
+			} else if value.Valid {
+				_m.PartialOutput = value.String
+			}
+		case distillrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = value.Int64
+			}
+		case distillrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case distillrun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DistillRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *DistillRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DistillRun.
+// Note that you need to call DistillRun.Unwrap() before calling this method if this DistillRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DistillRun) Update() *DistillRunUpdateOne {
+	return NewDistillRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DistillRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DistillRun) Unwrap() *DistillRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DistillRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DistillRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("DistillRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("in_chat_id=")
+	builder.WriteString(_m.InChatID)
+	builder.WriteString(", ")
+	builder.WriteString("span_start=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SpanStart))
+	builder.WriteString(", ")
+	builder.WriteString("span_end=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SpanEnd))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("message_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageCount))
+	builder.WriteString(", ")
+	builder.WriteString("item_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemCount))
+	builder.WriteString(", ")
+	builder.WriteString("event_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventCount))
+	builder.WriteString(", ")
+	builder.WriteString("prompt_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PromptTokens))
+	builder.WriteString(", ")
+	builder.WriteString("completion_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompletionTokens))
+	builder.WriteString(", ")
+	builder.WriteString("cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cost))
+	builder.WriteString(", ")
+	builder.WriteString("stage_usage=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StageUsage))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("partial_output=")
+	builder.WriteString(_m.PartialOutput)
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FinishedAt))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DistillRuns is a parsable slice of DistillRun.
+type DistillRuns []*DistillRun
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package distillrun
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/schema"
+)
+
+const (
+	// Label holds the string label denoting the distillrun type in the database.
+	Label = "distill_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldInChatID holds the string denoting the in_chat_id field in the database.
+	FieldInChatID = "in_chat_id"
+	// FieldSpanStart holds the string denoting the span_start field in the database.
+	FieldSpanStart = "span_start"
+	// FieldSpanEnd holds the string denoting the span_end field in the database.
+	FieldSpanEnd = "span_end"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMessageCount holds the string denoting the message_count field in the database.
+	FieldMessageCount = "message_count"
+	// FieldItemCount holds the string denoting the item_count field in the database.
+	FieldItemCount = "item_count"
+	// FieldEventCount holds the string denoting the event_count field in the database.
+	FieldEventCount = "event_count"
+	// FieldPromptTokens holds the string denoting the prompt_tokens field in the database.
+	FieldPromptTokens = "prompt_tokens"
+	// FieldCompletionTokens holds the string denoting the completion_tokens field in the database.
+	FieldCompletionTokens = "completion_tokens"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
+	// FieldStageUsage holds the string denoting the stage_usage field in the database.
+	FieldStageUsage = "stage_usage"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldPartialOutput holds the string denoting the partial_output field in the database.
+	FieldPartialOutput = "partial_output"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the distillrun in the database.
+	Table = "distill_runs"
+)
+
+// Columns holds all SQL columns for distillrun fields.
+var Columns = []string{
+	FieldID,
+	FieldWorkspaceID,
+	FieldInChatID,
+	FieldSpanStart,
+	FieldSpanEnd,
+	FieldStatus,
+	FieldMessageCount,
+	FieldItemCount,
+	FieldEventCount,
+	FieldPromptTokens,
+	FieldCompletionTokens,
+	FieldCost,
+	FieldStageUsage,
+	FieldError,
+	FieldPartialOutput,
+	FieldFinishedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultWorkspaceID holds the default value on creation for the "workspace_id" field.
+	DefaultWorkspaceID func() uuid.UUID
+	// InChatIDValidator is a validator for the "in_chat_id" field. It is called by the builders before save.
+	InChatIDValidator func(string) error
+	// DefaultMessageCount holds the default value on creation for the "message_count" field.
+	DefaultMessageCount int
+	// DefaultItemCount holds the default value on creation for the "item_count" field.
+	DefaultItemCount int
+	// DefaultEventCount holds the default value on creation for the "event_count" field.
+	DefaultEventCount int
+	// DefaultPromptTokens holds the default value on creation for the "prompt_tokens" field.
+	DefaultPromptTokens int
+	// DefaultCompletionTokens holds the default value on creation for the "completion_tokens" field.
+	DefaultCompletionTokens int
+	// DefaultCost holds the default value on creation for the "cost" field.
+	DefaultCost float64
+	// DefaultStageUsage holds the default value on creation for the "stage_usage" field.
+	DefaultStageUsage map[string]schema.StageUsage
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
+	// DefaultPartialOutput holds the default value on creation for the "partial_output" field.
+	DefaultPartialOutput string
+	// DefaultFinishedAt holds the default value on creation for the "finished_at" field.
+	DefaultFinishedAt int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() int64
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusRunning is the default value of the Status enum.
+const DefaultStatus = StatusRunning
+
+// Status values.
+const (
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusRunning, StatusSucceeded, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("distillrun: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the DistillRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByInChatID orders the results by the in_chat_id field.
+func ByInChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInChatID, opts...).ToFunc()
+}
+
+// BySpanStart orders the results by the span_start field.
+func BySpanStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpanStart, opts...).ToFunc()
+}
+
+// BySpanEnd orders the results by the span_end field.
+func BySpanEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpanEnd, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMessageCount orders the results by the message_count field.
+func ByMessageCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageCount, opts...).ToFunc()
+}
+
+// ByItemCount orders the results by the item_count field.
+func ByItemCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemCount, opts...).ToFunc()
+}
+
+// ByEventCount orders the results by the event_count field.
+func ByEventCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventCount, opts...).ToFunc()
+}
+
+// ByPromptTokens orders the results by the prompt_tokens field.
+func ByPromptTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptTokens, opts...).ToFunc()
+}
+
+// ByCompletionTokens orders the results by the completion_tokens field.
+func ByCompletionTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletionTokens, opts...).ToFunc()
+}
+
+// ByCost orders the results by the cost field.
+func ByCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCost, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByPartialOutput orders the results by the partial_output field.
+func ByPartialOutput(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPartialOutput, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
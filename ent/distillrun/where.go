@@ -0,0 +1,839 @@
+// Code generated by ent, DO NOT EDIT.
+
+package distillrun
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// InChatID applies equality check predicate on the "in_chat_id" field. It's identical to InChatIDEQ.
+func InChatID(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldInChatID, v))
+}
+
+// SpanStart applies equality check predicate on the "span_start" field. It's identical to SpanStartEQ.
+func SpanStart(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldSpanStart, v))
+}
+
+// SpanEnd applies equality check predicate on the "span_end" field. It's identical to SpanEndEQ.
+func SpanEnd(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldSpanEnd, v))
+}
+
+// MessageCount applies equality check predicate on the "message_count" field. It's identical to MessageCountEQ.
+func MessageCount(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// ItemCount applies equality check predicate on the "item_count" field. It's identical to ItemCountEQ.
+func ItemCount(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldItemCount, v))
+}
+
+// EventCount applies equality check predicate on the "event_count" field. It's identical to EventCountEQ.
+func EventCount(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldEventCount, v))
+}
+
+// PromptTokens applies equality check predicate on the "prompt_tokens" field. It's identical to PromptTokensEQ.
+func PromptTokens(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// CompletionTokens applies equality check predicate on the "completion_tokens" field. It's identical to CompletionTokensEQ.
+func CompletionTokens(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v float64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldCost, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldError, v))
+}
+
+// PartialOutput applies equality check predicate on the "partial_output" field. It's identical to PartialOutputEQ.
+func PartialOutput(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldPartialOutput, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
+// InChatIDEQ applies the EQ predicate on the "in_chat_id" field.
+func InChatIDEQ(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldInChatID, v))
+}
+
+// InChatIDNEQ applies the NEQ predicate on the "in_chat_id" field.
+func InChatIDNEQ(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldInChatID, v))
+}
+
+// InChatIDIn applies the In predicate on the "in_chat_id" field.
+func InChatIDIn(vs ...string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldInChatID, vs...))
+}
+
+// InChatIDNotIn applies the NotIn predicate on the "in_chat_id" field.
+func InChatIDNotIn(vs ...string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldInChatID, vs...))
+}
+
+// InChatIDGT applies the GT predicate on the "in_chat_id" field.
+func InChatIDGT(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldInChatID, v))
+}
+
+// InChatIDGTE applies the GTE predicate on the "in_chat_id" field.
+func InChatIDGTE(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldInChatID, v))
+}
+
+// InChatIDLT applies the LT predicate on the "in_chat_id" field.
+func InChatIDLT(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldInChatID, v))
+}
+
+// InChatIDLTE applies the LTE predicate on the "in_chat_id" field.
+func InChatIDLTE(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldInChatID, v))
+}
+
+// InChatIDContains applies the Contains predicate on the "in_chat_id" field.
+func InChatIDContains(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldContains(FieldInChatID, v))
+}
+
+// InChatIDHasPrefix applies the HasPrefix predicate on the "in_chat_id" field.
+func InChatIDHasPrefix(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldHasPrefix(FieldInChatID, v))
+}
+
+// InChatIDHasSuffix applies the HasSuffix predicate on the "in_chat_id" field.
+func InChatIDHasSuffix(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldHasSuffix(FieldInChatID, v))
+}
+
+// InChatIDEqualFold applies the EqualFold predicate on the "in_chat_id" field.
+func InChatIDEqualFold(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEqualFold(FieldInChatID, v))
+}
+
+// InChatIDContainsFold applies the ContainsFold predicate on the "in_chat_id" field.
+func InChatIDContainsFold(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldContainsFold(FieldInChatID, v))
+}
+
+// SpanStartEQ applies the EQ predicate on the "span_start" field.
+func SpanStartEQ(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldSpanStart, v))
+}
+
+// SpanStartNEQ applies the NEQ predicate on the "span_start" field.
+func SpanStartNEQ(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldSpanStart, v))
+}
+
+// SpanStartIn applies the In predicate on the "span_start" field.
+func SpanStartIn(vs ...int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldSpanStart, vs...))
+}
+
+// SpanStartNotIn applies the NotIn predicate on the "span_start" field.
+func SpanStartNotIn(vs ...int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldSpanStart, vs...))
+}
+
+// SpanStartGT applies the GT predicate on the "span_start" field.
+func SpanStartGT(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldSpanStart, v))
+}
+
+// SpanStartGTE applies the GTE predicate on the "span_start" field.
+func SpanStartGTE(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldSpanStart, v))
+}
+
+// SpanStartLT applies the LT predicate on the "span_start" field.
+func SpanStartLT(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldSpanStart, v))
+}
+
+// SpanStartLTE applies the LTE predicate on the "span_start" field.
+func SpanStartLTE(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldSpanStart, v))
+}
+
+// SpanEndEQ applies the EQ predicate on the "span_end" field.
+func SpanEndEQ(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldSpanEnd, v))
+}
+
+// SpanEndNEQ applies the NEQ predicate on the "span_end" field.
+func SpanEndNEQ(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldSpanEnd, v))
+}
+
+// SpanEndIn applies the In predicate on the "span_end" field.
+func SpanEndIn(vs ...int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldSpanEnd, vs...))
+}
+
+// SpanEndNotIn applies the NotIn predicate on the "span_end" field.
+func SpanEndNotIn(vs ...int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldSpanEnd, vs...))
+}
+
+// SpanEndGT applies the GT predicate on the "span_end" field.
+func SpanEndGT(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldSpanEnd, v))
+}
+
+// SpanEndGTE applies the GTE predicate on the "span_end" field.
+func SpanEndGTE(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldSpanEnd, v))
+}
+
+// SpanEndLT applies the LT predicate on the "span_end" field.
+func SpanEndLT(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldSpanEnd, v))
+}
+
+// SpanEndLTE applies the LTE predicate on the "span_end" field.
+func SpanEndLTE(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldSpanEnd, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// MessageCountEQ applies the EQ predicate on the "message_count" field.
+func MessageCountEQ(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// MessageCountNEQ applies the NEQ predicate on the "message_count" field.
+func MessageCountNEQ(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldMessageCount, v))
+}
+
+// MessageCountIn applies the In predicate on the "message_count" field.
+func MessageCountIn(vs ...int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldMessageCount, vs...))
+}
+
+// MessageCountNotIn applies the NotIn predicate on the "message_count" field.
+func MessageCountNotIn(vs ...int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldMessageCount, vs...))
+}
+
+// MessageCountGT applies the GT predicate on the "message_count" field.
+func MessageCountGT(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldMessageCount, v))
+}
+
+// MessageCountGTE applies the GTE predicate on the "message_count" field.
+func MessageCountGTE(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldMessageCount, v))
+}
+
+// MessageCountLT applies the LT predicate on the "message_count" field.
+func MessageCountLT(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldMessageCount, v))
+}
+
+// MessageCountLTE applies the LTE predicate on the "message_count" field.
+func MessageCountLTE(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldMessageCount, v))
+}
+
+// ItemCountEQ applies the EQ predicate on the "item_count" field.
+func ItemCountEQ(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldItemCount, v))
+}
+
+// ItemCountNEQ applies the NEQ predicate on the "item_count" field.
+func ItemCountNEQ(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldItemCount, v))
+}
+
+// ItemCountIn applies the In predicate on the "item_count" field.
+func ItemCountIn(vs ...int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldItemCount, vs...))
+}
+
+// ItemCountNotIn applies the NotIn predicate on the "item_count" field.
+func ItemCountNotIn(vs ...int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldItemCount, vs...))
+}
+
+// ItemCountGT applies the GT predicate on the "item_count" field.
+func ItemCountGT(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldItemCount, v))
+}
+
+// ItemCountGTE applies the GTE predicate on the "item_count" field.
+func ItemCountGTE(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldItemCount, v))
+}
+
+// ItemCountLT applies the LT predicate on the "item_count" field.
+func ItemCountLT(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldItemCount, v))
+}
+
+// ItemCountLTE applies the LTE predicate on the "item_count" field.
+func ItemCountLTE(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldItemCount, v))
+}
+
+// EventCountEQ applies the EQ predicate on the "event_count" field.
+func EventCountEQ(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldEventCount, v))
+}
+
+// EventCountNEQ applies the NEQ predicate on the "event_count" field.
+func EventCountNEQ(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldEventCount, v))
+}
+
+// EventCountIn applies the In predicate on the "event_count" field.
+func EventCountIn(vs ...int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldEventCount, vs...))
+}
+
+// EventCountNotIn applies the NotIn predicate on the "event_count" field.
+func EventCountNotIn(vs ...int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldEventCount, vs...))
+}
+
+// EventCountGT applies the GT predicate on the "event_count" field.
+func EventCountGT(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldEventCount, v))
+}
+
+// EventCountGTE applies the GTE predicate on the "event_count" field.
+func EventCountGTE(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldEventCount, v))
+}
+
+// EventCountLT applies the LT predicate on the "event_count" field.
+func EventCountLT(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldEventCount, v))
+}
+
+// EventCountLTE applies the LTE predicate on the "event_count" field.
+func EventCountLTE(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldEventCount, v))
+}
+
+// PromptTokensEQ applies the EQ predicate on the "prompt_tokens" field.
+func PromptTokensEQ(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensNEQ applies the NEQ predicate on the "prompt_tokens" field.
+func PromptTokensNEQ(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensIn applies the In predicate on the "prompt_tokens" field.
+func PromptTokensIn(vs ...int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensNotIn applies the NotIn predicate on the "prompt_tokens" field.
+func PromptTokensNotIn(vs ...int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensGT applies the GT predicate on the "prompt_tokens" field.
+func PromptTokensGT(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldPromptTokens, v))
+}
+
+// PromptTokensGTE applies the GTE predicate on the "prompt_tokens" field.
+func PromptTokensGTE(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldPromptTokens, v))
+}
+
+// PromptTokensLT applies the LT predicate on the "prompt_tokens" field.
+func PromptTokensLT(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldPromptTokens, v))
+}
+
+// PromptTokensLTE applies the LTE predicate on the "prompt_tokens" field.
+func PromptTokensLTE(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldPromptTokens, v))
+}
+
+// CompletionTokensEQ applies the EQ predicate on the "completion_tokens" field.
+func CompletionTokensEQ(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensNEQ applies the NEQ predicate on the "completion_tokens" field.
+func CompletionTokensNEQ(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensIn applies the In predicate on the "completion_tokens" field.
+func CompletionTokensIn(vs ...int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensNotIn applies the NotIn predicate on the "completion_tokens" field.
+func CompletionTokensNotIn(vs ...int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensGT applies the GT predicate on the "completion_tokens" field.
+func CompletionTokensGT(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensGTE applies the GTE predicate on the "completion_tokens" field.
+func CompletionTokensGTE(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLT applies the LT predicate on the "completion_tokens" field.
+func CompletionTokensLT(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLTE applies the LTE predicate on the "completion_tokens" field.
+func CompletionTokensLTE(v int) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldCompletionTokens, v))
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v float64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldCost, v))
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v float64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldCost, v))
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...float64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldCost, vs...))
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...float64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldCost, vs...))
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v float64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldCost, v))
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v float64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldCost, v))
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v float64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldCost, v))
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v float64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldCost, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// PartialOutputEQ applies the EQ predicate on the "partial_output" field.
+func PartialOutputEQ(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldPartialOutput, v))
+}
+
+// PartialOutputNEQ applies the NEQ predicate on the "partial_output" field.
+func PartialOutputNEQ(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldPartialOutput, v))
+}
+
+// PartialOutputIn applies the In predicate on the "partial_output" field.
+func PartialOutputIn(vs ...string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldPartialOutput, vs...))
+}
+
+// PartialOutputNotIn applies the NotIn predicate on the "partial_output" field.
+func PartialOutputNotIn(vs ...string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldPartialOutput, vs...))
+}
+
+// PartialOutputGT applies the GT predicate on the "partial_output" field.
+func PartialOutputGT(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldPartialOutput, v))
+}
+
+// PartialOutputGTE applies the GTE predicate on the "partial_output" field.
+func PartialOutputGTE(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldPartialOutput, v))
+}
+
+// PartialOutputLT applies the LT predicate on the "partial_output" field.
+func PartialOutputLT(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldPartialOutput, v))
+}
+
+// PartialOutputLTE applies the LTE predicate on the "partial_output" field.
+func PartialOutputLTE(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldPartialOutput, v))
+}
+
+// PartialOutputContains applies the Contains predicate on the "partial_output" field.
+func PartialOutputContains(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldContains(FieldPartialOutput, v))
+}
+
+// PartialOutputHasPrefix applies the HasPrefix predicate on the "partial_output" field.
+func PartialOutputHasPrefix(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldHasPrefix(FieldPartialOutput, v))
+}
+
+// PartialOutputHasSuffix applies the HasSuffix predicate on the "partial_output" field.
+func PartialOutputHasSuffix(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldHasSuffix(FieldPartialOutput, v))
+}
+
+// PartialOutputEqualFold applies the EqualFold predicate on the "partial_output" field.
+func PartialOutputEqualFold(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEqualFold(FieldPartialOutput, v))
+}
+
+// PartialOutputContainsFold applies the ContainsFold predicate on the "partial_output" field.
+func PartialOutputContainsFold(v string) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldContainsFold(FieldPartialOutput, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.DistillRun {
+	return predicate.DistillRun(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DistillRun) predicate.DistillRun {
+	return predicate.DistillRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DistillRun) predicate.DistillRun {
+	return predicate.DistillRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DistillRun) predicate.DistillRun {
+	return predicate.DistillRun(sql.NotPredicates(p))
+}
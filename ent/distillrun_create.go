@@ -0,0 +1,1552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/distillrun"
+	"github.com/luoling8192/mindwave/schema"
+)
+
+// DistillRunCreate is the builder for creating a DistillRun entity.
+type DistillRunCreate struct {
+	config
+	mutation *DistillRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *DistillRunCreate) SetWorkspaceID(v uuid.UUID) *DistillRunCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableWorkspaceID(v *uuid.UUID) *DistillRunCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (_c *DistillRunCreate) SetInChatID(v string) *DistillRunCreate {
+	_c.mutation.SetInChatID(v)
+	return _c
+}
+
+// SetSpanStart sets the "span_start" field.
+func (_c *DistillRunCreate) SetSpanStart(v int64) *DistillRunCreate {
+	_c.mutation.SetSpanStart(v)
+	return _c
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (_c *DistillRunCreate) SetSpanEnd(v int64) *DistillRunCreate {
+	_c.mutation.SetSpanEnd(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DistillRunCreate) SetStatus(v distillrun.Status) *DistillRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableStatus(v *distillrun.Status) *DistillRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_c *DistillRunCreate) SetMessageCount(v int) *DistillRunCreate {
+	_c.mutation.SetMessageCount(v)
+	return _c
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableMessageCount(v *int) *DistillRunCreate {
+	if v != nil {
+		_c.SetMessageCount(*v)
+	}
+	return _c
+}
+
+// SetItemCount sets the "item_count" field.
+func (_c *DistillRunCreate) SetItemCount(v int) *DistillRunCreate {
+	_c.mutation.SetItemCount(v)
+	return _c
+}
+
+// SetNillableItemCount sets the "item_count" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableItemCount(v *int) *DistillRunCreate {
+	if v != nil {
+		_c.SetItemCount(*v)
+	}
+	return _c
+}
+
+// SetEventCount sets the "event_count" field.
+func (_c *DistillRunCreate) SetEventCount(v int) *DistillRunCreate {
+	_c.mutation.SetEventCount(v)
+	return _c
+}
+
+// SetNillableEventCount sets the "event_count" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableEventCount(v *int) *DistillRunCreate {
+	if v != nil {
+		_c.SetEventCount(*v)
+	}
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *DistillRunCreate) SetPromptTokens(v int) *DistillRunCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillablePromptTokens(v *int) *DistillRunCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_c *DistillRunCreate) SetCompletionTokens(v int) *DistillRunCreate {
+	_c.mutation.SetCompletionTokens(v)
+	return _c
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableCompletionTokens(v *int) *DistillRunCreate {
+	if v != nil {
+		_c.SetCompletionTokens(*v)
+	}
+	return _c
+}
+
+// SetCost sets the "cost" field.
+func (_c *DistillRunCreate) SetCost(v float64) *DistillRunCreate {
+	_c.mutation.SetCost(v)
+	return _c
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableCost(v *float64) *DistillRunCreate {
+	if v != nil {
+		_c.SetCost(*v)
+	}
+	return _c
+}
+
+// SetStageUsage sets the "stage_usage" field.
+func (_c *DistillRunCreate) SetStageUsage(v map[string]schema.StageUsage) *DistillRunCreate {
+	_c.mutation.SetStageUsage(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *DistillRunCreate) SetError(v string) *DistillRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableError(v *string) *DistillRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetPartialOutput sets the "partial_output" field.
+func (_c *DistillRunCreate) SetPartialOutput(v string) *DistillRunCreate {
+	_c.mutation.SetPartialOutput(v)
+	return _c
+}
+
+// SetNillablePartialOutput sets the "partial_output" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillablePartialOutput(v *string) *DistillRunCreate {
+	if v != nil {
+		_c.SetPartialOutput(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *DistillRunCreate) SetFinishedAt(v int64) *DistillRunCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableFinishedAt(v *int64) *DistillRunCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DistillRunCreate) SetCreatedAt(v int64) *DistillRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableCreatedAt(v *int64) *DistillRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DistillRunCreate) SetUpdatedAt(v int64) *DistillRunCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableUpdatedAt(v *int64) *DistillRunCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DistillRunCreate) SetID(v uuid.UUID) *DistillRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DistillRunCreate) SetNillableID(v *uuid.UUID) *DistillRunCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DistillRunMutation object of the builder.
+func (_c *DistillRunCreate) Mutation() *DistillRunMutation {
+	return _c.mutation
+}
+
+// Save creates the DistillRun in the database.
+func (_c *DistillRunCreate) Save(ctx context.Context) (*DistillRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DistillRunCreate) SaveX(ctx context.Context) *DistillRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DistillRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DistillRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DistillRunCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := distillrun.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := distillrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		v := distillrun.DefaultMessageCount
+		_c.mutation.SetMessageCount(v)
+	}
+	if _, ok := _c.mutation.ItemCount(); !ok {
+		v := distillrun.DefaultItemCount
+		_c.mutation.SetItemCount(v)
+	}
+	if _, ok := _c.mutation.EventCount(); !ok {
+		v := distillrun.DefaultEventCount
+		_c.mutation.SetEventCount(v)
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		v := distillrun.DefaultPromptTokens
+		_c.mutation.SetPromptTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		v := distillrun.DefaultCompletionTokens
+		_c.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := _c.mutation.Cost(); !ok {
+		v := distillrun.DefaultCost
+		_c.mutation.SetCost(v)
+	}
+	if _, ok := _c.mutation.StageUsage(); !ok {
+		v := distillrun.DefaultStageUsage
+		_c.mutation.SetStageUsage(v)
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		v := distillrun.DefaultError
+		_c.mutation.SetError(v)
+	}
+	if _, ok := _c.mutation.PartialOutput(); !ok {
+		v := distillrun.DefaultPartialOutput
+		_c.mutation.SetPartialOutput(v)
+	}
+	if _, ok := _c.mutation.FinishedAt(); !ok {
+		v := distillrun.DefaultFinishedAt
+		_c.mutation.SetFinishedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := distillrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := distillrun.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := distillrun.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DistillRunCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "DistillRun.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.InChatID(); !ok {
+		return &ValidationError{Name: "in_chat_id", err: errors.New(`ent: missing required field "DistillRun.in_chat_id"`)}
+	}
+	if v, ok := _c.mutation.InChatID(); ok {
+		if err := distillrun.InChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "in_chat_id", err: fmt.Errorf(`ent: validator failed for field "DistillRun.in_chat_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SpanStart(); !ok {
+		return &ValidationError{Name: "span_start", err: errors.New(`ent: missing required field "DistillRun.span_start"`)}
+	}
+	if _, ok := _c.mutation.SpanEnd(); !ok {
+		return &ValidationError{Name: "span_end", err: errors.New(`ent: missing required field "DistillRun.span_end"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DistillRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := distillrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DistillRun.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		return &ValidationError{Name: "message_count", err: errors.New(`ent: missing required field "DistillRun.message_count"`)}
+	}
+	if _, ok := _c.mutation.ItemCount(); !ok {
+		return &ValidationError{Name: "item_count", err: errors.New(`ent: missing required field "DistillRun.item_count"`)}
+	}
+	if _, ok := _c.mutation.EventCount(); !ok {
+		return &ValidationError{Name: "event_count", err: errors.New(`ent: missing required field "DistillRun.event_count"`)}
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "DistillRun.prompt_tokens"`)}
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "DistillRun.completion_tokens"`)}
+	}
+	if _, ok := _c.mutation.Cost(); !ok {
+		return &ValidationError{Name: "cost", err: errors.New(`ent: missing required field "DistillRun.cost"`)}
+	}
+	if _, ok := _c.mutation.StageUsage(); !ok {
+		return &ValidationError{Name: "stage_usage", err: errors.New(`ent: missing required field "DistillRun.stage_usage"`)}
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "DistillRun.error"`)}
+	}
+	if _, ok := _c.mutation.PartialOutput(); !ok {
+		return &ValidationError{Name: "partial_output", err: errors.New(`ent: missing required field "DistillRun.partial_output"`)}
+	}
+	if _, ok := _c.mutation.FinishedAt(); !ok {
+		return &ValidationError{Name: "finished_at", err: errors.New(`ent: missing required field "DistillRun.finished_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DistillRun.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DistillRun.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DistillRunCreate) sqlSave(ctx context.Context) (*DistillRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DistillRunCreate) createSpec() (*DistillRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DistillRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(distillrun.Table, sqlgraph.NewFieldSpec(distillrun.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.DistillRun
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(distillrun.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.InChatID(); ok {
+		_spec.SetField(distillrun.FieldInChatID, field.TypeString, value)
+		_node.InChatID = value
+	}
+	if value, ok := _c.mutation.SpanStart(); ok {
+		_spec.SetField(distillrun.FieldSpanStart, field.TypeInt64, value)
+		_node.SpanStart = value
+	}
+	if value, ok := _c.mutation.SpanEnd(); ok {
+		_spec.SetField(distillrun.FieldSpanEnd, field.TypeInt64, value)
+		_node.SpanEnd = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(distillrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.MessageCount(); ok {
+		_spec.SetField(distillrun.FieldMessageCount, field.TypeInt, value)
+		_node.MessageCount = value
+	}
+	if value, ok := _c.mutation.ItemCount(); ok {
+		_spec.SetField(distillrun.FieldItemCount, field.TypeInt, value)
+		_node.ItemCount = value
+	}
+	if value, ok := _c.mutation.EventCount(); ok {
+		_spec.SetField(distillrun.FieldEventCount, field.TypeInt, value)
+		_node.EventCount = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(distillrun.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := _c.mutation.CompletionTokens(); ok {
+		_spec.SetField(distillrun.FieldCompletionTokens, field.TypeInt, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := _c.mutation.Cost(); ok {
+		_spec.SetField(distillrun.FieldCost, field.TypeFloat64, value)
+		_node.Cost = value
+	}
+	if value, ok := _c.mutation.StageUsage(); ok {
+		_spec.SetField(distillrun.FieldStageUsage, field.TypeJSON, value)
+		_node.StageUsage = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(distillrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.PartialOutput(); ok {
+		_spec.SetField(distillrun.FieldPartialOutput, field.TypeString, value)
+		_node.PartialOutput = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(distillrun.FieldFinishedAt, field.TypeInt64, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(distillrun.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(distillrun.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DistillRun.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DistillRunUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DistillRunCreate) OnConflict(opts ...sql.ConflictOption) *DistillRunUpsertOne {
+	_c.conflict = opts
+	return &DistillRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DistillRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DistillRunCreate) OnConflictColumns(columns ...string) *DistillRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DistillRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DistillRunUpsertOne is the builder for "upsert"-ing
+	//  one DistillRun node.
+	DistillRunUpsertOne struct {
+		create *DistillRunCreate
+	}
+
+	// DistillRunUpsert is the "OnConflict" setter.
+	DistillRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *DistillRunUpsert) SetWorkspaceID(v uuid.UUID) *DistillRunUpsert {
+	u.Set(distillrun.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateWorkspaceID() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldWorkspaceID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DistillRunUpsert) SetStatus(v distillrun.Status) *DistillRunUpsert {
+	u.Set(distillrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateStatus() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldStatus)
+	return u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *DistillRunUpsert) SetMessageCount(v int) *DistillRunUpsert {
+	u.Set(distillrun.FieldMessageCount, v)
+	return u
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateMessageCount() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldMessageCount)
+	return u
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *DistillRunUpsert) AddMessageCount(v int) *DistillRunUpsert {
+	u.Add(distillrun.FieldMessageCount, v)
+	return u
+}
+
+// SetItemCount sets the "item_count" field.
+func (u *DistillRunUpsert) SetItemCount(v int) *DistillRunUpsert {
+	u.Set(distillrun.FieldItemCount, v)
+	return u
+}
+
+// UpdateItemCount sets the "item_count" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateItemCount() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldItemCount)
+	return u
+}
+
+// AddItemCount adds v to the "item_count" field.
+func (u *DistillRunUpsert) AddItemCount(v int) *DistillRunUpsert {
+	u.Add(distillrun.FieldItemCount, v)
+	return u
+}
+
+// SetEventCount sets the "event_count" field.
+func (u *DistillRunUpsert) SetEventCount(v int) *DistillRunUpsert {
+	u.Set(distillrun.FieldEventCount, v)
+	return u
+}
+
+// UpdateEventCount sets the "event_count" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateEventCount() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldEventCount)
+	return u
+}
+
+// AddEventCount adds v to the "event_count" field.
+func (u *DistillRunUpsert) AddEventCount(v int) *DistillRunUpsert {
+	u.Add(distillrun.FieldEventCount, v)
+	return u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *DistillRunUpsert) SetPromptTokens(v int) *DistillRunUpsert {
+	u.Set(distillrun.FieldPromptTokens, v)
+	return u
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdatePromptTokens() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldPromptTokens)
+	return u
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *DistillRunUpsert) AddPromptTokens(v int) *DistillRunUpsert {
+	u.Add(distillrun.FieldPromptTokens, v)
+	return u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *DistillRunUpsert) SetCompletionTokens(v int) *DistillRunUpsert {
+	u.Set(distillrun.FieldCompletionTokens, v)
+	return u
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateCompletionTokens() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldCompletionTokens)
+	return u
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *DistillRunUpsert) AddCompletionTokens(v int) *DistillRunUpsert {
+	u.Add(distillrun.FieldCompletionTokens, v)
+	return u
+}
+
+// SetCost sets the "cost" field.
+func (u *DistillRunUpsert) SetCost(v float64) *DistillRunUpsert {
+	u.Set(distillrun.FieldCost, v)
+	return u
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateCost() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldCost)
+	return u
+}
+
+// AddCost adds v to the "cost" field.
+func (u *DistillRunUpsert) AddCost(v float64) *DistillRunUpsert {
+	u.Add(distillrun.FieldCost, v)
+	return u
+}
+
+// SetStageUsage sets the "stage_usage" field.
+func (u *DistillRunUpsert) SetStageUsage(v map[string]schema.StageUsage) *DistillRunUpsert {
+	u.Set(distillrun.FieldStageUsage, v)
+	return u
+}
+
+// UpdateStageUsage sets the "stage_usage" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateStageUsage() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldStageUsage)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *DistillRunUpsert) SetError(v string) *DistillRunUpsert {
+	u.Set(distillrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateError() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldError)
+	return u
+}
+
+// SetPartialOutput sets the "partial_output" field.
+func (u *DistillRunUpsert) SetPartialOutput(v string) *DistillRunUpsert {
+	u.Set(distillrun.FieldPartialOutput, v)
+	return u
+}
+
+// UpdatePartialOutput sets the "partial_output" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdatePartialOutput() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldPartialOutput)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *DistillRunUpsert) SetFinishedAt(v int64) *DistillRunUpsert {
+	u.Set(distillrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateFinishedAt() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldFinishedAt)
+	return u
+}
+
+// AddFinishedAt adds v to the "finished_at" field.
+func (u *DistillRunUpsert) AddFinishedAt(v int64) *DistillRunUpsert {
+	u.Add(distillrun.FieldFinishedAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DistillRunUpsert) SetUpdatedAt(v int64) *DistillRunUpsert {
+	u.Set(distillrun.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DistillRunUpsert) UpdateUpdatedAt() *DistillRunUpsert {
+	u.SetExcluded(distillrun.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *DistillRunUpsert) AddUpdatedAt(v int64) *DistillRunUpsert {
+	u.Add(distillrun.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DistillRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(distillrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DistillRunUpsertOne) UpdateNewValues() *DistillRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(distillrun.FieldID)
+		}
+		if _, exists := u.create.mutation.InChatID(); exists {
+			s.SetIgnore(distillrun.FieldInChatID)
+		}
+		if _, exists := u.create.mutation.SpanStart(); exists {
+			s.SetIgnore(distillrun.FieldSpanStart)
+		}
+		if _, exists := u.create.mutation.SpanEnd(); exists {
+			s.SetIgnore(distillrun.FieldSpanEnd)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(distillrun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DistillRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DistillRunUpsertOne) Ignore() *DistillRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DistillRunUpsertOne) DoNothing() *DistillRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DistillRunCreate.OnConflict
+// documentation for more info.
+func (u *DistillRunUpsertOne) Update(set func(*DistillRunUpsert)) *DistillRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DistillRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *DistillRunUpsertOne) SetWorkspaceID(v uuid.UUID) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateWorkspaceID() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DistillRunUpsertOne) SetStatus(v distillrun.Status) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateStatus() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *DistillRunUpsertOne) SetMessageCount(v int) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetMessageCount(v)
+	})
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *DistillRunUpsertOne) AddMessageCount(v int) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddMessageCount(v)
+	})
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateMessageCount() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateMessageCount()
+	})
+}
+
+// SetItemCount sets the "item_count" field.
+func (u *DistillRunUpsertOne) SetItemCount(v int) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetItemCount(v)
+	})
+}
+
+// AddItemCount adds v to the "item_count" field.
+func (u *DistillRunUpsertOne) AddItemCount(v int) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddItemCount(v)
+	})
+}
+
+// UpdateItemCount sets the "item_count" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateItemCount() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateItemCount()
+	})
+}
+
+// SetEventCount sets the "event_count" field.
+func (u *DistillRunUpsertOne) SetEventCount(v int) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetEventCount(v)
+	})
+}
+
+// AddEventCount adds v to the "event_count" field.
+func (u *DistillRunUpsertOne) AddEventCount(v int) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddEventCount(v)
+	})
+}
+
+// UpdateEventCount sets the "event_count" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateEventCount() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateEventCount()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *DistillRunUpsertOne) SetPromptTokens(v int) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *DistillRunUpsertOne) AddPromptTokens(v int) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdatePromptTokens() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *DistillRunUpsertOne) SetCompletionTokens(v int) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *DistillRunUpsertOne) AddCompletionTokens(v int) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateCompletionTokens() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// SetCost sets the "cost" field.
+func (u *DistillRunUpsertOne) SetCost(v float64) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetCost(v)
+	})
+}
+
+// AddCost adds v to the "cost" field.
+func (u *DistillRunUpsertOne) AddCost(v float64) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddCost(v)
+	})
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateCost() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateCost()
+	})
+}
+
+// SetStageUsage sets the "stage_usage" field.
+func (u *DistillRunUpsertOne) SetStageUsage(v map[string]schema.StageUsage) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetStageUsage(v)
+	})
+}
+
+// UpdateStageUsage sets the "stage_usage" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateStageUsage() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateStageUsage()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DistillRunUpsertOne) SetError(v string) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateError() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// SetPartialOutput sets the "partial_output" field.
+func (u *DistillRunUpsertOne) SetPartialOutput(v string) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetPartialOutput(v)
+	})
+}
+
+// UpdatePartialOutput sets the "partial_output" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdatePartialOutput() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdatePartialOutput()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *DistillRunUpsertOne) SetFinishedAt(v int64) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// AddFinishedAt adds v to the "finished_at" field.
+func (u *DistillRunUpsertOne) AddFinishedAt(v int64) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateFinishedAt() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DistillRunUpsertOne) SetUpdatedAt(v int64) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *DistillRunUpsertOne) AddUpdatedAt(v int64) *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DistillRunUpsertOne) UpdateUpdatedAt() *DistillRunUpsertOne {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DistillRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DistillRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DistillRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DistillRunUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DistillRunUpsertOne.ID is not supported by MySQL driver. Use DistillRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DistillRunUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DistillRunCreateBulk is the builder for creating many DistillRun entities in bulk.
+type DistillRunCreateBulk struct {
+	config
+	err      error
+	builders []*DistillRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DistillRun entities in the database.
+func (_c *DistillRunCreateBulk) Save(ctx context.Context) ([]*DistillRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DistillRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DistillRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DistillRunCreateBulk) SaveX(ctx context.Context) []*DistillRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DistillRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DistillRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DistillRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DistillRunUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DistillRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *DistillRunUpsertBulk {
+	_c.conflict = opts
+	return &DistillRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DistillRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DistillRunCreateBulk) OnConflictColumns(columns ...string) *DistillRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DistillRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// DistillRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of DistillRun nodes.
+type DistillRunUpsertBulk struct {
+	create *DistillRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DistillRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(distillrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DistillRunUpsertBulk) UpdateNewValues() *DistillRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(distillrun.FieldID)
+			}
+			if _, exists := b.mutation.InChatID(); exists {
+				s.SetIgnore(distillrun.FieldInChatID)
+			}
+			if _, exists := b.mutation.SpanStart(); exists {
+				s.SetIgnore(distillrun.FieldSpanStart)
+			}
+			if _, exists := b.mutation.SpanEnd(); exists {
+				s.SetIgnore(distillrun.FieldSpanEnd)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(distillrun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DistillRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DistillRunUpsertBulk) Ignore() *DistillRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DistillRunUpsertBulk) DoNothing() *DistillRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DistillRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *DistillRunUpsertBulk) Update(set func(*DistillRunUpsert)) *DistillRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DistillRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *DistillRunUpsertBulk) SetWorkspaceID(v uuid.UUID) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateWorkspaceID() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DistillRunUpsertBulk) SetStatus(v distillrun.Status) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateStatus() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *DistillRunUpsertBulk) SetMessageCount(v int) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetMessageCount(v)
+	})
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *DistillRunUpsertBulk) AddMessageCount(v int) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddMessageCount(v)
+	})
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateMessageCount() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateMessageCount()
+	})
+}
+
+// SetItemCount sets the "item_count" field.
+func (u *DistillRunUpsertBulk) SetItemCount(v int) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetItemCount(v)
+	})
+}
+
+// AddItemCount adds v to the "item_count" field.
+func (u *DistillRunUpsertBulk) AddItemCount(v int) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddItemCount(v)
+	})
+}
+
+// UpdateItemCount sets the "item_count" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateItemCount() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateItemCount()
+	})
+}
+
+// SetEventCount sets the "event_count" field.
+func (u *DistillRunUpsertBulk) SetEventCount(v int) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetEventCount(v)
+	})
+}
+
+// AddEventCount adds v to the "event_count" field.
+func (u *DistillRunUpsertBulk) AddEventCount(v int) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddEventCount(v)
+	})
+}
+
+// UpdateEventCount sets the "event_count" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateEventCount() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateEventCount()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *DistillRunUpsertBulk) SetPromptTokens(v int) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *DistillRunUpsertBulk) AddPromptTokens(v int) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdatePromptTokens() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *DistillRunUpsertBulk) SetCompletionTokens(v int) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *DistillRunUpsertBulk) AddCompletionTokens(v int) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateCompletionTokens() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// SetCost sets the "cost" field.
+func (u *DistillRunUpsertBulk) SetCost(v float64) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetCost(v)
+	})
+}
+
+// AddCost adds v to the "cost" field.
+func (u *DistillRunUpsertBulk) AddCost(v float64) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddCost(v)
+	})
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateCost() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateCost()
+	})
+}
+
+// SetStageUsage sets the "stage_usage" field.
+func (u *DistillRunUpsertBulk) SetStageUsage(v map[string]schema.StageUsage) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetStageUsage(v)
+	})
+}
+
+// UpdateStageUsage sets the "stage_usage" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateStageUsage() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateStageUsage()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DistillRunUpsertBulk) SetError(v string) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateError() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// SetPartialOutput sets the "partial_output" field.
+func (u *DistillRunUpsertBulk) SetPartialOutput(v string) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetPartialOutput(v)
+	})
+}
+
+// UpdatePartialOutput sets the "partial_output" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdatePartialOutput() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdatePartialOutput()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *DistillRunUpsertBulk) SetFinishedAt(v int64) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// AddFinishedAt adds v to the "finished_at" field.
+func (u *DistillRunUpsertBulk) AddFinishedAt(v int64) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateFinishedAt() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DistillRunUpsertBulk) SetUpdatedAt(v int64) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *DistillRunUpsertBulk) AddUpdatedAt(v int64) *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DistillRunUpsertBulk) UpdateUpdatedAt() *DistillRunUpsertBulk {
+	return u.Update(func(s *DistillRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DistillRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DistillRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DistillRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DistillRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
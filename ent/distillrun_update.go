@@ -0,0 +1,796 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/distillrun"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+	"github.com/luoling8192/mindwave/schema"
+)
+
+// DistillRunUpdate is the builder for updating DistillRun entities.
+type DistillRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DistillRunMutation
+}
+
+// Where appends a list predicates to the DistillRunUpdate builder.
+func (_u *DistillRunUpdate) Where(ps ...predicate.DistillRun) *DistillRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *DistillRunUpdate) SetWorkspaceID(v uuid.UUID) *DistillRunUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillableWorkspaceID(v *uuid.UUID) *DistillRunUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DistillRunUpdate) SetStatus(v distillrun.Status) *DistillRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillableStatus(v *distillrun.Status) *DistillRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_u *DistillRunUpdate) SetMessageCount(v int) *DistillRunUpdate {
+	_u.mutation.ResetMessageCount()
+	_u.mutation.SetMessageCount(v)
+	return _u
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillableMessageCount(v *int) *DistillRunUpdate {
+	if v != nil {
+		_u.SetMessageCount(*v)
+	}
+	return _u
+}
+
+// AddMessageCount adds value to the "message_count" field.
+func (_u *DistillRunUpdate) AddMessageCount(v int) *DistillRunUpdate {
+	_u.mutation.AddMessageCount(v)
+	return _u
+}
+
+// SetItemCount sets the "item_count" field.
+func (_u *DistillRunUpdate) SetItemCount(v int) *DistillRunUpdate {
+	_u.mutation.ResetItemCount()
+	_u.mutation.SetItemCount(v)
+	return _u
+}
+
+// SetNillableItemCount sets the "item_count" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillableItemCount(v *int) *DistillRunUpdate {
+	if v != nil {
+		_u.SetItemCount(*v)
+	}
+	return _u
+}
+
+// AddItemCount adds value to the "item_count" field.
+func (_u *DistillRunUpdate) AddItemCount(v int) *DistillRunUpdate {
+	_u.mutation.AddItemCount(v)
+	return _u
+}
+
+// SetEventCount sets the "event_count" field.
+func (_u *DistillRunUpdate) SetEventCount(v int) *DistillRunUpdate {
+	_u.mutation.ResetEventCount()
+	_u.mutation.SetEventCount(v)
+	return _u
+}
+
+// SetNillableEventCount sets the "event_count" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillableEventCount(v *int) *DistillRunUpdate {
+	if v != nil {
+		_u.SetEventCount(*v)
+	}
+	return _u
+}
+
+// AddEventCount adds value to the "event_count" field.
+func (_u *DistillRunUpdate) AddEventCount(v int) *DistillRunUpdate {
+	_u.mutation.AddEventCount(v)
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *DistillRunUpdate) SetPromptTokens(v int) *DistillRunUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillablePromptTokens(v *int) *DistillRunUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *DistillRunUpdate) AddPromptTokens(v int) *DistillRunUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *DistillRunUpdate) SetCompletionTokens(v int) *DistillRunUpdate {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillableCompletionTokens(v *int) *DistillRunUpdate {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *DistillRunUpdate) AddCompletionTokens(v int) *DistillRunUpdate {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *DistillRunUpdate) SetCost(v float64) *DistillRunUpdate {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillableCost(v *float64) *DistillRunUpdate {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *DistillRunUpdate) AddCost(v float64) *DistillRunUpdate {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
+// SetStageUsage sets the "stage_usage" field.
+func (_u *DistillRunUpdate) SetStageUsage(v map[string]schema.StageUsage) *DistillRunUpdate {
+	_u.mutation.SetStageUsage(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DistillRunUpdate) SetError(v string) *DistillRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillableError(v *string) *DistillRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetPartialOutput sets the "partial_output" field.
+func (_u *DistillRunUpdate) SetPartialOutput(v string) *DistillRunUpdate {
+	_u.mutation.SetPartialOutput(v)
+	return _u
+}
+
+// SetNillablePartialOutput sets the "partial_output" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillablePartialOutput(v *string) *DistillRunUpdate {
+	if v != nil {
+		_u.SetPartialOutput(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *DistillRunUpdate) SetFinishedAt(v int64) *DistillRunUpdate {
+	_u.mutation.ResetFinishedAt()
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *DistillRunUpdate) SetNillableFinishedAt(v *int64) *DistillRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// AddFinishedAt adds value to the "finished_at" field.
+func (_u *DistillRunUpdate) AddFinishedAt(v int64) *DistillRunUpdate {
+	_u.mutation.AddFinishedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DistillRunUpdate) SetUpdatedAt(v int64) *DistillRunUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *DistillRunUpdate) AddUpdatedAt(v int64) *DistillRunUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DistillRunMutation object of the builder.
+func (_u *DistillRunUpdate) Mutation() *DistillRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DistillRunUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DistillRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DistillRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DistillRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DistillRunUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := distillrun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DistillRunUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := distillrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DistillRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DistillRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(distillrun.Table, distillrun.Columns, sqlgraph.NewFieldSpec(distillrun.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(distillrun.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(distillrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MessageCount(); ok {
+		_spec.SetField(distillrun.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageCount(); ok {
+		_spec.AddField(distillrun.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemCount(); ok {
+		_spec.SetField(distillrun.FieldItemCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemCount(); ok {
+		_spec.AddField(distillrun.FieldItemCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventCount(); ok {
+		_spec.SetField(distillrun.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventCount(); ok {
+		_spec.AddField(distillrun.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(distillrun.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(distillrun.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(distillrun.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(distillrun.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(distillrun.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(distillrun.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.StageUsage(); ok {
+		_spec.SetField(distillrun.FieldStageUsage, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(distillrun.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PartialOutput(); ok {
+		_spec.SetField(distillrun.FieldPartialOutput, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(distillrun.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(distillrun.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(distillrun.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(distillrun.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.DistillRun
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{distillrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DistillRunUpdateOne is the builder for updating a single DistillRun entity.
+type DistillRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DistillRunMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *DistillRunUpdateOne) SetWorkspaceID(v uuid.UUID) *DistillRunUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DistillRunUpdateOne) SetStatus(v distillrun.Status) *DistillRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillableStatus(v *distillrun.Status) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_u *DistillRunUpdateOne) SetMessageCount(v int) *DistillRunUpdateOne {
+	_u.mutation.ResetMessageCount()
+	_u.mutation.SetMessageCount(v)
+	return _u
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillableMessageCount(v *int) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetMessageCount(*v)
+	}
+	return _u
+}
+
+// AddMessageCount adds value to the "message_count" field.
+func (_u *DistillRunUpdateOne) AddMessageCount(v int) *DistillRunUpdateOne {
+	_u.mutation.AddMessageCount(v)
+	return _u
+}
+
+// SetItemCount sets the "item_count" field.
+func (_u *DistillRunUpdateOne) SetItemCount(v int) *DistillRunUpdateOne {
+	_u.mutation.ResetItemCount()
+	_u.mutation.SetItemCount(v)
+	return _u
+}
+
+// SetNillableItemCount sets the "item_count" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillableItemCount(v *int) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetItemCount(*v)
+	}
+	return _u
+}
+
+// AddItemCount adds value to the "item_count" field.
+func (_u *DistillRunUpdateOne) AddItemCount(v int) *DistillRunUpdateOne {
+	_u.mutation.AddItemCount(v)
+	return _u
+}
+
+// SetEventCount sets the "event_count" field.
+func (_u *DistillRunUpdateOne) SetEventCount(v int) *DistillRunUpdateOne {
+	_u.mutation.ResetEventCount()
+	_u.mutation.SetEventCount(v)
+	return _u
+}
+
+// SetNillableEventCount sets the "event_count" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillableEventCount(v *int) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetEventCount(*v)
+	}
+	return _u
+}
+
+// AddEventCount adds value to the "event_count" field.
+func (_u *DistillRunUpdateOne) AddEventCount(v int) *DistillRunUpdateOne {
+	_u.mutation.AddEventCount(v)
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *DistillRunUpdateOne) SetPromptTokens(v int) *DistillRunUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillablePromptTokens(v *int) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *DistillRunUpdateOne) AddPromptTokens(v int) *DistillRunUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *DistillRunUpdateOne) SetCompletionTokens(v int) *DistillRunUpdateOne {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillableCompletionTokens(v *int) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *DistillRunUpdateOne) AddCompletionTokens(v int) *DistillRunUpdateOne {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *DistillRunUpdateOne) SetCost(v float64) *DistillRunUpdateOne {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillableCost(v *float64) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *DistillRunUpdateOne) AddCost(v float64) *DistillRunUpdateOne {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
+// SetStageUsage sets the "stage_usage" field.
+func (_u *DistillRunUpdateOne) SetStageUsage(v map[string]schema.StageUsage) *DistillRunUpdateOne {
+	_u.mutation.SetStageUsage(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DistillRunUpdateOne) SetError(v string) *DistillRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillableError(v *string) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetPartialOutput sets the "partial_output" field.
+func (_u *DistillRunUpdateOne) SetPartialOutput(v string) *DistillRunUpdateOne {
+	_u.mutation.SetPartialOutput(v)
+	return _u
+}
+
+// SetNillablePartialOutput sets the "partial_output" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillablePartialOutput(v *string) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetPartialOutput(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *DistillRunUpdateOne) SetFinishedAt(v int64) *DistillRunUpdateOne {
+	_u.mutation.ResetFinishedAt()
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *DistillRunUpdateOne) SetNillableFinishedAt(v *int64) *DistillRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// AddFinishedAt adds value to the "finished_at" field.
+func (_u *DistillRunUpdateOne) AddFinishedAt(v int64) *DistillRunUpdateOne {
+	_u.mutation.AddFinishedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DistillRunUpdateOne) SetUpdatedAt(v int64) *DistillRunUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *DistillRunUpdateOne) AddUpdatedAt(v int64) *DistillRunUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DistillRunMutation object of the builder.
+func (_u *DistillRunUpdateOne) Mutation() *DistillRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DistillRunUpdate builder.
+func (_u *DistillRunUpdateOne) Where(ps ...predicate.DistillRun) *DistillRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DistillRunUpdateOne) Select(field string, fields ...string) *DistillRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DistillRun entity.
+func (_u *DistillRunUpdateOne) Save(ctx context.Context) (*DistillRun, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DistillRunUpdateOne) SaveX(ctx context.Context) *DistillRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DistillRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DistillRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DistillRunUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := distillrun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DistillRunUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := distillrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DistillRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DistillRunUpdateOne) sqlSave(ctx context.Context) (_node *DistillRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(distillrun.Table, distillrun.Columns, sqlgraph.NewFieldSpec(distillrun.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DistillRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, distillrun.FieldID)
+		for _, f := range fields {
+			if !distillrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != distillrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(distillrun.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(distillrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MessageCount(); ok {
+		_spec.SetField(distillrun.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageCount(); ok {
+		_spec.AddField(distillrun.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemCount(); ok {
+		_spec.SetField(distillrun.FieldItemCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemCount(); ok {
+		_spec.AddField(distillrun.FieldItemCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventCount(); ok {
+		_spec.SetField(distillrun.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventCount(); ok {
+		_spec.AddField(distillrun.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(distillrun.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(distillrun.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(distillrun.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(distillrun.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(distillrun.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(distillrun.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.StageUsage(); ok {
+		_spec.SetField(distillrun.FieldStageUsage, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(distillrun.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PartialOutput(); ok {
+		_spec.SetField(distillrun.FieldPartialOutput, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(distillrun.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(distillrun.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(distillrun.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(distillrun.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.DistillRun
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &DistillRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{distillrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
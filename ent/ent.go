@@ -12,10 +12,26 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/luoling8192/mindwave/ent/accesslog"
+	"github.com/luoling8192/mindwave/ent/apikey"
+	"github.com/luoling8192/mindwave/ent/askturn"
+	"github.com/luoling8192/mindwave/ent/chatgrant"
 	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/chatretention"
+	"github.com/luoling8192/mindwave/ent/chatschedule"
+	"github.com/luoling8192/mindwave/ent/digestdelivery"
+	"github.com/luoling8192/mindwave/ent/distillrun"
 	"github.com/luoling8192/mindwave/ent/event"
 	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/job"
 	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/ent/llmcacheentry"
+	"github.com/luoling8192/mindwave/ent/owneraccountlink"
+	"github.com/luoling8192/mindwave/ent/person"
+	"github.com/luoling8192/mindwave/ent/personauditlog"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/ent/summary"
+	"github.com/luoling8192/mindwave/ent/workspace"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +92,26 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chatmessage.Table: chatmessage.ValidColumn,
-			event.Table:       event.ValidColumn,
-			identity.Table:    identity.ValidColumn,
-			joinedchat.Table:  joinedchat.ValidColumn,
+			apikey.Table:           apikey.ValidColumn,
+			accesslog.Table:        accesslog.ValidColumn,
+			askturn.Table:          askturn.ValidColumn,
+			chatgrant.Table:        chatgrant.ValidColumn,
+			chatmessage.Table:      chatmessage.ValidColumn,
+			chatretention.Table:    chatretention.ValidColumn,
+			chatschedule.Table:     chatschedule.ValidColumn,
+			digestdelivery.Table:   digestdelivery.ValidColumn,
+			distillrun.Table:       distillrun.ValidColumn,
+			event.Table:            event.ValidColumn,
+			identity.Table:         identity.ValidColumn,
+			job.Table:              job.ValidColumn,
+			joinedchat.Table:       joinedchat.ValidColumn,
+			llmcacheentry.Table:    llmcacheentry.ValidColumn,
+			owneraccountlink.Table: owneraccountlink.ValidColumn,
+			person.Table:           person.ValidColumn,
+			personauditlog.Table:   personauditlog.ValidColumn,
+			profile.Table:          profile.ValidColumn,
+			summary.Table:          summary.ValidColumn,
+			workspace.Table:        workspace.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
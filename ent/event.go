@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/luoling8192/mindwave/ent/event"
+	pgvector "github.com/pgvector/pgvector-go"
 )
 
 // Event is the model entity for the Event schema.
@@ -18,6 +19,8 @@ type Event struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 	// Platform holds the value of the "platform" field.
 	Platform string `json:"platform,omitempty"`
 	// Name holds the value of the "name" field.
@@ -28,6 +31,8 @@ type Event struct {
 	Description string `json:"description,omitempty"`
 	// FromName holds the value of the "from_name" field.
 	FromName string `json:"from_name,omitempty"`
+	// UnmatchedNames holds the value of the "unmatched_names" field.
+	UnmatchedNames []string `json:"unmatched_names,omitempty"`
 	// InChatID holds the value of the "in_chat_id" field.
 	InChatID string `json:"in_chat_id,omitempty"`
 	// InChatType holds the value of the "in_chat_type" field.
@@ -36,6 +41,18 @@ type Event struct {
 	PlatformTimestamp int64 `json:"platform_timestamp,omitempty"`
 	// EvidenceMessageIds holds the value of the "evidence_message_ids" field.
 	EvidenceMessageIds []uuid.UUID `json:"evidence_message_ids,omitempty"`
+	// SpanStart holds the value of the "span_start" field.
+	SpanStart int64 `json:"span_start,omitempty"`
+	// SpanEnd holds the value of the "span_end" field.
+	SpanEnd int64 `json:"span_end,omitempty"`
+	// DescriptionVector1536 holds the value of the "description_vector_1536" field.
+	DescriptionVector1536 *pgvector.Vector `json:"description_vector_1536,omitempty"`
+	// DescriptionVector1024 holds the value of the "description_vector_1024" field.
+	DescriptionVector1024 *pgvector.Vector `json:"description_vector_1024,omitempty"`
+	// DescriptionVector768 holds the value of the "description_vector_768" field.
+	DescriptionVector768 *pgvector.Vector `json:"description_vector_768,omitempty"`
+	// ContinuesID holds the value of the "continues_id" field.
+	ContinuesID *uuid.UUID `json:"continues_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt int64 `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -50,9 +67,13 @@ type Event struct {
 type EventEdges struct {
 	// Identities holds the value of the identities edge.
 	Identities []*Identity `json:"identities,omitempty"`
+	// Continues holds the value of the continues edge.
+	Continues *Event `json:"continues,omitempty"`
+	// Continuations holds the value of the continuations edge.
+	Continuations []*Event `json:"continuations,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // IdentitiesOrErr returns the Identities value or an error if the edge
@@ -64,18 +85,42 @@ func (e EventEdges) IdentitiesOrErr() ([]*Identity, error) {
 	return nil, &NotLoadedError{edge: "identities"}
 }
 
+// ContinuesOrErr returns the Continues value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EventEdges) ContinuesOrErr() (*Event, error) {
+	if e.Continues != nil {
+		return e.Continues, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: event.Label}
+	}
+	return nil, &NotLoadedError{edge: "continues"}
+}
+
+// ContinuationsOrErr returns the Continuations value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventEdges) ContinuationsOrErr() ([]*Event, error) {
+	if e.loadedTypes[2] {
+		return e.Continuations, nil
+	}
+	return nil, &NotLoadedError{edge: "continuations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Event) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case event.FieldTags, event.FieldEvidenceMessageIds:
+		case event.FieldDescriptionVector1536, event.FieldDescriptionVector1024, event.FieldDescriptionVector768:
+			values[i] = &sql.NullScanner{S: new(pgvector.Vector)}
+		case event.FieldContinuesID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case event.FieldTags, event.FieldUnmatchedNames, event.FieldEvidenceMessageIds:
 			values[i] = new([]byte)
-		case event.FieldPlatformTimestamp, event.FieldCreatedAt, event.FieldUpdatedAt:
+		case event.FieldPlatformTimestamp, event.FieldSpanStart, event.FieldSpanEnd, event.FieldCreatedAt, event.FieldUpdatedAt:
 			values[i] = new(sql.NullInt64)
 		case event.FieldPlatform, event.FieldName, event.FieldDescription, event.FieldFromName, event.FieldInChatID, event.FieldInChatType:
 			values[i] = new(sql.NullString)
-		case event.FieldID:
+		case event.FieldID, event.FieldWorkspaceID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -98,6 +143,12 @@ func (_m *Event) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case event.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
 		case event.FieldPlatform:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field platform", values[i])
@@ -130,6 +181,14 @@ func (_m *Event) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.FromName = value.String
 			}
+		case event.FieldUnmatchedNames:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field unmatched_names", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UnmatchedNames); err != nil {
+					return fmt.Errorf("unmarshal field unmatched_names: %w", err)
+				}
+			}
 		case event.FieldInChatID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field in_chat_id", values[i])
@@ -156,6 +215,46 @@ func (_m *Event) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field evidence_message_ids: %w", err)
 				}
 			}
+		case event.FieldSpanStart:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field span_start", values[i])
+			} else if value.Valid {
+				_m.SpanStart = value.Int64
+			}
+		case event.FieldSpanEnd:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field span_end", values[i])
+			} else if value.Valid {
+				_m.SpanEnd = value.Int64
+			}
+		case event.FieldDescriptionVector1536:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field description_vector_1536", values[i])
+			} else if value.Valid {
+				_m.DescriptionVector1536 = new(pgvector.Vector)
+				*_m.DescriptionVector1536 = *value.S.(*pgvector.Vector)
+			}
+		case event.FieldDescriptionVector1024:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field description_vector_1024", values[i])
+			} else if value.Valid {
+				_m.DescriptionVector1024 = new(pgvector.Vector)
+				*_m.DescriptionVector1024 = *value.S.(*pgvector.Vector)
+			}
+		case event.FieldDescriptionVector768:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field description_vector_768", values[i])
+			} else if value.Valid {
+				_m.DescriptionVector768 = new(pgvector.Vector)
+				*_m.DescriptionVector768 = *value.S.(*pgvector.Vector)
+			}
+		case event.FieldContinuesID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field continues_id", values[i])
+			} else if value.Valid {
+				_m.ContinuesID = new(uuid.UUID)
+				*_m.ContinuesID = *value.S.(*uuid.UUID)
+			}
 		case event.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -186,6 +285,16 @@ func (_m *Event) QueryIdentities() *IdentityQuery {
 	return NewEventClient(_m.config).QueryIdentities(_m)
 }
 
+// QueryContinues queries the "continues" edge of the Event entity.
+func (_m *Event) QueryContinues() *EventQuery {
+	return NewEventClient(_m.config).QueryContinues(_m)
+}
+
+// QueryContinuations queries the "continuations" edge of the Event entity.
+func (_m *Event) QueryContinuations() *EventQuery {
+	return NewEventClient(_m.config).QueryContinuations(_m)
+}
+
 // Update returns a builder for updating this Event.
 // Note that you need to call Event.Unwrap() before calling this method if this Event
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -209,6 +318,9 @@ func (_m *Event) String() string {
 	var builder strings.Builder
 	builder.WriteString("Event(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
 	builder.WriteString("platform=")
 	builder.WriteString(_m.Platform)
 	builder.WriteString(", ")
@@ -224,6 +336,9 @@ func (_m *Event) String() string {
 	builder.WriteString("from_name=")
 	builder.WriteString(_m.FromName)
 	builder.WriteString(", ")
+	builder.WriteString("unmatched_names=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnmatchedNames))
+	builder.WriteString(", ")
 	builder.WriteString("in_chat_id=")
 	builder.WriteString(_m.InChatID)
 	builder.WriteString(", ")
@@ -236,6 +351,32 @@ func (_m *Event) String() string {
 	builder.WriteString("evidence_message_ids=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EvidenceMessageIds))
 	builder.WriteString(", ")
+	builder.WriteString("span_start=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SpanStart))
+	builder.WriteString(", ")
+	builder.WriteString("span_end=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SpanEnd))
+	builder.WriteString(", ")
+	if v := _m.DescriptionVector1536; v != nil {
+		builder.WriteString("description_vector_1536=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DescriptionVector1024; v != nil {
+		builder.WriteString("description_vector_1024=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DescriptionVector768; v != nil {
+		builder.WriteString("description_vector_768=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ContinuesID; v != nil {
+		builder.WriteString("continues_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
 	builder.WriteString(", ")
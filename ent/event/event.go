@@ -13,6 +13,8 @@ const (
 	Label = "event"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
 	// FieldPlatform holds the string denoting the platform field in the database.
 	FieldPlatform = "platform"
 	// FieldName holds the string denoting the name field in the database.
@@ -23,6 +25,8 @@ const (
 	FieldDescription = "description"
 	// FieldFromName holds the string denoting the from_name field in the database.
 	FieldFromName = "from_name"
+	// FieldUnmatchedNames holds the string denoting the unmatched_names field in the database.
+	FieldUnmatchedNames = "unmatched_names"
 	// FieldInChatID holds the string denoting the in_chat_id field in the database.
 	FieldInChatID = "in_chat_id"
 	// FieldInChatType holds the string denoting the in_chat_type field in the database.
@@ -31,12 +35,28 @@ const (
 	FieldPlatformTimestamp = "platform_timestamp"
 	// FieldEvidenceMessageIds holds the string denoting the evidence_message_ids field in the database.
 	FieldEvidenceMessageIds = "evidence_message_ids"
+	// FieldSpanStart holds the string denoting the span_start field in the database.
+	FieldSpanStart = "span_start"
+	// FieldSpanEnd holds the string denoting the span_end field in the database.
+	FieldSpanEnd = "span_end"
+	// FieldDescriptionVector1536 holds the string denoting the description_vector_1536 field in the database.
+	FieldDescriptionVector1536 = "description_vector_1536"
+	// FieldDescriptionVector1024 holds the string denoting the description_vector_1024 field in the database.
+	FieldDescriptionVector1024 = "description_vector_1024"
+	// FieldDescriptionVector768 holds the string denoting the description_vector_768 field in the database.
+	FieldDescriptionVector768 = "description_vector_768"
+	// FieldContinuesID holds the string denoting the continues_id field in the database.
+	FieldContinuesID = "continues_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeIdentities holds the string denoting the identities edge name in mutations.
 	EdgeIdentities = "identities"
+	// EdgeContinues holds the string denoting the continues edge name in mutations.
+	EdgeContinues = "continues"
+	// EdgeContinuations holds the string denoting the continuations edge name in mutations.
+	EdgeContinuations = "continuations"
 	// Table holds the table name of the event in the database.
 	Table = "events"
 	// IdentitiesTable is the table that holds the identities relation/edge. The primary key declared below.
@@ -44,20 +64,36 @@ const (
 	// IdentitiesInverseTable is the table name for the Identity entity.
 	// It exists in this package in order to avoid circular dependency with the "identity" package.
 	IdentitiesInverseTable = "identities"
+	// ContinuesTable is the table that holds the continues relation/edge.
+	ContinuesTable = "events"
+	// ContinuesColumn is the table column denoting the continues relation/edge.
+	ContinuesColumn = "continues_id"
+	// ContinuationsTable is the table that holds the continuations relation/edge.
+	ContinuationsTable = "events"
+	// ContinuationsColumn is the table column denoting the continuations relation/edge.
+	ContinuationsColumn = "continues_id"
 )
 
 // Columns holds all SQL columns for event fields.
 var Columns = []string{
 	FieldID,
+	FieldWorkspaceID,
 	FieldPlatform,
 	FieldName,
 	FieldTags,
 	FieldDescription,
 	FieldFromName,
+	FieldUnmatchedNames,
 	FieldInChatID,
 	FieldInChatType,
 	FieldPlatformTimestamp,
 	FieldEvidenceMessageIds,
+	FieldSpanStart,
+	FieldSpanEnd,
+	FieldDescriptionVector1536,
+	FieldDescriptionVector1024,
+	FieldDescriptionVector768,
+	FieldContinuesID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -79,6 +115,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultWorkspaceID holds the default value on creation for the "workspace_id" field.
+	DefaultWorkspaceID func() uuid.UUID
 	// DefaultPlatform holds the default value on creation for the "platform" field.
 	DefaultPlatform string
 	// PlatformValidator is a validator for the "platform" field. It is called by the builders before save.
@@ -107,6 +145,10 @@ var (
 	DefaultPlatformTimestamp int64
 	// DefaultEvidenceMessageIds holds the default value on creation for the "evidence_message_ids" field.
 	DefaultEvidenceMessageIds []uuid.UUID
+	// DefaultSpanStart holds the default value on creation for the "span_start" field.
+	DefaultSpanStart int64
+	// DefaultSpanEnd holds the default value on creation for the "span_end" field.
+	DefaultSpanEnd int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() int64
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -125,6 +167,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
 // ByPlatform orders the results by the platform field.
 func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
@@ -160,6 +207,36 @@ func ByPlatformTimestamp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPlatformTimestamp, opts...).ToFunc()
 }
 
+// BySpanStart orders the results by the span_start field.
+func BySpanStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpanStart, opts...).ToFunc()
+}
+
+// BySpanEnd orders the results by the span_end field.
+func BySpanEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpanEnd, opts...).ToFunc()
+}
+
+// ByDescriptionVector1536 orders the results by the description_vector_1536 field.
+func ByDescriptionVector1536(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescriptionVector1536, opts...).ToFunc()
+}
+
+// ByDescriptionVector1024 orders the results by the description_vector_1024 field.
+func ByDescriptionVector1024(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescriptionVector1024, opts...).ToFunc()
+}
+
+// ByDescriptionVector768 orders the results by the description_vector_768 field.
+func ByDescriptionVector768(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescriptionVector768, opts...).ToFunc()
+}
+
+// ByContinuesID orders the results by the continues_id field.
+func ByContinuesID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContinuesID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -183,6 +260,27 @@ func ByIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByContinuesField orders the results by continues field.
+func ByContinuesField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContinuesStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByContinuationsCount orders the results by continuations count.
+func ByContinuationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContinuationsStep(), opts...)
+	}
+}
+
+// ByContinuations orders the results by continuations terms.
+func ByContinuations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContinuationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newIdentitiesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -190,3 +288,17 @@ func newIdentitiesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, IdentitiesTable, IdentitiesPrimaryKey...),
 	)
 }
+func newContinuesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ContinuesTable, ContinuesColumn),
+	)
+}
+func newContinuationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContinuationsTable, ContinuationsColumn),
+	)
+}
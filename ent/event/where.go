@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/luoling8192/mindwave/ent/internal"
 	"github.com/luoling8192/mindwave/ent/predicate"
+	pgvector "github.com/pgvector/pgvector-go"
 )
 
 // ID filters vertices based on their ID field.
@@ -55,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.Event {
 	return predicate.Event(sql.FieldLTE(FieldID, id))
 }
 
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
 // Platform applies equality check predicate on the "platform" field. It's identical to PlatformEQ.
 func Platform(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldPlatform, v))
@@ -90,6 +96,36 @@ func PlatformTimestamp(v int64) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldPlatformTimestamp, v))
 }
 
+// SpanStart applies equality check predicate on the "span_start" field. It's identical to SpanStartEQ.
+func SpanStart(v int64) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSpanStart, v))
+}
+
+// SpanEnd applies equality check predicate on the "span_end" field. It's identical to SpanEndEQ.
+func SpanEnd(v int64) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSpanEnd, v))
+}
+
+// DescriptionVector1536 applies equality check predicate on the "description_vector_1536" field. It's identical to DescriptionVector1536EQ.
+func DescriptionVector1536(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldDescriptionVector1536, v))
+}
+
+// DescriptionVector1024 applies equality check predicate on the "description_vector_1024" field. It's identical to DescriptionVector1024EQ.
+func DescriptionVector1024(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldDescriptionVector1024, v))
+}
+
+// DescriptionVector768 applies equality check predicate on the "description_vector_768" field. It's identical to DescriptionVector768EQ.
+func DescriptionVector768(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldDescriptionVector768, v))
+}
+
+// ContinuesID applies equality check predicate on the "continues_id" field. It's identical to ContinuesIDEQ.
+func ContinuesID(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldContinuesID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v int64) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldCreatedAt, v))
@@ -100,6 +136,46 @@ func UpdatedAt(v int64) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
 // PlatformEQ applies the EQ predicate on the "platform" field.
 func PlatformEQ(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldPlatform, v))
@@ -370,6 +446,16 @@ func FromNameContainsFold(v string) predicate.Event {
 	return predicate.Event(sql.FieldContainsFold(FieldFromName, v))
 }
 
+// UnmatchedNamesIsNil applies the IsNil predicate on the "unmatched_names" field.
+func UnmatchedNamesIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldUnmatchedNames))
+}
+
+// UnmatchedNamesNotNil applies the NotNil predicate on the "unmatched_names" field.
+func UnmatchedNamesNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldUnmatchedNames))
+}
+
 // InChatIDEQ applies the EQ predicate on the "in_chat_id" field.
 func InChatIDEQ(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldInChatID, v))
@@ -540,6 +626,266 @@ func PlatformTimestampLTE(v int64) predicate.Event {
 	return predicate.Event(sql.FieldLTE(FieldPlatformTimestamp, v))
 }
 
+// SpanStartEQ applies the EQ predicate on the "span_start" field.
+func SpanStartEQ(v int64) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSpanStart, v))
+}
+
+// SpanStartNEQ applies the NEQ predicate on the "span_start" field.
+func SpanStartNEQ(v int64) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldSpanStart, v))
+}
+
+// SpanStartIn applies the In predicate on the "span_start" field.
+func SpanStartIn(vs ...int64) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldSpanStart, vs...))
+}
+
+// SpanStartNotIn applies the NotIn predicate on the "span_start" field.
+func SpanStartNotIn(vs ...int64) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldSpanStart, vs...))
+}
+
+// SpanStartGT applies the GT predicate on the "span_start" field.
+func SpanStartGT(v int64) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldSpanStart, v))
+}
+
+// SpanStartGTE applies the GTE predicate on the "span_start" field.
+func SpanStartGTE(v int64) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldSpanStart, v))
+}
+
+// SpanStartLT applies the LT predicate on the "span_start" field.
+func SpanStartLT(v int64) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldSpanStart, v))
+}
+
+// SpanStartLTE applies the LTE predicate on the "span_start" field.
+func SpanStartLTE(v int64) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldSpanStart, v))
+}
+
+// SpanEndEQ applies the EQ predicate on the "span_end" field.
+func SpanEndEQ(v int64) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSpanEnd, v))
+}
+
+// SpanEndNEQ applies the NEQ predicate on the "span_end" field.
+func SpanEndNEQ(v int64) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldSpanEnd, v))
+}
+
+// SpanEndIn applies the In predicate on the "span_end" field.
+func SpanEndIn(vs ...int64) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldSpanEnd, vs...))
+}
+
+// SpanEndNotIn applies the NotIn predicate on the "span_end" field.
+func SpanEndNotIn(vs ...int64) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldSpanEnd, vs...))
+}
+
+// SpanEndGT applies the GT predicate on the "span_end" field.
+func SpanEndGT(v int64) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldSpanEnd, v))
+}
+
+// SpanEndGTE applies the GTE predicate on the "span_end" field.
+func SpanEndGTE(v int64) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldSpanEnd, v))
+}
+
+// SpanEndLT applies the LT predicate on the "span_end" field.
+func SpanEndLT(v int64) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldSpanEnd, v))
+}
+
+// SpanEndLTE applies the LTE predicate on the "span_end" field.
+func SpanEndLTE(v int64) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldSpanEnd, v))
+}
+
+// DescriptionVector1536EQ applies the EQ predicate on the "description_vector_1536" field.
+func DescriptionVector1536EQ(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldDescriptionVector1536, v))
+}
+
+// DescriptionVector1536NEQ applies the NEQ predicate on the "description_vector_1536" field.
+func DescriptionVector1536NEQ(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldDescriptionVector1536, v))
+}
+
+// DescriptionVector1536In applies the In predicate on the "description_vector_1536" field.
+func DescriptionVector1536In(vs ...pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldDescriptionVector1536, vs...))
+}
+
+// DescriptionVector1536NotIn applies the NotIn predicate on the "description_vector_1536" field.
+func DescriptionVector1536NotIn(vs ...pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldDescriptionVector1536, vs...))
+}
+
+// DescriptionVector1536GT applies the GT predicate on the "description_vector_1536" field.
+func DescriptionVector1536GT(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldDescriptionVector1536, v))
+}
+
+// DescriptionVector1536GTE applies the GTE predicate on the "description_vector_1536" field.
+func DescriptionVector1536GTE(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldDescriptionVector1536, v))
+}
+
+// DescriptionVector1536LT applies the LT predicate on the "description_vector_1536" field.
+func DescriptionVector1536LT(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldDescriptionVector1536, v))
+}
+
+// DescriptionVector1536LTE applies the LTE predicate on the "description_vector_1536" field.
+func DescriptionVector1536LTE(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldDescriptionVector1536, v))
+}
+
+// DescriptionVector1536IsNil applies the IsNil predicate on the "description_vector_1536" field.
+func DescriptionVector1536IsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldDescriptionVector1536))
+}
+
+// DescriptionVector1536NotNil applies the NotNil predicate on the "description_vector_1536" field.
+func DescriptionVector1536NotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldDescriptionVector1536))
+}
+
+// DescriptionVector1024EQ applies the EQ predicate on the "description_vector_1024" field.
+func DescriptionVector1024EQ(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldDescriptionVector1024, v))
+}
+
+// DescriptionVector1024NEQ applies the NEQ predicate on the "description_vector_1024" field.
+func DescriptionVector1024NEQ(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldDescriptionVector1024, v))
+}
+
+// DescriptionVector1024In applies the In predicate on the "description_vector_1024" field.
+func DescriptionVector1024In(vs ...pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldDescriptionVector1024, vs...))
+}
+
+// DescriptionVector1024NotIn applies the NotIn predicate on the "description_vector_1024" field.
+func DescriptionVector1024NotIn(vs ...pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldDescriptionVector1024, vs...))
+}
+
+// DescriptionVector1024GT applies the GT predicate on the "description_vector_1024" field.
+func DescriptionVector1024GT(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldDescriptionVector1024, v))
+}
+
+// DescriptionVector1024GTE applies the GTE predicate on the "description_vector_1024" field.
+func DescriptionVector1024GTE(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldDescriptionVector1024, v))
+}
+
+// DescriptionVector1024LT applies the LT predicate on the "description_vector_1024" field.
+func DescriptionVector1024LT(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldDescriptionVector1024, v))
+}
+
+// DescriptionVector1024LTE applies the LTE predicate on the "description_vector_1024" field.
+func DescriptionVector1024LTE(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldDescriptionVector1024, v))
+}
+
+// DescriptionVector1024IsNil applies the IsNil predicate on the "description_vector_1024" field.
+func DescriptionVector1024IsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldDescriptionVector1024))
+}
+
+// DescriptionVector1024NotNil applies the NotNil predicate on the "description_vector_1024" field.
+func DescriptionVector1024NotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldDescriptionVector1024))
+}
+
+// DescriptionVector768EQ applies the EQ predicate on the "description_vector_768" field.
+func DescriptionVector768EQ(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldDescriptionVector768, v))
+}
+
+// DescriptionVector768NEQ applies the NEQ predicate on the "description_vector_768" field.
+func DescriptionVector768NEQ(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldDescriptionVector768, v))
+}
+
+// DescriptionVector768In applies the In predicate on the "description_vector_768" field.
+func DescriptionVector768In(vs ...pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldDescriptionVector768, vs...))
+}
+
+// DescriptionVector768NotIn applies the NotIn predicate on the "description_vector_768" field.
+func DescriptionVector768NotIn(vs ...pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldDescriptionVector768, vs...))
+}
+
+// DescriptionVector768GT applies the GT predicate on the "description_vector_768" field.
+func DescriptionVector768GT(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldDescriptionVector768, v))
+}
+
+// DescriptionVector768GTE applies the GTE predicate on the "description_vector_768" field.
+func DescriptionVector768GTE(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldDescriptionVector768, v))
+}
+
+// DescriptionVector768LT applies the LT predicate on the "description_vector_768" field.
+func DescriptionVector768LT(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldDescriptionVector768, v))
+}
+
+// DescriptionVector768LTE applies the LTE predicate on the "description_vector_768" field.
+func DescriptionVector768LTE(v pgvector.Vector) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldDescriptionVector768, v))
+}
+
+// DescriptionVector768IsNil applies the IsNil predicate on the "description_vector_768" field.
+func DescriptionVector768IsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldDescriptionVector768))
+}
+
+// DescriptionVector768NotNil applies the NotNil predicate on the "description_vector_768" field.
+func DescriptionVector768NotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldDescriptionVector768))
+}
+
+// ContinuesIDEQ applies the EQ predicate on the "continues_id" field.
+func ContinuesIDEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldContinuesID, v))
+}
+
+// ContinuesIDNEQ applies the NEQ predicate on the "continues_id" field.
+func ContinuesIDNEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldContinuesID, v))
+}
+
+// ContinuesIDIn applies the In predicate on the "continues_id" field.
+func ContinuesIDIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldContinuesID, vs...))
+}
+
+// ContinuesIDNotIn applies the NotIn predicate on the "continues_id" field.
+func ContinuesIDNotIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldContinuesID, vs...))
+}
+
+// ContinuesIDIsNil applies the IsNil predicate on the "continues_id" field.
+func ContinuesIDIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldContinuesID))
+}
+
+// ContinuesIDNotNil applies the NotNil predicate on the "continues_id" field.
+func ContinuesIDNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldContinuesID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v int64) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldCreatedAt, v))
@@ -649,6 +995,64 @@ func HasIdentitiesWith(preds ...predicate.Identity) predicate.Event {
 	})
 }
 
+// HasContinues applies the HasEdge predicate on the "continues" edge.
+func HasContinues() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ContinuesTable, ContinuesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Event
+		step.Edge.Schema = schemaConfig.Event
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContinuesWith applies the HasEdge predicate on the "continues" edge with a given conditions (other predicates).
+func HasContinuesWith(preds ...predicate.Event) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newContinuesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Event
+		step.Edge.Schema = schemaConfig.Event
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContinuations applies the HasEdge predicate on the "continuations" edge.
+func HasContinuations() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContinuationsTable, ContinuationsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Event
+		step.Edge.Schema = schemaConfig.Event
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContinuationsWith applies the HasEdge predicate on the "continuations" edge with a given conditions (other predicates).
+func HasContinuationsWith(preds ...predicate.Event) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newContinuationsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Event
+		step.Edge.Schema = schemaConfig.Event
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Event) predicate.Event {
 	return predicate.Event(sql.AndPredicates(predicates...))
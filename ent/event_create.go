@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/luoling8192/mindwave/ent/event"
 	"github.com/luoling8192/mindwave/ent/identity"
+	pgvector "github.com/pgvector/pgvector-go"
 )
 
 // EventCreate is the builder for creating a Event entity.
@@ -24,6 +25,20 @@ type EventCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *EventCreate) SetWorkspaceID(v uuid.UUID) *EventCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *EventCreate) SetNillableWorkspaceID(v *uuid.UUID) *EventCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
 // SetPlatform sets the "platform" field.
 func (_c *EventCreate) SetPlatform(v string) *EventCreate {
 	_c.mutation.SetPlatform(v)
@@ -86,6 +101,12 @@ func (_c *EventCreate) SetNillableFromName(v *string) *EventCreate {
 	return _c
 }
 
+// SetUnmatchedNames sets the "unmatched_names" field.
+func (_c *EventCreate) SetUnmatchedNames(v []string) *EventCreate {
+	_c.mutation.SetUnmatchedNames(v)
+	return _c
+}
+
 // SetInChatID sets the "in_chat_id" field.
 func (_c *EventCreate) SetInChatID(v string) *EventCreate {
 	_c.mutation.SetInChatID(v)
@@ -134,6 +155,90 @@ func (_c *EventCreate) SetEvidenceMessageIds(v []uuid.UUID) *EventCreate {
 	return _c
 }
 
+// SetSpanStart sets the "span_start" field.
+func (_c *EventCreate) SetSpanStart(v int64) *EventCreate {
+	_c.mutation.SetSpanStart(v)
+	return _c
+}
+
+// SetNillableSpanStart sets the "span_start" field if the given value is not nil.
+func (_c *EventCreate) SetNillableSpanStart(v *int64) *EventCreate {
+	if v != nil {
+		_c.SetSpanStart(*v)
+	}
+	return _c
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (_c *EventCreate) SetSpanEnd(v int64) *EventCreate {
+	_c.mutation.SetSpanEnd(v)
+	return _c
+}
+
+// SetNillableSpanEnd sets the "span_end" field if the given value is not nil.
+func (_c *EventCreate) SetNillableSpanEnd(v *int64) *EventCreate {
+	if v != nil {
+		_c.SetSpanEnd(*v)
+	}
+	return _c
+}
+
+// SetDescriptionVector1536 sets the "description_vector_1536" field.
+func (_c *EventCreate) SetDescriptionVector1536(v pgvector.Vector) *EventCreate {
+	_c.mutation.SetDescriptionVector1536(v)
+	return _c
+}
+
+// SetNillableDescriptionVector1536 sets the "description_vector_1536" field if the given value is not nil.
+func (_c *EventCreate) SetNillableDescriptionVector1536(v *pgvector.Vector) *EventCreate {
+	if v != nil {
+		_c.SetDescriptionVector1536(*v)
+	}
+	return _c
+}
+
+// SetDescriptionVector1024 sets the "description_vector_1024" field.
+func (_c *EventCreate) SetDescriptionVector1024(v pgvector.Vector) *EventCreate {
+	_c.mutation.SetDescriptionVector1024(v)
+	return _c
+}
+
+// SetNillableDescriptionVector1024 sets the "description_vector_1024" field if the given value is not nil.
+func (_c *EventCreate) SetNillableDescriptionVector1024(v *pgvector.Vector) *EventCreate {
+	if v != nil {
+		_c.SetDescriptionVector1024(*v)
+	}
+	return _c
+}
+
+// SetDescriptionVector768 sets the "description_vector_768" field.
+func (_c *EventCreate) SetDescriptionVector768(v pgvector.Vector) *EventCreate {
+	_c.mutation.SetDescriptionVector768(v)
+	return _c
+}
+
+// SetNillableDescriptionVector768 sets the "description_vector_768" field if the given value is not nil.
+func (_c *EventCreate) SetNillableDescriptionVector768(v *pgvector.Vector) *EventCreate {
+	if v != nil {
+		_c.SetDescriptionVector768(*v)
+	}
+	return _c
+}
+
+// SetContinuesID sets the "continues_id" field.
+func (_c *EventCreate) SetContinuesID(v uuid.UUID) *EventCreate {
+	_c.mutation.SetContinuesID(v)
+	return _c
+}
+
+// SetNillableContinuesID sets the "continues_id" field if the given value is not nil.
+func (_c *EventCreate) SetNillableContinuesID(v *uuid.UUID) *EventCreate {
+	if v != nil {
+		_c.SetContinuesID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *EventCreate) SetCreatedAt(v int64) *EventCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -191,6 +296,26 @@ func (_c *EventCreate) AddIdentities(v ...*Identity) *EventCreate {
 	return _c.AddIdentityIDs(ids...)
 }
 
+// SetContinues sets the "continues" edge to the Event entity.
+func (_c *EventCreate) SetContinues(v *Event) *EventCreate {
+	return _c.SetContinuesID(v.ID)
+}
+
+// AddContinuationIDs adds the "continuations" edge to the Event entity by IDs.
+func (_c *EventCreate) AddContinuationIDs(ids ...uuid.UUID) *EventCreate {
+	_c.mutation.AddContinuationIDs(ids...)
+	return _c
+}
+
+// AddContinuations adds the "continuations" edges to the Event entity.
+func (_c *EventCreate) AddContinuations(v ...*Event) *EventCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContinuationIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (_c *EventCreate) Mutation() *EventMutation {
 	return _c.mutation
@@ -226,6 +351,10 @@ func (_c *EventCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *EventCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := event.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
 	if _, ok := _c.mutation.Platform(); !ok {
 		v := event.DefaultPlatform
 		_c.mutation.SetPlatform(v)
@@ -258,6 +387,14 @@ func (_c *EventCreate) defaults() {
 		v := event.DefaultEvidenceMessageIds
 		_c.mutation.SetEvidenceMessageIds(v)
 	}
+	if _, ok := _c.mutation.SpanStart(); !ok {
+		v := event.DefaultSpanStart
+		_c.mutation.SetSpanStart(v)
+	}
+	if _, ok := _c.mutation.SpanEnd(); !ok {
+		v := event.DefaultSpanEnd
+		_c.mutation.SetSpanEnd(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := event.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -274,6 +411,9 @@ func (_c *EventCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *EventCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Event.workspace_id"`)}
+	}
 	if _, ok := _c.mutation.Platform(); !ok {
 		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "Event.platform"`)}
 	}
@@ -328,6 +468,12 @@ func (_c *EventCreate) check() error {
 	if _, ok := _c.mutation.EvidenceMessageIds(); !ok {
 		return &ValidationError{Name: "evidence_message_ids", err: errors.New(`ent: missing required field "Event.evidence_message_ids"`)}
 	}
+	if _, ok := _c.mutation.SpanStart(); !ok {
+		return &ValidationError{Name: "span_start", err: errors.New(`ent: missing required field "Event.span_start"`)}
+	}
+	if _, ok := _c.mutation.SpanEnd(); !ok {
+		return &ValidationError{Name: "span_end", err: errors.New(`ent: missing required field "Event.span_end"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Event.created_at"`)}
 	}
@@ -371,6 +517,10 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(event.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
 	if value, ok := _c.mutation.Platform(); ok {
 		_spec.SetField(event.FieldPlatform, field.TypeString, value)
 		_node.Platform = value
@@ -391,6 +541,10 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_spec.SetField(event.FieldFromName, field.TypeString, value)
 		_node.FromName = value
 	}
+	if value, ok := _c.mutation.UnmatchedNames(); ok {
+		_spec.SetField(event.FieldUnmatchedNames, field.TypeJSON, value)
+		_node.UnmatchedNames = value
+	}
 	if value, ok := _c.mutation.InChatID(); ok {
 		_spec.SetField(event.FieldInChatID, field.TypeString, value)
 		_node.InChatID = value
@@ -407,6 +561,26 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_spec.SetField(event.FieldEvidenceMessageIds, field.TypeJSON, value)
 		_node.EvidenceMessageIds = value
 	}
+	if value, ok := _c.mutation.SpanStart(); ok {
+		_spec.SetField(event.FieldSpanStart, field.TypeInt64, value)
+		_node.SpanStart = value
+	}
+	if value, ok := _c.mutation.SpanEnd(); ok {
+		_spec.SetField(event.FieldSpanEnd, field.TypeInt64, value)
+		_node.SpanEnd = value
+	}
+	if value, ok := _c.mutation.DescriptionVector1536(); ok {
+		_spec.SetField(event.FieldDescriptionVector1536, field.TypeOther, value)
+		_node.DescriptionVector1536 = &value
+	}
+	if value, ok := _c.mutation.DescriptionVector1024(); ok {
+		_spec.SetField(event.FieldDescriptionVector1024, field.TypeOther, value)
+		_node.DescriptionVector1024 = &value
+	}
+	if value, ok := _c.mutation.DescriptionVector768(); ok {
+		_spec.SetField(event.FieldDescriptionVector768, field.TypeOther, value)
+		_node.DescriptionVector768 = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(event.FieldCreatedAt, field.TypeInt64, value)
 		_node.CreatedAt = value
@@ -432,6 +606,41 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ContinuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.ContinuesTable,
+			Columns: []string{event.ContinuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.Event
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ContinuesID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContinuationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ContinuationsTable,
+			Columns: []string{event.ContinuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.Event
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -439,7 +648,7 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Event.Create().
-//		SetPlatform(v).
+//		SetWorkspaceID(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -448,7 +657,7 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.EventUpsert) {
-//			SetPlatform(v+v).
+//			SetWorkspaceID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *EventCreate) OnConflict(opts ...sql.ConflictOption) *EventUpsertOne {
@@ -484,6 +693,18 @@ type (
 	}
 )
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *EventUpsert) SetWorkspaceID(v uuid.UUID) *EventUpsert {
+	u.Set(event.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *EventUpsert) UpdateWorkspaceID() *EventUpsert {
+	u.SetExcluded(event.FieldWorkspaceID)
+	return u
+}
+
 // SetPlatform sets the "platform" field.
 func (u *EventUpsert) SetPlatform(v string) *EventUpsert {
 	u.Set(event.FieldPlatform, v)
@@ -550,6 +771,24 @@ func (u *EventUpsert) UpdateFromName() *EventUpsert {
 	return u
 }
 
+// SetUnmatchedNames sets the "unmatched_names" field.
+func (u *EventUpsert) SetUnmatchedNames(v []string) *EventUpsert {
+	u.Set(event.FieldUnmatchedNames, v)
+	return u
+}
+
+// UpdateUnmatchedNames sets the "unmatched_names" field to the value that was provided on create.
+func (u *EventUpsert) UpdateUnmatchedNames() *EventUpsert {
+	u.SetExcluded(event.FieldUnmatchedNames)
+	return u
+}
+
+// ClearUnmatchedNames clears the value of the "unmatched_names" field.
+func (u *EventUpsert) ClearUnmatchedNames() *EventUpsert {
+	u.SetNull(event.FieldUnmatchedNames)
+	return u
+}
+
 // SetInChatID sets the "in_chat_id" field.
 func (u *EventUpsert) SetInChatID(v string) *EventUpsert {
 	u.Set(event.FieldInChatID, v)
@@ -604,6 +843,114 @@ func (u *EventUpsert) UpdateEvidenceMessageIds() *EventUpsert {
 	return u
 }
 
+// SetSpanStart sets the "span_start" field.
+func (u *EventUpsert) SetSpanStart(v int64) *EventUpsert {
+	u.Set(event.FieldSpanStart, v)
+	return u
+}
+
+// UpdateSpanStart sets the "span_start" field to the value that was provided on create.
+func (u *EventUpsert) UpdateSpanStart() *EventUpsert {
+	u.SetExcluded(event.FieldSpanStart)
+	return u
+}
+
+// AddSpanStart adds v to the "span_start" field.
+func (u *EventUpsert) AddSpanStart(v int64) *EventUpsert {
+	u.Add(event.FieldSpanStart, v)
+	return u
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (u *EventUpsert) SetSpanEnd(v int64) *EventUpsert {
+	u.Set(event.FieldSpanEnd, v)
+	return u
+}
+
+// UpdateSpanEnd sets the "span_end" field to the value that was provided on create.
+func (u *EventUpsert) UpdateSpanEnd() *EventUpsert {
+	u.SetExcluded(event.FieldSpanEnd)
+	return u
+}
+
+// AddSpanEnd adds v to the "span_end" field.
+func (u *EventUpsert) AddSpanEnd(v int64) *EventUpsert {
+	u.Add(event.FieldSpanEnd, v)
+	return u
+}
+
+// SetDescriptionVector1536 sets the "description_vector_1536" field.
+func (u *EventUpsert) SetDescriptionVector1536(v pgvector.Vector) *EventUpsert {
+	u.Set(event.FieldDescriptionVector1536, v)
+	return u
+}
+
+// UpdateDescriptionVector1536 sets the "description_vector_1536" field to the value that was provided on create.
+func (u *EventUpsert) UpdateDescriptionVector1536() *EventUpsert {
+	u.SetExcluded(event.FieldDescriptionVector1536)
+	return u
+}
+
+// ClearDescriptionVector1536 clears the value of the "description_vector_1536" field.
+func (u *EventUpsert) ClearDescriptionVector1536() *EventUpsert {
+	u.SetNull(event.FieldDescriptionVector1536)
+	return u
+}
+
+// SetDescriptionVector1024 sets the "description_vector_1024" field.
+func (u *EventUpsert) SetDescriptionVector1024(v pgvector.Vector) *EventUpsert {
+	u.Set(event.FieldDescriptionVector1024, v)
+	return u
+}
+
+// UpdateDescriptionVector1024 sets the "description_vector_1024" field to the value that was provided on create.
+func (u *EventUpsert) UpdateDescriptionVector1024() *EventUpsert {
+	u.SetExcluded(event.FieldDescriptionVector1024)
+	return u
+}
+
+// ClearDescriptionVector1024 clears the value of the "description_vector_1024" field.
+func (u *EventUpsert) ClearDescriptionVector1024() *EventUpsert {
+	u.SetNull(event.FieldDescriptionVector1024)
+	return u
+}
+
+// SetDescriptionVector768 sets the "description_vector_768" field.
+func (u *EventUpsert) SetDescriptionVector768(v pgvector.Vector) *EventUpsert {
+	u.Set(event.FieldDescriptionVector768, v)
+	return u
+}
+
+// UpdateDescriptionVector768 sets the "description_vector_768" field to the value that was provided on create.
+func (u *EventUpsert) UpdateDescriptionVector768() *EventUpsert {
+	u.SetExcluded(event.FieldDescriptionVector768)
+	return u
+}
+
+// ClearDescriptionVector768 clears the value of the "description_vector_768" field.
+func (u *EventUpsert) ClearDescriptionVector768() *EventUpsert {
+	u.SetNull(event.FieldDescriptionVector768)
+	return u
+}
+
+// SetContinuesID sets the "continues_id" field.
+func (u *EventUpsert) SetContinuesID(v uuid.UUID) *EventUpsert {
+	u.Set(event.FieldContinuesID, v)
+	return u
+}
+
+// UpdateContinuesID sets the "continues_id" field to the value that was provided on create.
+func (u *EventUpsert) UpdateContinuesID() *EventUpsert {
+	u.SetExcluded(event.FieldContinuesID)
+	return u
+}
+
+// ClearContinuesID clears the value of the "continues_id" field.
+func (u *EventUpsert) ClearContinuesID() *EventUpsert {
+	u.SetNull(event.FieldContinuesID)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *EventUpsert) SetCreatedAt(v int64) *EventUpsert {
 	u.Set(event.FieldCreatedAt, v)
@@ -688,6 +1035,20 @@ func (u *EventUpsertOne) Update(set func(*EventUpsert)) *EventUpsertOne {
 	return u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *EventUpsertOne) SetWorkspaceID(v uuid.UUID) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateWorkspaceID() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
 // SetPlatform sets the "platform" field.
 func (u *EventUpsertOne) SetPlatform(v string) *EventUpsertOne {
 	return u.Update(func(s *EventUpsert) {
@@ -765,6 +1126,27 @@ func (u *EventUpsertOne) UpdateFromName() *EventUpsertOne {
 	})
 }
 
+// SetUnmatchedNames sets the "unmatched_names" field.
+func (u *EventUpsertOne) SetUnmatchedNames(v []string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetUnmatchedNames(v)
+	})
+}
+
+// UpdateUnmatchedNames sets the "unmatched_names" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateUnmatchedNames() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateUnmatchedNames()
+	})
+}
+
+// ClearUnmatchedNames clears the value of the "unmatched_names" field.
+func (u *EventUpsertOne) ClearUnmatchedNames() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearUnmatchedNames()
+	})
+}
+
 // SetInChatID sets the "in_chat_id" field.
 func (u *EventUpsertOne) SetInChatID(v string) *EventUpsertOne {
 	return u.Update(func(s *EventUpsert) {
@@ -828,6 +1210,132 @@ func (u *EventUpsertOne) UpdateEvidenceMessageIds() *EventUpsertOne {
 	})
 }
 
+// SetSpanStart sets the "span_start" field.
+func (u *EventUpsertOne) SetSpanStart(v int64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetSpanStart(v)
+	})
+}
+
+// AddSpanStart adds v to the "span_start" field.
+func (u *EventUpsertOne) AddSpanStart(v int64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.AddSpanStart(v)
+	})
+}
+
+// UpdateSpanStart sets the "span_start" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateSpanStart() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateSpanStart()
+	})
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (u *EventUpsertOne) SetSpanEnd(v int64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetSpanEnd(v)
+	})
+}
+
+// AddSpanEnd adds v to the "span_end" field.
+func (u *EventUpsertOne) AddSpanEnd(v int64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.AddSpanEnd(v)
+	})
+}
+
+// UpdateSpanEnd sets the "span_end" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateSpanEnd() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateSpanEnd()
+	})
+}
+
+// SetDescriptionVector1536 sets the "description_vector_1536" field.
+func (u *EventUpsertOne) SetDescriptionVector1536(v pgvector.Vector) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDescriptionVector1536(v)
+	})
+}
+
+// UpdateDescriptionVector1536 sets the "description_vector_1536" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateDescriptionVector1536() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDescriptionVector1536()
+	})
+}
+
+// ClearDescriptionVector1536 clears the value of the "description_vector_1536" field.
+func (u *EventUpsertOne) ClearDescriptionVector1536() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDescriptionVector1536()
+	})
+}
+
+// SetDescriptionVector1024 sets the "description_vector_1024" field.
+func (u *EventUpsertOne) SetDescriptionVector1024(v pgvector.Vector) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDescriptionVector1024(v)
+	})
+}
+
+// UpdateDescriptionVector1024 sets the "description_vector_1024" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateDescriptionVector1024() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDescriptionVector1024()
+	})
+}
+
+// ClearDescriptionVector1024 clears the value of the "description_vector_1024" field.
+func (u *EventUpsertOne) ClearDescriptionVector1024() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDescriptionVector1024()
+	})
+}
+
+// SetDescriptionVector768 sets the "description_vector_768" field.
+func (u *EventUpsertOne) SetDescriptionVector768(v pgvector.Vector) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDescriptionVector768(v)
+	})
+}
+
+// UpdateDescriptionVector768 sets the "description_vector_768" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateDescriptionVector768() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDescriptionVector768()
+	})
+}
+
+// ClearDescriptionVector768 clears the value of the "description_vector_768" field.
+func (u *EventUpsertOne) ClearDescriptionVector768() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDescriptionVector768()
+	})
+}
+
+// SetContinuesID sets the "continues_id" field.
+func (u *EventUpsertOne) SetContinuesID(v uuid.UUID) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetContinuesID(v)
+	})
+}
+
+// UpdateContinuesID sets the "continues_id" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateContinuesID() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateContinuesID()
+	})
+}
+
+// ClearContinuesID clears the value of the "continues_id" field.
+func (u *EventUpsertOne) ClearContinuesID() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearContinuesID()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *EventUpsertOne) SetCreatedAt(v int64) *EventUpsertOne {
 	return u.Update(func(s *EventUpsert) {
@@ -1006,7 +1514,7 @@ func (_c *EventCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.EventUpsert) {
-//			SetPlatform(v+v).
+//			SetWorkspaceID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *EventCreateBulk) OnConflict(opts ...sql.ConflictOption) *EventUpsertBulk {
@@ -1085,6 +1593,20 @@ func (u *EventUpsertBulk) Update(set func(*EventUpsert)) *EventUpsertBulk {
 	return u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *EventUpsertBulk) SetWorkspaceID(v uuid.UUID) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateWorkspaceID() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
 // SetPlatform sets the "platform" field.
 func (u *EventUpsertBulk) SetPlatform(v string) *EventUpsertBulk {
 	return u.Update(func(s *EventUpsert) {
@@ -1162,6 +1684,27 @@ func (u *EventUpsertBulk) UpdateFromName() *EventUpsertBulk {
 	})
 }
 
+// SetUnmatchedNames sets the "unmatched_names" field.
+func (u *EventUpsertBulk) SetUnmatchedNames(v []string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetUnmatchedNames(v)
+	})
+}
+
+// UpdateUnmatchedNames sets the "unmatched_names" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateUnmatchedNames() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateUnmatchedNames()
+	})
+}
+
+// ClearUnmatchedNames clears the value of the "unmatched_names" field.
+func (u *EventUpsertBulk) ClearUnmatchedNames() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearUnmatchedNames()
+	})
+}
+
 // SetInChatID sets the "in_chat_id" field.
 func (u *EventUpsertBulk) SetInChatID(v string) *EventUpsertBulk {
 	return u.Update(func(s *EventUpsert) {
@@ -1225,6 +1768,132 @@ func (u *EventUpsertBulk) UpdateEvidenceMessageIds() *EventUpsertBulk {
 	})
 }
 
+// SetSpanStart sets the "span_start" field.
+func (u *EventUpsertBulk) SetSpanStart(v int64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetSpanStart(v)
+	})
+}
+
+// AddSpanStart adds v to the "span_start" field.
+func (u *EventUpsertBulk) AddSpanStart(v int64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.AddSpanStart(v)
+	})
+}
+
+// UpdateSpanStart sets the "span_start" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateSpanStart() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateSpanStart()
+	})
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (u *EventUpsertBulk) SetSpanEnd(v int64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetSpanEnd(v)
+	})
+}
+
+// AddSpanEnd adds v to the "span_end" field.
+func (u *EventUpsertBulk) AddSpanEnd(v int64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.AddSpanEnd(v)
+	})
+}
+
+// UpdateSpanEnd sets the "span_end" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateSpanEnd() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateSpanEnd()
+	})
+}
+
+// SetDescriptionVector1536 sets the "description_vector_1536" field.
+func (u *EventUpsertBulk) SetDescriptionVector1536(v pgvector.Vector) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDescriptionVector1536(v)
+	})
+}
+
+// UpdateDescriptionVector1536 sets the "description_vector_1536" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateDescriptionVector1536() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDescriptionVector1536()
+	})
+}
+
+// ClearDescriptionVector1536 clears the value of the "description_vector_1536" field.
+func (u *EventUpsertBulk) ClearDescriptionVector1536() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDescriptionVector1536()
+	})
+}
+
+// SetDescriptionVector1024 sets the "description_vector_1024" field.
+func (u *EventUpsertBulk) SetDescriptionVector1024(v pgvector.Vector) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDescriptionVector1024(v)
+	})
+}
+
+// UpdateDescriptionVector1024 sets the "description_vector_1024" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateDescriptionVector1024() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDescriptionVector1024()
+	})
+}
+
+// ClearDescriptionVector1024 clears the value of the "description_vector_1024" field.
+func (u *EventUpsertBulk) ClearDescriptionVector1024() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDescriptionVector1024()
+	})
+}
+
+// SetDescriptionVector768 sets the "description_vector_768" field.
+func (u *EventUpsertBulk) SetDescriptionVector768(v pgvector.Vector) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDescriptionVector768(v)
+	})
+}
+
+// UpdateDescriptionVector768 sets the "description_vector_768" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateDescriptionVector768() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDescriptionVector768()
+	})
+}
+
+// ClearDescriptionVector768 clears the value of the "description_vector_768" field.
+func (u *EventUpsertBulk) ClearDescriptionVector768() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearDescriptionVector768()
+	})
+}
+
+// SetContinuesID sets the "continues_id" field.
+func (u *EventUpsertBulk) SetContinuesID(v uuid.UUID) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetContinuesID(v)
+	})
+}
+
+// UpdateContinuesID sets the "continues_id" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateContinuesID() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateContinuesID()
+	})
+}
+
+// ClearContinuesID clears the value of the "continues_id" field.
+func (u *EventUpsertBulk) ClearContinuesID() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.ClearContinuesID()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *EventUpsertBulk) SetCreatedAt(v int64) *EventUpsertBulk {
 	return u.Update(func(s *EventUpsert) {
@@ -23,12 +23,14 @@ import (
 // EventQuery is the builder for querying Event entities.
 type EventQuery struct {
 	config
-	ctx            *QueryContext
-	order          []event.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Event
-	withIdentities *IdentityQuery
-	modifiers      []func(*sql.Selector)
+	ctx               *QueryContext
+	order             []event.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Event
+	withIdentities    *IdentityQuery
+	withContinues     *EventQuery
+	withContinuations *EventQuery
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -90,6 +92,56 @@ func (_q *EventQuery) QueryIdentities() *IdentityQuery {
 	return query
 }
 
+// QueryContinues chains the current query on the "continues" edge.
+func (_q *EventQuery) QueryContinues() *EventQuery {
+	query := (&EventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, event.ContinuesTable, event.ContinuesColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.Event
+		step.Edge.Schema = schemaConfig.Event
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContinuations chains the current query on the "continuations" edge.
+func (_q *EventQuery) QueryContinuations() *EventQuery {
+	query := (&EventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.ContinuationsTable, event.ContinuationsColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.Event
+		step.Edge.Schema = schemaConfig.Event
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Event entity from the query.
 // Returns a *NotFoundError when no Event was found.
 func (_q *EventQuery) First(ctx context.Context) (*Event, error) {
@@ -277,12 +329,14 @@ func (_q *EventQuery) Clone() *EventQuery {
 		return nil
 	}
 	return &EventQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]event.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Event{}, _q.predicates...),
-		withIdentities: _q.withIdentities.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]event.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.Event{}, _q.predicates...),
+		withIdentities:    _q.withIdentities.Clone(),
+		withContinues:     _q.withContinues.Clone(),
+		withContinuations: _q.withContinuations.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -300,18 +354,40 @@ func (_q *EventQuery) WithIdentities(opts ...func(*IdentityQuery)) *EventQuery {
 	return _q
 }
 
+// WithContinues tells the query-builder to eager-load the nodes that are connected to
+// the "continues" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EventQuery) WithContinues(opts ...func(*EventQuery)) *EventQuery {
+	query := (&EventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContinues = query
+	return _q
+}
+
+// WithContinuations tells the query-builder to eager-load the nodes that are connected to
+// the "continuations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EventQuery) WithContinuations(opts ...func(*EventQuery)) *EventQuery {
+	query := (&EventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContinuations = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Platform string `json:"platform,omitempty"`
+//		WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Event.Query().
-//		GroupBy(event.FieldPlatform).
+//		GroupBy(event.FieldWorkspaceID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *EventQuery) GroupBy(field string, fields ...string) *EventGroupBy {
@@ -329,11 +405,11 @@ func (_q *EventQuery) GroupBy(field string, fields ...string) *EventGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Platform string `json:"platform,omitempty"`
+//		WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 //	}
 //
 //	client.Event.Query().
-//		Select(event.FieldPlatform).
+//		Select(event.FieldWorkspaceID).
 //		Scan(ctx, &v)
 func (_q *EventQuery) Select(fields ...string) *EventSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -378,8 +454,10 @@ func (_q *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 	var (
 		nodes       = []*Event{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			_q.withIdentities != nil,
+			_q.withContinues != nil,
+			_q.withContinuations != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -412,6 +490,19 @@ func (_q *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 			return nil, err
 		}
 	}
+	if query := _q.withContinues; query != nil {
+		if err := _q.loadContinues(ctx, query, nodes, nil,
+			func(n *Event, e *Event) { n.Edges.Continues = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withContinuations; query != nil {
+		if err := _q.loadContinuations(ctx, query, nodes,
+			func(n *Event) { n.Edges.Continuations = []*Event{} },
+			func(n *Event, e *Event) { n.Edges.Continuations = append(n.Edges.Continuations, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -477,6 +568,71 @@ func (_q *EventQuery) loadIdentities(ctx context.Context, query *IdentityQuery,
 	}
 	return nil
 }
+func (_q *EventQuery) loadContinues(ctx context.Context, query *EventQuery, nodes []*Event, init func(*Event), assign func(*Event, *Event)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Event)
+	for i := range nodes {
+		if nodes[i].ContinuesID == nil {
+			continue
+		}
+		fk := *nodes[i].ContinuesID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(event.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "continues_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *EventQuery) loadContinuations(ctx context.Context, query *EventQuery, nodes []*Event, init func(*Event), assign func(*Event, *Event)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Event)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(event.FieldContinuesID)
+	}
+	query.Where(predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(event.ContinuationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ContinuesID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "continues_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "continues_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *EventQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -508,6 +664,9 @@ func (_q *EventQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withContinues != nil {
+			_spec.Node.AddColumnOnce(event.FieldContinuesID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
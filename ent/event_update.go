@@ -16,6 +16,7 @@ import (
 	"github.com/luoling8192/mindwave/ent/identity"
 	"github.com/luoling8192/mindwave/ent/internal"
 	"github.com/luoling8192/mindwave/ent/predicate"
+	pgvector "github.com/pgvector/pgvector-go"
 )
 
 // EventUpdate is the builder for updating Event entities.
@@ -31,6 +32,20 @@ func (_u *EventUpdate) Where(ps ...predicate.Event) *EventUpdate {
 	return _u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *EventUpdate) SetWorkspaceID(v uuid.UUID) *EventUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableWorkspaceID(v *uuid.UUID) *EventUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
 // SetPlatform sets the "platform" field.
 func (_u *EventUpdate) SetPlatform(v string) *EventUpdate {
 	_u.mutation.SetPlatform(v)
@@ -105,6 +120,24 @@ func (_u *EventUpdate) SetNillableFromName(v *string) *EventUpdate {
 	return _u
 }
 
+// SetUnmatchedNames sets the "unmatched_names" field.
+func (_u *EventUpdate) SetUnmatchedNames(v []string) *EventUpdate {
+	_u.mutation.SetUnmatchedNames(v)
+	return _u
+}
+
+// AppendUnmatchedNames appends value to the "unmatched_names" field.
+func (_u *EventUpdate) AppendUnmatchedNames(v []string) *EventUpdate {
+	_u.mutation.AppendUnmatchedNames(v)
+	return _u
+}
+
+// ClearUnmatchedNames clears the value of the "unmatched_names" field.
+func (_u *EventUpdate) ClearUnmatchedNames() *EventUpdate {
+	_u.mutation.ClearUnmatchedNames()
+	return _u
+}
+
 // SetInChatID sets the "in_chat_id" field.
 func (_u *EventUpdate) SetInChatID(v string) *EventUpdate {
 	_u.mutation.SetInChatID(v)
@@ -166,6 +199,128 @@ func (_u *EventUpdate) AppendEvidenceMessageIds(v []uuid.UUID) *EventUpdate {
 	return _u
 }
 
+// SetSpanStart sets the "span_start" field.
+func (_u *EventUpdate) SetSpanStart(v int64) *EventUpdate {
+	_u.mutation.ResetSpanStart()
+	_u.mutation.SetSpanStart(v)
+	return _u
+}
+
+// SetNillableSpanStart sets the "span_start" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableSpanStart(v *int64) *EventUpdate {
+	if v != nil {
+		_u.SetSpanStart(*v)
+	}
+	return _u
+}
+
+// AddSpanStart adds value to the "span_start" field.
+func (_u *EventUpdate) AddSpanStart(v int64) *EventUpdate {
+	_u.mutation.AddSpanStart(v)
+	return _u
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (_u *EventUpdate) SetSpanEnd(v int64) *EventUpdate {
+	_u.mutation.ResetSpanEnd()
+	_u.mutation.SetSpanEnd(v)
+	return _u
+}
+
+// SetNillableSpanEnd sets the "span_end" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableSpanEnd(v *int64) *EventUpdate {
+	if v != nil {
+		_u.SetSpanEnd(*v)
+	}
+	return _u
+}
+
+// AddSpanEnd adds value to the "span_end" field.
+func (_u *EventUpdate) AddSpanEnd(v int64) *EventUpdate {
+	_u.mutation.AddSpanEnd(v)
+	return _u
+}
+
+// SetDescriptionVector1536 sets the "description_vector_1536" field.
+func (_u *EventUpdate) SetDescriptionVector1536(v pgvector.Vector) *EventUpdate {
+	_u.mutation.SetDescriptionVector1536(v)
+	return _u
+}
+
+// SetNillableDescriptionVector1536 sets the "description_vector_1536" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableDescriptionVector1536(v *pgvector.Vector) *EventUpdate {
+	if v != nil {
+		_u.SetDescriptionVector1536(*v)
+	}
+	return _u
+}
+
+// ClearDescriptionVector1536 clears the value of the "description_vector_1536" field.
+func (_u *EventUpdate) ClearDescriptionVector1536() *EventUpdate {
+	_u.mutation.ClearDescriptionVector1536()
+	return _u
+}
+
+// SetDescriptionVector1024 sets the "description_vector_1024" field.
+func (_u *EventUpdate) SetDescriptionVector1024(v pgvector.Vector) *EventUpdate {
+	_u.mutation.SetDescriptionVector1024(v)
+	return _u
+}
+
+// SetNillableDescriptionVector1024 sets the "description_vector_1024" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableDescriptionVector1024(v *pgvector.Vector) *EventUpdate {
+	if v != nil {
+		_u.SetDescriptionVector1024(*v)
+	}
+	return _u
+}
+
+// ClearDescriptionVector1024 clears the value of the "description_vector_1024" field.
+func (_u *EventUpdate) ClearDescriptionVector1024() *EventUpdate {
+	_u.mutation.ClearDescriptionVector1024()
+	return _u
+}
+
+// SetDescriptionVector768 sets the "description_vector_768" field.
+func (_u *EventUpdate) SetDescriptionVector768(v pgvector.Vector) *EventUpdate {
+	_u.mutation.SetDescriptionVector768(v)
+	return _u
+}
+
+// SetNillableDescriptionVector768 sets the "description_vector_768" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableDescriptionVector768(v *pgvector.Vector) *EventUpdate {
+	if v != nil {
+		_u.SetDescriptionVector768(*v)
+	}
+	return _u
+}
+
+// ClearDescriptionVector768 clears the value of the "description_vector_768" field.
+func (_u *EventUpdate) ClearDescriptionVector768() *EventUpdate {
+	_u.mutation.ClearDescriptionVector768()
+	return _u
+}
+
+// SetContinuesID sets the "continues_id" field.
+func (_u *EventUpdate) SetContinuesID(v uuid.UUID) *EventUpdate {
+	_u.mutation.SetContinuesID(v)
+	return _u
+}
+
+// SetNillableContinuesID sets the "continues_id" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableContinuesID(v *uuid.UUID) *EventUpdate {
+	if v != nil {
+		_u.SetContinuesID(*v)
+	}
+	return _u
+}
+
+// ClearContinuesID clears the value of the "continues_id" field.
+func (_u *EventUpdate) ClearContinuesID() *EventUpdate {
+	_u.mutation.ClearContinuesID()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *EventUpdate) SetCreatedAt(v int64) *EventUpdate {
 	_u.mutation.ResetCreatedAt()
@@ -215,6 +370,26 @@ func (_u *EventUpdate) AddIdentities(v ...*Identity) *EventUpdate {
 	return _u.AddIdentityIDs(ids...)
 }
 
+// SetContinues sets the "continues" edge to the Event entity.
+func (_u *EventUpdate) SetContinues(v *Event) *EventUpdate {
+	return _u.SetContinuesID(v.ID)
+}
+
+// AddContinuationIDs adds the "continuations" edge to the Event entity by IDs.
+func (_u *EventUpdate) AddContinuationIDs(ids ...uuid.UUID) *EventUpdate {
+	_u.mutation.AddContinuationIDs(ids...)
+	return _u
+}
+
+// AddContinuations adds the "continuations" edges to the Event entity.
+func (_u *EventUpdate) AddContinuations(v ...*Event) *EventUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContinuationIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (_u *EventUpdate) Mutation() *EventMutation {
 	return _u.mutation
@@ -241,6 +416,33 @@ func (_u *EventUpdate) RemoveIdentities(v ...*Identity) *EventUpdate {
 	return _u.RemoveIdentityIDs(ids...)
 }
 
+// ClearContinues clears the "continues" edge to the Event entity.
+func (_u *EventUpdate) ClearContinues() *EventUpdate {
+	_u.mutation.ClearContinues()
+	return _u
+}
+
+// ClearContinuations clears all "continuations" edges to the Event entity.
+func (_u *EventUpdate) ClearContinuations() *EventUpdate {
+	_u.mutation.ClearContinuations()
+	return _u
+}
+
+// RemoveContinuationIDs removes the "continuations" edge to Event entities by IDs.
+func (_u *EventUpdate) RemoveContinuationIDs(ids ...uuid.UUID) *EventUpdate {
+	_u.mutation.RemoveContinuationIDs(ids...)
+	return _u
+}
+
+// RemoveContinuations removes "continuations" edges to Event entities.
+func (_u *EventUpdate) RemoveContinuations(v ...*Event) *EventUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContinuationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EventUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -324,6 +526,9 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(event.FieldWorkspaceID, field.TypeUUID, value)
+	}
 	if value, ok := _u.mutation.Platform(); ok {
 		_spec.SetField(event.FieldPlatform, field.TypeString, value)
 	}
@@ -347,6 +552,17 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.FromName(); ok {
 		_spec.SetField(event.FieldFromName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.UnmatchedNames(); ok {
+		_spec.SetField(event.FieldUnmatchedNames, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUnmatchedNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, event.FieldUnmatchedNames, value)
+		})
+	}
+	if _u.mutation.UnmatchedNamesCleared() {
+		_spec.ClearField(event.FieldUnmatchedNames, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.InChatID(); ok {
 		_spec.SetField(event.FieldInChatID, field.TypeString, value)
 	}
@@ -367,6 +583,36 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			sqljson.Append(u, event.FieldEvidenceMessageIds, value)
 		})
 	}
+	if value, ok := _u.mutation.SpanStart(); ok {
+		_spec.SetField(event.FieldSpanStart, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSpanStart(); ok {
+		_spec.AddField(event.FieldSpanStart, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SpanEnd(); ok {
+		_spec.SetField(event.FieldSpanEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSpanEnd(); ok {
+		_spec.AddField(event.FieldSpanEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DescriptionVector1536(); ok {
+		_spec.SetField(event.FieldDescriptionVector1536, field.TypeOther, value)
+	}
+	if _u.mutation.DescriptionVector1536Cleared() {
+		_spec.ClearField(event.FieldDescriptionVector1536, field.TypeOther)
+	}
+	if value, ok := _u.mutation.DescriptionVector1024(); ok {
+		_spec.SetField(event.FieldDescriptionVector1024, field.TypeOther, value)
+	}
+	if _u.mutation.DescriptionVector1024Cleared() {
+		_spec.ClearField(event.FieldDescriptionVector1024, field.TypeOther)
+	}
+	if value, ok := _u.mutation.DescriptionVector768(); ok {
+		_spec.SetField(event.FieldDescriptionVector768, field.TypeOther, value)
+	}
+	if _u.mutation.DescriptionVector768Cleared() {
+		_spec.ClearField(event.FieldDescriptionVector768, field.TypeOther)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(event.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -427,6 +673,85 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContinuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.ContinuesTable,
+			Columns: []string{event.ContinuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Event
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContinuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.ContinuesTable,
+			Columns: []string{event.ContinuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Event
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContinuationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ContinuationsTable,
+			Columns: []string{event.ContinuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Event
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContinuationsIDs(); len(nodes) > 0 && !_u.mutation.ContinuationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ContinuationsTable,
+			Columns: []string{event.ContinuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Event
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContinuationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ContinuationsTable,
+			Columns: []string{event.ContinuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Event
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = _u.schemaConfig.Event
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
@@ -449,6 +774,20 @@ type EventUpdateOne struct {
 	mutation *EventMutation
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *EventUpdateOne) SetWorkspaceID(v uuid.UUID) *EventUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *EventUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
 // SetPlatform sets the "platform" field.
 func (_u *EventUpdateOne) SetPlatform(v string) *EventUpdateOne {
 	_u.mutation.SetPlatform(v)
@@ -523,6 +862,24 @@ func (_u *EventUpdateOne) SetNillableFromName(v *string) *EventUpdateOne {
 	return _u
 }
 
+// SetUnmatchedNames sets the "unmatched_names" field.
+func (_u *EventUpdateOne) SetUnmatchedNames(v []string) *EventUpdateOne {
+	_u.mutation.SetUnmatchedNames(v)
+	return _u
+}
+
+// AppendUnmatchedNames appends value to the "unmatched_names" field.
+func (_u *EventUpdateOne) AppendUnmatchedNames(v []string) *EventUpdateOne {
+	_u.mutation.AppendUnmatchedNames(v)
+	return _u
+}
+
+// ClearUnmatchedNames clears the value of the "unmatched_names" field.
+func (_u *EventUpdateOne) ClearUnmatchedNames() *EventUpdateOne {
+	_u.mutation.ClearUnmatchedNames()
+	return _u
+}
+
 // SetInChatID sets the "in_chat_id" field.
 func (_u *EventUpdateOne) SetInChatID(v string) *EventUpdateOne {
 	_u.mutation.SetInChatID(v)
@@ -584,6 +941,128 @@ func (_u *EventUpdateOne) AppendEvidenceMessageIds(v []uuid.UUID) *EventUpdateOn
 	return _u
 }
 
+// SetSpanStart sets the "span_start" field.
+func (_u *EventUpdateOne) SetSpanStart(v int64) *EventUpdateOne {
+	_u.mutation.ResetSpanStart()
+	_u.mutation.SetSpanStart(v)
+	return _u
+}
+
+// SetNillableSpanStart sets the "span_start" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableSpanStart(v *int64) *EventUpdateOne {
+	if v != nil {
+		_u.SetSpanStart(*v)
+	}
+	return _u
+}
+
+// AddSpanStart adds value to the "span_start" field.
+func (_u *EventUpdateOne) AddSpanStart(v int64) *EventUpdateOne {
+	_u.mutation.AddSpanStart(v)
+	return _u
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (_u *EventUpdateOne) SetSpanEnd(v int64) *EventUpdateOne {
+	_u.mutation.ResetSpanEnd()
+	_u.mutation.SetSpanEnd(v)
+	return _u
+}
+
+// SetNillableSpanEnd sets the "span_end" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableSpanEnd(v *int64) *EventUpdateOne {
+	if v != nil {
+		_u.SetSpanEnd(*v)
+	}
+	return _u
+}
+
+// AddSpanEnd adds value to the "span_end" field.
+func (_u *EventUpdateOne) AddSpanEnd(v int64) *EventUpdateOne {
+	_u.mutation.AddSpanEnd(v)
+	return _u
+}
+
+// SetDescriptionVector1536 sets the "description_vector_1536" field.
+func (_u *EventUpdateOne) SetDescriptionVector1536(v pgvector.Vector) *EventUpdateOne {
+	_u.mutation.SetDescriptionVector1536(v)
+	return _u
+}
+
+// SetNillableDescriptionVector1536 sets the "description_vector_1536" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableDescriptionVector1536(v *pgvector.Vector) *EventUpdateOne {
+	if v != nil {
+		_u.SetDescriptionVector1536(*v)
+	}
+	return _u
+}
+
+// ClearDescriptionVector1536 clears the value of the "description_vector_1536" field.
+func (_u *EventUpdateOne) ClearDescriptionVector1536() *EventUpdateOne {
+	_u.mutation.ClearDescriptionVector1536()
+	return _u
+}
+
+// SetDescriptionVector1024 sets the "description_vector_1024" field.
+func (_u *EventUpdateOne) SetDescriptionVector1024(v pgvector.Vector) *EventUpdateOne {
+	_u.mutation.SetDescriptionVector1024(v)
+	return _u
+}
+
+// SetNillableDescriptionVector1024 sets the "description_vector_1024" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableDescriptionVector1024(v *pgvector.Vector) *EventUpdateOne {
+	if v != nil {
+		_u.SetDescriptionVector1024(*v)
+	}
+	return _u
+}
+
+// ClearDescriptionVector1024 clears the value of the "description_vector_1024" field.
+func (_u *EventUpdateOne) ClearDescriptionVector1024() *EventUpdateOne {
+	_u.mutation.ClearDescriptionVector1024()
+	return _u
+}
+
+// SetDescriptionVector768 sets the "description_vector_768" field.
+func (_u *EventUpdateOne) SetDescriptionVector768(v pgvector.Vector) *EventUpdateOne {
+	_u.mutation.SetDescriptionVector768(v)
+	return _u
+}
+
+// SetNillableDescriptionVector768 sets the "description_vector_768" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableDescriptionVector768(v *pgvector.Vector) *EventUpdateOne {
+	if v != nil {
+		_u.SetDescriptionVector768(*v)
+	}
+	return _u
+}
+
+// ClearDescriptionVector768 clears the value of the "description_vector_768" field.
+func (_u *EventUpdateOne) ClearDescriptionVector768() *EventUpdateOne {
+	_u.mutation.ClearDescriptionVector768()
+	return _u
+}
+
+// SetContinuesID sets the "continues_id" field.
+func (_u *EventUpdateOne) SetContinuesID(v uuid.UUID) *EventUpdateOne {
+	_u.mutation.SetContinuesID(v)
+	return _u
+}
+
+// SetNillableContinuesID sets the "continues_id" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableContinuesID(v *uuid.UUID) *EventUpdateOne {
+	if v != nil {
+		_u.SetContinuesID(*v)
+	}
+	return _u
+}
+
+// ClearContinuesID clears the value of the "continues_id" field.
+func (_u *EventUpdateOne) ClearContinuesID() *EventUpdateOne {
+	_u.mutation.ClearContinuesID()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *EventUpdateOne) SetCreatedAt(v int64) *EventUpdateOne {
 	_u.mutation.ResetCreatedAt()
@@ -633,6 +1112,26 @@ func (_u *EventUpdateOne) AddIdentities(v ...*Identity) *EventUpdateOne {
 	return _u.AddIdentityIDs(ids...)
 }
 
+// SetContinues sets the "continues" edge to the Event entity.
+func (_u *EventUpdateOne) SetContinues(v *Event) *EventUpdateOne {
+	return _u.SetContinuesID(v.ID)
+}
+
+// AddContinuationIDs adds the "continuations" edge to the Event entity by IDs.
+func (_u *EventUpdateOne) AddContinuationIDs(ids ...uuid.UUID) *EventUpdateOne {
+	_u.mutation.AddContinuationIDs(ids...)
+	return _u
+}
+
+// AddContinuations adds the "continuations" edges to the Event entity.
+func (_u *EventUpdateOne) AddContinuations(v ...*Event) *EventUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContinuationIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (_u *EventUpdateOne) Mutation() *EventMutation {
 	return _u.mutation
@@ -659,6 +1158,33 @@ func (_u *EventUpdateOne) RemoveIdentities(v ...*Identity) *EventUpdateOne {
 	return _u.RemoveIdentityIDs(ids...)
 }
 
+// ClearContinues clears the "continues" edge to the Event entity.
+func (_u *EventUpdateOne) ClearContinues() *EventUpdateOne {
+	_u.mutation.ClearContinues()
+	return _u
+}
+
+// ClearContinuations clears all "continuations" edges to the Event entity.
+func (_u *EventUpdateOne) ClearContinuations() *EventUpdateOne {
+	_u.mutation.ClearContinuations()
+	return _u
+}
+
+// RemoveContinuationIDs removes the "continuations" edge to Event entities by IDs.
+func (_u *EventUpdateOne) RemoveContinuationIDs(ids ...uuid.UUID) *EventUpdateOne {
+	_u.mutation.RemoveContinuationIDs(ids...)
+	return _u
+}
+
+// RemoveContinuations removes "continuations" edges to Event entities.
+func (_u *EventUpdateOne) RemoveContinuations(v ...*Event) *EventUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContinuationIDs(ids...)
+}
+
 // Where appends a list predicates to the EventUpdate builder.
 func (_u *EventUpdateOne) Where(ps ...predicate.Event) *EventUpdateOne {
 	_u.mutation.Where(ps...)
@@ -772,6 +1298,9 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 			}
 		}
 	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(event.FieldWorkspaceID, field.TypeUUID, value)
+	}
 	if value, ok := _u.mutation.Platform(); ok {
 		_spec.SetField(event.FieldPlatform, field.TypeString, value)
 	}
@@ -795,6 +1324,17 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 	if value, ok := _u.mutation.FromName(); ok {
 		_spec.SetField(event.FieldFromName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.UnmatchedNames(); ok {
+		_spec.SetField(event.FieldUnmatchedNames, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUnmatchedNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, event.FieldUnmatchedNames, value)
+		})
+	}
+	if _u.mutation.UnmatchedNamesCleared() {
+		_spec.ClearField(event.FieldUnmatchedNames, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.InChatID(); ok {
 		_spec.SetField(event.FieldInChatID, field.TypeString, value)
 	}
@@ -815,6 +1355,36 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 			sqljson.Append(u, event.FieldEvidenceMessageIds, value)
 		})
 	}
+	if value, ok := _u.mutation.SpanStart(); ok {
+		_spec.SetField(event.FieldSpanStart, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSpanStart(); ok {
+		_spec.AddField(event.FieldSpanStart, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SpanEnd(); ok {
+		_spec.SetField(event.FieldSpanEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSpanEnd(); ok {
+		_spec.AddField(event.FieldSpanEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DescriptionVector1536(); ok {
+		_spec.SetField(event.FieldDescriptionVector1536, field.TypeOther, value)
+	}
+	if _u.mutation.DescriptionVector1536Cleared() {
+		_spec.ClearField(event.FieldDescriptionVector1536, field.TypeOther)
+	}
+	if value, ok := _u.mutation.DescriptionVector1024(); ok {
+		_spec.SetField(event.FieldDescriptionVector1024, field.TypeOther, value)
+	}
+	if _u.mutation.DescriptionVector1024Cleared() {
+		_spec.ClearField(event.FieldDescriptionVector1024, field.TypeOther)
+	}
+	if value, ok := _u.mutation.DescriptionVector768(); ok {
+		_spec.SetField(event.FieldDescriptionVector768, field.TypeOther, value)
+	}
+	if _u.mutation.DescriptionVector768Cleared() {
+		_spec.ClearField(event.FieldDescriptionVector768, field.TypeOther)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(event.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -875,6 +1445,85 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContinuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.ContinuesTable,
+			Columns: []string{event.ContinuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Event
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContinuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.ContinuesTable,
+			Columns: []string{event.ContinuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Event
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContinuationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ContinuationsTable,
+			Columns: []string{event.ContinuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Event
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContinuationsIDs(); len(nodes) > 0 && !_u.mutation.ContinuationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ContinuationsTable,
+			Columns: []string{event.ContinuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Event
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContinuationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ContinuationsTable,
+			Columns: []string{event.ContinuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Event
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = _u.schemaConfig.Event
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_node = &Event{config: _u.config}
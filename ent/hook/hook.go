@@ -9,6 +9,54 @@ import (
 	"github.com/luoling8192/mindwave/ent"
 )
 
+// The APIKeyFunc type is an adapter to allow the use of ordinary
+// function as APIKey mutator.
+type APIKeyFunc func(context.Context, *ent.APIKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APIKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIKeyMutation", m)
+}
+
+// The AccessLogFunc type is an adapter to allow the use of ordinary
+// function as AccessLog mutator.
+type AccessLogFunc func(context.Context, *ent.AccessLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccessLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccessLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccessLogMutation", m)
+}
+
+// The AskTurnFunc type is an adapter to allow the use of ordinary
+// function as AskTurn mutator.
+type AskTurnFunc func(context.Context, *ent.AskTurnMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AskTurnFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AskTurnMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AskTurnMutation", m)
+}
+
+// The ChatGrantFunc type is an adapter to allow the use of ordinary
+// function as ChatGrant mutator.
+type ChatGrantFunc func(context.Context, *ent.ChatGrantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatGrantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatGrantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatGrantMutation", m)
+}
+
 // The ChatMessageFunc type is an adapter to allow the use of ordinary
 // function as ChatMessage mutator.
 type ChatMessageFunc func(context.Context, *ent.ChatMessageMutation) (ent.Value, error)
@@ -21,6 +69,54 @@ func (f ChatMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatMessageMutation", m)
 }
 
+// The ChatRetentionFunc type is an adapter to allow the use of ordinary
+// function as ChatRetention mutator.
+type ChatRetentionFunc func(context.Context, *ent.ChatRetentionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatRetentionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatRetentionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatRetentionMutation", m)
+}
+
+// The ChatScheduleFunc type is an adapter to allow the use of ordinary
+// function as ChatSchedule mutator.
+type ChatScheduleFunc func(context.Context, *ent.ChatScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatScheduleMutation", m)
+}
+
+// The DigestDeliveryFunc type is an adapter to allow the use of ordinary
+// function as DigestDelivery mutator.
+type DigestDeliveryFunc func(context.Context, *ent.DigestDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DigestDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DigestDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DigestDeliveryMutation", m)
+}
+
+// The DistillRunFunc type is an adapter to allow the use of ordinary
+// function as DistillRun mutator.
+type DistillRunFunc func(context.Context, *ent.DistillRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DistillRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DistillRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DistillRunMutation", m)
+}
+
 // The EventFunc type is an adapter to allow the use of ordinary
 // function as Event mutator.
 type EventFunc func(context.Context, *ent.EventMutation) (ent.Value, error)
@@ -45,6 +141,18 @@ func (f IdentityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdentityMutation", m)
 }
 
+// The JobFunc type is an adapter to allow the use of ordinary
+// function as Job mutator.
+type JobFunc func(context.Context, *ent.JobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobMutation", m)
+}
+
 // The JoinedChatFunc type is an adapter to allow the use of ordinary
 // function as JoinedChat mutator.
 type JoinedChatFunc func(context.Context, *ent.JoinedChatMutation) (ent.Value, error)
@@ -57,6 +165,90 @@ func (f JoinedChatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JoinedChatMutation", m)
 }
 
+// The LLMCacheEntryFunc type is an adapter to allow the use of ordinary
+// function as LLMCacheEntry mutator.
+type LLMCacheEntryFunc func(context.Context, *ent.LLMCacheEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LLMCacheEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LLMCacheEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LLMCacheEntryMutation", m)
+}
+
+// The OwnerAccountLinkFunc type is an adapter to allow the use of ordinary
+// function as OwnerAccountLink mutator.
+type OwnerAccountLinkFunc func(context.Context, *ent.OwnerAccountLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OwnerAccountLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OwnerAccountLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OwnerAccountLinkMutation", m)
+}
+
+// The PersonFunc type is an adapter to allow the use of ordinary
+// function as Person mutator.
+type PersonFunc func(context.Context, *ent.PersonMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PersonFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PersonMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PersonMutation", m)
+}
+
+// The PersonAuditLogFunc type is an adapter to allow the use of ordinary
+// function as PersonAuditLog mutator.
+type PersonAuditLogFunc func(context.Context, *ent.PersonAuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PersonAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PersonAuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PersonAuditLogMutation", m)
+}
+
+// The ProfileFunc type is an adapter to allow the use of ordinary
+// function as Profile mutator.
+type ProfileFunc func(context.Context, *ent.ProfileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProfileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfileMutation", m)
+}
+
+// The SummaryFunc type is an adapter to allow the use of ordinary
+// function as Summary mutator.
+type SummaryFunc func(context.Context, *ent.SummaryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SummaryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SummaryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SummaryMutation", m)
+}
+
+// The WorkspaceFunc type is an adapter to allow the use of ordinary
+// function as Workspace mutator.
+type WorkspaceFunc func(context.Context, *ent.WorkspaceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkspaceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkspaceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkspaceMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/person"
 )
 
 // Identity is the model entity for the Identity schema.
@@ -18,6 +19,8 @@ type Identity struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 	// Platform holds the value of the "platform" field.
 	Platform string `json:"platform,omitempty"`
 	// PlatformUserID holds the value of the "platform_user_id" field.
@@ -30,6 +33,12 @@ type Identity struct {
 	ProfilePhotoURL string `json:"profile_photo_url,omitempty"`
 	// AltIds holds the value of the "alt_ids" field.
 	AltIds []string `json:"alt_ids,omitempty"`
+	// PersonID holds the value of the "person_id" field.
+	PersonID *uuid.UUID `json:"person_id,omitempty"`
+	// OptedOut holds the value of the "opted_out" field.
+	OptedOut bool `json:"opted_out,omitempty"`
+	// ForgottenAt holds the value of the "forgotten_at" field.
+	ForgottenAt int64 `json:"forgotten_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt int64 `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -44,9 +53,13 @@ type Identity struct {
 type IdentityEdges struct {
 	// Events holds the value of the events edge.
 	Events []*Event `json:"events,omitempty"`
+	// Profiles holds the value of the profiles edge.
+	Profiles []*Profile `json:"profiles,omitempty"`
+	// Person holds the value of the person edge.
+	Person *Person `json:"person,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // EventsOrErr returns the Events value or an error if the edge
@@ -58,18 +71,42 @@ func (e IdentityEdges) EventsOrErr() ([]*Event, error) {
 	return nil, &NotLoadedError{edge: "events"}
 }
 
+// ProfilesOrErr returns the Profiles value or an error if the edge
+// was not loaded in eager-loading.
+func (e IdentityEdges) ProfilesOrErr() ([]*Profile, error) {
+	if e.loadedTypes[1] {
+		return e.Profiles, nil
+	}
+	return nil, &NotLoadedError{edge: "profiles"}
+}
+
+// PersonOrErr returns the Person value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IdentityEdges) PersonOrErr() (*Person, error) {
+	if e.Person != nil {
+		return e.Person, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: person.Label}
+	}
+	return nil, &NotLoadedError{edge: "person"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Identity) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case identity.FieldPersonID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case identity.FieldAltIds:
 			values[i] = new([]byte)
-		case identity.FieldCreatedAt, identity.FieldUpdatedAt:
+		case identity.FieldOptedOut:
+			values[i] = new(sql.NullBool)
+		case identity.FieldForgottenAt, identity.FieldCreatedAt, identity.FieldUpdatedAt:
 			values[i] = new(sql.NullInt64)
 		case identity.FieldPlatform, identity.FieldPlatformUserID, identity.FieldUsername, identity.FieldDisplayName, identity.FieldProfilePhotoURL:
 			values[i] = new(sql.NullString)
-		case identity.FieldID:
+		case identity.FieldID, identity.FieldWorkspaceID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -92,6 +129,12 @@ func (_m *Identity) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case identity.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
 		case identity.FieldPlatform:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field platform", values[i])
@@ -130,6 +173,25 @@ func (_m *Identity) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field alt_ids: %w", err)
 				}
 			}
+		case identity.FieldPersonID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field person_id", values[i])
+			} else if value.Valid {
+				_m.PersonID = new(uuid.UUID)
+				*_m.PersonID = *value.S.(*uuid.UUID)
+			}
+		case identity.FieldOptedOut:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field opted_out", values[i])
+			} else if value.Valid {
+				_m.OptedOut = value.Bool
+			}
+		case identity.FieldForgottenAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field forgotten_at", values[i])
+			} else if value.Valid {
+				_m.ForgottenAt = value.Int64
+			}
 		case identity.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -160,6 +222,16 @@ func (_m *Identity) QueryEvents() *EventQuery {
 	return NewIdentityClient(_m.config).QueryEvents(_m)
 }
 
+// QueryProfiles queries the "profiles" edge of the Identity entity.
+func (_m *Identity) QueryProfiles() *ProfileQuery {
+	return NewIdentityClient(_m.config).QueryProfiles(_m)
+}
+
+// QueryPerson queries the "person" edge of the Identity entity.
+func (_m *Identity) QueryPerson() *PersonQuery {
+	return NewIdentityClient(_m.config).QueryPerson(_m)
+}
+
 // Update returns a builder for updating this Identity.
 // Note that you need to call Identity.Unwrap() before calling this method if this Identity
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -183,6 +255,9 @@ func (_m *Identity) String() string {
 	var builder strings.Builder
 	builder.WriteString("Identity(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
 	builder.WriteString("platform=")
 	builder.WriteString(_m.Platform)
 	builder.WriteString(", ")
@@ -201,6 +276,17 @@ func (_m *Identity) String() string {
 	builder.WriteString("alt_ids=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AltIds))
 	builder.WriteString(", ")
+	if v := _m.PersonID; v != nil {
+		builder.WriteString("person_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("opted_out=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OptedOut))
+	builder.WriteString(", ")
+	builder.WriteString("forgotten_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ForgottenAt))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
 	builder.WriteString(", ")
@@ -55,6 +55,11 @@ func IDLTE(id uuid.UUID) predicate.Identity {
 	return predicate.Identity(sql.FieldLTE(FieldID, id))
 }
 
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
 // Platform applies equality check predicate on the "platform" field. It's identical to PlatformEQ.
 func Platform(v string) predicate.Identity {
 	return predicate.Identity(sql.FieldEQ(FieldPlatform, v))
@@ -80,6 +85,21 @@ func ProfilePhotoURL(v string) predicate.Identity {
 	return predicate.Identity(sql.FieldEQ(FieldProfilePhotoURL, v))
 }
 
+// PersonID applies equality check predicate on the "person_id" field. It's identical to PersonIDEQ.
+func PersonID(v uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldEQ(FieldPersonID, v))
+}
+
+// OptedOut applies equality check predicate on the "opted_out" field. It's identical to OptedOutEQ.
+func OptedOut(v bool) predicate.Identity {
+	return predicate.Identity(sql.FieldEQ(FieldOptedOut, v))
+}
+
+// ForgottenAt applies equality check predicate on the "forgotten_at" field. It's identical to ForgottenAtEQ.
+func ForgottenAt(v int64) predicate.Identity {
+	return predicate.Identity(sql.FieldEQ(FieldForgottenAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v int64) predicate.Identity {
 	return predicate.Identity(sql.FieldEQ(FieldCreatedAt, v))
@@ -90,6 +110,46 @@ func UpdatedAt(v int64) predicate.Identity {
 	return predicate.Identity(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
 // PlatformEQ applies the EQ predicate on the "platform" field.
 func PlatformEQ(v string) predicate.Identity {
 	return predicate.Identity(sql.FieldEQ(FieldPlatform, v))
@@ -425,6 +485,86 @@ func AltIdsNotNil() predicate.Identity {
 	return predicate.Identity(sql.FieldNotNull(FieldAltIds))
 }
 
+// PersonIDEQ applies the EQ predicate on the "person_id" field.
+func PersonIDEQ(v uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldEQ(FieldPersonID, v))
+}
+
+// PersonIDNEQ applies the NEQ predicate on the "person_id" field.
+func PersonIDNEQ(v uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldNEQ(FieldPersonID, v))
+}
+
+// PersonIDIn applies the In predicate on the "person_id" field.
+func PersonIDIn(vs ...uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldIn(FieldPersonID, vs...))
+}
+
+// PersonIDNotIn applies the NotIn predicate on the "person_id" field.
+func PersonIDNotIn(vs ...uuid.UUID) predicate.Identity {
+	return predicate.Identity(sql.FieldNotIn(FieldPersonID, vs...))
+}
+
+// PersonIDIsNil applies the IsNil predicate on the "person_id" field.
+func PersonIDIsNil() predicate.Identity {
+	return predicate.Identity(sql.FieldIsNull(FieldPersonID))
+}
+
+// PersonIDNotNil applies the NotNil predicate on the "person_id" field.
+func PersonIDNotNil() predicate.Identity {
+	return predicate.Identity(sql.FieldNotNull(FieldPersonID))
+}
+
+// OptedOutEQ applies the EQ predicate on the "opted_out" field.
+func OptedOutEQ(v bool) predicate.Identity {
+	return predicate.Identity(sql.FieldEQ(FieldOptedOut, v))
+}
+
+// OptedOutNEQ applies the NEQ predicate on the "opted_out" field.
+func OptedOutNEQ(v bool) predicate.Identity {
+	return predicate.Identity(sql.FieldNEQ(FieldOptedOut, v))
+}
+
+// ForgottenAtEQ applies the EQ predicate on the "forgotten_at" field.
+func ForgottenAtEQ(v int64) predicate.Identity {
+	return predicate.Identity(sql.FieldEQ(FieldForgottenAt, v))
+}
+
+// ForgottenAtNEQ applies the NEQ predicate on the "forgotten_at" field.
+func ForgottenAtNEQ(v int64) predicate.Identity {
+	return predicate.Identity(sql.FieldNEQ(FieldForgottenAt, v))
+}
+
+// ForgottenAtIn applies the In predicate on the "forgotten_at" field.
+func ForgottenAtIn(vs ...int64) predicate.Identity {
+	return predicate.Identity(sql.FieldIn(FieldForgottenAt, vs...))
+}
+
+// ForgottenAtNotIn applies the NotIn predicate on the "forgotten_at" field.
+func ForgottenAtNotIn(vs ...int64) predicate.Identity {
+	return predicate.Identity(sql.FieldNotIn(FieldForgottenAt, vs...))
+}
+
+// ForgottenAtGT applies the GT predicate on the "forgotten_at" field.
+func ForgottenAtGT(v int64) predicate.Identity {
+	return predicate.Identity(sql.FieldGT(FieldForgottenAt, v))
+}
+
+// ForgottenAtGTE applies the GTE predicate on the "forgotten_at" field.
+func ForgottenAtGTE(v int64) predicate.Identity {
+	return predicate.Identity(sql.FieldGTE(FieldForgottenAt, v))
+}
+
+// ForgottenAtLT applies the LT predicate on the "forgotten_at" field.
+func ForgottenAtLT(v int64) predicate.Identity {
+	return predicate.Identity(sql.FieldLT(FieldForgottenAt, v))
+}
+
+// ForgottenAtLTE applies the LTE predicate on the "forgotten_at" field.
+func ForgottenAtLTE(v int64) predicate.Identity {
+	return predicate.Identity(sql.FieldLTE(FieldForgottenAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v int64) predicate.Identity {
 	return predicate.Identity(sql.FieldEQ(FieldCreatedAt, v))
@@ -534,6 +674,64 @@ func HasEventsWith(preds ...predicate.Event) predicate.Identity {
 	})
 }
 
+// HasProfiles applies the HasEdge predicate on the "profiles" edge.
+func HasProfiles() predicate.Identity {
+	return predicate.Identity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProfilesTable, ProfilesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Profile
+		step.Edge.Schema = schemaConfig.Profile
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfilesWith applies the HasEdge predicate on the "profiles" edge with a given conditions (other predicates).
+func HasProfilesWith(preds ...predicate.Profile) predicate.Identity {
+	return predicate.Identity(func(s *sql.Selector) {
+		step := newProfilesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Profile
+		step.Edge.Schema = schemaConfig.Profile
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPerson applies the HasEdge predicate on the "person" edge.
+func HasPerson() predicate.Identity {
+	return predicate.Identity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PersonTable, PersonColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Person
+		step.Edge.Schema = schemaConfig.Identity
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPersonWith applies the HasEdge predicate on the "person" edge with a given conditions (other predicates).
+func HasPersonWith(preds ...predicate.Person) predicate.Identity {
+	return predicate.Identity(func(s *sql.Selector) {
+		step := newPersonStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Person
+		step.Edge.Schema = schemaConfig.Identity
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Identity) predicate.Identity {
 	return predicate.Identity(sql.AndPredicates(predicates...))
@@ -14,6 +14,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/luoling8192/mindwave/ent/event"
 	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/person"
+	"github.com/luoling8192/mindwave/ent/profile"
 )
 
 // IdentityCreate is the builder for creating a Identity entity.
@@ -24,6 +26,20 @@ type IdentityCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *IdentityCreate) SetWorkspaceID(v uuid.UUID) *IdentityCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *IdentityCreate) SetNillableWorkspaceID(v *uuid.UUID) *IdentityCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
 // SetPlatform sets the "platform" field.
 func (_c *IdentityCreate) SetPlatform(v string) *IdentityCreate {
 	_c.mutation.SetPlatform(v)
@@ -100,6 +116,48 @@ func (_c *IdentityCreate) SetAltIds(v []string) *IdentityCreate {
 	return _c
 }
 
+// SetPersonID sets the "person_id" field.
+func (_c *IdentityCreate) SetPersonID(v uuid.UUID) *IdentityCreate {
+	_c.mutation.SetPersonID(v)
+	return _c
+}
+
+// SetNillablePersonID sets the "person_id" field if the given value is not nil.
+func (_c *IdentityCreate) SetNillablePersonID(v *uuid.UUID) *IdentityCreate {
+	if v != nil {
+		_c.SetPersonID(*v)
+	}
+	return _c
+}
+
+// SetOptedOut sets the "opted_out" field.
+func (_c *IdentityCreate) SetOptedOut(v bool) *IdentityCreate {
+	_c.mutation.SetOptedOut(v)
+	return _c
+}
+
+// SetNillableOptedOut sets the "opted_out" field if the given value is not nil.
+func (_c *IdentityCreate) SetNillableOptedOut(v *bool) *IdentityCreate {
+	if v != nil {
+		_c.SetOptedOut(*v)
+	}
+	return _c
+}
+
+// SetForgottenAt sets the "forgotten_at" field.
+func (_c *IdentityCreate) SetForgottenAt(v int64) *IdentityCreate {
+	_c.mutation.SetForgottenAt(v)
+	return _c
+}
+
+// SetNillableForgottenAt sets the "forgotten_at" field if the given value is not nil.
+func (_c *IdentityCreate) SetNillableForgottenAt(v *int64) *IdentityCreate {
+	if v != nil {
+		_c.SetForgottenAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *IdentityCreate) SetCreatedAt(v int64) *IdentityCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -157,6 +215,26 @@ func (_c *IdentityCreate) AddEvents(v ...*Event) *IdentityCreate {
 	return _c.AddEventIDs(ids...)
 }
 
+// AddProfileIDs adds the "profiles" edge to the Profile entity by IDs.
+func (_c *IdentityCreate) AddProfileIDs(ids ...uuid.UUID) *IdentityCreate {
+	_c.mutation.AddProfileIDs(ids...)
+	return _c
+}
+
+// AddProfiles adds the "profiles" edges to the Profile entity.
+func (_c *IdentityCreate) AddProfiles(v ...*Profile) *IdentityCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddProfileIDs(ids...)
+}
+
+// SetPerson sets the "person" edge to the Person entity.
+func (_c *IdentityCreate) SetPerson(v *Person) *IdentityCreate {
+	return _c.SetPersonID(v.ID)
+}
+
 // Mutation returns the IdentityMutation object of the builder.
 func (_c *IdentityCreate) Mutation() *IdentityMutation {
 	return _c.mutation
@@ -192,6 +270,10 @@ func (_c *IdentityCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *IdentityCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := identity.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
 	if _, ok := _c.mutation.Platform(); !ok {
 		v := identity.DefaultPlatform
 		_c.mutation.SetPlatform(v)
@@ -212,6 +294,14 @@ func (_c *IdentityCreate) defaults() {
 		v := identity.DefaultProfilePhotoURL
 		_c.mutation.SetProfilePhotoURL(v)
 	}
+	if _, ok := _c.mutation.OptedOut(); !ok {
+		v := identity.DefaultOptedOut
+		_c.mutation.SetOptedOut(v)
+	}
+	if _, ok := _c.mutation.ForgottenAt(); !ok {
+		v := identity.DefaultForgottenAt
+		_c.mutation.SetForgottenAt(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := identity.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -228,6 +318,9 @@ func (_c *IdentityCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *IdentityCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Identity.workspace_id"`)}
+	}
 	if _, ok := _c.mutation.Platform(); !ok {
 		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "Identity.platform"`)}
 	}
@@ -243,6 +336,12 @@ func (_c *IdentityCreate) check() error {
 	if _, ok := _c.mutation.ProfilePhotoURL(); !ok {
 		return &ValidationError{Name: "profile_photo_url", err: errors.New(`ent: missing required field "Identity.profile_photo_url"`)}
 	}
+	if _, ok := _c.mutation.OptedOut(); !ok {
+		return &ValidationError{Name: "opted_out", err: errors.New(`ent: missing required field "Identity.opted_out"`)}
+	}
+	if _, ok := _c.mutation.ForgottenAt(); !ok {
+		return &ValidationError{Name: "forgotten_at", err: errors.New(`ent: missing required field "Identity.forgotten_at"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Identity.created_at"`)}
 	}
@@ -286,6 +385,10 @@ func (_c *IdentityCreate) createSpec() (*Identity, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(identity.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
 	if value, ok := _c.mutation.Platform(); ok {
 		_spec.SetField(identity.FieldPlatform, field.TypeString, value)
 		_node.Platform = value
@@ -310,6 +413,14 @@ func (_c *IdentityCreate) createSpec() (*Identity, *sqlgraph.CreateSpec) {
 		_spec.SetField(identity.FieldAltIds, field.TypeJSON, value)
 		_node.AltIds = value
 	}
+	if value, ok := _c.mutation.OptedOut(); ok {
+		_spec.SetField(identity.FieldOptedOut, field.TypeBool, value)
+		_node.OptedOut = value
+	}
+	if value, ok := _c.mutation.ForgottenAt(); ok {
+		_spec.SetField(identity.FieldForgottenAt, field.TypeInt64, value)
+		_node.ForgottenAt = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(identity.FieldCreatedAt, field.TypeInt64, value)
 		_node.CreatedAt = value
@@ -335,6 +446,41 @@ func (_c *IdentityCreate) createSpec() (*Identity, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ProfilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   identity.ProfilesTable,
+			Columns: []string{identity.ProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.Profile
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PersonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   identity.PersonTable,
+			Columns: []string{identity.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.Identity
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PersonID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -342,7 +488,7 @@ func (_c *IdentityCreate) createSpec() (*Identity, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Identity.Create().
-//		SetPlatform(v).
+//		SetWorkspaceID(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -351,7 +497,7 @@ func (_c *IdentityCreate) createSpec() (*Identity, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.IdentityUpsert) {
-//			SetPlatform(v+v).
+//			SetWorkspaceID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *IdentityCreate) OnConflict(opts ...sql.ConflictOption) *IdentityUpsertOne {
@@ -387,6 +533,18 @@ type (
 	}
 )
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *IdentityUpsert) SetWorkspaceID(v uuid.UUID) *IdentityUpsert {
+	u.Set(identity.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *IdentityUpsert) UpdateWorkspaceID() *IdentityUpsert {
+	u.SetExcluded(identity.FieldWorkspaceID)
+	return u
+}
+
 // SetPlatform sets the "platform" field.
 func (u *IdentityUpsert) SetPlatform(v string) *IdentityUpsert {
 	u.Set(identity.FieldPlatform, v)
@@ -465,6 +623,54 @@ func (u *IdentityUpsert) ClearAltIds() *IdentityUpsert {
 	return u
 }
 
+// SetPersonID sets the "person_id" field.
+func (u *IdentityUpsert) SetPersonID(v uuid.UUID) *IdentityUpsert {
+	u.Set(identity.FieldPersonID, v)
+	return u
+}
+
+// UpdatePersonID sets the "person_id" field to the value that was provided on create.
+func (u *IdentityUpsert) UpdatePersonID() *IdentityUpsert {
+	u.SetExcluded(identity.FieldPersonID)
+	return u
+}
+
+// ClearPersonID clears the value of the "person_id" field.
+func (u *IdentityUpsert) ClearPersonID() *IdentityUpsert {
+	u.SetNull(identity.FieldPersonID)
+	return u
+}
+
+// SetOptedOut sets the "opted_out" field.
+func (u *IdentityUpsert) SetOptedOut(v bool) *IdentityUpsert {
+	u.Set(identity.FieldOptedOut, v)
+	return u
+}
+
+// UpdateOptedOut sets the "opted_out" field to the value that was provided on create.
+func (u *IdentityUpsert) UpdateOptedOut() *IdentityUpsert {
+	u.SetExcluded(identity.FieldOptedOut)
+	return u
+}
+
+// SetForgottenAt sets the "forgotten_at" field.
+func (u *IdentityUpsert) SetForgottenAt(v int64) *IdentityUpsert {
+	u.Set(identity.FieldForgottenAt, v)
+	return u
+}
+
+// UpdateForgottenAt sets the "forgotten_at" field to the value that was provided on create.
+func (u *IdentityUpsert) UpdateForgottenAt() *IdentityUpsert {
+	u.SetExcluded(identity.FieldForgottenAt)
+	return u
+}
+
+// AddForgottenAt adds v to the "forgotten_at" field.
+func (u *IdentityUpsert) AddForgottenAt(v int64) *IdentityUpsert {
+	u.Add(identity.FieldForgottenAt, v)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *IdentityUpsert) SetCreatedAt(v int64) *IdentityUpsert {
 	u.Set(identity.FieldCreatedAt, v)
@@ -549,6 +755,20 @@ func (u *IdentityUpsertOne) Update(set func(*IdentityUpsert)) *IdentityUpsertOne
 	return u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *IdentityUpsertOne) SetWorkspaceID(v uuid.UUID) *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *IdentityUpsertOne) UpdateWorkspaceID() *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
 // SetPlatform sets the "platform" field.
 func (u *IdentityUpsertOne) SetPlatform(v string) *IdentityUpsertOne {
 	return u.Update(func(s *IdentityUpsert) {
@@ -640,6 +860,62 @@ func (u *IdentityUpsertOne) ClearAltIds() *IdentityUpsertOne {
 	})
 }
 
+// SetPersonID sets the "person_id" field.
+func (u *IdentityUpsertOne) SetPersonID(v uuid.UUID) *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetPersonID(v)
+	})
+}
+
+// UpdatePersonID sets the "person_id" field to the value that was provided on create.
+func (u *IdentityUpsertOne) UpdatePersonID() *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdatePersonID()
+	})
+}
+
+// ClearPersonID clears the value of the "person_id" field.
+func (u *IdentityUpsertOne) ClearPersonID() *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.ClearPersonID()
+	})
+}
+
+// SetOptedOut sets the "opted_out" field.
+func (u *IdentityUpsertOne) SetOptedOut(v bool) *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetOptedOut(v)
+	})
+}
+
+// UpdateOptedOut sets the "opted_out" field to the value that was provided on create.
+func (u *IdentityUpsertOne) UpdateOptedOut() *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateOptedOut()
+	})
+}
+
+// SetForgottenAt sets the "forgotten_at" field.
+func (u *IdentityUpsertOne) SetForgottenAt(v int64) *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetForgottenAt(v)
+	})
+}
+
+// AddForgottenAt adds v to the "forgotten_at" field.
+func (u *IdentityUpsertOne) AddForgottenAt(v int64) *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.AddForgottenAt(v)
+	})
+}
+
+// UpdateForgottenAt sets the "forgotten_at" field to the value that was provided on create.
+func (u *IdentityUpsertOne) UpdateForgottenAt() *IdentityUpsertOne {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateForgottenAt()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *IdentityUpsertOne) SetCreatedAt(v int64) *IdentityUpsertOne {
 	return u.Update(func(s *IdentityUpsert) {
@@ -818,7 +1094,7 @@ func (_c *IdentityCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.IdentityUpsert) {
-//			SetPlatform(v+v).
+//			SetWorkspaceID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *IdentityCreateBulk) OnConflict(opts ...sql.ConflictOption) *IdentityUpsertBulk {
@@ -897,6 +1173,20 @@ func (u *IdentityUpsertBulk) Update(set func(*IdentityUpsert)) *IdentityUpsertBu
 	return u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *IdentityUpsertBulk) SetWorkspaceID(v uuid.UUID) *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *IdentityUpsertBulk) UpdateWorkspaceID() *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
 // SetPlatform sets the "platform" field.
 func (u *IdentityUpsertBulk) SetPlatform(v string) *IdentityUpsertBulk {
 	return u.Update(func(s *IdentityUpsert) {
@@ -988,6 +1278,62 @@ func (u *IdentityUpsertBulk) ClearAltIds() *IdentityUpsertBulk {
 	})
 }
 
+// SetPersonID sets the "person_id" field.
+func (u *IdentityUpsertBulk) SetPersonID(v uuid.UUID) *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetPersonID(v)
+	})
+}
+
+// UpdatePersonID sets the "person_id" field to the value that was provided on create.
+func (u *IdentityUpsertBulk) UpdatePersonID() *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdatePersonID()
+	})
+}
+
+// ClearPersonID clears the value of the "person_id" field.
+func (u *IdentityUpsertBulk) ClearPersonID() *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.ClearPersonID()
+	})
+}
+
+// SetOptedOut sets the "opted_out" field.
+func (u *IdentityUpsertBulk) SetOptedOut(v bool) *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetOptedOut(v)
+	})
+}
+
+// UpdateOptedOut sets the "opted_out" field to the value that was provided on create.
+func (u *IdentityUpsertBulk) UpdateOptedOut() *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateOptedOut()
+	})
+}
+
+// SetForgottenAt sets the "forgotten_at" field.
+func (u *IdentityUpsertBulk) SetForgottenAt(v int64) *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.SetForgottenAt(v)
+	})
+}
+
+// AddForgottenAt adds v to the "forgotten_at" field.
+func (u *IdentityUpsertBulk) AddForgottenAt(v int64) *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.AddForgottenAt(v)
+	})
+}
+
+// UpdateForgottenAt sets the "forgotten_at" field to the value that was provided on create.
+func (u *IdentityUpsertBulk) UpdateForgottenAt() *IdentityUpsertBulk {
+	return u.Update(func(s *IdentityUpsert) {
+		s.UpdateForgottenAt()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *IdentityUpsertBulk) SetCreatedAt(v int64) *IdentityUpsertBulk {
 	return u.Update(func(s *IdentityUpsert) {
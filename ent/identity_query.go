@@ -17,18 +17,22 @@ import (
 	"github.com/luoling8192/mindwave/ent/event"
 	"github.com/luoling8192/mindwave/ent/identity"
 	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/person"
 	"github.com/luoling8192/mindwave/ent/predicate"
+	"github.com/luoling8192/mindwave/ent/profile"
 )
 
 // IdentityQuery is the builder for querying Identity entities.
 type IdentityQuery struct {
 	config
-	ctx        *QueryContext
-	order      []identity.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Identity
-	withEvents *EventQuery
-	modifiers  []func(*sql.Selector)
+	ctx          *QueryContext
+	order        []identity.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Identity
+	withEvents   *EventQuery
+	withProfiles *ProfileQuery
+	withPerson   *PersonQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -90,6 +94,56 @@ func (_q *IdentityQuery) QueryEvents() *EventQuery {
 	return query
 }
 
+// QueryProfiles chains the current query on the "profiles" edge.
+func (_q *IdentityQuery) QueryProfiles() *ProfileQuery {
+	query := (&ProfileClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identity.Table, identity.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, identity.ProfilesTable, identity.ProfilesColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.Profile
+		step.Edge.Schema = schemaConfig.Profile
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPerson chains the current query on the "person" edge.
+func (_q *IdentityQuery) QueryPerson() *PersonQuery {
+	query := (&PersonClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identity.Table, identity.FieldID, selector),
+			sqlgraph.To(person.Table, person.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, identity.PersonTable, identity.PersonColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.Person
+		step.Edge.Schema = schemaConfig.Identity
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Identity entity from the query.
 // Returns a *NotFoundError when no Identity was found.
 func (_q *IdentityQuery) First(ctx context.Context) (*Identity, error) {
@@ -277,12 +331,14 @@ func (_q *IdentityQuery) Clone() *IdentityQuery {
 		return nil
 	}
 	return &IdentityQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]identity.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Identity{}, _q.predicates...),
-		withEvents: _q.withEvents.Clone(),
+		config:       _q.config,
+		ctx:          _q.ctx.Clone(),
+		order:        append([]identity.OrderOption{}, _q.order...),
+		inters:       append([]Interceptor{}, _q.inters...),
+		predicates:   append([]predicate.Identity{}, _q.predicates...),
+		withEvents:   _q.withEvents.Clone(),
+		withProfiles: _q.withProfiles.Clone(),
+		withPerson:   _q.withPerson.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -300,18 +356,40 @@ func (_q *IdentityQuery) WithEvents(opts ...func(*EventQuery)) *IdentityQuery {
 	return _q
 }
 
+// WithProfiles tells the query-builder to eager-load the nodes that are connected to
+// the "profiles" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *IdentityQuery) WithProfiles(opts ...func(*ProfileQuery)) *IdentityQuery {
+	query := (&ProfileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withProfiles = query
+	return _q
+}
+
+// WithPerson tells the query-builder to eager-load the nodes that are connected to
+// the "person" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *IdentityQuery) WithPerson(opts ...func(*PersonQuery)) *IdentityQuery {
+	query := (&PersonClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPerson = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Platform string `json:"platform,omitempty"`
+//		WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Identity.Query().
-//		GroupBy(identity.FieldPlatform).
+//		GroupBy(identity.FieldWorkspaceID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *IdentityQuery) GroupBy(field string, fields ...string) *IdentityGroupBy {
@@ -329,11 +407,11 @@ func (_q *IdentityQuery) GroupBy(field string, fields ...string) *IdentityGroupB
 // Example:
 //
 //	var v []struct {
-//		Platform string `json:"platform,omitempty"`
+//		WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 //	}
 //
 //	client.Identity.Query().
-//		Select(identity.FieldPlatform).
+//		Select(identity.FieldWorkspaceID).
 //		Scan(ctx, &v)
 func (_q *IdentityQuery) Select(fields ...string) *IdentitySelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -378,8 +456,10 @@ func (_q *IdentityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ide
 	var (
 		nodes       = []*Identity{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			_q.withEvents != nil,
+			_q.withProfiles != nil,
+			_q.withPerson != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -412,6 +492,19 @@ func (_q *IdentityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ide
 			return nil, err
 		}
 	}
+	if query := _q.withProfiles; query != nil {
+		if err := _q.loadProfiles(ctx, query, nodes,
+			func(n *Identity) { n.Edges.Profiles = []*Profile{} },
+			func(n *Identity, e *Profile) { n.Edges.Profiles = append(n.Edges.Profiles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPerson; query != nil {
+		if err := _q.loadPerson(ctx, query, nodes, nil,
+			func(n *Identity, e *Person) { n.Edges.Person = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -477,6 +570,68 @@ func (_q *IdentityQuery) loadEvents(ctx context.Context, query *EventQuery, node
 	}
 	return nil
 }
+func (_q *IdentityQuery) loadProfiles(ctx context.Context, query *ProfileQuery, nodes []*Identity, init func(*Identity), assign func(*Identity, *Profile)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Identity)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(profile.FieldIdentityID)
+	}
+	query.Where(predicate.Profile(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(identity.ProfilesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.IdentityID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "identity_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *IdentityQuery) loadPerson(ctx context.Context, query *PersonQuery, nodes []*Identity, init func(*Identity), assign func(*Identity, *Person)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Identity)
+	for i := range nodes {
+		if nodes[i].PersonID == nil {
+			continue
+		}
+		fk := *nodes[i].PersonID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(person.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "person_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *IdentityQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -508,6 +663,9 @@ func (_q *IdentityQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withPerson != nil {
+			_spec.Node.AddColumnOnce(identity.FieldPersonID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
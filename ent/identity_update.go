@@ -15,7 +15,9 @@ import (
 	"github.com/luoling8192/mindwave/ent/event"
 	"github.com/luoling8192/mindwave/ent/identity"
 	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/person"
 	"github.com/luoling8192/mindwave/ent/predicate"
+	"github.com/luoling8192/mindwave/ent/profile"
 )
 
 // IdentityUpdate is the builder for updating Identity entities.
@@ -31,6 +33,20 @@ func (_u *IdentityUpdate) Where(ps ...predicate.Identity) *IdentityUpdate {
 	return _u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *IdentityUpdate) SetWorkspaceID(v uuid.UUID) *IdentityUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *IdentityUpdate) SetNillableWorkspaceID(v *uuid.UUID) *IdentityUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
 // SetPlatform sets the "platform" field.
 func (_u *IdentityUpdate) SetPlatform(v string) *IdentityUpdate {
 	_u.mutation.SetPlatform(v)
@@ -119,6 +135,61 @@ func (_u *IdentityUpdate) ClearAltIds() *IdentityUpdate {
 	return _u
 }
 
+// SetPersonID sets the "person_id" field.
+func (_u *IdentityUpdate) SetPersonID(v uuid.UUID) *IdentityUpdate {
+	_u.mutation.SetPersonID(v)
+	return _u
+}
+
+// SetNillablePersonID sets the "person_id" field if the given value is not nil.
+func (_u *IdentityUpdate) SetNillablePersonID(v *uuid.UUID) *IdentityUpdate {
+	if v != nil {
+		_u.SetPersonID(*v)
+	}
+	return _u
+}
+
+// ClearPersonID clears the value of the "person_id" field.
+func (_u *IdentityUpdate) ClearPersonID() *IdentityUpdate {
+	_u.mutation.ClearPersonID()
+	return _u
+}
+
+// SetOptedOut sets the "opted_out" field.
+func (_u *IdentityUpdate) SetOptedOut(v bool) *IdentityUpdate {
+	_u.mutation.SetOptedOut(v)
+	return _u
+}
+
+// SetNillableOptedOut sets the "opted_out" field if the given value is not nil.
+func (_u *IdentityUpdate) SetNillableOptedOut(v *bool) *IdentityUpdate {
+	if v != nil {
+		_u.SetOptedOut(*v)
+	}
+	return _u
+}
+
+// SetForgottenAt sets the "forgotten_at" field.
+func (_u *IdentityUpdate) SetForgottenAt(v int64) *IdentityUpdate {
+	_u.mutation.ResetForgottenAt()
+	_u.mutation.SetForgottenAt(v)
+	return _u
+}
+
+// SetNillableForgottenAt sets the "forgotten_at" field if the given value is not nil.
+func (_u *IdentityUpdate) SetNillableForgottenAt(v *int64) *IdentityUpdate {
+	if v != nil {
+		_u.SetForgottenAt(*v)
+	}
+	return _u
+}
+
+// AddForgottenAt adds value to the "forgotten_at" field.
+func (_u *IdentityUpdate) AddForgottenAt(v int64) *IdentityUpdate {
+	_u.mutation.AddForgottenAt(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *IdentityUpdate) SetCreatedAt(v int64) *IdentityUpdate {
 	_u.mutation.ResetCreatedAt()
@@ -168,6 +239,26 @@ func (_u *IdentityUpdate) AddEvents(v ...*Event) *IdentityUpdate {
 	return _u.AddEventIDs(ids...)
 }
 
+// AddProfileIDs adds the "profiles" edge to the Profile entity by IDs.
+func (_u *IdentityUpdate) AddProfileIDs(ids ...uuid.UUID) *IdentityUpdate {
+	_u.mutation.AddProfileIDs(ids...)
+	return _u
+}
+
+// AddProfiles adds the "profiles" edges to the Profile entity.
+func (_u *IdentityUpdate) AddProfiles(v ...*Profile) *IdentityUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddProfileIDs(ids...)
+}
+
+// SetPerson sets the "person" edge to the Person entity.
+func (_u *IdentityUpdate) SetPerson(v *Person) *IdentityUpdate {
+	return _u.SetPersonID(v.ID)
+}
+
 // Mutation returns the IdentityMutation object of the builder.
 func (_u *IdentityUpdate) Mutation() *IdentityMutation {
 	return _u.mutation
@@ -194,6 +285,33 @@ func (_u *IdentityUpdate) RemoveEvents(v ...*Event) *IdentityUpdate {
 	return _u.RemoveEventIDs(ids...)
 }
 
+// ClearProfiles clears all "profiles" edges to the Profile entity.
+func (_u *IdentityUpdate) ClearProfiles() *IdentityUpdate {
+	_u.mutation.ClearProfiles()
+	return _u
+}
+
+// RemoveProfileIDs removes the "profiles" edge to Profile entities by IDs.
+func (_u *IdentityUpdate) RemoveProfileIDs(ids ...uuid.UUID) *IdentityUpdate {
+	_u.mutation.RemoveProfileIDs(ids...)
+	return _u
+}
+
+// RemoveProfiles removes "profiles" edges to Profile entities.
+func (_u *IdentityUpdate) RemoveProfiles(v ...*Profile) *IdentityUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProfileIDs(ids...)
+}
+
+// ClearPerson clears the "person" edge to the Person entity.
+func (_u *IdentityUpdate) ClearPerson() *IdentityUpdate {
+	_u.mutation.ClearPerson()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *IdentityUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -239,6 +357,9 @@ func (_u *IdentityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(identity.FieldWorkspaceID, field.TypeUUID, value)
+	}
 	if value, ok := _u.mutation.Platform(); ok {
 		_spec.SetField(identity.FieldPlatform, field.TypeString, value)
 	}
@@ -265,6 +386,15 @@ func (_u *IdentityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AltIdsCleared() {
 		_spec.ClearField(identity.FieldAltIds, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.OptedOut(); ok {
+		_spec.SetField(identity.FieldOptedOut, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ForgottenAt(); ok {
+		_spec.SetField(identity.FieldForgottenAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedForgottenAt(); ok {
+		_spec.AddField(identity.FieldForgottenAt, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(identity.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -325,6 +455,85 @@ func (_u *IdentityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   identity.ProfilesTable,
+			Columns: []string{identity.ProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Profile
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedProfilesIDs(); len(nodes) > 0 && !_u.mutation.ProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   identity.ProfilesTable,
+			Columns: []string{identity.ProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Profile
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   identity.ProfilesTable,
+			Columns: []string{identity.ProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Profile
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PersonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   identity.PersonTable,
+			Columns: []string{identity.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Identity
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PersonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   identity.PersonTable,
+			Columns: []string{identity.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Identity
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = _u.schemaConfig.Identity
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
@@ -347,6 +556,20 @@ type IdentityUpdateOne struct {
 	mutation *IdentityMutation
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *IdentityUpdateOne) SetWorkspaceID(v uuid.UUID) *IdentityUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *IdentityUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *IdentityUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
 // SetPlatform sets the "platform" field.
 func (_u *IdentityUpdateOne) SetPlatform(v string) *IdentityUpdateOne {
 	_u.mutation.SetPlatform(v)
@@ -435,6 +658,61 @@ func (_u *IdentityUpdateOne) ClearAltIds() *IdentityUpdateOne {
 	return _u
 }
 
+// SetPersonID sets the "person_id" field.
+func (_u *IdentityUpdateOne) SetPersonID(v uuid.UUID) *IdentityUpdateOne {
+	_u.mutation.SetPersonID(v)
+	return _u
+}
+
+// SetNillablePersonID sets the "person_id" field if the given value is not nil.
+func (_u *IdentityUpdateOne) SetNillablePersonID(v *uuid.UUID) *IdentityUpdateOne {
+	if v != nil {
+		_u.SetPersonID(*v)
+	}
+	return _u
+}
+
+// ClearPersonID clears the value of the "person_id" field.
+func (_u *IdentityUpdateOne) ClearPersonID() *IdentityUpdateOne {
+	_u.mutation.ClearPersonID()
+	return _u
+}
+
+// SetOptedOut sets the "opted_out" field.
+func (_u *IdentityUpdateOne) SetOptedOut(v bool) *IdentityUpdateOne {
+	_u.mutation.SetOptedOut(v)
+	return _u
+}
+
+// SetNillableOptedOut sets the "opted_out" field if the given value is not nil.
+func (_u *IdentityUpdateOne) SetNillableOptedOut(v *bool) *IdentityUpdateOne {
+	if v != nil {
+		_u.SetOptedOut(*v)
+	}
+	return _u
+}
+
+// SetForgottenAt sets the "forgotten_at" field.
+func (_u *IdentityUpdateOne) SetForgottenAt(v int64) *IdentityUpdateOne {
+	_u.mutation.ResetForgottenAt()
+	_u.mutation.SetForgottenAt(v)
+	return _u
+}
+
+// SetNillableForgottenAt sets the "forgotten_at" field if the given value is not nil.
+func (_u *IdentityUpdateOne) SetNillableForgottenAt(v *int64) *IdentityUpdateOne {
+	if v != nil {
+		_u.SetForgottenAt(*v)
+	}
+	return _u
+}
+
+// AddForgottenAt adds value to the "forgotten_at" field.
+func (_u *IdentityUpdateOne) AddForgottenAt(v int64) *IdentityUpdateOne {
+	_u.mutation.AddForgottenAt(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *IdentityUpdateOne) SetCreatedAt(v int64) *IdentityUpdateOne {
 	_u.mutation.ResetCreatedAt()
@@ -484,6 +762,26 @@ func (_u *IdentityUpdateOne) AddEvents(v ...*Event) *IdentityUpdateOne {
 	return _u.AddEventIDs(ids...)
 }
 
+// AddProfileIDs adds the "profiles" edge to the Profile entity by IDs.
+func (_u *IdentityUpdateOne) AddProfileIDs(ids ...uuid.UUID) *IdentityUpdateOne {
+	_u.mutation.AddProfileIDs(ids...)
+	return _u
+}
+
+// AddProfiles adds the "profiles" edges to the Profile entity.
+func (_u *IdentityUpdateOne) AddProfiles(v ...*Profile) *IdentityUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddProfileIDs(ids...)
+}
+
+// SetPerson sets the "person" edge to the Person entity.
+func (_u *IdentityUpdateOne) SetPerson(v *Person) *IdentityUpdateOne {
+	return _u.SetPersonID(v.ID)
+}
+
 // Mutation returns the IdentityMutation object of the builder.
 func (_u *IdentityUpdateOne) Mutation() *IdentityMutation {
 	return _u.mutation
@@ -510,6 +808,33 @@ func (_u *IdentityUpdateOne) RemoveEvents(v ...*Event) *IdentityUpdateOne {
 	return _u.RemoveEventIDs(ids...)
 }
 
+// ClearProfiles clears all "profiles" edges to the Profile entity.
+func (_u *IdentityUpdateOne) ClearProfiles() *IdentityUpdateOne {
+	_u.mutation.ClearProfiles()
+	return _u
+}
+
+// RemoveProfileIDs removes the "profiles" edge to Profile entities by IDs.
+func (_u *IdentityUpdateOne) RemoveProfileIDs(ids ...uuid.UUID) *IdentityUpdateOne {
+	_u.mutation.RemoveProfileIDs(ids...)
+	return _u
+}
+
+// RemoveProfiles removes "profiles" edges to Profile entities.
+func (_u *IdentityUpdateOne) RemoveProfiles(v ...*Profile) *IdentityUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProfileIDs(ids...)
+}
+
+// ClearPerson clears the "person" edge to the Person entity.
+func (_u *IdentityUpdateOne) ClearPerson() *IdentityUpdateOne {
+	_u.mutation.ClearPerson()
+	return _u
+}
+
 // Where appends a list predicates to the IdentityUpdate builder.
 func (_u *IdentityUpdateOne) Where(ps ...predicate.Identity) *IdentityUpdateOne {
 	_u.mutation.Where(ps...)
@@ -585,6 +910,9 @@ func (_u *IdentityUpdateOne) sqlSave(ctx context.Context) (_node *Identity, err
 			}
 		}
 	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(identity.FieldWorkspaceID, field.TypeUUID, value)
+	}
 	if value, ok := _u.mutation.Platform(); ok {
 		_spec.SetField(identity.FieldPlatform, field.TypeString, value)
 	}
@@ -611,6 +939,15 @@ func (_u *IdentityUpdateOne) sqlSave(ctx context.Context) (_node *Identity, err
 	if _u.mutation.AltIdsCleared() {
 		_spec.ClearField(identity.FieldAltIds, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.OptedOut(); ok {
+		_spec.SetField(identity.FieldOptedOut, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ForgottenAt(); ok {
+		_spec.SetField(identity.FieldForgottenAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedForgottenAt(); ok {
+		_spec.AddField(identity.FieldForgottenAt, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(identity.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -671,6 +1008,85 @@ func (_u *IdentityUpdateOne) sqlSave(ctx context.Context) (_node *Identity, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   identity.ProfilesTable,
+			Columns: []string{identity.ProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Profile
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedProfilesIDs(); len(nodes) > 0 && !_u.mutation.ProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   identity.ProfilesTable,
+			Columns: []string{identity.ProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Profile
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   identity.ProfilesTable,
+			Columns: []string{identity.ProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Profile
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PersonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   identity.PersonTable,
+			Columns: []string{identity.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Identity
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PersonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   identity.PersonTable,
+			Columns: []string{identity.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _u.schemaConfig.Identity
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = _u.schemaConfig.Identity
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_node = &Identity{config: _u.config}
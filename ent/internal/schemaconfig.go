@@ -7,11 +7,27 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	ChatMessage    string // ChatMessage table.
-	Event          string // Event table.
-	Identity       string // Identity table.
-	IdentityEvents string // Identity-events->Event table.
-	JoinedChat     string // JoinedChat table.
+	APIKey           string // APIKey table.
+	AccessLog        string // AccessLog table.
+	AskTurn          string // AskTurn table.
+	ChatGrant        string // ChatGrant table.
+	ChatMessage      string // ChatMessage table.
+	ChatRetention    string // ChatRetention table.
+	ChatSchedule     string // ChatSchedule table.
+	DigestDelivery   string // DigestDelivery table.
+	DistillRun       string // DistillRun table.
+	Event            string // Event table.
+	Identity         string // Identity table.
+	IdentityEvents   string // Identity-events->Event table.
+	Job              string // Job table.
+	JoinedChat       string // JoinedChat table.
+	LLMCacheEntry    string // LLMCacheEntry table.
+	OwnerAccountLink string // OwnerAccountLink table.
+	Person           string // Person table.
+	PersonAuditLog   string // PersonAuditLog table.
+	Profile          string // Profile table.
+	Summary          string // Summary table.
+	Workspace        string // Workspace table.
 }
 
 type schemaCtxKey struct{}
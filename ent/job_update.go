@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/job"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// JobUpdate is the builder for updating Job entities.
+type JobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (_u *JobUpdate) Where(ps ...predicate.Job) *JobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *JobUpdate) SetWorkspaceID(v uuid.UUID) *JobUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableWorkspaceID(v *uuid.UUID) *JobUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *JobUpdate) SetStatus(v job.Status) *JobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableStatus(v *job.Status) *JobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *JobUpdate) SetAttempts(v int) *JobUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableAttempts(v *int) *JobUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *JobUpdate) AddAttempts(v int) *JobUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *JobUpdate) SetMaxAttempts(v int) *JobUpdate {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableMaxAttempts(v *int) *JobUpdate {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *JobUpdate) AddMaxAttempts(v int) *JobUpdate {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetRunAt sets the "run_at" field.
+func (_u *JobUpdate) SetRunAt(v int64) *JobUpdate {
+	_u.mutation.ResetRunAt()
+	_u.mutation.SetRunAt(v)
+	return _u
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableRunAt(v *int64) *JobUpdate {
+	if v != nil {
+		_u.SetRunAt(*v)
+	}
+	return _u
+}
+
+// AddRunAt adds value to the "run_at" field.
+func (_u *JobUpdate) AddRunAt(v int64) *JobUpdate {
+	_u.mutation.AddRunAt(v)
+	return _u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_u *JobUpdate) SetLockedBy(v string) *JobUpdate {
+	_u.mutation.SetLockedBy(v)
+	return _u
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableLockedBy(v *string) *JobUpdate {
+	if v != nil {
+		_u.SetLockedBy(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *JobUpdate) SetLockedUntil(v int64) *JobUpdate {
+	_u.mutation.ResetLockedUntil()
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableLockedUntil(v *int64) *JobUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// AddLockedUntil adds value to the "locked_until" field.
+func (_u *JobUpdate) AddLockedUntil(v int64) *JobUpdate {
+	_u.mutation.AddLockedUntil(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *JobUpdate) SetLastError(v string) *JobUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableLastError(v *string) *JobUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *JobUpdate) SetFinishedAt(v int64) *JobUpdate {
+	_u.mutation.ResetFinishedAt()
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableFinishedAt(v *int64) *JobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// AddFinishedAt adds value to the "finished_at" field.
+func (_u *JobUpdate) AddFinishedAt(v int64) *JobUpdate {
+	_u.mutation.AddFinishedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *JobUpdate) SetUpdatedAt(v int64) *JobUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *JobUpdate) AddUpdatedAt(v int64) *JobUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (_u *JobUpdate) Mutation() *JobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *JobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := job.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *JobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(job.Table, job.Columns, sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(job.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(job.FieldKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RunAt(); ok {
+		_spec.SetField(job.FieldRunAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRunAt(); ok {
+		_spec.AddField(job.FieldRunAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LockedBy(); ok {
+		_spec.SetField(job.FieldLockedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(job.FieldLockedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLockedUntil(); ok {
+		_spec.AddField(job.FieldLockedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(job.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(job.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(job.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(job.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(job.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Job
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JobUpdateOne is the builder for updating a single Job entity.
+type JobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *JobUpdateOne) SetWorkspaceID(v uuid.UUID) *JobUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *JobUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *JobUpdateOne) SetStatus(v job.Status) *JobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableStatus(v *job.Status) *JobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *JobUpdateOne) SetAttempts(v int) *JobUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableAttempts(v *int) *JobUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *JobUpdateOne) AddAttempts(v int) *JobUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *JobUpdateOne) SetMaxAttempts(v int) *JobUpdateOne {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableMaxAttempts(v *int) *JobUpdateOne {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *JobUpdateOne) AddMaxAttempts(v int) *JobUpdateOne {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetRunAt sets the "run_at" field.
+func (_u *JobUpdateOne) SetRunAt(v int64) *JobUpdateOne {
+	_u.mutation.ResetRunAt()
+	_u.mutation.SetRunAt(v)
+	return _u
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableRunAt(v *int64) *JobUpdateOne {
+	if v != nil {
+		_u.SetRunAt(*v)
+	}
+	return _u
+}
+
+// AddRunAt adds value to the "run_at" field.
+func (_u *JobUpdateOne) AddRunAt(v int64) *JobUpdateOne {
+	_u.mutation.AddRunAt(v)
+	return _u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_u *JobUpdateOne) SetLockedBy(v string) *JobUpdateOne {
+	_u.mutation.SetLockedBy(v)
+	return _u
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableLockedBy(v *string) *JobUpdateOne {
+	if v != nil {
+		_u.SetLockedBy(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *JobUpdateOne) SetLockedUntil(v int64) *JobUpdateOne {
+	_u.mutation.ResetLockedUntil()
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableLockedUntil(v *int64) *JobUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// AddLockedUntil adds value to the "locked_until" field.
+func (_u *JobUpdateOne) AddLockedUntil(v int64) *JobUpdateOne {
+	_u.mutation.AddLockedUntil(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *JobUpdateOne) SetLastError(v string) *JobUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableLastError(v *string) *JobUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *JobUpdateOne) SetFinishedAt(v int64) *JobUpdateOne {
+	_u.mutation.ResetFinishedAt()
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableFinishedAt(v *int64) *JobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// AddFinishedAt adds value to the "finished_at" field.
+func (_u *JobUpdateOne) AddFinishedAt(v int64) *JobUpdateOne {
+	_u.mutation.AddFinishedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *JobUpdateOne) SetUpdatedAt(v int64) *JobUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *JobUpdateOne) AddUpdatedAt(v int64) *JobUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (_u *JobUpdateOne) Mutation() *JobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (_u *JobUpdateOne) Where(ps ...predicate.Job) *JobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JobUpdateOne) Select(field string, fields ...string) *JobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Job entity.
+func (_u *JobUpdateOne) Save(ctx context.Context) (*Job, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobUpdateOne) SaveX(ctx context.Context) *Job {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *JobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := job.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(job.Table, job.Columns, sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Job.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, job.FieldID)
+		for _, f := range fields {
+			if !job.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != job.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(job.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if _u.mutation.KeyCleared() {
+		_spec.ClearField(job.FieldKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RunAt(); ok {
+		_spec.SetField(job.FieldRunAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRunAt(); ok {
+		_spec.AddField(job.FieldRunAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LockedBy(); ok {
+		_spec.SetField(job.FieldLockedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(job.FieldLockedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLockedUntil(); ok {
+		_spec.AddField(job.FieldLockedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(job.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(job.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(job.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(job.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(job.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Job
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &Job{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
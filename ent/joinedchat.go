@@ -17,6 +17,8 @@ type JoinedChat struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 	// Platform holds the value of the "platform" field.
 	Platform string `json:"platform,omitempty"`
 	// ChatID holds the value of the "chat_id" field.
@@ -43,7 +45,7 @@ func (*JoinedChat) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case joinedchat.FieldPlatform, joinedchat.FieldChatID, joinedchat.FieldChatName, joinedchat.FieldChatType:
 			values[i] = new(sql.NullString)
-		case joinedchat.FieldID:
+		case joinedchat.FieldID, joinedchat.FieldWorkspaceID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -66,6 +68,12 @@ func (_m *JoinedChat) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case joinedchat.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
 		case joinedchat.FieldPlatform:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field platform", values[i])
@@ -144,6 +152,9 @@ func (_m *JoinedChat) String() string {
 	var builder strings.Builder
 	builder.WriteString("JoinedChat(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
 	builder.WriteString("platform=")
 	builder.WriteString(_m.Platform)
 	builder.WriteString(", ")
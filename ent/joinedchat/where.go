@@ -53,6 +53,11 @@ func IDLTE(id uuid.UUID) predicate.JoinedChat {
 	return predicate.JoinedChat(sql.FieldLTE(FieldID, id))
 }
 
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.JoinedChat {
+	return predicate.JoinedChat(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
 // Platform applies equality check predicate on the "platform" field. It's identical to PlatformEQ.
 func Platform(v string) predicate.JoinedChat {
 	return predicate.JoinedChat(sql.FieldEQ(FieldPlatform, v))
@@ -88,6 +93,46 @@ func UpdatedAt(v int64) predicate.JoinedChat {
 	return predicate.JoinedChat(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.JoinedChat {
+	return predicate.JoinedChat(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.JoinedChat {
+	return predicate.JoinedChat(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.JoinedChat {
+	return predicate.JoinedChat(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.JoinedChat {
+	return predicate.JoinedChat(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.JoinedChat {
+	return predicate.JoinedChat(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.JoinedChat {
+	return predicate.JoinedChat(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.JoinedChat {
+	return predicate.JoinedChat(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.JoinedChat {
+	return predicate.JoinedChat(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
 // PlatformEQ applies the EQ predicate on the "platform" field.
 func PlatformEQ(v string) predicate.JoinedChat {
 	return predicate.JoinedChat(sql.FieldEQ(FieldPlatform, v))
@@ -23,6 +23,20 @@ type JoinedChatCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *JoinedChatCreate) SetWorkspaceID(v uuid.UUID) *JoinedChatCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *JoinedChatCreate) SetNillableWorkspaceID(v *uuid.UUID) *JoinedChatCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
 // SetPlatform sets the "platform" field.
 func (_c *JoinedChatCreate) SetPlatform(v string) *JoinedChatCreate {
 	_c.mutation.SetPlatform(v)
@@ -170,6 +184,10 @@ func (_c *JoinedChatCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *JoinedChatCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := joinedchat.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
 	if _, ok := _c.mutation.Platform(); !ok {
 		v := joinedchat.DefaultPlatform
 		_c.mutation.SetPlatform(v)
@@ -206,6 +224,9 @@ func (_c *JoinedChatCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *JoinedChatCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "JoinedChat.workspace_id"`)}
+	}
 	if _, ok := _c.mutation.Platform(); !ok {
 		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "JoinedChat.platform"`)}
 	}
@@ -284,6 +305,10 @@ func (_c *JoinedChatCreate) createSpec() (*JoinedChat, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(joinedchat.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
 	if value, ok := _c.mutation.Platform(); ok {
 		_spec.SetField(joinedchat.FieldPlatform, field.TypeString, value)
 		_node.Platform = value
@@ -319,7 +344,7 @@ func (_c *JoinedChatCreate) createSpec() (*JoinedChat, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.JoinedChat.Create().
-//		SetPlatform(v).
+//		SetWorkspaceID(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -328,7 +353,7 @@ func (_c *JoinedChatCreate) createSpec() (*JoinedChat, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.JoinedChatUpsert) {
-//			SetPlatform(v+v).
+//			SetWorkspaceID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *JoinedChatCreate) OnConflict(opts ...sql.ConflictOption) *JoinedChatUpsertOne {
@@ -364,6 +389,18 @@ type (
 	}
 )
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *JoinedChatUpsert) SetWorkspaceID(v uuid.UUID) *JoinedChatUpsert {
+	u.Set(joinedchat.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *JoinedChatUpsert) UpdateWorkspaceID() *JoinedChatUpsert {
+	u.SetExcluded(joinedchat.FieldWorkspaceID)
+	return u
+}
+
 // SetPlatform sets the "platform" field.
 func (u *JoinedChatUpsert) SetPlatform(v string) *JoinedChatUpsert {
 	u.Set(joinedchat.FieldPlatform, v)
@@ -514,6 +551,20 @@ func (u *JoinedChatUpsertOne) Update(set func(*JoinedChatUpsert)) *JoinedChatUps
 	return u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *JoinedChatUpsertOne) SetWorkspaceID(v uuid.UUID) *JoinedChatUpsertOne {
+	return u.Update(func(s *JoinedChatUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *JoinedChatUpsertOne) UpdateWorkspaceID() *JoinedChatUpsertOne {
+	return u.Update(func(s *JoinedChatUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
 // SetPlatform sets the "platform" field.
 func (u *JoinedChatUpsertOne) SetPlatform(v string) *JoinedChatUpsertOne {
 	return u.Update(func(s *JoinedChatUpsert) {
@@ -769,7 +820,7 @@ func (_c *JoinedChatCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.JoinedChatUpsert) {
-//			SetPlatform(v+v).
+//			SetWorkspaceID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *JoinedChatCreateBulk) OnConflict(opts ...sql.ConflictOption) *JoinedChatUpsertBulk {
@@ -848,6 +899,20 @@ func (u *JoinedChatUpsertBulk) Update(set func(*JoinedChatUpsert)) *JoinedChatUp
 	return u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *JoinedChatUpsertBulk) SetWorkspaceID(v uuid.UUID) *JoinedChatUpsertBulk {
+	return u.Update(func(s *JoinedChatUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *JoinedChatUpsertBulk) UpdateWorkspaceID() *JoinedChatUpsertBulk {
+	return u.Update(func(s *JoinedChatUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
 // SetPlatform sets the "platform" field.
 func (u *JoinedChatUpsertBulk) SetPlatform(v string) *JoinedChatUpsertBulk {
 	return u.Update(func(s *JoinedChatUpsert) {
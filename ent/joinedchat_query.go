@@ -266,12 +266,12 @@ func (_q *JoinedChatQuery) Clone() *JoinedChatQuery {
 // Example:
 //
 //	var v []struct {
-//		Platform string `json:"platform,omitempty"`
+//		WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.JoinedChat.Query().
-//		GroupBy(joinedchat.FieldPlatform).
+//		GroupBy(joinedchat.FieldWorkspaceID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *JoinedChatQuery) GroupBy(field string, fields ...string) *JoinedChatGroupBy {
@@ -289,11 +289,11 @@ func (_q *JoinedChatQuery) GroupBy(field string, fields ...string) *JoinedChatGr
 // Example:
 //
 //	var v []struct {
-//		Platform string `json:"platform,omitempty"`
+//		WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 //	}
 //
 //	client.JoinedChat.Query().
-//		Select(joinedchat.FieldPlatform).
+//		Select(joinedchat.FieldWorkspaceID).
 //		Scan(ctx, &v)
 func (_q *JoinedChatQuery) Select(fields ...string) *JoinedChatSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/luoling8192/mindwave/ent/internal"
 	"github.com/luoling8192/mindwave/ent/joinedchat"
 	"github.com/luoling8192/mindwave/ent/predicate"
@@ -28,6 +29,20 @@ func (_u *JoinedChatUpdate) Where(ps ...predicate.JoinedChat) *JoinedChatUpdate
 	return _u
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *JoinedChatUpdate) SetWorkspaceID(v uuid.UUID) *JoinedChatUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *JoinedChatUpdate) SetNillableWorkspaceID(v *uuid.UUID) *JoinedChatUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
 // SetPlatform sets the "platform" field.
 func (_u *JoinedChatUpdate) SetPlatform(v string) *JoinedChatUpdate {
 	_u.mutation.SetPlatform(v)
@@ -217,6 +232,9 @@ func (_u *JoinedChatUpdate) sqlSave(ctx context.Context) (_node int, err error)
 			}
 		}
 	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(joinedchat.FieldWorkspaceID, field.TypeUUID, value)
+	}
 	if value, ok := _u.mutation.Platform(); ok {
 		_spec.SetField(joinedchat.FieldPlatform, field.TypeString, value)
 	}
@@ -269,6 +287,20 @@ type JoinedChatUpdateOne struct {
 	mutation *JoinedChatMutation
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *JoinedChatUpdateOne) SetWorkspaceID(v uuid.UUID) *JoinedChatUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *JoinedChatUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *JoinedChatUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
 // SetPlatform sets the "platform" field.
 func (_u *JoinedChatUpdateOne) SetPlatform(v string) *JoinedChatUpdateOne {
 	_u.mutation.SetPlatform(v)
@@ -488,6 +520,9 @@ func (_u *JoinedChatUpdateOne) sqlSave(ctx context.Context) (_node *JoinedChat,
 			}
 		}
 	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(joinedchat.FieldWorkspaceID, field.TypeUUID, value)
+	}
 	if value, ok := _u.mutation.Platform(); ok {
 		_spec.SetField(joinedchat.FieldPlatform, field.TypeString, value)
 	}
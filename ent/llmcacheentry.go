@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/llmcacheentry"
+)
+
+// LLMCacheEntry is the model entity for the LLMCacheEntry schema.
+type LLMCacheEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Stage holds the value of the "stage" field.
+	Stage string `json:"stage,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// PromptVersion holds the value of the "prompt_version" field.
+	PromptVersion string `json:"prompt_version,omitempty"`
+	// InputHash holds the value of the "input_hash" field.
+	InputHash string `json:"input_hash,omitempty"`
+	// Value holds the value of the "value" field.
+	Value []byte `json:"value,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LLMCacheEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case llmcacheentry.FieldValue:
+			values[i] = new([]byte)
+		case llmcacheentry.FieldExpiresAt, llmcacheentry.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case llmcacheentry.FieldKey, llmcacheentry.FieldStage, llmcacheentry.FieldModel, llmcacheentry.FieldPromptVersion, llmcacheentry.FieldInputHash:
+			values[i] = new(sql.NullString)
+		case llmcacheentry.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LLMCacheEntry fields.
+func (_m *LLMCacheEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case llmcacheentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case llmcacheentry.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case llmcacheentry.FieldStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stage", values[i])
+			} else if value.Valid {
+				_m.Stage = value.String
+			}
+		case llmcacheentry.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case llmcacheentry.FieldPromptVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_version", values[i])
+			} else if value.Valid {
+				_m.PromptVersion = value.String
+			}
+		case llmcacheentry.FieldInputHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field input_hash", values[i])
+			} else if value.Valid {
+				_m.InputHash = value.String
+			}
+		case llmcacheentry.FieldValue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value != nil {
+				_m.Value = *value
+			}
+		case llmcacheentry.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Int64
+			}
+		case llmcacheentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the LLMCacheEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *LLMCacheEntry) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LLMCacheEntry.
+// Note that you need to call LLMCacheEntry.Unwrap() before calling this method if this LLMCacheEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LLMCacheEntry) Update() *LLMCacheEntryUpdateOne {
+	return NewLLMCacheEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LLMCacheEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LLMCacheEntry) Unwrap() *LLMCacheEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LLMCacheEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LLMCacheEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("LLMCacheEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("stage=")
+	builder.WriteString(_m.Stage)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("prompt_version=")
+	builder.WriteString(_m.PromptVersion)
+	builder.WriteString(", ")
+	builder.WriteString("input_hash=")
+	builder.WriteString(_m.InputHash)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Value))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpiresAt))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LLMCacheEntries is a parsable slice of LLMCacheEntry.
+type LLMCacheEntries []*LLMCacheEntry
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package llmcacheentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the llmcacheentry type in the database.
+	Label = "llm_cache_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldStage holds the string denoting the stage field in the database.
+	FieldStage = "stage"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldPromptVersion holds the string denoting the prompt_version field in the database.
+	FieldPromptVersion = "prompt_version"
+	// FieldInputHash holds the string denoting the input_hash field in the database.
+	FieldInputHash = "input_hash"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the llmcacheentry in the database.
+	Table = "llm_cache_entries"
+)
+
+// Columns holds all SQL columns for llmcacheentry fields.
+var Columns = []string{
+	FieldID,
+	FieldKey,
+	FieldStage,
+	FieldModel,
+	FieldPromptVersion,
+	FieldInputHash,
+	FieldValue,
+	FieldExpiresAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// DefaultStage holds the default value on creation for the "stage" field.
+	DefaultStage string
+	// DefaultModel holds the default value on creation for the "model" field.
+	DefaultModel string
+	// DefaultPromptVersion holds the default value on creation for the "prompt_version" field.
+	DefaultPromptVersion string
+	// DefaultInputHash holds the default value on creation for the "input_hash" field.
+	DefaultInputHash string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the LLMCacheEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByStage orders the results by the stage field.
+func ByStage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStage, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByPromptVersion orders the results by the prompt_version field.
+func ByPromptVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptVersion, opts...).ToFunc()
+}
+
+// ByInputHash orders the results by the input_hash field.
+func ByInputHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputHash, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package llmcacheentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldKey, v))
+}
+
+// Stage applies equality check predicate on the "stage" field. It's identical to StageEQ.
+func Stage(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldStage, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldModel, v))
+}
+
+// PromptVersion applies equality check predicate on the "prompt_version" field. It's identical to PromptVersionEQ.
+func PromptVersion(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldPromptVersion, v))
+}
+
+// InputHash applies equality check predicate on the "input_hash" field. It's identical to InputHashEQ.
+func InputHash(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldInputHash, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v []byte) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldValue, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldContainsFold(FieldKey, v))
+}
+
+// StageEQ applies the EQ predicate on the "stage" field.
+func StageEQ(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldStage, v))
+}
+
+// StageNEQ applies the NEQ predicate on the "stage" field.
+func StageNEQ(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNEQ(FieldStage, v))
+}
+
+// StageIn applies the In predicate on the "stage" field.
+func StageIn(vs ...string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldIn(FieldStage, vs...))
+}
+
+// StageNotIn applies the NotIn predicate on the "stage" field.
+func StageNotIn(vs ...string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNotIn(FieldStage, vs...))
+}
+
+// StageGT applies the GT predicate on the "stage" field.
+func StageGT(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGT(FieldStage, v))
+}
+
+// StageGTE applies the GTE predicate on the "stage" field.
+func StageGTE(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGTE(FieldStage, v))
+}
+
+// StageLT applies the LT predicate on the "stage" field.
+func StageLT(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLT(FieldStage, v))
+}
+
+// StageLTE applies the LTE predicate on the "stage" field.
+func StageLTE(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLTE(FieldStage, v))
+}
+
+// StageContains applies the Contains predicate on the "stage" field.
+func StageContains(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldContains(FieldStage, v))
+}
+
+// StageHasPrefix applies the HasPrefix predicate on the "stage" field.
+func StageHasPrefix(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldHasPrefix(FieldStage, v))
+}
+
+// StageHasSuffix applies the HasSuffix predicate on the "stage" field.
+func StageHasSuffix(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldHasSuffix(FieldStage, v))
+}
+
+// StageEqualFold applies the EqualFold predicate on the "stage" field.
+func StageEqualFold(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEqualFold(FieldStage, v))
+}
+
+// StageContainsFold applies the ContainsFold predicate on the "stage" field.
+func StageContainsFold(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldContainsFold(FieldStage, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldContainsFold(FieldModel, v))
+}
+
+// PromptVersionEQ applies the EQ predicate on the "prompt_version" field.
+func PromptVersionEQ(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldPromptVersion, v))
+}
+
+// PromptVersionNEQ applies the NEQ predicate on the "prompt_version" field.
+func PromptVersionNEQ(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNEQ(FieldPromptVersion, v))
+}
+
+// PromptVersionIn applies the In predicate on the "prompt_version" field.
+func PromptVersionIn(vs ...string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldIn(FieldPromptVersion, vs...))
+}
+
+// PromptVersionNotIn applies the NotIn predicate on the "prompt_version" field.
+func PromptVersionNotIn(vs ...string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNotIn(FieldPromptVersion, vs...))
+}
+
+// PromptVersionGT applies the GT predicate on the "prompt_version" field.
+func PromptVersionGT(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGT(FieldPromptVersion, v))
+}
+
+// PromptVersionGTE applies the GTE predicate on the "prompt_version" field.
+func PromptVersionGTE(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGTE(FieldPromptVersion, v))
+}
+
+// PromptVersionLT applies the LT predicate on the "prompt_version" field.
+func PromptVersionLT(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLT(FieldPromptVersion, v))
+}
+
+// PromptVersionLTE applies the LTE predicate on the "prompt_version" field.
+func PromptVersionLTE(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLTE(FieldPromptVersion, v))
+}
+
+// PromptVersionContains applies the Contains predicate on the "prompt_version" field.
+func PromptVersionContains(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldContains(FieldPromptVersion, v))
+}
+
+// PromptVersionHasPrefix applies the HasPrefix predicate on the "prompt_version" field.
+func PromptVersionHasPrefix(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldHasPrefix(FieldPromptVersion, v))
+}
+
+// PromptVersionHasSuffix applies the HasSuffix predicate on the "prompt_version" field.
+func PromptVersionHasSuffix(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldHasSuffix(FieldPromptVersion, v))
+}
+
+// PromptVersionEqualFold applies the EqualFold predicate on the "prompt_version" field.
+func PromptVersionEqualFold(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEqualFold(FieldPromptVersion, v))
+}
+
+// PromptVersionContainsFold applies the ContainsFold predicate on the "prompt_version" field.
+func PromptVersionContainsFold(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldContainsFold(FieldPromptVersion, v))
+}
+
+// InputHashEQ applies the EQ predicate on the "input_hash" field.
+func InputHashEQ(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldInputHash, v))
+}
+
+// InputHashNEQ applies the NEQ predicate on the "input_hash" field.
+func InputHashNEQ(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNEQ(FieldInputHash, v))
+}
+
+// InputHashIn applies the In predicate on the "input_hash" field.
+func InputHashIn(vs ...string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldIn(FieldInputHash, vs...))
+}
+
+// InputHashNotIn applies the NotIn predicate on the "input_hash" field.
+func InputHashNotIn(vs ...string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNotIn(FieldInputHash, vs...))
+}
+
+// InputHashGT applies the GT predicate on the "input_hash" field.
+func InputHashGT(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGT(FieldInputHash, v))
+}
+
+// InputHashGTE applies the GTE predicate on the "input_hash" field.
+func InputHashGTE(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGTE(FieldInputHash, v))
+}
+
+// InputHashLT applies the LT predicate on the "input_hash" field.
+func InputHashLT(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLT(FieldInputHash, v))
+}
+
+// InputHashLTE applies the LTE predicate on the "input_hash" field.
+func InputHashLTE(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLTE(FieldInputHash, v))
+}
+
+// InputHashContains applies the Contains predicate on the "input_hash" field.
+func InputHashContains(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldContains(FieldInputHash, v))
+}
+
+// InputHashHasPrefix applies the HasPrefix predicate on the "input_hash" field.
+func InputHashHasPrefix(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldHasPrefix(FieldInputHash, v))
+}
+
+// InputHashHasSuffix applies the HasSuffix predicate on the "input_hash" field.
+func InputHashHasSuffix(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldHasSuffix(FieldInputHash, v))
+}
+
+// InputHashEqualFold applies the EqualFold predicate on the "input_hash" field.
+func InputHashEqualFold(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEqualFold(FieldInputHash, v))
+}
+
+// InputHashContainsFold applies the ContainsFold predicate on the "input_hash" field.
+func InputHashContainsFold(v string) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldContainsFold(FieldInputHash, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v []byte) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v []byte) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...[]byte) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...[]byte) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v []byte) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v []byte) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v []byte) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v []byte) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLTE(FieldValue, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LLMCacheEntry) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LLMCacheEntry) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LLMCacheEntry) predicate.LLMCacheEntry {
+	return predicate.LLMCacheEntry(sql.NotPredicates(p))
+}
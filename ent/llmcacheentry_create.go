@@ -0,0 +1,951 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/llmcacheentry"
+)
+
+// LLMCacheEntryCreate is the builder for creating a LLMCacheEntry entity.
+type LLMCacheEntryCreate struct {
+	config
+	mutation *LLMCacheEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKey sets the "key" field.
+func (_c *LLMCacheEntryCreate) SetKey(v string) *LLMCacheEntryCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetStage sets the "stage" field.
+func (_c *LLMCacheEntryCreate) SetStage(v string) *LLMCacheEntryCreate {
+	_c.mutation.SetStage(v)
+	return _c
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_c *LLMCacheEntryCreate) SetNillableStage(v *string) *LLMCacheEntryCreate {
+	if v != nil {
+		_c.SetStage(*v)
+	}
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *LLMCacheEntryCreate) SetModel(v string) *LLMCacheEntryCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_c *LLMCacheEntryCreate) SetNillableModel(v *string) *LLMCacheEntryCreate {
+	if v != nil {
+		_c.SetModel(*v)
+	}
+	return _c
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_c *LLMCacheEntryCreate) SetPromptVersion(v string) *LLMCacheEntryCreate {
+	_c.mutation.SetPromptVersion(v)
+	return _c
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_c *LLMCacheEntryCreate) SetNillablePromptVersion(v *string) *LLMCacheEntryCreate {
+	if v != nil {
+		_c.SetPromptVersion(*v)
+	}
+	return _c
+}
+
+// SetInputHash sets the "input_hash" field.
+func (_c *LLMCacheEntryCreate) SetInputHash(v string) *LLMCacheEntryCreate {
+	_c.mutation.SetInputHash(v)
+	return _c
+}
+
+// SetNillableInputHash sets the "input_hash" field if the given value is not nil.
+func (_c *LLMCacheEntryCreate) SetNillableInputHash(v *string) *LLMCacheEntryCreate {
+	if v != nil {
+		_c.SetInputHash(*v)
+	}
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *LLMCacheEntryCreate) SetValue(v []byte) *LLMCacheEntryCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *LLMCacheEntryCreate) SetExpiresAt(v int64) *LLMCacheEntryCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LLMCacheEntryCreate) SetCreatedAt(v int64) *LLMCacheEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LLMCacheEntryCreate) SetNillableCreatedAt(v *int64) *LLMCacheEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LLMCacheEntryCreate) SetID(v uuid.UUID) *LLMCacheEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LLMCacheEntryCreate) SetNillableID(v *uuid.UUID) *LLMCacheEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LLMCacheEntryMutation object of the builder.
+func (_c *LLMCacheEntryCreate) Mutation() *LLMCacheEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the LLMCacheEntry in the database.
+func (_c *LLMCacheEntryCreate) Save(ctx context.Context) (*LLMCacheEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LLMCacheEntryCreate) SaveX(ctx context.Context) *LLMCacheEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LLMCacheEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LLMCacheEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LLMCacheEntryCreate) defaults() {
+	if _, ok := _c.mutation.Stage(); !ok {
+		v := llmcacheentry.DefaultStage
+		_c.mutation.SetStage(v)
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		v := llmcacheentry.DefaultModel
+		_c.mutation.SetModel(v)
+	}
+	if _, ok := _c.mutation.PromptVersion(); !ok {
+		v := llmcacheentry.DefaultPromptVersion
+		_c.mutation.SetPromptVersion(v)
+	}
+	if _, ok := _c.mutation.InputHash(); !ok {
+		v := llmcacheentry.DefaultInputHash
+		_c.mutation.SetInputHash(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := llmcacheentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := llmcacheentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LLMCacheEntryCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "LLMCacheEntry.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := llmcacheentry.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "LLMCacheEntry.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Stage(); !ok {
+		return &ValidationError{Name: "stage", err: errors.New(`ent: missing required field "LLMCacheEntry.stage"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "LLMCacheEntry.model"`)}
+	}
+	if _, ok := _c.mutation.PromptVersion(); !ok {
+		return &ValidationError{Name: "prompt_version", err: errors.New(`ent: missing required field "LLMCacheEntry.prompt_version"`)}
+	}
+	if _, ok := _c.mutation.InputHash(); !ok {
+		return &ValidationError{Name: "input_hash", err: errors.New(`ent: missing required field "LLMCacheEntry.input_hash"`)}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "LLMCacheEntry.value"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "LLMCacheEntry.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LLMCacheEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LLMCacheEntryCreate) sqlSave(ctx context.Context) (*LLMCacheEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LLMCacheEntryCreate) createSpec() (*LLMCacheEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LLMCacheEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(llmcacheentry.Table, sqlgraph.NewFieldSpec(llmcacheentry.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.LLMCacheEntry
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(llmcacheentry.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Stage(); ok {
+		_spec.SetField(llmcacheentry.FieldStage, field.TypeString, value)
+		_node.Stage = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(llmcacheentry.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.PromptVersion(); ok {
+		_spec.SetField(llmcacheentry.FieldPromptVersion, field.TypeString, value)
+		_node.PromptVersion = value
+	}
+	if value, ok := _c.mutation.InputHash(); ok {
+		_spec.SetField(llmcacheentry.FieldInputHash, field.TypeString, value)
+		_node.InputHash = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(llmcacheentry.FieldValue, field.TypeBytes, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(llmcacheentry.FieldExpiresAt, field.TypeInt64, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(llmcacheentry.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LLMCacheEntry.Create().
+//		SetKey(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LLMCacheEntryUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LLMCacheEntryCreate) OnConflict(opts ...sql.ConflictOption) *LLMCacheEntryUpsertOne {
+	_c.conflict = opts
+	return &LLMCacheEntryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LLMCacheEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LLMCacheEntryCreate) OnConflictColumns(columns ...string) *LLMCacheEntryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LLMCacheEntryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LLMCacheEntryUpsertOne is the builder for "upsert"-ing
+	//  one LLMCacheEntry node.
+	LLMCacheEntryUpsertOne struct {
+		create *LLMCacheEntryCreate
+	}
+
+	// LLMCacheEntryUpsert is the "OnConflict" setter.
+	LLMCacheEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStage sets the "stage" field.
+func (u *LLMCacheEntryUpsert) SetStage(v string) *LLMCacheEntryUpsert {
+	u.Set(llmcacheentry.FieldStage, v)
+	return u
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsert) UpdateStage() *LLMCacheEntryUpsert {
+	u.SetExcluded(llmcacheentry.FieldStage)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *LLMCacheEntryUpsert) SetModel(v string) *LLMCacheEntryUpsert {
+	u.Set(llmcacheentry.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsert) UpdateModel() *LLMCacheEntryUpsert {
+	u.SetExcluded(llmcacheentry.FieldModel)
+	return u
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (u *LLMCacheEntryUpsert) SetPromptVersion(v string) *LLMCacheEntryUpsert {
+	u.Set(llmcacheentry.FieldPromptVersion, v)
+	return u
+}
+
+// UpdatePromptVersion sets the "prompt_version" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsert) UpdatePromptVersion() *LLMCacheEntryUpsert {
+	u.SetExcluded(llmcacheentry.FieldPromptVersion)
+	return u
+}
+
+// SetInputHash sets the "input_hash" field.
+func (u *LLMCacheEntryUpsert) SetInputHash(v string) *LLMCacheEntryUpsert {
+	u.Set(llmcacheentry.FieldInputHash, v)
+	return u
+}
+
+// UpdateInputHash sets the "input_hash" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsert) UpdateInputHash() *LLMCacheEntryUpsert {
+	u.SetExcluded(llmcacheentry.FieldInputHash)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *LLMCacheEntryUpsert) SetValue(v []byte) *LLMCacheEntryUpsert {
+	u.Set(llmcacheentry.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsert) UpdateValue() *LLMCacheEntryUpsert {
+	u.SetExcluded(llmcacheentry.FieldValue)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LLMCacheEntryUpsert) SetExpiresAt(v int64) *LLMCacheEntryUpsert {
+	u.Set(llmcacheentry.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsert) UpdateExpiresAt() *LLMCacheEntryUpsert {
+	u.SetExcluded(llmcacheentry.FieldExpiresAt)
+	return u
+}
+
+// AddExpiresAt adds v to the "expires_at" field.
+func (u *LLMCacheEntryUpsert) AddExpiresAt(v int64) *LLMCacheEntryUpsert {
+	u.Add(llmcacheentry.FieldExpiresAt, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LLMCacheEntryUpsert) SetCreatedAt(v int64) *LLMCacheEntryUpsert {
+	u.Set(llmcacheentry.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsert) UpdateCreatedAt() *LLMCacheEntryUpsert {
+	u.SetExcluded(llmcacheentry.FieldCreatedAt)
+	return u
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *LLMCacheEntryUpsert) AddCreatedAt(v int64) *LLMCacheEntryUpsert {
+	u.Add(llmcacheentry.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LLMCacheEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(llmcacheentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LLMCacheEntryUpsertOne) UpdateNewValues() *LLMCacheEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(llmcacheentry.FieldID)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(llmcacheentry.FieldKey)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LLMCacheEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LLMCacheEntryUpsertOne) Ignore() *LLMCacheEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LLMCacheEntryUpsertOne) DoNothing() *LLMCacheEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LLMCacheEntryCreate.OnConflict
+// documentation for more info.
+func (u *LLMCacheEntryUpsertOne) Update(set func(*LLMCacheEntryUpsert)) *LLMCacheEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LLMCacheEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStage sets the "stage" field.
+func (u *LLMCacheEntryUpsertOne) SetStage(v string) *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertOne) UpdateStage() *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *LLMCacheEntryUpsertOne) SetModel(v string) *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertOne) UpdateModel() *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (u *LLMCacheEntryUpsertOne) SetPromptVersion(v string) *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetPromptVersion(v)
+	})
+}
+
+// UpdatePromptVersion sets the "prompt_version" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertOne) UpdatePromptVersion() *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdatePromptVersion()
+	})
+}
+
+// SetInputHash sets the "input_hash" field.
+func (u *LLMCacheEntryUpsertOne) SetInputHash(v string) *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetInputHash(v)
+	})
+}
+
+// UpdateInputHash sets the "input_hash" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertOne) UpdateInputHash() *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateInputHash()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *LLMCacheEntryUpsertOne) SetValue(v []byte) *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertOne) UpdateValue() *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LLMCacheEntryUpsertOne) SetExpiresAt(v int64) *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// AddExpiresAt adds v to the "expires_at" field.
+func (u *LLMCacheEntryUpsertOne) AddExpiresAt(v int64) *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.AddExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertOne) UpdateExpiresAt() *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LLMCacheEntryUpsertOne) SetCreatedAt(v int64) *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *LLMCacheEntryUpsertOne) AddCreatedAt(v int64) *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertOne) UpdateCreatedAt() *LLMCacheEntryUpsertOne {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LLMCacheEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LLMCacheEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LLMCacheEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LLMCacheEntryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LLMCacheEntryUpsertOne.ID is not supported by MySQL driver. Use LLMCacheEntryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LLMCacheEntryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LLMCacheEntryCreateBulk is the builder for creating many LLMCacheEntry entities in bulk.
+type LLMCacheEntryCreateBulk struct {
+	config
+	err      error
+	builders []*LLMCacheEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LLMCacheEntry entities in the database.
+func (_c *LLMCacheEntryCreateBulk) Save(ctx context.Context) ([]*LLMCacheEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LLMCacheEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LLMCacheEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LLMCacheEntryCreateBulk) SaveX(ctx context.Context) []*LLMCacheEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LLMCacheEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LLMCacheEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LLMCacheEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LLMCacheEntryUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LLMCacheEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *LLMCacheEntryUpsertBulk {
+	_c.conflict = opts
+	return &LLMCacheEntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LLMCacheEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LLMCacheEntryCreateBulk) OnConflictColumns(columns ...string) *LLMCacheEntryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LLMCacheEntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// LLMCacheEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of LLMCacheEntry nodes.
+type LLMCacheEntryUpsertBulk struct {
+	create *LLMCacheEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LLMCacheEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(llmcacheentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LLMCacheEntryUpsertBulk) UpdateNewValues() *LLMCacheEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(llmcacheentry.FieldID)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(llmcacheentry.FieldKey)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LLMCacheEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LLMCacheEntryUpsertBulk) Ignore() *LLMCacheEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LLMCacheEntryUpsertBulk) DoNothing() *LLMCacheEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LLMCacheEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *LLMCacheEntryUpsertBulk) Update(set func(*LLMCacheEntryUpsert)) *LLMCacheEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LLMCacheEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStage sets the "stage" field.
+func (u *LLMCacheEntryUpsertBulk) SetStage(v string) *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertBulk) UpdateStage() *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *LLMCacheEntryUpsertBulk) SetModel(v string) *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertBulk) UpdateModel() *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (u *LLMCacheEntryUpsertBulk) SetPromptVersion(v string) *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetPromptVersion(v)
+	})
+}
+
+// UpdatePromptVersion sets the "prompt_version" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertBulk) UpdatePromptVersion() *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdatePromptVersion()
+	})
+}
+
+// SetInputHash sets the "input_hash" field.
+func (u *LLMCacheEntryUpsertBulk) SetInputHash(v string) *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetInputHash(v)
+	})
+}
+
+// UpdateInputHash sets the "input_hash" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertBulk) UpdateInputHash() *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateInputHash()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *LLMCacheEntryUpsertBulk) SetValue(v []byte) *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertBulk) UpdateValue() *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LLMCacheEntryUpsertBulk) SetExpiresAt(v int64) *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// AddExpiresAt adds v to the "expires_at" field.
+func (u *LLMCacheEntryUpsertBulk) AddExpiresAt(v int64) *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.AddExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertBulk) UpdateExpiresAt() *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LLMCacheEntryUpsertBulk) SetCreatedAt(v int64) *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *LLMCacheEntryUpsertBulk) AddCreatedAt(v int64) *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LLMCacheEntryUpsertBulk) UpdateCreatedAt() *LLMCacheEntryUpsertBulk {
+	return u.Update(func(s *LLMCacheEntryUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LLMCacheEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LLMCacheEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LLMCacheEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LLMCacheEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/llmcacheentry"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// LLMCacheEntryUpdate is the builder for updating LLMCacheEntry entities.
+type LLMCacheEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LLMCacheEntryMutation
+}
+
+// Where appends a list predicates to the LLMCacheEntryUpdate builder.
+func (_u *LLMCacheEntryUpdate) Where(ps ...predicate.LLMCacheEntry) *LLMCacheEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStage sets the "stage" field.
+func (_u *LLMCacheEntryUpdate) SetStage(v string) *LLMCacheEntryUpdate {
+	_u.mutation.SetStage(v)
+	return _u
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdate) SetNillableStage(v *string) *LLMCacheEntryUpdate {
+	if v != nil {
+		_u.SetStage(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *LLMCacheEntryUpdate) SetModel(v string) *LLMCacheEntryUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdate) SetNillableModel(v *string) *LLMCacheEntryUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_u *LLMCacheEntryUpdate) SetPromptVersion(v string) *LLMCacheEntryUpdate {
+	_u.mutation.SetPromptVersion(v)
+	return _u
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdate) SetNillablePromptVersion(v *string) *LLMCacheEntryUpdate {
+	if v != nil {
+		_u.SetPromptVersion(*v)
+	}
+	return _u
+}
+
+// SetInputHash sets the "input_hash" field.
+func (_u *LLMCacheEntryUpdate) SetInputHash(v string) *LLMCacheEntryUpdate {
+	_u.mutation.SetInputHash(v)
+	return _u
+}
+
+// SetNillableInputHash sets the "input_hash" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdate) SetNillableInputHash(v *string) *LLMCacheEntryUpdate {
+	if v != nil {
+		_u.SetInputHash(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *LLMCacheEntryUpdate) SetValue(v []byte) *LLMCacheEntryUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *LLMCacheEntryUpdate) SetExpiresAt(v int64) *LLMCacheEntryUpdate {
+	_u.mutation.ResetExpiresAt()
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdate) SetNillableExpiresAt(v *int64) *LLMCacheEntryUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// AddExpiresAt adds value to the "expires_at" field.
+func (_u *LLMCacheEntryUpdate) AddExpiresAt(v int64) *LLMCacheEntryUpdate {
+	_u.mutation.AddExpiresAt(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LLMCacheEntryUpdate) SetCreatedAt(v int64) *LLMCacheEntryUpdate {
+	_u.mutation.ResetCreatedAt()
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdate) SetNillableCreatedAt(v *int64) *LLMCacheEntryUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *LLMCacheEntryUpdate) AddCreatedAt(v int64) *LLMCacheEntryUpdate {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// Mutation returns the LLMCacheEntryMutation object of the builder.
+func (_u *LLMCacheEntryUpdate) Mutation() *LLMCacheEntryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LLMCacheEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LLMCacheEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LLMCacheEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LLMCacheEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LLMCacheEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(llmcacheentry.Table, llmcacheentry.Columns, sqlgraph.NewFieldSpec(llmcacheentry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Stage(); ok {
+		_spec.SetField(llmcacheentry.FieldStage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(llmcacheentry.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PromptVersion(); ok {
+		_spec.SetField(llmcacheentry.FieldPromptVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputHash(); ok {
+		_spec.SetField(llmcacheentry.FieldInputHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(llmcacheentry.FieldValue, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(llmcacheentry.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(llmcacheentry.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(llmcacheentry.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(llmcacheentry.FieldCreatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LLMCacheEntry
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{llmcacheentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LLMCacheEntryUpdateOne is the builder for updating a single LLMCacheEntry entity.
+type LLMCacheEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LLMCacheEntryMutation
+}
+
+// SetStage sets the "stage" field.
+func (_u *LLMCacheEntryUpdateOne) SetStage(v string) *LLMCacheEntryUpdateOne {
+	_u.mutation.SetStage(v)
+	return _u
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdateOne) SetNillableStage(v *string) *LLMCacheEntryUpdateOne {
+	if v != nil {
+		_u.SetStage(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *LLMCacheEntryUpdateOne) SetModel(v string) *LLMCacheEntryUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdateOne) SetNillableModel(v *string) *LLMCacheEntryUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_u *LLMCacheEntryUpdateOne) SetPromptVersion(v string) *LLMCacheEntryUpdateOne {
+	_u.mutation.SetPromptVersion(v)
+	return _u
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdateOne) SetNillablePromptVersion(v *string) *LLMCacheEntryUpdateOne {
+	if v != nil {
+		_u.SetPromptVersion(*v)
+	}
+	return _u
+}
+
+// SetInputHash sets the "input_hash" field.
+func (_u *LLMCacheEntryUpdateOne) SetInputHash(v string) *LLMCacheEntryUpdateOne {
+	_u.mutation.SetInputHash(v)
+	return _u
+}
+
+// SetNillableInputHash sets the "input_hash" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdateOne) SetNillableInputHash(v *string) *LLMCacheEntryUpdateOne {
+	if v != nil {
+		_u.SetInputHash(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *LLMCacheEntryUpdateOne) SetValue(v []byte) *LLMCacheEntryUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *LLMCacheEntryUpdateOne) SetExpiresAt(v int64) *LLMCacheEntryUpdateOne {
+	_u.mutation.ResetExpiresAt()
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdateOne) SetNillableExpiresAt(v *int64) *LLMCacheEntryUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// AddExpiresAt adds value to the "expires_at" field.
+func (_u *LLMCacheEntryUpdateOne) AddExpiresAt(v int64) *LLMCacheEntryUpdateOne {
+	_u.mutation.AddExpiresAt(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LLMCacheEntryUpdateOne) SetCreatedAt(v int64) *LLMCacheEntryUpdateOne {
+	_u.mutation.ResetCreatedAt()
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LLMCacheEntryUpdateOne) SetNillableCreatedAt(v *int64) *LLMCacheEntryUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *LLMCacheEntryUpdateOne) AddCreatedAt(v int64) *LLMCacheEntryUpdateOne {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// Mutation returns the LLMCacheEntryMutation object of the builder.
+func (_u *LLMCacheEntryUpdateOne) Mutation() *LLMCacheEntryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LLMCacheEntryUpdate builder.
+func (_u *LLMCacheEntryUpdateOne) Where(ps ...predicate.LLMCacheEntry) *LLMCacheEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LLMCacheEntryUpdateOne) Select(field string, fields ...string) *LLMCacheEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LLMCacheEntry entity.
+func (_u *LLMCacheEntryUpdateOne) Save(ctx context.Context) (*LLMCacheEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LLMCacheEntryUpdateOne) SaveX(ctx context.Context) *LLMCacheEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LLMCacheEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LLMCacheEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LLMCacheEntryUpdateOne) sqlSave(ctx context.Context) (_node *LLMCacheEntry, err error) {
+	_spec := sqlgraph.NewUpdateSpec(llmcacheentry.Table, llmcacheentry.Columns, sqlgraph.NewFieldSpec(llmcacheentry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LLMCacheEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, llmcacheentry.FieldID)
+		for _, f := range fields {
+			if !llmcacheentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != llmcacheentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Stage(); ok {
+		_spec.SetField(llmcacheentry.FieldStage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(llmcacheentry.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PromptVersion(); ok {
+		_spec.SetField(llmcacheentry.FieldPromptVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputHash(); ok {
+		_spec.SetField(llmcacheentry.FieldInputHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(llmcacheentry.FieldValue, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(llmcacheentry.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(llmcacheentry.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(llmcacheentry.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(llmcacheentry.FieldCreatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LLMCacheEntry
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &LLMCacheEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{llmcacheentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
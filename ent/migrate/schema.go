@@ -9,9 +9,139 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"viewer", "curator", "admin"}},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "last_used_at", Type: field.TypeInt64, Default: 0},
+		{Name: "revoked_at", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[1]},
+			},
+			{
+				Name:    "apikey_subject",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[3]},
+			},
+		},
+	}
+	// AccessLogsColumns holds the columns for the "access_logs" table.
+	AccessLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "subject", Type: field.TypeString, Default: ""},
+		{Name: "method", Type: field.TypeString, Default: ""},
+		{Name: "role", Type: field.TypeString, Default: ""},
+		{Name: "endpoint", Type: field.TypeString},
+		{Name: "query", Type: field.TypeString, Default: ""},
+		{Name: "chat_ids", Type: field.TypeJSON},
+		{Name: "message_ids", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// AccessLogsTable holds the schema information for the "access_logs" table.
+	AccessLogsTable = &schema.Table{
+		Name:       "access_logs",
+		Columns:    AccessLogsColumns,
+		PrimaryKey: []*schema.Column{AccessLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesslog_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccessLogsColumns[1]},
+			},
+			{
+				Name:    "accesslog_subject_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccessLogsColumns[2], AccessLogsColumns[9]},
+			},
+			{
+				Name:    "accesslog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccessLogsColumns[9]},
+			},
+		},
+	}
+	// AskTurnsColumns holds the columns for the "ask_turns" table.
+	AskTurnsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "conversation_id", Type: field.TypeUUID},
+		{Name: "subject", Type: field.TypeString, Default: ""},
+		{Name: "question", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "standalone_question", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "answer", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "refused", Type: field.TypeBool, Default: false},
+		{Name: "cited_message_ids", Type: field.TypeJSON},
+		{Name: "event_ids", Type: field.TypeJSON},
+		{Name: "model", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// AskTurnsTable holds the schema information for the "ask_turns" table.
+	AskTurnsTable = &schema.Table{
+		Name:       "ask_turns",
+		Columns:    AskTurnsColumns,
+		PrimaryKey: []*schema.Column{AskTurnsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "askturn_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{AskTurnsColumns[1]},
+			},
+			{
+				Name:    "askturn_conversation_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AskTurnsColumns[2], AskTurnsColumns[11]},
+			},
+		},
+	}
+	// ChatGrantsColumns holds the columns for the "chat_grants" table.
+	ChatGrantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "chat_id", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"viewer", "curator"}, Default: "viewer"},
+		{Name: "granted_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// ChatGrantsTable holds the schema information for the "chat_grants" table.
+	ChatGrantsTable = &schema.Table{
+		Name:       "chat_grants",
+		Columns:    ChatGrantsColumns,
+		PrimaryKey: []*schema.Column{ChatGrantsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatgrant_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{ChatGrantsColumns[1]},
+			},
+			{
+				Name:    "chatgrant_workspace_id_subject_chat_id",
+				Unique:  true,
+				Columns: []*schema.Column{ChatGrantsColumns[1], ChatGrantsColumns[2], ChatGrantsColumns[3]},
+			},
+		},
+	}
 	// ChatMessagesColumns holds the columns for the "chat_messages" table.
 	ChatMessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
 		{Name: "platform", Type: field.TypeString, Default: ""},
 		{Name: "platform_message_id", Type: field.TypeString, Default: ""},
 		{Name: "from_id", Type: field.TypeString, Default: ""},
@@ -29,6 +159,7 @@ var (
 		{Name: "content_vector_1024", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "vector(1024)"}},
 		{Name: "content_vector_768", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "vector(768)"}},
 		{Name: "jieba_tokens", Type: field.TypeJSON},
+		{Name: "tokenized_at", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeInt64},
 		{Name: "updated_at", Type: field.TypeInt64},
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
@@ -39,15 +170,20 @@ var (
 		Columns:    ChatMessagesColumns,
 		PrimaryKey: []*schema.Column{ChatMessagesColumns[0]},
 		Indexes: []*schema.Index{
+			{
+				Name:    "chatmessage_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[1]},
+			},
 			{
 				Name:    "chatmessage_platform_platform_message_id_in_chat_id_owner_account_id",
 				Unique:  true,
-				Columns: []*schema.Column{ChatMessagesColumns[1], ChatMessagesColumns[2], ChatMessagesColumns[7], ChatMessagesColumns[6]},
+				Columns: []*schema.Column{ChatMessagesColumns[2], ChatMessagesColumns[3], ChatMessagesColumns[8], ChatMessagesColumns[7]},
 			},
 			{
 				Name:    "chatmessage_content_vector_1536",
 				Unique:  false,
-				Columns: []*schema.Column{ChatMessagesColumns[14]},
+				Columns: []*schema.Column{ChatMessagesColumns[15]},
 				Annotation: &entsql.IndexAnnotation{
 					OpClass: "vector_l2_ops",
 					Type:    "hnsw",
@@ -56,7 +192,7 @@ var (
 			{
 				Name:    "chatmessage_content_vector_1024",
 				Unique:  false,
-				Columns: []*schema.Column{ChatMessagesColumns[15]},
+				Columns: []*schema.Column{ChatMessagesColumns[16]},
 				Annotation: &entsql.IndexAnnotation{
 					OpClass: "vector_l2_ops",
 					Type:    "hnsw",
@@ -65,7 +201,7 @@ var (
 			{
 				Name:    "chatmessage_content_vector_768",
 				Unique:  false,
-				Columns: []*schema.Column{ChatMessagesColumns[16]},
+				Columns: []*schema.Column{ChatMessagesColumns[17]},
 				Annotation: &entsql.IndexAnnotation{
 					OpClass: "vector_l2_ops",
 					Type:    "hnsw",
@@ -74,64 +210,322 @@ var (
 			{
 				Name:    "chatmessage_jieba_tokens",
 				Unique:  false,
-				Columns: []*schema.Column{ChatMessagesColumns[17]},
+				Columns: []*schema.Column{ChatMessagesColumns[18]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "GIN",
+				},
 			},
 			{
 				Name:    "chatmessage_from_user_uuid",
 				Unique:  false,
-				Columns: []*schema.Column{ChatMessagesColumns[5]},
+				Columns: []*schema.Column{ChatMessagesColumns[6]},
+			},
+		},
+	}
+	// ChatRetentionsColumns holds the columns for the "chat_retentions" table.
+	ChatRetentionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "chat_id", Type: field.TypeString},
+		{Name: "days", Type: field.TypeInt},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"content", "all"}, Default: "content"},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "last_run_at", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// ChatRetentionsTable holds the schema information for the "chat_retentions" table.
+	ChatRetentionsTable = &schema.Table{
+		Name:       "chat_retentions",
+		Columns:    ChatRetentionsColumns,
+		PrimaryKey: []*schema.Column{ChatRetentionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatretention_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{ChatRetentionsColumns[1]},
+			},
+			{
+				Name:    "chatretention_workspace_id_chat_id",
+				Unique:  true,
+				Columns: []*schema.Column{ChatRetentionsColumns[1], ChatRetentionsColumns[2]},
+			},
+		},
+	}
+	// ChatSchedulesColumns holds the columns for the "chat_schedules" table.
+	ChatSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "chat_id", Type: field.TypeString},
+		{Name: "cron", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "last_window_end", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// ChatSchedulesTable holds the schema information for the "chat_schedules" table.
+	ChatSchedulesTable = &schema.Table{
+		Name:       "chat_schedules",
+		Columns:    ChatSchedulesColumns,
+		PrimaryKey: []*schema.Column{ChatSchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatschedule_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{ChatSchedulesColumns[1]},
+			},
+			{
+				Name:    "chatschedule_workspace_id_chat_id",
+				Unique:  true,
+				Columns: []*schema.Column{ChatSchedulesColumns[1], ChatSchedulesColumns[2]},
+			},
+		},
+	}
+	// DigestDeliveriesColumns holds the columns for the "digest_deliveries" table.
+	DigestDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "publisher", Type: field.TypeString},
+		{Name: "chat_id", Type: field.TypeString},
+		{Name: "digest_key", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent"}, Default: "pending"},
+		{Name: "parts_total", Type: field.TypeInt, Default: 0},
+		{Name: "parts_sent", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Default: ""},
+		{Name: "sent_at", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// DigestDeliveriesTable holds the schema information for the "digest_deliveries" table.
+	DigestDeliveriesTable = &schema.Table{
+		Name:       "digest_deliveries",
+		Columns:    DigestDeliveriesColumns,
+		PrimaryKey: []*schema.Column{DigestDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "digestdelivery_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{DigestDeliveriesColumns[1]},
+			},
+			{
+				Name:    "digestdelivery_workspace_id_publisher_chat_id_digest_key",
+				Unique:  true,
+				Columns: []*schema.Column{DigestDeliveriesColumns[1], DigestDeliveriesColumns[2], DigestDeliveriesColumns[3], DigestDeliveriesColumns[4]},
+			},
+		},
+	}
+	// DistillRunsColumns holds the columns for the "distill_runs" table.
+	DistillRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "in_chat_id", Type: field.TypeString},
+		{Name: "span_start", Type: field.TypeInt64},
+		{Name: "span_end", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "succeeded", "failed"}, Default: "running"},
+		{Name: "message_count", Type: field.TypeInt, Default: 0},
+		{Name: "item_count", Type: field.TypeInt, Default: 0},
+		{Name: "event_count", Type: field.TypeInt, Default: 0},
+		{Name: "prompt_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "cost", Type: field.TypeFloat64, Default: 0},
+		{Name: "stage_usage", Type: field.TypeJSON},
+		{Name: "error", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "partial_output", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "finished_at", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// DistillRunsTable holds the schema information for the "distill_runs" table.
+	DistillRunsTable = &schema.Table{
+		Name:       "distill_runs",
+		Columns:    DistillRunsColumns,
+		PrimaryKey: []*schema.Column{DistillRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "distillrun_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{DistillRunsColumns[1]},
+			},
+			{
+				Name:    "distillrun_in_chat_id_span_start",
+				Unique:  false,
+				Columns: []*schema.Column{DistillRunsColumns[2], DistillRunsColumns[3]},
+			},
+			{
+				Name:    "distillrun_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DistillRunsColumns[16]},
 			},
 		},
 	}
 	// EventsColumns holds the columns for the "events" table.
 	EventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
 		{Name: "platform", Type: field.TypeString, Default: ""},
 		{Name: "name", Type: field.TypeString, Default: ""},
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "description", Type: field.TypeString, Default: ""},
 		{Name: "from_name", Type: field.TypeString, Default: ""},
+		{Name: "unmatched_names", Type: field.TypeJSON, Nullable: true},
 		{Name: "in_chat_id", Type: field.TypeString, Default: ""},
 		{Name: "in_chat_type", Type: field.TypeString, Default: ""},
 		{Name: "platform_timestamp", Type: field.TypeInt64, Default: 0},
 		{Name: "evidence_message_ids", Type: field.TypeJSON},
+		{Name: "span_start", Type: field.TypeInt64, Default: 0},
+		{Name: "span_end", Type: field.TypeInt64, Default: 0},
+		{Name: "description_vector_1536", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(1536)"}},
+		{Name: "description_vector_1024", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(1024)"}},
+		{Name: "description_vector_768", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(768)"}},
 		{Name: "created_at", Type: field.TypeInt64},
 		{Name: "updated_at", Type: field.TypeInt64},
+		{Name: "continues_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// EventsTable holds the schema information for the "events" table.
 	EventsTable = &schema.Table{
 		Name:       "events",
 		Columns:    EventsColumns,
 		PrimaryKey: []*schema.Column{EventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "events_events_continuations",
+				Columns:    []*schema.Column{EventsColumns[19]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "event_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[1]},
+			},
+			{
+				Name:    "event_in_chat_id_platform_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[8], EventsColumns[10]},
+			},
+			{
+				Name:    "event_description_vector_1536",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[14]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "vector_cosine_ops",
+					Type:    "hnsw",
+				},
+			},
+			{
+				Name:    "event_description_vector_1024",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[15]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "vector_cosine_ops",
+					Type:    "hnsw",
+				},
+			},
+			{
+				Name:    "event_description_vector_768",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[16]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "vector_cosine_ops",
+					Type:    "hnsw",
+				},
+			},
+		},
 	}
 	// IdentitiesColumns holds the columns for the "identities" table.
 	IdentitiesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
 		{Name: "platform", Type: field.TypeString, Default: ""},
 		{Name: "platform_user_id", Type: field.TypeString, Default: ""},
 		{Name: "username", Type: field.TypeString, Default: ""},
 		{Name: "display_name", Type: field.TypeString, Default: ""},
 		{Name: "profile_photo_url", Type: field.TypeString, Default: ""},
 		{Name: "alt_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "opted_out", Type: field.TypeBool, Default: false},
+		{Name: "forgotten_at", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeInt64},
 		{Name: "updated_at", Type: field.TypeInt64},
+		{Name: "person_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// IdentitiesTable holds the schema information for the "identities" table.
 	IdentitiesTable = &schema.Table{
 		Name:       "identities",
 		Columns:    IdentitiesColumns,
 		PrimaryKey: []*schema.Column{IdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identities_persons_identities",
+				Columns:    []*schema.Column{IdentitiesColumns[12]},
+				RefColumns: []*schema.Column{PersonsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "identity_platform_platform_user_id",
+				Name:    "identity_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{IdentitiesColumns[1]},
+			},
+			{
+				Name:    "identity_workspace_id_platform_platform_user_id",
 				Unique:  true,
-				Columns: []*schema.Column{IdentitiesColumns[1], IdentitiesColumns[2]},
+				Columns: []*schema.Column{IdentitiesColumns[1], IdentitiesColumns[2], IdentitiesColumns[3]},
+			},
+			{
+				Name:    "identity_person_id",
+				Unique:  false,
+				Columns: []*schema.Column{IdentitiesColumns[12]},
+			},
+		},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"distill", "embed", "graph_sync", "profile", "tokenize"}},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "key", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "succeeded", "dead", "cancelled"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 5},
+		{Name: "run_at", Type: field.TypeInt64},
+		{Name: "locked_by", Type: field.TypeString, Default: ""},
+		{Name: "locked_until", Type: field.TypeInt64, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "finished_at", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[1]},
+			},
+			{
+				Name:    "job_workspace_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{JobsColumns[1], JobsColumns[4]},
+			},
+			{
+				Name:    "job_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[5], JobsColumns[8]},
 			},
 		},
 	}
 	// JoinedChatsColumns holds the columns for the "joined_chats" table.
 	JoinedChatsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
 		{Name: "platform", Type: field.TypeString, Default: ""},
 		{Name: "chat_id", Type: field.TypeString, Default: ""},
 		{Name: "chat_name", Type: field.TypeString, Default: ""},
@@ -146,13 +540,209 @@ var (
 		Columns:    JoinedChatsColumns,
 		PrimaryKey: []*schema.Column{JoinedChatsColumns[0]},
 		Indexes: []*schema.Index{
+			{
+				Name:    "joinedchat_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{JoinedChatsColumns[1]},
+			},
 			{
 				Name:    "platform_chat_id_unique_index",
 				Unique:  true,
-				Columns: []*schema.Column{JoinedChatsColumns[1], JoinedChatsColumns[2]},
+				Columns: []*schema.Column{JoinedChatsColumns[2], JoinedChatsColumns[3]},
+			},
+		},
+	}
+	// LlmCacheEntriesColumns holds the columns for the "llm_cache_entries" table.
+	LlmCacheEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "stage", Type: field.TypeString, Default: ""},
+		{Name: "model", Type: field.TypeString, Default: ""},
+		{Name: "prompt_version", Type: field.TypeString, Default: ""},
+		{Name: "input_hash", Type: field.TypeString, Default: ""},
+		{Name: "value", Type: field.TypeBytes},
+		{Name: "expires_at", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// LlmCacheEntriesTable holds the schema information for the "llm_cache_entries" table.
+	LlmCacheEntriesTable = &schema.Table{
+		Name:       "llm_cache_entries",
+		Columns:    LlmCacheEntriesColumns,
+		PrimaryKey: []*schema.Column{LlmCacheEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "llmcacheentry_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{LlmCacheEntriesColumns[7]},
+			},
+		},
+	}
+	// OwnerAccountLinksColumns holds the columns for the "owner_account_links" table.
+	OwnerAccountLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "owner_account_id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// OwnerAccountLinksTable holds the schema information for the "owner_account_links" table.
+	OwnerAccountLinksTable = &schema.Table{
+		Name:       "owner_account_links",
+		Columns:    OwnerAccountLinksColumns,
+		PrimaryKey: []*schema.Column{OwnerAccountLinksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "owneraccountlink_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{OwnerAccountLinksColumns[1]},
+			},
+			{
+				Name:    "owneraccountlink_workspace_id_subject_owner_account_id",
+				Unique:  true,
+				Columns: []*schema.Column{OwnerAccountLinksColumns[1], OwnerAccountLinksColumns[2], OwnerAccountLinksColumns[3]},
 			},
 		},
 	}
+	// PersonsColumns holds the columns for the "persons" table.
+	PersonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "display_name", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// PersonsTable holds the schema information for the "persons" table.
+	PersonsTable = &schema.Table{
+		Name:       "persons",
+		Columns:    PersonsColumns,
+		PrimaryKey: []*schema.Column{PersonsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "person_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{PersonsColumns[1]},
+			},
+		},
+	}
+	// PersonAuditLogsColumns holds the columns for the "person_audit_logs" table.
+	PersonAuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"merge", "split", "forget"}},
+		{Name: "person_id", Type: field.TypeUUID},
+		{Name: "source_person_ids", Type: field.TypeJSON},
+		{Name: "identity_ids", Type: field.TypeJSON},
+		{Name: "chat_ids", Type: field.TypeJSON},
+		{Name: "actor", Type: field.TypeString, Default: ""},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "counts", Type: field.TypeJSON, Nullable: true},
+		{Name: "score", Type: field.TypeFloat64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// PersonAuditLogsTable holds the schema information for the "person_audit_logs" table.
+	PersonAuditLogsTable = &schema.Table{
+		Name:       "person_audit_logs",
+		Columns:    PersonAuditLogsColumns,
+		PrimaryKey: []*schema.Column{PersonAuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "personauditlog_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{PersonAuditLogsColumns[1]},
+			},
+		},
+	}
+	// ProfilesColumns holds the columns for the "profiles" table.
+	ProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "claims", Type: field.TypeJSON},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "event_count", Type: field.TypeInt, Default: 0},
+		{Name: "events_updated_until", Type: field.TypeInt64, Default: 0},
+		{Name: "events_updated_until_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "model", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "identity_id", Type: field.TypeUUID},
+	}
+	// ProfilesTable holds the schema information for the "profiles" table.
+	ProfilesTable = &schema.Table{
+		Name:       "profiles",
+		Columns:    ProfilesColumns,
+		PrimaryKey: []*schema.Column{ProfilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profiles_identities_profiles",
+				Columns:    []*schema.Column{ProfilesColumns[10]},
+				RefColumns: []*schema.Column{IdentitiesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "profile_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProfilesColumns[1]},
+			},
+			{
+				Name:    "profile_identity_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{ProfilesColumns[10], ProfilesColumns[2]},
+			},
+		},
+	}
+	// SummariesColumns holds the columns for the "summaries" table.
+	SummariesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID, Default: "00000000-0000-0000-0000-000000000000"},
+		{Name: "platform", Type: field.TypeString, Default: ""},
+		{Name: "in_chat_id", Type: field.TypeString, Default: ""},
+		{Name: "in_chat_type", Type: field.TypeString, Default: ""},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "model", Type: field.TypeString, Default: ""},
+		{Name: "message_count", Type: field.TypeInt, Default: 0},
+		{Name: "span_start", Type: field.TypeInt64, Default: 0},
+		{Name: "span_end", Type: field.TypeInt64, Default: 0},
+		{Name: "platform_timestamp", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// SummariesTable holds the schema information for the "summaries" table.
+	SummariesTable = &schema.Table{
+		Name:       "summaries",
+		Columns:    SummariesColumns,
+		PrimaryKey: []*schema.Column{SummariesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "summary_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{SummariesColumns[1]},
+			},
+			{
+				Name:    "summary_in_chat_id_platform_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{SummariesColumns[3], SummariesColumns[10]},
+			},
+		},
+	}
+	// WorkspacesColumns holds the columns for the "workspaces" table.
+	WorkspacesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "graph_name", Type: field.TypeString, Unique: true},
+		{Name: "llm_providers", Type: field.TypeJSON, Nullable: true},
+		{Name: "llm_monthly_budget", Type: field.TypeFloat64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// WorkspacesTable holds the schema information for the "workspaces" table.
+	WorkspacesTable = &schema.Table{
+		Name:       "workspaces",
+		Columns:    WorkspacesColumns,
+		PrimaryKey: []*schema.Column{WorkspacesColumns[0]},
+	}
 	// IdentityEventsColumns holds the columns for the "identity_events" table.
 	IdentityEventsColumns = []*schema.Column{
 		{Name: "identity_id", Type: field.TypeUUID},
@@ -180,15 +770,34 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AccessLogsTable,
+		AskTurnsTable,
+		ChatGrantsTable,
 		ChatMessagesTable,
+		ChatRetentionsTable,
+		ChatSchedulesTable,
+		DigestDeliveriesTable,
+		DistillRunsTable,
 		EventsTable,
 		IdentitiesTable,
+		JobsTable,
 		JoinedChatsTable,
+		LlmCacheEntriesTable,
+		OwnerAccountLinksTable,
+		PersonsTable,
+		PersonAuditLogsTable,
+		ProfilesTable,
+		SummariesTable,
+		WorkspacesTable,
 		IdentityEventsTable,
 	}
 )
 
 func init() {
+	EventsTable.ForeignKeys[0].RefTable = EventsTable
+	IdentitiesTable.ForeignKeys[0].RefTable = PersonsTable
+	ProfilesTable.ForeignKeys[0].RefTable = IdentitiesTable
 	IdentityEventsTable.ForeignKeys[0].RefTable = IdentitiesTable
 	IdentityEventsTable.ForeignKeys[1].RefTable = EventsTable
 }
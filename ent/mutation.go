@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"sync"
@@ -11,11 +12,28 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/accesslog"
+	"github.com/luoling8192/mindwave/ent/apikey"
+	"github.com/luoling8192/mindwave/ent/askturn"
+	"github.com/luoling8192/mindwave/ent/chatgrant"
 	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/chatretention"
+	"github.com/luoling8192/mindwave/ent/chatschedule"
+	"github.com/luoling8192/mindwave/ent/digestdelivery"
+	"github.com/luoling8192/mindwave/ent/distillrun"
 	"github.com/luoling8192/mindwave/ent/event"
 	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/job"
 	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/ent/llmcacheentry"
+	"github.com/luoling8192/mindwave/ent/owneraccountlink"
+	"github.com/luoling8192/mindwave/ent/person"
+	"github.com/luoling8192/mindwave/ent/personauditlog"
 	"github.com/luoling8192/mindwave/ent/predicate"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/ent/summary"
+	"github.com/luoling8192/mindwave/ent/workspace"
+	"github.com/luoling8192/mindwave/schema"
 	pgvector "github.com/pgvector/pgvector-go"
 )
 
@@ -28,60 +46,63 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChatMessage = "ChatMessage"
-	TypeEvent       = "Event"
-	TypeIdentity    = "Identity"
-	TypeJoinedChat  = "JoinedChat"
+	TypeAPIKey           = "APIKey"
+	TypeAccessLog        = "AccessLog"
+	TypeAskTurn          = "AskTurn"
+	TypeChatGrant        = "ChatGrant"
+	TypeChatMessage      = "ChatMessage"
+	TypeChatRetention    = "ChatRetention"
+	TypeChatSchedule     = "ChatSchedule"
+	TypeDigestDelivery   = "DigestDelivery"
+	TypeDistillRun       = "DistillRun"
+	TypeEvent            = "Event"
+	TypeIdentity         = "Identity"
+	TypeJob              = "Job"
+	TypeJoinedChat       = "JoinedChat"
+	TypeLLMCacheEntry    = "LLMCacheEntry"
+	TypeOwnerAccountLink = "OwnerAccountLink"
+	TypePerson           = "Person"
+	TypePersonAuditLog   = "PersonAuditLog"
+	TypeProfile          = "Profile"
+	TypeSummary          = "Summary"
+	TypeWorkspace        = "Workspace"
 )
 
-// ChatMessageMutation represents an operation that mutates the ChatMessage nodes in the graph.
-type ChatMessageMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	platform              *string
-	platform_message_id   *string
-	from_id               *string
-	from_name             *string
-	from_user_uuid        *uuid.UUID
-	owner_account_id      *uuid.UUID
-	in_chat_id            *string
-	in_chat_type          *string
-	content               *string
-	is_reply              *bool
-	reply_to_name         *string
-	reply_to_id           *string
-	platform_timestamp    *int64
-	addplatform_timestamp *int64
-	content_vector_1536   *pgvector.Vector
-	content_vector_1024   *pgvector.Vector
-	content_vector_768    *pgvector.Vector
-	jieba_tokens          *[]string
-	appendjieba_tokens    []string
-	created_at            *int64
-	addcreated_at         *int64
-	updated_at            *int64
-	addupdated_at         *int64
-	deleted_at            *int64
-	adddeleted_at         *int64
-	clearedFields         map[string]struct{}
-	done                  bool
-	oldValue              func(context.Context) (*ChatMessage, error)
-	predicates            []predicate.ChatMessage
-}
-
-var _ ent.Mutation = (*ChatMessageMutation)(nil)
-
-// chatmessageOption allows management of the mutation configuration using functional options.
-type chatmessageOption func(*ChatMessageMutation)
-
-// newChatMessageMutation creates new mutation for the ChatMessage entity.
-func newChatMessageMutation(c config, op Op, opts ...chatmessageOption) *ChatMessageMutation {
-	m := &ChatMessageMutation{
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	workspace_id    *uuid.UUID
+	name            *string
+	subject         *string
+	role            *apikey.Role
+	key_hash        *string
+	prefix          *string
+	last_used_at    *int64
+	addlast_used_at *int64
+	revoked_at      *int64
+	addrevoked_at   *int64
+	created_at      *int64
+	addcreated_at   *int64
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*APIKey, error)
+	predicates      []predicate.APIKey
+}
+
+var _ ent.Mutation = (*APIKeyMutation)(nil)
+
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
+
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChatMessage,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -90,20 +111,20 @@ func newChatMessageMutation(c config, op Op, opts ...chatmessageOption) *ChatMes
 	return m
 }
 
-// withChatMessageID sets the ID field of the mutation.
-func withChatMessageID(id uuid.UUID) chatmessageOption {
-	return func(m *ChatMessageMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id uuid.UUID) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ChatMessage
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*ChatMessage, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ChatMessage.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -112,10 +133,10 @@ func withChatMessageID(id uuid.UUID) chatmessageOption {
 	}
 }
 
-// withChatMessage sets the old ChatMessage of the mutation.
-func withChatMessage(node *ChatMessage) chatmessageOption {
-	return func(m *ChatMessageMutation) {
-		m.oldValue = func(context.Context) (*ChatMessage, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -124,7 +145,7 @@ func withChatMessage(node *ChatMessage) chatmessageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChatMessageMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -132,7 +153,7 @@ func (m ChatMessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChatMessageMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -142,14 +163,14 @@ func (m ChatMessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ChatMessage entities.
-func (m *ChatMessageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of APIKey entities.
+func (m *APIKeyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChatMessageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *APIKeyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -160,7 +181,7 @@ func (m *ChatMessageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChatMessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -169,862 +190,17030 @@ func (m *ChatMessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChatMessage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPlatform sets the "platform" field.
-func (m *ChatMessageMutation) SetPlatform(s string) {
-	m.platform = &s
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *APIKeyMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
 }
 
-// Platform returns the value of the "platform" field in the mutation.
-func (m *ChatMessageMutation) Platform() (r string, exists bool) {
-	v := m.platform
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *APIKeyMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlatform returns the old "platform" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldWorkspaceID returns the old "workspace_id" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldPlatform(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatform requires an ID field in the mutation")
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
 	}
-	return oldValue.Platform, nil
+	return oldValue.WorkspaceID, nil
 }
 
-// ResetPlatform resets all changes to the "platform" field.
-func (m *ChatMessageMutation) ResetPlatform() {
-	m.platform = nil
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *APIKeyMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
 }
 
-// SetPlatformMessageID sets the "platform_message_id" field.
-func (m *ChatMessageMutation) SetPlatformMessageID(s string) {
-	m.platform_message_id = &s
+// SetName sets the "name" field.
+func (m *APIKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// PlatformMessageID returns the value of the "platform_message_id" field in the mutation.
-func (m *ChatMessageMutation) PlatformMessageID() (r string, exists bool) {
-	v := m.platform_message_id
+// Name returns the value of the "name" field in the mutation.
+func (m *APIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlatformMessageID returns the old "platform_message_id" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldPlatformMessageID(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatformMessageID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatformMessageID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatformMessageID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.PlatformMessageID, nil
+	return oldValue.Name, nil
 }
 
-// ResetPlatformMessageID resets all changes to the "platform_message_id" field.
-func (m *ChatMessageMutation) ResetPlatformMessageID() {
-	m.platform_message_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *APIKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetFromID sets the "from_id" field.
-func (m *ChatMessageMutation) SetFromID(s string) {
-	m.from_id = &s
+// SetSubject sets the "subject" field.
+func (m *APIKeyMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// FromID returns the value of the "from_id" field in the mutation.
-func (m *ChatMessageMutation) FromID() (r string, exists bool) {
-	v := m.from_id
+// Subject returns the value of the "subject" field in the mutation.
+func (m *APIKeyMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromID returns the old "from_id" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldFromID(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromID requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.FromID, nil
+	return oldValue.Subject, nil
 }
 
-// ResetFromID resets all changes to the "from_id" field.
-func (m *ChatMessageMutation) ResetFromID() {
-	m.from_id = nil
+// ResetSubject resets all changes to the "subject" field.
+func (m *APIKeyMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// SetFromName sets the "from_name" field.
-func (m *ChatMessageMutation) SetFromName(s string) {
-	m.from_name = &s
+// SetRole sets the "role" field.
+func (m *APIKeyMutation) SetRole(a apikey.Role) {
+	m.role = &a
 }
 
-// FromName returns the value of the "from_name" field in the mutation.
-func (m *ChatMessageMutation) FromName() (r string, exists bool) {
-	v := m.from_name
+// Role returns the value of the "role" field in the mutation.
+func (m *APIKeyMutation) Role() (r apikey.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromName returns the old "from_name" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldFromName(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldRole(ctx context.Context) (v apikey.Role, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromName requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.FromName, nil
+	return oldValue.Role, nil
 }
 
-// ResetFromName resets all changes to the "from_name" field.
-func (m *ChatMessageMutation) ResetFromName() {
-	m.from_name = nil
+// ResetRole resets all changes to the "role" field.
+func (m *APIKeyMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetFromUserUUID sets the "from_user_uuid" field.
-func (m *ChatMessageMutation) SetFromUserUUID(u uuid.UUID) {
-	m.from_user_uuid = &u
+// SetKeyHash sets the "key_hash" field.
+func (m *APIKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
 }
 
-// FromUserUUID returns the value of the "from_user_uuid" field in the mutation.
-func (m *ChatMessageMutation) FromUserUUID() (r uuid.UUID, exists bool) {
-	v := m.from_user_uuid
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *APIKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromUserUUID returns the old "from_user_uuid" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldFromUserUUID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *APIKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromUserUUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromUserUUID requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromUserUUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return oldValue.FromUserUUID, nil
-}
-
-// ClearFromUserUUID clears the value of the "from_user_uuid" field.
-func (m *ChatMessageMutation) ClearFromUserUUID() {
-	m.from_user_uuid = nil
-	m.clearedFields[chatmessage.FieldFromUserUUID] = struct{}{}
-}
-
-// FromUserUUIDCleared returns if the "from_user_uuid" field was cleared in this mutation.
-func (m *ChatMessageMutation) FromUserUUIDCleared() bool {
-	_, ok := m.clearedFields[chatmessage.FieldFromUserUUID]
-	return ok
+	return oldValue.KeyHash, nil
 }
 
-// ResetFromUserUUID resets all changes to the "from_user_uuid" field.
-func (m *ChatMessageMutation) ResetFromUserUUID() {
-	m.from_user_uuid = nil
-	delete(m.clearedFields, chatmessage.FieldFromUserUUID)
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *APIKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
-// SetOwnerAccountID sets the "owner_account_id" field.
-func (m *ChatMessageMutation) SetOwnerAccountID(u uuid.UUID) {
-	m.owner_account_id = &u
+// SetPrefix sets the "prefix" field.
+func (m *APIKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// OwnerAccountID returns the value of the "owner_account_id" field in the mutation.
-func (m *ChatMessageMutation) OwnerAccountID() (r uuid.UUID, exists bool) {
-	v := m.owner_account_id
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerAccountID returns the old "owner_account_id" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldOwnerAccountID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *APIKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerAccountID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerAccountID requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerAccountID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.OwnerAccountID, nil
-}
-
-// ClearOwnerAccountID clears the value of the "owner_account_id" field.
-func (m *ChatMessageMutation) ClearOwnerAccountID() {
-	m.owner_account_id = nil
-	m.clearedFields[chatmessage.FieldOwnerAccountID] = struct{}{}
+	return oldValue.Prefix, nil
 }
 
-// OwnerAccountIDCleared returns if the "owner_account_id" field was cleared in this mutation.
-func (m *ChatMessageMutation) OwnerAccountIDCleared() bool {
-	_, ok := m.clearedFields[chatmessage.FieldOwnerAccountID]
-	return ok
-}
-
-// ResetOwnerAccountID resets all changes to the "owner_account_id" field.
-func (m *ChatMessageMutation) ResetOwnerAccountID() {
-	m.owner_account_id = nil
-	delete(m.clearedFields, chatmessage.FieldOwnerAccountID)
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeyMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetInChatID sets the "in_chat_id" field.
-func (m *ChatMessageMutation) SetInChatID(s string) {
-	m.in_chat_id = &s
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(i int64) {
+	m.last_used_at = &i
+	m.addlast_used_at = nil
 }
 
-// InChatID returns the value of the "in_chat_id" field in the mutation.
-func (m *ChatMessageMutation) InChatID() (r string, exists bool) {
-	v := m.in_chat_id
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r int64, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInChatID returns the old "in_chat_id" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldInChatID(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInChatID requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInChatID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.InChatID, nil
-}
-
-// ResetInChatID resets all changes to the "in_chat_id" field.
-func (m *ChatMessageMutation) ResetInChatID() {
-	m.in_chat_id = nil
+	return oldValue.LastUsedAt, nil
 }
 
-// SetInChatType sets the "in_chat_type" field.
-func (m *ChatMessageMutation) SetInChatType(s string) {
-	m.in_chat_type = &s
+// AddLastUsedAt adds i to the "last_used_at" field.
+func (m *APIKeyMutation) AddLastUsedAt(i int64) {
+	if m.addlast_used_at != nil {
+		*m.addlast_used_at += i
+	} else {
+		m.addlast_used_at = &i
+	}
 }
 
-// InChatType returns the value of the "in_chat_type" field in the mutation.
-func (m *ChatMessageMutation) InChatType() (r string, exists bool) {
-	v := m.in_chat_type
+// AddedLastUsedAt returns the value that was added to the "last_used_at" field in this mutation.
+func (m *APIKeyMutation) AddedLastUsedAt() (r int64, exists bool) {
+	v := m.addlast_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInChatType returns the old "in_chat_type" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldInChatType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInChatType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInChatType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInChatType: %w", err)
-	}
-	return oldValue.InChatType, nil
-}
-
-// ResetInChatType resets all changes to the "in_chat_type" field.
-func (m *ChatMessageMutation) ResetInChatType() {
-	m.in_chat_type = nil
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	m.addlast_used_at = nil
 }
 
-// SetContent sets the "content" field.
-func (m *ChatMessageMutation) SetContent(s string) {
-	m.content = &s
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeyMutation) SetRevokedAt(i int64) {
+	m.revoked_at = &i
+	m.addrevoked_at = nil
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *ChatMessageMutation) Content() (r string, exists bool) {
-	v := m.content
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeyMutation) RevokedAt() (r int64, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldRevokedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *ChatMessageMutation) ResetContent() {
-	m.content = nil
+// AddRevokedAt adds i to the "revoked_at" field.
+func (m *APIKeyMutation) AddRevokedAt(i int64) {
+	if m.addrevoked_at != nil {
+		*m.addrevoked_at += i
+	} else {
+		m.addrevoked_at = &i
+	}
+}
+
+// AddedRevokedAt returns the value that was added to the "revoked_at" field in this mutation.
+func (m *APIKeyMutation) AddedRevokedAt() (r int64, exists bool) {
+	v := m.addrevoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	m.addrevoked_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *APIKeyMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APIKeyMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *APIKeyMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *APIKeyMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *APIKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *APIKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.workspace_id != nil {
+		fields = append(fields, apikey.FieldWorkspaceID)
+	}
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
+	}
+	if m.subject != nil {
+		fields = append(fields, apikey.FieldSubject)
+	}
+	if m.role != nil {
+		fields = append(fields, apikey.FieldRole)
+	}
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
+	}
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case apikey.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldSubject:
+		return m.Subject()
+	case apikey.FieldRole:
+		return m.Role()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
+	case apikey.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case apikey.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldSubject:
+		return m.OldSubject(ctx)
+	case apikey.FieldRole:
+		return m.OldRole(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case apikey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case apikey.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case apikey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case apikey.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case apikey.FieldRole:
+		v, ok := value.(apikey.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case apikey.FieldKeyHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyHash(v)
+		return nil
+	case apikey.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case apikey.FieldRevokedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case apikey.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *APIKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.addrevoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case apikey.FieldLastUsedAt:
+		return m.AddedLastUsedAt()
+	case apikey.FieldRevokedAt:
+		return m.AddedRevokedAt()
+	case apikey.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastUsedAt(v)
+		return nil
+	case apikey.FieldRevokedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevokedAt(v)
+		return nil
+	case apikey.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *APIKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *APIKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *APIKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *APIKeyMutation) ResetField(name string) error {
+	switch name {
+	case apikey.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case apikey.FieldName:
+		m.ResetName()
+		return nil
+	case apikey.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case apikey.FieldRole:
+		m.ResetRole()
+		return nil
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
+		return nil
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case apikey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *APIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *APIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *APIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *APIKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *APIKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown APIKey edge %s", name)
+}
+
+// AccessLogMutation represents an operation that mutates the AccessLog nodes in the graph.
+type AccessLogMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	workspace_id      *uuid.UUID
+	subject           *string
+	method            *string
+	role              *string
+	endpoint          *string
+	query             *string
+	chat_ids          *[]string
+	appendchat_ids    []string
+	message_ids       *[]uuid.UUID
+	appendmessage_ids []uuid.UUID
+	created_at        *int64
+	addcreated_at     *int64
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*AccessLog, error)
+	predicates        []predicate.AccessLog
+}
+
+var _ ent.Mutation = (*AccessLogMutation)(nil)
+
+// accesslogOption allows management of the mutation configuration using functional options.
+type accesslogOption func(*AccessLogMutation)
+
+// newAccessLogMutation creates new mutation for the AccessLog entity.
+func newAccessLogMutation(c config, op Op, opts ...accesslogOption) *AccessLogMutation {
+	m := &AccessLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAccessLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAccessLogID sets the ID field of the mutation.
+func withAccessLogID(id uuid.UUID) accesslogOption {
+	return func(m *AccessLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AccessLog
+		)
+		m.oldValue = func(ctx context.Context) (*AccessLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AccessLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAccessLog sets the old AccessLog of the mutation.
+func withAccessLog(node *AccessLog) accesslogOption {
+	return func(m *AccessLogMutation) {
+		m.oldValue = func(context.Context) (*AccessLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AccessLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AccessLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AccessLog entities.
+func (m *AccessLogMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AccessLogMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AccessLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AccessLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *AccessLogMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *AccessLogMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *AccessLogMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *AccessLogMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *AccessLogMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *AccessLogMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetMethod sets the "method" field.
+func (m *AccessLogMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *AccessLogMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *AccessLogMutation) ResetMethod() {
+	m.method = nil
+}
+
+// SetRole sets the "role" field.
+func (m *AccessLogMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *AccessLogMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *AccessLogMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (m *AccessLogMutation) SetEndpoint(s string) {
+	m.endpoint = &s
+}
+
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *AccessLogMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndpoint returns the old "endpoint" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+	}
+	return oldValue.Endpoint, nil
+}
+
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *AccessLogMutation) ResetEndpoint() {
+	m.endpoint = nil
+}
+
+// SetQuery sets the "query" field.
+func (m *AccessLogMutation) SetQuery(s string) {
+	m.query = &s
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *AccessLogMutation) Query() (r string, exists bool) {
+	v := m.query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuery returns the old "query" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
+}
+
+// ResetQuery resets all changes to the "query" field.
+func (m *AccessLogMutation) ResetQuery() {
+	m.query = nil
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (m *AccessLogMutation) SetChatIds(s []string) {
+	m.chat_ids = &s
+	m.appendchat_ids = nil
+}
+
+// ChatIds returns the value of the "chat_ids" field in the mutation.
+func (m *AccessLogMutation) ChatIds() (r []string, exists bool) {
+	v := m.chat_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatIds returns the old "chat_ids" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldChatIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatIds: %w", err)
+	}
+	return oldValue.ChatIds, nil
+}
+
+// AppendChatIds adds s to the "chat_ids" field.
+func (m *AccessLogMutation) AppendChatIds(s []string) {
+	m.appendchat_ids = append(m.appendchat_ids, s...)
+}
+
+// AppendedChatIds returns the list of values that were appended to the "chat_ids" field in this mutation.
+func (m *AccessLogMutation) AppendedChatIds() ([]string, bool) {
+	if len(m.appendchat_ids) == 0 {
+		return nil, false
+	}
+	return m.appendchat_ids, true
+}
+
+// ResetChatIds resets all changes to the "chat_ids" field.
+func (m *AccessLogMutation) ResetChatIds() {
+	m.chat_ids = nil
+	m.appendchat_ids = nil
+}
+
+// SetMessageIds sets the "message_ids" field.
+func (m *AccessLogMutation) SetMessageIds(u []uuid.UUID) {
+	m.message_ids = &u
+	m.appendmessage_ids = nil
+}
+
+// MessageIds returns the value of the "message_ids" field in the mutation.
+func (m *AccessLogMutation) MessageIds() (r []uuid.UUID, exists bool) {
+	v := m.message_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageIds returns the old "message_ids" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldMessageIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageIds: %w", err)
+	}
+	return oldValue.MessageIds, nil
+}
+
+// AppendMessageIds adds u to the "message_ids" field.
+func (m *AccessLogMutation) AppendMessageIds(u []uuid.UUID) {
+	m.appendmessage_ids = append(m.appendmessage_ids, u...)
+}
+
+// AppendedMessageIds returns the list of values that were appended to the "message_ids" field in this mutation.
+func (m *AccessLogMutation) AppendedMessageIds() ([]uuid.UUID, bool) {
+	if len(m.appendmessage_ids) == 0 {
+		return nil, false
+	}
+	return m.appendmessage_ids, true
+}
+
+// ResetMessageIds resets all changes to the "message_ids" field.
+func (m *AccessLogMutation) ResetMessageIds() {
+	m.message_ids = nil
+	m.appendmessage_ids = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AccessLogMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AccessLogMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AccessLog entity.
+// If the AccessLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessLogMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *AccessLogMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *AccessLogMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AccessLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// Where appends a list predicates to the AccessLogMutation builder.
+func (m *AccessLogMutation) Where(ps ...predicate.AccessLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AccessLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AccessLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AccessLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AccessLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AccessLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AccessLog).
+func (m *AccessLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AccessLogMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.workspace_id != nil {
+		fields = append(fields, accesslog.FieldWorkspaceID)
+	}
+	if m.subject != nil {
+		fields = append(fields, accesslog.FieldSubject)
+	}
+	if m.method != nil {
+		fields = append(fields, accesslog.FieldMethod)
+	}
+	if m.role != nil {
+		fields = append(fields, accesslog.FieldRole)
+	}
+	if m.endpoint != nil {
+		fields = append(fields, accesslog.FieldEndpoint)
+	}
+	if m.query != nil {
+		fields = append(fields, accesslog.FieldQuery)
+	}
+	if m.chat_ids != nil {
+		fields = append(fields, accesslog.FieldChatIds)
+	}
+	if m.message_ids != nil {
+		fields = append(fields, accesslog.FieldMessageIds)
+	}
+	if m.created_at != nil {
+		fields = append(fields, accesslog.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AccessLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case accesslog.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case accesslog.FieldSubject:
+		return m.Subject()
+	case accesslog.FieldMethod:
+		return m.Method()
+	case accesslog.FieldRole:
+		return m.Role()
+	case accesslog.FieldEndpoint:
+		return m.Endpoint()
+	case accesslog.FieldQuery:
+		return m.Query()
+	case accesslog.FieldChatIds:
+		return m.ChatIds()
+	case accesslog.FieldMessageIds:
+		return m.MessageIds()
+	case accesslog.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AccessLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case accesslog.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case accesslog.FieldSubject:
+		return m.OldSubject(ctx)
+	case accesslog.FieldMethod:
+		return m.OldMethod(ctx)
+	case accesslog.FieldRole:
+		return m.OldRole(ctx)
+	case accesslog.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case accesslog.FieldQuery:
+		return m.OldQuery(ctx)
+	case accesslog.FieldChatIds:
+		return m.OldChatIds(ctx)
+	case accesslog.FieldMessageIds:
+		return m.OldMessageIds(ctx)
+	case accesslog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AccessLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccessLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case accesslog.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case accesslog.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case accesslog.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case accesslog.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case accesslog.FieldEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpoint(v)
+		return nil
+	case accesslog.FieldQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuery(v)
+		return nil
+	case accesslog.FieldChatIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatIds(v)
+		return nil
+	case accesslog.FieldMessageIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageIds(v)
+		return nil
+	case accesslog.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AccessLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AccessLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, accesslog.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AccessLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case accesslog.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccessLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case accesslog.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AccessLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AccessLogMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AccessLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AccessLogMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AccessLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AccessLogMutation) ResetField(name string) error {
+	switch name {
+	case accesslog.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case accesslog.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case accesslog.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case accesslog.FieldRole:
+		m.ResetRole()
+		return nil
+	case accesslog.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case accesslog.FieldQuery:
+		m.ResetQuery()
+		return nil
+	case accesslog.FieldChatIds:
+		m.ResetChatIds()
+		return nil
+	case accesslog.FieldMessageIds:
+		m.ResetMessageIds()
+		return nil
+	case accesslog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AccessLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AccessLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AccessLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AccessLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AccessLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AccessLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AccessLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AccessLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AccessLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AccessLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AccessLog edge %s", name)
+}
+
+// AskTurnMutation represents an operation that mutates the AskTurn nodes in the graph.
+type AskTurnMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	workspace_id            *uuid.UUID
+	conversation_id         *uuid.UUID
+	subject                 *string
+	question                *string
+	standalone_question     *string
+	answer                  *string
+	refused                 *bool
+	cited_message_ids       *[]uuid.UUID
+	appendcited_message_ids []uuid.UUID
+	event_ids               *[]uuid.UUID
+	appendevent_ids         []uuid.UUID
+	model                   *string
+	created_at              *int64
+	addcreated_at           *int64
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*AskTurn, error)
+	predicates              []predicate.AskTurn
+}
+
+var _ ent.Mutation = (*AskTurnMutation)(nil)
+
+// askturnOption allows management of the mutation configuration using functional options.
+type askturnOption func(*AskTurnMutation)
+
+// newAskTurnMutation creates new mutation for the AskTurn entity.
+func newAskTurnMutation(c config, op Op, opts ...askturnOption) *AskTurnMutation {
+	m := &AskTurnMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAskTurn,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAskTurnID sets the ID field of the mutation.
+func withAskTurnID(id uuid.UUID) askturnOption {
+	return func(m *AskTurnMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AskTurn
+		)
+		m.oldValue = func(ctx context.Context) (*AskTurn, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AskTurn.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAskTurn sets the old AskTurn of the mutation.
+func withAskTurn(node *AskTurn) askturnOption {
+	return func(m *AskTurnMutation) {
+		m.oldValue = func(context.Context) (*AskTurn, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AskTurnMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AskTurnMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AskTurn entities.
+func (m *AskTurnMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AskTurnMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AskTurnMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AskTurn.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *AskTurnMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *AskTurnMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *AskTurnMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (m *AskTurnMutation) SetConversationID(u uuid.UUID) {
+	m.conversation_id = &u
+}
+
+// ConversationID returns the value of the "conversation_id" field in the mutation.
+func (m *AskTurnMutation) ConversationID() (r uuid.UUID, exists bool) {
+	v := m.conversation_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConversationID returns the old "conversation_id" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldConversationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConversationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConversationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConversationID: %w", err)
+	}
+	return oldValue.ConversationID, nil
+}
+
+// ResetConversationID resets all changes to the "conversation_id" field.
+func (m *AskTurnMutation) ResetConversationID() {
+	m.conversation_id = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *AskTurnMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *AskTurnMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *AskTurnMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetQuestion sets the "question" field.
+func (m *AskTurnMutation) SetQuestion(s string) {
+	m.question = &s
+}
+
+// Question returns the value of the "question" field in the mutation.
+func (m *AskTurnMutation) Question() (r string, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestion returns the old "question" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+	}
+	return oldValue.Question, nil
+}
+
+// ResetQuestion resets all changes to the "question" field.
+func (m *AskTurnMutation) ResetQuestion() {
+	m.question = nil
+}
+
+// SetStandaloneQuestion sets the "standalone_question" field.
+func (m *AskTurnMutation) SetStandaloneQuestion(s string) {
+	m.standalone_question = &s
+}
+
+// StandaloneQuestion returns the value of the "standalone_question" field in the mutation.
+func (m *AskTurnMutation) StandaloneQuestion() (r string, exists bool) {
+	v := m.standalone_question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStandaloneQuestion returns the old "standalone_question" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldStandaloneQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStandaloneQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStandaloneQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStandaloneQuestion: %w", err)
+	}
+	return oldValue.StandaloneQuestion, nil
+}
+
+// ResetStandaloneQuestion resets all changes to the "standalone_question" field.
+func (m *AskTurnMutation) ResetStandaloneQuestion() {
+	m.standalone_question = nil
+}
+
+// SetAnswer sets the "answer" field.
+func (m *AskTurnMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *AskTurnMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *AskTurnMutation) ResetAnswer() {
+	m.answer = nil
+}
+
+// SetRefused sets the "refused" field.
+func (m *AskTurnMutation) SetRefused(b bool) {
+	m.refused = &b
+}
+
+// Refused returns the value of the "refused" field in the mutation.
+func (m *AskTurnMutation) Refused() (r bool, exists bool) {
+	v := m.refused
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefused returns the old "refused" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldRefused(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefused is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefused requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefused: %w", err)
+	}
+	return oldValue.Refused, nil
+}
+
+// ResetRefused resets all changes to the "refused" field.
+func (m *AskTurnMutation) ResetRefused() {
+	m.refused = nil
+}
+
+// SetCitedMessageIds sets the "cited_message_ids" field.
+func (m *AskTurnMutation) SetCitedMessageIds(u []uuid.UUID) {
+	m.cited_message_ids = &u
+	m.appendcited_message_ids = nil
+}
+
+// CitedMessageIds returns the value of the "cited_message_ids" field in the mutation.
+func (m *AskTurnMutation) CitedMessageIds() (r []uuid.UUID, exists bool) {
+	v := m.cited_message_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCitedMessageIds returns the old "cited_message_ids" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldCitedMessageIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCitedMessageIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCitedMessageIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCitedMessageIds: %w", err)
+	}
+	return oldValue.CitedMessageIds, nil
+}
+
+// AppendCitedMessageIds adds u to the "cited_message_ids" field.
+func (m *AskTurnMutation) AppendCitedMessageIds(u []uuid.UUID) {
+	m.appendcited_message_ids = append(m.appendcited_message_ids, u...)
+}
+
+// AppendedCitedMessageIds returns the list of values that were appended to the "cited_message_ids" field in this mutation.
+func (m *AskTurnMutation) AppendedCitedMessageIds() ([]uuid.UUID, bool) {
+	if len(m.appendcited_message_ids) == 0 {
+		return nil, false
+	}
+	return m.appendcited_message_ids, true
+}
+
+// ResetCitedMessageIds resets all changes to the "cited_message_ids" field.
+func (m *AskTurnMutation) ResetCitedMessageIds() {
+	m.cited_message_ids = nil
+	m.appendcited_message_ids = nil
+}
+
+// SetEventIds sets the "event_ids" field.
+func (m *AskTurnMutation) SetEventIds(u []uuid.UUID) {
+	m.event_ids = &u
+	m.appendevent_ids = nil
+}
+
+// EventIds returns the value of the "event_ids" field in the mutation.
+func (m *AskTurnMutation) EventIds() (r []uuid.UUID, exists bool) {
+	v := m.event_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventIds returns the old "event_ids" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldEventIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventIds: %w", err)
+	}
+	return oldValue.EventIds, nil
+}
+
+// AppendEventIds adds u to the "event_ids" field.
+func (m *AskTurnMutation) AppendEventIds(u []uuid.UUID) {
+	m.appendevent_ids = append(m.appendevent_ids, u...)
+}
+
+// AppendedEventIds returns the list of values that were appended to the "event_ids" field in this mutation.
+func (m *AskTurnMutation) AppendedEventIds() ([]uuid.UUID, bool) {
+	if len(m.appendevent_ids) == 0 {
+		return nil, false
+	}
+	return m.appendevent_ids, true
+}
+
+// ResetEventIds resets all changes to the "event_ids" field.
+func (m *AskTurnMutation) ResetEventIds() {
+	m.event_ids = nil
+	m.appendevent_ids = nil
+}
+
+// SetModel sets the "model" field.
+func (m *AskTurnMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *AskTurnMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *AskTurnMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AskTurnMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AskTurnMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AskTurn entity.
+// If the AskTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AskTurnMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *AskTurnMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *AskTurnMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AskTurnMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// Where appends a list predicates to the AskTurnMutation builder.
+func (m *AskTurnMutation) Where(ps ...predicate.AskTurn) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AskTurnMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AskTurnMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AskTurn, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AskTurnMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AskTurnMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AskTurn).
+func (m *AskTurnMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AskTurnMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.workspace_id != nil {
+		fields = append(fields, askturn.FieldWorkspaceID)
+	}
+	if m.conversation_id != nil {
+		fields = append(fields, askturn.FieldConversationID)
+	}
+	if m.subject != nil {
+		fields = append(fields, askturn.FieldSubject)
+	}
+	if m.question != nil {
+		fields = append(fields, askturn.FieldQuestion)
+	}
+	if m.standalone_question != nil {
+		fields = append(fields, askturn.FieldStandaloneQuestion)
+	}
+	if m.answer != nil {
+		fields = append(fields, askturn.FieldAnswer)
+	}
+	if m.refused != nil {
+		fields = append(fields, askturn.FieldRefused)
+	}
+	if m.cited_message_ids != nil {
+		fields = append(fields, askturn.FieldCitedMessageIds)
+	}
+	if m.event_ids != nil {
+		fields = append(fields, askturn.FieldEventIds)
+	}
+	if m.model != nil {
+		fields = append(fields, askturn.FieldModel)
+	}
+	if m.created_at != nil {
+		fields = append(fields, askturn.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AskTurnMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case askturn.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case askturn.FieldConversationID:
+		return m.ConversationID()
+	case askturn.FieldSubject:
+		return m.Subject()
+	case askturn.FieldQuestion:
+		return m.Question()
+	case askturn.FieldStandaloneQuestion:
+		return m.StandaloneQuestion()
+	case askturn.FieldAnswer:
+		return m.Answer()
+	case askturn.FieldRefused:
+		return m.Refused()
+	case askturn.FieldCitedMessageIds:
+		return m.CitedMessageIds()
+	case askturn.FieldEventIds:
+		return m.EventIds()
+	case askturn.FieldModel:
+		return m.Model()
+	case askturn.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AskTurnMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case askturn.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case askturn.FieldConversationID:
+		return m.OldConversationID(ctx)
+	case askturn.FieldSubject:
+		return m.OldSubject(ctx)
+	case askturn.FieldQuestion:
+		return m.OldQuestion(ctx)
+	case askturn.FieldStandaloneQuestion:
+		return m.OldStandaloneQuestion(ctx)
+	case askturn.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case askturn.FieldRefused:
+		return m.OldRefused(ctx)
+	case askturn.FieldCitedMessageIds:
+		return m.OldCitedMessageIds(ctx)
+	case askturn.FieldEventIds:
+		return m.OldEventIds(ctx)
+	case askturn.FieldModel:
+		return m.OldModel(ctx)
+	case askturn.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AskTurn field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AskTurnMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case askturn.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case askturn.FieldConversationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConversationID(v)
+		return nil
+	case askturn.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case askturn.FieldQuestion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestion(v)
+		return nil
+	case askturn.FieldStandaloneQuestion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStandaloneQuestion(v)
+		return nil
+	case askturn.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	case askturn.FieldRefused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefused(v)
+		return nil
+	case askturn.FieldCitedMessageIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCitedMessageIds(v)
+		return nil
+	case askturn.FieldEventIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventIds(v)
+		return nil
+	case askturn.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case askturn.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AskTurn field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AskTurnMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, askturn.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AskTurnMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case askturn.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AskTurnMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case askturn.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AskTurn numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AskTurnMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AskTurnMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AskTurnMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AskTurn nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AskTurnMutation) ResetField(name string) error {
+	switch name {
+	case askturn.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case askturn.FieldConversationID:
+		m.ResetConversationID()
+		return nil
+	case askturn.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case askturn.FieldQuestion:
+		m.ResetQuestion()
+		return nil
+	case askturn.FieldStandaloneQuestion:
+		m.ResetStandaloneQuestion()
+		return nil
+	case askturn.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case askturn.FieldRefused:
+		m.ResetRefused()
+		return nil
+	case askturn.FieldCitedMessageIds:
+		m.ResetCitedMessageIds()
+		return nil
+	case askturn.FieldEventIds:
+		m.ResetEventIds()
+		return nil
+	case askturn.FieldModel:
+		m.ResetModel()
+		return nil
+	case askturn.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AskTurn field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AskTurnMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AskTurnMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AskTurnMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AskTurnMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AskTurnMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AskTurnMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AskTurnMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AskTurn unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AskTurnMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AskTurn edge %s", name)
+}
+
+// ChatGrantMutation represents an operation that mutates the ChatGrant nodes in the graph.
+type ChatGrantMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	workspace_id  *uuid.UUID
+	subject       *string
+	chat_id       *string
+	role          *chatgrant.Role
+	granted_by    *string
+	created_at    *int64
+	addcreated_at *int64
+	updated_at    *int64
+	addupdated_at *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ChatGrant, error)
+	predicates    []predicate.ChatGrant
+}
+
+var _ ent.Mutation = (*ChatGrantMutation)(nil)
+
+// chatgrantOption allows management of the mutation configuration using functional options.
+type chatgrantOption func(*ChatGrantMutation)
+
+// newChatGrantMutation creates new mutation for the ChatGrant entity.
+func newChatGrantMutation(c config, op Op, opts ...chatgrantOption) *ChatGrantMutation {
+	m := &ChatGrantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChatGrant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChatGrantID sets the ID field of the mutation.
+func withChatGrantID(id uuid.UUID) chatgrantOption {
+	return func(m *ChatGrantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChatGrant
+		)
+		m.oldValue = func(ctx context.Context) (*ChatGrant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChatGrant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChatGrant sets the old ChatGrant of the mutation.
+func withChatGrant(node *ChatGrant) chatgrantOption {
+	return func(m *ChatGrantMutation) {
+		m.oldValue = func(context.Context) (*ChatGrant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChatGrantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChatGrantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChatGrant entities.
+func (m *ChatGrantMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChatGrantMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChatGrantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChatGrant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *ChatGrantMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *ChatGrantMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the ChatGrant entity.
+// If the ChatGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatGrantMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *ChatGrantMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *ChatGrantMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *ChatGrantMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the ChatGrant entity.
+// If the ChatGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatGrantMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *ChatGrantMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *ChatGrantMutation) SetChatID(s string) {
+	m.chat_id = &s
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *ChatGrantMutation) ChatID() (r string, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the ChatGrant entity.
+// If the ChatGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatGrantMutation) OldChatID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *ChatGrantMutation) ResetChatID() {
+	m.chat_id = nil
+}
+
+// SetRole sets the "role" field.
+func (m *ChatGrantMutation) SetRole(c chatgrant.Role) {
+	m.role = &c
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *ChatGrantMutation) Role() (r chatgrant.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the ChatGrant entity.
+// If the ChatGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatGrantMutation) OldRole(ctx context.Context) (v chatgrant.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *ChatGrantMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (m *ChatGrantMutation) SetGrantedBy(s string) {
+	m.granted_by = &s
+}
+
+// GrantedBy returns the value of the "granted_by" field in the mutation.
+func (m *ChatGrantMutation) GrantedBy() (r string, exists bool) {
+	v := m.granted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantedBy returns the old "granted_by" field's value of the ChatGrant entity.
+// If the ChatGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatGrantMutation) OldGrantedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantedBy: %w", err)
+	}
+	return oldValue.GrantedBy, nil
+}
+
+// ResetGrantedBy resets all changes to the "granted_by" field.
+func (m *ChatGrantMutation) ResetGrantedBy() {
+	m.granted_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChatGrantMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChatGrantMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ChatGrant entity.
+// If the ChatGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatGrantMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *ChatGrantMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ChatGrantMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChatGrantMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ChatGrantMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ChatGrantMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ChatGrant entity.
+// If the ChatGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatGrantMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *ChatGrantMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *ChatGrantMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ChatGrantMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the ChatGrantMutation builder.
+func (m *ChatGrantMutation) Where(ps ...predicate.ChatGrant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChatGrantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChatGrantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatGrant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChatGrantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChatGrantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChatGrant).
+func (m *ChatGrantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChatGrantMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.workspace_id != nil {
+		fields = append(fields, chatgrant.FieldWorkspaceID)
+	}
+	if m.subject != nil {
+		fields = append(fields, chatgrant.FieldSubject)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, chatgrant.FieldChatID)
+	}
+	if m.role != nil {
+		fields = append(fields, chatgrant.FieldRole)
+	}
+	if m.granted_by != nil {
+		fields = append(fields, chatgrant.FieldGrantedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, chatgrant.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, chatgrant.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChatGrantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chatgrant.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case chatgrant.FieldSubject:
+		return m.Subject()
+	case chatgrant.FieldChatID:
+		return m.ChatID()
+	case chatgrant.FieldRole:
+		return m.Role()
+	case chatgrant.FieldGrantedBy:
+		return m.GrantedBy()
+	case chatgrant.FieldCreatedAt:
+		return m.CreatedAt()
+	case chatgrant.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChatGrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chatgrant.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case chatgrant.FieldSubject:
+		return m.OldSubject(ctx)
+	case chatgrant.FieldChatID:
+		return m.OldChatID(ctx)
+	case chatgrant.FieldRole:
+		return m.OldRole(ctx)
+	case chatgrant.FieldGrantedBy:
+		return m.OldGrantedBy(ctx)
+	case chatgrant.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case chatgrant.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChatGrant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatGrantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chatgrant.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case chatgrant.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case chatgrant.FieldChatID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case chatgrant.FieldRole:
+		v, ok := value.(chatgrant.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case chatgrant.FieldGrantedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantedBy(v)
+		return nil
+	case chatgrant.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case chatgrant.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatGrant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChatGrantMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, chatgrant.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, chatgrant.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChatGrantMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chatgrant.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case chatgrant.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatGrantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chatgrant.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case chatgrant.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatGrant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChatGrantMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChatGrantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChatGrantMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChatGrant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChatGrantMutation) ResetField(name string) error {
+	switch name {
+	case chatgrant.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case chatgrant.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case chatgrant.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case chatgrant.FieldRole:
+		m.ResetRole()
+		return nil
+	case chatgrant.FieldGrantedBy:
+		m.ResetGrantedBy()
+		return nil
+	case chatgrant.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case chatgrant.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ChatGrant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChatGrantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChatGrantMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChatGrantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChatGrantMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChatGrantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChatGrantMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChatGrantMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChatGrant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChatGrantMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChatGrant edge %s", name)
+}
+
+// ChatMessageMutation represents an operation that mutates the ChatMessage nodes in the graph.
+type ChatMessageMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	workspace_id          *uuid.UUID
+	platform              *string
+	platform_message_id   *string
+	from_id               *string
+	from_name             *string
+	from_user_uuid        *uuid.UUID
+	owner_account_id      *uuid.UUID
+	in_chat_id            *string
+	in_chat_type          *string
+	content               *string
+	is_reply              *bool
+	reply_to_name         *string
+	reply_to_id           *string
+	platform_timestamp    *int64
+	addplatform_timestamp *int64
+	content_vector_1536   *pgvector.Vector
+	content_vector_1024   *pgvector.Vector
+	content_vector_768    *pgvector.Vector
+	jieba_tokens          *[]string
+	appendjieba_tokens    []string
+	tokenized_at          *int64
+	addtokenized_at       *int64
+	created_at            *int64
+	addcreated_at         *int64
+	updated_at            *int64
+	addupdated_at         *int64
+	deleted_at            *int64
+	adddeleted_at         *int64
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*ChatMessage, error)
+	predicates            []predicate.ChatMessage
+}
+
+var _ ent.Mutation = (*ChatMessageMutation)(nil)
+
+// chatmessageOption allows management of the mutation configuration using functional options.
+type chatmessageOption func(*ChatMessageMutation)
+
+// newChatMessageMutation creates new mutation for the ChatMessage entity.
+func newChatMessageMutation(c config, op Op, opts ...chatmessageOption) *ChatMessageMutation {
+	m := &ChatMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChatMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChatMessageID sets the ID field of the mutation.
+func withChatMessageID(id uuid.UUID) chatmessageOption {
+	return func(m *ChatMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChatMessage
+		)
+		m.oldValue = func(ctx context.Context) (*ChatMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChatMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChatMessage sets the old ChatMessage of the mutation.
+func withChatMessage(node *ChatMessage) chatmessageOption {
+	return func(m *ChatMessageMutation) {
+		m.oldValue = func(context.Context) (*ChatMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChatMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChatMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChatMessage entities.
+func (m *ChatMessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChatMessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChatMessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChatMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *ChatMessageMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *ChatMessageMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *ChatMessageMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetPlatform sets the "platform" field.
+func (m *ChatMessageMutation) SetPlatform(s string) {
+	m.platform = &s
+}
+
+// Platform returns the value of the "platform" field in the mutation.
+func (m *ChatMessageMutation) Platform() (r string, exists bool) {
+	v := m.platform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatform returns the old "platform" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldPlatform(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+	}
+	return oldValue.Platform, nil
+}
+
+// ResetPlatform resets all changes to the "platform" field.
+func (m *ChatMessageMutation) ResetPlatform() {
+	m.platform = nil
+}
+
+// SetPlatformMessageID sets the "platform_message_id" field.
+func (m *ChatMessageMutation) SetPlatformMessageID(s string) {
+	m.platform_message_id = &s
+}
+
+// PlatformMessageID returns the value of the "platform_message_id" field in the mutation.
+func (m *ChatMessageMutation) PlatformMessageID() (r string, exists bool) {
+	v := m.platform_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatformMessageID returns the old "platform_message_id" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldPlatformMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatformMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatformMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatformMessageID: %w", err)
+	}
+	return oldValue.PlatformMessageID, nil
+}
+
+// ResetPlatformMessageID resets all changes to the "platform_message_id" field.
+func (m *ChatMessageMutation) ResetPlatformMessageID() {
+	m.platform_message_id = nil
+}
+
+// SetFromID sets the "from_id" field.
+func (m *ChatMessageMutation) SetFromID(s string) {
+	m.from_id = &s
+}
+
+// FromID returns the value of the "from_id" field in the mutation.
+func (m *ChatMessageMutation) FromID() (r string, exists bool) {
+	v := m.from_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromID returns the old "from_id" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldFromID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromID: %w", err)
+	}
+	return oldValue.FromID, nil
+}
+
+// ResetFromID resets all changes to the "from_id" field.
+func (m *ChatMessageMutation) ResetFromID() {
+	m.from_id = nil
+}
+
+// SetFromName sets the "from_name" field.
+func (m *ChatMessageMutation) SetFromName(s string) {
+	m.from_name = &s
+}
+
+// FromName returns the value of the "from_name" field in the mutation.
+func (m *ChatMessageMutation) FromName() (r string, exists bool) {
+	v := m.from_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromName returns the old "from_name" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldFromName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromName: %w", err)
+	}
+	return oldValue.FromName, nil
+}
+
+// ResetFromName resets all changes to the "from_name" field.
+func (m *ChatMessageMutation) ResetFromName() {
+	m.from_name = nil
+}
+
+// SetFromUserUUID sets the "from_user_uuid" field.
+func (m *ChatMessageMutation) SetFromUserUUID(u uuid.UUID) {
+	m.from_user_uuid = &u
+}
+
+// FromUserUUID returns the value of the "from_user_uuid" field in the mutation.
+func (m *ChatMessageMutation) FromUserUUID() (r uuid.UUID, exists bool) {
+	v := m.from_user_uuid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromUserUUID returns the old "from_user_uuid" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldFromUserUUID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromUserUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromUserUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromUserUUID: %w", err)
+	}
+	return oldValue.FromUserUUID, nil
+}
+
+// ClearFromUserUUID clears the value of the "from_user_uuid" field.
+func (m *ChatMessageMutation) ClearFromUserUUID() {
+	m.from_user_uuid = nil
+	m.clearedFields[chatmessage.FieldFromUserUUID] = struct{}{}
+}
+
+// FromUserUUIDCleared returns if the "from_user_uuid" field was cleared in this mutation.
+func (m *ChatMessageMutation) FromUserUUIDCleared() bool {
+	_, ok := m.clearedFields[chatmessage.FieldFromUserUUID]
+	return ok
+}
+
+// ResetFromUserUUID resets all changes to the "from_user_uuid" field.
+func (m *ChatMessageMutation) ResetFromUserUUID() {
+	m.from_user_uuid = nil
+	delete(m.clearedFields, chatmessage.FieldFromUserUUID)
+}
+
+// SetOwnerAccountID sets the "owner_account_id" field.
+func (m *ChatMessageMutation) SetOwnerAccountID(u uuid.UUID) {
+	m.owner_account_id = &u
+}
+
+// OwnerAccountID returns the value of the "owner_account_id" field in the mutation.
+func (m *ChatMessageMutation) OwnerAccountID() (r uuid.UUID, exists bool) {
+	v := m.owner_account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerAccountID returns the old "owner_account_id" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldOwnerAccountID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerAccountID: %w", err)
+	}
+	return oldValue.OwnerAccountID, nil
+}
+
+// ClearOwnerAccountID clears the value of the "owner_account_id" field.
+func (m *ChatMessageMutation) ClearOwnerAccountID() {
+	m.owner_account_id = nil
+	m.clearedFields[chatmessage.FieldOwnerAccountID] = struct{}{}
+}
+
+// OwnerAccountIDCleared returns if the "owner_account_id" field was cleared in this mutation.
+func (m *ChatMessageMutation) OwnerAccountIDCleared() bool {
+	_, ok := m.clearedFields[chatmessage.FieldOwnerAccountID]
+	return ok
+}
+
+// ResetOwnerAccountID resets all changes to the "owner_account_id" field.
+func (m *ChatMessageMutation) ResetOwnerAccountID() {
+	m.owner_account_id = nil
+	delete(m.clearedFields, chatmessage.FieldOwnerAccountID)
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (m *ChatMessageMutation) SetInChatID(s string) {
+	m.in_chat_id = &s
+}
+
+// InChatID returns the value of the "in_chat_id" field in the mutation.
+func (m *ChatMessageMutation) InChatID() (r string, exists bool) {
+	v := m.in_chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInChatID returns the old "in_chat_id" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldInChatID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInChatID: %w", err)
+	}
+	return oldValue.InChatID, nil
+}
+
+// ResetInChatID resets all changes to the "in_chat_id" field.
+func (m *ChatMessageMutation) ResetInChatID() {
+	m.in_chat_id = nil
+}
+
+// SetInChatType sets the "in_chat_type" field.
+func (m *ChatMessageMutation) SetInChatType(s string) {
+	m.in_chat_type = &s
+}
+
+// InChatType returns the value of the "in_chat_type" field in the mutation.
+func (m *ChatMessageMutation) InChatType() (r string, exists bool) {
+	v := m.in_chat_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInChatType returns the old "in_chat_type" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldInChatType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInChatType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInChatType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInChatType: %w", err)
+	}
+	return oldValue.InChatType, nil
+}
+
+// ResetInChatType resets all changes to the "in_chat_type" field.
+func (m *ChatMessageMutation) ResetInChatType() {
+	m.in_chat_type = nil
+}
+
+// SetContent sets the "content" field.
+func (m *ChatMessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ChatMessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ChatMessageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetIsReply sets the "is_reply" field.
+func (m *ChatMessageMutation) SetIsReply(b bool) {
+	m.is_reply = &b
+}
+
+// IsReply returns the value of the "is_reply" field in the mutation.
+func (m *ChatMessageMutation) IsReply() (r bool, exists bool) {
+	v := m.is_reply
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsReply returns the old "is_reply" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldIsReply(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsReply is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsReply requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsReply: %w", err)
+	}
+	return oldValue.IsReply, nil
+}
+
+// ResetIsReply resets all changes to the "is_reply" field.
+func (m *ChatMessageMutation) ResetIsReply() {
+	m.is_reply = nil
+}
+
+// SetReplyToName sets the "reply_to_name" field.
+func (m *ChatMessageMutation) SetReplyToName(s string) {
+	m.reply_to_name = &s
+}
+
+// ReplyToName returns the value of the "reply_to_name" field in the mutation.
+func (m *ChatMessageMutation) ReplyToName() (r string, exists bool) {
+	v := m.reply_to_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToName returns the old "reply_to_name" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldReplyToName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToName: %w", err)
+	}
+	return oldValue.ReplyToName, nil
+}
+
+// ResetReplyToName resets all changes to the "reply_to_name" field.
+func (m *ChatMessageMutation) ResetReplyToName() {
+	m.reply_to_name = nil
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (m *ChatMessageMutation) SetReplyToID(s string) {
+	m.reply_to_id = &s
+}
+
+// ReplyToID returns the value of the "reply_to_id" field in the mutation.
+func (m *ChatMessageMutation) ReplyToID() (r string, exists bool) {
+	v := m.reply_to_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToID returns the old "reply_to_id" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldReplyToID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToID: %w", err)
+	}
+	return oldValue.ReplyToID, nil
+}
+
+// ResetReplyToID resets all changes to the "reply_to_id" field.
+func (m *ChatMessageMutation) ResetReplyToID() {
+	m.reply_to_id = nil
+}
+
+// SetPlatformTimestamp sets the "platform_timestamp" field.
+func (m *ChatMessageMutation) SetPlatformTimestamp(i int64) {
+	m.platform_timestamp = &i
+	m.addplatform_timestamp = nil
+}
+
+// PlatformTimestamp returns the value of the "platform_timestamp" field in the mutation.
+func (m *ChatMessageMutation) PlatformTimestamp() (r int64, exists bool) {
+	v := m.platform_timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatformTimestamp returns the old "platform_timestamp" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldPlatformTimestamp(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatformTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatformTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatformTimestamp: %w", err)
+	}
+	return oldValue.PlatformTimestamp, nil
+}
+
+// AddPlatformTimestamp adds i to the "platform_timestamp" field.
+func (m *ChatMessageMutation) AddPlatformTimestamp(i int64) {
+	if m.addplatform_timestamp != nil {
+		*m.addplatform_timestamp += i
+	} else {
+		m.addplatform_timestamp = &i
+	}
+}
+
+// AddedPlatformTimestamp returns the value that was added to the "platform_timestamp" field in this mutation.
+func (m *ChatMessageMutation) AddedPlatformTimestamp() (r int64, exists bool) {
+	v := m.addplatform_timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPlatformTimestamp resets all changes to the "platform_timestamp" field.
+func (m *ChatMessageMutation) ResetPlatformTimestamp() {
+	m.platform_timestamp = nil
+	m.addplatform_timestamp = nil
+}
+
+// SetContentVector1536 sets the "content_vector_1536" field.
+func (m *ChatMessageMutation) SetContentVector1536(pg pgvector.Vector) {
+	m.content_vector_1536 = &pg
+}
+
+// ContentVector1536 returns the value of the "content_vector_1536" field in the mutation.
+func (m *ChatMessageMutation) ContentVector1536() (r pgvector.Vector, exists bool) {
+	v := m.content_vector_1536
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentVector1536 returns the old "content_vector_1536" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldContentVector1536(ctx context.Context) (v *pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentVector1536 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentVector1536 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentVector1536: %w", err)
+	}
+	return oldValue.ContentVector1536, nil
+}
+
+// ResetContentVector1536 resets all changes to the "content_vector_1536" field.
+func (m *ChatMessageMutation) ResetContentVector1536() {
+	m.content_vector_1536 = nil
+}
+
+// SetContentVector1024 sets the "content_vector_1024" field.
+func (m *ChatMessageMutation) SetContentVector1024(pg pgvector.Vector) {
+	m.content_vector_1024 = &pg
+}
+
+// ContentVector1024 returns the value of the "content_vector_1024" field in the mutation.
+func (m *ChatMessageMutation) ContentVector1024() (r pgvector.Vector, exists bool) {
+	v := m.content_vector_1024
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentVector1024 returns the old "content_vector_1024" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldContentVector1024(ctx context.Context) (v *pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentVector1024 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentVector1024 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentVector1024: %w", err)
+	}
+	return oldValue.ContentVector1024, nil
+}
+
+// ResetContentVector1024 resets all changes to the "content_vector_1024" field.
+func (m *ChatMessageMutation) ResetContentVector1024() {
+	m.content_vector_1024 = nil
+}
+
+// SetContentVector768 sets the "content_vector_768" field.
+func (m *ChatMessageMutation) SetContentVector768(pg pgvector.Vector) {
+	m.content_vector_768 = &pg
+}
+
+// ContentVector768 returns the value of the "content_vector_768" field in the mutation.
+func (m *ChatMessageMutation) ContentVector768() (r pgvector.Vector, exists bool) {
+	v := m.content_vector_768
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentVector768 returns the old "content_vector_768" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldContentVector768(ctx context.Context) (v *pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentVector768 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentVector768 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentVector768: %w", err)
+	}
+	return oldValue.ContentVector768, nil
+}
+
+// ResetContentVector768 resets all changes to the "content_vector_768" field.
+func (m *ChatMessageMutation) ResetContentVector768() {
+	m.content_vector_768 = nil
+}
+
+// SetJiebaTokens sets the "jieba_tokens" field.
+func (m *ChatMessageMutation) SetJiebaTokens(s []string) {
+	m.jieba_tokens = &s
+	m.appendjieba_tokens = nil
+}
+
+// JiebaTokens returns the value of the "jieba_tokens" field in the mutation.
+func (m *ChatMessageMutation) JiebaTokens() (r []string, exists bool) {
+	v := m.jieba_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJiebaTokens returns the old "jieba_tokens" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldJiebaTokens(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJiebaTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJiebaTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJiebaTokens: %w", err)
+	}
+	return oldValue.JiebaTokens, nil
+}
+
+// AppendJiebaTokens adds s to the "jieba_tokens" field.
+func (m *ChatMessageMutation) AppendJiebaTokens(s []string) {
+	m.appendjieba_tokens = append(m.appendjieba_tokens, s...)
+}
+
+// AppendedJiebaTokens returns the list of values that were appended to the "jieba_tokens" field in this mutation.
+func (m *ChatMessageMutation) AppendedJiebaTokens() ([]string, bool) {
+	if len(m.appendjieba_tokens) == 0 {
+		return nil, false
+	}
+	return m.appendjieba_tokens, true
+}
+
+// ResetJiebaTokens resets all changes to the "jieba_tokens" field.
+func (m *ChatMessageMutation) ResetJiebaTokens() {
+	m.jieba_tokens = nil
+	m.appendjieba_tokens = nil
+}
+
+// SetTokenizedAt sets the "tokenized_at" field.
+func (m *ChatMessageMutation) SetTokenizedAt(i int64) {
+	m.tokenized_at = &i
+	m.addtokenized_at = nil
+}
+
+// TokenizedAt returns the value of the "tokenized_at" field in the mutation.
+func (m *ChatMessageMutation) TokenizedAt() (r int64, exists bool) {
+	v := m.tokenized_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenizedAt returns the old "tokenized_at" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldTokenizedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenizedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenizedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenizedAt: %w", err)
+	}
+	return oldValue.TokenizedAt, nil
+}
+
+// AddTokenizedAt adds i to the "tokenized_at" field.
+func (m *ChatMessageMutation) AddTokenizedAt(i int64) {
+	if m.addtokenized_at != nil {
+		*m.addtokenized_at += i
+	} else {
+		m.addtokenized_at = &i
+	}
+}
+
+// AddedTokenizedAt returns the value that was added to the "tokenized_at" field in this mutation.
+func (m *ChatMessageMutation) AddedTokenizedAt() (r int64, exists bool) {
+	v := m.addtokenized_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTokenizedAt resets all changes to the "tokenized_at" field.
+func (m *ChatMessageMutation) ResetTokenizedAt() {
+	m.tokenized_at = nil
+	m.addtokenized_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChatMessageMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChatMessageMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *ChatMessageMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ChatMessageMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChatMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ChatMessageMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ChatMessageMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *ChatMessageMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *ChatMessageMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ChatMessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ChatMessageMutation) SetDeletedAt(i int64) {
+	m.deleted_at = &i
+	m.adddeleted_at = nil
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ChatMessageMutation) DeletedAt() (r int64, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldDeletedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (m *ChatMessageMutation) AddDeletedAt(i int64) {
+	if m.adddeleted_at != nil {
+		*m.adddeleted_at += i
+	} else {
+		m.adddeleted_at = &i
+	}
+}
+
+// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
+func (m *ChatMessageMutation) AddedDeletedAt() (r int64, exists bool) {
+	v := m.adddeleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ChatMessageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	m.adddeleted_at = nil
+}
+
+// Where appends a list predicates to the ChatMessageMutation builder.
+func (m *ChatMessageMutation) Where(ps ...predicate.ChatMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChatMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChatMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChatMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChatMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChatMessage).
+func (m *ChatMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChatMessageMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.workspace_id != nil {
+		fields = append(fields, chatmessage.FieldWorkspaceID)
+	}
+	if m.platform != nil {
+		fields = append(fields, chatmessage.FieldPlatform)
+	}
+	if m.platform_message_id != nil {
+		fields = append(fields, chatmessage.FieldPlatformMessageID)
+	}
+	if m.from_id != nil {
+		fields = append(fields, chatmessage.FieldFromID)
+	}
+	if m.from_name != nil {
+		fields = append(fields, chatmessage.FieldFromName)
+	}
+	if m.from_user_uuid != nil {
+		fields = append(fields, chatmessage.FieldFromUserUUID)
+	}
+	if m.owner_account_id != nil {
+		fields = append(fields, chatmessage.FieldOwnerAccountID)
+	}
+	if m.in_chat_id != nil {
+		fields = append(fields, chatmessage.FieldInChatID)
+	}
+	if m.in_chat_type != nil {
+		fields = append(fields, chatmessage.FieldInChatType)
+	}
+	if m.content != nil {
+		fields = append(fields, chatmessage.FieldContent)
+	}
+	if m.is_reply != nil {
+		fields = append(fields, chatmessage.FieldIsReply)
+	}
+	if m.reply_to_name != nil {
+		fields = append(fields, chatmessage.FieldReplyToName)
+	}
+	if m.reply_to_id != nil {
+		fields = append(fields, chatmessage.FieldReplyToID)
+	}
+	if m.platform_timestamp != nil {
+		fields = append(fields, chatmessage.FieldPlatformTimestamp)
+	}
+	if m.content_vector_1536 != nil {
+		fields = append(fields, chatmessage.FieldContentVector1536)
+	}
+	if m.content_vector_1024 != nil {
+		fields = append(fields, chatmessage.FieldContentVector1024)
+	}
+	if m.content_vector_768 != nil {
+		fields = append(fields, chatmessage.FieldContentVector768)
+	}
+	if m.jieba_tokens != nil {
+		fields = append(fields, chatmessage.FieldJiebaTokens)
+	}
+	if m.tokenized_at != nil {
+		fields = append(fields, chatmessage.FieldTokenizedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, chatmessage.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, chatmessage.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, chatmessage.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChatMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chatmessage.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case chatmessage.FieldPlatform:
+		return m.Platform()
+	case chatmessage.FieldPlatformMessageID:
+		return m.PlatformMessageID()
+	case chatmessage.FieldFromID:
+		return m.FromID()
+	case chatmessage.FieldFromName:
+		return m.FromName()
+	case chatmessage.FieldFromUserUUID:
+		return m.FromUserUUID()
+	case chatmessage.FieldOwnerAccountID:
+		return m.OwnerAccountID()
+	case chatmessage.FieldInChatID:
+		return m.InChatID()
+	case chatmessage.FieldInChatType:
+		return m.InChatType()
+	case chatmessage.FieldContent:
+		return m.Content()
+	case chatmessage.FieldIsReply:
+		return m.IsReply()
+	case chatmessage.FieldReplyToName:
+		return m.ReplyToName()
+	case chatmessage.FieldReplyToID:
+		return m.ReplyToID()
+	case chatmessage.FieldPlatformTimestamp:
+		return m.PlatformTimestamp()
+	case chatmessage.FieldContentVector1536:
+		return m.ContentVector1536()
+	case chatmessage.FieldContentVector1024:
+		return m.ContentVector1024()
+	case chatmessage.FieldContentVector768:
+		return m.ContentVector768()
+	case chatmessage.FieldJiebaTokens:
+		return m.JiebaTokens()
+	case chatmessage.FieldTokenizedAt:
+		return m.TokenizedAt()
+	case chatmessage.FieldCreatedAt:
+		return m.CreatedAt()
+	case chatmessage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case chatmessage.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChatMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chatmessage.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case chatmessage.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case chatmessage.FieldPlatformMessageID:
+		return m.OldPlatformMessageID(ctx)
+	case chatmessage.FieldFromID:
+		return m.OldFromID(ctx)
+	case chatmessage.FieldFromName:
+		return m.OldFromName(ctx)
+	case chatmessage.FieldFromUserUUID:
+		return m.OldFromUserUUID(ctx)
+	case chatmessage.FieldOwnerAccountID:
+		return m.OldOwnerAccountID(ctx)
+	case chatmessage.FieldInChatID:
+		return m.OldInChatID(ctx)
+	case chatmessage.FieldInChatType:
+		return m.OldInChatType(ctx)
+	case chatmessage.FieldContent:
+		return m.OldContent(ctx)
+	case chatmessage.FieldIsReply:
+		return m.OldIsReply(ctx)
+	case chatmessage.FieldReplyToName:
+		return m.OldReplyToName(ctx)
+	case chatmessage.FieldReplyToID:
+		return m.OldReplyToID(ctx)
+	case chatmessage.FieldPlatformTimestamp:
+		return m.OldPlatformTimestamp(ctx)
+	case chatmessage.FieldContentVector1536:
+		return m.OldContentVector1536(ctx)
+	case chatmessage.FieldContentVector1024:
+		return m.OldContentVector1024(ctx)
+	case chatmessage.FieldContentVector768:
+		return m.OldContentVector768(ctx)
+	case chatmessage.FieldJiebaTokens:
+		return m.OldJiebaTokens(ctx)
+	case chatmessage.FieldTokenizedAt:
+		return m.OldTokenizedAt(ctx)
+	case chatmessage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case chatmessage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case chatmessage.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChatMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chatmessage.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case chatmessage.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case chatmessage.FieldPlatformMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatformMessageID(v)
+		return nil
+	case chatmessage.FieldFromID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromID(v)
+		return nil
+	case chatmessage.FieldFromName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromName(v)
+		return nil
+	case chatmessage.FieldFromUserUUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromUserUUID(v)
+		return nil
+	case chatmessage.FieldOwnerAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerAccountID(v)
+		return nil
+	case chatmessage.FieldInChatID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInChatID(v)
+		return nil
+	case chatmessage.FieldInChatType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInChatType(v)
+		return nil
+	case chatmessage.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case chatmessage.FieldIsReply:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsReply(v)
+		return nil
+	case chatmessage.FieldReplyToName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToName(v)
+		return nil
+	case chatmessage.FieldReplyToID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToID(v)
+		return nil
+	case chatmessage.FieldPlatformTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatformTimestamp(v)
+		return nil
+	case chatmessage.FieldContentVector1536:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentVector1536(v)
+		return nil
+	case chatmessage.FieldContentVector1024:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentVector1024(v)
+		return nil
+	case chatmessage.FieldContentVector768:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentVector768(v)
+		return nil
+	case chatmessage.FieldJiebaTokens:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJiebaTokens(v)
+		return nil
+	case chatmessage.FieldTokenizedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenizedAt(v)
+		return nil
+	case chatmessage.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case chatmessage.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case chatmessage.FieldDeletedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChatMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addplatform_timestamp != nil {
+		fields = append(fields, chatmessage.FieldPlatformTimestamp)
+	}
+	if m.addtokenized_at != nil {
+		fields = append(fields, chatmessage.FieldTokenizedAt)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, chatmessage.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, chatmessage.FieldUpdatedAt)
+	}
+	if m.adddeleted_at != nil {
+		fields = append(fields, chatmessage.FieldDeletedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChatMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chatmessage.FieldPlatformTimestamp:
+		return m.AddedPlatformTimestamp()
+	case chatmessage.FieldTokenizedAt:
+		return m.AddedTokenizedAt()
+	case chatmessage.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case chatmessage.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	case chatmessage.FieldDeletedAt:
+		return m.AddedDeletedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chatmessage.FieldPlatformTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlatformTimestamp(v)
+		return nil
+	case chatmessage.FieldTokenizedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenizedAt(v)
+		return nil
+	case chatmessage.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case chatmessage.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	case chatmessage.FieldDeletedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChatMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(chatmessage.FieldFromUserUUID) {
+		fields = append(fields, chatmessage.FieldFromUserUUID)
+	}
+	if m.FieldCleared(chatmessage.FieldOwnerAccountID) {
+		fields = append(fields, chatmessage.FieldOwnerAccountID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChatMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChatMessageMutation) ClearField(name string) error {
+	switch name {
+	case chatmessage.FieldFromUserUUID:
+		m.ClearFromUserUUID()
+		return nil
+	case chatmessage.FieldOwnerAccountID:
+		m.ClearOwnerAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown ChatMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChatMessageMutation) ResetField(name string) error {
+	switch name {
+	case chatmessage.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case chatmessage.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case chatmessage.FieldPlatformMessageID:
+		m.ResetPlatformMessageID()
+		return nil
+	case chatmessage.FieldFromID:
+		m.ResetFromID()
+		return nil
+	case chatmessage.FieldFromName:
+		m.ResetFromName()
+		return nil
+	case chatmessage.FieldFromUserUUID:
+		m.ResetFromUserUUID()
+		return nil
+	case chatmessage.FieldOwnerAccountID:
+		m.ResetOwnerAccountID()
+		return nil
+	case chatmessage.FieldInChatID:
+		m.ResetInChatID()
+		return nil
+	case chatmessage.FieldInChatType:
+		m.ResetInChatType()
+		return nil
+	case chatmessage.FieldContent:
+		m.ResetContent()
+		return nil
+	case chatmessage.FieldIsReply:
+		m.ResetIsReply()
+		return nil
+	case chatmessage.FieldReplyToName:
+		m.ResetReplyToName()
+		return nil
+	case chatmessage.FieldReplyToID:
+		m.ResetReplyToID()
+		return nil
+	case chatmessage.FieldPlatformTimestamp:
+		m.ResetPlatformTimestamp()
+		return nil
+	case chatmessage.FieldContentVector1536:
+		m.ResetContentVector1536()
+		return nil
+	case chatmessage.FieldContentVector1024:
+		m.ResetContentVector1024()
+		return nil
+	case chatmessage.FieldContentVector768:
+		m.ResetContentVector768()
+		return nil
+	case chatmessage.FieldJiebaTokens:
+		m.ResetJiebaTokens()
+		return nil
+	case chatmessage.FieldTokenizedAt:
+		m.ResetTokenizedAt()
+		return nil
+	case chatmessage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case chatmessage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case chatmessage.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ChatMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChatMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChatMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChatMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChatMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChatMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChatMessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChatMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChatMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChatMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChatMessage edge %s", name)
+}
+
+// ChatRetentionMutation represents an operation that mutates the ChatRetention nodes in the graph.
+type ChatRetentionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	workspace_id   *uuid.UUID
+	chat_id        *string
+	days           *int
+	adddays        *int
+	mode           *chatretention.Mode
+	enabled        *bool
+	last_run_at    *int64
+	addlast_run_at *int64
+	created_at     *int64
+	addcreated_at  *int64
+	updated_at     *int64
+	addupdated_at  *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ChatRetention, error)
+	predicates     []predicate.ChatRetention
+}
+
+var _ ent.Mutation = (*ChatRetentionMutation)(nil)
+
+// chatretentionOption allows management of the mutation configuration using functional options.
+type chatretentionOption func(*ChatRetentionMutation)
+
+// newChatRetentionMutation creates new mutation for the ChatRetention entity.
+func newChatRetentionMutation(c config, op Op, opts ...chatretentionOption) *ChatRetentionMutation {
+	m := &ChatRetentionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChatRetention,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChatRetentionID sets the ID field of the mutation.
+func withChatRetentionID(id uuid.UUID) chatretentionOption {
+	return func(m *ChatRetentionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChatRetention
+		)
+		m.oldValue = func(ctx context.Context) (*ChatRetention, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChatRetention.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChatRetention sets the old ChatRetention of the mutation.
+func withChatRetention(node *ChatRetention) chatretentionOption {
+	return func(m *ChatRetentionMutation) {
+		m.oldValue = func(context.Context) (*ChatRetention, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChatRetentionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChatRetentionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChatRetention entities.
+func (m *ChatRetentionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChatRetentionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChatRetentionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChatRetention.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *ChatRetentionMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *ChatRetentionMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the ChatRetention entity.
+// If the ChatRetention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRetentionMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *ChatRetentionMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *ChatRetentionMutation) SetChatID(s string) {
+	m.chat_id = &s
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *ChatRetentionMutation) ChatID() (r string, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the ChatRetention entity.
+// If the ChatRetention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRetentionMutation) OldChatID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *ChatRetentionMutation) ResetChatID() {
+	m.chat_id = nil
+}
+
+// SetDays sets the "days" field.
+func (m *ChatRetentionMutation) SetDays(i int) {
+	m.days = &i
+	m.adddays = nil
+}
+
+// Days returns the value of the "days" field in the mutation.
+func (m *ChatRetentionMutation) Days() (r int, exists bool) {
+	v := m.days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDays returns the old "days" field's value of the ChatRetention entity.
+// If the ChatRetention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRetentionMutation) OldDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDays: %w", err)
+	}
+	return oldValue.Days, nil
+}
+
+// AddDays adds i to the "days" field.
+func (m *ChatRetentionMutation) AddDays(i int) {
+	if m.adddays != nil {
+		*m.adddays += i
+	} else {
+		m.adddays = &i
+	}
+}
+
+// AddedDays returns the value that was added to the "days" field in this mutation.
+func (m *ChatRetentionMutation) AddedDays() (r int, exists bool) {
+	v := m.adddays
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDays resets all changes to the "days" field.
+func (m *ChatRetentionMutation) ResetDays() {
+	m.days = nil
+	m.adddays = nil
+}
+
+// SetMode sets the "mode" field.
+func (m *ChatRetentionMutation) SetMode(c chatretention.Mode) {
+	m.mode = &c
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *ChatRetentionMutation) Mode() (r chatretention.Mode, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the ChatRetention entity.
+// If the ChatRetention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRetentionMutation) OldMode(ctx context.Context) (v chatretention.Mode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *ChatRetentionMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ChatRetentionMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ChatRetentionMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the ChatRetention entity.
+// If the ChatRetention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRetentionMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ChatRetentionMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (m *ChatRetentionMutation) SetLastRunAt(i int64) {
+	m.last_run_at = &i
+	m.addlast_run_at = nil
+}
+
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *ChatRetentionMutation) LastRunAt() (r int64, exists bool) {
+	v := m.last_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunAt returns the old "last_run_at" field's value of the ChatRetention entity.
+// If the ChatRetention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRetentionMutation) OldLastRunAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	}
+	return oldValue.LastRunAt, nil
+}
+
+// AddLastRunAt adds i to the "last_run_at" field.
+func (m *ChatRetentionMutation) AddLastRunAt(i int64) {
+	if m.addlast_run_at != nil {
+		*m.addlast_run_at += i
+	} else {
+		m.addlast_run_at = &i
+	}
+}
+
+// AddedLastRunAt returns the value that was added to the "last_run_at" field in this mutation.
+func (m *ChatRetentionMutation) AddedLastRunAt() (r int64, exists bool) {
+	v := m.addlast_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *ChatRetentionMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	m.addlast_run_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChatRetentionMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChatRetentionMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ChatRetention entity.
+// If the ChatRetention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRetentionMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *ChatRetentionMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ChatRetentionMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChatRetentionMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ChatRetentionMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ChatRetentionMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ChatRetention entity.
+// If the ChatRetention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRetentionMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *ChatRetentionMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *ChatRetentionMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ChatRetentionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the ChatRetentionMutation builder.
+func (m *ChatRetentionMutation) Where(ps ...predicate.ChatRetention) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChatRetentionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChatRetentionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatRetention, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChatRetentionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChatRetentionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChatRetention).
+func (m *ChatRetentionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChatRetentionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.workspace_id != nil {
+		fields = append(fields, chatretention.FieldWorkspaceID)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, chatretention.FieldChatID)
+	}
+	if m.days != nil {
+		fields = append(fields, chatretention.FieldDays)
+	}
+	if m.mode != nil {
+		fields = append(fields, chatretention.FieldMode)
+	}
+	if m.enabled != nil {
+		fields = append(fields, chatretention.FieldEnabled)
+	}
+	if m.last_run_at != nil {
+		fields = append(fields, chatretention.FieldLastRunAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, chatretention.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, chatretention.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChatRetentionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chatretention.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case chatretention.FieldChatID:
+		return m.ChatID()
+	case chatretention.FieldDays:
+		return m.Days()
+	case chatretention.FieldMode:
+		return m.Mode()
+	case chatretention.FieldEnabled:
+		return m.Enabled()
+	case chatretention.FieldLastRunAt:
+		return m.LastRunAt()
+	case chatretention.FieldCreatedAt:
+		return m.CreatedAt()
+	case chatretention.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChatRetentionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chatretention.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case chatretention.FieldChatID:
+		return m.OldChatID(ctx)
+	case chatretention.FieldDays:
+		return m.OldDays(ctx)
+	case chatretention.FieldMode:
+		return m.OldMode(ctx)
+	case chatretention.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case chatretention.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	case chatretention.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case chatretention.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChatRetention field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatRetentionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chatretention.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case chatretention.FieldChatID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case chatretention.FieldDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDays(v)
+		return nil
+	case chatretention.FieldMode:
+		v, ok := value.(chatretention.Mode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case chatretention.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case chatretention.FieldLastRunAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunAt(v)
+		return nil
+	case chatretention.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case chatretention.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatRetention field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChatRetentionMutation) AddedFields() []string {
+	var fields []string
+	if m.adddays != nil {
+		fields = append(fields, chatretention.FieldDays)
+	}
+	if m.addlast_run_at != nil {
+		fields = append(fields, chatretention.FieldLastRunAt)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, chatretention.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, chatretention.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChatRetentionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chatretention.FieldDays:
+		return m.AddedDays()
+	case chatretention.FieldLastRunAt:
+		return m.AddedLastRunAt()
+	case chatretention.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case chatretention.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatRetentionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chatretention.FieldDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDays(v)
+		return nil
+	case chatretention.FieldLastRunAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastRunAt(v)
+		return nil
+	case chatretention.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case chatretention.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatRetention numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChatRetentionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChatRetentionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChatRetentionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChatRetention nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChatRetentionMutation) ResetField(name string) error {
+	switch name {
+	case chatretention.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case chatretention.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case chatretention.FieldDays:
+		m.ResetDays()
+		return nil
+	case chatretention.FieldMode:
+		m.ResetMode()
+		return nil
+	case chatretention.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case chatretention.FieldLastRunAt:
+		m.ResetLastRunAt()
+		return nil
+	case chatretention.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case chatretention.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ChatRetention field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChatRetentionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChatRetentionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChatRetentionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChatRetentionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChatRetentionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChatRetentionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChatRetentionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChatRetention unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChatRetentionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChatRetention edge %s", name)
+}
+
+// ChatScheduleMutation represents an operation that mutates the ChatSchedule nodes in the graph.
+type ChatScheduleMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	workspace_id       *uuid.UUID
+	chat_id            *string
+	cron               *string
+	enabled            *bool
+	last_window_end    *int64
+	addlast_window_end *int64
+	created_at         *int64
+	addcreated_at      *int64
+	updated_at         *int64
+	addupdated_at      *int64
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ChatSchedule, error)
+	predicates         []predicate.ChatSchedule
+}
+
+var _ ent.Mutation = (*ChatScheduleMutation)(nil)
+
+// chatscheduleOption allows management of the mutation configuration using functional options.
+type chatscheduleOption func(*ChatScheduleMutation)
+
+// newChatScheduleMutation creates new mutation for the ChatSchedule entity.
+func newChatScheduleMutation(c config, op Op, opts ...chatscheduleOption) *ChatScheduleMutation {
+	m := &ChatScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChatSchedule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChatScheduleID sets the ID field of the mutation.
+func withChatScheduleID(id uuid.UUID) chatscheduleOption {
+	return func(m *ChatScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChatSchedule
+		)
+		m.oldValue = func(ctx context.Context) (*ChatSchedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChatSchedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChatSchedule sets the old ChatSchedule of the mutation.
+func withChatSchedule(node *ChatSchedule) chatscheduleOption {
+	return func(m *ChatScheduleMutation) {
+		m.oldValue = func(context.Context) (*ChatSchedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChatScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChatScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChatSchedule entities.
+func (m *ChatScheduleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChatScheduleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChatScheduleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChatSchedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *ChatScheduleMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *ChatScheduleMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the ChatSchedule entity.
+// If the ChatSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatScheduleMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *ChatScheduleMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *ChatScheduleMutation) SetChatID(s string) {
+	m.chat_id = &s
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *ChatScheduleMutation) ChatID() (r string, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the ChatSchedule entity.
+// If the ChatSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatScheduleMutation) OldChatID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *ChatScheduleMutation) ResetChatID() {
+	m.chat_id = nil
+}
+
+// SetCron sets the "cron" field.
+func (m *ChatScheduleMutation) SetCron(s string) {
+	m.cron = &s
+}
+
+// Cron returns the value of the "cron" field in the mutation.
+func (m *ChatScheduleMutation) Cron() (r string, exists bool) {
+	v := m.cron
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCron returns the old "cron" field's value of the ChatSchedule entity.
+// If the ChatSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatScheduleMutation) OldCron(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCron is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCron requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCron: %w", err)
+	}
+	return oldValue.Cron, nil
+}
+
+// ResetCron resets all changes to the "cron" field.
+func (m *ChatScheduleMutation) ResetCron() {
+	m.cron = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ChatScheduleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ChatScheduleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the ChatSchedule entity.
+// If the ChatSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatScheduleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ChatScheduleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetLastWindowEnd sets the "last_window_end" field.
+func (m *ChatScheduleMutation) SetLastWindowEnd(i int64) {
+	m.last_window_end = &i
+	m.addlast_window_end = nil
+}
+
+// LastWindowEnd returns the value of the "last_window_end" field in the mutation.
+func (m *ChatScheduleMutation) LastWindowEnd() (r int64, exists bool) {
+	v := m.last_window_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastWindowEnd returns the old "last_window_end" field's value of the ChatSchedule entity.
+// If the ChatSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatScheduleMutation) OldLastWindowEnd(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastWindowEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastWindowEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastWindowEnd: %w", err)
+	}
+	return oldValue.LastWindowEnd, nil
+}
+
+// AddLastWindowEnd adds i to the "last_window_end" field.
+func (m *ChatScheduleMutation) AddLastWindowEnd(i int64) {
+	if m.addlast_window_end != nil {
+		*m.addlast_window_end += i
+	} else {
+		m.addlast_window_end = &i
+	}
+}
+
+// AddedLastWindowEnd returns the value that was added to the "last_window_end" field in this mutation.
+func (m *ChatScheduleMutation) AddedLastWindowEnd() (r int64, exists bool) {
+	v := m.addlast_window_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastWindowEnd resets all changes to the "last_window_end" field.
+func (m *ChatScheduleMutation) ResetLastWindowEnd() {
+	m.last_window_end = nil
+	m.addlast_window_end = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChatScheduleMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChatScheduleMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ChatSchedule entity.
+// If the ChatSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatScheduleMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *ChatScheduleMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ChatScheduleMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChatScheduleMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ChatScheduleMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ChatScheduleMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ChatSchedule entity.
+// If the ChatSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatScheduleMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *ChatScheduleMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *ChatScheduleMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ChatScheduleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the ChatScheduleMutation builder.
+func (m *ChatScheduleMutation) Where(ps ...predicate.ChatSchedule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChatScheduleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChatScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatSchedule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChatScheduleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChatScheduleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChatSchedule).
+func (m *ChatScheduleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChatScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.workspace_id != nil {
+		fields = append(fields, chatschedule.FieldWorkspaceID)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, chatschedule.FieldChatID)
+	}
+	if m.cron != nil {
+		fields = append(fields, chatschedule.FieldCron)
+	}
+	if m.enabled != nil {
+		fields = append(fields, chatschedule.FieldEnabled)
+	}
+	if m.last_window_end != nil {
+		fields = append(fields, chatschedule.FieldLastWindowEnd)
+	}
+	if m.created_at != nil {
+		fields = append(fields, chatschedule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, chatschedule.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChatScheduleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chatschedule.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case chatschedule.FieldChatID:
+		return m.ChatID()
+	case chatschedule.FieldCron:
+		return m.Cron()
+	case chatschedule.FieldEnabled:
+		return m.Enabled()
+	case chatschedule.FieldLastWindowEnd:
+		return m.LastWindowEnd()
+	case chatschedule.FieldCreatedAt:
+		return m.CreatedAt()
+	case chatschedule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChatScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chatschedule.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case chatschedule.FieldChatID:
+		return m.OldChatID(ctx)
+	case chatschedule.FieldCron:
+		return m.OldCron(ctx)
+	case chatschedule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case chatschedule.FieldLastWindowEnd:
+		return m.OldLastWindowEnd(ctx)
+	case chatschedule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case chatschedule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChatSchedule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatScheduleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chatschedule.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case chatschedule.FieldChatID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case chatschedule.FieldCron:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCron(v)
+		return nil
+	case chatschedule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case chatschedule.FieldLastWindowEnd:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastWindowEnd(v)
+		return nil
+	case chatschedule.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case chatschedule.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatSchedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChatScheduleMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_window_end != nil {
+		fields = append(fields, chatschedule.FieldLastWindowEnd)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, chatschedule.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, chatschedule.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChatScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chatschedule.FieldLastWindowEnd:
+		return m.AddedLastWindowEnd()
+	case chatschedule.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case chatschedule.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chatschedule.FieldLastWindowEnd:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastWindowEnd(v)
+		return nil
+	case chatschedule.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case chatschedule.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatSchedule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChatScheduleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChatScheduleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChatScheduleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChatSchedule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChatScheduleMutation) ResetField(name string) error {
+	switch name {
+	case chatschedule.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case chatschedule.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case chatschedule.FieldCron:
+		m.ResetCron()
+		return nil
+	case chatschedule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case chatschedule.FieldLastWindowEnd:
+		m.ResetLastWindowEnd()
+		return nil
+	case chatschedule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case chatschedule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ChatSchedule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChatScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChatScheduleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChatScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChatScheduleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChatScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChatScheduleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChatScheduleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChatSchedule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChatScheduleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChatSchedule edge %s", name)
+}
+
+// DigestDeliveryMutation represents an operation that mutates the DigestDelivery nodes in the graph.
+type DigestDeliveryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	workspace_id   *uuid.UUID
+	publisher      *string
+	chat_id        *string
+	digest_key     *string
+	status         *digestdelivery.Status
+	parts_total    *int
+	addparts_total *int
+	parts_sent     *int
+	addparts_sent  *int
+	error          *string
+	sent_at        *int64
+	addsent_at     *int64
+	created_at     *int64
+	addcreated_at  *int64
+	updated_at     *int64
+	addupdated_at  *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*DigestDelivery, error)
+	predicates     []predicate.DigestDelivery
+}
+
+var _ ent.Mutation = (*DigestDeliveryMutation)(nil)
+
+// digestdeliveryOption allows management of the mutation configuration using functional options.
+type digestdeliveryOption func(*DigestDeliveryMutation)
+
+// newDigestDeliveryMutation creates new mutation for the DigestDelivery entity.
+func newDigestDeliveryMutation(c config, op Op, opts ...digestdeliveryOption) *DigestDeliveryMutation {
+	m := &DigestDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDigestDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDigestDeliveryID sets the ID field of the mutation.
+func withDigestDeliveryID(id uuid.UUID) digestdeliveryOption {
+	return func(m *DigestDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DigestDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*DigestDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DigestDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDigestDelivery sets the old DigestDelivery of the mutation.
+func withDigestDelivery(node *DigestDelivery) digestdeliveryOption {
+	return func(m *DigestDeliveryMutation) {
+		m.oldValue = func(context.Context) (*DigestDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DigestDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DigestDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DigestDelivery entities.
+func (m *DigestDeliveryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DigestDeliveryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DigestDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DigestDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *DigestDeliveryMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *DigestDeliveryMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *DigestDeliveryMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetPublisher sets the "publisher" field.
+func (m *DigestDeliveryMutation) SetPublisher(s string) {
+	m.publisher = &s
+}
+
+// Publisher returns the value of the "publisher" field in the mutation.
+func (m *DigestDeliveryMutation) Publisher() (r string, exists bool) {
+	v := m.publisher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublisher returns the old "publisher" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldPublisher(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublisher is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublisher requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublisher: %w", err)
+	}
+	return oldValue.Publisher, nil
+}
+
+// ResetPublisher resets all changes to the "publisher" field.
+func (m *DigestDeliveryMutation) ResetPublisher() {
+	m.publisher = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *DigestDeliveryMutation) SetChatID(s string) {
+	m.chat_id = &s
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *DigestDeliveryMutation) ChatID() (r string, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldChatID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *DigestDeliveryMutation) ResetChatID() {
+	m.chat_id = nil
+}
+
+// SetDigestKey sets the "digest_key" field.
+func (m *DigestDeliveryMutation) SetDigestKey(s string) {
+	m.digest_key = &s
+}
+
+// DigestKey returns the value of the "digest_key" field in the mutation.
+func (m *DigestDeliveryMutation) DigestKey() (r string, exists bool) {
+	v := m.digest_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDigestKey returns the old "digest_key" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldDigestKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDigestKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDigestKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDigestKey: %w", err)
+	}
+	return oldValue.DigestKey, nil
+}
+
+// ResetDigestKey resets all changes to the "digest_key" field.
+func (m *DigestDeliveryMutation) ResetDigestKey() {
+	m.digest_key = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *DigestDeliveryMutation) SetStatus(d digestdelivery.Status) {
+	m.status = &d
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DigestDeliveryMutation) Status() (r digestdelivery.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldStatus(ctx context.Context) (v digestdelivery.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DigestDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPartsTotal sets the "parts_total" field.
+func (m *DigestDeliveryMutation) SetPartsTotal(i int) {
+	m.parts_total = &i
+	m.addparts_total = nil
+}
+
+// PartsTotal returns the value of the "parts_total" field in the mutation.
+func (m *DigestDeliveryMutation) PartsTotal() (r int, exists bool) {
+	v := m.parts_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPartsTotal returns the old "parts_total" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldPartsTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPartsTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPartsTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPartsTotal: %w", err)
+	}
+	return oldValue.PartsTotal, nil
+}
+
+// AddPartsTotal adds i to the "parts_total" field.
+func (m *DigestDeliveryMutation) AddPartsTotal(i int) {
+	if m.addparts_total != nil {
+		*m.addparts_total += i
+	} else {
+		m.addparts_total = &i
+	}
+}
+
+// AddedPartsTotal returns the value that was added to the "parts_total" field in this mutation.
+func (m *DigestDeliveryMutation) AddedPartsTotal() (r int, exists bool) {
+	v := m.addparts_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPartsTotal resets all changes to the "parts_total" field.
+func (m *DigestDeliveryMutation) ResetPartsTotal() {
+	m.parts_total = nil
+	m.addparts_total = nil
+}
+
+// SetPartsSent sets the "parts_sent" field.
+func (m *DigestDeliveryMutation) SetPartsSent(i int) {
+	m.parts_sent = &i
+	m.addparts_sent = nil
+}
+
+// PartsSent returns the value of the "parts_sent" field in the mutation.
+func (m *DigestDeliveryMutation) PartsSent() (r int, exists bool) {
+	v := m.parts_sent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPartsSent returns the old "parts_sent" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldPartsSent(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPartsSent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPartsSent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPartsSent: %w", err)
+	}
+	return oldValue.PartsSent, nil
+}
+
+// AddPartsSent adds i to the "parts_sent" field.
+func (m *DigestDeliveryMutation) AddPartsSent(i int) {
+	if m.addparts_sent != nil {
+		*m.addparts_sent += i
+	} else {
+		m.addparts_sent = &i
+	}
+}
+
+// AddedPartsSent returns the value that was added to the "parts_sent" field in this mutation.
+func (m *DigestDeliveryMutation) AddedPartsSent() (r int, exists bool) {
+	v := m.addparts_sent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPartsSent resets all changes to the "parts_sent" field.
+func (m *DigestDeliveryMutation) ResetPartsSent() {
+	m.parts_sent = nil
+	m.addparts_sent = nil
+}
+
+// SetError sets the "error" field.
+func (m *DigestDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *DigestDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *DigestDeliveryMutation) ResetError() {
+	m.error = nil
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *DigestDeliveryMutation) SetSentAt(i int64) {
+	m.sent_at = &i
+	m.addsent_at = nil
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *DigestDeliveryMutation) SentAt() (r int64, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldSentAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// AddSentAt adds i to the "sent_at" field.
+func (m *DigestDeliveryMutation) AddSentAt(i int64) {
+	if m.addsent_at != nil {
+		*m.addsent_at += i
+	} else {
+		m.addsent_at = &i
+	}
+}
+
+// AddedSentAt returns the value that was added to the "sent_at" field in this mutation.
+func (m *DigestDeliveryMutation) AddedSentAt() (r int64, exists bool) {
+	v := m.addsent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *DigestDeliveryMutation) ResetSentAt() {
+	m.sent_at = nil
+	m.addsent_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DigestDeliveryMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DigestDeliveryMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *DigestDeliveryMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *DigestDeliveryMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DigestDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DigestDeliveryMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DigestDeliveryMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DigestDelivery entity.
+// If the DigestDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DigestDeliveryMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *DigestDeliveryMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *DigestDeliveryMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DigestDeliveryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the DigestDeliveryMutation builder.
+func (m *DigestDeliveryMutation) Where(ps ...predicate.DigestDelivery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DigestDeliveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DigestDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DigestDelivery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DigestDeliveryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DigestDeliveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DigestDelivery).
+func (m *DigestDeliveryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DigestDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.workspace_id != nil {
+		fields = append(fields, digestdelivery.FieldWorkspaceID)
+	}
+	if m.publisher != nil {
+		fields = append(fields, digestdelivery.FieldPublisher)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, digestdelivery.FieldChatID)
+	}
+	if m.digest_key != nil {
+		fields = append(fields, digestdelivery.FieldDigestKey)
+	}
+	if m.status != nil {
+		fields = append(fields, digestdelivery.FieldStatus)
+	}
+	if m.parts_total != nil {
+		fields = append(fields, digestdelivery.FieldPartsTotal)
+	}
+	if m.parts_sent != nil {
+		fields = append(fields, digestdelivery.FieldPartsSent)
+	}
+	if m.error != nil {
+		fields = append(fields, digestdelivery.FieldError)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, digestdelivery.FieldSentAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, digestdelivery.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, digestdelivery.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DigestDeliveryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case digestdelivery.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case digestdelivery.FieldPublisher:
+		return m.Publisher()
+	case digestdelivery.FieldChatID:
+		return m.ChatID()
+	case digestdelivery.FieldDigestKey:
+		return m.DigestKey()
+	case digestdelivery.FieldStatus:
+		return m.Status()
+	case digestdelivery.FieldPartsTotal:
+		return m.PartsTotal()
+	case digestdelivery.FieldPartsSent:
+		return m.PartsSent()
+	case digestdelivery.FieldError:
+		return m.Error()
+	case digestdelivery.FieldSentAt:
+		return m.SentAt()
+	case digestdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case digestdelivery.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DigestDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case digestdelivery.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case digestdelivery.FieldPublisher:
+		return m.OldPublisher(ctx)
+	case digestdelivery.FieldChatID:
+		return m.OldChatID(ctx)
+	case digestdelivery.FieldDigestKey:
+		return m.OldDigestKey(ctx)
+	case digestdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case digestdelivery.FieldPartsTotal:
+		return m.OldPartsTotal(ctx)
+	case digestdelivery.FieldPartsSent:
+		return m.OldPartsSent(ctx)
+	case digestdelivery.FieldError:
+		return m.OldError(ctx)
+	case digestdelivery.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case digestdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case digestdelivery.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DigestDelivery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DigestDeliveryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case digestdelivery.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case digestdelivery.FieldPublisher:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublisher(v)
+		return nil
+	case digestdelivery.FieldChatID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case digestdelivery.FieldDigestKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigestKey(v)
+		return nil
+	case digestdelivery.FieldStatus:
+		v, ok := value.(digestdelivery.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case digestdelivery.FieldPartsTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPartsTotal(v)
+		return nil
+	case digestdelivery.FieldPartsSent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPartsSent(v)
+		return nil
+	case digestdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case digestdelivery.FieldSentAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case digestdelivery.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case digestdelivery.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DigestDelivery field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DigestDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addparts_total != nil {
+		fields = append(fields, digestdelivery.FieldPartsTotal)
+	}
+	if m.addparts_sent != nil {
+		fields = append(fields, digestdelivery.FieldPartsSent)
+	}
+	if m.addsent_at != nil {
+		fields = append(fields, digestdelivery.FieldSentAt)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, digestdelivery.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, digestdelivery.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DigestDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case digestdelivery.FieldPartsTotal:
+		return m.AddedPartsTotal()
+	case digestdelivery.FieldPartsSent:
+		return m.AddedPartsSent()
+	case digestdelivery.FieldSentAt:
+		return m.AddedSentAt()
+	case digestdelivery.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case digestdelivery.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DigestDeliveryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case digestdelivery.FieldPartsTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPartsTotal(v)
+		return nil
+	case digestdelivery.FieldPartsSent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPartsSent(v)
+		return nil
+	case digestdelivery.FieldSentAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSentAt(v)
+		return nil
+	case digestdelivery.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case digestdelivery.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DigestDelivery numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DigestDeliveryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DigestDeliveryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DigestDeliveryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DigestDelivery nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DigestDeliveryMutation) ResetField(name string) error {
+	switch name {
+	case digestdelivery.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case digestdelivery.FieldPublisher:
+		m.ResetPublisher()
+		return nil
+	case digestdelivery.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case digestdelivery.FieldDigestKey:
+		m.ResetDigestKey()
+		return nil
+	case digestdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case digestdelivery.FieldPartsTotal:
+		m.ResetPartsTotal()
+		return nil
+	case digestdelivery.FieldPartsSent:
+		m.ResetPartsSent()
+		return nil
+	case digestdelivery.FieldError:
+		m.ResetError()
+		return nil
+	case digestdelivery.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case digestdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case digestdelivery.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DigestDelivery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DigestDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DigestDeliveryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DigestDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DigestDeliveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DigestDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DigestDeliveryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DigestDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DigestDelivery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DigestDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DigestDelivery edge %s", name)
+}
+
+// DistillRunMutation represents an operation that mutates the DistillRun nodes in the graph.
+type DistillRunMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	workspace_id         *uuid.UUID
+	in_chat_id           *string
+	span_start           *int64
+	addspan_start        *int64
+	span_end             *int64
+	addspan_end          *int64
+	status               *distillrun.Status
+	message_count        *int
+	addmessage_count     *int
+	item_count           *int
+	additem_count        *int
+	event_count          *int
+	addevent_count       *int
+	prompt_tokens        *int
+	addprompt_tokens     *int
+	completion_tokens    *int
+	addcompletion_tokens *int
+	cost                 *float64
+	addcost              *float64
+	stage_usage          *map[string]schema.StageUsage
+	error                *string
+	partial_output       *string
+	finished_at          *int64
+	addfinished_at       *int64
+	created_at           *int64
+	addcreated_at        *int64
+	updated_at           *int64
+	addupdated_at        *int64
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*DistillRun, error)
+	predicates           []predicate.DistillRun
+}
+
+var _ ent.Mutation = (*DistillRunMutation)(nil)
+
+// distillrunOption allows management of the mutation configuration using functional options.
+type distillrunOption func(*DistillRunMutation)
+
+// newDistillRunMutation creates new mutation for the DistillRun entity.
+func newDistillRunMutation(c config, op Op, opts ...distillrunOption) *DistillRunMutation {
+	m := &DistillRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDistillRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDistillRunID sets the ID field of the mutation.
+func withDistillRunID(id uuid.UUID) distillrunOption {
+	return func(m *DistillRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DistillRun
+		)
+		m.oldValue = func(ctx context.Context) (*DistillRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DistillRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDistillRun sets the old DistillRun of the mutation.
+func withDistillRun(node *DistillRun) distillrunOption {
+	return func(m *DistillRunMutation) {
+		m.oldValue = func(context.Context) (*DistillRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DistillRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DistillRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DistillRun entities.
+func (m *DistillRunMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DistillRunMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DistillRunMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DistillRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *DistillRunMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *DistillRunMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *DistillRunMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (m *DistillRunMutation) SetInChatID(s string) {
+	m.in_chat_id = &s
+}
+
+// InChatID returns the value of the "in_chat_id" field in the mutation.
+func (m *DistillRunMutation) InChatID() (r string, exists bool) {
+	v := m.in_chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInChatID returns the old "in_chat_id" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldInChatID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInChatID: %w", err)
+	}
+	return oldValue.InChatID, nil
+}
+
+// ResetInChatID resets all changes to the "in_chat_id" field.
+func (m *DistillRunMutation) ResetInChatID() {
+	m.in_chat_id = nil
+}
+
+// SetSpanStart sets the "span_start" field.
+func (m *DistillRunMutation) SetSpanStart(i int64) {
+	m.span_start = &i
+	m.addspan_start = nil
+}
+
+// SpanStart returns the value of the "span_start" field in the mutation.
+func (m *DistillRunMutation) SpanStart() (r int64, exists bool) {
+	v := m.span_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpanStart returns the old "span_start" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldSpanStart(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpanStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpanStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpanStart: %w", err)
+	}
+	return oldValue.SpanStart, nil
+}
+
+// AddSpanStart adds i to the "span_start" field.
+func (m *DistillRunMutation) AddSpanStart(i int64) {
+	if m.addspan_start != nil {
+		*m.addspan_start += i
+	} else {
+		m.addspan_start = &i
+	}
+}
+
+// AddedSpanStart returns the value that was added to the "span_start" field in this mutation.
+func (m *DistillRunMutation) AddedSpanStart() (r int64, exists bool) {
+	v := m.addspan_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpanStart resets all changes to the "span_start" field.
+func (m *DistillRunMutation) ResetSpanStart() {
+	m.span_start = nil
+	m.addspan_start = nil
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (m *DistillRunMutation) SetSpanEnd(i int64) {
+	m.span_end = &i
+	m.addspan_end = nil
+}
+
+// SpanEnd returns the value of the "span_end" field in the mutation.
+func (m *DistillRunMutation) SpanEnd() (r int64, exists bool) {
+	v := m.span_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpanEnd returns the old "span_end" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldSpanEnd(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpanEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpanEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpanEnd: %w", err)
+	}
+	return oldValue.SpanEnd, nil
+}
+
+// AddSpanEnd adds i to the "span_end" field.
+func (m *DistillRunMutation) AddSpanEnd(i int64) {
+	if m.addspan_end != nil {
+		*m.addspan_end += i
+	} else {
+		m.addspan_end = &i
+	}
+}
+
+// AddedSpanEnd returns the value that was added to the "span_end" field in this mutation.
+func (m *DistillRunMutation) AddedSpanEnd() (r int64, exists bool) {
+	v := m.addspan_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpanEnd resets all changes to the "span_end" field.
+func (m *DistillRunMutation) ResetSpanEnd() {
+	m.span_end = nil
+	m.addspan_end = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *DistillRunMutation) SetStatus(d distillrun.Status) {
+	m.status = &d
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DistillRunMutation) Status() (r distillrun.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldStatus(ctx context.Context) (v distillrun.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DistillRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMessageCount sets the "message_count" field.
+func (m *DistillRunMutation) SetMessageCount(i int) {
+	m.message_count = &i
+	m.addmessage_count = nil
+}
+
+// MessageCount returns the value of the "message_count" field in the mutation.
+func (m *DistillRunMutation) MessageCount() (r int, exists bool) {
+	v := m.message_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageCount returns the old "message_count" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldMessageCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageCount: %w", err)
+	}
+	return oldValue.MessageCount, nil
+}
+
+// AddMessageCount adds i to the "message_count" field.
+func (m *DistillRunMutation) AddMessageCount(i int) {
+	if m.addmessage_count != nil {
+		*m.addmessage_count += i
+	} else {
+		m.addmessage_count = &i
+	}
+}
+
+// AddedMessageCount returns the value that was added to the "message_count" field in this mutation.
+func (m *DistillRunMutation) AddedMessageCount() (r int, exists bool) {
+	v := m.addmessage_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageCount resets all changes to the "message_count" field.
+func (m *DistillRunMutation) ResetMessageCount() {
+	m.message_count = nil
+	m.addmessage_count = nil
+}
+
+// SetItemCount sets the "item_count" field.
+func (m *DistillRunMutation) SetItemCount(i int) {
+	m.item_count = &i
+	m.additem_count = nil
+}
+
+// ItemCount returns the value of the "item_count" field in the mutation.
+func (m *DistillRunMutation) ItemCount() (r int, exists bool) {
+	v := m.item_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemCount returns the old "item_count" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldItemCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemCount: %w", err)
+	}
+	return oldValue.ItemCount, nil
+}
+
+// AddItemCount adds i to the "item_count" field.
+func (m *DistillRunMutation) AddItemCount(i int) {
+	if m.additem_count != nil {
+		*m.additem_count += i
+	} else {
+		m.additem_count = &i
+	}
+}
+
+// AddedItemCount returns the value that was added to the "item_count" field in this mutation.
+func (m *DistillRunMutation) AddedItemCount() (r int, exists bool) {
+	v := m.additem_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetItemCount resets all changes to the "item_count" field.
+func (m *DistillRunMutation) ResetItemCount() {
+	m.item_count = nil
+	m.additem_count = nil
+}
+
+// SetEventCount sets the "event_count" field.
+func (m *DistillRunMutation) SetEventCount(i int) {
+	m.event_count = &i
+	m.addevent_count = nil
+}
+
+// EventCount returns the value of the "event_count" field in the mutation.
+func (m *DistillRunMutation) EventCount() (r int, exists bool) {
+	v := m.event_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventCount returns the old "event_count" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldEventCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventCount: %w", err)
+	}
+	return oldValue.EventCount, nil
+}
+
+// AddEventCount adds i to the "event_count" field.
+func (m *DistillRunMutation) AddEventCount(i int) {
+	if m.addevent_count != nil {
+		*m.addevent_count += i
+	} else {
+		m.addevent_count = &i
+	}
+}
+
+// AddedEventCount returns the value that was added to the "event_count" field in this mutation.
+func (m *DistillRunMutation) AddedEventCount() (r int, exists bool) {
+	v := m.addevent_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventCount resets all changes to the "event_count" field.
+func (m *DistillRunMutation) ResetEventCount() {
+	m.event_count = nil
+	m.addevent_count = nil
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *DistillRunMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
+}
+
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *DistillRunMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTokens returns the old "prompt_tokens" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldPromptTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+	}
+	return oldValue.PromptTokens, nil
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *DistillRunMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
+	} else {
+		m.addprompt_tokens = &i
+	}
+}
+
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *DistillRunMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *DistillRunMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *DistillRunMutation) SetCompletionTokens(i int) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
+}
+
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *DistillRunMutation) CompletionTokens() (r int, exists bool) {
+	v := m.completion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionTokens returns the old "completion_tokens" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldCompletionTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
+	}
+	return oldValue.CompletionTokens, nil
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *DistillRunMutation) AddCompletionTokens(i int) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
+}
+
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *DistillRunMutation) AddedCompletionTokens() (r int, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *DistillRunMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+}
+
+// SetCost sets the "cost" field.
+func (m *DistillRunMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *DistillRunMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *DistillRunMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *DistillRunMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *DistillRunMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+}
+
+// SetStageUsage sets the "stage_usage" field.
+func (m *DistillRunMutation) SetStageUsage(mu map[string]schema.StageUsage) {
+	m.stage_usage = &mu
+}
+
+// StageUsage returns the value of the "stage_usage" field in the mutation.
+func (m *DistillRunMutation) StageUsage() (r map[string]schema.StageUsage, exists bool) {
+	v := m.stage_usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStageUsage returns the old "stage_usage" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldStageUsage(ctx context.Context) (v map[string]schema.StageUsage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStageUsage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStageUsage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStageUsage: %w", err)
+	}
+	return oldValue.StageUsage, nil
+}
+
+// ResetStageUsage resets all changes to the "stage_usage" field.
+func (m *DistillRunMutation) ResetStageUsage() {
+	m.stage_usage = nil
+}
+
+// SetError sets the "error" field.
+func (m *DistillRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *DistillRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *DistillRunMutation) ResetError() {
+	m.error = nil
+}
+
+// SetPartialOutput sets the "partial_output" field.
+func (m *DistillRunMutation) SetPartialOutput(s string) {
+	m.partial_output = &s
+}
+
+// PartialOutput returns the value of the "partial_output" field in the mutation.
+func (m *DistillRunMutation) PartialOutput() (r string, exists bool) {
+	v := m.partial_output
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPartialOutput returns the old "partial_output" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldPartialOutput(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPartialOutput is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPartialOutput requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPartialOutput: %w", err)
+	}
+	return oldValue.PartialOutput, nil
+}
+
+// ResetPartialOutput resets all changes to the "partial_output" field.
+func (m *DistillRunMutation) ResetPartialOutput() {
+	m.partial_output = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *DistillRunMutation) SetFinishedAt(i int64) {
+	m.finished_at = &i
+	m.addfinished_at = nil
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *DistillRunMutation) FinishedAt() (r int64, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldFinishedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (m *DistillRunMutation) AddFinishedAt(i int64) {
+	if m.addfinished_at != nil {
+		*m.addfinished_at += i
+	} else {
+		m.addfinished_at = &i
+	}
+}
+
+// AddedFinishedAt returns the value that was added to the "finished_at" field in this mutation.
+func (m *DistillRunMutation) AddedFinishedAt() (r int64, exists bool) {
+	v := m.addfinished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *DistillRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	m.addfinished_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DistillRunMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DistillRunMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *DistillRunMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *DistillRunMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DistillRunMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DistillRunMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DistillRunMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DistillRun entity.
+// If the DistillRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistillRunMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *DistillRunMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *DistillRunMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DistillRunMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the DistillRunMutation builder.
+func (m *DistillRunMutation) Where(ps ...predicate.DistillRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DistillRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DistillRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DistillRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DistillRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DistillRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DistillRun).
+func (m *DistillRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DistillRunMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.workspace_id != nil {
+		fields = append(fields, distillrun.FieldWorkspaceID)
+	}
+	if m.in_chat_id != nil {
+		fields = append(fields, distillrun.FieldInChatID)
+	}
+	if m.span_start != nil {
+		fields = append(fields, distillrun.FieldSpanStart)
+	}
+	if m.span_end != nil {
+		fields = append(fields, distillrun.FieldSpanEnd)
+	}
+	if m.status != nil {
+		fields = append(fields, distillrun.FieldStatus)
+	}
+	if m.message_count != nil {
+		fields = append(fields, distillrun.FieldMessageCount)
+	}
+	if m.item_count != nil {
+		fields = append(fields, distillrun.FieldItemCount)
+	}
+	if m.event_count != nil {
+		fields = append(fields, distillrun.FieldEventCount)
+	}
+	if m.prompt_tokens != nil {
+		fields = append(fields, distillrun.FieldPromptTokens)
+	}
+	if m.completion_tokens != nil {
+		fields = append(fields, distillrun.FieldCompletionTokens)
+	}
+	if m.cost != nil {
+		fields = append(fields, distillrun.FieldCost)
+	}
+	if m.stage_usage != nil {
+		fields = append(fields, distillrun.FieldStageUsage)
+	}
+	if m.error != nil {
+		fields = append(fields, distillrun.FieldError)
+	}
+	if m.partial_output != nil {
+		fields = append(fields, distillrun.FieldPartialOutput)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, distillrun.FieldFinishedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, distillrun.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, distillrun.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DistillRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case distillrun.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case distillrun.FieldInChatID:
+		return m.InChatID()
+	case distillrun.FieldSpanStart:
+		return m.SpanStart()
+	case distillrun.FieldSpanEnd:
+		return m.SpanEnd()
+	case distillrun.FieldStatus:
+		return m.Status()
+	case distillrun.FieldMessageCount:
+		return m.MessageCount()
+	case distillrun.FieldItemCount:
+		return m.ItemCount()
+	case distillrun.FieldEventCount:
+		return m.EventCount()
+	case distillrun.FieldPromptTokens:
+		return m.PromptTokens()
+	case distillrun.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case distillrun.FieldCost:
+		return m.Cost()
+	case distillrun.FieldStageUsage:
+		return m.StageUsage()
+	case distillrun.FieldError:
+		return m.Error()
+	case distillrun.FieldPartialOutput:
+		return m.PartialOutput()
+	case distillrun.FieldFinishedAt:
+		return m.FinishedAt()
+	case distillrun.FieldCreatedAt:
+		return m.CreatedAt()
+	case distillrun.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DistillRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case distillrun.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case distillrun.FieldInChatID:
+		return m.OldInChatID(ctx)
+	case distillrun.FieldSpanStart:
+		return m.OldSpanStart(ctx)
+	case distillrun.FieldSpanEnd:
+		return m.OldSpanEnd(ctx)
+	case distillrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case distillrun.FieldMessageCount:
+		return m.OldMessageCount(ctx)
+	case distillrun.FieldItemCount:
+		return m.OldItemCount(ctx)
+	case distillrun.FieldEventCount:
+		return m.OldEventCount(ctx)
+	case distillrun.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case distillrun.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case distillrun.FieldCost:
+		return m.OldCost(ctx)
+	case distillrun.FieldStageUsage:
+		return m.OldStageUsage(ctx)
+	case distillrun.FieldError:
+		return m.OldError(ctx)
+	case distillrun.FieldPartialOutput:
+		return m.OldPartialOutput(ctx)
+	case distillrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case distillrun.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case distillrun.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DistillRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DistillRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case distillrun.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case distillrun.FieldInChatID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInChatID(v)
+		return nil
+	case distillrun.FieldSpanStart:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpanStart(v)
+		return nil
+	case distillrun.FieldSpanEnd:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpanEnd(v)
+		return nil
+	case distillrun.FieldStatus:
+		v, ok := value.(distillrun.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case distillrun.FieldMessageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageCount(v)
+		return nil
+	case distillrun.FieldItemCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemCount(v)
+		return nil
+	case distillrun.FieldEventCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventCount(v)
+		return nil
+	case distillrun.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
+	case distillrun.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTokens(v)
+		return nil
+	case distillrun.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case distillrun.FieldStageUsage:
+		v, ok := value.(map[string]schema.StageUsage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStageUsage(v)
+		return nil
+	case distillrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case distillrun.FieldPartialOutput:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPartialOutput(v)
+		return nil
+	case distillrun.FieldFinishedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case distillrun.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case distillrun.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DistillRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DistillRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addspan_start != nil {
+		fields = append(fields, distillrun.FieldSpanStart)
+	}
+	if m.addspan_end != nil {
+		fields = append(fields, distillrun.FieldSpanEnd)
+	}
+	if m.addmessage_count != nil {
+		fields = append(fields, distillrun.FieldMessageCount)
+	}
+	if m.additem_count != nil {
+		fields = append(fields, distillrun.FieldItemCount)
+	}
+	if m.addevent_count != nil {
+		fields = append(fields, distillrun.FieldEventCount)
+	}
+	if m.addprompt_tokens != nil {
+		fields = append(fields, distillrun.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, distillrun.FieldCompletionTokens)
+	}
+	if m.addcost != nil {
+		fields = append(fields, distillrun.FieldCost)
+	}
+	if m.addfinished_at != nil {
+		fields = append(fields, distillrun.FieldFinishedAt)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, distillrun.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, distillrun.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DistillRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case distillrun.FieldSpanStart:
+		return m.AddedSpanStart()
+	case distillrun.FieldSpanEnd:
+		return m.AddedSpanEnd()
+	case distillrun.FieldMessageCount:
+		return m.AddedMessageCount()
+	case distillrun.FieldItemCount:
+		return m.AddedItemCount()
+	case distillrun.FieldEventCount:
+		return m.AddedEventCount()
+	case distillrun.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case distillrun.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
+	case distillrun.FieldCost:
+		return m.AddedCost()
+	case distillrun.FieldFinishedAt:
+		return m.AddedFinishedAt()
+	case distillrun.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case distillrun.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DistillRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case distillrun.FieldSpanStart:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpanStart(v)
+		return nil
+	case distillrun.FieldSpanEnd:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpanEnd(v)
+		return nil
+	case distillrun.FieldMessageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageCount(v)
+		return nil
+	case distillrun.FieldItemCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddItemCount(v)
+		return nil
+	case distillrun.FieldEventCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventCount(v)
+		return nil
+	case distillrun.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case distillrun.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
+		return nil
+	case distillrun.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	case distillrun.FieldFinishedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFinishedAt(v)
+		return nil
+	case distillrun.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case distillrun.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DistillRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DistillRunMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DistillRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DistillRunMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DistillRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DistillRunMutation) ResetField(name string) error {
+	switch name {
+	case distillrun.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case distillrun.FieldInChatID:
+		m.ResetInChatID()
+		return nil
+	case distillrun.FieldSpanStart:
+		m.ResetSpanStart()
+		return nil
+	case distillrun.FieldSpanEnd:
+		m.ResetSpanEnd()
+		return nil
+	case distillrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case distillrun.FieldMessageCount:
+		m.ResetMessageCount()
+		return nil
+	case distillrun.FieldItemCount:
+		m.ResetItemCount()
+		return nil
+	case distillrun.FieldEventCount:
+		m.ResetEventCount()
+		return nil
+	case distillrun.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case distillrun.FieldCompletionTokens:
+		m.ResetCompletionTokens()
+		return nil
+	case distillrun.FieldCost:
+		m.ResetCost()
+		return nil
+	case distillrun.FieldStageUsage:
+		m.ResetStageUsage()
+		return nil
+	case distillrun.FieldError:
+		m.ResetError()
+		return nil
+	case distillrun.FieldPartialOutput:
+		m.ResetPartialOutput()
+		return nil
+	case distillrun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case distillrun.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case distillrun.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DistillRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DistillRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DistillRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DistillRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DistillRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DistillRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DistillRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DistillRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DistillRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DistillRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DistillRun edge %s", name)
+}
+
+// EventMutation represents an operation that mutates the Event nodes in the graph.
+type EventMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	workspace_id               *uuid.UUID
+	platform                   *string
+	name                       *string
+	tags                       *[]string
+	appendtags                 []string
+	description                *string
+	from_name                  *string
+	unmatched_names            *[]string
+	appendunmatched_names      []string
+	in_chat_id                 *string
+	in_chat_type               *string
+	platform_timestamp         *int64
+	addplatform_timestamp      *int64
+	evidence_message_ids       *[]uuid.UUID
+	appendevidence_message_ids []uuid.UUID
+	span_start                 *int64
+	addspan_start              *int64
+	span_end                   *int64
+	addspan_end                *int64
+	description_vector_1536    *pgvector.Vector
+	description_vector_1024    *pgvector.Vector
+	description_vector_768     *pgvector.Vector
+	created_at                 *int64
+	addcreated_at              *int64
+	updated_at                 *int64
+	addupdated_at              *int64
+	clearedFields              map[string]struct{}
+	identities                 map[uuid.UUID]struct{}
+	removedidentities          map[uuid.UUID]struct{}
+	clearedidentities          bool
+	continues                  *uuid.UUID
+	clearedcontinues           bool
+	continuations              map[uuid.UUID]struct{}
+	removedcontinuations       map[uuid.UUID]struct{}
+	clearedcontinuations       bool
+	done                       bool
+	oldValue                   func(context.Context) (*Event, error)
+	predicates                 []predicate.Event
+}
+
+var _ ent.Mutation = (*EventMutation)(nil)
+
+// eventOption allows management of the mutation configuration using functional options.
+type eventOption func(*EventMutation)
+
+// newEventMutation creates new mutation for the Event entity.
+func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
+	m := &EventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventID sets the ID field of the mutation.
+func withEventID(id uuid.UUID) eventOption {
+	return func(m *EventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Event
+		)
+		m.oldValue = func(ctx context.Context) (*Event, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Event.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEvent sets the old Event of the mutation.
+func withEvent(node *Event) eventOption {
+	return func(m *EventMutation) {
+		m.oldValue = func(context.Context) (*Event, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Event entities.
+func (m *EventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *EventMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *EventMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *EventMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetPlatform sets the "platform" field.
+func (m *EventMutation) SetPlatform(s string) {
+	m.platform = &s
+}
+
+// Platform returns the value of the "platform" field in the mutation.
+func (m *EventMutation) Platform() (r string, exists bool) {
+	v := m.platform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatform returns the old "platform" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldPlatform(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+	}
+	return oldValue.Platform, nil
+}
+
+// ResetPlatform resets all changes to the "platform" field.
+func (m *EventMutation) ResetPlatform() {
+	m.platform = nil
+}
+
+// SetName sets the "name" field.
+func (m *EventMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EventMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EventMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *EventMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *EventMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *EventMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *EventMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *EventMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[event.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *EventMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[event.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *EventMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, event.FieldTags)
+}
+
+// SetDescription sets the "description" field.
+func (m *EventMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EventMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EventMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetFromName sets the "from_name" field.
+func (m *EventMutation) SetFromName(s string) {
+	m.from_name = &s
+}
+
+// FromName returns the value of the "from_name" field in the mutation.
+func (m *EventMutation) FromName() (r string, exists bool) {
+	v := m.from_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromName returns the old "from_name" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldFromName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromName: %w", err)
+	}
+	return oldValue.FromName, nil
+}
+
+// ResetFromName resets all changes to the "from_name" field.
+func (m *EventMutation) ResetFromName() {
+	m.from_name = nil
+}
+
+// SetUnmatchedNames sets the "unmatched_names" field.
+func (m *EventMutation) SetUnmatchedNames(s []string) {
+	m.unmatched_names = &s
+	m.appendunmatched_names = nil
+}
+
+// UnmatchedNames returns the value of the "unmatched_names" field in the mutation.
+func (m *EventMutation) UnmatchedNames() (r []string, exists bool) {
+	v := m.unmatched_names
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnmatchedNames returns the old "unmatched_names" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldUnmatchedNames(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnmatchedNames is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnmatchedNames requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnmatchedNames: %w", err)
+	}
+	return oldValue.UnmatchedNames, nil
+}
+
+// AppendUnmatchedNames adds s to the "unmatched_names" field.
+func (m *EventMutation) AppendUnmatchedNames(s []string) {
+	m.appendunmatched_names = append(m.appendunmatched_names, s...)
+}
+
+// AppendedUnmatchedNames returns the list of values that were appended to the "unmatched_names" field in this mutation.
+func (m *EventMutation) AppendedUnmatchedNames() ([]string, bool) {
+	if len(m.appendunmatched_names) == 0 {
+		return nil, false
+	}
+	return m.appendunmatched_names, true
+}
+
+// ClearUnmatchedNames clears the value of the "unmatched_names" field.
+func (m *EventMutation) ClearUnmatchedNames() {
+	m.unmatched_names = nil
+	m.appendunmatched_names = nil
+	m.clearedFields[event.FieldUnmatchedNames] = struct{}{}
+}
+
+// UnmatchedNamesCleared returns if the "unmatched_names" field was cleared in this mutation.
+func (m *EventMutation) UnmatchedNamesCleared() bool {
+	_, ok := m.clearedFields[event.FieldUnmatchedNames]
+	return ok
+}
+
+// ResetUnmatchedNames resets all changes to the "unmatched_names" field.
+func (m *EventMutation) ResetUnmatchedNames() {
+	m.unmatched_names = nil
+	m.appendunmatched_names = nil
+	delete(m.clearedFields, event.FieldUnmatchedNames)
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (m *EventMutation) SetInChatID(s string) {
+	m.in_chat_id = &s
+}
+
+// InChatID returns the value of the "in_chat_id" field in the mutation.
+func (m *EventMutation) InChatID() (r string, exists bool) {
+	v := m.in_chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInChatID returns the old "in_chat_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldInChatID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInChatID: %w", err)
+	}
+	return oldValue.InChatID, nil
+}
+
+// ResetInChatID resets all changes to the "in_chat_id" field.
+func (m *EventMutation) ResetInChatID() {
+	m.in_chat_id = nil
+}
+
+// SetInChatType sets the "in_chat_type" field.
+func (m *EventMutation) SetInChatType(s string) {
+	m.in_chat_type = &s
+}
+
+// InChatType returns the value of the "in_chat_type" field in the mutation.
+func (m *EventMutation) InChatType() (r string, exists bool) {
+	v := m.in_chat_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInChatType returns the old "in_chat_type" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldInChatType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInChatType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInChatType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInChatType: %w", err)
+	}
+	return oldValue.InChatType, nil
+}
+
+// ResetInChatType resets all changes to the "in_chat_type" field.
+func (m *EventMutation) ResetInChatType() {
+	m.in_chat_type = nil
+}
+
+// SetPlatformTimestamp sets the "platform_timestamp" field.
+func (m *EventMutation) SetPlatformTimestamp(i int64) {
+	m.platform_timestamp = &i
+	m.addplatform_timestamp = nil
+}
+
+// PlatformTimestamp returns the value of the "platform_timestamp" field in the mutation.
+func (m *EventMutation) PlatformTimestamp() (r int64, exists bool) {
+	v := m.platform_timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatformTimestamp returns the old "platform_timestamp" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldPlatformTimestamp(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatformTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatformTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatformTimestamp: %w", err)
+	}
+	return oldValue.PlatformTimestamp, nil
+}
+
+// AddPlatformTimestamp adds i to the "platform_timestamp" field.
+func (m *EventMutation) AddPlatformTimestamp(i int64) {
+	if m.addplatform_timestamp != nil {
+		*m.addplatform_timestamp += i
+	} else {
+		m.addplatform_timestamp = &i
+	}
+}
+
+// AddedPlatformTimestamp returns the value that was added to the "platform_timestamp" field in this mutation.
+func (m *EventMutation) AddedPlatformTimestamp() (r int64, exists bool) {
+	v := m.addplatform_timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPlatformTimestamp resets all changes to the "platform_timestamp" field.
+func (m *EventMutation) ResetPlatformTimestamp() {
+	m.platform_timestamp = nil
+	m.addplatform_timestamp = nil
+}
+
+// SetEvidenceMessageIds sets the "evidence_message_ids" field.
+func (m *EventMutation) SetEvidenceMessageIds(u []uuid.UUID) {
+	m.evidence_message_ids = &u
+	m.appendevidence_message_ids = nil
+}
+
+// EvidenceMessageIds returns the value of the "evidence_message_ids" field in the mutation.
+func (m *EventMutation) EvidenceMessageIds() (r []uuid.UUID, exists bool) {
+	v := m.evidence_message_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvidenceMessageIds returns the old "evidence_message_ids" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldEvidenceMessageIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvidenceMessageIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvidenceMessageIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvidenceMessageIds: %w", err)
+	}
+	return oldValue.EvidenceMessageIds, nil
+}
+
+// AppendEvidenceMessageIds adds u to the "evidence_message_ids" field.
+func (m *EventMutation) AppendEvidenceMessageIds(u []uuid.UUID) {
+	m.appendevidence_message_ids = append(m.appendevidence_message_ids, u...)
+}
+
+// AppendedEvidenceMessageIds returns the list of values that were appended to the "evidence_message_ids" field in this mutation.
+func (m *EventMutation) AppendedEvidenceMessageIds() ([]uuid.UUID, bool) {
+	if len(m.appendevidence_message_ids) == 0 {
+		return nil, false
+	}
+	return m.appendevidence_message_ids, true
+}
+
+// ResetEvidenceMessageIds resets all changes to the "evidence_message_ids" field.
+func (m *EventMutation) ResetEvidenceMessageIds() {
+	m.evidence_message_ids = nil
+	m.appendevidence_message_ids = nil
+}
+
+// SetSpanStart sets the "span_start" field.
+func (m *EventMutation) SetSpanStart(i int64) {
+	m.span_start = &i
+	m.addspan_start = nil
+}
+
+// SpanStart returns the value of the "span_start" field in the mutation.
+func (m *EventMutation) SpanStart() (r int64, exists bool) {
+	v := m.span_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpanStart returns the old "span_start" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldSpanStart(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpanStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpanStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpanStart: %w", err)
+	}
+	return oldValue.SpanStart, nil
+}
+
+// AddSpanStart adds i to the "span_start" field.
+func (m *EventMutation) AddSpanStart(i int64) {
+	if m.addspan_start != nil {
+		*m.addspan_start += i
+	} else {
+		m.addspan_start = &i
+	}
+}
+
+// AddedSpanStart returns the value that was added to the "span_start" field in this mutation.
+func (m *EventMutation) AddedSpanStart() (r int64, exists bool) {
+	v := m.addspan_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpanStart resets all changes to the "span_start" field.
+func (m *EventMutation) ResetSpanStart() {
+	m.span_start = nil
+	m.addspan_start = nil
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (m *EventMutation) SetSpanEnd(i int64) {
+	m.span_end = &i
+	m.addspan_end = nil
+}
+
+// SpanEnd returns the value of the "span_end" field in the mutation.
+func (m *EventMutation) SpanEnd() (r int64, exists bool) {
+	v := m.span_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpanEnd returns the old "span_end" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldSpanEnd(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpanEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpanEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpanEnd: %w", err)
+	}
+	return oldValue.SpanEnd, nil
+}
+
+// AddSpanEnd adds i to the "span_end" field.
+func (m *EventMutation) AddSpanEnd(i int64) {
+	if m.addspan_end != nil {
+		*m.addspan_end += i
+	} else {
+		m.addspan_end = &i
+	}
+}
+
+// AddedSpanEnd returns the value that was added to the "span_end" field in this mutation.
+func (m *EventMutation) AddedSpanEnd() (r int64, exists bool) {
+	v := m.addspan_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpanEnd resets all changes to the "span_end" field.
+func (m *EventMutation) ResetSpanEnd() {
+	m.span_end = nil
+	m.addspan_end = nil
+}
+
+// SetDescriptionVector1536 sets the "description_vector_1536" field.
+func (m *EventMutation) SetDescriptionVector1536(pg pgvector.Vector) {
+	m.description_vector_1536 = &pg
+}
+
+// DescriptionVector1536 returns the value of the "description_vector_1536" field in the mutation.
+func (m *EventMutation) DescriptionVector1536() (r pgvector.Vector, exists bool) {
+	v := m.description_vector_1536
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescriptionVector1536 returns the old "description_vector_1536" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDescriptionVector1536(ctx context.Context) (v *pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescriptionVector1536 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescriptionVector1536 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescriptionVector1536: %w", err)
+	}
+	return oldValue.DescriptionVector1536, nil
+}
+
+// ClearDescriptionVector1536 clears the value of the "description_vector_1536" field.
+func (m *EventMutation) ClearDescriptionVector1536() {
+	m.description_vector_1536 = nil
+	m.clearedFields[event.FieldDescriptionVector1536] = struct{}{}
+}
+
+// DescriptionVector1536Cleared returns if the "description_vector_1536" field was cleared in this mutation.
+func (m *EventMutation) DescriptionVector1536Cleared() bool {
+	_, ok := m.clearedFields[event.FieldDescriptionVector1536]
+	return ok
+}
+
+// ResetDescriptionVector1536 resets all changes to the "description_vector_1536" field.
+func (m *EventMutation) ResetDescriptionVector1536() {
+	m.description_vector_1536 = nil
+	delete(m.clearedFields, event.FieldDescriptionVector1536)
+}
+
+// SetDescriptionVector1024 sets the "description_vector_1024" field.
+func (m *EventMutation) SetDescriptionVector1024(pg pgvector.Vector) {
+	m.description_vector_1024 = &pg
+}
+
+// DescriptionVector1024 returns the value of the "description_vector_1024" field in the mutation.
+func (m *EventMutation) DescriptionVector1024() (r pgvector.Vector, exists bool) {
+	v := m.description_vector_1024
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescriptionVector1024 returns the old "description_vector_1024" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDescriptionVector1024(ctx context.Context) (v *pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescriptionVector1024 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescriptionVector1024 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescriptionVector1024: %w", err)
+	}
+	return oldValue.DescriptionVector1024, nil
+}
+
+// ClearDescriptionVector1024 clears the value of the "description_vector_1024" field.
+func (m *EventMutation) ClearDescriptionVector1024() {
+	m.description_vector_1024 = nil
+	m.clearedFields[event.FieldDescriptionVector1024] = struct{}{}
+}
+
+// DescriptionVector1024Cleared returns if the "description_vector_1024" field was cleared in this mutation.
+func (m *EventMutation) DescriptionVector1024Cleared() bool {
+	_, ok := m.clearedFields[event.FieldDescriptionVector1024]
+	return ok
+}
+
+// ResetDescriptionVector1024 resets all changes to the "description_vector_1024" field.
+func (m *EventMutation) ResetDescriptionVector1024() {
+	m.description_vector_1024 = nil
+	delete(m.clearedFields, event.FieldDescriptionVector1024)
+}
+
+// SetDescriptionVector768 sets the "description_vector_768" field.
+func (m *EventMutation) SetDescriptionVector768(pg pgvector.Vector) {
+	m.description_vector_768 = &pg
+}
+
+// DescriptionVector768 returns the value of the "description_vector_768" field in the mutation.
+func (m *EventMutation) DescriptionVector768() (r pgvector.Vector, exists bool) {
+	v := m.description_vector_768
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescriptionVector768 returns the old "description_vector_768" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDescriptionVector768(ctx context.Context) (v *pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescriptionVector768 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescriptionVector768 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescriptionVector768: %w", err)
+	}
+	return oldValue.DescriptionVector768, nil
+}
+
+// ClearDescriptionVector768 clears the value of the "description_vector_768" field.
+func (m *EventMutation) ClearDescriptionVector768() {
+	m.description_vector_768 = nil
+	m.clearedFields[event.FieldDescriptionVector768] = struct{}{}
+}
+
+// DescriptionVector768Cleared returns if the "description_vector_768" field was cleared in this mutation.
+func (m *EventMutation) DescriptionVector768Cleared() bool {
+	_, ok := m.clearedFields[event.FieldDescriptionVector768]
+	return ok
+}
+
+// ResetDescriptionVector768 resets all changes to the "description_vector_768" field.
+func (m *EventMutation) ResetDescriptionVector768() {
+	m.description_vector_768 = nil
+	delete(m.clearedFields, event.FieldDescriptionVector768)
+}
+
+// SetContinuesID sets the "continues_id" field.
+func (m *EventMutation) SetContinuesID(u uuid.UUID) {
+	m.continues = &u
+}
+
+// ContinuesID returns the value of the "continues_id" field in the mutation.
+func (m *EventMutation) ContinuesID() (r uuid.UUID, exists bool) {
+	v := m.continues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContinuesID returns the old "continues_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldContinuesID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContinuesID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContinuesID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContinuesID: %w", err)
+	}
+	return oldValue.ContinuesID, nil
+}
+
+// ClearContinuesID clears the value of the "continues_id" field.
+func (m *EventMutation) ClearContinuesID() {
+	m.continues = nil
+	m.clearedFields[event.FieldContinuesID] = struct{}{}
+}
+
+// ContinuesIDCleared returns if the "continues_id" field was cleared in this mutation.
+func (m *EventMutation) ContinuesIDCleared() bool {
+	_, ok := m.clearedFields[event.FieldContinuesID]
+	return ok
+}
+
+// ResetContinuesID resets all changes to the "continues_id" field.
+func (m *EventMutation) ResetContinuesID() {
+	m.continues = nil
+	delete(m.clearedFields, event.FieldContinuesID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *EventMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *EventMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EventMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EventMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *EventMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *EventMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by ids.
+func (m *EventMutation) AddIdentityIDs(ids ...uuid.UUID) {
+	if m.identities == nil {
+		m.identities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIdentities clears the "identities" edge to the Identity entity.
+func (m *EventMutation) ClearIdentities() {
+	m.clearedidentities = true
+}
+
+// IdentitiesCleared reports if the "identities" edge to the Identity entity was cleared.
+func (m *EventMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
+}
+
+// RemoveIdentityIDs removes the "identities" edge to the Identity entity by IDs.
+func (m *EventMutation) RemoveIdentityIDs(ids ...uuid.UUID) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIdentities returns the removed IDs of the "identities" edge to the Identity entity.
+func (m *EventMutation) RemovedIdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *EventMutation) IdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *EventMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
+}
+
+// ClearContinues clears the "continues" edge to the Event entity.
+func (m *EventMutation) ClearContinues() {
+	m.clearedcontinues = true
+	m.clearedFields[event.FieldContinuesID] = struct{}{}
+}
+
+// ContinuesCleared reports if the "continues" edge to the Event entity was cleared.
+func (m *EventMutation) ContinuesCleared() bool {
+	return m.ContinuesIDCleared() || m.clearedcontinues
+}
+
+// ContinuesIDs returns the "continues" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContinuesID instead. It exists only for internal usage by the builders.
+func (m *EventMutation) ContinuesIDs() (ids []uuid.UUID) {
+	if id := m.continues; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetContinues resets all changes to the "continues" edge.
+func (m *EventMutation) ResetContinues() {
+	m.continues = nil
+	m.clearedcontinues = false
+}
+
+// AddContinuationIDs adds the "continuations" edge to the Event entity by ids.
+func (m *EventMutation) AddContinuationIDs(ids ...uuid.UUID) {
+	if m.continuations == nil {
+		m.continuations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.continuations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContinuations clears the "continuations" edge to the Event entity.
+func (m *EventMutation) ClearContinuations() {
+	m.clearedcontinuations = true
+}
+
+// ContinuationsCleared reports if the "continuations" edge to the Event entity was cleared.
+func (m *EventMutation) ContinuationsCleared() bool {
+	return m.clearedcontinuations
+}
+
+// RemoveContinuationIDs removes the "continuations" edge to the Event entity by IDs.
+func (m *EventMutation) RemoveContinuationIDs(ids ...uuid.UUID) {
+	if m.removedcontinuations == nil {
+		m.removedcontinuations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.continuations, ids[i])
+		m.removedcontinuations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContinuations returns the removed IDs of the "continuations" edge to the Event entity.
+func (m *EventMutation) RemovedContinuationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcontinuations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContinuationsIDs returns the "continuations" edge IDs in the mutation.
+func (m *EventMutation) ContinuationsIDs() (ids []uuid.UUID) {
+	for id := range m.continuations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContinuations resets all changes to the "continuations" edge.
+func (m *EventMutation) ResetContinuations() {
+	m.continuations = nil
+	m.clearedcontinuations = false
+	m.removedcontinuations = nil
+}
+
+// Where appends a list predicates to the EventMutation builder.
+func (m *EventMutation) Where(ps ...predicate.Event) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Event, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Event).
+func (m *EventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.workspace_id != nil {
+		fields = append(fields, event.FieldWorkspaceID)
+	}
+	if m.platform != nil {
+		fields = append(fields, event.FieldPlatform)
+	}
+	if m.name != nil {
+		fields = append(fields, event.FieldName)
+	}
+	if m.tags != nil {
+		fields = append(fields, event.FieldTags)
+	}
+	if m.description != nil {
+		fields = append(fields, event.FieldDescription)
+	}
+	if m.from_name != nil {
+		fields = append(fields, event.FieldFromName)
+	}
+	if m.unmatched_names != nil {
+		fields = append(fields, event.FieldUnmatchedNames)
+	}
+	if m.in_chat_id != nil {
+		fields = append(fields, event.FieldInChatID)
+	}
+	if m.in_chat_type != nil {
+		fields = append(fields, event.FieldInChatType)
+	}
+	if m.platform_timestamp != nil {
+		fields = append(fields, event.FieldPlatformTimestamp)
+	}
+	if m.evidence_message_ids != nil {
+		fields = append(fields, event.FieldEvidenceMessageIds)
+	}
+	if m.span_start != nil {
+		fields = append(fields, event.FieldSpanStart)
+	}
+	if m.span_end != nil {
+		fields = append(fields, event.FieldSpanEnd)
+	}
+	if m.description_vector_1536 != nil {
+		fields = append(fields, event.FieldDescriptionVector1536)
+	}
+	if m.description_vector_1024 != nil {
+		fields = append(fields, event.FieldDescriptionVector1024)
+	}
+	if m.description_vector_768 != nil {
+		fields = append(fields, event.FieldDescriptionVector768)
+	}
+	if m.continues != nil {
+		fields = append(fields, event.FieldContinuesID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, event.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, event.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case event.FieldPlatform:
+		return m.Platform()
+	case event.FieldName:
+		return m.Name()
+	case event.FieldTags:
+		return m.Tags()
+	case event.FieldDescription:
+		return m.Description()
+	case event.FieldFromName:
+		return m.FromName()
+	case event.FieldUnmatchedNames:
+		return m.UnmatchedNames()
+	case event.FieldInChatID:
+		return m.InChatID()
+	case event.FieldInChatType:
+		return m.InChatType()
+	case event.FieldPlatformTimestamp:
+		return m.PlatformTimestamp()
+	case event.FieldEvidenceMessageIds:
+		return m.EvidenceMessageIds()
+	case event.FieldSpanStart:
+		return m.SpanStart()
+	case event.FieldSpanEnd:
+		return m.SpanEnd()
+	case event.FieldDescriptionVector1536:
+		return m.DescriptionVector1536()
+	case event.FieldDescriptionVector1024:
+		return m.DescriptionVector1024()
+	case event.FieldDescriptionVector768:
+		return m.DescriptionVector768()
+	case event.FieldContinuesID:
+		return m.ContinuesID()
+	case event.FieldCreatedAt:
+		return m.CreatedAt()
+	case event.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case event.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case event.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case event.FieldName:
+		return m.OldName(ctx)
+	case event.FieldTags:
+		return m.OldTags(ctx)
+	case event.FieldDescription:
+		return m.OldDescription(ctx)
+	case event.FieldFromName:
+		return m.OldFromName(ctx)
+	case event.FieldUnmatchedNames:
+		return m.OldUnmatchedNames(ctx)
+	case event.FieldInChatID:
+		return m.OldInChatID(ctx)
+	case event.FieldInChatType:
+		return m.OldInChatType(ctx)
+	case event.FieldPlatformTimestamp:
+		return m.OldPlatformTimestamp(ctx)
+	case event.FieldEvidenceMessageIds:
+		return m.OldEvidenceMessageIds(ctx)
+	case event.FieldSpanStart:
+		return m.OldSpanStart(ctx)
+	case event.FieldSpanEnd:
+		return m.OldSpanEnd(ctx)
+	case event.FieldDescriptionVector1536:
+		return m.OldDescriptionVector1536(ctx)
+	case event.FieldDescriptionVector1024:
+		return m.OldDescriptionVector1024(ctx)
+	case event.FieldDescriptionVector768:
+		return m.OldDescriptionVector768(ctx)
+	case event.FieldContinuesID:
+		return m.OldContinuesID(ctx)
+	case event.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case event.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Event field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case event.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case event.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case event.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case event.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case event.FieldFromName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromName(v)
+		return nil
+	case event.FieldUnmatchedNames:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnmatchedNames(v)
+		return nil
+	case event.FieldInChatID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInChatID(v)
+		return nil
+	case event.FieldInChatType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInChatType(v)
+		return nil
+	case event.FieldPlatformTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatformTimestamp(v)
+		return nil
+	case event.FieldEvidenceMessageIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvidenceMessageIds(v)
+		return nil
+	case event.FieldSpanStart:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpanStart(v)
+		return nil
+	case event.FieldSpanEnd:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpanEnd(v)
+		return nil
+	case event.FieldDescriptionVector1536:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescriptionVector1536(v)
+		return nil
+	case event.FieldDescriptionVector1024:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescriptionVector1024(v)
+		return nil
+	case event.FieldDescriptionVector768:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescriptionVector768(v)
+		return nil
+	case event.FieldContinuesID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContinuesID(v)
+		return nil
+	case event.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case event.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventMutation) AddedFields() []string {
+	var fields []string
+	if m.addplatform_timestamp != nil {
+		fields = append(fields, event.FieldPlatformTimestamp)
+	}
+	if m.addspan_start != nil {
+		fields = append(fields, event.FieldSpanStart)
+	}
+	if m.addspan_end != nil {
+		fields = append(fields, event.FieldSpanEnd)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, event.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, event.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldPlatformTimestamp:
+		return m.AddedPlatformTimestamp()
+	case event.FieldSpanStart:
+		return m.AddedSpanStart()
+	case event.FieldSpanEnd:
+		return m.AddedSpanEnd()
+	case event.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case event.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldPlatformTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlatformTimestamp(v)
+		return nil
+	case event.FieldSpanStart:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpanStart(v)
+		return nil
+	case event.FieldSpanEnd:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpanEnd(v)
+		return nil
+	case event.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case event.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(event.FieldTags) {
+		fields = append(fields, event.FieldTags)
+	}
+	if m.FieldCleared(event.FieldUnmatchedNames) {
+		fields = append(fields, event.FieldUnmatchedNames)
+	}
+	if m.FieldCleared(event.FieldDescriptionVector1536) {
+		fields = append(fields, event.FieldDescriptionVector1536)
+	}
+	if m.FieldCleared(event.FieldDescriptionVector1024) {
+		fields = append(fields, event.FieldDescriptionVector1024)
+	}
+	if m.FieldCleared(event.FieldDescriptionVector768) {
+		fields = append(fields, event.FieldDescriptionVector768)
+	}
+	if m.FieldCleared(event.FieldContinuesID) {
+		fields = append(fields, event.FieldContinuesID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventMutation) ClearField(name string) error {
+	switch name {
+	case event.FieldTags:
+		m.ClearTags()
+		return nil
+	case event.FieldUnmatchedNames:
+		m.ClearUnmatchedNames()
+		return nil
+	case event.FieldDescriptionVector1536:
+		m.ClearDescriptionVector1536()
+		return nil
+	case event.FieldDescriptionVector1024:
+		m.ClearDescriptionVector1024()
+		return nil
+	case event.FieldDescriptionVector768:
+		m.ClearDescriptionVector768()
+		return nil
+	case event.FieldContinuesID:
+		m.ClearContinuesID()
+		return nil
+	}
+	return fmt.Errorf("unknown Event nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventMutation) ResetField(name string) error {
+	switch name {
+	case event.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case event.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case event.FieldName:
+		m.ResetName()
+		return nil
+	case event.FieldTags:
+		m.ResetTags()
+		return nil
+	case event.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case event.FieldFromName:
+		m.ResetFromName()
+		return nil
+	case event.FieldUnmatchedNames:
+		m.ResetUnmatchedNames()
+		return nil
+	case event.FieldInChatID:
+		m.ResetInChatID()
+		return nil
+	case event.FieldInChatType:
+		m.ResetInChatType()
+		return nil
+	case event.FieldPlatformTimestamp:
+		m.ResetPlatformTimestamp()
+		return nil
+	case event.FieldEvidenceMessageIds:
+		m.ResetEvidenceMessageIds()
+		return nil
+	case event.FieldSpanStart:
+		m.ResetSpanStart()
+		return nil
+	case event.FieldSpanEnd:
+		m.ResetSpanEnd()
+		return nil
+	case event.FieldDescriptionVector1536:
+		m.ResetDescriptionVector1536()
+		return nil
+	case event.FieldDescriptionVector1024:
+		m.ResetDescriptionVector1024()
+		return nil
+	case event.FieldDescriptionVector768:
+		m.ResetDescriptionVector768()
+		return nil
+	case event.FieldContinuesID:
+		m.ResetContinuesID()
+		return nil
+	case event.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case event.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.identities != nil {
+		edges = append(edges, event.EdgeIdentities)
+	}
+	if m.continues != nil {
+		edges = append(edges, event.EdgeContinues)
+	}
+	if m.continuations != nil {
+		edges = append(edges, event.EdgeContinuations)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case event.EdgeContinues:
+		if id := m.continues; id != nil {
+			return []ent.Value{*id}
+		}
+	case event.EdgeContinuations:
+		ids := make([]ent.Value, 0, len(m.continuations))
+		for id := range m.continuations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedidentities != nil {
+		edges = append(edges, event.EdgeIdentities)
+	}
+	if m.removedcontinuations != nil {
+		edges = append(edges, event.EdgeContinuations)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case event.EdgeContinuations:
+		ids := make([]ent.Value, 0, len(m.removedcontinuations))
+		for id := range m.removedcontinuations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedidentities {
+		edges = append(edges, event.EdgeIdentities)
+	}
+	if m.clearedcontinues {
+		edges = append(edges, event.EdgeContinues)
+	}
+	if m.clearedcontinuations {
+		edges = append(edges, event.EdgeContinuations)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case event.EdgeIdentities:
+		return m.clearedidentities
+	case event.EdgeContinues:
+		return m.clearedcontinues
+	case event.EdgeContinuations:
+		return m.clearedcontinuations
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventMutation) ClearEdge(name string) error {
+	switch name {
+	case event.EdgeContinues:
+		m.ClearContinues()
+		return nil
+	}
+	return fmt.Errorf("unknown Event unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventMutation) ResetEdge(name string) error {
+	switch name {
+	case event.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
+	case event.EdgeContinues:
+		m.ResetContinues()
+		return nil
+	case event.EdgeContinuations:
+		m.ResetContinuations()
+		return nil
+	}
+	return fmt.Errorf("unknown Event edge %s", name)
+}
+
+// IdentityMutation represents an operation that mutates the Identity nodes in the graph.
+type IdentityMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	workspace_id      *uuid.UUID
+	platform          *string
+	platform_user_id  *string
+	username          *string
+	display_name      *string
+	profile_photo_url *string
+	alt_ids           *[]string
+	appendalt_ids     []string
+	opted_out         *bool
+	forgotten_at      *int64
+	addforgotten_at   *int64
+	created_at        *int64
+	addcreated_at     *int64
+	updated_at        *int64
+	addupdated_at     *int64
+	clearedFields     map[string]struct{}
+	events            map[uuid.UUID]struct{}
+	removedevents     map[uuid.UUID]struct{}
+	clearedevents     bool
+	profiles          map[uuid.UUID]struct{}
+	removedprofiles   map[uuid.UUID]struct{}
+	clearedprofiles   bool
+	person            *uuid.UUID
+	clearedperson     bool
+	done              bool
+	oldValue          func(context.Context) (*Identity, error)
+	predicates        []predicate.Identity
+}
+
+var _ ent.Mutation = (*IdentityMutation)(nil)
+
+// identityOption allows management of the mutation configuration using functional options.
+type identityOption func(*IdentityMutation)
+
+// newIdentityMutation creates new mutation for the Identity entity.
+func newIdentityMutation(c config, op Op, opts ...identityOption) *IdentityMutation {
+	m := &IdentityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdentity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdentityID sets the ID field of the mutation.
+func withIdentityID(id uuid.UUID) identityOption {
+	return func(m *IdentityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Identity
+		)
+		m.oldValue = func(ctx context.Context) (*Identity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Identity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdentity sets the old Identity of the mutation.
+func withIdentity(node *Identity) identityOption {
+	return func(m *IdentityMutation) {
+		m.oldValue = func(context.Context) (*Identity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdentityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdentityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Identity entities.
+func (m *IdentityMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdentityMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdentityMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Identity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *IdentityMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *IdentityMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *IdentityMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetPlatform sets the "platform" field.
+func (m *IdentityMutation) SetPlatform(s string) {
+	m.platform = &s
+}
+
+// Platform returns the value of the "platform" field in the mutation.
+func (m *IdentityMutation) Platform() (r string, exists bool) {
+	v := m.platform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatform returns the old "platform" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldPlatform(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+	}
+	return oldValue.Platform, nil
+}
+
+// ResetPlatform resets all changes to the "platform" field.
+func (m *IdentityMutation) ResetPlatform() {
+	m.platform = nil
+}
+
+// SetPlatformUserID sets the "platform_user_id" field.
+func (m *IdentityMutation) SetPlatformUserID(s string) {
+	m.platform_user_id = &s
+}
+
+// PlatformUserID returns the value of the "platform_user_id" field in the mutation.
+func (m *IdentityMutation) PlatformUserID() (r string, exists bool) {
+	v := m.platform_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatformUserID returns the old "platform_user_id" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldPlatformUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatformUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatformUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatformUserID: %w", err)
+	}
+	return oldValue.PlatformUserID, nil
+}
+
+// ResetPlatformUserID resets all changes to the "platform_user_id" field.
+func (m *IdentityMutation) ResetPlatformUserID() {
+	m.platform_user_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *IdentityMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *IdentityMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *IdentityMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *IdentityMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *IdentityMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *IdentityMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetProfilePhotoURL sets the "profile_photo_url" field.
+func (m *IdentityMutation) SetProfilePhotoURL(s string) {
+	m.profile_photo_url = &s
+}
+
+// ProfilePhotoURL returns the value of the "profile_photo_url" field in the mutation.
+func (m *IdentityMutation) ProfilePhotoURL() (r string, exists bool) {
+	v := m.profile_photo_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfilePhotoURL returns the old "profile_photo_url" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldProfilePhotoURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfilePhotoURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfilePhotoURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfilePhotoURL: %w", err)
+	}
+	return oldValue.ProfilePhotoURL, nil
+}
+
+// ResetProfilePhotoURL resets all changes to the "profile_photo_url" field.
+func (m *IdentityMutation) ResetProfilePhotoURL() {
+	m.profile_photo_url = nil
+}
+
+// SetAltIds sets the "alt_ids" field.
+func (m *IdentityMutation) SetAltIds(s []string) {
+	m.alt_ids = &s
+	m.appendalt_ids = nil
+}
+
+// AltIds returns the value of the "alt_ids" field in the mutation.
+func (m *IdentityMutation) AltIds() (r []string, exists bool) {
+	v := m.alt_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAltIds returns the old "alt_ids" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldAltIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAltIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAltIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAltIds: %w", err)
+	}
+	return oldValue.AltIds, nil
+}
+
+// AppendAltIds adds s to the "alt_ids" field.
+func (m *IdentityMutation) AppendAltIds(s []string) {
+	m.appendalt_ids = append(m.appendalt_ids, s...)
+}
+
+// AppendedAltIds returns the list of values that were appended to the "alt_ids" field in this mutation.
+func (m *IdentityMutation) AppendedAltIds() ([]string, bool) {
+	if len(m.appendalt_ids) == 0 {
+		return nil, false
+	}
+	return m.appendalt_ids, true
+}
+
+// ClearAltIds clears the value of the "alt_ids" field.
+func (m *IdentityMutation) ClearAltIds() {
+	m.alt_ids = nil
+	m.appendalt_ids = nil
+	m.clearedFields[identity.FieldAltIds] = struct{}{}
+}
+
+// AltIdsCleared returns if the "alt_ids" field was cleared in this mutation.
+func (m *IdentityMutation) AltIdsCleared() bool {
+	_, ok := m.clearedFields[identity.FieldAltIds]
+	return ok
+}
+
+// ResetAltIds resets all changes to the "alt_ids" field.
+func (m *IdentityMutation) ResetAltIds() {
+	m.alt_ids = nil
+	m.appendalt_ids = nil
+	delete(m.clearedFields, identity.FieldAltIds)
+}
+
+// SetPersonID sets the "person_id" field.
+func (m *IdentityMutation) SetPersonID(u uuid.UUID) {
+	m.person = &u
+}
+
+// PersonID returns the value of the "person_id" field in the mutation.
+func (m *IdentityMutation) PersonID() (r uuid.UUID, exists bool) {
+	v := m.person
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPersonID returns the old "person_id" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldPersonID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPersonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPersonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPersonID: %w", err)
+	}
+	return oldValue.PersonID, nil
+}
+
+// ClearPersonID clears the value of the "person_id" field.
+func (m *IdentityMutation) ClearPersonID() {
+	m.person = nil
+	m.clearedFields[identity.FieldPersonID] = struct{}{}
+}
+
+// PersonIDCleared returns if the "person_id" field was cleared in this mutation.
+func (m *IdentityMutation) PersonIDCleared() bool {
+	_, ok := m.clearedFields[identity.FieldPersonID]
+	return ok
+}
+
+// ResetPersonID resets all changes to the "person_id" field.
+func (m *IdentityMutation) ResetPersonID() {
+	m.person = nil
+	delete(m.clearedFields, identity.FieldPersonID)
+}
+
+// SetOptedOut sets the "opted_out" field.
+func (m *IdentityMutation) SetOptedOut(b bool) {
+	m.opted_out = &b
+}
+
+// OptedOut returns the value of the "opted_out" field in the mutation.
+func (m *IdentityMutation) OptedOut() (r bool, exists bool) {
+	v := m.opted_out
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptedOut returns the old "opted_out" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldOptedOut(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptedOut is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptedOut requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptedOut: %w", err)
+	}
+	return oldValue.OptedOut, nil
+}
+
+// ResetOptedOut resets all changes to the "opted_out" field.
+func (m *IdentityMutation) ResetOptedOut() {
+	m.opted_out = nil
+}
+
+// SetForgottenAt sets the "forgotten_at" field.
+func (m *IdentityMutation) SetForgottenAt(i int64) {
+	m.forgotten_at = &i
+	m.addforgotten_at = nil
+}
+
+// ForgottenAt returns the value of the "forgotten_at" field in the mutation.
+func (m *IdentityMutation) ForgottenAt() (r int64, exists bool) {
+	v := m.forgotten_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForgottenAt returns the old "forgotten_at" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldForgottenAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForgottenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForgottenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForgottenAt: %w", err)
+	}
+	return oldValue.ForgottenAt, nil
+}
+
+// AddForgottenAt adds i to the "forgotten_at" field.
+func (m *IdentityMutation) AddForgottenAt(i int64) {
+	if m.addforgotten_at != nil {
+		*m.addforgotten_at += i
+	} else {
+		m.addforgotten_at = &i
+	}
+}
+
+// AddedForgottenAt returns the value that was added to the "forgotten_at" field in this mutation.
+func (m *IdentityMutation) AddedForgottenAt() (r int64, exists bool) {
+	v := m.addforgotten_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetForgottenAt resets all changes to the "forgotten_at" field.
+func (m *IdentityMutation) ResetForgottenAt() {
+	m.forgotten_at = nil
+	m.addforgotten_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdentityMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdentityMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *IdentityMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *IdentityMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdentityMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IdentityMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IdentityMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *IdentityMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *IdentityMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IdentityMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// AddEventIDs adds the "events" edge to the Event entity by ids.
+func (m *IdentityMutation) AddEventIDs(ids ...uuid.UUID) {
+	if m.events == nil {
+		m.events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvents clears the "events" edge to the Event entity.
+func (m *IdentityMutation) ClearEvents() {
+	m.clearedevents = true
+}
+
+// EventsCleared reports if the "events" edge to the Event entity was cleared.
+func (m *IdentityMutation) EventsCleared() bool {
+	return m.clearedevents
+}
+
+// RemoveEventIDs removes the "events" edge to the Event entity by IDs.
+func (m *IdentityMutation) RemoveEventIDs(ids ...uuid.UUID) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEvents returns the removed IDs of the "events" edge to the Event entity.
+func (m *IdentityMutation) RemovedEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *IdentityMutation) EventsIDs() (ids []uuid.UUID) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEvents resets all changes to the "events" edge.
+func (m *IdentityMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
+}
+
+// AddProfileIDs adds the "profiles" edge to the Profile entity by ids.
+func (m *IdentityMutation) AddProfileIDs(ids ...uuid.UUID) {
+	if m.profiles == nil {
+		m.profiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.profiles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProfiles clears the "profiles" edge to the Profile entity.
+func (m *IdentityMutation) ClearProfiles() {
+	m.clearedprofiles = true
+}
+
+// ProfilesCleared reports if the "profiles" edge to the Profile entity was cleared.
+func (m *IdentityMutation) ProfilesCleared() bool {
+	return m.clearedprofiles
+}
+
+// RemoveProfileIDs removes the "profiles" edge to the Profile entity by IDs.
+func (m *IdentityMutation) RemoveProfileIDs(ids ...uuid.UUID) {
+	if m.removedprofiles == nil {
+		m.removedprofiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.profiles, ids[i])
+		m.removedprofiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProfiles returns the removed IDs of the "profiles" edge to the Profile entity.
+func (m *IdentityMutation) RemovedProfilesIDs() (ids []uuid.UUID) {
+	for id := range m.removedprofiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProfilesIDs returns the "profiles" edge IDs in the mutation.
+func (m *IdentityMutation) ProfilesIDs() (ids []uuid.UUID) {
+	for id := range m.profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProfiles resets all changes to the "profiles" edge.
+func (m *IdentityMutation) ResetProfiles() {
+	m.profiles = nil
+	m.clearedprofiles = false
+	m.removedprofiles = nil
+}
+
+// ClearPerson clears the "person" edge to the Person entity.
+func (m *IdentityMutation) ClearPerson() {
+	m.clearedperson = true
+	m.clearedFields[identity.FieldPersonID] = struct{}{}
+}
+
+// PersonCleared reports if the "person" edge to the Person entity was cleared.
+func (m *IdentityMutation) PersonCleared() bool {
+	return m.PersonIDCleared() || m.clearedperson
+}
+
+// PersonIDs returns the "person" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PersonID instead. It exists only for internal usage by the builders.
+func (m *IdentityMutation) PersonIDs() (ids []uuid.UUID) {
+	if id := m.person; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPerson resets all changes to the "person" edge.
+func (m *IdentityMutation) ResetPerson() {
+	m.person = nil
+	m.clearedperson = false
+}
+
+// Where appends a list predicates to the IdentityMutation builder.
+func (m *IdentityMutation) Where(ps ...predicate.Identity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdentityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Identity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdentityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdentityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Identity).
+func (m *IdentityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdentityMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.workspace_id != nil {
+		fields = append(fields, identity.FieldWorkspaceID)
+	}
+	if m.platform != nil {
+		fields = append(fields, identity.FieldPlatform)
+	}
+	if m.platform_user_id != nil {
+		fields = append(fields, identity.FieldPlatformUserID)
+	}
+	if m.username != nil {
+		fields = append(fields, identity.FieldUsername)
+	}
+	if m.display_name != nil {
+		fields = append(fields, identity.FieldDisplayName)
+	}
+	if m.profile_photo_url != nil {
+		fields = append(fields, identity.FieldProfilePhotoURL)
+	}
+	if m.alt_ids != nil {
+		fields = append(fields, identity.FieldAltIds)
+	}
+	if m.person != nil {
+		fields = append(fields, identity.FieldPersonID)
+	}
+	if m.opted_out != nil {
+		fields = append(fields, identity.FieldOptedOut)
+	}
+	if m.forgotten_at != nil {
+		fields = append(fields, identity.FieldForgottenAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, identity.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, identity.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdentityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case identity.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case identity.FieldPlatform:
+		return m.Platform()
+	case identity.FieldPlatformUserID:
+		return m.PlatformUserID()
+	case identity.FieldUsername:
+		return m.Username()
+	case identity.FieldDisplayName:
+		return m.DisplayName()
+	case identity.FieldProfilePhotoURL:
+		return m.ProfilePhotoURL()
+	case identity.FieldAltIds:
+		return m.AltIds()
+	case identity.FieldPersonID:
+		return m.PersonID()
+	case identity.FieldOptedOut:
+		return m.OptedOut()
+	case identity.FieldForgottenAt:
+		return m.ForgottenAt()
+	case identity.FieldCreatedAt:
+		return m.CreatedAt()
+	case identity.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case identity.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case identity.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case identity.FieldPlatformUserID:
+		return m.OldPlatformUserID(ctx)
+	case identity.FieldUsername:
+		return m.OldUsername(ctx)
+	case identity.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case identity.FieldProfilePhotoURL:
+		return m.OldProfilePhotoURL(ctx)
+	case identity.FieldAltIds:
+		return m.OldAltIds(ctx)
+	case identity.FieldPersonID:
+		return m.OldPersonID(ctx)
+	case identity.FieldOptedOut:
+		return m.OldOptedOut(ctx)
+	case identity.FieldForgottenAt:
+		return m.OldForgottenAt(ctx)
+	case identity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case identity.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Identity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdentityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case identity.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case identity.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case identity.FieldPlatformUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatformUserID(v)
+		return nil
+	case identity.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case identity.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case identity.FieldProfilePhotoURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfilePhotoURL(v)
+		return nil
+	case identity.FieldAltIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAltIds(v)
+		return nil
+	case identity.FieldPersonID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPersonID(v)
+		return nil
+	case identity.FieldOptedOut:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptedOut(v)
+		return nil
+	case identity.FieldForgottenAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForgottenAt(v)
+		return nil
+	case identity.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case identity.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Identity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdentityMutation) AddedFields() []string {
+	var fields []string
+	if m.addforgotten_at != nil {
+		fields = append(fields, identity.FieldForgottenAt)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, identity.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, identity.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdentityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case identity.FieldForgottenAt:
+		return m.AddedForgottenAt()
+	case identity.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case identity.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdentityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case identity.FieldForgottenAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddForgottenAt(v)
+		return nil
+	case identity.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case identity.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Identity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdentityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(identity.FieldAltIds) {
+		fields = append(fields, identity.FieldAltIds)
+	}
+	if m.FieldCleared(identity.FieldPersonID) {
+		fields = append(fields, identity.FieldPersonID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdentityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdentityMutation) ClearField(name string) error {
+	switch name {
+	case identity.FieldAltIds:
+		m.ClearAltIds()
+		return nil
+	case identity.FieldPersonID:
+		m.ClearPersonID()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdentityMutation) ResetField(name string) error {
+	switch name {
+	case identity.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case identity.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case identity.FieldPlatformUserID:
+		m.ResetPlatformUserID()
+		return nil
+	case identity.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case identity.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case identity.FieldProfilePhotoURL:
+		m.ResetProfilePhotoURL()
+		return nil
+	case identity.FieldAltIds:
+		m.ResetAltIds()
+		return nil
+	case identity.FieldPersonID:
+		m.ResetPersonID()
+		return nil
+	case identity.FieldOptedOut:
+		m.ResetOptedOut()
+		return nil
+	case identity.FieldForgottenAt:
+		m.ResetForgottenAt()
+		return nil
+	case identity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case identity.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdentityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.events != nil {
+		edges = append(edges, identity.EdgeEvents)
+	}
+	if m.profiles != nil {
+		edges = append(edges, identity.EdgeProfiles)
+	}
+	if m.person != nil {
+		edges = append(edges, identity.EdgePerson)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdentityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case identity.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	case identity.EdgeProfiles:
+		ids := make([]ent.Value, 0, len(m.profiles))
+		for id := range m.profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case identity.EdgePerson:
+		if id := m.person; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdentityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedevents != nil {
+		edges = append(edges, identity.EdgeEvents)
+	}
+	if m.removedprofiles != nil {
+		edges = append(edges, identity.EdgeProfiles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdentityMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case identity.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case identity.EdgeProfiles:
+		ids := make([]ent.Value, 0, len(m.removedprofiles))
+		for id := range m.removedprofiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdentityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedevents {
+		edges = append(edges, identity.EdgeEvents)
+	}
+	if m.clearedprofiles {
+		edges = append(edges, identity.EdgeProfiles)
+	}
+	if m.clearedperson {
+		edges = append(edges, identity.EdgePerson)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdentityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case identity.EdgeEvents:
+		return m.clearedevents
+	case identity.EdgeProfiles:
+		return m.clearedprofiles
+	case identity.EdgePerson:
+		return m.clearedperson
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdentityMutation) ClearEdge(name string) error {
+	switch name {
+	case identity.EdgePerson:
+		m.ClearPerson()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdentityMutation) ResetEdge(name string) error {
+	switch name {
+	case identity.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	case identity.EdgeProfiles:
+		m.ResetProfiles()
+		return nil
+	case identity.EdgePerson:
+		m.ResetPerson()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity edge %s", name)
+}
+
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	workspace_id    *uuid.UUID
+	kind            *job.Kind
+	payload         *jsontext.Value
+	appendpayload   jsontext.Value
+	key             *string
+	status          *job.Status
+	attempts        *int
+	addattempts     *int
+	max_attempts    *int
+	addmax_attempts *int
+	run_at          *int64
+	addrun_at       *int64
+	locked_by       *string
+	locked_until    *int64
+	addlocked_until *int64
+	last_error      *string
+	finished_at     *int64
+	addfinished_at  *int64
+	created_at      *int64
+	addcreated_at   *int64
+	updated_at      *int64
+	addupdated_at   *int64
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Job, error)
+	predicates      []predicate.Job
+}
+
+var _ ent.Mutation = (*JobMutation)(nil)
+
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobID sets the ID field of the mutation.
+func withJobID(id uuid.UUID) jobOption {
+	return func(m *JobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Job
+		)
+		m.oldValue = func(ctx context.Context) (*Job, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Job.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *JobMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *JobMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *JobMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *JobMutation) SetKind(j job.Kind) {
+	m.kind = &j
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *JobMutation) Kind() (r job.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldKind(ctx context.Context) (v job.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *JobMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *JobMutation) SetPayload(j jsontext.Value) {
+	m.payload = &j
+	m.appendpayload = nil
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *JobMutation) Payload() (r jsontext.Value, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldPayload(ctx context.Context) (v jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// AppendPayload adds j to the "payload" field.
+func (m *JobMutation) AppendPayload(j jsontext.Value) {
+	m.appendpayload = append(m.appendpayload, j...)
+}
+
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *JobMutation) AppendedPayload() (jsontext.Value, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *JobMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+}
+
+// SetKey sets the "key" field.
+func (m *JobMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *JobMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ClearKey clears the value of the "key" field.
+func (m *JobMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[job.FieldKey] = struct{}{}
+}
+
+// KeyCleared returns if the "key" field was cleared in this mutation.
+func (m *JobMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[job.FieldKey]
+	return ok
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *JobMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, job.FieldKey)
+}
+
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(j job.Status) {
+	m.status = &j
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r job.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStatus(ctx context.Context) (v job.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *JobMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
+}
+
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *JobMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAttempts returns the old "max_attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *JobMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
+	}
+}
+
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *JobMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *JobMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
+}
+
+// SetRunAt sets the "run_at" field.
+func (m *JobMutation) SetRunAt(i int64) {
+	m.run_at = &i
+	m.addrun_at = nil
+}
+
+// RunAt returns the value of the "run_at" field in the mutation.
+func (m *JobMutation) RunAt() (r int64, exists bool) {
+	v := m.run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunAt returns the old "run_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldRunAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunAt: %w", err)
+	}
+	return oldValue.RunAt, nil
+}
+
+// AddRunAt adds i to the "run_at" field.
+func (m *JobMutation) AddRunAt(i int64) {
+	if m.addrun_at != nil {
+		*m.addrun_at += i
+	} else {
+		m.addrun_at = &i
+	}
+}
+
+// AddedRunAt returns the value that was added to the "run_at" field in this mutation.
+func (m *JobMutation) AddedRunAt() (r int64, exists bool) {
+	v := m.addrun_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRunAt resets all changes to the "run_at" field.
+func (m *JobMutation) ResetRunAt() {
+	m.run_at = nil
+	m.addrun_at = nil
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (m *JobMutation) SetLockedBy(s string) {
+	m.locked_by = &s
+}
+
+// LockedBy returns the value of the "locked_by" field in the mutation.
+func (m *JobMutation) LockedBy() (r string, exists bool) {
+	v := m.locked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedBy returns the old "locked_by" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLockedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedBy: %w", err)
+	}
+	return oldValue.LockedBy, nil
+}
+
+// ResetLockedBy resets all changes to the "locked_by" field.
+func (m *JobMutation) ResetLockedBy() {
+	m.locked_by = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *JobMutation) SetLockedUntil(i int64) {
+	m.locked_until = &i
+	m.addlocked_until = nil
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *JobMutation) LockedUntil() (r int64, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLockedUntil(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// AddLockedUntil adds i to the "locked_until" field.
+func (m *JobMutation) AddLockedUntil(i int64) {
+	if m.addlocked_until != nil {
+		*m.addlocked_until += i
+	} else {
+		m.addlocked_until = &i
+	}
+}
+
+// AddedLockedUntil returns the value that was added to the "locked_until" field in this mutation.
+func (m *JobMutation) AddedLockedUntil() (r int64, exists bool) {
+	v := m.addlocked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *JobMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	m.addlocked_until = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *JobMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *JobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *JobMutation) ResetLastError() {
+	m.last_error = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobMutation) SetFinishedAt(i int64) {
+	m.finished_at = &i
+	m.addfinished_at = nil
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobMutation) FinishedAt() (r int64, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldFinishedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (m *JobMutation) AddFinishedAt(i int64) {
+	if m.addfinished_at != nil {
+		*m.addfinished_at += i
+	} else {
+		m.addfinished_at = &i
+	}
+}
+
+// AddedFinishedAt returns the value that was added to the "finished_at" field in this mutation.
+func (m *JobMutation) AddedFinishedAt() (r int64, exists bool) {
+	v := m.addfinished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	m.addfinished_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *JobMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *JobMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JobMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JobMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *JobMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *JobMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.workspace_id != nil {
+		fields = append(fields, job.FieldWorkspaceID)
+	}
+	if m.kind != nil {
+		fields = append(fields, job.FieldKind)
+	}
+	if m.payload != nil {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.key != nil {
+		fields = append(fields, job.FieldKey)
+	}
+	if m.status != nil {
+		fields = append(fields, job.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	if m.run_at != nil {
+		fields = append(fields, job.FieldRunAt)
+	}
+	if m.locked_by != nil {
+		fields = append(fields, job.FieldLockedBy)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, job.FieldLockedUntil)
+	}
+	if m.last_error != nil {
+		fields = append(fields, job.FieldLastError)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, job.FieldFinishedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, job.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case job.FieldKind:
+		return m.Kind()
+	case job.FieldPayload:
+		return m.Payload()
+	case job.FieldKey:
+		return m.Key()
+	case job.FieldStatus:
+		return m.Status()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case job.FieldRunAt:
+		return m.RunAt()
+	case job.FieldLockedBy:
+		return m.LockedBy()
+	case job.FieldLockedUntil:
+		return m.LockedUntil()
+	case job.FieldLastError:
+		return m.LastError()
+	case job.FieldFinishedAt:
+		return m.FinishedAt()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	case job.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case job.FieldKind:
+		return m.OldKind(ctx)
+	case job.FieldPayload:
+		return m.OldPayload(ctx)
+	case job.FieldKey:
+		return m.OldKey(ctx)
+	case job.FieldStatus:
+		return m.OldStatus(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case job.FieldRunAt:
+		return m.OldRunAt(ctx)
+	case job.FieldLockedBy:
+		return m.OldLockedBy(ctx)
+	case job.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case job.FieldLastError:
+		return m.OldLastError(ctx)
+	case job.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case job.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case job.FieldKind:
+		v, ok := value.(job.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case job.FieldPayload:
+		v, ok := value.(jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case job.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(job.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case job.FieldRunAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunAt(v)
+		return nil
+	case job.FieldLockedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedBy(v)
+		return nil
+	case job.FieldLockedUntil:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case job.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case job.FieldFinishedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case job.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	if m.addrun_at != nil {
+		fields = append(fields, job.FieldRunAt)
+	}
+	if m.addlocked_until != nil {
+		fields = append(fields, job.FieldLockedUntil)
+	}
+	if m.addfinished_at != nil {
+		fields = append(fields, job.FieldFinishedAt)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, job.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	case job.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	case job.FieldRunAt:
+		return m.AddedRunAt()
+	case job.FieldLockedUntil:
+		return m.AddedLockedUntil()
+	case job.FieldFinishedAt:
+		return m.AddedFinishedAt()
+	case job.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case job.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	case job.FieldRunAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRunAt(v)
+		return nil
+	case job.FieldLockedUntil:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLockedUntil(v)
+		return nil
+	case job.FieldFinishedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFinishedAt(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case job.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldKey) {
+		fields = append(fields, job.FieldKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldKey:
+		m.ClearKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case job.FieldKind:
+		m.ResetKind()
+		return nil
+	case job.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case job.FieldKey:
+		m.ResetKey()
+		return nil
+	case job.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case job.FieldRunAt:
+		m.ResetRunAt()
+		return nil
+	case job.FieldLockedBy:
+		m.ResetLockedBy()
+		return nil
+	case job.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case job.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case job.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// JoinedChatMutation represents an operation that mutates the JoinedChat nodes in the graph.
+type JoinedChatMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	workspace_id   *uuid.UUID
+	platform       *string
+	chat_id        *string
+	chat_name      *string
+	chat_type      *string
+	dialog_date    *int64
+	adddialog_date *int64
+	created_at     *int64
+	addcreated_at  *int64
+	updated_at     *int64
+	addupdated_at  *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*JoinedChat, error)
+	predicates     []predicate.JoinedChat
+}
+
+var _ ent.Mutation = (*JoinedChatMutation)(nil)
+
+// joinedchatOption allows management of the mutation configuration using functional options.
+type joinedchatOption func(*JoinedChatMutation)
+
+// newJoinedChatMutation creates new mutation for the JoinedChat entity.
+func newJoinedChatMutation(c config, op Op, opts ...joinedchatOption) *JoinedChatMutation {
+	m := &JoinedChatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJoinedChat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJoinedChatID sets the ID field of the mutation.
+func withJoinedChatID(id uuid.UUID) joinedchatOption {
+	return func(m *JoinedChatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JoinedChat
+		)
+		m.oldValue = func(ctx context.Context) (*JoinedChat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JoinedChat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJoinedChat sets the old JoinedChat of the mutation.
+func withJoinedChat(node *JoinedChat) joinedchatOption {
+	return func(m *JoinedChatMutation) {
+		m.oldValue = func(context.Context) (*JoinedChat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JoinedChatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JoinedChatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of JoinedChat entities.
+func (m *JoinedChatMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JoinedChatMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JoinedChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JoinedChat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *JoinedChatMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *JoinedChatMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the JoinedChat entity.
+// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinedChatMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *JoinedChatMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetPlatform sets the "platform" field.
+func (m *JoinedChatMutation) SetPlatform(s string) {
+	m.platform = &s
+}
+
+// Platform returns the value of the "platform" field in the mutation.
+func (m *JoinedChatMutation) Platform() (r string, exists bool) {
+	v := m.platform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatform returns the old "platform" field's value of the JoinedChat entity.
+// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinedChatMutation) OldPlatform(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+	}
+	return oldValue.Platform, nil
+}
+
+// ResetPlatform resets all changes to the "platform" field.
+func (m *JoinedChatMutation) ResetPlatform() {
+	m.platform = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *JoinedChatMutation) SetChatID(s string) {
+	m.chat_id = &s
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *JoinedChatMutation) ChatID() (r string, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the JoinedChat entity.
+// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinedChatMutation) OldChatID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *JoinedChatMutation) ResetChatID() {
+	m.chat_id = nil
+}
+
+// SetChatName sets the "chat_name" field.
+func (m *JoinedChatMutation) SetChatName(s string) {
+	m.chat_name = &s
+}
+
+// ChatName returns the value of the "chat_name" field in the mutation.
+func (m *JoinedChatMutation) ChatName() (r string, exists bool) {
+	v := m.chat_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatName returns the old "chat_name" field's value of the JoinedChat entity.
+// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinedChatMutation) OldChatName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatName: %w", err)
+	}
+	return oldValue.ChatName, nil
+}
+
+// ResetChatName resets all changes to the "chat_name" field.
+func (m *JoinedChatMutation) ResetChatName() {
+	m.chat_name = nil
+}
+
+// SetChatType sets the "chat_type" field.
+func (m *JoinedChatMutation) SetChatType(s string) {
+	m.chat_type = &s
+}
+
+// ChatType returns the value of the "chat_type" field in the mutation.
+func (m *JoinedChatMutation) ChatType() (r string, exists bool) {
+	v := m.chat_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatType returns the old "chat_type" field's value of the JoinedChat entity.
+// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinedChatMutation) OldChatType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatType: %w", err)
+	}
+	return oldValue.ChatType, nil
+}
+
+// ResetChatType resets all changes to the "chat_type" field.
+func (m *JoinedChatMutation) ResetChatType() {
+	m.chat_type = nil
+}
+
+// SetDialogDate sets the "dialog_date" field.
+func (m *JoinedChatMutation) SetDialogDate(i int64) {
+	m.dialog_date = &i
+	m.adddialog_date = nil
+}
+
+// DialogDate returns the value of the "dialog_date" field in the mutation.
+func (m *JoinedChatMutation) DialogDate() (r int64, exists bool) {
+	v := m.dialog_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDialogDate returns the old "dialog_date" field's value of the JoinedChat entity.
+// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinedChatMutation) OldDialogDate(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDialogDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDialogDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDialogDate: %w", err)
+	}
+	return oldValue.DialogDate, nil
+}
+
+// AddDialogDate adds i to the "dialog_date" field.
+func (m *JoinedChatMutation) AddDialogDate(i int64) {
+	if m.adddialog_date != nil {
+		*m.adddialog_date += i
+	} else {
+		m.adddialog_date = &i
+	}
+}
+
+// AddedDialogDate returns the value that was added to the "dialog_date" field in this mutation.
+func (m *JoinedChatMutation) AddedDialogDate() (r int64, exists bool) {
+	v := m.adddialog_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDialogDate resets all changes to the "dialog_date" field.
+func (m *JoinedChatMutation) ResetDialogDate() {
+	m.dialog_date = nil
+	m.adddialog_date = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JoinedChatMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JoinedChatMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the JoinedChat entity.
+// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinedChatMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *JoinedChatMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *JoinedChatMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JoinedChatMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JoinedChatMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JoinedChatMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the JoinedChat entity.
+// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinedChatMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *JoinedChatMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *JoinedChatMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JoinedChatMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the JoinedChatMutation builder.
+func (m *JoinedChatMutation) Where(ps ...predicate.JoinedChat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JoinedChatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JoinedChatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JoinedChat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JoinedChatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JoinedChatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JoinedChat).
+func (m *JoinedChatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JoinedChatMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.workspace_id != nil {
+		fields = append(fields, joinedchat.FieldWorkspaceID)
+	}
+	if m.platform != nil {
+		fields = append(fields, joinedchat.FieldPlatform)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, joinedchat.FieldChatID)
+	}
+	if m.chat_name != nil {
+		fields = append(fields, joinedchat.FieldChatName)
+	}
+	if m.chat_type != nil {
+		fields = append(fields, joinedchat.FieldChatType)
+	}
+	if m.dialog_date != nil {
+		fields = append(fields, joinedchat.FieldDialogDate)
+	}
+	if m.created_at != nil {
+		fields = append(fields, joinedchat.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, joinedchat.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JoinedChatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case joinedchat.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case joinedchat.FieldPlatform:
+		return m.Platform()
+	case joinedchat.FieldChatID:
+		return m.ChatID()
+	case joinedchat.FieldChatName:
+		return m.ChatName()
+	case joinedchat.FieldChatType:
+		return m.ChatType()
+	case joinedchat.FieldDialogDate:
+		return m.DialogDate()
+	case joinedchat.FieldCreatedAt:
+		return m.CreatedAt()
+	case joinedchat.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JoinedChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case joinedchat.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case joinedchat.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case joinedchat.FieldChatID:
+		return m.OldChatID(ctx)
+	case joinedchat.FieldChatName:
+		return m.OldChatName(ctx)
+	case joinedchat.FieldChatType:
+		return m.OldChatType(ctx)
+	case joinedchat.FieldDialogDate:
+		return m.OldDialogDate(ctx)
+	case joinedchat.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case joinedchat.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown JoinedChat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JoinedChatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case joinedchat.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case joinedchat.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case joinedchat.FieldChatID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case joinedchat.FieldChatName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatName(v)
+		return nil
+	case joinedchat.FieldChatType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatType(v)
+		return nil
+	case joinedchat.FieldDialogDate:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDialogDate(v)
+		return nil
+	case joinedchat.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case joinedchat.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JoinedChat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JoinedChatMutation) AddedFields() []string {
+	var fields []string
+	if m.adddialog_date != nil {
+		fields = append(fields, joinedchat.FieldDialogDate)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, joinedchat.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, joinedchat.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JoinedChatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case joinedchat.FieldDialogDate:
+		return m.AddedDialogDate()
+	case joinedchat.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case joinedchat.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JoinedChatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case joinedchat.FieldDialogDate:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDialogDate(v)
+		return nil
+	case joinedchat.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case joinedchat.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JoinedChat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JoinedChatMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JoinedChatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JoinedChatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown JoinedChat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JoinedChatMutation) ResetField(name string) error {
+	switch name {
+	case joinedchat.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case joinedchat.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case joinedchat.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case joinedchat.FieldChatName:
+		m.ResetChatName()
+		return nil
+	case joinedchat.FieldChatType:
+		m.ResetChatType()
+		return nil
+	case joinedchat.FieldDialogDate:
+		m.ResetDialogDate()
+		return nil
+	case joinedchat.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case joinedchat.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown JoinedChat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JoinedChatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JoinedChatMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JoinedChatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JoinedChatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JoinedChatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JoinedChatMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JoinedChatMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JoinedChat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JoinedChatMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JoinedChat edge %s", name)
+}
+
+// LLMCacheEntryMutation represents an operation that mutates the LLMCacheEntry nodes in the graph.
+type LLMCacheEntryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	key            *string
+	stage          *string
+	model          *string
+	prompt_version *string
+	input_hash     *string
+	value          *[]byte
+	expires_at     *int64
+	addexpires_at  *int64
+	created_at     *int64
+	addcreated_at  *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*LLMCacheEntry, error)
+	predicates     []predicate.LLMCacheEntry
+}
+
+var _ ent.Mutation = (*LLMCacheEntryMutation)(nil)
+
+// llmcacheentryOption allows management of the mutation configuration using functional options.
+type llmcacheentryOption func(*LLMCacheEntryMutation)
+
+// newLLMCacheEntryMutation creates new mutation for the LLMCacheEntry entity.
+func newLLMCacheEntryMutation(c config, op Op, opts ...llmcacheentryOption) *LLMCacheEntryMutation {
+	m := &LLMCacheEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLLMCacheEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLLMCacheEntryID sets the ID field of the mutation.
+func withLLMCacheEntryID(id uuid.UUID) llmcacheentryOption {
+	return func(m *LLMCacheEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LLMCacheEntry
+		)
+		m.oldValue = func(ctx context.Context) (*LLMCacheEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LLMCacheEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLLMCacheEntry sets the old LLMCacheEntry of the mutation.
+func withLLMCacheEntry(node *LLMCacheEntry) llmcacheentryOption {
+	return func(m *LLMCacheEntryMutation) {
+		m.oldValue = func(context.Context) (*LLMCacheEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LLMCacheEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LLMCacheEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LLMCacheEntry entities.
+func (m *LLMCacheEntryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LLMCacheEntryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LLMCacheEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LLMCacheEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *LLMCacheEntryMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *LLMCacheEntryMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the LLMCacheEntry entity.
+// If the LLMCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheEntryMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *LLMCacheEntryMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetStage sets the "stage" field.
+func (m *LLMCacheEntryMutation) SetStage(s string) {
+	m.stage = &s
+}
+
+// Stage returns the value of the "stage" field in the mutation.
+func (m *LLMCacheEntryMutation) Stage() (r string, exists bool) {
+	v := m.stage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStage returns the old "stage" field's value of the LLMCacheEntry entity.
+// If the LLMCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheEntryMutation) OldStage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStage: %w", err)
+	}
+	return oldValue.Stage, nil
+}
+
+// ResetStage resets all changes to the "stage" field.
+func (m *LLMCacheEntryMutation) ResetStage() {
+	m.stage = nil
+}
+
+// SetModel sets the "model" field.
+func (m *LLMCacheEntryMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *LLMCacheEntryMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the LLMCacheEntry entity.
+// If the LLMCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheEntryMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *LLMCacheEntryMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (m *LLMCacheEntryMutation) SetPromptVersion(s string) {
+	m.prompt_version = &s
+}
+
+// PromptVersion returns the value of the "prompt_version" field in the mutation.
+func (m *LLMCacheEntryMutation) PromptVersion() (r string, exists bool) {
+	v := m.prompt_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptVersion returns the old "prompt_version" field's value of the LLMCacheEntry entity.
+// If the LLMCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheEntryMutation) OldPromptVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptVersion: %w", err)
+	}
+	return oldValue.PromptVersion, nil
+}
+
+// ResetPromptVersion resets all changes to the "prompt_version" field.
+func (m *LLMCacheEntryMutation) ResetPromptVersion() {
+	m.prompt_version = nil
+}
+
+// SetInputHash sets the "input_hash" field.
+func (m *LLMCacheEntryMutation) SetInputHash(s string) {
+	m.input_hash = &s
+}
+
+// InputHash returns the value of the "input_hash" field in the mutation.
+func (m *LLMCacheEntryMutation) InputHash() (r string, exists bool) {
+	v := m.input_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputHash returns the old "input_hash" field's value of the LLMCacheEntry entity.
+// If the LLMCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheEntryMutation) OldInputHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputHash: %w", err)
+	}
+	return oldValue.InputHash, nil
+}
+
+// ResetInputHash resets all changes to the "input_hash" field.
+func (m *LLMCacheEntryMutation) ResetInputHash() {
+	m.input_hash = nil
+}
+
+// SetValue sets the "value" field.
+func (m *LLMCacheEntryMutation) SetValue(b []byte) {
+	m.value = &b
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *LLMCacheEntryMutation) Value() (r []byte, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the LLMCacheEntry entity.
+// If the LLMCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheEntryMutation) OldValue(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *LLMCacheEntryMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *LLMCacheEntryMutation) SetExpiresAt(i int64) {
+	m.expires_at = &i
+	m.addexpires_at = nil
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *LLMCacheEntryMutation) ExpiresAt() (r int64, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the LLMCacheEntry entity.
+// If the LLMCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheEntryMutation) OldExpiresAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (m *LLMCacheEntryMutation) AddExpiresAt(i int64) {
+	if m.addexpires_at != nil {
+		*m.addexpires_at += i
+	} else {
+		m.addexpires_at = &i
+	}
+}
+
+// AddedExpiresAt returns the value that was added to the "expires_at" field in this mutation.
+func (m *LLMCacheEntryMutation) AddedExpiresAt() (r int64, exists bool) {
+	v := m.addexpires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *LLMCacheEntryMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	m.addexpires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LLMCacheEntryMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LLMCacheEntryMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LLMCacheEntry entity.
+// If the LLMCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMCacheEntryMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *LLMCacheEntryMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *LLMCacheEntryMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LLMCacheEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// Where appends a list predicates to the LLMCacheEntryMutation builder.
+func (m *LLMCacheEntryMutation) Where(ps ...predicate.LLMCacheEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LLMCacheEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LLMCacheEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LLMCacheEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LLMCacheEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LLMCacheEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LLMCacheEntry).
+func (m *LLMCacheEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LLMCacheEntryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.key != nil {
+		fields = append(fields, llmcacheentry.FieldKey)
+	}
+	if m.stage != nil {
+		fields = append(fields, llmcacheentry.FieldStage)
+	}
+	if m.model != nil {
+		fields = append(fields, llmcacheentry.FieldModel)
+	}
+	if m.prompt_version != nil {
+		fields = append(fields, llmcacheentry.FieldPromptVersion)
+	}
+	if m.input_hash != nil {
+		fields = append(fields, llmcacheentry.FieldInputHash)
+	}
+	if m.value != nil {
+		fields = append(fields, llmcacheentry.FieldValue)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, llmcacheentry.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, llmcacheentry.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LLMCacheEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case llmcacheentry.FieldKey:
+		return m.Key()
+	case llmcacheentry.FieldStage:
+		return m.Stage()
+	case llmcacheentry.FieldModel:
+		return m.Model()
+	case llmcacheentry.FieldPromptVersion:
+		return m.PromptVersion()
+	case llmcacheentry.FieldInputHash:
+		return m.InputHash()
+	case llmcacheentry.FieldValue:
+		return m.Value()
+	case llmcacheentry.FieldExpiresAt:
+		return m.ExpiresAt()
+	case llmcacheentry.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LLMCacheEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case llmcacheentry.FieldKey:
+		return m.OldKey(ctx)
+	case llmcacheentry.FieldStage:
+		return m.OldStage(ctx)
+	case llmcacheentry.FieldModel:
+		return m.OldModel(ctx)
+	case llmcacheentry.FieldPromptVersion:
+		return m.OldPromptVersion(ctx)
+	case llmcacheentry.FieldInputHash:
+		return m.OldInputHash(ctx)
+	case llmcacheentry.FieldValue:
+		return m.OldValue(ctx)
+	case llmcacheentry.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case llmcacheentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LLMCacheEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LLMCacheEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case llmcacheentry.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case llmcacheentry.FieldStage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStage(v)
+		return nil
+	case llmcacheentry.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case llmcacheentry.FieldPromptVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptVersion(v)
+		return nil
+	case llmcacheentry.FieldInputHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputHash(v)
+		return nil
+	case llmcacheentry.FieldValue:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case llmcacheentry.FieldExpiresAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case llmcacheentry.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCacheEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LLMCacheEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addexpires_at != nil {
+		fields = append(fields, llmcacheentry.FieldExpiresAt)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, llmcacheentry.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LLMCacheEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case llmcacheentry.FieldExpiresAt:
+		return m.AddedExpiresAt()
+	case llmcacheentry.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LLMCacheEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case llmcacheentry.FieldExpiresAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiresAt(v)
+		return nil
+	case llmcacheentry.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCacheEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LLMCacheEntryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LLMCacheEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LLMCacheEntryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LLMCacheEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LLMCacheEntryMutation) ResetField(name string) error {
+	switch name {
+	case llmcacheentry.FieldKey:
+		m.ResetKey()
+		return nil
+	case llmcacheentry.FieldStage:
+		m.ResetStage()
+		return nil
+	case llmcacheentry.FieldModel:
+		m.ResetModel()
+		return nil
+	case llmcacheentry.FieldPromptVersion:
+		m.ResetPromptVersion()
+		return nil
+	case llmcacheentry.FieldInputHash:
+		m.ResetInputHash()
+		return nil
+	case llmcacheentry.FieldValue:
+		m.ResetValue()
+		return nil
+	case llmcacheentry.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case llmcacheentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LLMCacheEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LLMCacheEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LLMCacheEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LLMCacheEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LLMCacheEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LLMCacheEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LLMCacheEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LLMCacheEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LLMCacheEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LLMCacheEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LLMCacheEntry edge %s", name)
+}
+
+// OwnerAccountLinkMutation represents an operation that mutates the OwnerAccountLink nodes in the graph.
+type OwnerAccountLinkMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	workspace_id     *uuid.UUID
+	subject          *string
+	owner_account_id *uuid.UUID
+	created_at       *int64
+	addcreated_at    *int64
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*OwnerAccountLink, error)
+	predicates       []predicate.OwnerAccountLink
+}
+
+var _ ent.Mutation = (*OwnerAccountLinkMutation)(nil)
+
+// owneraccountlinkOption allows management of the mutation configuration using functional options.
+type owneraccountlinkOption func(*OwnerAccountLinkMutation)
+
+// newOwnerAccountLinkMutation creates new mutation for the OwnerAccountLink entity.
+func newOwnerAccountLinkMutation(c config, op Op, opts ...owneraccountlinkOption) *OwnerAccountLinkMutation {
+	m := &OwnerAccountLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOwnerAccountLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOwnerAccountLinkID sets the ID field of the mutation.
+func withOwnerAccountLinkID(id uuid.UUID) owneraccountlinkOption {
+	return func(m *OwnerAccountLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OwnerAccountLink
+		)
+		m.oldValue = func(ctx context.Context) (*OwnerAccountLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OwnerAccountLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOwnerAccountLink sets the old OwnerAccountLink of the mutation.
+func withOwnerAccountLink(node *OwnerAccountLink) owneraccountlinkOption {
+	return func(m *OwnerAccountLinkMutation) {
+		m.oldValue = func(context.Context) (*OwnerAccountLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OwnerAccountLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OwnerAccountLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OwnerAccountLink entities.
+func (m *OwnerAccountLinkMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OwnerAccountLinkMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OwnerAccountLinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OwnerAccountLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *OwnerAccountLinkMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *OwnerAccountLinkMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the OwnerAccountLink entity.
+// If the OwnerAccountLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnerAccountLinkMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *OwnerAccountLinkMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *OwnerAccountLinkMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *OwnerAccountLinkMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the OwnerAccountLink entity.
+// If the OwnerAccountLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnerAccountLinkMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *OwnerAccountLinkMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetOwnerAccountID sets the "owner_account_id" field.
+func (m *OwnerAccountLinkMutation) SetOwnerAccountID(u uuid.UUID) {
+	m.owner_account_id = &u
+}
+
+// OwnerAccountID returns the value of the "owner_account_id" field in the mutation.
+func (m *OwnerAccountLinkMutation) OwnerAccountID() (r uuid.UUID, exists bool) {
+	v := m.owner_account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerAccountID returns the old "owner_account_id" field's value of the OwnerAccountLink entity.
+// If the OwnerAccountLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnerAccountLinkMutation) OldOwnerAccountID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerAccountID: %w", err)
+	}
+	return oldValue.OwnerAccountID, nil
+}
+
+// ResetOwnerAccountID resets all changes to the "owner_account_id" field.
+func (m *OwnerAccountLinkMutation) ResetOwnerAccountID() {
+	m.owner_account_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OwnerAccountLinkMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OwnerAccountLinkMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OwnerAccountLink entity.
+// If the OwnerAccountLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnerAccountLinkMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *OwnerAccountLinkMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *OwnerAccountLinkMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OwnerAccountLinkMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// Where appends a list predicates to the OwnerAccountLinkMutation builder.
+func (m *OwnerAccountLinkMutation) Where(ps ...predicate.OwnerAccountLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OwnerAccountLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OwnerAccountLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OwnerAccountLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OwnerAccountLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OwnerAccountLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OwnerAccountLink).
+func (m *OwnerAccountLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OwnerAccountLinkMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.workspace_id != nil {
+		fields = append(fields, owneraccountlink.FieldWorkspaceID)
+	}
+	if m.subject != nil {
+		fields = append(fields, owneraccountlink.FieldSubject)
+	}
+	if m.owner_account_id != nil {
+		fields = append(fields, owneraccountlink.FieldOwnerAccountID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, owneraccountlink.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OwnerAccountLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case owneraccountlink.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case owneraccountlink.FieldSubject:
+		return m.Subject()
+	case owneraccountlink.FieldOwnerAccountID:
+		return m.OwnerAccountID()
+	case owneraccountlink.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OwnerAccountLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case owneraccountlink.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case owneraccountlink.FieldSubject:
+		return m.OldSubject(ctx)
+	case owneraccountlink.FieldOwnerAccountID:
+		return m.OldOwnerAccountID(ctx)
+	case owneraccountlink.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OwnerAccountLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnerAccountLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case owneraccountlink.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case owneraccountlink.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case owneraccountlink.FieldOwnerAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerAccountID(v)
+		return nil
+	case owneraccountlink.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerAccountLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OwnerAccountLinkMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, owneraccountlink.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OwnerAccountLinkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case owneraccountlink.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnerAccountLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case owneraccountlink.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerAccountLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OwnerAccountLinkMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OwnerAccountLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OwnerAccountLinkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OwnerAccountLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OwnerAccountLinkMutation) ResetField(name string) error {
+	switch name {
+	case owneraccountlink.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case owneraccountlink.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case owneraccountlink.FieldOwnerAccountID:
+		m.ResetOwnerAccountID()
+		return nil
+	case owneraccountlink.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerAccountLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OwnerAccountLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OwnerAccountLinkMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OwnerAccountLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OwnerAccountLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OwnerAccountLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OwnerAccountLinkMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OwnerAccountLinkMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OwnerAccountLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OwnerAccountLinkMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OwnerAccountLink edge %s", name)
+}
+
+// PersonMutation represents an operation that mutates the Person nodes in the graph.
+type PersonMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	workspace_id      *uuid.UUID
+	display_name      *string
+	created_at        *int64
+	addcreated_at     *int64
+	updated_at        *int64
+	addupdated_at     *int64
+	clearedFields     map[string]struct{}
+	identities        map[uuid.UUID]struct{}
+	removedidentities map[uuid.UUID]struct{}
+	clearedidentities bool
+	done              bool
+	oldValue          func(context.Context) (*Person, error)
+	predicates        []predicate.Person
+}
+
+var _ ent.Mutation = (*PersonMutation)(nil)
+
+// personOption allows management of the mutation configuration using functional options.
+type personOption func(*PersonMutation)
+
+// newPersonMutation creates new mutation for the Person entity.
+func newPersonMutation(c config, op Op, opts ...personOption) *PersonMutation {
+	m := &PersonMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePerson,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPersonID sets the ID field of the mutation.
+func withPersonID(id uuid.UUID) personOption {
+	return func(m *PersonMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Person
+		)
+		m.oldValue = func(ctx context.Context) (*Person, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Person.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPerson sets the old Person of the mutation.
+func withPerson(node *Person) personOption {
+	return func(m *PersonMutation) {
+		m.oldValue = func(context.Context) (*Person, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PersonMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PersonMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Person entities.
+func (m *PersonMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PersonMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PersonMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Person.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *PersonMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *PersonMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Person entity.
+// If the Person object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *PersonMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *PersonMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *PersonMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Person entity.
+// If the Person object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *PersonMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PersonMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PersonMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Person entity.
+// If the Person object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *PersonMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *PersonMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PersonMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PersonMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PersonMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Person entity.
+// If the Person object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *PersonMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *PersonMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PersonMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by ids.
+func (m *PersonMutation) AddIdentityIDs(ids ...uuid.UUID) {
+	if m.identities == nil {
+		m.identities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIdentities clears the "identities" edge to the Identity entity.
+func (m *PersonMutation) ClearIdentities() {
+	m.clearedidentities = true
+}
+
+// IdentitiesCleared reports if the "identities" edge to the Identity entity was cleared.
+func (m *PersonMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
+}
+
+// RemoveIdentityIDs removes the "identities" edge to the Identity entity by IDs.
+func (m *PersonMutation) RemoveIdentityIDs(ids ...uuid.UUID) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIdentities returns the removed IDs of the "identities" edge to the Identity entity.
+func (m *PersonMutation) RemovedIdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *PersonMutation) IdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *PersonMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
+}
+
+// Where appends a list predicates to the PersonMutation builder.
+func (m *PersonMutation) Where(ps ...predicate.Person) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PersonMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PersonMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Person, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PersonMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PersonMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Person).
+func (m *PersonMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PersonMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.workspace_id != nil {
+		fields = append(fields, person.FieldWorkspaceID)
+	}
+	if m.display_name != nil {
+		fields = append(fields, person.FieldDisplayName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, person.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, person.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PersonMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case person.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case person.FieldDisplayName:
+		return m.DisplayName()
+	case person.FieldCreatedAt:
+		return m.CreatedAt()
+	case person.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PersonMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case person.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case person.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case person.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case person.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Person field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersonMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case person.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case person.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case person.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case person.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Person field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PersonMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, person.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, person.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PersonMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case person.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case person.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersonMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case person.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case person.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Person numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PersonMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PersonMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PersonMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Person nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PersonMutation) ResetField(name string) error {
+	switch name {
+	case person.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case person.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case person.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case person.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Person field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PersonMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.identities != nil {
+		edges = append(edges, person.EdgeIdentities)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PersonMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case person.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PersonMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedidentities != nil {
+		edges = append(edges, person.EdgeIdentities)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PersonMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case person.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PersonMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedidentities {
+		edges = append(edges, person.EdgeIdentities)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PersonMutation) EdgeCleared(name string) bool {
+	switch name {
+	case person.EdgeIdentities:
+		return m.clearedidentities
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PersonMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Person unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PersonMutation) ResetEdge(name string) error {
+	switch name {
+	case person.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
+	}
+	return fmt.Errorf("unknown Person edge %s", name)
+}
+
+// PersonAuditLogMutation represents an operation that mutates the PersonAuditLog nodes in the graph.
+type PersonAuditLogMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	workspace_id            *uuid.UUID
+	action                  *personauditlog.Action
+	person_id               *uuid.UUID
+	source_person_ids       *[]uuid.UUID
+	appendsource_person_ids []uuid.UUID
+	identity_ids            *[]uuid.UUID
+	appendidentity_ids      []uuid.UUID
+	chat_ids                *[]string
+	appendchat_ids          []string
+	actor                   *string
+	reason                  *string
+	counts                  *map[string]int
+	score                   *float64
+	addscore                *float64
+	created_at              *int64
+	addcreated_at           *int64
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*PersonAuditLog, error)
+	predicates              []predicate.PersonAuditLog
+}
+
+var _ ent.Mutation = (*PersonAuditLogMutation)(nil)
+
+// personauditlogOption allows management of the mutation configuration using functional options.
+type personauditlogOption func(*PersonAuditLogMutation)
+
+// newPersonAuditLogMutation creates new mutation for the PersonAuditLog entity.
+func newPersonAuditLogMutation(c config, op Op, opts ...personauditlogOption) *PersonAuditLogMutation {
+	m := &PersonAuditLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePersonAuditLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// SetIsReply sets the "is_reply" field.
-func (m *ChatMessageMutation) SetIsReply(b bool) {
-	m.is_reply = &b
+// withPersonAuditLogID sets the ID field of the mutation.
+func withPersonAuditLogID(id uuid.UUID) personauditlogOption {
+	return func(m *PersonAuditLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PersonAuditLog
+		)
+		m.oldValue = func(ctx context.Context) (*PersonAuditLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PersonAuditLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// IsReply returns the value of the "is_reply" field in the mutation.
-func (m *ChatMessageMutation) IsReply() (r bool, exists bool) {
-	v := m.is_reply
-	if v == nil {
-		return
+// withPersonAuditLog sets the old PersonAuditLog of the mutation.
+func withPersonAuditLog(node *PersonAuditLog) personauditlogOption {
+	return func(m *PersonAuditLogMutation) {
+		m.oldValue = func(context.Context) (*PersonAuditLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldIsReply returns the old "is_reply" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldIsReply(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsReply is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsReply requires an ID field in the mutation")
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PersonAuditLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PersonAuditLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsReply: %w", err)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PersonAuditLog entities.
+func (m *PersonAuditLogMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PersonAuditLogMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.IsReply, nil
+	return *m.id, true
 }
 
-// ResetIsReply resets all changes to the "is_reply" field.
-func (m *ChatMessageMutation) ResetIsReply() {
-	m.is_reply = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PersonAuditLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PersonAuditLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetReplyToName sets the "reply_to_name" field.
-func (m *ChatMessageMutation) SetReplyToName(s string) {
-	m.reply_to_name = &s
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *PersonAuditLogMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
 }
 
-// ReplyToName returns the value of the "reply_to_name" field in the mutation.
-func (m *ChatMessageMutation) ReplyToName() (r string, exists bool) {
-	v := m.reply_to_name
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *PersonAuditLogMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReplyToName returns the old "reply_to_name" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldWorkspaceID returns the old "workspace_id" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldReplyToName(ctx context.Context) (v string, err error) {
+func (m *PersonAuditLogMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReplyToName is only allowed on UpdateOne operations")
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReplyToName requires an ID field in the mutation")
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReplyToName: %w", err)
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
 	}
-	return oldValue.ReplyToName, nil
+	return oldValue.WorkspaceID, nil
 }
 
-// ResetReplyToName resets all changes to the "reply_to_name" field.
-func (m *ChatMessageMutation) ResetReplyToName() {
-	m.reply_to_name = nil
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *PersonAuditLogMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
 }
 
-// SetReplyToID sets the "reply_to_id" field.
-func (m *ChatMessageMutation) SetReplyToID(s string) {
-	m.reply_to_id = &s
+// SetAction sets the "action" field.
+func (m *PersonAuditLogMutation) SetAction(pe personauditlog.Action) {
+	m.action = &pe
 }
 
-// ReplyToID returns the value of the "reply_to_id" field in the mutation.
-func (m *ChatMessageMutation) ReplyToID() (r string, exists bool) {
-	v := m.reply_to_id
+// Action returns the value of the "action" field in the mutation.
+func (m *PersonAuditLogMutation) Action() (r personauditlog.Action, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReplyToID returns the old "reply_to_id" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldReplyToID(ctx context.Context) (v string, err error) {
+func (m *PersonAuditLogMutation) OldAction(ctx context.Context) (v personauditlog.Action, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReplyToID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReplyToID requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReplyToID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.ReplyToID, nil
+	return oldValue.Action, nil
 }
 
-// ResetReplyToID resets all changes to the "reply_to_id" field.
-func (m *ChatMessageMutation) ResetReplyToID() {
-	m.reply_to_id = nil
+// ResetAction resets all changes to the "action" field.
+func (m *PersonAuditLogMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetPlatformTimestamp sets the "platform_timestamp" field.
-func (m *ChatMessageMutation) SetPlatformTimestamp(i int64) {
-	m.platform_timestamp = &i
-	m.addplatform_timestamp = nil
+// SetPersonID sets the "person_id" field.
+func (m *PersonAuditLogMutation) SetPersonID(u uuid.UUID) {
+	m.person_id = &u
 }
 
-// PlatformTimestamp returns the value of the "platform_timestamp" field in the mutation.
-func (m *ChatMessageMutation) PlatformTimestamp() (r int64, exists bool) {
-	v := m.platform_timestamp
+// PersonID returns the value of the "person_id" field in the mutation.
+func (m *PersonAuditLogMutation) PersonID() (r uuid.UUID, exists bool) {
+	v := m.person_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlatformTimestamp returns the old "platform_timestamp" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldPersonID returns the old "person_id" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldPlatformTimestamp(ctx context.Context) (v int64, err error) {
+func (m *PersonAuditLogMutation) OldPersonID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatformTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldPersonID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatformTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldPersonID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatformTimestamp: %w", err)
-	}
-	return oldValue.PlatformTimestamp, nil
-}
-
-// AddPlatformTimestamp adds i to the "platform_timestamp" field.
-func (m *ChatMessageMutation) AddPlatformTimestamp(i int64) {
-	if m.addplatform_timestamp != nil {
-		*m.addplatform_timestamp += i
-	} else {
-		m.addplatform_timestamp = &i
+		return v, fmt.Errorf("querying old value for OldPersonID: %w", err)
 	}
+	return oldValue.PersonID, nil
 }
 
-// AddedPlatformTimestamp returns the value that was added to the "platform_timestamp" field in this mutation.
-func (m *ChatMessageMutation) AddedPlatformTimestamp() (r int64, exists bool) {
-	v := m.addplatform_timestamp
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetPlatformTimestamp resets all changes to the "platform_timestamp" field.
-func (m *ChatMessageMutation) ResetPlatformTimestamp() {
-	m.platform_timestamp = nil
-	m.addplatform_timestamp = nil
+// ResetPersonID resets all changes to the "person_id" field.
+func (m *PersonAuditLogMutation) ResetPersonID() {
+	m.person_id = nil
 }
 
-// SetContentVector1536 sets the "content_vector_1536" field.
-func (m *ChatMessageMutation) SetContentVector1536(pg pgvector.Vector) {
-	m.content_vector_1536 = &pg
+// SetSourcePersonIds sets the "source_person_ids" field.
+func (m *PersonAuditLogMutation) SetSourcePersonIds(u []uuid.UUID) {
+	m.source_person_ids = &u
+	m.appendsource_person_ids = nil
 }
 
-// ContentVector1536 returns the value of the "content_vector_1536" field in the mutation.
-func (m *ChatMessageMutation) ContentVector1536() (r pgvector.Vector, exists bool) {
-	v := m.content_vector_1536
+// SourcePersonIds returns the value of the "source_person_ids" field in the mutation.
+func (m *PersonAuditLogMutation) SourcePersonIds() (r []uuid.UUID, exists bool) {
+	v := m.source_person_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentVector1536 returns the old "content_vector_1536" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldSourcePersonIds returns the old "source_person_ids" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldContentVector1536(ctx context.Context) (v *pgvector.Vector, err error) {
+func (m *PersonAuditLogMutation) OldSourcePersonIds(ctx context.Context) (v []uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentVector1536 is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourcePersonIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentVector1536 requires an ID field in the mutation")
+		return v, errors.New("OldSourcePersonIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentVector1536: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourcePersonIds: %w", err)
 	}
-	return oldValue.ContentVector1536, nil
+	return oldValue.SourcePersonIds, nil
 }
 
-// ResetContentVector1536 resets all changes to the "content_vector_1536" field.
-func (m *ChatMessageMutation) ResetContentVector1536() {
-	m.content_vector_1536 = nil
+// AppendSourcePersonIds adds u to the "source_person_ids" field.
+func (m *PersonAuditLogMutation) AppendSourcePersonIds(u []uuid.UUID) {
+	m.appendsource_person_ids = append(m.appendsource_person_ids, u...)
 }
 
-// SetContentVector1024 sets the "content_vector_1024" field.
-func (m *ChatMessageMutation) SetContentVector1024(pg pgvector.Vector) {
-	m.content_vector_1024 = &pg
+// AppendedSourcePersonIds returns the list of values that were appended to the "source_person_ids" field in this mutation.
+func (m *PersonAuditLogMutation) AppendedSourcePersonIds() ([]uuid.UUID, bool) {
+	if len(m.appendsource_person_ids) == 0 {
+		return nil, false
+	}
+	return m.appendsource_person_ids, true
 }
 
-// ContentVector1024 returns the value of the "content_vector_1024" field in the mutation.
-func (m *ChatMessageMutation) ContentVector1024() (r pgvector.Vector, exists bool) {
-	v := m.content_vector_1024
+// ResetSourcePersonIds resets all changes to the "source_person_ids" field.
+func (m *PersonAuditLogMutation) ResetSourcePersonIds() {
+	m.source_person_ids = nil
+	m.appendsource_person_ids = nil
+}
+
+// SetIdentityIds sets the "identity_ids" field.
+func (m *PersonAuditLogMutation) SetIdentityIds(u []uuid.UUID) {
+	m.identity_ids = &u
+	m.appendidentity_ids = nil
+}
+
+// IdentityIds returns the value of the "identity_ids" field in the mutation.
+func (m *PersonAuditLogMutation) IdentityIds() (r []uuid.UUID, exists bool) {
+	v := m.identity_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentVector1024 returns the old "content_vector_1024" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldIdentityIds returns the old "identity_ids" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldContentVector1024(ctx context.Context) (v *pgvector.Vector, err error) {
+func (m *PersonAuditLogMutation) OldIdentityIds(ctx context.Context) (v []uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentVector1024 is only allowed on UpdateOne operations")
+		return v, errors.New("OldIdentityIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentVector1024 requires an ID field in the mutation")
+		return v, errors.New("OldIdentityIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentVector1024: %w", err)
+		return v, fmt.Errorf("querying old value for OldIdentityIds: %w", err)
 	}
-	return oldValue.ContentVector1024, nil
+	return oldValue.IdentityIds, nil
 }
 
-// ResetContentVector1024 resets all changes to the "content_vector_1024" field.
-func (m *ChatMessageMutation) ResetContentVector1024() {
-	m.content_vector_1024 = nil
+// AppendIdentityIds adds u to the "identity_ids" field.
+func (m *PersonAuditLogMutation) AppendIdentityIds(u []uuid.UUID) {
+	m.appendidentity_ids = append(m.appendidentity_ids, u...)
 }
 
-// SetContentVector768 sets the "content_vector_768" field.
-func (m *ChatMessageMutation) SetContentVector768(pg pgvector.Vector) {
-	m.content_vector_768 = &pg
+// AppendedIdentityIds returns the list of values that were appended to the "identity_ids" field in this mutation.
+func (m *PersonAuditLogMutation) AppendedIdentityIds() ([]uuid.UUID, bool) {
+	if len(m.appendidentity_ids) == 0 {
+		return nil, false
+	}
+	return m.appendidentity_ids, true
 }
 
-// ContentVector768 returns the value of the "content_vector_768" field in the mutation.
-func (m *ChatMessageMutation) ContentVector768() (r pgvector.Vector, exists bool) {
-	v := m.content_vector_768
+// ResetIdentityIds resets all changes to the "identity_ids" field.
+func (m *PersonAuditLogMutation) ResetIdentityIds() {
+	m.identity_ids = nil
+	m.appendidentity_ids = nil
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (m *PersonAuditLogMutation) SetChatIds(s []string) {
+	m.chat_ids = &s
+	m.appendchat_ids = nil
+}
+
+// ChatIds returns the value of the "chat_ids" field in the mutation.
+func (m *PersonAuditLogMutation) ChatIds() (r []string, exists bool) {
+	v := m.chat_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentVector768 returns the old "content_vector_768" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldChatIds returns the old "chat_ids" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldContentVector768(ctx context.Context) (v *pgvector.Vector, err error) {
+func (m *PersonAuditLogMutation) OldChatIds(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentVector768 is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentVector768 requires an ID field in the mutation")
+		return v, errors.New("OldChatIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentVector768: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatIds: %w", err)
 	}
-	return oldValue.ContentVector768, nil
+	return oldValue.ChatIds, nil
 }
 
-// ResetContentVector768 resets all changes to the "content_vector_768" field.
-func (m *ChatMessageMutation) ResetContentVector768() {
-	m.content_vector_768 = nil
+// AppendChatIds adds s to the "chat_ids" field.
+func (m *PersonAuditLogMutation) AppendChatIds(s []string) {
+	m.appendchat_ids = append(m.appendchat_ids, s...)
 }
 
-// SetJiebaTokens sets the "jieba_tokens" field.
-func (m *ChatMessageMutation) SetJiebaTokens(s []string) {
-	m.jieba_tokens = &s
-	m.appendjieba_tokens = nil
+// AppendedChatIds returns the list of values that were appended to the "chat_ids" field in this mutation.
+func (m *PersonAuditLogMutation) AppendedChatIds() ([]string, bool) {
+	if len(m.appendchat_ids) == 0 {
+		return nil, false
+	}
+	return m.appendchat_ids, true
 }
 
-// JiebaTokens returns the value of the "jieba_tokens" field in the mutation.
-func (m *ChatMessageMutation) JiebaTokens() (r []string, exists bool) {
-	v := m.jieba_tokens
+// ResetChatIds resets all changes to the "chat_ids" field.
+func (m *PersonAuditLogMutation) ResetChatIds() {
+	m.chat_ids = nil
+	m.appendchat_ids = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *PersonAuditLogMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *PersonAuditLogMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJiebaTokens returns the old "jieba_tokens" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldJiebaTokens(ctx context.Context) (v []string, err error) {
+func (m *PersonAuditLogMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJiebaTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJiebaTokens requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJiebaTokens: %w", err)
-	}
-	return oldValue.JiebaTokens, nil
-}
-
-// AppendJiebaTokens adds s to the "jieba_tokens" field.
-func (m *ChatMessageMutation) AppendJiebaTokens(s []string) {
-	m.appendjieba_tokens = append(m.appendjieba_tokens, s...)
-}
-
-// AppendedJiebaTokens returns the list of values that were appended to the "jieba_tokens" field in this mutation.
-func (m *ChatMessageMutation) AppendedJiebaTokens() ([]string, bool) {
-	if len(m.appendjieba_tokens) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return m.appendjieba_tokens, true
-}
-
-// ResetJiebaTokens resets all changes to the "jieba_tokens" field.
-func (m *ChatMessageMutation) ResetJiebaTokens() {
-	m.jieba_tokens = nil
-	m.appendjieba_tokens = nil
+	return oldValue.Actor, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ChatMessageMutation) SetCreatedAt(i int64) {
-	m.created_at = &i
-	m.addcreated_at = nil
+// ResetActor resets all changes to the "actor" field.
+func (m *PersonAuditLogMutation) ResetActor() {
+	m.actor = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ChatMessageMutation) CreatedAt() (r int64, exists bool) {
-	v := m.created_at
+// SetReason sets the "reason" field.
+func (m *PersonAuditLogMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *PersonAuditLogMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *PersonAuditLogMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Reason, nil
 }
 
-// AddCreatedAt adds i to the "created_at" field.
-func (m *ChatMessageMutation) AddCreatedAt(i int64) {
-	if m.addcreated_at != nil {
-		*m.addcreated_at += i
-	} else {
-		m.addcreated_at = &i
-	}
+// ResetReason resets all changes to the "reason" field.
+func (m *PersonAuditLogMutation) ResetReason() {
+	m.reason = nil
 }
 
-// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *ChatMessageMutation) AddedCreatedAt() (r int64, exists bool) {
-	v := m.addcreated_at
+// SetCounts sets the "counts" field.
+func (m *PersonAuditLogMutation) SetCounts(value map[string]int) {
+	m.counts = &value
+}
+
+// Counts returns the value of the "counts" field in the mutation.
+func (m *PersonAuditLogMutation) Counts() (r map[string]int, exists bool) {
+	v := m.counts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ChatMessageMutation) ResetCreatedAt() {
-	m.created_at = nil
-	m.addcreated_at = nil
+// OldCounts returns the old "counts" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonAuditLogMutation) OldCounts(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCounts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCounts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCounts: %w", err)
+	}
+	return oldValue.Counts, nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ChatMessageMutation) SetUpdatedAt(i int64) {
-	m.updated_at = &i
-	m.addupdated_at = nil
+// ClearCounts clears the value of the "counts" field.
+func (m *PersonAuditLogMutation) ClearCounts() {
+	m.counts = nil
+	m.clearedFields[personauditlog.FieldCounts] = struct{}{}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ChatMessageMutation) UpdatedAt() (r int64, exists bool) {
-	v := m.updated_at
+// CountsCleared returns if the "counts" field was cleared in this mutation.
+func (m *PersonAuditLogMutation) CountsCleared() bool {
+	_, ok := m.clearedFields[personauditlog.FieldCounts]
+	return ok
+}
+
+// ResetCounts resets all changes to the "counts" field.
+func (m *PersonAuditLogMutation) ResetCounts() {
+	m.counts = nil
+	delete(m.clearedFields, personauditlog.FieldCounts)
+}
+
+// SetScore sets the "score" field.
+func (m *PersonAuditLogMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *PersonAuditLogMutation) Score() (r float64, exists bool) {
+	v := m.score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldScore returns the old "score" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+func (m *PersonAuditLogMutation) OldScore(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldScore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Score, nil
 }
 
-// AddUpdatedAt adds i to the "updated_at" field.
-func (m *ChatMessageMutation) AddUpdatedAt(i int64) {
-	if m.addupdated_at != nil {
-		*m.addupdated_at += i
+// AddScore adds f to the "score" field.
+func (m *PersonAuditLogMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
 	} else {
-		m.addupdated_at = &i
+		m.addscore = &f
 	}
 }
 
-// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *ChatMessageMutation) AddedUpdatedAt() (r int64, exists bool) {
-	v := m.addupdated_at
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *PersonAuditLogMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ChatMessageMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	m.addupdated_at = nil
+// ResetScore resets all changes to the "score" field.
+func (m *PersonAuditLogMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *ChatMessageMutation) SetDeletedAt(i int64) {
-	m.deleted_at = &i
-	m.adddeleted_at = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *PersonAuditLogMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ChatMessageMutation) DeletedAt() (r int64, exists bool) {
-	v := m.deleted_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PersonAuditLogMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the ChatMessage entity.
-// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PersonAuditLog entity.
+// If the PersonAuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMessageMutation) OldDeletedAt(ctx context.Context) (v int64, err error) {
+func (m *PersonAuditLogMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddDeletedAt adds i to the "deleted_at" field.
-func (m *ChatMessageMutation) AddDeletedAt(i int64) {
-	if m.adddeleted_at != nil {
-		*m.adddeleted_at += i
+// AddCreatedAt adds i to the "created_at" field.
+func (m *PersonAuditLogMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
 	} else {
-		m.adddeleted_at = &i
+		m.addcreated_at = &i
 	}
 }
 
-// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
-func (m *ChatMessageMutation) AddedDeletedAt() (r int64, exists bool) {
-	v := m.adddeleted_at
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *PersonAuditLogMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ChatMessageMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	m.adddeleted_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PersonAuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
 }
 
-// Where appends a list predicates to the ChatMessageMutation builder.
-func (m *ChatMessageMutation) Where(ps ...predicate.ChatMessage) {
+// Where appends a list predicates to the PersonAuditLogMutation builder.
+func (m *PersonAuditLogMutation) Where(ps ...predicate.PersonAuditLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChatMessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PersonAuditLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChatMessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChatMessage, len(ps))
+func (m *PersonAuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PersonAuditLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1032,84 +17221,57 @@ func (m *ChatMessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChatMessageMutation) Op() Op {
+func (m *PersonAuditLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChatMessageMutation) SetOp(op Op) {
+func (m *PersonAuditLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ChatMessage).
-func (m *ChatMessageMutation) Type() string {
+// Type returns the node type of this mutation (PersonAuditLog).
+func (m *PersonAuditLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChatMessageMutation) Fields() []string {
-	fields := make([]string, 0, 20)
-	if m.platform != nil {
-		fields = append(fields, chatmessage.FieldPlatform)
-	}
-	if m.platform_message_id != nil {
-		fields = append(fields, chatmessage.FieldPlatformMessageID)
-	}
-	if m.from_id != nil {
-		fields = append(fields, chatmessage.FieldFromID)
-	}
-	if m.from_name != nil {
-		fields = append(fields, chatmessage.FieldFromName)
-	}
-	if m.from_user_uuid != nil {
-		fields = append(fields, chatmessage.FieldFromUserUUID)
-	}
-	if m.owner_account_id != nil {
-		fields = append(fields, chatmessage.FieldOwnerAccountID)
-	}
-	if m.in_chat_id != nil {
-		fields = append(fields, chatmessage.FieldInChatID)
-	}
-	if m.in_chat_type != nil {
-		fields = append(fields, chatmessage.FieldInChatType)
+func (m *PersonAuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.workspace_id != nil {
+		fields = append(fields, personauditlog.FieldWorkspaceID)
 	}
-	if m.content != nil {
-		fields = append(fields, chatmessage.FieldContent)
+	if m.action != nil {
+		fields = append(fields, personauditlog.FieldAction)
 	}
-	if m.is_reply != nil {
-		fields = append(fields, chatmessage.FieldIsReply)
+	if m.person_id != nil {
+		fields = append(fields, personauditlog.FieldPersonID)
 	}
-	if m.reply_to_name != nil {
-		fields = append(fields, chatmessage.FieldReplyToName)
+	if m.source_person_ids != nil {
+		fields = append(fields, personauditlog.FieldSourcePersonIds)
 	}
-	if m.reply_to_id != nil {
-		fields = append(fields, chatmessage.FieldReplyToID)
+	if m.identity_ids != nil {
+		fields = append(fields, personauditlog.FieldIdentityIds)
 	}
-	if m.platform_timestamp != nil {
-		fields = append(fields, chatmessage.FieldPlatformTimestamp)
+	if m.chat_ids != nil {
+		fields = append(fields, personauditlog.FieldChatIds)
 	}
-	if m.content_vector_1536 != nil {
-		fields = append(fields, chatmessage.FieldContentVector1536)
+	if m.actor != nil {
+		fields = append(fields, personauditlog.FieldActor)
 	}
-	if m.content_vector_1024 != nil {
-		fields = append(fields, chatmessage.FieldContentVector1024)
+	if m.reason != nil {
+		fields = append(fields, personauditlog.FieldReason)
 	}
-	if m.content_vector_768 != nil {
-		fields = append(fields, chatmessage.FieldContentVector768)
+	if m.counts != nil {
+		fields = append(fields, personauditlog.FieldCounts)
 	}
-	if m.jieba_tokens != nil {
-		fields = append(fields, chatmessage.FieldJiebaTokens)
+	if m.score != nil {
+		fields = append(fields, personauditlog.FieldScore)
 	}
 	if m.created_at != nil {
-		fields = append(fields, chatmessage.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, chatmessage.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, chatmessage.FieldDeletedAt)
+		fields = append(fields, personauditlog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1117,265 +17279,160 @@ func (m *ChatMessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChatMessageMutation) Field(name string) (ent.Value, bool) {
+func (m *PersonAuditLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case chatmessage.FieldPlatform:
-		return m.Platform()
-	case chatmessage.FieldPlatformMessageID:
-		return m.PlatformMessageID()
-	case chatmessage.FieldFromID:
-		return m.FromID()
-	case chatmessage.FieldFromName:
-		return m.FromName()
-	case chatmessage.FieldFromUserUUID:
-		return m.FromUserUUID()
-	case chatmessage.FieldOwnerAccountID:
-		return m.OwnerAccountID()
-	case chatmessage.FieldInChatID:
-		return m.InChatID()
-	case chatmessage.FieldInChatType:
-		return m.InChatType()
-	case chatmessage.FieldContent:
-		return m.Content()
-	case chatmessage.FieldIsReply:
-		return m.IsReply()
-	case chatmessage.FieldReplyToName:
-		return m.ReplyToName()
-	case chatmessage.FieldReplyToID:
-		return m.ReplyToID()
-	case chatmessage.FieldPlatformTimestamp:
-		return m.PlatformTimestamp()
-	case chatmessage.FieldContentVector1536:
-		return m.ContentVector1536()
-	case chatmessage.FieldContentVector1024:
-		return m.ContentVector1024()
-	case chatmessage.FieldContentVector768:
-		return m.ContentVector768()
-	case chatmessage.FieldJiebaTokens:
-		return m.JiebaTokens()
-	case chatmessage.FieldCreatedAt:
+	case personauditlog.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case personauditlog.FieldAction:
+		return m.Action()
+	case personauditlog.FieldPersonID:
+		return m.PersonID()
+	case personauditlog.FieldSourcePersonIds:
+		return m.SourcePersonIds()
+	case personauditlog.FieldIdentityIds:
+		return m.IdentityIds()
+	case personauditlog.FieldChatIds:
+		return m.ChatIds()
+	case personauditlog.FieldActor:
+		return m.Actor()
+	case personauditlog.FieldReason:
+		return m.Reason()
+	case personauditlog.FieldCounts:
+		return m.Counts()
+	case personauditlog.FieldScore:
+		return m.Score()
+	case personauditlog.FieldCreatedAt:
 		return m.CreatedAt()
-	case chatmessage.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case chatmessage.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ChatMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case chatmessage.FieldPlatform:
-		return m.OldPlatform(ctx)
-	case chatmessage.FieldPlatformMessageID:
-		return m.OldPlatformMessageID(ctx)
-	case chatmessage.FieldFromID:
-		return m.OldFromID(ctx)
-	case chatmessage.FieldFromName:
-		return m.OldFromName(ctx)
-	case chatmessage.FieldFromUserUUID:
-		return m.OldFromUserUUID(ctx)
-	case chatmessage.FieldOwnerAccountID:
-		return m.OldOwnerAccountID(ctx)
-	case chatmessage.FieldInChatID:
-		return m.OldInChatID(ctx)
-	case chatmessage.FieldInChatType:
-		return m.OldInChatType(ctx)
-	case chatmessage.FieldContent:
-		return m.OldContent(ctx)
-	case chatmessage.FieldIsReply:
-		return m.OldIsReply(ctx)
-	case chatmessage.FieldReplyToName:
-		return m.OldReplyToName(ctx)
-	case chatmessage.FieldReplyToID:
-		return m.OldReplyToID(ctx)
-	case chatmessage.FieldPlatformTimestamp:
-		return m.OldPlatformTimestamp(ctx)
-	case chatmessage.FieldContentVector1536:
-		return m.OldContentVector1536(ctx)
-	case chatmessage.FieldContentVector1024:
-		return m.OldContentVector1024(ctx)
-	case chatmessage.FieldContentVector768:
-		return m.OldContentVector768(ctx)
-	case chatmessage.FieldJiebaTokens:
-		return m.OldJiebaTokens(ctx)
-	case chatmessage.FieldCreatedAt:
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PersonAuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case personauditlog.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case personauditlog.FieldAction:
+		return m.OldAction(ctx)
+	case personauditlog.FieldPersonID:
+		return m.OldPersonID(ctx)
+	case personauditlog.FieldSourcePersonIds:
+		return m.OldSourcePersonIds(ctx)
+	case personauditlog.FieldIdentityIds:
+		return m.OldIdentityIds(ctx)
+	case personauditlog.FieldChatIds:
+		return m.OldChatIds(ctx)
+	case personauditlog.FieldActor:
+		return m.OldActor(ctx)
+	case personauditlog.FieldReason:
+		return m.OldReason(ctx)
+	case personauditlog.FieldCounts:
+		return m.OldCounts(ctx)
+	case personauditlog.FieldScore:
+		return m.OldScore(ctx)
+	case personauditlog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case chatmessage.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case chatmessage.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChatMessage field %s", name)
+	return nil, fmt.Errorf("unknown PersonAuditLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChatMessageMutation) SetField(name string, value ent.Value) error {
+func (m *PersonAuditLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case chatmessage.FieldPlatform:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlatform(v)
-		return nil
-	case chatmessage.FieldPlatformMessageID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlatformMessageID(v)
-		return nil
-	case chatmessage.FieldFromID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFromID(v)
-		return nil
-	case chatmessage.FieldFromName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFromName(v)
-		return nil
-	case chatmessage.FieldFromUserUUID:
+	case personauditlog.FieldWorkspaceID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFromUserUUID(v)
+		m.SetWorkspaceID(v)
 		return nil
-	case chatmessage.FieldOwnerAccountID:
-		v, ok := value.(uuid.UUID)
+	case personauditlog.FieldAction:
+		v, ok := value.(personauditlog.Action)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerAccountID(v)
+		m.SetAction(v)
 		return nil
-	case chatmessage.FieldInChatID:
-		v, ok := value.(string)
+	case personauditlog.FieldPersonID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInChatID(v)
+		m.SetPersonID(v)
 		return nil
-	case chatmessage.FieldInChatType:
-		v, ok := value.(string)
+	case personauditlog.FieldSourcePersonIds:
+		v, ok := value.([]uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInChatType(v)
+		m.SetSourcePersonIds(v)
 		return nil
-	case chatmessage.FieldContent:
-		v, ok := value.(string)
+	case personauditlog.FieldIdentityIds:
+		v, ok := value.([]uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetIdentityIds(v)
 		return nil
-	case chatmessage.FieldIsReply:
-		v, ok := value.(bool)
+	case personauditlog.FieldChatIds:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsReply(v)
+		m.SetChatIds(v)
 		return nil
-	case chatmessage.FieldReplyToName:
+	case personauditlog.FieldActor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReplyToName(v)
+		m.SetActor(v)
 		return nil
-	case chatmessage.FieldReplyToID:
+	case personauditlog.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReplyToID(v)
-		return nil
-	case chatmessage.FieldPlatformTimestamp:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlatformTimestamp(v)
-		return nil
-	case chatmessage.FieldContentVector1536:
-		v, ok := value.(pgvector.Vector)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContentVector1536(v)
-		return nil
-	case chatmessage.FieldContentVector1024:
-		v, ok := value.(pgvector.Vector)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContentVector1024(v)
+		m.SetReason(v)
 		return nil
-	case chatmessage.FieldContentVector768:
-		v, ok := value.(pgvector.Vector)
+	case personauditlog.FieldCounts:
+		v, ok := value.(map[string]int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentVector768(v)
+		m.SetCounts(v)
 		return nil
-	case chatmessage.FieldJiebaTokens:
-		v, ok := value.([]string)
+	case personauditlog.FieldScore:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJiebaTokens(v)
+		m.SetScore(v)
 		return nil
-	case chatmessage.FieldCreatedAt:
+	case personauditlog.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case chatmessage.FieldUpdatedAt:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case chatmessage.FieldDeletedAt:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ChatMessage field %s", name)
+	return fmt.Errorf("unknown PersonAuditLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChatMessageMutation) AddedFields() []string {
+func (m *PersonAuditLogMutation) AddedFields() []string {
 	var fields []string
-	if m.addplatform_timestamp != nil {
-		fields = append(fields, chatmessage.FieldPlatformTimestamp)
+	if m.addscore != nil {
+		fields = append(fields, personauditlog.FieldScore)
 	}
 	if m.addcreated_at != nil {
-		fields = append(fields, chatmessage.FieldCreatedAt)
-	}
-	if m.addupdated_at != nil {
-		fields = append(fields, chatmessage.FieldUpdatedAt)
-	}
-	if m.adddeleted_at != nil {
-		fields = append(fields, chatmessage.FieldDeletedAt)
+		fields = append(fields, personauditlog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1383,16 +17440,12 @@ func (m *ChatMessageMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChatMessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PersonAuditLogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case chatmessage.FieldPlatformTimestamp:
-		return m.AddedPlatformTimestamp()
-	case chatmessage.FieldCreatedAt:
+	case personauditlog.FieldScore:
+		return m.AddedScore()
+	case personauditlog.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case chatmessage.FieldUpdatedAt:
-		return m.AddedUpdatedAt()
-	case chatmessage.FieldDeletedAt:
-		return m.AddedDeletedAt()
 	}
 	return nil, false
 }
@@ -1400,232 +17453,182 @@ func (m *ChatMessageMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChatMessageMutation) AddField(name string, value ent.Value) error {
+func (m *PersonAuditLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case chatmessage.FieldPlatformTimestamp:
-		v, ok := value.(int64)
+	case personauditlog.FieldScore:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPlatformTimestamp(v)
+		m.AddScore(v)
 		return nil
-	case chatmessage.FieldCreatedAt:
+	case personauditlog.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
-	case chatmessage.FieldUpdatedAt:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUpdatedAt(v)
-		return nil
-	case chatmessage.FieldDeletedAt:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDeletedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ChatMessage numeric field %s", name)
+	return fmt.Errorf("unknown PersonAuditLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChatMessageMutation) ClearedFields() []string {
+func (m *PersonAuditLogMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(chatmessage.FieldFromUserUUID) {
-		fields = append(fields, chatmessage.FieldFromUserUUID)
-	}
-	if m.FieldCleared(chatmessage.FieldOwnerAccountID) {
-		fields = append(fields, chatmessage.FieldOwnerAccountID)
+	if m.FieldCleared(personauditlog.FieldCounts) {
+		fields = append(fields, personauditlog.FieldCounts)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChatMessageMutation) FieldCleared(name string) bool {
+func (m *PersonAuditLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChatMessageMutation) ClearField(name string) error {
+func (m *PersonAuditLogMutation) ClearField(name string) error {
 	switch name {
-	case chatmessage.FieldFromUserUUID:
-		m.ClearFromUserUUID()
-		return nil
-	case chatmessage.FieldOwnerAccountID:
-		m.ClearOwnerAccountID()
+	case personauditlog.FieldCounts:
+		m.ClearCounts()
 		return nil
 	}
-	return fmt.Errorf("unknown ChatMessage nullable field %s", name)
+	return fmt.Errorf("unknown PersonAuditLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChatMessageMutation) ResetField(name string) error {
+func (m *PersonAuditLogMutation) ResetField(name string) error {
 	switch name {
-	case chatmessage.FieldPlatform:
-		m.ResetPlatform()
-		return nil
-	case chatmessage.FieldPlatformMessageID:
-		m.ResetPlatformMessageID()
-		return nil
-	case chatmessage.FieldFromID:
-		m.ResetFromID()
-		return nil
-	case chatmessage.FieldFromName:
-		m.ResetFromName()
-		return nil
-	case chatmessage.FieldFromUserUUID:
-		m.ResetFromUserUUID()
-		return nil
-	case chatmessage.FieldOwnerAccountID:
-		m.ResetOwnerAccountID()
+	case personauditlog.FieldWorkspaceID:
+		m.ResetWorkspaceID()
 		return nil
-	case chatmessage.FieldInChatID:
-		m.ResetInChatID()
-		return nil
-	case chatmessage.FieldInChatType:
-		m.ResetInChatType()
-		return nil
-	case chatmessage.FieldContent:
-		m.ResetContent()
+	case personauditlog.FieldAction:
+		m.ResetAction()
 		return nil
-	case chatmessage.FieldIsReply:
-		m.ResetIsReply()
+	case personauditlog.FieldPersonID:
+		m.ResetPersonID()
 		return nil
-	case chatmessage.FieldReplyToName:
-		m.ResetReplyToName()
+	case personauditlog.FieldSourcePersonIds:
+		m.ResetSourcePersonIds()
 		return nil
-	case chatmessage.FieldReplyToID:
-		m.ResetReplyToID()
+	case personauditlog.FieldIdentityIds:
+		m.ResetIdentityIds()
 		return nil
-	case chatmessage.FieldPlatformTimestamp:
-		m.ResetPlatformTimestamp()
+	case personauditlog.FieldChatIds:
+		m.ResetChatIds()
 		return nil
-	case chatmessage.FieldContentVector1536:
-		m.ResetContentVector1536()
+	case personauditlog.FieldActor:
+		m.ResetActor()
 		return nil
-	case chatmessage.FieldContentVector1024:
-		m.ResetContentVector1024()
+	case personauditlog.FieldReason:
+		m.ResetReason()
 		return nil
-	case chatmessage.FieldContentVector768:
-		m.ResetContentVector768()
+	case personauditlog.FieldCounts:
+		m.ResetCounts()
 		return nil
-	case chatmessage.FieldJiebaTokens:
-		m.ResetJiebaTokens()
+	case personauditlog.FieldScore:
+		m.ResetScore()
 		return nil
-	case chatmessage.FieldCreatedAt:
+	case personauditlog.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case chatmessage.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case chatmessage.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown ChatMessage field %s", name)
+	return fmt.Errorf("unknown PersonAuditLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChatMessageMutation) AddedEdges() []string {
+func (m *PersonAuditLogMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChatMessageMutation) AddedIDs(name string) []ent.Value {
+func (m *PersonAuditLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChatMessageMutation) RemovedEdges() []string {
+func (m *PersonAuditLogMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChatMessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *PersonAuditLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChatMessageMutation) ClearedEdges() []string {
+func (m *PersonAuditLogMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChatMessageMutation) EdgeCleared(name string) bool {
+func (m *PersonAuditLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChatMessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ChatMessage unique edge %s", name)
+func (m *PersonAuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PersonAuditLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChatMessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ChatMessage edge %s", name)
+func (m *PersonAuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PersonAuditLog edge %s", name)
 }
 
-// EventMutation represents an operation that mutates the Event nodes in the graph.
-type EventMutation struct {
+// ProfileMutation represents an operation that mutates the Profile nodes in the graph.
+type ProfileMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *uuid.UUID
-	platform                   *string
-	name                       *string
-	tags                       *[]string
-	appendtags                 []string
-	description                *string
-	from_name                  *string
-	in_chat_id                 *string
-	in_chat_type               *string
-	platform_timestamp         *int64
-	addplatform_timestamp      *int64
-	evidence_message_ids       *[]uuid.UUID
-	appendevidence_message_ids []uuid.UUID
-	created_at                 *int64
-	addcreated_at              *int64
-	updated_at                 *int64
-	addupdated_at              *int64
-	clearedFields              map[string]struct{}
-	identities                 map[uuid.UUID]struct{}
-	removedidentities          map[uuid.UUID]struct{}
-	clearedidentities          bool
-	done                       bool
-	oldValue                   func(context.Context) (*Event, error)
-	predicates                 []predicate.Event
-}
-
-var _ ent.Mutation = (*EventMutation)(nil)
-
-// eventOption allows management of the mutation configuration using functional options.
-type eventOption func(*EventMutation)
-
-// newEventMutation creates new mutation for the Event entity.
-func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
-	m := &EventMutation{
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	workspace_id            *uuid.UUID
+	version                 *int
+	addversion              *int
+	claims                  *[]schema.ProfileClaim
+	appendclaims            []schema.ProfileClaim
+	content                 *string
+	event_count             *int
+	addevent_count          *int
+	events_updated_until    *int64
+	addevents_updated_until *int64
+	events_updated_until_id *uuid.UUID
+	model                   *string
+	created_at              *int64
+	addcreated_at           *int64
+	clearedFields           map[string]struct{}
+	identity                *uuid.UUID
+	clearedidentity         bool
+	done                    bool
+	oldValue                func(context.Context) (*Profile, error)
+	predicates              []predicate.Profile
+}
+
+var _ ent.Mutation = (*ProfileMutation)(nil)
+
+// profileOption allows management of the mutation configuration using functional options.
+type profileOption func(*ProfileMutation)
+
+// newProfileMutation creates new mutation for the Profile entity.
+func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation {
+	m := &ProfileMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEvent,
+		typ:           TypeProfile,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1634,20 +17637,20 @@ func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
 	return m
 }
 
-// withEventID sets the ID field of the mutation.
-func withEventID(id uuid.UUID) eventOption {
-	return func(m *EventMutation) {
+// withProfileID sets the ID field of the mutation.
+func withProfileID(id uuid.UUID) profileOption {
+	return func(m *ProfileMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Event
+			value *Profile
 		)
-		m.oldValue = func(ctx context.Context) (*Event, error) {
+		m.oldValue = func(ctx context.Context) (*Profile, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Event.Get(ctx, id)
+					value, err = m.Client().Profile.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1656,10 +17659,10 @@ func withEventID(id uuid.UUID) eventOption {
 	}
 }
 
-// withEvent sets the old Event of the mutation.
-func withEvent(node *Event) eventOption {
-	return func(m *EventMutation) {
-		m.oldValue = func(context.Context) (*Event, error) {
+// withProfile sets the old Profile of the mutation.
+func withProfile(node *Profile) profileOption {
+	return func(m *ProfileMutation) {
+		m.oldValue = func(context.Context) (*Profile, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1668,7 +17671,7 @@ func withEvent(node *Event) eventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EventMutation) Client() *Client {
+func (m ProfileMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1676,7 +17679,7 @@ func (m EventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EventMutation) Tx() (*Tx, error) {
+func (m ProfileMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1686,14 +17689,14 @@ func (m EventMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Event entities.
-func (m *EventMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Profile entities.
+func (m *ProfileMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EventMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ProfileMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1704,7 +17707,7 @@ func (m *EventMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ProfileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1713,408 +17716,419 @@ func (m *EventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Profile.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPlatform sets the "platform" field.
-func (m *EventMutation) SetPlatform(s string) {
-	m.platform = &s
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *ProfileMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
 }
 
-// Platform returns the value of the "platform" field in the mutation.
-func (m *EventMutation) Platform() (r string, exists bool) {
-	v := m.platform
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *ProfileMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlatform returns the old "platform" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldWorkspaceID returns the old "workspace_id" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldPlatform(ctx context.Context) (v string, err error) {
+func (m *ProfileMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatform requires an ID field in the mutation")
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
 	}
-	return oldValue.Platform, nil
+	return oldValue.WorkspaceID, nil
 }
 
-// ResetPlatform resets all changes to the "platform" field.
-func (m *EventMutation) ResetPlatform() {
-	m.platform = nil
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *ProfileMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *EventMutation) SetName(s string) {
-	m.name = &s
+// SetIdentityID sets the "identity_id" field.
+func (m *ProfileMutation) SetIdentityID(u uuid.UUID) {
+	m.identity = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *EventMutation) Name() (r string, exists bool) {
-	v := m.name
+// IdentityID returns the value of the "identity_id" field in the mutation.
+func (m *ProfileMutation) IdentityID() (r uuid.UUID, exists bool) {
+	v := m.identity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldIdentityID returns the old "identity_id" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ProfileMutation) OldIdentityID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldIdentityID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldIdentityID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldIdentityID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.IdentityID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *EventMutation) ResetName() {
-	m.name = nil
+// ResetIdentityID resets all changes to the "identity_id" field.
+func (m *ProfileMutation) ResetIdentityID() {
+	m.identity = nil
 }
 
-// SetTags sets the "tags" field.
-func (m *EventMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
+// SetVersion sets the "version" field.
+func (m *ProfileMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *EventMutation) Tags() (r []string, exists bool) {
-	v := m.tags
+// Version returns the value of the "version" field in the mutation.
+func (m *ProfileMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTags returns the old "tags" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldTags(ctx context.Context) (v []string, err error) {
+func (m *ProfileMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Tags, nil
+	return oldValue.Version, nil
 }
 
-// AppendTags adds s to the "tags" field.
-func (m *EventMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
-}
-
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *EventMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
+// AddVersion adds i to the "version" field.
+func (m *ProfileMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
 	}
-	return m.appendtags, true
-}
-
-// ClearTags clears the value of the "tags" field.
-func (m *EventMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[event.FieldTags] = struct{}{}
 }
 
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *EventMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[event.FieldTags]
-	return ok
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ProfileMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *EventMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, event.FieldTags)
+// ResetVersion resets all changes to the "version" field.
+func (m *ProfileMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *EventMutation) SetDescription(s string) {
-	m.description = &s
+// SetClaims sets the "claims" field.
+func (m *ProfileMutation) SetClaims(sc []schema.ProfileClaim) {
+	m.claims = &sc
+	m.appendclaims = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *EventMutation) Description() (r string, exists bool) {
-	v := m.description
+// Claims returns the value of the "claims" field in the mutation.
+func (m *ProfileMutation) Claims() (r []schema.ProfileClaim, exists bool) {
+	v := m.claims
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldClaims returns the old "claims" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ProfileMutation) OldClaims(ctx context.Context) (v []schema.ProfileClaim, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaims is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldClaims requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaims: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Claims, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *EventMutation) ResetDescription() {
-	m.description = nil
+// AppendClaims adds sc to the "claims" field.
+func (m *ProfileMutation) AppendClaims(sc []schema.ProfileClaim) {
+	m.appendclaims = append(m.appendclaims, sc...)
 }
 
-// SetFromName sets the "from_name" field.
-func (m *EventMutation) SetFromName(s string) {
-	m.from_name = &s
+// AppendedClaims returns the list of values that were appended to the "claims" field in this mutation.
+func (m *ProfileMutation) AppendedClaims() ([]schema.ProfileClaim, bool) {
+	if len(m.appendclaims) == 0 {
+		return nil, false
+	}
+	return m.appendclaims, true
 }
 
-// FromName returns the value of the "from_name" field in the mutation.
-func (m *EventMutation) FromName() (r string, exists bool) {
-	v := m.from_name
+// ResetClaims resets all changes to the "claims" field.
+func (m *ProfileMutation) ResetClaims() {
+	m.claims = nil
+	m.appendclaims = nil
+}
+
+// SetContent sets the "content" field.
+func (m *ProfileMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ProfileMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromName returns the old "from_name" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldFromName(ctx context.Context) (v string, err error) {
+func (m *ProfileMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromName is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromName requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromName: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.FromName, nil
+	return oldValue.Content, nil
 }
 
-// ResetFromName resets all changes to the "from_name" field.
-func (m *EventMutation) ResetFromName() {
-	m.from_name = nil
+// ResetContent resets all changes to the "content" field.
+func (m *ProfileMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetInChatID sets the "in_chat_id" field.
-func (m *EventMutation) SetInChatID(s string) {
-	m.in_chat_id = &s
+// SetEventCount sets the "event_count" field.
+func (m *ProfileMutation) SetEventCount(i int) {
+	m.event_count = &i
+	m.addevent_count = nil
 }
 
-// InChatID returns the value of the "in_chat_id" field in the mutation.
-func (m *EventMutation) InChatID() (r string, exists bool) {
-	v := m.in_chat_id
+// EventCount returns the value of the "event_count" field in the mutation.
+func (m *ProfileMutation) EventCount() (r int, exists bool) {
+	v := m.event_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInChatID returns the old "in_chat_id" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldEventCount returns the old "event_count" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldInChatID(ctx context.Context) (v string, err error) {
+func (m *ProfileMutation) OldEventCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInChatID requires an ID field in the mutation")
+		return v, errors.New("OldEventCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInChatID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventCount: %w", err)
 	}
-	return oldValue.InChatID, nil
-}
-
-// ResetInChatID resets all changes to the "in_chat_id" field.
-func (m *EventMutation) ResetInChatID() {
-	m.in_chat_id = nil
+	return oldValue.EventCount, nil
 }
 
-// SetInChatType sets the "in_chat_type" field.
-func (m *EventMutation) SetInChatType(s string) {
-	m.in_chat_type = &s
+// AddEventCount adds i to the "event_count" field.
+func (m *ProfileMutation) AddEventCount(i int) {
+	if m.addevent_count != nil {
+		*m.addevent_count += i
+	} else {
+		m.addevent_count = &i
+	}
 }
 
-// InChatType returns the value of the "in_chat_type" field in the mutation.
-func (m *EventMutation) InChatType() (r string, exists bool) {
-	v := m.in_chat_type
+// AddedEventCount returns the value that was added to the "event_count" field in this mutation.
+func (m *ProfileMutation) AddedEventCount() (r int, exists bool) {
+	v := m.addevent_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInChatType returns the old "in_chat_type" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldInChatType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInChatType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInChatType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInChatType: %w", err)
-	}
-	return oldValue.InChatType, nil
-}
-
-// ResetInChatType resets all changes to the "in_chat_type" field.
-func (m *EventMutation) ResetInChatType() {
-	m.in_chat_type = nil
+// ResetEventCount resets all changes to the "event_count" field.
+func (m *ProfileMutation) ResetEventCount() {
+	m.event_count = nil
+	m.addevent_count = nil
 }
 
-// SetPlatformTimestamp sets the "platform_timestamp" field.
-func (m *EventMutation) SetPlatformTimestamp(i int64) {
-	m.platform_timestamp = &i
-	m.addplatform_timestamp = nil
+// SetEventsUpdatedUntil sets the "events_updated_until" field.
+func (m *ProfileMutation) SetEventsUpdatedUntil(i int64) {
+	m.events_updated_until = &i
+	m.addevents_updated_until = nil
 }
 
-// PlatformTimestamp returns the value of the "platform_timestamp" field in the mutation.
-func (m *EventMutation) PlatformTimestamp() (r int64, exists bool) {
-	v := m.platform_timestamp
+// EventsUpdatedUntil returns the value of the "events_updated_until" field in the mutation.
+func (m *ProfileMutation) EventsUpdatedUntil() (r int64, exists bool) {
+	v := m.events_updated_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlatformTimestamp returns the old "platform_timestamp" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldEventsUpdatedUntil returns the old "events_updated_until" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldPlatformTimestamp(ctx context.Context) (v int64, err error) {
+func (m *ProfileMutation) OldEventsUpdatedUntil(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatformTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventsUpdatedUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatformTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldEventsUpdatedUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatformTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventsUpdatedUntil: %w", err)
 	}
-	return oldValue.PlatformTimestamp, nil
+	return oldValue.EventsUpdatedUntil, nil
 }
 
-// AddPlatformTimestamp adds i to the "platform_timestamp" field.
-func (m *EventMutation) AddPlatformTimestamp(i int64) {
-	if m.addplatform_timestamp != nil {
-		*m.addplatform_timestamp += i
+// AddEventsUpdatedUntil adds i to the "events_updated_until" field.
+func (m *ProfileMutation) AddEventsUpdatedUntil(i int64) {
+	if m.addevents_updated_until != nil {
+		*m.addevents_updated_until += i
 	} else {
-		m.addplatform_timestamp = &i
+		m.addevents_updated_until = &i
 	}
 }
 
-// AddedPlatformTimestamp returns the value that was added to the "platform_timestamp" field in this mutation.
-func (m *EventMutation) AddedPlatformTimestamp() (r int64, exists bool) {
-	v := m.addplatform_timestamp
+// AddedEventsUpdatedUntil returns the value that was added to the "events_updated_until" field in this mutation.
+func (m *ProfileMutation) AddedEventsUpdatedUntil() (r int64, exists bool) {
+	v := m.addevents_updated_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPlatformTimestamp resets all changes to the "platform_timestamp" field.
-func (m *EventMutation) ResetPlatformTimestamp() {
-	m.platform_timestamp = nil
-	m.addplatform_timestamp = nil
+// ResetEventsUpdatedUntil resets all changes to the "events_updated_until" field.
+func (m *ProfileMutation) ResetEventsUpdatedUntil() {
+	m.events_updated_until = nil
+	m.addevents_updated_until = nil
 }
 
-// SetEvidenceMessageIds sets the "evidence_message_ids" field.
-func (m *EventMutation) SetEvidenceMessageIds(u []uuid.UUID) {
-	m.evidence_message_ids = &u
-	m.appendevidence_message_ids = nil
+// SetEventsUpdatedUntilID sets the "events_updated_until_id" field.
+func (m *ProfileMutation) SetEventsUpdatedUntilID(u uuid.UUID) {
+	m.events_updated_until_id = &u
 }
 
-// EvidenceMessageIds returns the value of the "evidence_message_ids" field in the mutation.
-func (m *EventMutation) EvidenceMessageIds() (r []uuid.UUID, exists bool) {
-	v := m.evidence_message_ids
+// EventsUpdatedUntilID returns the value of the "events_updated_until_id" field in the mutation.
+func (m *ProfileMutation) EventsUpdatedUntilID() (r uuid.UUID, exists bool) {
+	v := m.events_updated_until_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEvidenceMessageIds returns the old "evidence_message_ids" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldEventsUpdatedUntilID returns the old "events_updated_until_id" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldEvidenceMessageIds(ctx context.Context) (v []uuid.UUID, err error) {
+func (m *ProfileMutation) OldEventsUpdatedUntilID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEvidenceMessageIds is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventsUpdatedUntilID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEvidenceMessageIds requires an ID field in the mutation")
+		return v, errors.New("OldEventsUpdatedUntilID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEvidenceMessageIds: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventsUpdatedUntilID: %w", err)
 	}
-	return oldValue.EvidenceMessageIds, nil
+	return oldValue.EventsUpdatedUntilID, nil
 }
 
-// AppendEvidenceMessageIds adds u to the "evidence_message_ids" field.
-func (m *EventMutation) AppendEvidenceMessageIds(u []uuid.UUID) {
-	m.appendevidence_message_ids = append(m.appendevidence_message_ids, u...)
+// ResetEventsUpdatedUntilID resets all changes to the "events_updated_until_id" field.
+func (m *ProfileMutation) ResetEventsUpdatedUntilID() {
+	m.events_updated_until_id = nil
 }
 
-// AppendedEvidenceMessageIds returns the list of values that were appended to the "evidence_message_ids" field in this mutation.
-func (m *EventMutation) AppendedEvidenceMessageIds() ([]uuid.UUID, bool) {
-	if len(m.appendevidence_message_ids) == 0 {
-		return nil, false
+// SetModel sets the "model" field.
+func (m *ProfileMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *ProfileMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
 	}
-	return m.appendevidence_message_ids, true
+	return *v, true
 }
 
-// ResetEvidenceMessageIds resets all changes to the "evidence_message_ids" field.
-func (m *EventMutation) ResetEvidenceMessageIds() {
-	m.evidence_message_ids = nil
-	m.appendevidence_message_ids = nil
+// OldModel returns the old "model" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *ProfileMutation) ResetModel() {
+	m.model = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *EventMutation) SetCreatedAt(i int64) {
+func (m *ProfileMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EventMutation) CreatedAt() (r int64, exists bool) {
+func (m *ProfileMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2122,10 +18136,10 @@ func (m *EventMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2140,7 +18154,7 @@ func (m *EventMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *EventMutation) AddCreatedAt(i int64) {
+func (m *ProfileMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -2149,7 +18163,7 @@ func (m *EventMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *EventMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *ProfileMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -2158,130 +18172,47 @@ func (m *EventMutation) AddedCreatedAt() (r int64, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EventMutation) ResetCreatedAt() {
+func (m *ProfileMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EventMutation) SetUpdatedAt(i int64) {
-	m.updated_at = &i
-	m.addupdated_at = nil
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EventMutation) UpdatedAt() (r int64, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// AddUpdatedAt adds i to the "updated_at" field.
-func (m *EventMutation) AddUpdatedAt(i int64) {
-	if m.addupdated_at != nil {
-		*m.addupdated_at += i
-	} else {
-		m.addupdated_at = &i
-	}
-}
-
-// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *EventMutation) AddedUpdatedAt() (r int64, exists bool) {
-	v := m.addupdated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EventMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	m.addupdated_at = nil
-}
-
-// AddIdentityIDs adds the "identities" edge to the Identity entity by ids.
-func (m *EventMutation) AddIdentityIDs(ids ...uuid.UUID) {
-	if m.identities == nil {
-		m.identities = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.identities[ids[i]] = struct{}{}
-	}
-}
-
-// ClearIdentities clears the "identities" edge to the Identity entity.
-func (m *EventMutation) ClearIdentities() {
-	m.clearedidentities = true
-}
-
-// IdentitiesCleared reports if the "identities" edge to the Identity entity was cleared.
-func (m *EventMutation) IdentitiesCleared() bool {
-	return m.clearedidentities
-}
-
-// RemoveIdentityIDs removes the "identities" edge to the Identity entity by IDs.
-func (m *EventMutation) RemoveIdentityIDs(ids ...uuid.UUID) {
-	if m.removedidentities == nil {
-		m.removedidentities = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.identities, ids[i])
-		m.removedidentities[ids[i]] = struct{}{}
-	}
+// ClearIdentity clears the "identity" edge to the Identity entity.
+func (m *ProfileMutation) ClearIdentity() {
+	m.clearedidentity = true
+	m.clearedFields[profile.FieldIdentityID] = struct{}{}
 }
 
-// RemovedIdentities returns the removed IDs of the "identities" edge to the Identity entity.
-func (m *EventMutation) RemovedIdentitiesIDs() (ids []uuid.UUID) {
-	for id := range m.removedidentities {
-		ids = append(ids, id)
-	}
-	return
+// IdentityCleared reports if the "identity" edge to the Identity entity was cleared.
+func (m *ProfileMutation) IdentityCleared() bool {
+	return m.clearedidentity
 }
 
-// IdentitiesIDs returns the "identities" edge IDs in the mutation.
-func (m *EventMutation) IdentitiesIDs() (ids []uuid.UUID) {
-	for id := range m.identities {
-		ids = append(ids, id)
+// IdentityIDs returns the "identity" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// IdentityID instead. It exists only for internal usage by the builders.
+func (m *ProfileMutation) IdentityIDs() (ids []uuid.UUID) {
+	if id := m.identity; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetIdentities resets all changes to the "identities" edge.
-func (m *EventMutation) ResetIdentities() {
-	m.identities = nil
-	m.clearedidentities = false
-	m.removedidentities = nil
+// ResetIdentity resets all changes to the "identity" edge.
+func (m *ProfileMutation) ResetIdentity() {
+	m.identity = nil
+	m.clearedidentity = false
 }
 
-// Where appends a list predicates to the EventMutation builder.
-func (m *EventMutation) Where(ps ...predicate.Event) {
+// Where appends a list predicates to the ProfileMutation builder.
+func (m *ProfileMutation) Where(ps ...predicate.Profile) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProfileMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Event, len(ps))
+func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Profile, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2289,57 +18220,54 @@ func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EventMutation) Op() Op {
+func (m *ProfileMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EventMutation) SetOp(op Op) {
+func (m *ProfileMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Event).
-func (m *EventMutation) Type() string {
+// Type returns the node type of this mutation (Profile).
+func (m *ProfileMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EventMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.platform != nil {
-		fields = append(fields, event.FieldPlatform)
+func (m *ProfileMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.workspace_id != nil {
+		fields = append(fields, profile.FieldWorkspaceID)
 	}
-	if m.name != nil {
-		fields = append(fields, event.FieldName)
+	if m.identity != nil {
+		fields = append(fields, profile.FieldIdentityID)
 	}
-	if m.tags != nil {
-		fields = append(fields, event.FieldTags)
+	if m.version != nil {
+		fields = append(fields, profile.FieldVersion)
 	}
-	if m.description != nil {
-		fields = append(fields, event.FieldDescription)
+	if m.claims != nil {
+		fields = append(fields, profile.FieldClaims)
 	}
-	if m.from_name != nil {
-		fields = append(fields, event.FieldFromName)
+	if m.content != nil {
+		fields = append(fields, profile.FieldContent)
 	}
-	if m.in_chat_id != nil {
-		fields = append(fields, event.FieldInChatID)
+	if m.event_count != nil {
+		fields = append(fields, profile.FieldEventCount)
 	}
-	if m.in_chat_type != nil {
-		fields = append(fields, event.FieldInChatType)
+	if m.events_updated_until != nil {
+		fields = append(fields, profile.FieldEventsUpdatedUntil)
 	}
-	if m.platform_timestamp != nil {
-		fields = append(fields, event.FieldPlatformTimestamp)
+	if m.events_updated_until_id != nil {
+		fields = append(fields, profile.FieldEventsUpdatedUntilID)
 	}
-	if m.evidence_message_ids != nil {
-		fields = append(fields, event.FieldEvidenceMessageIds)
+	if m.model != nil {
+		fields = append(fields, profile.FieldModel)
 	}
 	if m.created_at != nil {
-		fields = append(fields, event.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, event.FieldUpdatedAt)
+		fields = append(fields, profile.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2347,30 +18275,28 @@ func (m *EventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EventMutation) Field(name string) (ent.Value, bool) {
+func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case event.FieldPlatform:
-		return m.Platform()
-	case event.FieldName:
-		return m.Name()
-	case event.FieldTags:
-		return m.Tags()
-	case event.FieldDescription:
-		return m.Description()
-	case event.FieldFromName:
-		return m.FromName()
-	case event.FieldInChatID:
-		return m.InChatID()
-	case event.FieldInChatType:
-		return m.InChatType()
-	case event.FieldPlatformTimestamp:
-		return m.PlatformTimestamp()
-	case event.FieldEvidenceMessageIds:
-		return m.EvidenceMessageIds()
-	case event.FieldCreatedAt:
+	case profile.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case profile.FieldIdentityID:
+		return m.IdentityID()
+	case profile.FieldVersion:
+		return m.Version()
+	case profile.FieldClaims:
+		return m.Claims()
+	case profile.FieldContent:
+		return m.Content()
+	case profile.FieldEventCount:
+		return m.EventCount()
+	case profile.FieldEventsUpdatedUntil:
+		return m.EventsUpdatedUntil()
+	case profile.FieldEventsUpdatedUntilID:
+		return m.EventsUpdatedUntilID()
+	case profile.FieldModel:
+		return m.Model()
+	case profile.FieldCreatedAt:
 		return m.CreatedAt()
-	case event.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2378,132 +18304,126 @@ func (m *EventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case event.FieldPlatform:
-		return m.OldPlatform(ctx)
-	case event.FieldName:
-		return m.OldName(ctx)
-	case event.FieldTags:
-		return m.OldTags(ctx)
-	case event.FieldDescription:
-		return m.OldDescription(ctx)
-	case event.FieldFromName:
-		return m.OldFromName(ctx)
-	case event.FieldInChatID:
-		return m.OldInChatID(ctx)
-	case event.FieldInChatType:
-		return m.OldInChatType(ctx)
-	case event.FieldPlatformTimestamp:
-		return m.OldPlatformTimestamp(ctx)
-	case event.FieldEvidenceMessageIds:
-		return m.OldEvidenceMessageIds(ctx)
-	case event.FieldCreatedAt:
+	case profile.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case profile.FieldIdentityID:
+		return m.OldIdentityID(ctx)
+	case profile.FieldVersion:
+		return m.OldVersion(ctx)
+	case profile.FieldClaims:
+		return m.OldClaims(ctx)
+	case profile.FieldContent:
+		return m.OldContent(ctx)
+	case profile.FieldEventCount:
+		return m.OldEventCount(ctx)
+	case profile.FieldEventsUpdatedUntil:
+		return m.OldEventsUpdatedUntil(ctx)
+	case profile.FieldEventsUpdatedUntilID:
+		return m.OldEventsUpdatedUntilID(ctx)
+	case profile.FieldModel:
+		return m.OldModel(ctx)
+	case profile.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case event.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Event field %s", name)
+	return nil, fmt.Errorf("unknown Profile field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EventMutation) SetField(name string, value ent.Value) error {
+func (m *ProfileMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case event.FieldPlatform:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlatform(v)
-		return nil
-	case event.FieldName:
-		v, ok := value.(string)
+	case profile.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetWorkspaceID(v)
 		return nil
-	case event.FieldTags:
-		v, ok := value.([]string)
+	case profile.FieldIdentityID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTags(v)
+		m.SetIdentityID(v)
 		return nil
-	case event.FieldDescription:
-		v, ok := value.(string)
+	case profile.FieldVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetVersion(v)
 		return nil
-	case event.FieldFromName:
-		v, ok := value.(string)
+	case profile.FieldClaims:
+		v, ok := value.([]schema.ProfileClaim)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFromName(v)
+		m.SetClaims(v)
 		return nil
-	case event.FieldInChatID:
+	case profile.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInChatID(v)
+		m.SetContent(v)
 		return nil
-	case event.FieldInChatType:
-		v, ok := value.(string)
+	case profile.FieldEventCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInChatType(v)
+		m.SetEventCount(v)
 		return nil
-	case event.FieldPlatformTimestamp:
+	case profile.FieldEventsUpdatedUntil:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlatformTimestamp(v)
+		m.SetEventsUpdatedUntil(v)
 		return nil
-	case event.FieldEvidenceMessageIds:
-		v, ok := value.([]uuid.UUID)
+	case profile.FieldEventsUpdatedUntilID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEvidenceMessageIds(v)
+		m.SetEventsUpdatedUntilID(v)
 		return nil
-	case event.FieldCreatedAt:
-		v, ok := value.(int64)
+	case profile.FieldModel:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetModel(v)
 		return nil
-	case event.FieldUpdatedAt:
+	case profile.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Event field %s", name)
+	return fmt.Errorf("unknown Profile field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EventMutation) AddedFields() []string {
+func (m *ProfileMutation) AddedFields() []string {
 	var fields []string
-	if m.addplatform_timestamp != nil {
-		fields = append(fields, event.FieldPlatformTimestamp)
+	if m.addversion != nil {
+		fields = append(fields, profile.FieldVersion)
 	}
-	if m.addcreated_at != nil {
-		fields = append(fields, event.FieldCreatedAt)
+	if m.addevent_count != nil {
+		fields = append(fields, profile.FieldEventCount)
 	}
-	if m.addupdated_at != nil {
-		fields = append(fields, event.FieldUpdatedAt)
+	if m.addevents_updated_until != nil {
+		fields = append(fields, profile.FieldEventsUpdatedUntil)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, profile.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2511,14 +18431,16 @@ func (m *EventMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case event.FieldPlatformTimestamp:
-		return m.AddedPlatformTimestamp()
-	case event.FieldCreatedAt:
+	case profile.FieldVersion:
+		return m.AddedVersion()
+	case profile.FieldEventCount:
+		return m.AddedEventCount()
+	case profile.FieldEventsUpdatedUntil:
+		return m.AddedEventsUpdatedUntil()
+	case profile.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case event.FieldUpdatedAt:
-		return m.AddedUpdatedAt()
 	}
 	return nil, false
 }
@@ -2526,223 +18448,212 @@ func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EventMutation) AddField(name string, value ent.Value) error {
+func (m *ProfileMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case event.FieldPlatformTimestamp:
-		v, ok := value.(int64)
+	case profile.FieldVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPlatformTimestamp(v)
+		m.AddVersion(v)
 		return nil
-	case event.FieldCreatedAt:
+	case profile.FieldEventCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventCount(v)
+		return nil
+	case profile.FieldEventsUpdatedUntil:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCreatedAt(v)
+		m.AddEventsUpdatedUntil(v)
 		return nil
-	case event.FieldUpdatedAt:
+	case profile.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUpdatedAt(v)
+		m.AddCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Event numeric field %s", name)
+	return fmt.Errorf("unknown Profile numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EventMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(event.FieldTags) {
-		fields = append(fields, event.FieldTags)
-	}
-	return fields
+func (m *ProfileMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EventMutation) FieldCleared(name string) bool {
+func (m *ProfileMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EventMutation) ClearField(name string) error {
-	switch name {
-	case event.FieldTags:
-		m.ClearTags()
-		return nil
-	}
-	return fmt.Errorf("unknown Event nullable field %s", name)
+func (m *ProfileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Profile nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EventMutation) ResetField(name string) error {
+func (m *ProfileMutation) ResetField(name string) error {
 	switch name {
-	case event.FieldPlatform:
-		m.ResetPlatform()
+	case profile.FieldWorkspaceID:
+		m.ResetWorkspaceID()
 		return nil
-	case event.FieldName:
-		m.ResetName()
+	case profile.FieldIdentityID:
+		m.ResetIdentityID()
 		return nil
-	case event.FieldTags:
-		m.ResetTags()
+	case profile.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case event.FieldDescription:
-		m.ResetDescription()
+	case profile.FieldClaims:
+		m.ResetClaims()
 		return nil
-	case event.FieldFromName:
-		m.ResetFromName()
+	case profile.FieldContent:
+		m.ResetContent()
 		return nil
-	case event.FieldInChatID:
-		m.ResetInChatID()
+	case profile.FieldEventCount:
+		m.ResetEventCount()
 		return nil
-	case event.FieldInChatType:
-		m.ResetInChatType()
+	case profile.FieldEventsUpdatedUntil:
+		m.ResetEventsUpdatedUntil()
 		return nil
-	case event.FieldPlatformTimestamp:
-		m.ResetPlatformTimestamp()
+	case profile.FieldEventsUpdatedUntilID:
+		m.ResetEventsUpdatedUntilID()
 		return nil
-	case event.FieldEvidenceMessageIds:
-		m.ResetEvidenceMessageIds()
+	case profile.FieldModel:
+		m.ResetModel()
 		return nil
-	case event.FieldCreatedAt:
+	case profile.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case event.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Event field %s", name)
+	return fmt.Errorf("unknown Profile field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EventMutation) AddedEdges() []string {
+func (m *ProfileMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.identities != nil {
-		edges = append(edges, event.EdgeIdentities)
+	if m.identity != nil {
+		edges = append(edges, profile.EdgeIdentity)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EventMutation) AddedIDs(name string) []ent.Value {
+func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case event.EdgeIdentities:
-		ids := make([]ent.Value, 0, len(m.identities))
-		for id := range m.identities {
-			ids = append(ids, id)
+	case profile.EdgeIdentity:
+		if id := m.identity; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EventMutation) RemovedEdges() []string {
+func (m *ProfileMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedidentities != nil {
-		edges = append(edges, event.EdgeIdentities)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EventMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case event.EdgeIdentities:
-		ids := make([]ent.Value, 0, len(m.removedidentities))
-		for id := range m.removedidentities {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EventMutation) ClearedEdges() []string {
+func (m *ProfileMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedidentities {
-		edges = append(edges, event.EdgeIdentities)
+	if m.clearedidentity {
+		edges = append(edges, profile.EdgeIdentity)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EventMutation) EdgeCleared(name string) bool {
+func (m *ProfileMutation) EdgeCleared(name string) bool {
 	switch name {
-	case event.EdgeIdentities:
-		return m.clearedidentities
+	case profile.EdgeIdentity:
+		return m.clearedidentity
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EventMutation) ClearEdge(name string) error {
+func (m *ProfileMutation) ClearEdge(name string) error {
 	switch name {
+	case profile.EdgeIdentity:
+		m.ClearIdentity()
+		return nil
 	}
-	return fmt.Errorf("unknown Event unique edge %s", name)
+	return fmt.Errorf("unknown Profile unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EventMutation) ResetEdge(name string) error {
+func (m *ProfileMutation) ResetEdge(name string) error {
 	switch name {
-	case event.EdgeIdentities:
-		m.ResetIdentities()
+	case profile.EdgeIdentity:
+		m.ResetIdentity()
 		return nil
 	}
-	return fmt.Errorf("unknown Event edge %s", name)
+	return fmt.Errorf("unknown Profile edge %s", name)
 }
 
-// IdentityMutation represents an operation that mutates the Identity nodes in the graph.
-type IdentityMutation struct {
+// SummaryMutation represents an operation that mutates the Summary nodes in the graph.
+type SummaryMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	platform          *string
-	platform_user_id  *string
-	username          *string
-	display_name      *string
-	profile_photo_url *string
-	alt_ids           *[]string
-	appendalt_ids     []string
-	created_at        *int64
-	addcreated_at     *int64
-	updated_at        *int64
-	addupdated_at     *int64
-	clearedFields     map[string]struct{}
-	events            map[uuid.UUID]struct{}
-	removedevents     map[uuid.UUID]struct{}
-	clearedevents     bool
-	done              bool
-	oldValue          func(context.Context) (*Identity, error)
-	predicates        []predicate.Identity
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	workspace_id          *uuid.UUID
+	platform              *string
+	in_chat_id            *string
+	in_chat_type          *string
+	content               *string
+	model                 *string
+	message_count         *int
+	addmessage_count      *int
+	span_start            *int64
+	addspan_start         *int64
+	span_end              *int64
+	addspan_end           *int64
+	platform_timestamp    *int64
+	addplatform_timestamp *int64
+	created_at            *int64
+	addcreated_at         *int64
+	updated_at            *int64
+	addupdated_at         *int64
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*Summary, error)
+	predicates            []predicate.Summary
 }
 
-var _ ent.Mutation = (*IdentityMutation)(nil)
+var _ ent.Mutation = (*SummaryMutation)(nil)
 
-// identityOption allows management of the mutation configuration using functional options.
-type identityOption func(*IdentityMutation)
+// summaryOption allows management of the mutation configuration using functional options.
+type summaryOption func(*SummaryMutation)
 
-// newIdentityMutation creates new mutation for the Identity entity.
-func newIdentityMutation(c config, op Op, opts ...identityOption) *IdentityMutation {
-	m := &IdentityMutation{
+// newSummaryMutation creates new mutation for the Summary entity.
+func newSummaryMutation(c config, op Op, opts ...summaryOption) *SummaryMutation {
+	m := &SummaryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeIdentity,
+		typ:           TypeSummary,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2751,20 +18662,20 @@ func newIdentityMutation(c config, op Op, opts ...identityOption) *IdentityMutat
 	return m
 }
 
-// withIdentityID sets the ID field of the mutation.
-func withIdentityID(id uuid.UUID) identityOption {
-	return func(m *IdentityMutation) {
+// withSummaryID sets the ID field of the mutation.
+func withSummaryID(id uuid.UUID) summaryOption {
+	return func(m *SummaryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Identity
+			value *Summary
 		)
-		m.oldValue = func(ctx context.Context) (*Identity, error) {
+		m.oldValue = func(ctx context.Context) (*Summary, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Identity.Get(ctx, id)
+					value, err = m.Client().Summary.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2773,10 +18684,10 @@ func withIdentityID(id uuid.UUID) identityOption {
 	}
 }
 
-// withIdentity sets the old Identity of the mutation.
-func withIdentity(node *Identity) identityOption {
-	return func(m *IdentityMutation) {
-		m.oldValue = func(context.Context) (*Identity, error) {
+// withSummary sets the old Summary of the mutation.
+func withSummary(node *Summary) summaryOption {
+	return func(m *SummaryMutation) {
+		m.oldValue = func(context.Context) (*Summary, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2785,7 +18696,7 @@ func withIdentity(node *Identity) identityOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m IdentityMutation) Client() *Client {
+func (m SummaryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2793,7 +18704,7 @@ func (m IdentityMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m IdentityMutation) Tx() (*Tx, error) {
+func (m SummaryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2803,14 +18714,14 @@ func (m IdentityMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Identity entities.
-func (m *IdentityMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Summary entities.
+func (m *SummaryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *IdentityMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SummaryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2821,7 +18732,7 @@ func (m *IdentityMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *IdentityMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SummaryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2830,19 +18741,55 @@ func (m *IdentityMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Identity.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Summary.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *SummaryMutation) SetWorkspaceID(u uuid.UUID) {
+	m.workspace_id = &u
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *SummaryMutation) WorkspaceID() (r uuid.UUID, exists bool) {
+	v := m.workspace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldWorkspaceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *SummaryMutation) ResetWorkspaceID() {
+	m.workspace_id = nil
+}
+
 // SetPlatform sets the "platform" field.
-func (m *IdentityMutation) SetPlatform(s string) {
+func (m *SummaryMutation) SetPlatform(s string) {
 	m.platform = &s
 }
 
 // Platform returns the value of the "platform" field in the mutation.
-func (m *IdentityMutation) Platform() (r string, exists bool) {
+func (m *SummaryMutation) Platform() (r string, exists bool) {
 	v := m.platform
 	if v == nil {
 		return
@@ -2850,10 +18797,10 @@ func (m *IdentityMutation) Platform() (r string, exists bool) {
 	return *v, true
 }
 
-// OldPlatform returns the old "platform" field's value of the Identity entity.
-// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// OldPlatform returns the old "platform" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IdentityMutation) OldPlatform(ctx context.Context) (v string, err error) {
+func (m *SummaryMutation) OldPlatform(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
 	}
@@ -2867,228 +18814,387 @@ func (m *IdentityMutation) OldPlatform(ctx context.Context) (v string, err error
 	return oldValue.Platform, nil
 }
 
-// ResetPlatform resets all changes to the "platform" field.
-func (m *IdentityMutation) ResetPlatform() {
-	m.platform = nil
+// ResetPlatform resets all changes to the "platform" field.
+func (m *SummaryMutation) ResetPlatform() {
+	m.platform = nil
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (m *SummaryMutation) SetInChatID(s string) {
+	m.in_chat_id = &s
+}
+
+// InChatID returns the value of the "in_chat_id" field in the mutation.
+func (m *SummaryMutation) InChatID() (r string, exists bool) {
+	v := m.in_chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInChatID returns the old "in_chat_id" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldInChatID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInChatID: %w", err)
+	}
+	return oldValue.InChatID, nil
+}
+
+// ResetInChatID resets all changes to the "in_chat_id" field.
+func (m *SummaryMutation) ResetInChatID() {
+	m.in_chat_id = nil
+}
+
+// SetInChatType sets the "in_chat_type" field.
+func (m *SummaryMutation) SetInChatType(s string) {
+	m.in_chat_type = &s
+}
+
+// InChatType returns the value of the "in_chat_type" field in the mutation.
+func (m *SummaryMutation) InChatType() (r string, exists bool) {
+	v := m.in_chat_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInChatType returns the old "in_chat_type" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldInChatType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInChatType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInChatType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInChatType: %w", err)
+	}
+	return oldValue.InChatType, nil
+}
+
+// ResetInChatType resets all changes to the "in_chat_type" field.
+func (m *SummaryMutation) ResetInChatType() {
+	m.in_chat_type = nil
+}
+
+// SetContent sets the "content" field.
+func (m *SummaryMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *SummaryMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *SummaryMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetPlatformUserID sets the "platform_user_id" field.
-func (m *IdentityMutation) SetPlatformUserID(s string) {
-	m.platform_user_id = &s
+// SetModel sets the "model" field.
+func (m *SummaryMutation) SetModel(s string) {
+	m.model = &s
 }
 
-// PlatformUserID returns the value of the "platform_user_id" field in the mutation.
-func (m *IdentityMutation) PlatformUserID() (r string, exists bool) {
-	v := m.platform_user_id
+// Model returns the value of the "model" field in the mutation.
+func (m *SummaryMutation) Model() (r string, exists bool) {
+	v := m.model
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlatformUserID returns the old "platform_user_id" field's value of the Identity entity.
-// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// OldModel returns the old "model" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IdentityMutation) OldPlatformUserID(ctx context.Context) (v string, err error) {
+func (m *SummaryMutation) OldModel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatformUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatformUserID requires an ID field in the mutation")
+		return v, errors.New("OldModel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatformUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
 	}
-	return oldValue.PlatformUserID, nil
+	return oldValue.Model, nil
 }
 
-// ResetPlatformUserID resets all changes to the "platform_user_id" field.
-func (m *IdentityMutation) ResetPlatformUserID() {
-	m.platform_user_id = nil
+// ResetModel resets all changes to the "model" field.
+func (m *SummaryMutation) ResetModel() {
+	m.model = nil
 }
 
-// SetUsername sets the "username" field.
-func (m *IdentityMutation) SetUsername(s string) {
-	m.username = &s
+// SetMessageCount sets the "message_count" field.
+func (m *SummaryMutation) SetMessageCount(i int) {
+	m.message_count = &i
+	m.addmessage_count = nil
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *IdentityMutation) Username() (r string, exists bool) {
-	v := m.username
+// MessageCount returns the value of the "message_count" field in the mutation.
+func (m *SummaryMutation) MessageCount() (r int, exists bool) {
+	v := m.message_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the Identity entity.
-// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageCount returns the old "message_count" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IdentityMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *SummaryMutation) OldMessageCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldMessageCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageCount: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.MessageCount, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *IdentityMutation) ResetUsername() {
-	m.username = nil
+// AddMessageCount adds i to the "message_count" field.
+func (m *SummaryMutation) AddMessageCount(i int) {
+	if m.addmessage_count != nil {
+		*m.addmessage_count += i
+	} else {
+		m.addmessage_count = &i
+	}
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *IdentityMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// AddedMessageCount returns the value that was added to the "message_count" field in this mutation.
+func (m *SummaryMutation) AddedMessageCount() (r int, exists bool) {
+	v := m.addmessage_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *IdentityMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// ResetMessageCount resets all changes to the "message_count" field.
+func (m *SummaryMutation) ResetMessageCount() {
+	m.message_count = nil
+	m.addmessage_count = nil
+}
+
+// SetSpanStart sets the "span_start" field.
+func (m *SummaryMutation) SetSpanStart(i int64) {
+	m.span_start = &i
+	m.addspan_start = nil
+}
+
+// SpanStart returns the value of the "span_start" field in the mutation.
+func (m *SummaryMutation) SpanStart() (r int64, exists bool) {
+	v := m.span_start
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Identity entity.
-// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// OldSpanStart returns the old "span_start" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IdentityMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *SummaryMutation) OldSpanStart(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpanStart is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldSpanStart requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpanStart: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.SpanStart, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *IdentityMutation) ResetDisplayName() {
-	m.display_name = nil
+// AddSpanStart adds i to the "span_start" field.
+func (m *SummaryMutation) AddSpanStart(i int64) {
+	if m.addspan_start != nil {
+		*m.addspan_start += i
+	} else {
+		m.addspan_start = &i
+	}
 }
 
-// SetProfilePhotoURL sets the "profile_photo_url" field.
-func (m *IdentityMutation) SetProfilePhotoURL(s string) {
-	m.profile_photo_url = &s
+// AddedSpanStart returns the value that was added to the "span_start" field in this mutation.
+func (m *SummaryMutation) AddedSpanStart() (r int64, exists bool) {
+	v := m.addspan_start
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ProfilePhotoURL returns the value of the "profile_photo_url" field in the mutation.
-func (m *IdentityMutation) ProfilePhotoURL() (r string, exists bool) {
-	v := m.profile_photo_url
+// ResetSpanStart resets all changes to the "span_start" field.
+func (m *SummaryMutation) ResetSpanStart() {
+	m.span_start = nil
+	m.addspan_start = nil
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (m *SummaryMutation) SetSpanEnd(i int64) {
+	m.span_end = &i
+	m.addspan_end = nil
+}
+
+// SpanEnd returns the value of the "span_end" field in the mutation.
+func (m *SummaryMutation) SpanEnd() (r int64, exists bool) {
+	v := m.span_end
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProfilePhotoURL returns the old "profile_photo_url" field's value of the Identity entity.
-// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// OldSpanEnd returns the old "span_end" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IdentityMutation) OldProfilePhotoURL(ctx context.Context) (v string, err error) {
+func (m *SummaryMutation) OldSpanEnd(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfilePhotoURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpanEnd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfilePhotoURL requires an ID field in the mutation")
+		return v, errors.New("OldSpanEnd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfilePhotoURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpanEnd: %w", err)
 	}
-	return oldValue.ProfilePhotoURL, nil
+	return oldValue.SpanEnd, nil
 }
 
-// ResetProfilePhotoURL resets all changes to the "profile_photo_url" field.
-func (m *IdentityMutation) ResetProfilePhotoURL() {
-	m.profile_photo_url = nil
+// AddSpanEnd adds i to the "span_end" field.
+func (m *SummaryMutation) AddSpanEnd(i int64) {
+	if m.addspan_end != nil {
+		*m.addspan_end += i
+	} else {
+		m.addspan_end = &i
+	}
 }
 
-// SetAltIds sets the "alt_ids" field.
-func (m *IdentityMutation) SetAltIds(s []string) {
-	m.alt_ids = &s
-	m.appendalt_ids = nil
+// AddedSpanEnd returns the value that was added to the "span_end" field in this mutation.
+func (m *SummaryMutation) AddedSpanEnd() (r int64, exists bool) {
+	v := m.addspan_end
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AltIds returns the value of the "alt_ids" field in the mutation.
-func (m *IdentityMutation) AltIds() (r []string, exists bool) {
-	v := m.alt_ids
+// ResetSpanEnd resets all changes to the "span_end" field.
+func (m *SummaryMutation) ResetSpanEnd() {
+	m.span_end = nil
+	m.addspan_end = nil
+}
+
+// SetPlatformTimestamp sets the "platform_timestamp" field.
+func (m *SummaryMutation) SetPlatformTimestamp(i int64) {
+	m.platform_timestamp = &i
+	m.addplatform_timestamp = nil
+}
+
+// PlatformTimestamp returns the value of the "platform_timestamp" field in the mutation.
+func (m *SummaryMutation) PlatformTimestamp() (r int64, exists bool) {
+	v := m.platform_timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAltIds returns the old "alt_ids" field's value of the Identity entity.
-// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// OldPlatformTimestamp returns the old "platform_timestamp" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IdentityMutation) OldAltIds(ctx context.Context) (v []string, err error) {
+func (m *SummaryMutation) OldPlatformTimestamp(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAltIds is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlatformTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAltIds requires an ID field in the mutation")
+		return v, errors.New("OldPlatformTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAltIds: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlatformTimestamp: %w", err)
 	}
-	return oldValue.AltIds, nil
-}
-
-// AppendAltIds adds s to the "alt_ids" field.
-func (m *IdentityMutation) AppendAltIds(s []string) {
-	m.appendalt_ids = append(m.appendalt_ids, s...)
+	return oldValue.PlatformTimestamp, nil
 }
 
-// AppendedAltIds returns the list of values that were appended to the "alt_ids" field in this mutation.
-func (m *IdentityMutation) AppendedAltIds() ([]string, bool) {
-	if len(m.appendalt_ids) == 0 {
-		return nil, false
+// AddPlatformTimestamp adds i to the "platform_timestamp" field.
+func (m *SummaryMutation) AddPlatformTimestamp(i int64) {
+	if m.addplatform_timestamp != nil {
+		*m.addplatform_timestamp += i
+	} else {
+		m.addplatform_timestamp = &i
 	}
-	return m.appendalt_ids, true
-}
-
-// ClearAltIds clears the value of the "alt_ids" field.
-func (m *IdentityMutation) ClearAltIds() {
-	m.alt_ids = nil
-	m.appendalt_ids = nil
-	m.clearedFields[identity.FieldAltIds] = struct{}{}
 }
 
-// AltIdsCleared returns if the "alt_ids" field was cleared in this mutation.
-func (m *IdentityMutation) AltIdsCleared() bool {
-	_, ok := m.clearedFields[identity.FieldAltIds]
-	return ok
+// AddedPlatformTimestamp returns the value that was added to the "platform_timestamp" field in this mutation.
+func (m *SummaryMutation) AddedPlatformTimestamp() (r int64, exists bool) {
+	v := m.addplatform_timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetAltIds resets all changes to the "alt_ids" field.
-func (m *IdentityMutation) ResetAltIds() {
-	m.alt_ids = nil
-	m.appendalt_ids = nil
-	delete(m.clearedFields, identity.FieldAltIds)
+// ResetPlatformTimestamp resets all changes to the "platform_timestamp" field.
+func (m *SummaryMutation) ResetPlatformTimestamp() {
+	m.platform_timestamp = nil
+	m.addplatform_timestamp = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *IdentityMutation) SetCreatedAt(i int64) {
+func (m *SummaryMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *IdentityMutation) CreatedAt() (r int64, exists bool) {
+func (m *SummaryMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3096,10 +19202,10 @@ func (m *IdentityMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Identity entity.
-// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IdentityMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *SummaryMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3114,7 +19220,7 @@ func (m *IdentityMutation) OldCreatedAt(ctx context.Context) (v int64, err error
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *IdentityMutation) AddCreatedAt(i int64) {
+func (m *SummaryMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -3123,7 +19229,7 @@ func (m *IdentityMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *IdentityMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *SummaryMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -3132,19 +19238,19 @@ func (m *IdentityMutation) AddedCreatedAt() (r int64, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *IdentityMutation) ResetCreatedAt() {
+func (m *SummaryMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *IdentityMutation) SetUpdatedAt(i int64) {
+func (m *SummaryMutation) SetUpdatedAt(i int64) {
 	m.updated_at = &i
 	m.addupdated_at = nil
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *IdentityMutation) UpdatedAt() (r int64, exists bool) {
+func (m *SummaryMutation) UpdatedAt() (r int64, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3152,10 +19258,10 @@ func (m *IdentityMutation) UpdatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Identity entity.
-// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IdentityMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+func (m *SummaryMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3170,7 +19276,7 @@ func (m *IdentityMutation) OldUpdatedAt(ctx context.Context) (v int64, err error
 }
 
 // AddUpdatedAt adds i to the "updated_at" field.
-func (m *IdentityMutation) AddUpdatedAt(i int64) {
+func (m *SummaryMutation) AddUpdatedAt(i int64) {
 	if m.addupdated_at != nil {
 		*m.addupdated_at += i
 	} else {
@@ -3179,7 +19285,7 @@ func (m *IdentityMutation) AddUpdatedAt(i int64) {
 }
 
 // AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *IdentityMutation) AddedUpdatedAt() (r int64, exists bool) {
+func (m *SummaryMutation) AddedUpdatedAt() (r int64, exists bool) {
 	v := m.addupdated_at
 	if v == nil {
 		return
@@ -3188,74 +19294,20 @@ func (m *IdentityMutation) AddedUpdatedAt() (r int64, exists bool) {
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *IdentityMutation) ResetUpdatedAt() {
+func (m *SummaryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 	m.addupdated_at = nil
 }
 
-// AddEventIDs adds the "events" edge to the Event entity by ids.
-func (m *IdentityMutation) AddEventIDs(ids ...uuid.UUID) {
-	if m.events == nil {
-		m.events = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.events[ids[i]] = struct{}{}
-	}
-}
-
-// ClearEvents clears the "events" edge to the Event entity.
-func (m *IdentityMutation) ClearEvents() {
-	m.clearedevents = true
-}
-
-// EventsCleared reports if the "events" edge to the Event entity was cleared.
-func (m *IdentityMutation) EventsCleared() bool {
-	return m.clearedevents
-}
-
-// RemoveEventIDs removes the "events" edge to the Event entity by IDs.
-func (m *IdentityMutation) RemoveEventIDs(ids ...uuid.UUID) {
-	if m.removedevents == nil {
-		m.removedevents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.events, ids[i])
-		m.removedevents[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedEvents returns the removed IDs of the "events" edge to the Event entity.
-func (m *IdentityMutation) RemovedEventsIDs() (ids []uuid.UUID) {
-	for id := range m.removedevents {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// EventsIDs returns the "events" edge IDs in the mutation.
-func (m *IdentityMutation) EventsIDs() (ids []uuid.UUID) {
-	for id := range m.events {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetEvents resets all changes to the "events" edge.
-func (m *IdentityMutation) ResetEvents() {
-	m.events = nil
-	m.clearedevents = false
-	m.removedevents = nil
-}
-
-// Where appends a list predicates to the IdentityMutation builder.
-func (m *IdentityMutation) Where(ps ...predicate.Identity) {
+// Where appends a list predicates to the SummaryMutation builder.
+func (m *SummaryMutation) Where(ps ...predicate.Summary) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the IdentityMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SummaryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *IdentityMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Identity, len(ps))
+func (m *SummaryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Summary, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3263,48 +19315,60 @@ func (m *IdentityMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *IdentityMutation) Op() Op {
+func (m *SummaryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *IdentityMutation) SetOp(op Op) {
+func (m *SummaryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Identity).
-func (m *IdentityMutation) Type() string {
+// Type returns the node type of this mutation (Summary).
+func (m *SummaryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *IdentityMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *SummaryMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.workspace_id != nil {
+		fields = append(fields, summary.FieldWorkspaceID)
+	}
 	if m.platform != nil {
-		fields = append(fields, identity.FieldPlatform)
+		fields = append(fields, summary.FieldPlatform)
 	}
-	if m.platform_user_id != nil {
-		fields = append(fields, identity.FieldPlatformUserID)
+	if m.in_chat_id != nil {
+		fields = append(fields, summary.FieldInChatID)
 	}
-	if m.username != nil {
-		fields = append(fields, identity.FieldUsername)
+	if m.in_chat_type != nil {
+		fields = append(fields, summary.FieldInChatType)
 	}
-	if m.display_name != nil {
-		fields = append(fields, identity.FieldDisplayName)
+	if m.content != nil {
+		fields = append(fields, summary.FieldContent)
+	}
+	if m.model != nil {
+		fields = append(fields, summary.FieldModel)
+	}
+	if m.message_count != nil {
+		fields = append(fields, summary.FieldMessageCount)
 	}
-	if m.profile_photo_url != nil {
-		fields = append(fields, identity.FieldProfilePhotoURL)
+	if m.span_start != nil {
+		fields = append(fields, summary.FieldSpanStart)
 	}
-	if m.alt_ids != nil {
-		fields = append(fields, identity.FieldAltIds)
+	if m.span_end != nil {
+		fields = append(fields, summary.FieldSpanEnd)
+	}
+	if m.platform_timestamp != nil {
+		fields = append(fields, summary.FieldPlatformTimestamp)
 	}
 	if m.created_at != nil {
-		fields = append(fields, identity.FieldCreatedAt)
+		fields = append(fields, summary.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, identity.FieldUpdatedAt)
+		fields = append(fields, summary.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3312,23 +19376,31 @@ func (m *IdentityMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *IdentityMutation) Field(name string) (ent.Value, bool) {
+func (m *SummaryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case identity.FieldPlatform:
+	case summary.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case summary.FieldPlatform:
 		return m.Platform()
-	case identity.FieldPlatformUserID:
-		return m.PlatformUserID()
-	case identity.FieldUsername:
-		return m.Username()
-	case identity.FieldDisplayName:
-		return m.DisplayName()
-	case identity.FieldProfilePhotoURL:
-		return m.ProfilePhotoURL()
-	case identity.FieldAltIds:
-		return m.AltIds()
-	case identity.FieldCreatedAt:
+	case summary.FieldInChatID:
+		return m.InChatID()
+	case summary.FieldInChatType:
+		return m.InChatType()
+	case summary.FieldContent:
+		return m.Content()
+	case summary.FieldModel:
+		return m.Model()
+	case summary.FieldMessageCount:
+		return m.MessageCount()
+	case summary.FieldSpanStart:
+		return m.SpanStart()
+	case summary.FieldSpanEnd:
+		return m.SpanEnd()
+	case summary.FieldPlatformTimestamp:
+		return m.PlatformTimestamp()
+	case summary.FieldCreatedAt:
 		return m.CreatedAt()
-	case identity.FieldUpdatedAt:
+	case summary.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3337,83 +19409,119 @@ func (m *IdentityMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *IdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SummaryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case identity.FieldPlatform:
+	case summary.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case summary.FieldPlatform:
 		return m.OldPlatform(ctx)
-	case identity.FieldPlatformUserID:
-		return m.OldPlatformUserID(ctx)
-	case identity.FieldUsername:
-		return m.OldUsername(ctx)
-	case identity.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case identity.FieldProfilePhotoURL:
-		return m.OldProfilePhotoURL(ctx)
-	case identity.FieldAltIds:
-		return m.OldAltIds(ctx)
-	case identity.FieldCreatedAt:
+	case summary.FieldInChatID:
+		return m.OldInChatID(ctx)
+	case summary.FieldInChatType:
+		return m.OldInChatType(ctx)
+	case summary.FieldContent:
+		return m.OldContent(ctx)
+	case summary.FieldModel:
+		return m.OldModel(ctx)
+	case summary.FieldMessageCount:
+		return m.OldMessageCount(ctx)
+	case summary.FieldSpanStart:
+		return m.OldSpanStart(ctx)
+	case summary.FieldSpanEnd:
+		return m.OldSpanEnd(ctx)
+	case summary.FieldPlatformTimestamp:
+		return m.OldPlatformTimestamp(ctx)
+	case summary.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case identity.FieldUpdatedAt:
+	case summary.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Identity field %s", name)
+	return nil, fmt.Errorf("unknown Summary field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IdentityMutation) SetField(name string, value ent.Value) error {
+func (m *SummaryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case identity.FieldPlatform:
+	case summary.FieldWorkspaceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case summary.FieldPlatform:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetPlatform(v)
 		return nil
-	case identity.FieldPlatformUserID:
+	case summary.FieldInChatID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlatformUserID(v)
+		m.SetInChatID(v)
 		return nil
-	case identity.FieldUsername:
+	case summary.FieldInChatType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetInChatType(v)
 		return nil
-	case identity.FieldDisplayName:
+	case summary.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetContent(v)
 		return nil
-	case identity.FieldProfilePhotoURL:
+	case summary.FieldModel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProfilePhotoURL(v)
+		m.SetModel(v)
 		return nil
-	case identity.FieldAltIds:
-		v, ok := value.([]string)
+	case summary.FieldMessageCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAltIds(v)
+		m.SetMessageCount(v)
 		return nil
-	case identity.FieldCreatedAt:
+	case summary.FieldSpanStart:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpanStart(v)
+		return nil
+	case summary.FieldSpanEnd:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpanEnd(v)
+		return nil
+	case summary.FieldPlatformTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatformTimestamp(v)
+		return nil
+	case summary.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case identity.FieldUpdatedAt:
+	case summary.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3421,18 +19529,30 @@ func (m *IdentityMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Identity field %s", name)
+	return fmt.Errorf("unknown Summary field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *IdentityMutation) AddedFields() []string {
+func (m *SummaryMutation) AddedFields() []string {
 	var fields []string
+	if m.addmessage_count != nil {
+		fields = append(fields, summary.FieldMessageCount)
+	}
+	if m.addspan_start != nil {
+		fields = append(fields, summary.FieldSpanStart)
+	}
+	if m.addspan_end != nil {
+		fields = append(fields, summary.FieldSpanEnd)
+	}
+	if m.addplatform_timestamp != nil {
+		fields = append(fields, summary.FieldPlatformTimestamp)
+	}
 	if m.addcreated_at != nil {
-		fields = append(fields, identity.FieldCreatedAt)
+		fields = append(fields, summary.FieldCreatedAt)
 	}
 	if m.addupdated_at != nil {
-		fields = append(fields, identity.FieldUpdatedAt)
+		fields = append(fields, summary.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3440,11 +19560,19 @@ func (m *IdentityMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *IdentityMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SummaryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case identity.FieldCreatedAt:
+	case summary.FieldMessageCount:
+		return m.AddedMessageCount()
+	case summary.FieldSpanStart:
+		return m.AddedSpanStart()
+	case summary.FieldSpanEnd:
+		return m.AddedSpanEnd()
+	case summary.FieldPlatformTimestamp:
+		return m.AddedPlatformTimestamp()
+	case summary.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case identity.FieldUpdatedAt:
+	case summary.FieldUpdatedAt:
 		return m.AddedUpdatedAt()
 	}
 	return nil, false
@@ -3453,16 +19581,44 @@ func (m *IdentityMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IdentityMutation) AddField(name string, value ent.Value) error {
+func (m *SummaryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case identity.FieldCreatedAt:
+	case summary.FieldMessageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageCount(v)
+		return nil
+	case summary.FieldSpanStart:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpanStart(v)
+		return nil
+	case summary.FieldSpanEnd:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpanEnd(v)
+		return nil
+	case summary.FieldPlatformTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlatformTimestamp(v)
+		return nil
+	case summary.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
-	case identity.FieldUpdatedAt:
+	case summary.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3470,186 +19626,154 @@ func (m *IdentityMutation) AddField(name string, value ent.Value) error {
 		m.AddUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Identity numeric field %s", name)
+	return fmt.Errorf("unknown Summary numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *IdentityMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(identity.FieldAltIds) {
-		fields = append(fields, identity.FieldAltIds)
-	}
-	return fields
+func (m *SummaryMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *IdentityMutation) FieldCleared(name string) bool {
+func (m *SummaryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *IdentityMutation) ClearField(name string) error {
-	switch name {
-	case identity.FieldAltIds:
-		m.ClearAltIds()
-		return nil
-	}
-	return fmt.Errorf("unknown Identity nullable field %s", name)
+func (m *SummaryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Summary nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *IdentityMutation) ResetField(name string) error {
+func (m *SummaryMutation) ResetField(name string) error {
 	switch name {
-	case identity.FieldPlatform:
+	case summary.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case summary.FieldPlatform:
 		m.ResetPlatform()
 		return nil
-	case identity.FieldPlatformUserID:
-		m.ResetPlatformUserID()
+	case summary.FieldInChatID:
+		m.ResetInChatID()
 		return nil
-	case identity.FieldUsername:
-		m.ResetUsername()
+	case summary.FieldInChatType:
+		m.ResetInChatType()
 		return nil
-	case identity.FieldDisplayName:
-		m.ResetDisplayName()
+	case summary.FieldContent:
+		m.ResetContent()
 		return nil
-	case identity.FieldProfilePhotoURL:
-		m.ResetProfilePhotoURL()
+	case summary.FieldModel:
+		m.ResetModel()
 		return nil
-	case identity.FieldAltIds:
-		m.ResetAltIds()
+	case summary.FieldMessageCount:
+		m.ResetMessageCount()
 		return nil
-	case identity.FieldCreatedAt:
+	case summary.FieldSpanStart:
+		m.ResetSpanStart()
+		return nil
+	case summary.FieldSpanEnd:
+		m.ResetSpanEnd()
+		return nil
+	case summary.FieldPlatformTimestamp:
+		m.ResetPlatformTimestamp()
+		return nil
+	case summary.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case identity.FieldUpdatedAt:
+	case summary.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Identity field %s", name)
+	return fmt.Errorf("unknown Summary field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *IdentityMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.events != nil {
-		edges = append(edges, identity.EdgeEvents)
-	}
+func (m *SummaryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *IdentityMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case identity.EdgeEvents:
-		ids := make([]ent.Value, 0, len(m.events))
-		for id := range m.events {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SummaryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *IdentityMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedevents != nil {
-		edges = append(edges, identity.EdgeEvents)
-	}
+func (m *SummaryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *IdentityMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case identity.EdgeEvents:
-		ids := make([]ent.Value, 0, len(m.removedevents))
-		for id := range m.removedevents {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SummaryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *IdentityMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedevents {
-		edges = append(edges, identity.EdgeEvents)
-	}
+func (m *SummaryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *IdentityMutation) EdgeCleared(name string) bool {
-	switch name {
-	case identity.EdgeEvents:
-		return m.clearedevents
-	}
+func (m *SummaryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *IdentityMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Identity unique edge %s", name)
+func (m *SummaryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Summary unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *IdentityMutation) ResetEdge(name string) error {
-	switch name {
-	case identity.EdgeEvents:
-		m.ResetEvents()
-		return nil
-	}
-	return fmt.Errorf("unknown Identity edge %s", name)
+func (m *SummaryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Summary edge %s", name)
 }
 
-// JoinedChatMutation represents an operation that mutates the JoinedChat nodes in the graph.
-type JoinedChatMutation struct {
+// WorkspaceMutation represents an operation that mutates the Workspace nodes in the graph.
+type WorkspaceMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	platform       *string
-	chat_id        *string
-	chat_name      *string
-	chat_type      *string
-	dialog_date    *int64
-	adddialog_date *int64
-	created_at     *int64
-	addcreated_at  *int64
-	updated_at     *int64
-	addupdated_at  *int64
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*JoinedChat, error)
-	predicates     []predicate.JoinedChat
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	slug                  *string
+	name                  *string
+	graph_name            *string
+	llm_providers         *jsontext.Value
+	appendllm_providers   jsontext.Value
+	llm_monthly_budget    *float64
+	addllm_monthly_budget *float64
+	created_at            *int64
+	addcreated_at         *int64
+	updated_at            *int64
+	addupdated_at         *int64
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*Workspace, error)
+	predicates            []predicate.Workspace
 }
 
-var _ ent.Mutation = (*JoinedChatMutation)(nil)
+var _ ent.Mutation = (*WorkspaceMutation)(nil)
 
-// joinedchatOption allows management of the mutation configuration using functional options.
-type joinedchatOption func(*JoinedChatMutation)
+// workspaceOption allows management of the mutation configuration using functional options.
+type workspaceOption func(*WorkspaceMutation)
 
-// newJoinedChatMutation creates new mutation for the JoinedChat entity.
-func newJoinedChatMutation(c config, op Op, opts ...joinedchatOption) *JoinedChatMutation {
-	m := &JoinedChatMutation{
+// newWorkspaceMutation creates new mutation for the Workspace entity.
+func newWorkspaceMutation(c config, op Op, opts ...workspaceOption) *WorkspaceMutation {
+	m := &WorkspaceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeJoinedChat,
+		typ:           TypeWorkspace,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3658,20 +19782,20 @@ func newJoinedChatMutation(c config, op Op, opts ...joinedchatOption) *JoinedCha
 	return m
 }
 
-// withJoinedChatID sets the ID field of the mutation.
-func withJoinedChatID(id uuid.UUID) joinedchatOption {
-	return func(m *JoinedChatMutation) {
+// withWorkspaceID sets the ID field of the mutation.
+func withWorkspaceID(id uuid.UUID) workspaceOption {
+	return func(m *WorkspaceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *JoinedChat
+			value *Workspace
 		)
-		m.oldValue = func(ctx context.Context) (*JoinedChat, error) {
+		m.oldValue = func(ctx context.Context) (*Workspace, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().JoinedChat.Get(ctx, id)
+					value, err = m.Client().Workspace.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3680,10 +19804,10 @@ func withJoinedChatID(id uuid.UUID) joinedchatOption {
 	}
 }
 
-// withJoinedChat sets the old JoinedChat of the mutation.
-func withJoinedChat(node *JoinedChat) joinedchatOption {
-	return func(m *JoinedChatMutation) {
-		m.oldValue = func(context.Context) (*JoinedChat, error) {
+// withWorkspace sets the old Workspace of the mutation.
+func withWorkspace(node *Workspace) workspaceOption {
+	return func(m *WorkspaceMutation) {
+		m.oldValue = func(context.Context) (*Workspace, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3692,7 +19816,7 @@ func withJoinedChat(node *JoinedChat) joinedchatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JoinedChatMutation) Client() *Client {
+func (m WorkspaceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3700,7 +19824,7 @@ func (m JoinedChatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m JoinedChatMutation) Tx() (*Tx, error) {
+func (m WorkspaceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3710,14 +19834,14 @@ func (m JoinedChatMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of JoinedChat entities.
-func (m *JoinedChatMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Workspace entities.
+func (m *WorkspaceMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *JoinedChatMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WorkspaceMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3728,7 +19852,7 @@ func (m *JoinedChatMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *JoinedChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WorkspaceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3737,220 +19861,249 @@ func (m *JoinedChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().JoinedChat.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Workspace.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPlatform sets the "platform" field.
-func (m *JoinedChatMutation) SetPlatform(s string) {
-	m.platform = &s
+// SetSlug sets the "slug" field.
+func (m *WorkspaceMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// Platform returns the value of the "platform" field in the mutation.
-func (m *JoinedChatMutation) Platform() (r string, exists bool) {
-	v := m.platform
+// Slug returns the value of the "slug" field in the mutation.
+func (m *WorkspaceMutation) Slug() (r string, exists bool) {
+	v := m.slug
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlatform returns the old "platform" field's value of the JoinedChat entity.
-// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// OldSlug returns the old "slug" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JoinedChatMutation) OldPlatform(ctx context.Context) (v string, err error) {
+func (m *WorkspaceMutation) OldSlug(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatform requires an ID field in the mutation")
+		return v, errors.New("OldSlug requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
 	}
-	return oldValue.Platform, nil
+	return oldValue.Slug, nil
 }
 
-// ResetPlatform resets all changes to the "platform" field.
-func (m *JoinedChatMutation) ResetPlatform() {
-	m.platform = nil
+// ResetSlug resets all changes to the "slug" field.
+func (m *WorkspaceMutation) ResetSlug() {
+	m.slug = nil
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *JoinedChatMutation) SetChatID(s string) {
-	m.chat_id = &s
+// SetName sets the "name" field.
+func (m *WorkspaceMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *JoinedChatMutation) ChatID() (r string, exists bool) {
-	v := m.chat_id
+// Name returns the value of the "name" field in the mutation.
+func (m *WorkspaceMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the JoinedChat entity.
-// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JoinedChatMutation) OldChatID(ctx context.Context) (v string, err error) {
+func (m *WorkspaceMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.ChatID, nil
+	return oldValue.Name, nil
 }
 
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *JoinedChatMutation) ResetChatID() {
-	m.chat_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *WorkspaceMutation) ResetName() {
+	m.name = nil
 }
 
-// SetChatName sets the "chat_name" field.
-func (m *JoinedChatMutation) SetChatName(s string) {
-	m.chat_name = &s
+// SetGraphName sets the "graph_name" field.
+func (m *WorkspaceMutation) SetGraphName(s string) {
+	m.graph_name = &s
 }
 
-// ChatName returns the value of the "chat_name" field in the mutation.
-func (m *JoinedChatMutation) ChatName() (r string, exists bool) {
-	v := m.chat_name
+// GraphName returns the value of the "graph_name" field in the mutation.
+func (m *WorkspaceMutation) GraphName() (r string, exists bool) {
+	v := m.graph_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatName returns the old "chat_name" field's value of the JoinedChat entity.
-// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// OldGraphName returns the old "graph_name" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JoinedChatMutation) OldChatName(ctx context.Context) (v string, err error) {
+func (m *WorkspaceMutation) OldGraphName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatName is only allowed on UpdateOne operations")
+		return v, errors.New("OldGraphName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatName requires an ID field in the mutation")
+		return v, errors.New("OldGraphName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatName: %w", err)
+		return v, fmt.Errorf("querying old value for OldGraphName: %w", err)
 	}
-	return oldValue.ChatName, nil
+	return oldValue.GraphName, nil
 }
 
-// ResetChatName resets all changes to the "chat_name" field.
-func (m *JoinedChatMutation) ResetChatName() {
-	m.chat_name = nil
+// ResetGraphName resets all changes to the "graph_name" field.
+func (m *WorkspaceMutation) ResetGraphName() {
+	m.graph_name = nil
 }
 
-// SetChatType sets the "chat_type" field.
-func (m *JoinedChatMutation) SetChatType(s string) {
-	m.chat_type = &s
+// SetLlmProviders sets the "llm_providers" field.
+func (m *WorkspaceMutation) SetLlmProviders(j jsontext.Value) {
+	m.llm_providers = &j
+	m.appendllm_providers = nil
 }
 
-// ChatType returns the value of the "chat_type" field in the mutation.
-func (m *JoinedChatMutation) ChatType() (r string, exists bool) {
-	v := m.chat_type
+// LlmProviders returns the value of the "llm_providers" field in the mutation.
+func (m *WorkspaceMutation) LlmProviders() (r jsontext.Value, exists bool) {
+	v := m.llm_providers
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatType returns the old "chat_type" field's value of the JoinedChat entity.
-// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// OldLlmProviders returns the old "llm_providers" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JoinedChatMutation) OldChatType(ctx context.Context) (v string, err error) {
+func (m *WorkspaceMutation) OldLlmProviders(ctx context.Context) (v jsontext.Value, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatType is only allowed on UpdateOne operations")
+		return v, errors.New("OldLlmProviders is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatType requires an ID field in the mutation")
+		return v, errors.New("OldLlmProviders requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatType: %w", err)
+		return v, fmt.Errorf("querying old value for OldLlmProviders: %w", err)
 	}
-	return oldValue.ChatType, nil
+	return oldValue.LlmProviders, nil
 }
 
-// ResetChatType resets all changes to the "chat_type" field.
-func (m *JoinedChatMutation) ResetChatType() {
-	m.chat_type = nil
+// AppendLlmProviders adds j to the "llm_providers" field.
+func (m *WorkspaceMutation) AppendLlmProviders(j jsontext.Value) {
+	m.appendllm_providers = append(m.appendllm_providers, j...)
 }
 
-// SetDialogDate sets the "dialog_date" field.
-func (m *JoinedChatMutation) SetDialogDate(i int64) {
-	m.dialog_date = &i
-	m.adddialog_date = nil
+// AppendedLlmProviders returns the list of values that were appended to the "llm_providers" field in this mutation.
+func (m *WorkspaceMutation) AppendedLlmProviders() (jsontext.Value, bool) {
+	if len(m.appendllm_providers) == 0 {
+		return nil, false
+	}
+	return m.appendllm_providers, true
 }
 
-// DialogDate returns the value of the "dialog_date" field in the mutation.
-func (m *JoinedChatMutation) DialogDate() (r int64, exists bool) {
-	v := m.dialog_date
+// ClearLlmProviders clears the value of the "llm_providers" field.
+func (m *WorkspaceMutation) ClearLlmProviders() {
+	m.llm_providers = nil
+	m.appendllm_providers = nil
+	m.clearedFields[workspace.FieldLlmProviders] = struct{}{}
+}
+
+// LlmProvidersCleared returns if the "llm_providers" field was cleared in this mutation.
+func (m *WorkspaceMutation) LlmProvidersCleared() bool {
+	_, ok := m.clearedFields[workspace.FieldLlmProviders]
+	return ok
+}
+
+// ResetLlmProviders resets all changes to the "llm_providers" field.
+func (m *WorkspaceMutation) ResetLlmProviders() {
+	m.llm_providers = nil
+	m.appendllm_providers = nil
+	delete(m.clearedFields, workspace.FieldLlmProviders)
+}
+
+// SetLlmMonthlyBudget sets the "llm_monthly_budget" field.
+func (m *WorkspaceMutation) SetLlmMonthlyBudget(f float64) {
+	m.llm_monthly_budget = &f
+	m.addllm_monthly_budget = nil
+}
+
+// LlmMonthlyBudget returns the value of the "llm_monthly_budget" field in the mutation.
+func (m *WorkspaceMutation) LlmMonthlyBudget() (r float64, exists bool) {
+	v := m.llm_monthly_budget
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDialogDate returns the old "dialog_date" field's value of the JoinedChat entity.
-// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// OldLlmMonthlyBudget returns the old "llm_monthly_budget" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JoinedChatMutation) OldDialogDate(ctx context.Context) (v int64, err error) {
+func (m *WorkspaceMutation) OldLlmMonthlyBudget(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDialogDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldLlmMonthlyBudget is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDialogDate requires an ID field in the mutation")
+		return v, errors.New("OldLlmMonthlyBudget requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDialogDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldLlmMonthlyBudget: %w", err)
 	}
-	return oldValue.DialogDate, nil
+	return oldValue.LlmMonthlyBudget, nil
 }
 
-// AddDialogDate adds i to the "dialog_date" field.
-func (m *JoinedChatMutation) AddDialogDate(i int64) {
-	if m.adddialog_date != nil {
-		*m.adddialog_date += i
+// AddLlmMonthlyBudget adds f to the "llm_monthly_budget" field.
+func (m *WorkspaceMutation) AddLlmMonthlyBudget(f float64) {
+	if m.addllm_monthly_budget != nil {
+		*m.addllm_monthly_budget += f
 	} else {
-		m.adddialog_date = &i
+		m.addllm_monthly_budget = &f
 	}
 }
 
-// AddedDialogDate returns the value that was added to the "dialog_date" field in this mutation.
-func (m *JoinedChatMutation) AddedDialogDate() (r int64, exists bool) {
-	v := m.adddialog_date
+// AddedLlmMonthlyBudget returns the value that was added to the "llm_monthly_budget" field in this mutation.
+func (m *WorkspaceMutation) AddedLlmMonthlyBudget() (r float64, exists bool) {
+	v := m.addllm_monthly_budget
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDialogDate resets all changes to the "dialog_date" field.
-func (m *JoinedChatMutation) ResetDialogDate() {
-	m.dialog_date = nil
-	m.adddialog_date = nil
+// ResetLlmMonthlyBudget resets all changes to the "llm_monthly_budget" field.
+func (m *WorkspaceMutation) ResetLlmMonthlyBudget() {
+	m.llm_monthly_budget = nil
+	m.addllm_monthly_budget = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *JoinedChatMutation) SetCreatedAt(i int64) {
+func (m *WorkspaceMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *JoinedChatMutation) CreatedAt() (r int64, exists bool) {
+func (m *WorkspaceMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3958,10 +20111,10 @@ func (m *JoinedChatMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the JoinedChat entity.
-// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JoinedChatMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *WorkspaceMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3976,7 +20129,7 @@ func (m *JoinedChatMutation) OldCreatedAt(ctx context.Context) (v int64, err err
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *JoinedChatMutation) AddCreatedAt(i int64) {
+func (m *WorkspaceMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -3985,7 +20138,7 @@ func (m *JoinedChatMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *JoinedChatMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *WorkspaceMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -3994,19 +20147,19 @@ func (m *JoinedChatMutation) AddedCreatedAt() (r int64, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *JoinedChatMutation) ResetCreatedAt() {
+func (m *WorkspaceMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *JoinedChatMutation) SetUpdatedAt(i int64) {
+func (m *WorkspaceMutation) SetUpdatedAt(i int64) {
 	m.updated_at = &i
 	m.addupdated_at = nil
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *JoinedChatMutation) UpdatedAt() (r int64, exists bool) {
+func (m *WorkspaceMutation) UpdatedAt() (r int64, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4014,10 +20167,10 @@ func (m *JoinedChatMutation) UpdatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the JoinedChat entity.
-// If the JoinedChat object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JoinedChatMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+func (m *WorkspaceMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4032,7 +20185,7 @@ func (m *JoinedChatMutation) OldUpdatedAt(ctx context.Context) (v int64, err err
 }
 
 // AddUpdatedAt adds i to the "updated_at" field.
-func (m *JoinedChatMutation) AddUpdatedAt(i int64) {
+func (m *WorkspaceMutation) AddUpdatedAt(i int64) {
 	if m.addupdated_at != nil {
 		*m.addupdated_at += i
 	} else {
@@ -4041,7 +20194,7 @@ func (m *JoinedChatMutation) AddUpdatedAt(i int64) {
 }
 
 // AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *JoinedChatMutation) AddedUpdatedAt() (r int64, exists bool) {
+func (m *WorkspaceMutation) AddedUpdatedAt() (r int64, exists bool) {
 	v := m.addupdated_at
 	if v == nil {
 		return
@@ -4050,20 +20203,20 @@ func (m *JoinedChatMutation) AddedUpdatedAt() (r int64, exists bool) {
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *JoinedChatMutation) ResetUpdatedAt() {
+func (m *WorkspaceMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 	m.addupdated_at = nil
 }
 
-// Where appends a list predicates to the JoinedChatMutation builder.
-func (m *JoinedChatMutation) Where(ps ...predicate.JoinedChat) {
+// Where appends a list predicates to the WorkspaceMutation builder.
+func (m *WorkspaceMutation) Where(ps ...predicate.Workspace) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the JoinedChatMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WorkspaceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JoinedChatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.JoinedChat, len(ps))
+func (m *WorkspaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Workspace, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4071,45 +20224,45 @@ func (m *JoinedChatMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *JoinedChatMutation) Op() Op {
+func (m *WorkspaceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *JoinedChatMutation) SetOp(op Op) {
+func (m *WorkspaceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (JoinedChat).
-func (m *JoinedChatMutation) Type() string {
+// Type returns the node type of this mutation (Workspace).
+func (m *WorkspaceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *JoinedChatMutation) Fields() []string {
+func (m *WorkspaceMutation) Fields() []string {
 	fields := make([]string, 0, 7)
-	if m.platform != nil {
-		fields = append(fields, joinedchat.FieldPlatform)
+	if m.slug != nil {
+		fields = append(fields, workspace.FieldSlug)
 	}
-	if m.chat_id != nil {
-		fields = append(fields, joinedchat.FieldChatID)
+	if m.name != nil {
+		fields = append(fields, workspace.FieldName)
 	}
-	if m.chat_name != nil {
-		fields = append(fields, joinedchat.FieldChatName)
+	if m.graph_name != nil {
+		fields = append(fields, workspace.FieldGraphName)
 	}
-	if m.chat_type != nil {
-		fields = append(fields, joinedchat.FieldChatType)
+	if m.llm_providers != nil {
+		fields = append(fields, workspace.FieldLlmProviders)
 	}
-	if m.dialog_date != nil {
-		fields = append(fields, joinedchat.FieldDialogDate)
+	if m.llm_monthly_budget != nil {
+		fields = append(fields, workspace.FieldLlmMonthlyBudget)
 	}
 	if m.created_at != nil {
-		fields = append(fields, joinedchat.FieldCreatedAt)
+		fields = append(fields, workspace.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, joinedchat.FieldUpdatedAt)
+		fields = append(fields, workspace.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4117,21 +20270,21 @@ func (m *JoinedChatMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *JoinedChatMutation) Field(name string) (ent.Value, bool) {
+func (m *WorkspaceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case joinedchat.FieldPlatform:
-		return m.Platform()
-	case joinedchat.FieldChatID:
-		return m.ChatID()
-	case joinedchat.FieldChatName:
-		return m.ChatName()
-	case joinedchat.FieldChatType:
-		return m.ChatType()
-	case joinedchat.FieldDialogDate:
-		return m.DialogDate()
-	case joinedchat.FieldCreatedAt:
+	case workspace.FieldSlug:
+		return m.Slug()
+	case workspace.FieldName:
+		return m.Name()
+	case workspace.FieldGraphName:
+		return m.GraphName()
+	case workspace.FieldLlmProviders:
+		return m.LlmProviders()
+	case workspace.FieldLlmMonthlyBudget:
+		return m.LlmMonthlyBudget()
+	case workspace.FieldCreatedAt:
 		return m.CreatedAt()
-	case joinedchat.FieldUpdatedAt:
+	case workspace.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -4140,74 +20293,74 @@ func (m *JoinedChatMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *JoinedChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WorkspaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case joinedchat.FieldPlatform:
-		return m.OldPlatform(ctx)
-	case joinedchat.FieldChatID:
-		return m.OldChatID(ctx)
-	case joinedchat.FieldChatName:
-		return m.OldChatName(ctx)
-	case joinedchat.FieldChatType:
-		return m.OldChatType(ctx)
-	case joinedchat.FieldDialogDate:
-		return m.OldDialogDate(ctx)
-	case joinedchat.FieldCreatedAt:
+	case workspace.FieldSlug:
+		return m.OldSlug(ctx)
+	case workspace.FieldName:
+		return m.OldName(ctx)
+	case workspace.FieldGraphName:
+		return m.OldGraphName(ctx)
+	case workspace.FieldLlmProviders:
+		return m.OldLlmProviders(ctx)
+	case workspace.FieldLlmMonthlyBudget:
+		return m.OldLlmMonthlyBudget(ctx)
+	case workspace.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case joinedchat.FieldUpdatedAt:
+	case workspace.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown JoinedChat field %s", name)
+	return nil, fmt.Errorf("unknown Workspace field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JoinedChatMutation) SetField(name string, value ent.Value) error {
+func (m *WorkspaceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case joinedchat.FieldPlatform:
+	case workspace.FieldSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlatform(v)
+		m.SetSlug(v)
 		return nil
-	case joinedchat.FieldChatID:
+	case workspace.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatID(v)
+		m.SetName(v)
 		return nil
-	case joinedchat.FieldChatName:
+	case workspace.FieldGraphName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatName(v)
+		m.SetGraphName(v)
 		return nil
-	case joinedchat.FieldChatType:
-		v, ok := value.(string)
+	case workspace.FieldLlmProviders:
+		v, ok := value.(jsontext.Value)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatType(v)
+		m.SetLlmProviders(v)
 		return nil
-	case joinedchat.FieldDialogDate:
-		v, ok := value.(int64)
+	case workspace.FieldLlmMonthlyBudget:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDialogDate(v)
+		m.SetLlmMonthlyBudget(v)
 		return nil
-	case joinedchat.FieldCreatedAt:
+	case workspace.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case joinedchat.FieldUpdatedAt:
+	case workspace.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4215,21 +20368,21 @@ func (m *JoinedChatMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown JoinedChat field %s", name)
+	return fmt.Errorf("unknown Workspace field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *JoinedChatMutation) AddedFields() []string {
+func (m *WorkspaceMutation) AddedFields() []string {
 	var fields []string
-	if m.adddialog_date != nil {
-		fields = append(fields, joinedchat.FieldDialogDate)
+	if m.addllm_monthly_budget != nil {
+		fields = append(fields, workspace.FieldLlmMonthlyBudget)
 	}
 	if m.addcreated_at != nil {
-		fields = append(fields, joinedchat.FieldCreatedAt)
+		fields = append(fields, workspace.FieldCreatedAt)
 	}
 	if m.addupdated_at != nil {
-		fields = append(fields, joinedchat.FieldUpdatedAt)
+		fields = append(fields, workspace.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4237,13 +20390,13 @@ func (m *JoinedChatMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *JoinedChatMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WorkspaceMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case joinedchat.FieldDialogDate:
-		return m.AddedDialogDate()
-	case joinedchat.FieldCreatedAt:
+	case workspace.FieldLlmMonthlyBudget:
+		return m.AddedLlmMonthlyBudget()
+	case workspace.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case joinedchat.FieldUpdatedAt:
+	case workspace.FieldUpdatedAt:
 		return m.AddedUpdatedAt()
 	}
 	return nil, false
@@ -4252,23 +20405,23 @@ func (m *JoinedChatMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JoinedChatMutation) AddField(name string, value ent.Value) error {
+func (m *WorkspaceMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case joinedchat.FieldDialogDate:
-		v, ok := value.(int64)
+	case workspace.FieldLlmMonthlyBudget:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDialogDate(v)
+		m.AddLlmMonthlyBudget(v)
 		return nil
-	case joinedchat.FieldCreatedAt:
+	case workspace.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
-	case joinedchat.FieldUpdatedAt:
+	case workspace.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4276,101 +20429,110 @@ func (m *JoinedChatMutation) AddField(name string, value ent.Value) error {
 		m.AddUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown JoinedChat numeric field %s", name)
+	return fmt.Errorf("unknown Workspace numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *JoinedChatMutation) ClearedFields() []string {
-	return nil
+func (m *WorkspaceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(workspace.FieldLlmProviders) {
+		fields = append(fields, workspace.FieldLlmProviders)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *JoinedChatMutation) FieldCleared(name string) bool {
+func (m *WorkspaceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *JoinedChatMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown JoinedChat nullable field %s", name)
+func (m *WorkspaceMutation) ClearField(name string) error {
+	switch name {
+	case workspace.FieldLlmProviders:
+		m.ClearLlmProviders()
+		return nil
+	}
+	return fmt.Errorf("unknown Workspace nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *JoinedChatMutation) ResetField(name string) error {
+func (m *WorkspaceMutation) ResetField(name string) error {
 	switch name {
-	case joinedchat.FieldPlatform:
-		m.ResetPlatform()
+	case workspace.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case joinedchat.FieldChatID:
-		m.ResetChatID()
+	case workspace.FieldName:
+		m.ResetName()
 		return nil
-	case joinedchat.FieldChatName:
-		m.ResetChatName()
+	case workspace.FieldGraphName:
+		m.ResetGraphName()
 		return nil
-	case joinedchat.FieldChatType:
-		m.ResetChatType()
+	case workspace.FieldLlmProviders:
+		m.ResetLlmProviders()
 		return nil
-	case joinedchat.FieldDialogDate:
-		m.ResetDialogDate()
+	case workspace.FieldLlmMonthlyBudget:
+		m.ResetLlmMonthlyBudget()
 		return nil
-	case joinedchat.FieldCreatedAt:
+	case workspace.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case joinedchat.FieldUpdatedAt:
+	case workspace.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown JoinedChat field %s", name)
+	return fmt.Errorf("unknown Workspace field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JoinedChatMutation) AddedEdges() []string {
+func (m *WorkspaceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *JoinedChatMutation) AddedIDs(name string) []ent.Value {
+func (m *WorkspaceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JoinedChatMutation) RemovedEdges() []string {
+func (m *WorkspaceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *JoinedChatMutation) RemovedIDs(name string) []ent.Value {
+func (m *WorkspaceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JoinedChatMutation) ClearedEdges() []string {
+func (m *WorkspaceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *JoinedChatMutation) EdgeCleared(name string) bool {
+func (m *WorkspaceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *JoinedChatMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown JoinedChat unique edge %s", name)
+func (m *WorkspaceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Workspace unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *JoinedChatMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown JoinedChat edge %s", name)
+func (m *WorkspaceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Workspace edge %s", name)
 }
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/owneraccountlink"
+)
+
+// OwnerAccountLink is the model entity for the OwnerAccountLink schema.
+type OwnerAccountLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// OwnerAccountID holds the value of the "owner_account_id" field.
+	OwnerAccountID uuid.UUID `json:"owner_account_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OwnerAccountLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case owneraccountlink.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case owneraccountlink.FieldSubject:
+			values[i] = new(sql.NullString)
+		case owneraccountlink.FieldID, owneraccountlink.FieldWorkspaceID, owneraccountlink.FieldOwnerAccountID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OwnerAccountLink fields.
+func (_m *OwnerAccountLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case owneraccountlink.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case owneraccountlink.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
+		case owneraccountlink.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case owneraccountlink.FieldOwnerAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_account_id", values[i])
+			} else if value != nil {
+				_m.OwnerAccountID = *value
+			}
+		case owneraccountlink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OwnerAccountLink.
+// This includes values selected through modifiers, order, etc.
+func (_m *OwnerAccountLink) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OwnerAccountLink.
+// Note that you need to call OwnerAccountLink.Unwrap() before calling this method if this OwnerAccountLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OwnerAccountLink) Update() *OwnerAccountLinkUpdateOne {
+	return NewOwnerAccountLinkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OwnerAccountLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OwnerAccountLink) Unwrap() *OwnerAccountLink {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OwnerAccountLink is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OwnerAccountLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("OwnerAccountLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("owner_account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerAccountID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OwnerAccountLinks is a parsable slice of OwnerAccountLink.
+type OwnerAccountLinks []*OwnerAccountLink
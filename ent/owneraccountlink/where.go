@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package owneraccountlink
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEQ(FieldSubject, v))
+}
+
+// OwnerAccountID applies equality check predicate on the "owner_account_id" field. It's identical to OwnerAccountIDEQ.
+func OwnerAccountID(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEQ(FieldOwnerAccountID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// OwnerAccountIDEQ applies the EQ predicate on the "owner_account_id" field.
+func OwnerAccountIDEQ(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEQ(FieldOwnerAccountID, v))
+}
+
+// OwnerAccountIDNEQ applies the NEQ predicate on the "owner_account_id" field.
+func OwnerAccountIDNEQ(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldNEQ(FieldOwnerAccountID, v))
+}
+
+// OwnerAccountIDIn applies the In predicate on the "owner_account_id" field.
+func OwnerAccountIDIn(vs ...uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldIn(FieldOwnerAccountID, vs...))
+}
+
+// OwnerAccountIDNotIn applies the NotIn predicate on the "owner_account_id" field.
+func OwnerAccountIDNotIn(vs ...uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldNotIn(FieldOwnerAccountID, vs...))
+}
+
+// OwnerAccountIDGT applies the GT predicate on the "owner_account_id" field.
+func OwnerAccountIDGT(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldGT(FieldOwnerAccountID, v))
+}
+
+// OwnerAccountIDGTE applies the GTE predicate on the "owner_account_id" field.
+func OwnerAccountIDGTE(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldGTE(FieldOwnerAccountID, v))
+}
+
+// OwnerAccountIDLT applies the LT predicate on the "owner_account_id" field.
+func OwnerAccountIDLT(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldLT(FieldOwnerAccountID, v))
+}
+
+// OwnerAccountIDLTE applies the LTE predicate on the "owner_account_id" field.
+func OwnerAccountIDLTE(v uuid.UUID) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldLTE(FieldOwnerAccountID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OwnerAccountLink) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OwnerAccountLink) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OwnerAccountLink) predicate.OwnerAccountLink {
+	return predicate.OwnerAccountLink(sql.NotPredicates(p))
+}
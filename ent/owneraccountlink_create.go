@@ -0,0 +1,663 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/owneraccountlink"
+)
+
+// OwnerAccountLinkCreate is the builder for creating a OwnerAccountLink entity.
+type OwnerAccountLinkCreate struct {
+	config
+	mutation *OwnerAccountLinkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *OwnerAccountLinkCreate) SetWorkspaceID(v uuid.UUID) *OwnerAccountLinkCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *OwnerAccountLinkCreate) SetNillableWorkspaceID(v *uuid.UUID) *OwnerAccountLinkCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *OwnerAccountLinkCreate) SetSubject(v string) *OwnerAccountLinkCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetOwnerAccountID sets the "owner_account_id" field.
+func (_c *OwnerAccountLinkCreate) SetOwnerAccountID(v uuid.UUID) *OwnerAccountLinkCreate {
+	_c.mutation.SetOwnerAccountID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OwnerAccountLinkCreate) SetCreatedAt(v int64) *OwnerAccountLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OwnerAccountLinkCreate) SetNillableCreatedAt(v *int64) *OwnerAccountLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OwnerAccountLinkCreate) SetID(v uuid.UUID) *OwnerAccountLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OwnerAccountLinkCreate) SetNillableID(v *uuid.UUID) *OwnerAccountLinkCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the OwnerAccountLinkMutation object of the builder.
+func (_c *OwnerAccountLinkCreate) Mutation() *OwnerAccountLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the OwnerAccountLink in the database.
+func (_c *OwnerAccountLinkCreate) Save(ctx context.Context) (*OwnerAccountLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OwnerAccountLinkCreate) SaveX(ctx context.Context) *OwnerAccountLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OwnerAccountLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OwnerAccountLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OwnerAccountLinkCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := owneraccountlink.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := owneraccountlink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := owneraccountlink.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OwnerAccountLinkCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "OwnerAccountLink.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "OwnerAccountLink.subject"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := owneraccountlink.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "OwnerAccountLink.subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OwnerAccountID(); !ok {
+		return &ValidationError{Name: "owner_account_id", err: errors.New(`ent: missing required field "OwnerAccountLink.owner_account_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OwnerAccountLink.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *OwnerAccountLinkCreate) sqlSave(ctx context.Context) (*OwnerAccountLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OwnerAccountLinkCreate) createSpec() (*OwnerAccountLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OwnerAccountLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(owneraccountlink.Table, sqlgraph.NewFieldSpec(owneraccountlink.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.OwnerAccountLink
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(owneraccountlink.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(owneraccountlink.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.OwnerAccountID(); ok {
+		_spec.SetField(owneraccountlink.FieldOwnerAccountID, field.TypeUUID, value)
+		_node.OwnerAccountID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(owneraccountlink.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OwnerAccountLink.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OwnerAccountLinkUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OwnerAccountLinkCreate) OnConflict(opts ...sql.ConflictOption) *OwnerAccountLinkUpsertOne {
+	_c.conflict = opts
+	return &OwnerAccountLinkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OwnerAccountLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OwnerAccountLinkCreate) OnConflictColumns(columns ...string) *OwnerAccountLinkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OwnerAccountLinkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// OwnerAccountLinkUpsertOne is the builder for "upsert"-ing
+	//  one OwnerAccountLink node.
+	OwnerAccountLinkUpsertOne struct {
+		create *OwnerAccountLinkCreate
+	}
+
+	// OwnerAccountLinkUpsert is the "OnConflict" setter.
+	OwnerAccountLinkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *OwnerAccountLinkUpsert) SetWorkspaceID(v uuid.UUID) *OwnerAccountLinkUpsert {
+	u.Set(owneraccountlink.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *OwnerAccountLinkUpsert) UpdateWorkspaceID() *OwnerAccountLinkUpsert {
+	u.SetExcluded(owneraccountlink.FieldWorkspaceID)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *OwnerAccountLinkUpsert) SetSubject(v string) *OwnerAccountLinkUpsert {
+	u.Set(owneraccountlink.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *OwnerAccountLinkUpsert) UpdateSubject() *OwnerAccountLinkUpsert {
+	u.SetExcluded(owneraccountlink.FieldSubject)
+	return u
+}
+
+// SetOwnerAccountID sets the "owner_account_id" field.
+func (u *OwnerAccountLinkUpsert) SetOwnerAccountID(v uuid.UUID) *OwnerAccountLinkUpsert {
+	u.Set(owneraccountlink.FieldOwnerAccountID, v)
+	return u
+}
+
+// UpdateOwnerAccountID sets the "owner_account_id" field to the value that was provided on create.
+func (u *OwnerAccountLinkUpsert) UpdateOwnerAccountID() *OwnerAccountLinkUpsert {
+	u.SetExcluded(owneraccountlink.FieldOwnerAccountID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OwnerAccountLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(owneraccountlink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OwnerAccountLinkUpsertOne) UpdateNewValues() *OwnerAccountLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(owneraccountlink.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(owneraccountlink.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OwnerAccountLink.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OwnerAccountLinkUpsertOne) Ignore() *OwnerAccountLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OwnerAccountLinkUpsertOne) DoNothing() *OwnerAccountLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OwnerAccountLinkCreate.OnConflict
+// documentation for more info.
+func (u *OwnerAccountLinkUpsertOne) Update(set func(*OwnerAccountLinkUpsert)) *OwnerAccountLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OwnerAccountLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *OwnerAccountLinkUpsertOne) SetWorkspaceID(v uuid.UUID) *OwnerAccountLinkUpsertOne {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *OwnerAccountLinkUpsertOne) UpdateWorkspaceID() *OwnerAccountLinkUpsertOne {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *OwnerAccountLinkUpsertOne) SetSubject(v string) *OwnerAccountLinkUpsertOne {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *OwnerAccountLinkUpsertOne) UpdateSubject() *OwnerAccountLinkUpsertOne {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetOwnerAccountID sets the "owner_account_id" field.
+func (u *OwnerAccountLinkUpsertOne) SetOwnerAccountID(v uuid.UUID) *OwnerAccountLinkUpsertOne {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.SetOwnerAccountID(v)
+	})
+}
+
+// UpdateOwnerAccountID sets the "owner_account_id" field to the value that was provided on create.
+func (u *OwnerAccountLinkUpsertOne) UpdateOwnerAccountID() *OwnerAccountLinkUpsertOne {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.UpdateOwnerAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *OwnerAccountLinkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OwnerAccountLinkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OwnerAccountLinkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OwnerAccountLinkUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OwnerAccountLinkUpsertOne.ID is not supported by MySQL driver. Use OwnerAccountLinkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OwnerAccountLinkUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OwnerAccountLinkCreateBulk is the builder for creating many OwnerAccountLink entities in bulk.
+type OwnerAccountLinkCreateBulk struct {
+	config
+	err      error
+	builders []*OwnerAccountLinkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OwnerAccountLink entities in the database.
+func (_c *OwnerAccountLinkCreateBulk) Save(ctx context.Context) ([]*OwnerAccountLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OwnerAccountLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OwnerAccountLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OwnerAccountLinkCreateBulk) SaveX(ctx context.Context) []*OwnerAccountLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OwnerAccountLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OwnerAccountLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OwnerAccountLink.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OwnerAccountLinkUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OwnerAccountLinkCreateBulk) OnConflict(opts ...sql.ConflictOption) *OwnerAccountLinkUpsertBulk {
+	_c.conflict = opts
+	return &OwnerAccountLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OwnerAccountLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OwnerAccountLinkCreateBulk) OnConflictColumns(columns ...string) *OwnerAccountLinkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OwnerAccountLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OwnerAccountLinkUpsertBulk is the builder for "upsert"-ing
+// a bulk of OwnerAccountLink nodes.
+type OwnerAccountLinkUpsertBulk struct {
+	create *OwnerAccountLinkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OwnerAccountLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(owneraccountlink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OwnerAccountLinkUpsertBulk) UpdateNewValues() *OwnerAccountLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(owneraccountlink.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(owneraccountlink.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OwnerAccountLink.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OwnerAccountLinkUpsertBulk) Ignore() *OwnerAccountLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OwnerAccountLinkUpsertBulk) DoNothing() *OwnerAccountLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OwnerAccountLinkCreateBulk.OnConflict
+// documentation for more info.
+func (u *OwnerAccountLinkUpsertBulk) Update(set func(*OwnerAccountLinkUpsert)) *OwnerAccountLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OwnerAccountLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *OwnerAccountLinkUpsertBulk) SetWorkspaceID(v uuid.UUID) *OwnerAccountLinkUpsertBulk {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *OwnerAccountLinkUpsertBulk) UpdateWorkspaceID() *OwnerAccountLinkUpsertBulk {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *OwnerAccountLinkUpsertBulk) SetSubject(v string) *OwnerAccountLinkUpsertBulk {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *OwnerAccountLinkUpsertBulk) UpdateSubject() *OwnerAccountLinkUpsertBulk {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetOwnerAccountID sets the "owner_account_id" field.
+func (u *OwnerAccountLinkUpsertBulk) SetOwnerAccountID(v uuid.UUID) *OwnerAccountLinkUpsertBulk {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.SetOwnerAccountID(v)
+	})
+}
+
+// UpdateOwnerAccountID sets the "owner_account_id" field to the value that was provided on create.
+func (u *OwnerAccountLinkUpsertBulk) UpdateOwnerAccountID() *OwnerAccountLinkUpsertBulk {
+	return u.Update(func(s *OwnerAccountLinkUpsert) {
+		s.UpdateOwnerAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *OwnerAccountLinkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OwnerAccountLinkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OwnerAccountLinkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OwnerAccountLinkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/owneraccountlink"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// OwnerAccountLinkDelete is the builder for deleting a OwnerAccountLink entity.
+type OwnerAccountLinkDelete struct {
+	config
+	hooks    []Hook
+	mutation *OwnerAccountLinkMutation
+}
+
+// Where appends a list predicates to the OwnerAccountLinkDelete builder.
+func (_d *OwnerAccountLinkDelete) Where(ps ...predicate.OwnerAccountLink) *OwnerAccountLinkDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *OwnerAccountLinkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *OwnerAccountLinkDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *OwnerAccountLinkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(owneraccountlink.Table, sqlgraph.NewFieldSpec(owneraccountlink.FieldID, field.TypeUUID))
+	_spec.Node.Schema = _d.schemaConfig.OwnerAccountLink
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// OwnerAccountLinkDeleteOne is the builder for deleting a single OwnerAccountLink entity.
+type OwnerAccountLinkDeleteOne struct {
+	_d *OwnerAccountLinkDelete
+}
+
+// Where appends a list predicates to the OwnerAccountLinkDelete builder.
+func (_d *OwnerAccountLinkDeleteOne) Where(ps ...predicate.OwnerAccountLink) *OwnerAccountLinkDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *OwnerAccountLinkDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{owneraccountlink.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *OwnerAccountLinkDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
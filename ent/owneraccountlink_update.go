@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/owneraccountlink"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// OwnerAccountLinkUpdate is the builder for updating OwnerAccountLink entities.
+type OwnerAccountLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OwnerAccountLinkMutation
+}
+
+// Where appends a list predicates to the OwnerAccountLinkUpdate builder.
+func (_u *OwnerAccountLinkUpdate) Where(ps ...predicate.OwnerAccountLink) *OwnerAccountLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *OwnerAccountLinkUpdate) SetWorkspaceID(v uuid.UUID) *OwnerAccountLinkUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *OwnerAccountLinkUpdate) SetNillableWorkspaceID(v *uuid.UUID) *OwnerAccountLinkUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *OwnerAccountLinkUpdate) SetSubject(v string) *OwnerAccountLinkUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *OwnerAccountLinkUpdate) SetNillableSubject(v *string) *OwnerAccountLinkUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetOwnerAccountID sets the "owner_account_id" field.
+func (_u *OwnerAccountLinkUpdate) SetOwnerAccountID(v uuid.UUID) *OwnerAccountLinkUpdate {
+	_u.mutation.SetOwnerAccountID(v)
+	return _u
+}
+
+// SetNillableOwnerAccountID sets the "owner_account_id" field if the given value is not nil.
+func (_u *OwnerAccountLinkUpdate) SetNillableOwnerAccountID(v *uuid.UUID) *OwnerAccountLinkUpdate {
+	if v != nil {
+		_u.SetOwnerAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the OwnerAccountLinkMutation object of the builder.
+func (_u *OwnerAccountLinkUpdate) Mutation() *OwnerAccountLinkMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OwnerAccountLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OwnerAccountLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OwnerAccountLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OwnerAccountLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OwnerAccountLinkUpdate) check() error {
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := owneraccountlink.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "OwnerAccountLink.subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OwnerAccountLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(owneraccountlink.Table, owneraccountlink.Columns, sqlgraph.NewFieldSpec(owneraccountlink.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(owneraccountlink.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(owneraccountlink.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OwnerAccountID(); ok {
+		_spec.SetField(owneraccountlink.FieldOwnerAccountID, field.TypeUUID, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.OwnerAccountLink
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{owneraccountlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OwnerAccountLinkUpdateOne is the builder for updating a single OwnerAccountLink entity.
+type OwnerAccountLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OwnerAccountLinkMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *OwnerAccountLinkUpdateOne) SetWorkspaceID(v uuid.UUID) *OwnerAccountLinkUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *OwnerAccountLinkUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *OwnerAccountLinkUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *OwnerAccountLinkUpdateOne) SetSubject(v string) *OwnerAccountLinkUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *OwnerAccountLinkUpdateOne) SetNillableSubject(v *string) *OwnerAccountLinkUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetOwnerAccountID sets the "owner_account_id" field.
+func (_u *OwnerAccountLinkUpdateOne) SetOwnerAccountID(v uuid.UUID) *OwnerAccountLinkUpdateOne {
+	_u.mutation.SetOwnerAccountID(v)
+	return _u
+}
+
+// SetNillableOwnerAccountID sets the "owner_account_id" field if the given value is not nil.
+func (_u *OwnerAccountLinkUpdateOne) SetNillableOwnerAccountID(v *uuid.UUID) *OwnerAccountLinkUpdateOne {
+	if v != nil {
+		_u.SetOwnerAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the OwnerAccountLinkMutation object of the builder.
+func (_u *OwnerAccountLinkUpdateOne) Mutation() *OwnerAccountLinkMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OwnerAccountLinkUpdate builder.
+func (_u *OwnerAccountLinkUpdateOne) Where(ps ...predicate.OwnerAccountLink) *OwnerAccountLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OwnerAccountLinkUpdateOne) Select(field string, fields ...string) *OwnerAccountLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OwnerAccountLink entity.
+func (_u *OwnerAccountLinkUpdateOne) Save(ctx context.Context) (*OwnerAccountLink, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OwnerAccountLinkUpdateOne) SaveX(ctx context.Context) *OwnerAccountLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OwnerAccountLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OwnerAccountLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OwnerAccountLinkUpdateOne) check() error {
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := owneraccountlink.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "OwnerAccountLink.subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OwnerAccountLinkUpdateOne) sqlSave(ctx context.Context) (_node *OwnerAccountLink, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(owneraccountlink.Table, owneraccountlink.Columns, sqlgraph.NewFieldSpec(owneraccountlink.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OwnerAccountLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, owneraccountlink.FieldID)
+		for _, f := range fields {
+			if !owneraccountlink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != owneraccountlink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(owneraccountlink.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(owneraccountlink.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OwnerAccountID(); ok {
+		_spec.SetField(owneraccountlink.FieldOwnerAccountID, field.TypeUUID, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.OwnerAccountLink
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &OwnerAccountLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{owneraccountlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/personauditlog"
+)
+
+// PersonAuditLog is the model entity for the PersonAuditLog schema.
+type PersonAuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action personauditlog.Action `json:"action,omitempty"`
+	// PersonID holds the value of the "person_id" field.
+	PersonID uuid.UUID `json:"person_id,omitempty"`
+	// SourcePersonIds holds the value of the "source_person_ids" field.
+	SourcePersonIds []uuid.UUID `json:"source_person_ids,omitempty"`
+	// IdentityIds holds the value of the "identity_ids" field.
+	IdentityIds []uuid.UUID `json:"identity_ids,omitempty"`
+	// ChatIds holds the value of the "chat_ids" field.
+	ChatIds []string `json:"chat_ids,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Counts holds the value of the "counts" field.
+	Counts map[string]int `json:"counts,omitempty"`
+	// Score holds the value of the "score" field.
+	Score float64 `json:"score,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PersonAuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case personauditlog.FieldSourcePersonIds, personauditlog.FieldIdentityIds, personauditlog.FieldChatIds, personauditlog.FieldCounts:
+			values[i] = new([]byte)
+		case personauditlog.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case personauditlog.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case personauditlog.FieldAction, personauditlog.FieldActor, personauditlog.FieldReason:
+			values[i] = new(sql.NullString)
+		case personauditlog.FieldID, personauditlog.FieldWorkspaceID, personauditlog.FieldPersonID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PersonAuditLog fields.
+func (_m *PersonAuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case personauditlog.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case personauditlog.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
+		case personauditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = personauditlog.Action(value.String)
+			}
+		case personauditlog.FieldPersonID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field person_id", values[i])
+			} else if value != nil {
+				_m.PersonID = *value
+			}
+		case personauditlog.FieldSourcePersonIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field source_person_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SourcePersonIds); err != nil {
+					return fmt.Errorf("unmarshal field source_person_ids: %w", err)
+				}
+			}
+		case personauditlog.FieldIdentityIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field identity_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.IdentityIds); err != nil {
+					return fmt.Errorf("unmarshal field identity_ids: %w", err)
+				}
+			}
+		case personauditlog.FieldChatIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ChatIds); err != nil {
+					return fmt.Errorf("unmarshal field chat_ids: %w", err)
+				}
+			}
+		case personauditlog.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = value.String
+			}
+		case personauditlog.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case personauditlog.FieldCounts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field counts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Counts); err != nil {
+					return fmt.Errorf("unmarshal field counts: %w", err)
+				}
+			}
+		case personauditlog.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = value.Float64
+			}
+		case personauditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PersonAuditLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *PersonAuditLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PersonAuditLog.
+// Note that you need to call PersonAuditLog.Unwrap() before calling this method if this PersonAuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PersonAuditLog) Update() *PersonAuditLogUpdateOne {
+	return NewPersonAuditLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PersonAuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PersonAuditLog) Unwrap() *PersonAuditLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PersonAuditLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PersonAuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("PersonAuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("person_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PersonID))
+	builder.WriteString(", ")
+	builder.WriteString("source_person_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SourcePersonIds))
+	builder.WriteString(", ")
+	builder.WriteString("identity_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IdentityIds))
+	builder.WriteString(", ")
+	builder.WriteString("chat_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatIds))
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(_m.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("counts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Counts))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PersonAuditLogs is a parsable slice of PersonAuditLog.
+type PersonAuditLogs []*PersonAuditLog
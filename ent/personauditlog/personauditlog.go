@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package personauditlog
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the personauditlog type in the database.
+	Label = "person_audit_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldPersonID holds the string denoting the person_id field in the database.
+	FieldPersonID = "person_id"
+	// FieldSourcePersonIds holds the string denoting the source_person_ids field in the database.
+	FieldSourcePersonIds = "source_person_ids"
+	// FieldIdentityIds holds the string denoting the identity_ids field in the database.
+	FieldIdentityIds = "identity_ids"
+	// FieldChatIds holds the string denoting the chat_ids field in the database.
+	FieldChatIds = "chat_ids"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCounts holds the string denoting the counts field in the database.
+	FieldCounts = "counts"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the personauditlog in the database.
+	Table = "person_audit_logs"
+)
+
+// Columns holds all SQL columns for personauditlog fields.
+var Columns = []string{
+	FieldID,
+	FieldWorkspaceID,
+	FieldAction,
+	FieldPersonID,
+	FieldSourcePersonIds,
+	FieldIdentityIds,
+	FieldChatIds,
+	FieldActor,
+	FieldReason,
+	FieldCounts,
+	FieldScore,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultWorkspaceID holds the default value on creation for the "workspace_id" field.
+	DefaultWorkspaceID func() uuid.UUID
+	// DefaultSourcePersonIds holds the default value on creation for the "source_person_ids" field.
+	DefaultSourcePersonIds []uuid.UUID
+	// DefaultIdentityIds holds the default value on creation for the "identity_ids" field.
+	DefaultIdentityIds []uuid.UUID
+	// DefaultChatIds holds the default value on creation for the "chat_ids" field.
+	DefaultChatIds []string
+	// DefaultActor holds the default value on creation for the "actor" field.
+	DefaultActor string
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore float64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionMerge  Action = "merge"
+	ActionSplit  Action = "split"
+	ActionForget Action = "forget"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionMerge, ActionSplit, ActionForget:
+		return nil
+	default:
+		return fmt.Errorf("personauditlog: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the PersonAuditLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByPersonID orders the results by the person_id field.
+func ByPersonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPersonID, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
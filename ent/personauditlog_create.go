@@ -0,0 +1,1119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/personauditlog"
+)
+
+// PersonAuditLogCreate is the builder for creating a PersonAuditLog entity.
+type PersonAuditLogCreate struct {
+	config
+	mutation *PersonAuditLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *PersonAuditLogCreate) SetWorkspaceID(v uuid.UUID) *PersonAuditLogCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *PersonAuditLogCreate) SetNillableWorkspaceID(v *uuid.UUID) *PersonAuditLogCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *PersonAuditLogCreate) SetAction(v personauditlog.Action) *PersonAuditLogCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetPersonID sets the "person_id" field.
+func (_c *PersonAuditLogCreate) SetPersonID(v uuid.UUID) *PersonAuditLogCreate {
+	_c.mutation.SetPersonID(v)
+	return _c
+}
+
+// SetSourcePersonIds sets the "source_person_ids" field.
+func (_c *PersonAuditLogCreate) SetSourcePersonIds(v []uuid.UUID) *PersonAuditLogCreate {
+	_c.mutation.SetSourcePersonIds(v)
+	return _c
+}
+
+// SetIdentityIds sets the "identity_ids" field.
+func (_c *PersonAuditLogCreate) SetIdentityIds(v []uuid.UUID) *PersonAuditLogCreate {
+	_c.mutation.SetIdentityIds(v)
+	return _c
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (_c *PersonAuditLogCreate) SetChatIds(v []string) *PersonAuditLogCreate {
+	_c.mutation.SetChatIds(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *PersonAuditLogCreate) SetActor(v string) *PersonAuditLogCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *PersonAuditLogCreate) SetNillableActor(v *string) *PersonAuditLogCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *PersonAuditLogCreate) SetReason(v string) *PersonAuditLogCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *PersonAuditLogCreate) SetNillableReason(v *string) *PersonAuditLogCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetCounts sets the "counts" field.
+func (_c *PersonAuditLogCreate) SetCounts(v map[string]int) *PersonAuditLogCreate {
+	_c.mutation.SetCounts(v)
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *PersonAuditLogCreate) SetScore(v float64) *PersonAuditLogCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *PersonAuditLogCreate) SetNillableScore(v *float64) *PersonAuditLogCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PersonAuditLogCreate) SetCreatedAt(v int64) *PersonAuditLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PersonAuditLogCreate) SetNillableCreatedAt(v *int64) *PersonAuditLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PersonAuditLogCreate) SetID(v uuid.UUID) *PersonAuditLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PersonAuditLogCreate) SetNillableID(v *uuid.UUID) *PersonAuditLogCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PersonAuditLogMutation object of the builder.
+func (_c *PersonAuditLogCreate) Mutation() *PersonAuditLogMutation {
+	return _c.mutation
+}
+
+// Save creates the PersonAuditLog in the database.
+func (_c *PersonAuditLogCreate) Save(ctx context.Context) (*PersonAuditLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PersonAuditLogCreate) SaveX(ctx context.Context) *PersonAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PersonAuditLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PersonAuditLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PersonAuditLogCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := personauditlog.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.SourcePersonIds(); !ok {
+		v := personauditlog.DefaultSourcePersonIds
+		_c.mutation.SetSourcePersonIds(v)
+	}
+	if _, ok := _c.mutation.IdentityIds(); !ok {
+		v := personauditlog.DefaultIdentityIds
+		_c.mutation.SetIdentityIds(v)
+	}
+	if _, ok := _c.mutation.ChatIds(); !ok {
+		v := personauditlog.DefaultChatIds
+		_c.mutation.SetChatIds(v)
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		v := personauditlog.DefaultActor
+		_c.mutation.SetActor(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := personauditlog.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := personauditlog.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := personauditlog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := personauditlog.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PersonAuditLogCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "PersonAuditLog.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "PersonAuditLog.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := personauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PersonAuditLog.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PersonID(); !ok {
+		return &ValidationError{Name: "person_id", err: errors.New(`ent: missing required field "PersonAuditLog.person_id"`)}
+	}
+	if _, ok := _c.mutation.SourcePersonIds(); !ok {
+		return &ValidationError{Name: "source_person_ids", err: errors.New(`ent: missing required field "PersonAuditLog.source_person_ids"`)}
+	}
+	if _, ok := _c.mutation.IdentityIds(); !ok {
+		return &ValidationError{Name: "identity_ids", err: errors.New(`ent: missing required field "PersonAuditLog.identity_ids"`)}
+	}
+	if _, ok := _c.mutation.ChatIds(); !ok {
+		return &ValidationError{Name: "chat_ids", err: errors.New(`ent: missing required field "PersonAuditLog.chat_ids"`)}
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "PersonAuditLog.actor"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "PersonAuditLog.reason"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "PersonAuditLog.score"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PersonAuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *PersonAuditLogCreate) sqlSave(ctx context.Context) (*PersonAuditLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PersonAuditLogCreate) createSpec() (*PersonAuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PersonAuditLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(personauditlog.Table, sqlgraph.NewFieldSpec(personauditlog.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.PersonAuditLog
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(personauditlog.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(personauditlog.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.PersonID(); ok {
+		_spec.SetField(personauditlog.FieldPersonID, field.TypeUUID, value)
+		_node.PersonID = value
+	}
+	if value, ok := _c.mutation.SourcePersonIds(); ok {
+		_spec.SetField(personauditlog.FieldSourcePersonIds, field.TypeJSON, value)
+		_node.SourcePersonIds = value
+	}
+	if value, ok := _c.mutation.IdentityIds(); ok {
+		_spec.SetField(personauditlog.FieldIdentityIds, field.TypeJSON, value)
+		_node.IdentityIds = value
+	}
+	if value, ok := _c.mutation.ChatIds(); ok {
+		_spec.SetField(personauditlog.FieldChatIds, field.TypeJSON, value)
+		_node.ChatIds = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(personauditlog.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(personauditlog.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Counts(); ok {
+		_spec.SetField(personauditlog.FieldCounts, field.TypeJSON, value)
+		_node.Counts = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(personauditlog.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(personauditlog.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PersonAuditLog.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PersonAuditLogUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PersonAuditLogCreate) OnConflict(opts ...sql.ConflictOption) *PersonAuditLogUpsertOne {
+	_c.conflict = opts
+	return &PersonAuditLogUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PersonAuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PersonAuditLogCreate) OnConflictColumns(columns ...string) *PersonAuditLogUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PersonAuditLogUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PersonAuditLogUpsertOne is the builder for "upsert"-ing
+	//  one PersonAuditLog node.
+	PersonAuditLogUpsertOne struct {
+		create *PersonAuditLogCreate
+	}
+
+	// PersonAuditLogUpsert is the "OnConflict" setter.
+	PersonAuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *PersonAuditLogUpsert) SetWorkspaceID(v uuid.UUID) *PersonAuditLogUpsert {
+	u.Set(personauditlog.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *PersonAuditLogUpsert) UpdateWorkspaceID() *PersonAuditLogUpsert {
+	u.SetExcluded(personauditlog.FieldWorkspaceID)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *PersonAuditLogUpsert) SetAction(v personauditlog.Action) *PersonAuditLogUpsert {
+	u.Set(personauditlog.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *PersonAuditLogUpsert) UpdateAction() *PersonAuditLogUpsert {
+	u.SetExcluded(personauditlog.FieldAction)
+	return u
+}
+
+// SetPersonID sets the "person_id" field.
+func (u *PersonAuditLogUpsert) SetPersonID(v uuid.UUID) *PersonAuditLogUpsert {
+	u.Set(personauditlog.FieldPersonID, v)
+	return u
+}
+
+// UpdatePersonID sets the "person_id" field to the value that was provided on create.
+func (u *PersonAuditLogUpsert) UpdatePersonID() *PersonAuditLogUpsert {
+	u.SetExcluded(personauditlog.FieldPersonID)
+	return u
+}
+
+// SetSourcePersonIds sets the "source_person_ids" field.
+func (u *PersonAuditLogUpsert) SetSourcePersonIds(v []uuid.UUID) *PersonAuditLogUpsert {
+	u.Set(personauditlog.FieldSourcePersonIds, v)
+	return u
+}
+
+// UpdateSourcePersonIds sets the "source_person_ids" field to the value that was provided on create.
+func (u *PersonAuditLogUpsert) UpdateSourcePersonIds() *PersonAuditLogUpsert {
+	u.SetExcluded(personauditlog.FieldSourcePersonIds)
+	return u
+}
+
+// SetIdentityIds sets the "identity_ids" field.
+func (u *PersonAuditLogUpsert) SetIdentityIds(v []uuid.UUID) *PersonAuditLogUpsert {
+	u.Set(personauditlog.FieldIdentityIds, v)
+	return u
+}
+
+// UpdateIdentityIds sets the "identity_ids" field to the value that was provided on create.
+func (u *PersonAuditLogUpsert) UpdateIdentityIds() *PersonAuditLogUpsert {
+	u.SetExcluded(personauditlog.FieldIdentityIds)
+	return u
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (u *PersonAuditLogUpsert) SetChatIds(v []string) *PersonAuditLogUpsert {
+	u.Set(personauditlog.FieldChatIds, v)
+	return u
+}
+
+// UpdateChatIds sets the "chat_ids" field to the value that was provided on create.
+func (u *PersonAuditLogUpsert) UpdateChatIds() *PersonAuditLogUpsert {
+	u.SetExcluded(personauditlog.FieldChatIds)
+	return u
+}
+
+// SetActor sets the "actor" field.
+func (u *PersonAuditLogUpsert) SetActor(v string) *PersonAuditLogUpsert {
+	u.Set(personauditlog.FieldActor, v)
+	return u
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *PersonAuditLogUpsert) UpdateActor() *PersonAuditLogUpsert {
+	u.SetExcluded(personauditlog.FieldActor)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *PersonAuditLogUpsert) SetReason(v string) *PersonAuditLogUpsert {
+	u.Set(personauditlog.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *PersonAuditLogUpsert) UpdateReason() *PersonAuditLogUpsert {
+	u.SetExcluded(personauditlog.FieldReason)
+	return u
+}
+
+// SetCounts sets the "counts" field.
+func (u *PersonAuditLogUpsert) SetCounts(v map[string]int) *PersonAuditLogUpsert {
+	u.Set(personauditlog.FieldCounts, v)
+	return u
+}
+
+// UpdateCounts sets the "counts" field to the value that was provided on create.
+func (u *PersonAuditLogUpsert) UpdateCounts() *PersonAuditLogUpsert {
+	u.SetExcluded(personauditlog.FieldCounts)
+	return u
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (u *PersonAuditLogUpsert) ClearCounts() *PersonAuditLogUpsert {
+	u.SetNull(personauditlog.FieldCounts)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *PersonAuditLogUpsert) SetScore(v float64) *PersonAuditLogUpsert {
+	u.Set(personauditlog.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *PersonAuditLogUpsert) UpdateScore() *PersonAuditLogUpsert {
+	u.SetExcluded(personauditlog.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *PersonAuditLogUpsert) AddScore(v float64) *PersonAuditLogUpsert {
+	u.Add(personauditlog.FieldScore, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PersonAuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(personauditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PersonAuditLogUpsertOne) UpdateNewValues() *PersonAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(personauditlog.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(personauditlog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PersonAuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PersonAuditLogUpsertOne) Ignore() *PersonAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PersonAuditLogUpsertOne) DoNothing() *PersonAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PersonAuditLogCreate.OnConflict
+// documentation for more info.
+func (u *PersonAuditLogUpsertOne) Update(set func(*PersonAuditLogUpsert)) *PersonAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PersonAuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *PersonAuditLogUpsertOne) SetWorkspaceID(v uuid.UUID) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertOne) UpdateWorkspaceID() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *PersonAuditLogUpsertOne) SetAction(v personauditlog.Action) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertOne) UpdateAction() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetPersonID sets the "person_id" field.
+func (u *PersonAuditLogUpsertOne) SetPersonID(v uuid.UUID) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetPersonID(v)
+	})
+}
+
+// UpdatePersonID sets the "person_id" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertOne) UpdatePersonID() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdatePersonID()
+	})
+}
+
+// SetSourcePersonIds sets the "source_person_ids" field.
+func (u *PersonAuditLogUpsertOne) SetSourcePersonIds(v []uuid.UUID) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetSourcePersonIds(v)
+	})
+}
+
+// UpdateSourcePersonIds sets the "source_person_ids" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertOne) UpdateSourcePersonIds() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateSourcePersonIds()
+	})
+}
+
+// SetIdentityIds sets the "identity_ids" field.
+func (u *PersonAuditLogUpsertOne) SetIdentityIds(v []uuid.UUID) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetIdentityIds(v)
+	})
+}
+
+// UpdateIdentityIds sets the "identity_ids" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertOne) UpdateIdentityIds() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateIdentityIds()
+	})
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (u *PersonAuditLogUpsertOne) SetChatIds(v []string) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetChatIds(v)
+	})
+}
+
+// UpdateChatIds sets the "chat_ids" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertOne) UpdateChatIds() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateChatIds()
+	})
+}
+
+// SetActor sets the "actor" field.
+func (u *PersonAuditLogUpsertOne) SetActor(v string) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetActor(v)
+	})
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertOne) UpdateActor() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateActor()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *PersonAuditLogUpsertOne) SetReason(v string) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertOne) UpdateReason() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetCounts sets the "counts" field.
+func (u *PersonAuditLogUpsertOne) SetCounts(v map[string]int) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetCounts(v)
+	})
+}
+
+// UpdateCounts sets the "counts" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertOne) UpdateCounts() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateCounts()
+	})
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (u *PersonAuditLogUpsertOne) ClearCounts() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.ClearCounts()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *PersonAuditLogUpsertOne) SetScore(v float64) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *PersonAuditLogUpsertOne) AddScore(v float64) *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertOne) UpdateScore() *PersonAuditLogUpsertOne {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// Exec executes the query.
+func (u *PersonAuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PersonAuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PersonAuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PersonAuditLogUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PersonAuditLogUpsertOne.ID is not supported by MySQL driver. Use PersonAuditLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PersonAuditLogUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PersonAuditLogCreateBulk is the builder for creating many PersonAuditLog entities in bulk.
+type PersonAuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*PersonAuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PersonAuditLog entities in the database.
+func (_c *PersonAuditLogCreateBulk) Save(ctx context.Context) ([]*PersonAuditLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PersonAuditLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PersonAuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PersonAuditLogCreateBulk) SaveX(ctx context.Context) []*PersonAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PersonAuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PersonAuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PersonAuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PersonAuditLogUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PersonAuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *PersonAuditLogUpsertBulk {
+	_c.conflict = opts
+	return &PersonAuditLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PersonAuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PersonAuditLogCreateBulk) OnConflictColumns(columns ...string) *PersonAuditLogUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PersonAuditLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// PersonAuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of PersonAuditLog nodes.
+type PersonAuditLogUpsertBulk struct {
+	create *PersonAuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PersonAuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(personauditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PersonAuditLogUpsertBulk) UpdateNewValues() *PersonAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(personauditlog.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(personauditlog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PersonAuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PersonAuditLogUpsertBulk) Ignore() *PersonAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PersonAuditLogUpsertBulk) DoNothing() *PersonAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PersonAuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *PersonAuditLogUpsertBulk) Update(set func(*PersonAuditLogUpsert)) *PersonAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PersonAuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *PersonAuditLogUpsertBulk) SetWorkspaceID(v uuid.UUID) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertBulk) UpdateWorkspaceID() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *PersonAuditLogUpsertBulk) SetAction(v personauditlog.Action) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertBulk) UpdateAction() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetPersonID sets the "person_id" field.
+func (u *PersonAuditLogUpsertBulk) SetPersonID(v uuid.UUID) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetPersonID(v)
+	})
+}
+
+// UpdatePersonID sets the "person_id" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertBulk) UpdatePersonID() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdatePersonID()
+	})
+}
+
+// SetSourcePersonIds sets the "source_person_ids" field.
+func (u *PersonAuditLogUpsertBulk) SetSourcePersonIds(v []uuid.UUID) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetSourcePersonIds(v)
+	})
+}
+
+// UpdateSourcePersonIds sets the "source_person_ids" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertBulk) UpdateSourcePersonIds() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateSourcePersonIds()
+	})
+}
+
+// SetIdentityIds sets the "identity_ids" field.
+func (u *PersonAuditLogUpsertBulk) SetIdentityIds(v []uuid.UUID) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetIdentityIds(v)
+	})
+}
+
+// UpdateIdentityIds sets the "identity_ids" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertBulk) UpdateIdentityIds() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateIdentityIds()
+	})
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (u *PersonAuditLogUpsertBulk) SetChatIds(v []string) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetChatIds(v)
+	})
+}
+
+// UpdateChatIds sets the "chat_ids" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertBulk) UpdateChatIds() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateChatIds()
+	})
+}
+
+// SetActor sets the "actor" field.
+func (u *PersonAuditLogUpsertBulk) SetActor(v string) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetActor(v)
+	})
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertBulk) UpdateActor() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateActor()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *PersonAuditLogUpsertBulk) SetReason(v string) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertBulk) UpdateReason() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetCounts sets the "counts" field.
+func (u *PersonAuditLogUpsertBulk) SetCounts(v map[string]int) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetCounts(v)
+	})
+}
+
+// UpdateCounts sets the "counts" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertBulk) UpdateCounts() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateCounts()
+	})
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (u *PersonAuditLogUpsertBulk) ClearCounts() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.ClearCounts()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *PersonAuditLogUpsertBulk) SetScore(v float64) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *PersonAuditLogUpsertBulk) AddScore(v float64) *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *PersonAuditLogUpsertBulk) UpdateScore() *PersonAuditLogUpsertBulk {
+	return u.Update(func(s *PersonAuditLogUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// Exec executes the query.
+func (u *PersonAuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PersonAuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PersonAuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PersonAuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/personauditlog"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// PersonAuditLogUpdate is the builder for updating PersonAuditLog entities.
+type PersonAuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PersonAuditLogMutation
+}
+
+// Where appends a list predicates to the PersonAuditLogUpdate builder.
+func (_u *PersonAuditLogUpdate) Where(ps ...predicate.PersonAuditLog) *PersonAuditLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *PersonAuditLogUpdate) SetWorkspaceID(v uuid.UUID) *PersonAuditLogUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *PersonAuditLogUpdate) SetNillableWorkspaceID(v *uuid.UUID) *PersonAuditLogUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *PersonAuditLogUpdate) SetAction(v personauditlog.Action) *PersonAuditLogUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *PersonAuditLogUpdate) SetNillableAction(v *personauditlog.Action) *PersonAuditLogUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetPersonID sets the "person_id" field.
+func (_u *PersonAuditLogUpdate) SetPersonID(v uuid.UUID) *PersonAuditLogUpdate {
+	_u.mutation.SetPersonID(v)
+	return _u
+}
+
+// SetNillablePersonID sets the "person_id" field if the given value is not nil.
+func (_u *PersonAuditLogUpdate) SetNillablePersonID(v *uuid.UUID) *PersonAuditLogUpdate {
+	if v != nil {
+		_u.SetPersonID(*v)
+	}
+	return _u
+}
+
+// SetSourcePersonIds sets the "source_person_ids" field.
+func (_u *PersonAuditLogUpdate) SetSourcePersonIds(v []uuid.UUID) *PersonAuditLogUpdate {
+	_u.mutation.SetSourcePersonIds(v)
+	return _u
+}
+
+// AppendSourcePersonIds appends value to the "source_person_ids" field.
+func (_u *PersonAuditLogUpdate) AppendSourcePersonIds(v []uuid.UUID) *PersonAuditLogUpdate {
+	_u.mutation.AppendSourcePersonIds(v)
+	return _u
+}
+
+// SetIdentityIds sets the "identity_ids" field.
+func (_u *PersonAuditLogUpdate) SetIdentityIds(v []uuid.UUID) *PersonAuditLogUpdate {
+	_u.mutation.SetIdentityIds(v)
+	return _u
+}
+
+// AppendIdentityIds appends value to the "identity_ids" field.
+func (_u *PersonAuditLogUpdate) AppendIdentityIds(v []uuid.UUID) *PersonAuditLogUpdate {
+	_u.mutation.AppendIdentityIds(v)
+	return _u
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (_u *PersonAuditLogUpdate) SetChatIds(v []string) *PersonAuditLogUpdate {
+	_u.mutation.SetChatIds(v)
+	return _u
+}
+
+// AppendChatIds appends value to the "chat_ids" field.
+func (_u *PersonAuditLogUpdate) AppendChatIds(v []string) *PersonAuditLogUpdate {
+	_u.mutation.AppendChatIds(v)
+	return _u
+}
+
+// SetActor sets the "actor" field.
+func (_u *PersonAuditLogUpdate) SetActor(v string) *PersonAuditLogUpdate {
+	_u.mutation.SetActor(v)
+	return _u
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_u *PersonAuditLogUpdate) SetNillableActor(v *string) *PersonAuditLogUpdate {
+	if v != nil {
+		_u.SetActor(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *PersonAuditLogUpdate) SetReason(v string) *PersonAuditLogUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *PersonAuditLogUpdate) SetNillableReason(v *string) *PersonAuditLogUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetCounts sets the "counts" field.
+func (_u *PersonAuditLogUpdate) SetCounts(v map[string]int) *PersonAuditLogUpdate {
+	_u.mutation.SetCounts(v)
+	return _u
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (_u *PersonAuditLogUpdate) ClearCounts() *PersonAuditLogUpdate {
+	_u.mutation.ClearCounts()
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *PersonAuditLogUpdate) SetScore(v float64) *PersonAuditLogUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *PersonAuditLogUpdate) SetNillableScore(v *float64) *PersonAuditLogUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *PersonAuditLogUpdate) AddScore(v float64) *PersonAuditLogUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// Mutation returns the PersonAuditLogMutation object of the builder.
+func (_u *PersonAuditLogUpdate) Mutation() *PersonAuditLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PersonAuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PersonAuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PersonAuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PersonAuditLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PersonAuditLogUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := personauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PersonAuditLog.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PersonAuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(personauditlog.Table, personauditlog.Columns, sqlgraph.NewFieldSpec(personauditlog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(personauditlog.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(personauditlog.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PersonID(); ok {
+		_spec.SetField(personauditlog.FieldPersonID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SourcePersonIds(); ok {
+		_spec.SetField(personauditlog.FieldSourcePersonIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSourcePersonIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personauditlog.FieldSourcePersonIds, value)
+		})
+	}
+	if value, ok := _u.mutation.IdentityIds(); ok {
+		_spec.SetField(personauditlog.FieldIdentityIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIdentityIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personauditlog.FieldIdentityIds, value)
+		})
+	}
+	if value, ok := _u.mutation.ChatIds(); ok {
+		_spec.SetField(personauditlog.FieldChatIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChatIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personauditlog.FieldChatIds, value)
+		})
+	}
+	if value, ok := _u.mutation.Actor(); ok {
+		_spec.SetField(personauditlog.FieldActor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(personauditlog.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Counts(); ok {
+		_spec.SetField(personauditlog.FieldCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.CountsCleared() {
+		_spec.ClearField(personauditlog.FieldCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(personauditlog.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(personauditlog.FieldScore, field.TypeFloat64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.PersonAuditLog
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PersonAuditLogUpdateOne is the builder for updating a single PersonAuditLog entity.
+type PersonAuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PersonAuditLogMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *PersonAuditLogUpdateOne) SetWorkspaceID(v uuid.UUID) *PersonAuditLogUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *PersonAuditLogUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *PersonAuditLogUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *PersonAuditLogUpdateOne) SetAction(v personauditlog.Action) *PersonAuditLogUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *PersonAuditLogUpdateOne) SetNillableAction(v *personauditlog.Action) *PersonAuditLogUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetPersonID sets the "person_id" field.
+func (_u *PersonAuditLogUpdateOne) SetPersonID(v uuid.UUID) *PersonAuditLogUpdateOne {
+	_u.mutation.SetPersonID(v)
+	return _u
+}
+
+// SetNillablePersonID sets the "person_id" field if the given value is not nil.
+func (_u *PersonAuditLogUpdateOne) SetNillablePersonID(v *uuid.UUID) *PersonAuditLogUpdateOne {
+	if v != nil {
+		_u.SetPersonID(*v)
+	}
+	return _u
+}
+
+// SetSourcePersonIds sets the "source_person_ids" field.
+func (_u *PersonAuditLogUpdateOne) SetSourcePersonIds(v []uuid.UUID) *PersonAuditLogUpdateOne {
+	_u.mutation.SetSourcePersonIds(v)
+	return _u
+}
+
+// AppendSourcePersonIds appends value to the "source_person_ids" field.
+func (_u *PersonAuditLogUpdateOne) AppendSourcePersonIds(v []uuid.UUID) *PersonAuditLogUpdateOne {
+	_u.mutation.AppendSourcePersonIds(v)
+	return _u
+}
+
+// SetIdentityIds sets the "identity_ids" field.
+func (_u *PersonAuditLogUpdateOne) SetIdentityIds(v []uuid.UUID) *PersonAuditLogUpdateOne {
+	_u.mutation.SetIdentityIds(v)
+	return _u
+}
+
+// AppendIdentityIds appends value to the "identity_ids" field.
+func (_u *PersonAuditLogUpdateOne) AppendIdentityIds(v []uuid.UUID) *PersonAuditLogUpdateOne {
+	_u.mutation.AppendIdentityIds(v)
+	return _u
+}
+
+// SetChatIds sets the "chat_ids" field.
+func (_u *PersonAuditLogUpdateOne) SetChatIds(v []string) *PersonAuditLogUpdateOne {
+	_u.mutation.SetChatIds(v)
+	return _u
+}
+
+// AppendChatIds appends value to the "chat_ids" field.
+func (_u *PersonAuditLogUpdateOne) AppendChatIds(v []string) *PersonAuditLogUpdateOne {
+	_u.mutation.AppendChatIds(v)
+	return _u
+}
+
+// SetActor sets the "actor" field.
+func (_u *PersonAuditLogUpdateOne) SetActor(v string) *PersonAuditLogUpdateOne {
+	_u.mutation.SetActor(v)
+	return _u
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_u *PersonAuditLogUpdateOne) SetNillableActor(v *string) *PersonAuditLogUpdateOne {
+	if v != nil {
+		_u.SetActor(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *PersonAuditLogUpdateOne) SetReason(v string) *PersonAuditLogUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *PersonAuditLogUpdateOne) SetNillableReason(v *string) *PersonAuditLogUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetCounts sets the "counts" field.
+func (_u *PersonAuditLogUpdateOne) SetCounts(v map[string]int) *PersonAuditLogUpdateOne {
+	_u.mutation.SetCounts(v)
+	return _u
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (_u *PersonAuditLogUpdateOne) ClearCounts() *PersonAuditLogUpdateOne {
+	_u.mutation.ClearCounts()
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *PersonAuditLogUpdateOne) SetScore(v float64) *PersonAuditLogUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *PersonAuditLogUpdateOne) SetNillableScore(v *float64) *PersonAuditLogUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *PersonAuditLogUpdateOne) AddScore(v float64) *PersonAuditLogUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// Mutation returns the PersonAuditLogMutation object of the builder.
+func (_u *PersonAuditLogUpdateOne) Mutation() *PersonAuditLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PersonAuditLogUpdate builder.
+func (_u *PersonAuditLogUpdateOne) Where(ps ...predicate.PersonAuditLog) *PersonAuditLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PersonAuditLogUpdateOne) Select(field string, fields ...string) *PersonAuditLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PersonAuditLog entity.
+func (_u *PersonAuditLogUpdateOne) Save(ctx context.Context) (*PersonAuditLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PersonAuditLogUpdateOne) SaveX(ctx context.Context) *PersonAuditLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PersonAuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PersonAuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PersonAuditLogUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := personauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PersonAuditLog.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PersonAuditLogUpdateOne) sqlSave(ctx context.Context) (_node *PersonAuditLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(personauditlog.Table, personauditlog.Columns, sqlgraph.NewFieldSpec(personauditlog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PersonAuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, personauditlog.FieldID)
+		for _, f := range fields {
+			if !personauditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != personauditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(personauditlog.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(personauditlog.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PersonID(); ok {
+		_spec.SetField(personauditlog.FieldPersonID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SourcePersonIds(); ok {
+		_spec.SetField(personauditlog.FieldSourcePersonIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSourcePersonIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personauditlog.FieldSourcePersonIds, value)
+		})
+	}
+	if value, ok := _u.mutation.IdentityIds(); ok {
+		_spec.SetField(personauditlog.FieldIdentityIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIdentityIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personauditlog.FieldIdentityIds, value)
+		})
+	}
+	if value, ok := _u.mutation.ChatIds(); ok {
+		_spec.SetField(personauditlog.FieldChatIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChatIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personauditlog.FieldChatIds, value)
+		})
+	}
+	if value, ok := _u.mutation.Actor(); ok {
+		_spec.SetField(personauditlog.FieldActor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(personauditlog.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Counts(); ok {
+		_spec.SetField(personauditlog.FieldCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.CountsCleared() {
+		_spec.ClearField(personauditlog.FieldCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(personauditlog.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(personauditlog.FieldScore, field.TypeFloat64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.PersonAuditLog
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &PersonAuditLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
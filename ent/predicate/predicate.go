@@ -6,14 +6,62 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// AccessLog is the predicate function for accesslog builders.
+type AccessLog func(*sql.Selector)
+
+// AskTurn is the predicate function for askturn builders.
+type AskTurn func(*sql.Selector)
+
+// ChatGrant is the predicate function for chatgrant builders.
+type ChatGrant func(*sql.Selector)
+
 // ChatMessage is the predicate function for chatmessage builders.
 type ChatMessage func(*sql.Selector)
 
+// ChatRetention is the predicate function for chatretention builders.
+type ChatRetention func(*sql.Selector)
+
+// ChatSchedule is the predicate function for chatschedule builders.
+type ChatSchedule func(*sql.Selector)
+
+// DigestDelivery is the predicate function for digestdelivery builders.
+type DigestDelivery func(*sql.Selector)
+
+// DistillRun is the predicate function for distillrun builders.
+type DistillRun func(*sql.Selector)
+
 // Event is the predicate function for event builders.
 type Event func(*sql.Selector)
 
 // Identity is the predicate function for identity builders.
 type Identity func(*sql.Selector)
 
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
 // JoinedChat is the predicate function for joinedchat builders.
 type JoinedChat func(*sql.Selector)
+
+// LLMCacheEntry is the predicate function for llmcacheentry builders.
+type LLMCacheEntry func(*sql.Selector)
+
+// OwnerAccountLink is the predicate function for owneraccountlink builders.
+type OwnerAccountLink func(*sql.Selector)
+
+// Person is the predicate function for person builders.
+type Person func(*sql.Selector)
+
+// PersonAuditLog is the predicate function for personauditlog builders.
+type PersonAuditLog func(*sql.Selector)
+
+// Profile is the predicate function for profile builders.
+type Profile func(*sql.Selector)
+
+// Summary is the predicate function for summary builders.
+type Summary func(*sql.Selector)
+
+// Workspace is the predicate function for workspace builders.
+type Workspace func(*sql.Selector)
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/schema"
+)
+
+// Profile is the model entity for the Profile schema.
+type Profile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+	// IdentityID holds the value of the "identity_id" field.
+	IdentityID uuid.UUID `json:"identity_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Claims holds the value of the "claims" field.
+	Claims []schema.ProfileClaim `json:"claims,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// EventCount holds the value of the "event_count" field.
+	EventCount int `json:"event_count,omitempty"`
+	// EventsUpdatedUntil holds the value of the "events_updated_until" field.
+	EventsUpdatedUntil int64 `json:"events_updated_until,omitempty"`
+	// EventsUpdatedUntilID holds the value of the "events_updated_until_id" field.
+	EventsUpdatedUntilID uuid.UUID `json:"events_updated_until_id,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProfileQuery when eager-loading is set.
+	Edges        ProfileEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProfileEdges holds the relations/edges for other nodes in the graph.
+type ProfileEdges struct {
+	// Identity holds the value of the identity edge.
+	Identity *Identity `json:"identity,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// IdentityOrErr returns the Identity value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProfileEdges) IdentityOrErr() (*Identity, error) {
+	if e.Identity != nil {
+		return e.Identity, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: identity.Label}
+	}
+	return nil, &NotLoadedError{edge: "identity"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Profile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case profile.FieldClaims:
+			values[i] = new([]byte)
+		case profile.FieldVersion, profile.FieldEventCount, profile.FieldEventsUpdatedUntil, profile.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case profile.FieldContent, profile.FieldModel:
+			values[i] = new(sql.NullString)
+		case profile.FieldID, profile.FieldWorkspaceID, profile.FieldIdentityID, profile.FieldEventsUpdatedUntilID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Profile fields.
+func (_m *Profile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case profile.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case profile.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
+		case profile.FieldIdentityID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field identity_id", values[i])
+			} else if value != nil {
+				_m.IdentityID = *value
+			}
+		case profile.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case profile.FieldClaims:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claims", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Claims); err != nil {
+					return fmt.Errorf("unmarshal field claims: %w", err)
+				}
+			}
+		case profile.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case profile.FieldEventCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_count", values[i])
+			} else if value.Valid {
+				_m.EventCount = int(value.Int64)
+			}
+		case profile.FieldEventsUpdatedUntil:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field events_updated_until", values[i])
+			} else if value.Valid {
+				_m.EventsUpdatedUntil = value.Int64
+			}
+		case profile.FieldEventsUpdatedUntilID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field events_updated_until_id", values[i])
+			} else if value != nil {
+				_m.EventsUpdatedUntilID = *value
+			}
+		case profile.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case profile.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Profile.
+// This includes values selected through modifiers, order, etc.
+func (_m *Profile) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryIdentity queries the "identity" edge of the Profile entity.
+func (_m *Profile) QueryIdentity() *IdentityQuery {
+	return NewProfileClient(_m.config).QueryIdentity(_m)
+}
+
+// Update returns a builder for updating this Profile.
+// Note that you need to call Profile.Unwrap() before calling this method if this Profile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Profile) Update() *ProfileUpdateOne {
+	return NewProfileClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Profile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Profile) Unwrap() *Profile {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Profile is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Profile) String() string {
+	var builder strings.Builder
+	builder.WriteString("Profile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("identity_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IdentityID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("claims=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Claims))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("event_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventCount))
+	builder.WriteString(", ")
+	builder.WriteString("events_updated_until=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventsUpdatedUntil))
+	builder.WriteString(", ")
+	builder.WriteString("events_updated_until_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventsUpdatedUntilID))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Profiles is a parsable slice of Profile.
+type Profiles []*Profile
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profile
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/schema"
+)
+
+const (
+	// Label holds the string label denoting the profile type in the database.
+	Label = "profile"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldIdentityID holds the string denoting the identity_id field in the database.
+	FieldIdentityID = "identity_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldClaims holds the string denoting the claims field in the database.
+	FieldClaims = "claims"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldEventCount holds the string denoting the event_count field in the database.
+	FieldEventCount = "event_count"
+	// FieldEventsUpdatedUntil holds the string denoting the events_updated_until field in the database.
+	FieldEventsUpdatedUntil = "events_updated_until"
+	// FieldEventsUpdatedUntilID holds the string denoting the events_updated_until_id field in the database.
+	FieldEventsUpdatedUntilID = "events_updated_until_id"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeIdentity holds the string denoting the identity edge name in mutations.
+	EdgeIdentity = "identity"
+	// Table holds the table name of the profile in the database.
+	Table = "profiles"
+	// IdentityTable is the table that holds the identity relation/edge.
+	IdentityTable = "profiles"
+	// IdentityInverseTable is the table name for the Identity entity.
+	// It exists in this package in order to avoid circular dependency with the "identity" package.
+	IdentityInverseTable = "identities"
+	// IdentityColumn is the table column denoting the identity relation/edge.
+	IdentityColumn = "identity_id"
+)
+
+// Columns holds all SQL columns for profile fields.
+var Columns = []string{
+	FieldID,
+	FieldWorkspaceID,
+	FieldIdentityID,
+	FieldVersion,
+	FieldClaims,
+	FieldContent,
+	FieldEventCount,
+	FieldEventsUpdatedUntil,
+	FieldEventsUpdatedUntilID,
+	FieldModel,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultWorkspaceID holds the default value on creation for the "workspace_id" field.
+	DefaultWorkspaceID func() uuid.UUID
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
+	// DefaultClaims holds the default value on creation for the "claims" field.
+	DefaultClaims []schema.ProfileClaim
+	// DefaultContent holds the default value on creation for the "content" field.
+	DefaultContent string
+	// DefaultEventCount holds the default value on creation for the "event_count" field.
+	DefaultEventCount int
+	// DefaultEventsUpdatedUntil holds the default value on creation for the "events_updated_until" field.
+	DefaultEventsUpdatedUntil int64
+	// DefaultEventsUpdatedUntilID holds the default value on creation for the "events_updated_until_id" field.
+	DefaultEventsUpdatedUntilID func() uuid.UUID
+	// DefaultModel holds the default value on creation for the "model" field.
+	DefaultModel string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Profile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByIdentityID orders the results by the identity_id field.
+func ByIdentityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdentityID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByEventCount orders the results by the event_count field.
+func ByEventCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventCount, opts...).ToFunc()
+}
+
+// ByEventsUpdatedUntil orders the results by the events_updated_until field.
+func ByEventsUpdatedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventsUpdatedUntil, opts...).ToFunc()
+}
+
+// ByEventsUpdatedUntilID orders the results by the events_updated_until_id field.
+func ByEventsUpdatedUntilID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventsUpdatedUntilID, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByIdentityField orders the results by identity field.
+func ByIdentityField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentityStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newIdentityStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentityInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, IdentityTable, IdentityColumn),
+	)
+}
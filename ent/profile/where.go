@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profile
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// IdentityID applies equality check predicate on the "identity_id" field. It's identical to IdentityIDEQ.
+func IdentityID(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldIdentityID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldVersion, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldContent, v))
+}
+
+// EventCount applies equality check predicate on the "event_count" field. It's identical to EventCountEQ.
+func EventCount(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldEventCount, v))
+}
+
+// EventsUpdatedUntil applies equality check predicate on the "events_updated_until" field. It's identical to EventsUpdatedUntilEQ.
+func EventsUpdatedUntil(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldEventsUpdatedUntil, v))
+}
+
+// EventsUpdatedUntilID applies equality check predicate on the "events_updated_until_id" field. It's identical to EventsUpdatedUntilIDEQ.
+func EventsUpdatedUntilID(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldEventsUpdatedUntilID, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldModel, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
+// IdentityIDEQ applies the EQ predicate on the "identity_id" field.
+func IdentityIDEQ(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldIdentityID, v))
+}
+
+// IdentityIDNEQ applies the NEQ predicate on the "identity_id" field.
+func IdentityIDNEQ(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldIdentityID, v))
+}
+
+// IdentityIDIn applies the In predicate on the "identity_id" field.
+func IdentityIDIn(vs ...uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldIdentityID, vs...))
+}
+
+// IdentityIDNotIn applies the NotIn predicate on the "identity_id" field.
+func IdentityIDNotIn(vs ...uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldIdentityID, vs...))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldVersion, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContainsFold(FieldContent, v))
+}
+
+// EventCountEQ applies the EQ predicate on the "event_count" field.
+func EventCountEQ(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldEventCount, v))
+}
+
+// EventCountNEQ applies the NEQ predicate on the "event_count" field.
+func EventCountNEQ(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldEventCount, v))
+}
+
+// EventCountIn applies the In predicate on the "event_count" field.
+func EventCountIn(vs ...int) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldEventCount, vs...))
+}
+
+// EventCountNotIn applies the NotIn predicate on the "event_count" field.
+func EventCountNotIn(vs ...int) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldEventCount, vs...))
+}
+
+// EventCountGT applies the GT predicate on the "event_count" field.
+func EventCountGT(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldEventCount, v))
+}
+
+// EventCountGTE applies the GTE predicate on the "event_count" field.
+func EventCountGTE(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldEventCount, v))
+}
+
+// EventCountLT applies the LT predicate on the "event_count" field.
+func EventCountLT(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldEventCount, v))
+}
+
+// EventCountLTE applies the LTE predicate on the "event_count" field.
+func EventCountLTE(v int) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldEventCount, v))
+}
+
+// EventsUpdatedUntilEQ applies the EQ predicate on the "events_updated_until" field.
+func EventsUpdatedUntilEQ(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldEventsUpdatedUntil, v))
+}
+
+// EventsUpdatedUntilNEQ applies the NEQ predicate on the "events_updated_until" field.
+func EventsUpdatedUntilNEQ(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldEventsUpdatedUntil, v))
+}
+
+// EventsUpdatedUntilIn applies the In predicate on the "events_updated_until" field.
+func EventsUpdatedUntilIn(vs ...int64) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldEventsUpdatedUntil, vs...))
+}
+
+// EventsUpdatedUntilNotIn applies the NotIn predicate on the "events_updated_until" field.
+func EventsUpdatedUntilNotIn(vs ...int64) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldEventsUpdatedUntil, vs...))
+}
+
+// EventsUpdatedUntilGT applies the GT predicate on the "events_updated_until" field.
+func EventsUpdatedUntilGT(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldEventsUpdatedUntil, v))
+}
+
+// EventsUpdatedUntilGTE applies the GTE predicate on the "events_updated_until" field.
+func EventsUpdatedUntilGTE(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldEventsUpdatedUntil, v))
+}
+
+// EventsUpdatedUntilLT applies the LT predicate on the "events_updated_until" field.
+func EventsUpdatedUntilLT(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldEventsUpdatedUntil, v))
+}
+
+// EventsUpdatedUntilLTE applies the LTE predicate on the "events_updated_until" field.
+func EventsUpdatedUntilLTE(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldEventsUpdatedUntil, v))
+}
+
+// EventsUpdatedUntilIDEQ applies the EQ predicate on the "events_updated_until_id" field.
+func EventsUpdatedUntilIDEQ(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldEventsUpdatedUntilID, v))
+}
+
+// EventsUpdatedUntilIDNEQ applies the NEQ predicate on the "events_updated_until_id" field.
+func EventsUpdatedUntilIDNEQ(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldEventsUpdatedUntilID, v))
+}
+
+// EventsUpdatedUntilIDIn applies the In predicate on the "events_updated_until_id" field.
+func EventsUpdatedUntilIDIn(vs ...uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldEventsUpdatedUntilID, vs...))
+}
+
+// EventsUpdatedUntilIDNotIn applies the NotIn predicate on the "events_updated_until_id" field.
+func EventsUpdatedUntilIDNotIn(vs ...uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldEventsUpdatedUntilID, vs...))
+}
+
+// EventsUpdatedUntilIDGT applies the GT predicate on the "events_updated_until_id" field.
+func EventsUpdatedUntilIDGT(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldEventsUpdatedUntilID, v))
+}
+
+// EventsUpdatedUntilIDGTE applies the GTE predicate on the "events_updated_until_id" field.
+func EventsUpdatedUntilIDGTE(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldEventsUpdatedUntilID, v))
+}
+
+// EventsUpdatedUntilIDLT applies the LT predicate on the "events_updated_until_id" field.
+func EventsUpdatedUntilIDLT(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldEventsUpdatedUntilID, v))
+}
+
+// EventsUpdatedUntilIDLTE applies the LTE predicate on the "events_updated_until_id" field.
+func EventsUpdatedUntilIDLTE(v uuid.UUID) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldEventsUpdatedUntilID, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.Profile {
+	return predicate.Profile(sql.FieldContainsFold(FieldModel, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Profile {
+	return predicate.Profile(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Profile {
+	return predicate.Profile(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Profile {
+	return predicate.Profile(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasIdentity applies the HasEdge predicate on the "identity" edge.
+func HasIdentity() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, IdentityTable, IdentityColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Identity
+		step.Edge.Schema = schemaConfig.Profile
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdentityWith applies the HasEdge predicate on the "identity" edge with a given conditions (other predicates).
+func HasIdentityWith(preds ...predicate.Identity) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newIdentityStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Identity
+		step.Edge.Schema = schemaConfig.Profile
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Profile) predicate.Profile {
+	return predicate.Profile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Profile) predicate.Profile {
+	return predicate.Profile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Profile) predicate.Profile {
+	return predicate.Profile(sql.NotPredicates(p))
+}
@@ -0,0 +1,1041 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/schema"
+)
+
+// ProfileCreate is the builder for creating a Profile entity.
+type ProfileCreate struct {
+	config
+	mutation *ProfileMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *ProfileCreate) SetWorkspaceID(v uuid.UUID) *ProfileCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableWorkspaceID(v *uuid.UUID) *ProfileCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetIdentityID sets the "identity_id" field.
+func (_c *ProfileCreate) SetIdentityID(v uuid.UUID) *ProfileCreate {
+	_c.mutation.SetIdentityID(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *ProfileCreate) SetVersion(v int) *ProfileCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetClaims sets the "claims" field.
+func (_c *ProfileCreate) SetClaims(v []schema.ProfileClaim) *ProfileCreate {
+	_c.mutation.SetClaims(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *ProfileCreate) SetContent(v string) *ProfileCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableContent(v *string) *ProfileCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetEventCount sets the "event_count" field.
+func (_c *ProfileCreate) SetEventCount(v int) *ProfileCreate {
+	_c.mutation.SetEventCount(v)
+	return _c
+}
+
+// SetNillableEventCount sets the "event_count" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableEventCount(v *int) *ProfileCreate {
+	if v != nil {
+		_c.SetEventCount(*v)
+	}
+	return _c
+}
+
+// SetEventsUpdatedUntil sets the "events_updated_until" field.
+func (_c *ProfileCreate) SetEventsUpdatedUntil(v int64) *ProfileCreate {
+	_c.mutation.SetEventsUpdatedUntil(v)
+	return _c
+}
+
+// SetNillableEventsUpdatedUntil sets the "events_updated_until" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableEventsUpdatedUntil(v *int64) *ProfileCreate {
+	if v != nil {
+		_c.SetEventsUpdatedUntil(*v)
+	}
+	return _c
+}
+
+// SetEventsUpdatedUntilID sets the "events_updated_until_id" field.
+func (_c *ProfileCreate) SetEventsUpdatedUntilID(v uuid.UUID) *ProfileCreate {
+	_c.mutation.SetEventsUpdatedUntilID(v)
+	return _c
+}
+
+// SetNillableEventsUpdatedUntilID sets the "events_updated_until_id" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableEventsUpdatedUntilID(v *uuid.UUID) *ProfileCreate {
+	if v != nil {
+		_c.SetEventsUpdatedUntilID(*v)
+	}
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *ProfileCreate) SetModel(v string) *ProfileCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableModel(v *string) *ProfileCreate {
+	if v != nil {
+		_c.SetModel(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProfileCreate) SetCreatedAt(v int64) *ProfileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableCreatedAt(v *int64) *ProfileCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ProfileCreate) SetID(v uuid.UUID) *ProfileCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableID(v *uuid.UUID) *ProfileCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetIdentity sets the "identity" edge to the Identity entity.
+func (_c *ProfileCreate) SetIdentity(v *Identity) *ProfileCreate {
+	return _c.SetIdentityID(v.ID)
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (_c *ProfileCreate) Mutation() *ProfileMutation {
+	return _c.mutation
+}
+
+// Save creates the Profile in the database.
+func (_c *ProfileCreate) Save(ctx context.Context) (*Profile, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProfileCreate) SaveX(ctx context.Context) *Profile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProfileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProfileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProfileCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := profile.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.Claims(); !ok {
+		v := profile.DefaultClaims
+		_c.mutation.SetClaims(v)
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		v := profile.DefaultContent
+		_c.mutation.SetContent(v)
+	}
+	if _, ok := _c.mutation.EventCount(); !ok {
+		v := profile.DefaultEventCount
+		_c.mutation.SetEventCount(v)
+	}
+	if _, ok := _c.mutation.EventsUpdatedUntil(); !ok {
+		v := profile.DefaultEventsUpdatedUntil
+		_c.mutation.SetEventsUpdatedUntil(v)
+	}
+	if _, ok := _c.mutation.EventsUpdatedUntilID(); !ok {
+		v := profile.DefaultEventsUpdatedUntilID()
+		_c.mutation.SetEventsUpdatedUntilID(v)
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		v := profile.DefaultModel
+		_c.mutation.SetModel(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := profile.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := profile.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProfileCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Profile.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.IdentityID(); !ok {
+		return &ValidationError{Name: "identity_id", err: errors.New(`ent: missing required field "Profile.identity_id"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Profile.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := profile.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Profile.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Claims(); !ok {
+		return &ValidationError{Name: "claims", err: errors.New(`ent: missing required field "Profile.claims"`)}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Profile.content"`)}
+	}
+	if _, ok := _c.mutation.EventCount(); !ok {
+		return &ValidationError{Name: "event_count", err: errors.New(`ent: missing required field "Profile.event_count"`)}
+	}
+	if _, ok := _c.mutation.EventsUpdatedUntil(); !ok {
+		return &ValidationError{Name: "events_updated_until", err: errors.New(`ent: missing required field "Profile.events_updated_until"`)}
+	}
+	if _, ok := _c.mutation.EventsUpdatedUntilID(); !ok {
+		return &ValidationError{Name: "events_updated_until_id", err: errors.New(`ent: missing required field "Profile.events_updated_until_id"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "Profile.model"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Profile.created_at"`)}
+	}
+	if len(_c.mutation.IdentityIDs()) == 0 {
+		return &ValidationError{Name: "identity", err: errors.New(`ent: missing required edge "Profile.identity"`)}
+	}
+	return nil
+}
+
+func (_c *ProfileCreate) sqlSave(ctx context.Context) (*Profile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Profile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(profile.Table, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.Profile
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(profile.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(profile.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Claims(); ok {
+		_spec.SetField(profile.FieldClaims, field.TypeJSON, value)
+		_node.Claims = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(profile.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.EventCount(); ok {
+		_spec.SetField(profile.FieldEventCount, field.TypeInt, value)
+		_node.EventCount = value
+	}
+	if value, ok := _c.mutation.EventsUpdatedUntil(); ok {
+		_spec.SetField(profile.FieldEventsUpdatedUntil, field.TypeInt64, value)
+		_node.EventsUpdatedUntil = value
+	}
+	if value, ok := _c.mutation.EventsUpdatedUntilID(); ok {
+		_spec.SetField(profile.FieldEventsUpdatedUntilID, field.TypeUUID, value)
+		_node.EventsUpdatedUntilID = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(profile.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(profile.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.IdentityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profile.IdentityTable,
+			Columns: []string{profile.IdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = _c.schemaConfig.Profile
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.IdentityID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Profile.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProfileCreate) OnConflict(opts ...sql.ConflictOption) *ProfileUpsertOne {
+	_c.conflict = opts
+	return &ProfileUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProfileCreate) OnConflictColumns(columns ...string) *ProfileUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProfileUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ProfileUpsertOne is the builder for "upsert"-ing
+	//  one Profile node.
+	ProfileUpsertOne struct {
+		create *ProfileCreate
+	}
+
+	// ProfileUpsert is the "OnConflict" setter.
+	ProfileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ProfileUpsert) SetWorkspaceID(v uuid.UUID) *ProfileUpsert {
+	u.Set(profile.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateWorkspaceID() *ProfileUpsert {
+	u.SetExcluded(profile.FieldWorkspaceID)
+	return u
+}
+
+// SetClaims sets the "claims" field.
+func (u *ProfileUpsert) SetClaims(v []schema.ProfileClaim) *ProfileUpsert {
+	u.Set(profile.FieldClaims, v)
+	return u
+}
+
+// UpdateClaims sets the "claims" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateClaims() *ProfileUpsert {
+	u.SetExcluded(profile.FieldClaims)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ProfileUpsert) SetContent(v string) *ProfileUpsert {
+	u.Set(profile.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateContent() *ProfileUpsert {
+	u.SetExcluded(profile.FieldContent)
+	return u
+}
+
+// SetEventCount sets the "event_count" field.
+func (u *ProfileUpsert) SetEventCount(v int) *ProfileUpsert {
+	u.Set(profile.FieldEventCount, v)
+	return u
+}
+
+// UpdateEventCount sets the "event_count" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateEventCount() *ProfileUpsert {
+	u.SetExcluded(profile.FieldEventCount)
+	return u
+}
+
+// AddEventCount adds v to the "event_count" field.
+func (u *ProfileUpsert) AddEventCount(v int) *ProfileUpsert {
+	u.Add(profile.FieldEventCount, v)
+	return u
+}
+
+// SetEventsUpdatedUntil sets the "events_updated_until" field.
+func (u *ProfileUpsert) SetEventsUpdatedUntil(v int64) *ProfileUpsert {
+	u.Set(profile.FieldEventsUpdatedUntil, v)
+	return u
+}
+
+// UpdateEventsUpdatedUntil sets the "events_updated_until" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateEventsUpdatedUntil() *ProfileUpsert {
+	u.SetExcluded(profile.FieldEventsUpdatedUntil)
+	return u
+}
+
+// AddEventsUpdatedUntil adds v to the "events_updated_until" field.
+func (u *ProfileUpsert) AddEventsUpdatedUntil(v int64) *ProfileUpsert {
+	u.Add(profile.FieldEventsUpdatedUntil, v)
+	return u
+}
+
+// SetEventsUpdatedUntilID sets the "events_updated_until_id" field.
+func (u *ProfileUpsert) SetEventsUpdatedUntilID(v uuid.UUID) *ProfileUpsert {
+	u.Set(profile.FieldEventsUpdatedUntilID, v)
+	return u
+}
+
+// UpdateEventsUpdatedUntilID sets the "events_updated_until_id" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateEventsUpdatedUntilID() *ProfileUpsert {
+	u.SetExcluded(profile.FieldEventsUpdatedUntilID)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *ProfileUpsert) SetModel(v string) *ProfileUpsert {
+	u.Set(profile.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateModel() *ProfileUpsert {
+	u.SetExcluded(profile.FieldModel)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileUpsertOne) UpdateNewValues() *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profile.FieldID)
+		}
+		if _, exists := u.create.mutation.IdentityID(); exists {
+			s.SetIgnore(profile.FieldIdentityID)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(profile.FieldVersion)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(profile.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfileUpsertOne) Ignore() *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileUpsertOne) DoNothing() *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileCreate.OnConflict
+// documentation for more info.
+func (u *ProfileUpsertOne) Update(set func(*ProfileUpsert)) *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ProfileUpsertOne) SetWorkspaceID(v uuid.UUID) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateWorkspaceID() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetClaims sets the "claims" field.
+func (u *ProfileUpsertOne) SetClaims(v []schema.ProfileClaim) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetClaims(v)
+	})
+}
+
+// UpdateClaims sets the "claims" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateClaims() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateClaims()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ProfileUpsertOne) SetContent(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateContent() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetEventCount sets the "event_count" field.
+func (u *ProfileUpsertOne) SetEventCount(v int) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetEventCount(v)
+	})
+}
+
+// AddEventCount adds v to the "event_count" field.
+func (u *ProfileUpsertOne) AddEventCount(v int) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.AddEventCount(v)
+	})
+}
+
+// UpdateEventCount sets the "event_count" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateEventCount() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateEventCount()
+	})
+}
+
+// SetEventsUpdatedUntil sets the "events_updated_until" field.
+func (u *ProfileUpsertOne) SetEventsUpdatedUntil(v int64) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetEventsUpdatedUntil(v)
+	})
+}
+
+// AddEventsUpdatedUntil adds v to the "events_updated_until" field.
+func (u *ProfileUpsertOne) AddEventsUpdatedUntil(v int64) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.AddEventsUpdatedUntil(v)
+	})
+}
+
+// UpdateEventsUpdatedUntil sets the "events_updated_until" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateEventsUpdatedUntil() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateEventsUpdatedUntil()
+	})
+}
+
+// SetEventsUpdatedUntilID sets the "events_updated_until_id" field.
+func (u *ProfileUpsertOne) SetEventsUpdatedUntilID(v uuid.UUID) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetEventsUpdatedUntilID(v)
+	})
+}
+
+// UpdateEventsUpdatedUntilID sets the "events_updated_until_id" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateEventsUpdatedUntilID() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateEventsUpdatedUntilID()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *ProfileUpsertOne) SetModel(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateModel() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfileUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfileUpsertOne.ID is not supported by MySQL driver. Use ProfileUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfileUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProfileCreateBulk is the builder for creating many Profile entities in bulk.
+type ProfileCreateBulk struct {
+	config
+	err      error
+	builders []*ProfileCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Profile entities in the database.
+func (_c *ProfileCreateBulk) Save(ctx context.Context) ([]*Profile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Profile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProfileCreateBulk) SaveX(ctx context.Context) []*Profile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Profile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProfileCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfileUpsertBulk {
+	_c.conflict = opts
+	return &ProfileUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProfileCreateBulk) OnConflictColumns(columns ...string) *ProfileUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProfileUpsertBulk{
+		create: _c,
+	}
+}
+
+// ProfileUpsertBulk is the builder for "upsert"-ing
+// a bulk of Profile nodes.
+type ProfileUpsertBulk struct {
+	create *ProfileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileUpsertBulk) UpdateNewValues() *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profile.FieldID)
+			}
+			if _, exists := b.mutation.IdentityID(); exists {
+				s.SetIgnore(profile.FieldIdentityID)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(profile.FieldVersion)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(profile.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfileUpsertBulk) Ignore() *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileUpsertBulk) DoNothing() *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfileUpsertBulk) Update(set func(*ProfileUpsert)) *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *ProfileUpsertBulk) SetWorkspaceID(v uuid.UUID) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateWorkspaceID() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetClaims sets the "claims" field.
+func (u *ProfileUpsertBulk) SetClaims(v []schema.ProfileClaim) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetClaims(v)
+	})
+}
+
+// UpdateClaims sets the "claims" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateClaims() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateClaims()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ProfileUpsertBulk) SetContent(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateContent() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetEventCount sets the "event_count" field.
+func (u *ProfileUpsertBulk) SetEventCount(v int) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetEventCount(v)
+	})
+}
+
+// AddEventCount adds v to the "event_count" field.
+func (u *ProfileUpsertBulk) AddEventCount(v int) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.AddEventCount(v)
+	})
+}
+
+// UpdateEventCount sets the "event_count" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateEventCount() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateEventCount()
+	})
+}
+
+// SetEventsUpdatedUntil sets the "events_updated_until" field.
+func (u *ProfileUpsertBulk) SetEventsUpdatedUntil(v int64) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetEventsUpdatedUntil(v)
+	})
+}
+
+// AddEventsUpdatedUntil adds v to the "events_updated_until" field.
+func (u *ProfileUpsertBulk) AddEventsUpdatedUntil(v int64) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.AddEventsUpdatedUntil(v)
+	})
+}
+
+// UpdateEventsUpdatedUntil sets the "events_updated_until" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateEventsUpdatedUntil() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateEventsUpdatedUntil()
+	})
+}
+
+// SetEventsUpdatedUntilID sets the "events_updated_until_id" field.
+func (u *ProfileUpsertBulk) SetEventsUpdatedUntilID(v uuid.UUID) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetEventsUpdatedUntilID(v)
+	})
+}
+
+// UpdateEventsUpdatedUntilID sets the "events_updated_until_id" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateEventsUpdatedUntilID() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateEventsUpdatedUntilID()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *ProfileUpsertBulk) SetModel(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateModel() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
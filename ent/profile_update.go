@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/schema"
+)
+
+// ProfileUpdate is the builder for updating Profile entities.
+type ProfileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProfileMutation
+}
+
+// Where appends a list predicates to the ProfileUpdate builder.
+func (_u *ProfileUpdate) Where(ps ...predicate.Profile) *ProfileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *ProfileUpdate) SetWorkspaceID(v uuid.UUID) *ProfileUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableWorkspaceID(v *uuid.UUID) *ProfileUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetClaims sets the "claims" field.
+func (_u *ProfileUpdate) SetClaims(v []schema.ProfileClaim) *ProfileUpdate {
+	_u.mutation.SetClaims(v)
+	return _u
+}
+
+// AppendClaims appends value to the "claims" field.
+func (_u *ProfileUpdate) AppendClaims(v []schema.ProfileClaim) *ProfileUpdate {
+	_u.mutation.AppendClaims(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ProfileUpdate) SetContent(v string) *ProfileUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableContent(v *string) *ProfileUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetEventCount sets the "event_count" field.
+func (_u *ProfileUpdate) SetEventCount(v int) *ProfileUpdate {
+	_u.mutation.ResetEventCount()
+	_u.mutation.SetEventCount(v)
+	return _u
+}
+
+// SetNillableEventCount sets the "event_count" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableEventCount(v *int) *ProfileUpdate {
+	if v != nil {
+		_u.SetEventCount(*v)
+	}
+	return _u
+}
+
+// AddEventCount adds value to the "event_count" field.
+func (_u *ProfileUpdate) AddEventCount(v int) *ProfileUpdate {
+	_u.mutation.AddEventCount(v)
+	return _u
+}
+
+// SetEventsUpdatedUntil sets the "events_updated_until" field.
+func (_u *ProfileUpdate) SetEventsUpdatedUntil(v int64) *ProfileUpdate {
+	_u.mutation.ResetEventsUpdatedUntil()
+	_u.mutation.SetEventsUpdatedUntil(v)
+	return _u
+}
+
+// SetNillableEventsUpdatedUntil sets the "events_updated_until" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableEventsUpdatedUntil(v *int64) *ProfileUpdate {
+	if v != nil {
+		_u.SetEventsUpdatedUntil(*v)
+	}
+	return _u
+}
+
+// AddEventsUpdatedUntil adds value to the "events_updated_until" field.
+func (_u *ProfileUpdate) AddEventsUpdatedUntil(v int64) *ProfileUpdate {
+	_u.mutation.AddEventsUpdatedUntil(v)
+	return _u
+}
+
+// SetEventsUpdatedUntilID sets the "events_updated_until_id" field.
+func (_u *ProfileUpdate) SetEventsUpdatedUntilID(v uuid.UUID) *ProfileUpdate {
+	_u.mutation.SetEventsUpdatedUntilID(v)
+	return _u
+}
+
+// SetNillableEventsUpdatedUntilID sets the "events_updated_until_id" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableEventsUpdatedUntilID(v *uuid.UUID) *ProfileUpdate {
+	if v != nil {
+		_u.SetEventsUpdatedUntilID(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ProfileUpdate) SetModel(v string) *ProfileUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableModel(v *string) *ProfileUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (_u *ProfileUpdate) Mutation() *ProfileMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProfileUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProfileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProfileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProfileUpdate) check() error {
+	if _u.mutation.IdentityCleared() && len(_u.mutation.IdentityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Profile.identity"`)
+	}
+	return nil
+}
+
+func (_u *ProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profile.Table, profile.Columns, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(profile.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Claims(); ok {
+		_spec.SetField(profile.FieldClaims, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClaims(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, profile.FieldClaims, value)
+		})
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(profile.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventCount(); ok {
+		_spec.SetField(profile.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventCount(); ok {
+		_spec.AddField(profile.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventsUpdatedUntil(); ok {
+		_spec.SetField(profile.FieldEventsUpdatedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEventsUpdatedUntil(); ok {
+		_spec.AddField(profile.FieldEventsUpdatedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EventsUpdatedUntilID(); ok {
+		_spec.SetField(profile.FieldEventsUpdatedUntilID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(profile.FieldModel, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Profile
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProfileUpdateOne is the builder for updating a single Profile entity.
+type ProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProfileMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *ProfileUpdateOne) SetWorkspaceID(v uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetClaims sets the "claims" field.
+func (_u *ProfileUpdateOne) SetClaims(v []schema.ProfileClaim) *ProfileUpdateOne {
+	_u.mutation.SetClaims(v)
+	return _u
+}
+
+// AppendClaims appends value to the "claims" field.
+func (_u *ProfileUpdateOne) AppendClaims(v []schema.ProfileClaim) *ProfileUpdateOne {
+	_u.mutation.AppendClaims(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ProfileUpdateOne) SetContent(v string) *ProfileUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableContent(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetEventCount sets the "event_count" field.
+func (_u *ProfileUpdateOne) SetEventCount(v int) *ProfileUpdateOne {
+	_u.mutation.ResetEventCount()
+	_u.mutation.SetEventCount(v)
+	return _u
+}
+
+// SetNillableEventCount sets the "event_count" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableEventCount(v *int) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetEventCount(*v)
+	}
+	return _u
+}
+
+// AddEventCount adds value to the "event_count" field.
+func (_u *ProfileUpdateOne) AddEventCount(v int) *ProfileUpdateOne {
+	_u.mutation.AddEventCount(v)
+	return _u
+}
+
+// SetEventsUpdatedUntil sets the "events_updated_until" field.
+func (_u *ProfileUpdateOne) SetEventsUpdatedUntil(v int64) *ProfileUpdateOne {
+	_u.mutation.ResetEventsUpdatedUntil()
+	_u.mutation.SetEventsUpdatedUntil(v)
+	return _u
+}
+
+// SetNillableEventsUpdatedUntil sets the "events_updated_until" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableEventsUpdatedUntil(v *int64) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetEventsUpdatedUntil(*v)
+	}
+	return _u
+}
+
+// AddEventsUpdatedUntil adds value to the "events_updated_until" field.
+func (_u *ProfileUpdateOne) AddEventsUpdatedUntil(v int64) *ProfileUpdateOne {
+	_u.mutation.AddEventsUpdatedUntil(v)
+	return _u
+}
+
+// SetEventsUpdatedUntilID sets the "events_updated_until_id" field.
+func (_u *ProfileUpdateOne) SetEventsUpdatedUntilID(v uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.SetEventsUpdatedUntilID(v)
+	return _u
+}
+
+// SetNillableEventsUpdatedUntilID sets the "events_updated_until_id" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableEventsUpdatedUntilID(v *uuid.UUID) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetEventsUpdatedUntilID(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ProfileUpdateOne) SetModel(v string) *ProfileUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableModel(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (_u *ProfileUpdateOne) Mutation() *ProfileMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProfileUpdate builder.
+func (_u *ProfileUpdateOne) Where(ps ...predicate.Profile) *ProfileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProfileUpdateOne) Select(field string, fields ...string) *ProfileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Profile entity.
+func (_u *ProfileUpdateOne) Save(ctx context.Context) (*Profile, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProfileUpdateOne) SaveX(ctx context.Context) *Profile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProfileUpdateOne) check() error {
+	if _u.mutation.IdentityCleared() && len(_u.mutation.IdentityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Profile.identity"`)
+	}
+	return nil
+}
+
+func (_u *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profile.Table, profile.Columns, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Profile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profile.FieldID)
+		for _, f := range fields {
+			if !profile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(profile.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Claims(); ok {
+		_spec.SetField(profile.FieldClaims, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedClaims(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, profile.FieldClaims, value)
+		})
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(profile.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventCount(); ok {
+		_spec.SetField(profile.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventCount(); ok {
+		_spec.AddField(profile.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventsUpdatedUntil(); ok {
+		_spec.SetField(profile.FieldEventsUpdatedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEventsUpdatedUntil(); ok {
+		_spec.AddField(profile.FieldEventsUpdatedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EventsUpdatedUntilID(); ok {
+		_spec.SetField(profile.FieldEventsUpdatedUntilID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(profile.FieldModel, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Profile
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &Profile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,10 +4,26 @@ package ent
 
 import (
 	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/accesslog"
+	"github.com/luoling8192/mindwave/ent/apikey"
+	"github.com/luoling8192/mindwave/ent/askturn"
+	"github.com/luoling8192/mindwave/ent/chatgrant"
 	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/chatretention"
+	"github.com/luoling8192/mindwave/ent/chatschedule"
+	"github.com/luoling8192/mindwave/ent/digestdelivery"
+	"github.com/luoling8192/mindwave/ent/distillrun"
 	"github.com/luoling8192/mindwave/ent/event"
 	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/job"
 	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/ent/llmcacheentry"
+	"github.com/luoling8192/mindwave/ent/owneraccountlink"
+	"github.com/luoling8192/mindwave/ent/person"
+	"github.com/luoling8192/mindwave/ent/personauditlog"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/ent/summary"
+	"github.com/luoling8192/mindwave/ent/workspace"
 	"github.com/luoling8192/mindwave/schema"
 )
 
@@ -15,8 +31,173 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyMixin := schema.APIKey{}.Mixin()
+	apikeyMixinFields0 := apikeyMixin[0].Fields()
+	_ = apikeyMixinFields0
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescWorkspaceID is the schema descriptor for workspace_id field.
+	apikeyDescWorkspaceID := apikeyMixinFields0[0].Descriptor()
+	// apikey.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	apikey.DefaultWorkspaceID = apikeyDescWorkspaceID.Default.(func() uuid.UUID)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[1].Descriptor()
+	// apikey.DefaultName holds the default value on creation for the name field.
+	apikey.DefaultName = apikeyDescName.Default.(string)
+	// apikeyDescSubject is the schema descriptor for subject field.
+	apikeyDescSubject := apikeyFields[2].Descriptor()
+	// apikey.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	apikey.SubjectValidator = apikeyDescSubject.Validators[0].(func(string) error)
+	// apikeyDescLastUsedAt is the schema descriptor for last_used_at field.
+	apikeyDescLastUsedAt := apikeyFields[6].Descriptor()
+	// apikey.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	apikey.DefaultLastUsedAt = apikeyDescLastUsedAt.Default.(int64)
+	// apikeyDescRevokedAt is the schema descriptor for revoked_at field.
+	apikeyDescRevokedAt := apikeyFields[7].Descriptor()
+	// apikey.DefaultRevokedAt holds the default value on creation for the revoked_at field.
+	apikey.DefaultRevokedAt = apikeyDescRevokedAt.Default.(int64)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[8].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() int64)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	accesslogMixin := schema.AccessLog{}.Mixin()
+	accesslogMixinFields0 := accesslogMixin[0].Fields()
+	_ = accesslogMixinFields0
+	accesslogFields := schema.AccessLog{}.Fields()
+	_ = accesslogFields
+	// accesslogDescWorkspaceID is the schema descriptor for workspace_id field.
+	accesslogDescWorkspaceID := accesslogMixinFields0[0].Descriptor()
+	// accesslog.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	accesslog.DefaultWorkspaceID = accesslogDescWorkspaceID.Default.(func() uuid.UUID)
+	// accesslogDescSubject is the schema descriptor for subject field.
+	accesslogDescSubject := accesslogFields[1].Descriptor()
+	// accesslog.DefaultSubject holds the default value on creation for the subject field.
+	accesslog.DefaultSubject = accesslogDescSubject.Default.(string)
+	// accesslogDescMethod is the schema descriptor for method field.
+	accesslogDescMethod := accesslogFields[2].Descriptor()
+	// accesslog.DefaultMethod holds the default value on creation for the method field.
+	accesslog.DefaultMethod = accesslogDescMethod.Default.(string)
+	// accesslogDescRole is the schema descriptor for role field.
+	accesslogDescRole := accesslogFields[3].Descriptor()
+	// accesslog.DefaultRole holds the default value on creation for the role field.
+	accesslog.DefaultRole = accesslogDescRole.Default.(string)
+	// accesslogDescQuery is the schema descriptor for query field.
+	accesslogDescQuery := accesslogFields[5].Descriptor()
+	// accesslog.DefaultQuery holds the default value on creation for the query field.
+	accesslog.DefaultQuery = accesslogDescQuery.Default.(string)
+	// accesslogDescChatIds is the schema descriptor for chat_ids field.
+	accesslogDescChatIds := accesslogFields[6].Descriptor()
+	// accesslog.DefaultChatIds holds the default value on creation for the chat_ids field.
+	accesslog.DefaultChatIds = accesslogDescChatIds.Default.([]string)
+	// accesslogDescMessageIds is the schema descriptor for message_ids field.
+	accesslogDescMessageIds := accesslogFields[7].Descriptor()
+	// accesslog.DefaultMessageIds holds the default value on creation for the message_ids field.
+	accesslog.DefaultMessageIds = accesslogDescMessageIds.Default.([]uuid.UUID)
+	// accesslogDescCreatedAt is the schema descriptor for created_at field.
+	accesslogDescCreatedAt := accesslogFields[8].Descriptor()
+	// accesslog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesslog.DefaultCreatedAt = accesslogDescCreatedAt.Default.(func() int64)
+	// accesslogDescID is the schema descriptor for id field.
+	accesslogDescID := accesslogFields[0].Descriptor()
+	// accesslog.DefaultID holds the default value on creation for the id field.
+	accesslog.DefaultID = accesslogDescID.Default.(func() uuid.UUID)
+	askturnMixin := schema.AskTurn{}.Mixin()
+	askturnMixinFields0 := askturnMixin[0].Fields()
+	_ = askturnMixinFields0
+	askturnFields := schema.AskTurn{}.Fields()
+	_ = askturnFields
+	// askturnDescWorkspaceID is the schema descriptor for workspace_id field.
+	askturnDescWorkspaceID := askturnMixinFields0[0].Descriptor()
+	// askturn.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	askturn.DefaultWorkspaceID = askturnDescWorkspaceID.Default.(func() uuid.UUID)
+	// askturnDescSubject is the schema descriptor for subject field.
+	askturnDescSubject := askturnFields[2].Descriptor()
+	// askturn.DefaultSubject holds the default value on creation for the subject field.
+	askturn.DefaultSubject = askturnDescSubject.Default.(string)
+	// askturnDescQuestion is the schema descriptor for question field.
+	askturnDescQuestion := askturnFields[3].Descriptor()
+	// askturn.DefaultQuestion holds the default value on creation for the question field.
+	askturn.DefaultQuestion = askturnDescQuestion.Default.(string)
+	// askturnDescStandaloneQuestion is the schema descriptor for standalone_question field.
+	askturnDescStandaloneQuestion := askturnFields[4].Descriptor()
+	// askturn.DefaultStandaloneQuestion holds the default value on creation for the standalone_question field.
+	askturn.DefaultStandaloneQuestion = askturnDescStandaloneQuestion.Default.(string)
+	// askturnDescAnswer is the schema descriptor for answer field.
+	askturnDescAnswer := askturnFields[5].Descriptor()
+	// askturn.DefaultAnswer holds the default value on creation for the answer field.
+	askturn.DefaultAnswer = askturnDescAnswer.Default.(string)
+	// askturnDescRefused is the schema descriptor for refused field.
+	askturnDescRefused := askturnFields[6].Descriptor()
+	// askturn.DefaultRefused holds the default value on creation for the refused field.
+	askturn.DefaultRefused = askturnDescRefused.Default.(bool)
+	// askturnDescCitedMessageIds is the schema descriptor for cited_message_ids field.
+	askturnDescCitedMessageIds := askturnFields[7].Descriptor()
+	// askturn.DefaultCitedMessageIds holds the default value on creation for the cited_message_ids field.
+	askturn.DefaultCitedMessageIds = askturnDescCitedMessageIds.Default.([]uuid.UUID)
+	// askturnDescEventIds is the schema descriptor for event_ids field.
+	askturnDescEventIds := askturnFields[8].Descriptor()
+	// askturn.DefaultEventIds holds the default value on creation for the event_ids field.
+	askturn.DefaultEventIds = askturnDescEventIds.Default.([]uuid.UUID)
+	// askturnDescModel is the schema descriptor for model field.
+	askturnDescModel := askturnFields[9].Descriptor()
+	// askturn.DefaultModel holds the default value on creation for the model field.
+	askturn.DefaultModel = askturnDescModel.Default.(string)
+	// askturnDescCreatedAt is the schema descriptor for created_at field.
+	askturnDescCreatedAt := askturnFields[10].Descriptor()
+	// askturn.DefaultCreatedAt holds the default value on creation for the created_at field.
+	askturn.DefaultCreatedAt = askturnDescCreatedAt.Default.(func() int64)
+	// askturnDescID is the schema descriptor for id field.
+	askturnDescID := askturnFields[0].Descriptor()
+	// askturn.DefaultID holds the default value on creation for the id field.
+	askturn.DefaultID = askturnDescID.Default.(func() uuid.UUID)
+	chatgrantMixin := schema.ChatGrant{}.Mixin()
+	chatgrantMixinFields0 := chatgrantMixin[0].Fields()
+	_ = chatgrantMixinFields0
+	chatgrantFields := schema.ChatGrant{}.Fields()
+	_ = chatgrantFields
+	// chatgrantDescWorkspaceID is the schema descriptor for workspace_id field.
+	chatgrantDescWorkspaceID := chatgrantMixinFields0[0].Descriptor()
+	// chatgrant.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	chatgrant.DefaultWorkspaceID = chatgrantDescWorkspaceID.Default.(func() uuid.UUID)
+	// chatgrantDescSubject is the schema descriptor for subject field.
+	chatgrantDescSubject := chatgrantFields[1].Descriptor()
+	// chatgrant.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	chatgrant.SubjectValidator = chatgrantDescSubject.Validators[0].(func(string) error)
+	// chatgrantDescChatID is the schema descriptor for chat_id field.
+	chatgrantDescChatID := chatgrantFields[2].Descriptor()
+	// chatgrant.ChatIDValidator is a validator for the "chat_id" field. It is called by the builders before save.
+	chatgrant.ChatIDValidator = chatgrantDescChatID.Validators[0].(func(string) error)
+	// chatgrantDescGrantedBy is the schema descriptor for granted_by field.
+	chatgrantDescGrantedBy := chatgrantFields[4].Descriptor()
+	// chatgrant.DefaultGrantedBy holds the default value on creation for the granted_by field.
+	chatgrant.DefaultGrantedBy = chatgrantDescGrantedBy.Default.(string)
+	// chatgrantDescCreatedAt is the schema descriptor for created_at field.
+	chatgrantDescCreatedAt := chatgrantFields[5].Descriptor()
+	// chatgrant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatgrant.DefaultCreatedAt = chatgrantDescCreatedAt.Default.(func() int64)
+	// chatgrantDescUpdatedAt is the schema descriptor for updated_at field.
+	chatgrantDescUpdatedAt := chatgrantFields[6].Descriptor()
+	// chatgrant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	chatgrant.DefaultUpdatedAt = chatgrantDescUpdatedAt.Default.(func() int64)
+	// chatgrant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	chatgrant.UpdateDefaultUpdatedAt = chatgrantDescUpdatedAt.UpdateDefault.(func() int64)
+	// chatgrantDescID is the schema descriptor for id field.
+	chatgrantDescID := chatgrantFields[0].Descriptor()
+	// chatgrant.DefaultID holds the default value on creation for the id field.
+	chatgrant.DefaultID = chatgrantDescID.Default.(func() uuid.UUID)
+	chatmessageMixin := schema.ChatMessage{}.Mixin()
+	chatmessageMixinFields0 := chatmessageMixin[0].Fields()
+	_ = chatmessageMixinFields0
 	chatmessageFields := schema.ChatMessage{}.Fields()
 	_ = chatmessageFields
+	// chatmessageDescWorkspaceID is the schema descriptor for workspace_id field.
+	chatmessageDescWorkspaceID := chatmessageMixinFields0[0].Descriptor()
+	// chatmessage.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	chatmessage.DefaultWorkspaceID = chatmessageDescWorkspaceID.Default.(func() uuid.UUID)
 	// chatmessageDescPlatform is the schema descriptor for platform field.
 	chatmessageDescPlatform := chatmessageFields[1].Descriptor()
 	// chatmessage.DefaultPlatform holds the default value on creation for the platform field.
@@ -83,26 +264,209 @@ func init() {
 	chatmessageDescJiebaTokens := chatmessageFields[17].Descriptor()
 	// chatmessage.DefaultJiebaTokens holds the default value on creation for the jieba_tokens field.
 	chatmessage.DefaultJiebaTokens = chatmessageDescJiebaTokens.Default.([]string)
+	// chatmessageDescTokenizedAt is the schema descriptor for tokenized_at field.
+	chatmessageDescTokenizedAt := chatmessageFields[18].Descriptor()
+	// chatmessage.DefaultTokenizedAt holds the default value on creation for the tokenized_at field.
+	chatmessage.DefaultTokenizedAt = chatmessageDescTokenizedAt.Default.(int64)
 	// chatmessageDescCreatedAt is the schema descriptor for created_at field.
-	chatmessageDescCreatedAt := chatmessageFields[18].Descriptor()
+	chatmessageDescCreatedAt := chatmessageFields[19].Descriptor()
 	// chatmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
 	chatmessage.DefaultCreatedAt = chatmessageDescCreatedAt.Default.(func() int64)
 	// chatmessageDescUpdatedAt is the schema descriptor for updated_at field.
-	chatmessageDescUpdatedAt := chatmessageFields[19].Descriptor()
+	chatmessageDescUpdatedAt := chatmessageFields[20].Descriptor()
 	// chatmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	chatmessage.DefaultUpdatedAt = chatmessageDescUpdatedAt.Default.(func() int64)
 	// chatmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	chatmessage.UpdateDefaultUpdatedAt = chatmessageDescUpdatedAt.UpdateDefault.(func() int64)
 	// chatmessageDescDeletedAt is the schema descriptor for deleted_at field.
-	chatmessageDescDeletedAt := chatmessageFields[20].Descriptor()
+	chatmessageDescDeletedAt := chatmessageFields[21].Descriptor()
 	// chatmessage.DefaultDeletedAt holds the default value on creation for the deleted_at field.
 	chatmessage.DefaultDeletedAt = chatmessageDescDeletedAt.Default.(int64)
 	// chatmessageDescID is the schema descriptor for id field.
 	chatmessageDescID := chatmessageFields[0].Descriptor()
 	// chatmessage.DefaultID holds the default value on creation for the id field.
 	chatmessage.DefaultID = chatmessageDescID.Default.(func() uuid.UUID)
+	chatretentionMixin := schema.ChatRetention{}.Mixin()
+	chatretentionMixinFields0 := chatretentionMixin[0].Fields()
+	_ = chatretentionMixinFields0
+	chatretentionFields := schema.ChatRetention{}.Fields()
+	_ = chatretentionFields
+	// chatretentionDescWorkspaceID is the schema descriptor for workspace_id field.
+	chatretentionDescWorkspaceID := chatretentionMixinFields0[0].Descriptor()
+	// chatretention.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	chatretention.DefaultWorkspaceID = chatretentionDescWorkspaceID.Default.(func() uuid.UUID)
+	// chatretentionDescDays is the schema descriptor for days field.
+	chatretentionDescDays := chatretentionFields[2].Descriptor()
+	// chatretention.DaysValidator is a validator for the "days" field. It is called by the builders before save.
+	chatretention.DaysValidator = chatretentionDescDays.Validators[0].(func(int) error)
+	// chatretentionDescEnabled is the schema descriptor for enabled field.
+	chatretentionDescEnabled := chatretentionFields[4].Descriptor()
+	// chatretention.DefaultEnabled holds the default value on creation for the enabled field.
+	chatretention.DefaultEnabled = chatretentionDescEnabled.Default.(bool)
+	// chatretentionDescLastRunAt is the schema descriptor for last_run_at field.
+	chatretentionDescLastRunAt := chatretentionFields[5].Descriptor()
+	// chatretention.DefaultLastRunAt holds the default value on creation for the last_run_at field.
+	chatretention.DefaultLastRunAt = chatretentionDescLastRunAt.Default.(int64)
+	// chatretentionDescCreatedAt is the schema descriptor for created_at field.
+	chatretentionDescCreatedAt := chatretentionFields[6].Descriptor()
+	// chatretention.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatretention.DefaultCreatedAt = chatretentionDescCreatedAt.Default.(func() int64)
+	// chatretentionDescUpdatedAt is the schema descriptor for updated_at field.
+	chatretentionDescUpdatedAt := chatretentionFields[7].Descriptor()
+	// chatretention.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	chatretention.DefaultUpdatedAt = chatretentionDescUpdatedAt.Default.(func() int64)
+	// chatretention.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	chatretention.UpdateDefaultUpdatedAt = chatretentionDescUpdatedAt.UpdateDefault.(func() int64)
+	// chatretentionDescID is the schema descriptor for id field.
+	chatretentionDescID := chatretentionFields[0].Descriptor()
+	// chatretention.DefaultID holds the default value on creation for the id field.
+	chatretention.DefaultID = chatretentionDescID.Default.(func() uuid.UUID)
+	chatscheduleMixin := schema.ChatSchedule{}.Mixin()
+	chatscheduleMixinFields0 := chatscheduleMixin[0].Fields()
+	_ = chatscheduleMixinFields0
+	chatscheduleFields := schema.ChatSchedule{}.Fields()
+	_ = chatscheduleFields
+	// chatscheduleDescWorkspaceID is the schema descriptor for workspace_id field.
+	chatscheduleDescWorkspaceID := chatscheduleMixinFields0[0].Descriptor()
+	// chatschedule.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	chatschedule.DefaultWorkspaceID = chatscheduleDescWorkspaceID.Default.(func() uuid.UUID)
+	// chatscheduleDescEnabled is the schema descriptor for enabled field.
+	chatscheduleDescEnabled := chatscheduleFields[3].Descriptor()
+	// chatschedule.DefaultEnabled holds the default value on creation for the enabled field.
+	chatschedule.DefaultEnabled = chatscheduleDescEnabled.Default.(bool)
+	// chatscheduleDescLastWindowEnd is the schema descriptor for last_window_end field.
+	chatscheduleDescLastWindowEnd := chatscheduleFields[4].Descriptor()
+	// chatschedule.DefaultLastWindowEnd holds the default value on creation for the last_window_end field.
+	chatschedule.DefaultLastWindowEnd = chatscheduleDescLastWindowEnd.Default.(int64)
+	// chatscheduleDescCreatedAt is the schema descriptor for created_at field.
+	chatscheduleDescCreatedAt := chatscheduleFields[5].Descriptor()
+	// chatschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatschedule.DefaultCreatedAt = chatscheduleDescCreatedAt.Default.(func() int64)
+	// chatscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	chatscheduleDescUpdatedAt := chatscheduleFields[6].Descriptor()
+	// chatschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	chatschedule.DefaultUpdatedAt = chatscheduleDescUpdatedAt.Default.(func() int64)
+	// chatschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	chatschedule.UpdateDefaultUpdatedAt = chatscheduleDescUpdatedAt.UpdateDefault.(func() int64)
+	// chatscheduleDescID is the schema descriptor for id field.
+	chatscheduleDescID := chatscheduleFields[0].Descriptor()
+	// chatschedule.DefaultID holds the default value on creation for the id field.
+	chatschedule.DefaultID = chatscheduleDescID.Default.(func() uuid.UUID)
+	digestdeliveryMixin := schema.DigestDelivery{}.Mixin()
+	digestdeliveryMixinFields0 := digestdeliveryMixin[0].Fields()
+	_ = digestdeliveryMixinFields0
+	digestdeliveryFields := schema.DigestDelivery{}.Fields()
+	_ = digestdeliveryFields
+	// digestdeliveryDescWorkspaceID is the schema descriptor for workspace_id field.
+	digestdeliveryDescWorkspaceID := digestdeliveryMixinFields0[0].Descriptor()
+	// digestdelivery.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	digestdelivery.DefaultWorkspaceID = digestdeliveryDescWorkspaceID.Default.(func() uuid.UUID)
+	// digestdeliveryDescPartsTotal is the schema descriptor for parts_total field.
+	digestdeliveryDescPartsTotal := digestdeliveryFields[5].Descriptor()
+	// digestdelivery.DefaultPartsTotal holds the default value on creation for the parts_total field.
+	digestdelivery.DefaultPartsTotal = digestdeliveryDescPartsTotal.Default.(int)
+	// digestdeliveryDescPartsSent is the schema descriptor for parts_sent field.
+	digestdeliveryDescPartsSent := digestdeliveryFields[6].Descriptor()
+	// digestdelivery.DefaultPartsSent holds the default value on creation for the parts_sent field.
+	digestdelivery.DefaultPartsSent = digestdeliveryDescPartsSent.Default.(int)
+	// digestdeliveryDescError is the schema descriptor for error field.
+	digestdeliveryDescError := digestdeliveryFields[7].Descriptor()
+	// digestdelivery.DefaultError holds the default value on creation for the error field.
+	digestdelivery.DefaultError = digestdeliveryDescError.Default.(string)
+	// digestdeliveryDescSentAt is the schema descriptor for sent_at field.
+	digestdeliveryDescSentAt := digestdeliveryFields[8].Descriptor()
+	// digestdelivery.DefaultSentAt holds the default value on creation for the sent_at field.
+	digestdelivery.DefaultSentAt = digestdeliveryDescSentAt.Default.(int64)
+	// digestdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	digestdeliveryDescCreatedAt := digestdeliveryFields[9].Descriptor()
+	// digestdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	digestdelivery.DefaultCreatedAt = digestdeliveryDescCreatedAt.Default.(func() int64)
+	// digestdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	digestdeliveryDescUpdatedAt := digestdeliveryFields[10].Descriptor()
+	// digestdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	digestdelivery.DefaultUpdatedAt = digestdeliveryDescUpdatedAt.Default.(func() int64)
+	// digestdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	digestdelivery.UpdateDefaultUpdatedAt = digestdeliveryDescUpdatedAt.UpdateDefault.(func() int64)
+	// digestdeliveryDescID is the schema descriptor for id field.
+	digestdeliveryDescID := digestdeliveryFields[0].Descriptor()
+	// digestdelivery.DefaultID holds the default value on creation for the id field.
+	digestdelivery.DefaultID = digestdeliveryDescID.Default.(func() uuid.UUID)
+	distillrunMixin := schema.DistillRun{}.Mixin()
+	distillrunMixinFields0 := distillrunMixin[0].Fields()
+	_ = distillrunMixinFields0
+	distillrunFields := schema.DistillRun{}.Fields()
+	_ = distillrunFields
+	// distillrunDescWorkspaceID is the schema descriptor for workspace_id field.
+	distillrunDescWorkspaceID := distillrunMixinFields0[0].Descriptor()
+	// distillrun.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	distillrun.DefaultWorkspaceID = distillrunDescWorkspaceID.Default.(func() uuid.UUID)
+	// distillrunDescInChatID is the schema descriptor for in_chat_id field.
+	distillrunDescInChatID := distillrunFields[1].Descriptor()
+	// distillrun.InChatIDValidator is a validator for the "in_chat_id" field. It is called by the builders before save.
+	distillrun.InChatIDValidator = distillrunDescInChatID.Validators[0].(func(string) error)
+	// distillrunDescMessageCount is the schema descriptor for message_count field.
+	distillrunDescMessageCount := distillrunFields[5].Descriptor()
+	// distillrun.DefaultMessageCount holds the default value on creation for the message_count field.
+	distillrun.DefaultMessageCount = distillrunDescMessageCount.Default.(int)
+	// distillrunDescItemCount is the schema descriptor for item_count field.
+	distillrunDescItemCount := distillrunFields[6].Descriptor()
+	// distillrun.DefaultItemCount holds the default value on creation for the item_count field.
+	distillrun.DefaultItemCount = distillrunDescItemCount.Default.(int)
+	// distillrunDescEventCount is the schema descriptor for event_count field.
+	distillrunDescEventCount := distillrunFields[7].Descriptor()
+	// distillrun.DefaultEventCount holds the default value on creation for the event_count field.
+	distillrun.DefaultEventCount = distillrunDescEventCount.Default.(int)
+	// distillrunDescPromptTokens is the schema descriptor for prompt_tokens field.
+	distillrunDescPromptTokens := distillrunFields[8].Descriptor()
+	// distillrun.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	distillrun.DefaultPromptTokens = distillrunDescPromptTokens.Default.(int)
+	// distillrunDescCompletionTokens is the schema descriptor for completion_tokens field.
+	distillrunDescCompletionTokens := distillrunFields[9].Descriptor()
+	// distillrun.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	distillrun.DefaultCompletionTokens = distillrunDescCompletionTokens.Default.(int)
+	// distillrunDescCost is the schema descriptor for cost field.
+	distillrunDescCost := distillrunFields[10].Descriptor()
+	// distillrun.DefaultCost holds the default value on creation for the cost field.
+	distillrun.DefaultCost = distillrunDescCost.Default.(float64)
+	// distillrunDescStageUsage is the schema descriptor for stage_usage field.
+	distillrunDescStageUsage := distillrunFields[11].Descriptor()
+	// distillrun.DefaultStageUsage holds the default value on creation for the stage_usage field.
+	distillrun.DefaultStageUsage = distillrunDescStageUsage.Default.(map[string]schema.StageUsage)
+	// distillrunDescError is the schema descriptor for error field.
+	distillrunDescError := distillrunFields[12].Descriptor()
+	// distillrun.DefaultError holds the default value on creation for the error field.
+	distillrun.DefaultError = distillrunDescError.Default.(string)
+	// distillrunDescPartialOutput is the schema descriptor for partial_output field.
+	distillrunDescPartialOutput := distillrunFields[13].Descriptor()
+	// distillrun.DefaultPartialOutput holds the default value on creation for the partial_output field.
+	distillrun.DefaultPartialOutput = distillrunDescPartialOutput.Default.(string)
+	// distillrunDescFinishedAt is the schema descriptor for finished_at field.
+	distillrunDescFinishedAt := distillrunFields[14].Descriptor()
+	// distillrun.DefaultFinishedAt holds the default value on creation for the finished_at field.
+	distillrun.DefaultFinishedAt = distillrunDescFinishedAt.Default.(int64)
+	// distillrunDescCreatedAt is the schema descriptor for created_at field.
+	distillrunDescCreatedAt := distillrunFields[15].Descriptor()
+	// distillrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	distillrun.DefaultCreatedAt = distillrunDescCreatedAt.Default.(func() int64)
+	// distillrunDescUpdatedAt is the schema descriptor for updated_at field.
+	distillrunDescUpdatedAt := distillrunFields[16].Descriptor()
+	// distillrun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	distillrun.DefaultUpdatedAt = distillrunDescUpdatedAt.Default.(func() int64)
+	// distillrun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	distillrun.UpdateDefaultUpdatedAt = distillrunDescUpdatedAt.UpdateDefault.(func() int64)
+	// distillrunDescID is the schema descriptor for id field.
+	distillrunDescID := distillrunFields[0].Descriptor()
+	// distillrun.DefaultID holds the default value on creation for the id field.
+	distillrun.DefaultID = distillrunDescID.Default.(func() uuid.UUID)
+	eventMixin := schema.Event{}.Mixin()
+	eventMixinFields0 := eventMixin[0].Fields()
+	_ = eventMixinFields0
 	eventFields := schema.Event{}.Fields()
 	_ = eventFields
+	// eventDescWorkspaceID is the schema descriptor for workspace_id field.
+	eventDescWorkspaceID := eventMixinFields0[0].Descriptor()
+	// event.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	event.DefaultWorkspaceID = eventDescWorkspaceID.Default.(func() uuid.UUID)
 	// eventDescPlatform is the schema descriptor for platform field.
 	eventDescPlatform := eventFields[1].Descriptor()
 	// event.DefaultPlatform holds the default value on creation for the platform field.
@@ -128,31 +492,39 @@ func init() {
 	// event.FromNameValidator is a validator for the "from_name" field. It is called by the builders before save.
 	event.FromNameValidator = eventDescFromName.Validators[0].(func(string) error)
 	// eventDescInChatID is the schema descriptor for in_chat_id field.
-	eventDescInChatID := eventFields[6].Descriptor()
+	eventDescInChatID := eventFields[7].Descriptor()
 	// event.DefaultInChatID holds the default value on creation for the in_chat_id field.
 	event.DefaultInChatID = eventDescInChatID.Default.(string)
 	// event.InChatIDValidator is a validator for the "in_chat_id" field. It is called by the builders before save.
 	event.InChatIDValidator = eventDescInChatID.Validators[0].(func(string) error)
 	// eventDescInChatType is the schema descriptor for in_chat_type field.
-	eventDescInChatType := eventFields[7].Descriptor()
+	eventDescInChatType := eventFields[8].Descriptor()
 	// event.DefaultInChatType holds the default value on creation for the in_chat_type field.
 	event.DefaultInChatType = eventDescInChatType.Default.(string)
 	// event.InChatTypeValidator is a validator for the "in_chat_type" field. It is called by the builders before save.
 	event.InChatTypeValidator = eventDescInChatType.Validators[0].(func(string) error)
 	// eventDescPlatformTimestamp is the schema descriptor for platform_timestamp field.
-	eventDescPlatformTimestamp := eventFields[8].Descriptor()
+	eventDescPlatformTimestamp := eventFields[9].Descriptor()
 	// event.DefaultPlatformTimestamp holds the default value on creation for the platform_timestamp field.
 	event.DefaultPlatformTimestamp = eventDescPlatformTimestamp.Default.(int64)
 	// eventDescEvidenceMessageIds is the schema descriptor for evidence_message_ids field.
-	eventDescEvidenceMessageIds := eventFields[9].Descriptor()
+	eventDescEvidenceMessageIds := eventFields[10].Descriptor()
 	// event.DefaultEvidenceMessageIds holds the default value on creation for the evidence_message_ids field.
 	event.DefaultEvidenceMessageIds = eventDescEvidenceMessageIds.Default.([]uuid.UUID)
+	// eventDescSpanStart is the schema descriptor for span_start field.
+	eventDescSpanStart := eventFields[11].Descriptor()
+	// event.DefaultSpanStart holds the default value on creation for the span_start field.
+	event.DefaultSpanStart = eventDescSpanStart.Default.(int64)
+	// eventDescSpanEnd is the schema descriptor for span_end field.
+	eventDescSpanEnd := eventFields[12].Descriptor()
+	// event.DefaultSpanEnd holds the default value on creation for the span_end field.
+	event.DefaultSpanEnd = eventDescSpanEnd.Default.(int64)
 	// eventDescCreatedAt is the schema descriptor for created_at field.
-	eventDescCreatedAt := eventFields[10].Descriptor()
+	eventDescCreatedAt := eventFields[17].Descriptor()
 	// event.DefaultCreatedAt holds the default value on creation for the created_at field.
 	event.DefaultCreatedAt = eventDescCreatedAt.Default.(func() int64)
 	// eventDescUpdatedAt is the schema descriptor for updated_at field.
-	eventDescUpdatedAt := eventFields[11].Descriptor()
+	eventDescUpdatedAt := eventFields[18].Descriptor()
 	// event.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	event.DefaultUpdatedAt = eventDescUpdatedAt.Default.(func() int64)
 	// event.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -161,8 +533,15 @@ func init() {
 	eventDescID := eventFields[0].Descriptor()
 	// event.DefaultID holds the default value on creation for the id field.
 	event.DefaultID = eventDescID.Default.(func() uuid.UUID)
+	identityMixin := schema.Identity{}.Mixin()
+	identityMixinFields0 := identityMixin[0].Fields()
+	_ = identityMixinFields0
 	identityFields := schema.Identity{}.Fields()
 	_ = identityFields
+	// identityDescWorkspaceID is the schema descriptor for workspace_id field.
+	identityDescWorkspaceID := identityMixinFields0[0].Descriptor()
+	// identity.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	identity.DefaultWorkspaceID = identityDescWorkspaceID.Default.(func() uuid.UUID)
 	// identityDescPlatform is the schema descriptor for platform field.
 	identityDescPlatform := identityFields[1].Descriptor()
 	// identity.DefaultPlatform holds the default value on creation for the platform field.
@@ -183,12 +562,20 @@ func init() {
 	identityDescProfilePhotoURL := identityFields[5].Descriptor()
 	// identity.DefaultProfilePhotoURL holds the default value on creation for the profile_photo_url field.
 	identity.DefaultProfilePhotoURL = identityDescProfilePhotoURL.Default.(string)
+	// identityDescOptedOut is the schema descriptor for opted_out field.
+	identityDescOptedOut := identityFields[8].Descriptor()
+	// identity.DefaultOptedOut holds the default value on creation for the opted_out field.
+	identity.DefaultOptedOut = identityDescOptedOut.Default.(bool)
+	// identityDescForgottenAt is the schema descriptor for forgotten_at field.
+	identityDescForgottenAt := identityFields[9].Descriptor()
+	// identity.DefaultForgottenAt holds the default value on creation for the forgotten_at field.
+	identity.DefaultForgottenAt = identityDescForgottenAt.Default.(int64)
 	// identityDescCreatedAt is the schema descriptor for created_at field.
-	identityDescCreatedAt := identityFields[7].Descriptor()
+	identityDescCreatedAt := identityFields[10].Descriptor()
 	// identity.DefaultCreatedAt holds the default value on creation for the created_at field.
 	identity.DefaultCreatedAt = identityDescCreatedAt.Default.(func() int64)
 	// identityDescUpdatedAt is the schema descriptor for updated_at field.
-	identityDescUpdatedAt := identityFields[8].Descriptor()
+	identityDescUpdatedAt := identityFields[11].Descriptor()
 	// identity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	identity.DefaultUpdatedAt = identityDescUpdatedAt.Default.(func() int64)
 	// identity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -197,8 +584,66 @@ func init() {
 	identityDescID := identityFields[0].Descriptor()
 	// identity.DefaultID holds the default value on creation for the id field.
 	identity.DefaultID = identityDescID.Default.(func() uuid.UUID)
+	jobMixin := schema.Job{}.Mixin()
+	jobMixinFields0 := jobMixin[0].Fields()
+	_ = jobMixinFields0
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescWorkspaceID is the schema descriptor for workspace_id field.
+	jobDescWorkspaceID := jobMixinFields0[0].Descriptor()
+	// job.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	job.DefaultWorkspaceID = jobDescWorkspaceID.Default.(func() uuid.UUID)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[5].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescMaxAttempts is the schema descriptor for max_attempts field.
+	jobDescMaxAttempts := jobFields[6].Descriptor()
+	// job.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	job.DefaultMaxAttempts = jobDescMaxAttempts.Default.(int)
+	// jobDescRunAt is the schema descriptor for run_at field.
+	jobDescRunAt := jobFields[7].Descriptor()
+	// job.DefaultRunAt holds the default value on creation for the run_at field.
+	job.DefaultRunAt = jobDescRunAt.Default.(func() int64)
+	// jobDescLockedBy is the schema descriptor for locked_by field.
+	jobDescLockedBy := jobFields[8].Descriptor()
+	// job.DefaultLockedBy holds the default value on creation for the locked_by field.
+	job.DefaultLockedBy = jobDescLockedBy.Default.(string)
+	// jobDescLockedUntil is the schema descriptor for locked_until field.
+	jobDescLockedUntil := jobFields[9].Descriptor()
+	// job.DefaultLockedUntil holds the default value on creation for the locked_until field.
+	job.DefaultLockedUntil = jobDescLockedUntil.Default.(int64)
+	// jobDescLastError is the schema descriptor for last_error field.
+	jobDescLastError := jobFields[10].Descriptor()
+	// job.DefaultLastError holds the default value on creation for the last_error field.
+	job.DefaultLastError = jobDescLastError.Default.(string)
+	// jobDescFinishedAt is the schema descriptor for finished_at field.
+	jobDescFinishedAt := jobFields[11].Descriptor()
+	// job.DefaultFinishedAt holds the default value on creation for the finished_at field.
+	job.DefaultFinishedAt = jobDescFinishedAt.Default.(int64)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[12].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() int64)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobFields[13].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() int64)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() int64)
+	// jobDescID is the schema descriptor for id field.
+	jobDescID := jobFields[0].Descriptor()
+	// job.DefaultID holds the default value on creation for the id field.
+	job.DefaultID = jobDescID.Default.(func() uuid.UUID)
+	joinedchatMixin := schema.JoinedChat{}.Mixin()
+	joinedchatMixinFields0 := joinedchatMixin[0].Fields()
+	_ = joinedchatMixinFields0
 	joinedchatFields := schema.JoinedChat{}.Fields()
 	_ = joinedchatFields
+	// joinedchatDescWorkspaceID is the schema descriptor for workspace_id field.
+	joinedchatDescWorkspaceID := joinedchatMixinFields0[0].Descriptor()
+	// joinedchat.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	joinedchat.DefaultWorkspaceID = joinedchatDescWorkspaceID.Default.(func() uuid.UUID)
 	// joinedchatDescPlatform is the schema descriptor for platform field.
 	joinedchatDescPlatform := joinedchatFields[1].Descriptor()
 	// joinedchat.DefaultPlatform holds the default value on creation for the platform field.
@@ -241,4 +686,263 @@ func init() {
 	joinedchatDescID := joinedchatFields[0].Descriptor()
 	// joinedchat.DefaultID holds the default value on creation for the id field.
 	joinedchat.DefaultID = joinedchatDescID.Default.(func() uuid.UUID)
+	llmcacheentryFields := schema.LLMCacheEntry{}.Fields()
+	_ = llmcacheentryFields
+	// llmcacheentryDescKey is the schema descriptor for key field.
+	llmcacheentryDescKey := llmcacheentryFields[1].Descriptor()
+	// llmcacheentry.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	llmcacheentry.KeyValidator = llmcacheentryDescKey.Validators[0].(func(string) error)
+	// llmcacheentryDescStage is the schema descriptor for stage field.
+	llmcacheentryDescStage := llmcacheentryFields[2].Descriptor()
+	// llmcacheentry.DefaultStage holds the default value on creation for the stage field.
+	llmcacheentry.DefaultStage = llmcacheentryDescStage.Default.(string)
+	// llmcacheentryDescModel is the schema descriptor for model field.
+	llmcacheentryDescModel := llmcacheentryFields[3].Descriptor()
+	// llmcacheentry.DefaultModel holds the default value on creation for the model field.
+	llmcacheentry.DefaultModel = llmcacheentryDescModel.Default.(string)
+	// llmcacheentryDescPromptVersion is the schema descriptor for prompt_version field.
+	llmcacheentryDescPromptVersion := llmcacheentryFields[4].Descriptor()
+	// llmcacheentry.DefaultPromptVersion holds the default value on creation for the prompt_version field.
+	llmcacheentry.DefaultPromptVersion = llmcacheentryDescPromptVersion.Default.(string)
+	// llmcacheentryDescInputHash is the schema descriptor for input_hash field.
+	llmcacheentryDescInputHash := llmcacheentryFields[5].Descriptor()
+	// llmcacheentry.DefaultInputHash holds the default value on creation for the input_hash field.
+	llmcacheentry.DefaultInputHash = llmcacheentryDescInputHash.Default.(string)
+	// llmcacheentryDescCreatedAt is the schema descriptor for created_at field.
+	llmcacheentryDescCreatedAt := llmcacheentryFields[8].Descriptor()
+	// llmcacheentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	llmcacheentry.DefaultCreatedAt = llmcacheentryDescCreatedAt.Default.(func() int64)
+	// llmcacheentryDescID is the schema descriptor for id field.
+	llmcacheentryDescID := llmcacheentryFields[0].Descriptor()
+	// llmcacheentry.DefaultID holds the default value on creation for the id field.
+	llmcacheentry.DefaultID = llmcacheentryDescID.Default.(func() uuid.UUID)
+	owneraccountlinkMixin := schema.OwnerAccountLink{}.Mixin()
+	owneraccountlinkMixinFields0 := owneraccountlinkMixin[0].Fields()
+	_ = owneraccountlinkMixinFields0
+	owneraccountlinkFields := schema.OwnerAccountLink{}.Fields()
+	_ = owneraccountlinkFields
+	// owneraccountlinkDescWorkspaceID is the schema descriptor for workspace_id field.
+	owneraccountlinkDescWorkspaceID := owneraccountlinkMixinFields0[0].Descriptor()
+	// owneraccountlink.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	owneraccountlink.DefaultWorkspaceID = owneraccountlinkDescWorkspaceID.Default.(func() uuid.UUID)
+	// owneraccountlinkDescSubject is the schema descriptor for subject field.
+	owneraccountlinkDescSubject := owneraccountlinkFields[1].Descriptor()
+	// owneraccountlink.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	owneraccountlink.SubjectValidator = owneraccountlinkDescSubject.Validators[0].(func(string) error)
+	// owneraccountlinkDescCreatedAt is the schema descriptor for created_at field.
+	owneraccountlinkDescCreatedAt := owneraccountlinkFields[3].Descriptor()
+	// owneraccountlink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	owneraccountlink.DefaultCreatedAt = owneraccountlinkDescCreatedAt.Default.(func() int64)
+	// owneraccountlinkDescID is the schema descriptor for id field.
+	owneraccountlinkDescID := owneraccountlinkFields[0].Descriptor()
+	// owneraccountlink.DefaultID holds the default value on creation for the id field.
+	owneraccountlink.DefaultID = owneraccountlinkDescID.Default.(func() uuid.UUID)
+	personMixin := schema.Person{}.Mixin()
+	personMixinFields0 := personMixin[0].Fields()
+	_ = personMixinFields0
+	personFields := schema.Person{}.Fields()
+	_ = personFields
+	// personDescWorkspaceID is the schema descriptor for workspace_id field.
+	personDescWorkspaceID := personMixinFields0[0].Descriptor()
+	// person.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	person.DefaultWorkspaceID = personDescWorkspaceID.Default.(func() uuid.UUID)
+	// personDescDisplayName is the schema descriptor for display_name field.
+	personDescDisplayName := personFields[1].Descriptor()
+	// person.DefaultDisplayName holds the default value on creation for the display_name field.
+	person.DefaultDisplayName = personDescDisplayName.Default.(string)
+	// personDescCreatedAt is the schema descriptor for created_at field.
+	personDescCreatedAt := personFields[2].Descriptor()
+	// person.DefaultCreatedAt holds the default value on creation for the created_at field.
+	person.DefaultCreatedAt = personDescCreatedAt.Default.(func() int64)
+	// personDescUpdatedAt is the schema descriptor for updated_at field.
+	personDescUpdatedAt := personFields[3].Descriptor()
+	// person.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	person.DefaultUpdatedAt = personDescUpdatedAt.Default.(func() int64)
+	// person.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	person.UpdateDefaultUpdatedAt = personDescUpdatedAt.UpdateDefault.(func() int64)
+	// personDescID is the schema descriptor for id field.
+	personDescID := personFields[0].Descriptor()
+	// person.DefaultID holds the default value on creation for the id field.
+	person.DefaultID = personDescID.Default.(func() uuid.UUID)
+	personauditlogMixin := schema.PersonAuditLog{}.Mixin()
+	personauditlogMixinFields0 := personauditlogMixin[0].Fields()
+	_ = personauditlogMixinFields0
+	personauditlogFields := schema.PersonAuditLog{}.Fields()
+	_ = personauditlogFields
+	// personauditlogDescWorkspaceID is the schema descriptor for workspace_id field.
+	personauditlogDescWorkspaceID := personauditlogMixinFields0[0].Descriptor()
+	// personauditlog.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	personauditlog.DefaultWorkspaceID = personauditlogDescWorkspaceID.Default.(func() uuid.UUID)
+	// personauditlogDescSourcePersonIds is the schema descriptor for source_person_ids field.
+	personauditlogDescSourcePersonIds := personauditlogFields[3].Descriptor()
+	// personauditlog.DefaultSourcePersonIds holds the default value on creation for the source_person_ids field.
+	personauditlog.DefaultSourcePersonIds = personauditlogDescSourcePersonIds.Default.([]uuid.UUID)
+	// personauditlogDescIdentityIds is the schema descriptor for identity_ids field.
+	personauditlogDescIdentityIds := personauditlogFields[4].Descriptor()
+	// personauditlog.DefaultIdentityIds holds the default value on creation for the identity_ids field.
+	personauditlog.DefaultIdentityIds = personauditlogDescIdentityIds.Default.([]uuid.UUID)
+	// personauditlogDescChatIds is the schema descriptor for chat_ids field.
+	personauditlogDescChatIds := personauditlogFields[5].Descriptor()
+	// personauditlog.DefaultChatIds holds the default value on creation for the chat_ids field.
+	personauditlog.DefaultChatIds = personauditlogDescChatIds.Default.([]string)
+	// personauditlogDescActor is the schema descriptor for actor field.
+	personauditlogDescActor := personauditlogFields[6].Descriptor()
+	// personauditlog.DefaultActor holds the default value on creation for the actor field.
+	personauditlog.DefaultActor = personauditlogDescActor.Default.(string)
+	// personauditlogDescReason is the schema descriptor for reason field.
+	personauditlogDescReason := personauditlogFields[7].Descriptor()
+	// personauditlog.DefaultReason holds the default value on creation for the reason field.
+	personauditlog.DefaultReason = personauditlogDescReason.Default.(string)
+	// personauditlogDescScore is the schema descriptor for score field.
+	personauditlogDescScore := personauditlogFields[9].Descriptor()
+	// personauditlog.DefaultScore holds the default value on creation for the score field.
+	personauditlog.DefaultScore = personauditlogDescScore.Default.(float64)
+	// personauditlogDescCreatedAt is the schema descriptor for created_at field.
+	personauditlogDescCreatedAt := personauditlogFields[10].Descriptor()
+	// personauditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	personauditlog.DefaultCreatedAt = personauditlogDescCreatedAt.Default.(func() int64)
+	// personauditlogDescID is the schema descriptor for id field.
+	personauditlogDescID := personauditlogFields[0].Descriptor()
+	// personauditlog.DefaultID holds the default value on creation for the id field.
+	personauditlog.DefaultID = personauditlogDescID.Default.(func() uuid.UUID)
+	profileMixin := schema.Profile{}.Mixin()
+	profileMixinFields0 := profileMixin[0].Fields()
+	_ = profileMixinFields0
+	profileFields := schema.Profile{}.Fields()
+	_ = profileFields
+	// profileDescWorkspaceID is the schema descriptor for workspace_id field.
+	profileDescWorkspaceID := profileMixinFields0[0].Descriptor()
+	// profile.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	profile.DefaultWorkspaceID = profileDescWorkspaceID.Default.(func() uuid.UUID)
+	// profileDescVersion is the schema descriptor for version field.
+	profileDescVersion := profileFields[2].Descriptor()
+	// profile.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	profile.VersionValidator = profileDescVersion.Validators[0].(func(int) error)
+	// profileDescClaims is the schema descriptor for claims field.
+	profileDescClaims := profileFields[3].Descriptor()
+	// profile.DefaultClaims holds the default value on creation for the claims field.
+	profile.DefaultClaims = profileDescClaims.Default.([]schema.ProfileClaim)
+	// profileDescContent is the schema descriptor for content field.
+	profileDescContent := profileFields[4].Descriptor()
+	// profile.DefaultContent holds the default value on creation for the content field.
+	profile.DefaultContent = profileDescContent.Default.(string)
+	// profileDescEventCount is the schema descriptor for event_count field.
+	profileDescEventCount := profileFields[5].Descriptor()
+	// profile.DefaultEventCount holds the default value on creation for the event_count field.
+	profile.DefaultEventCount = profileDescEventCount.Default.(int)
+	// profileDescEventsUpdatedUntil is the schema descriptor for events_updated_until field.
+	profileDescEventsUpdatedUntil := profileFields[6].Descriptor()
+	// profile.DefaultEventsUpdatedUntil holds the default value on creation for the events_updated_until field.
+	profile.DefaultEventsUpdatedUntil = profileDescEventsUpdatedUntil.Default.(int64)
+	// profileDescEventsUpdatedUntilID is the schema descriptor for events_updated_until_id field.
+	profileDescEventsUpdatedUntilID := profileFields[7].Descriptor()
+	// profile.DefaultEventsUpdatedUntilID holds the default value on creation for the events_updated_until_id field.
+	profile.DefaultEventsUpdatedUntilID = profileDescEventsUpdatedUntilID.Default.(func() uuid.UUID)
+	// profileDescModel is the schema descriptor for model field.
+	profileDescModel := profileFields[8].Descriptor()
+	// profile.DefaultModel holds the default value on creation for the model field.
+	profile.DefaultModel = profileDescModel.Default.(string)
+	// profileDescCreatedAt is the schema descriptor for created_at field.
+	profileDescCreatedAt := profileFields[9].Descriptor()
+	// profile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	profile.DefaultCreatedAt = profileDescCreatedAt.Default.(func() int64)
+	// profileDescID is the schema descriptor for id field.
+	profileDescID := profileFields[0].Descriptor()
+	// profile.DefaultID holds the default value on creation for the id field.
+	profile.DefaultID = profileDescID.Default.(func() uuid.UUID)
+	summaryMixin := schema.Summary{}.Mixin()
+	summaryMixinFields0 := summaryMixin[0].Fields()
+	_ = summaryMixinFields0
+	summaryFields := schema.Summary{}.Fields()
+	_ = summaryFields
+	// summaryDescWorkspaceID is the schema descriptor for workspace_id field.
+	summaryDescWorkspaceID := summaryMixinFields0[0].Descriptor()
+	// summary.DefaultWorkspaceID holds the default value on creation for the workspace_id field.
+	summary.DefaultWorkspaceID = summaryDescWorkspaceID.Default.(func() uuid.UUID)
+	// summaryDescPlatform is the schema descriptor for platform field.
+	summaryDescPlatform := summaryFields[1].Descriptor()
+	// summary.DefaultPlatform holds the default value on creation for the platform field.
+	summary.DefaultPlatform = summaryDescPlatform.Default.(string)
+	// summaryDescInChatID is the schema descriptor for in_chat_id field.
+	summaryDescInChatID := summaryFields[2].Descriptor()
+	// summary.DefaultInChatID holds the default value on creation for the in_chat_id field.
+	summary.DefaultInChatID = summaryDescInChatID.Default.(string)
+	// summary.InChatIDValidator is a validator for the "in_chat_id" field. It is called by the builders before save.
+	summary.InChatIDValidator = summaryDescInChatID.Validators[0].(func(string) error)
+	// summaryDescInChatType is the schema descriptor for in_chat_type field.
+	summaryDescInChatType := summaryFields[3].Descriptor()
+	// summary.DefaultInChatType holds the default value on creation for the in_chat_type field.
+	summary.DefaultInChatType = summaryDescInChatType.Default.(string)
+	// summaryDescContent is the schema descriptor for content field.
+	summaryDescContent := summaryFields[4].Descriptor()
+	// summary.DefaultContent holds the default value on creation for the content field.
+	summary.DefaultContent = summaryDescContent.Default.(string)
+	// summaryDescModel is the schema descriptor for model field.
+	summaryDescModel := summaryFields[5].Descriptor()
+	// summary.DefaultModel holds the default value on creation for the model field.
+	summary.DefaultModel = summaryDescModel.Default.(string)
+	// summaryDescMessageCount is the schema descriptor for message_count field.
+	summaryDescMessageCount := summaryFields[6].Descriptor()
+	// summary.DefaultMessageCount holds the default value on creation for the message_count field.
+	summary.DefaultMessageCount = summaryDescMessageCount.Default.(int)
+	// summaryDescSpanStart is the schema descriptor for span_start field.
+	summaryDescSpanStart := summaryFields[7].Descriptor()
+	// summary.DefaultSpanStart holds the default value on creation for the span_start field.
+	summary.DefaultSpanStart = summaryDescSpanStart.Default.(int64)
+	// summaryDescSpanEnd is the schema descriptor for span_end field.
+	summaryDescSpanEnd := summaryFields[8].Descriptor()
+	// summary.DefaultSpanEnd holds the default value on creation for the span_end field.
+	summary.DefaultSpanEnd = summaryDescSpanEnd.Default.(int64)
+	// summaryDescPlatformTimestamp is the schema descriptor for platform_timestamp field.
+	summaryDescPlatformTimestamp := summaryFields[9].Descriptor()
+	// summary.DefaultPlatformTimestamp holds the default value on creation for the platform_timestamp field.
+	summary.DefaultPlatformTimestamp = summaryDescPlatformTimestamp.Default.(int64)
+	// summaryDescCreatedAt is the schema descriptor for created_at field.
+	summaryDescCreatedAt := summaryFields[10].Descriptor()
+	// summary.DefaultCreatedAt holds the default value on creation for the created_at field.
+	summary.DefaultCreatedAt = summaryDescCreatedAt.Default.(func() int64)
+	// summaryDescUpdatedAt is the schema descriptor for updated_at field.
+	summaryDescUpdatedAt := summaryFields[11].Descriptor()
+	// summary.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	summary.DefaultUpdatedAt = summaryDescUpdatedAt.Default.(func() int64)
+	// summary.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	summary.UpdateDefaultUpdatedAt = summaryDescUpdatedAt.UpdateDefault.(func() int64)
+	// summaryDescID is the schema descriptor for id field.
+	summaryDescID := summaryFields[0].Descriptor()
+	// summary.DefaultID holds the default value on creation for the id field.
+	summary.DefaultID = summaryDescID.Default.(func() uuid.UUID)
+	workspaceFields := schema.Workspace{}.Fields()
+	_ = workspaceFields
+	// workspaceDescSlug is the schema descriptor for slug field.
+	workspaceDescSlug := workspaceFields[1].Descriptor()
+	// workspace.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	workspace.SlugValidator = workspaceDescSlug.Validators[0].(func(string) error)
+	// workspaceDescName is the schema descriptor for name field.
+	workspaceDescName := workspaceFields[2].Descriptor()
+	// workspace.DefaultName holds the default value on creation for the name field.
+	workspace.DefaultName = workspaceDescName.Default.(string)
+	// workspaceDescGraphName is the schema descriptor for graph_name field.
+	workspaceDescGraphName := workspaceFields[3].Descriptor()
+	// workspace.GraphNameValidator is a validator for the "graph_name" field. It is called by the builders before save.
+	workspace.GraphNameValidator = workspaceDescGraphName.Validators[0].(func(string) error)
+	// workspaceDescLlmMonthlyBudget is the schema descriptor for llm_monthly_budget field.
+	workspaceDescLlmMonthlyBudget := workspaceFields[5].Descriptor()
+	// workspace.DefaultLlmMonthlyBudget holds the default value on creation for the llm_monthly_budget field.
+	workspace.DefaultLlmMonthlyBudget = workspaceDescLlmMonthlyBudget.Default.(float64)
+	// workspace.LlmMonthlyBudgetValidator is a validator for the "llm_monthly_budget" field. It is called by the builders before save.
+	workspace.LlmMonthlyBudgetValidator = workspaceDescLlmMonthlyBudget.Validators[0].(func(float64) error)
+	// workspaceDescCreatedAt is the schema descriptor for created_at field.
+	workspaceDescCreatedAt := workspaceFields[6].Descriptor()
+	// workspace.DefaultCreatedAt holds the default value on creation for the created_at field.
+	workspace.DefaultCreatedAt = workspaceDescCreatedAt.Default.(func() int64)
+	// workspaceDescUpdatedAt is the schema descriptor for updated_at field.
+	workspaceDescUpdatedAt := workspaceFields[7].Descriptor()
+	// workspace.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	workspace.DefaultUpdatedAt = workspaceDescUpdatedAt.Default.(func() int64)
+	// workspace.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	workspace.UpdateDefaultUpdatedAt = workspaceDescUpdatedAt.UpdateDefault.(func() int64)
+	// workspaceDescID is the schema descriptor for id field.
+	workspaceDescID := workspaceFields[0].Descriptor()
+	// workspace.DefaultID holds the default value on creation for the id field.
+	workspace.DefaultID = workspaceDescID.Default.(func() uuid.UUID)
 }
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/summary"
+)
+
+// Summary is the model entity for the Summary schema.
+type Summary struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+	// Platform holds the value of the "platform" field.
+	Platform string `json:"platform,omitempty"`
+	// InChatID holds the value of the "in_chat_id" field.
+	InChatID string `json:"in_chat_id,omitempty"`
+	// InChatType holds the value of the "in_chat_type" field.
+	InChatType string `json:"in_chat_type,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// MessageCount holds the value of the "message_count" field.
+	MessageCount int `json:"message_count,omitempty"`
+	// SpanStart holds the value of the "span_start" field.
+	SpanStart int64 `json:"span_start,omitempty"`
+	// SpanEnd holds the value of the "span_end" field.
+	SpanEnd int64 `json:"span_end,omitempty"`
+	// PlatformTimestamp holds the value of the "platform_timestamp" field.
+	PlatformTimestamp int64 `json:"platform_timestamp,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Summary) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case summary.FieldMessageCount, summary.FieldSpanStart, summary.FieldSpanEnd, summary.FieldPlatformTimestamp, summary.FieldCreatedAt, summary.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case summary.FieldPlatform, summary.FieldInChatID, summary.FieldInChatType, summary.FieldContent, summary.FieldModel:
+			values[i] = new(sql.NullString)
+		case summary.FieldID, summary.FieldWorkspaceID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Summary fields.
+func (_m *Summary) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case summary.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case summary.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				_m.WorkspaceID = *value
+			}
+		case summary.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				_m.Platform = value.String
+			}
+		case summary.FieldInChatID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field in_chat_id", values[i])
+			} else if value.Valid {
+				_m.InChatID = value.String
+			}
+		case summary.FieldInChatType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field in_chat_type", values[i])
+			} else if value.Valid {
+				_m.InChatType = value.String
+			}
+		case summary.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case summary.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case summary.FieldMessageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_count", values[i])
+			} else if value.Valid {
+				_m.MessageCount = int(value.Int64)
+			}
+		case summary.FieldSpanStart:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field span_start", values[i])
+			} else if value.Valid {
+				_m.SpanStart = value.Int64
+			}
+		case summary.FieldSpanEnd:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field span_end", values[i])
+			} else if value.Valid {
+				_m.SpanEnd = value.Int64
+			}
+		case summary.FieldPlatformTimestamp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_timestamp", values[i])
+			} else if value.Valid {
+				_m.PlatformTimestamp = value.Int64
+			}
+		case summary.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case summary.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Summary.
+// This includes values selected through modifiers, order, etc.
+func (_m *Summary) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Summary.
+// Note that you need to call Summary.Unwrap() before calling this method if this Summary
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Summary) Update() *SummaryUpdateOne {
+	return NewSummaryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Summary entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Summary) Unwrap() *Summary {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Summary is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Summary) String() string {
+	var builder strings.Builder
+	builder.WriteString("Summary(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(_m.Platform)
+	builder.WriteString(", ")
+	builder.WriteString("in_chat_id=")
+	builder.WriteString(_m.InChatID)
+	builder.WriteString(", ")
+	builder.WriteString("in_chat_type=")
+	builder.WriteString(_m.InChatType)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("message_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageCount))
+	builder.WriteString(", ")
+	builder.WriteString("span_start=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SpanStart))
+	builder.WriteString(", ")
+	builder.WriteString("span_end=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SpanEnd))
+	builder.WriteString(", ")
+	builder.WriteString("platform_timestamp=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlatformTimestamp))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Summaries is a parsable slice of Summary.
+type Summaries []*Summary
@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package summary
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the summary type in the database.
+	Label = "summary"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
+	// FieldInChatID holds the string denoting the in_chat_id field in the database.
+	FieldInChatID = "in_chat_id"
+	// FieldInChatType holds the string denoting the in_chat_type field in the database.
+	FieldInChatType = "in_chat_type"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldMessageCount holds the string denoting the message_count field in the database.
+	FieldMessageCount = "message_count"
+	// FieldSpanStart holds the string denoting the span_start field in the database.
+	FieldSpanStart = "span_start"
+	// FieldSpanEnd holds the string denoting the span_end field in the database.
+	FieldSpanEnd = "span_end"
+	// FieldPlatformTimestamp holds the string denoting the platform_timestamp field in the database.
+	FieldPlatformTimestamp = "platform_timestamp"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the summary in the database.
+	Table = "summaries"
+)
+
+// Columns holds all SQL columns for summary fields.
+var Columns = []string{
+	FieldID,
+	FieldWorkspaceID,
+	FieldPlatform,
+	FieldInChatID,
+	FieldInChatType,
+	FieldContent,
+	FieldModel,
+	FieldMessageCount,
+	FieldSpanStart,
+	FieldSpanEnd,
+	FieldPlatformTimestamp,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultWorkspaceID holds the default value on creation for the "workspace_id" field.
+	DefaultWorkspaceID func() uuid.UUID
+	// DefaultPlatform holds the default value on creation for the "platform" field.
+	DefaultPlatform string
+	// DefaultInChatID holds the default value on creation for the "in_chat_id" field.
+	DefaultInChatID string
+	// InChatIDValidator is a validator for the "in_chat_id" field. It is called by the builders before save.
+	InChatIDValidator func(string) error
+	// DefaultInChatType holds the default value on creation for the "in_chat_type" field.
+	DefaultInChatType string
+	// DefaultContent holds the default value on creation for the "content" field.
+	DefaultContent string
+	// DefaultModel holds the default value on creation for the "model" field.
+	DefaultModel string
+	// DefaultMessageCount holds the default value on creation for the "message_count" field.
+	DefaultMessageCount int
+	// DefaultSpanStart holds the default value on creation for the "span_start" field.
+	DefaultSpanStart int64
+	// DefaultSpanEnd holds the default value on creation for the "span_end" field.
+	DefaultSpanEnd int64
+	// DefaultPlatformTimestamp holds the default value on creation for the "platform_timestamp" field.
+	DefaultPlatformTimestamp int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() int64
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Summary queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
+// ByInChatID orders the results by the in_chat_id field.
+func ByInChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInChatID, opts...).ToFunc()
+}
+
+// ByInChatType orders the results by the in_chat_type field.
+func ByInChatType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInChatType, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByMessageCount orders the results by the message_count field.
+func ByMessageCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageCount, opts...).ToFunc()
+}
+
+// BySpanStart orders the results by the span_start field.
+func BySpanStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpanStart, opts...).ToFunc()
+}
+
+// BySpanEnd orders the results by the span_end field.
+func BySpanEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpanEnd, opts...).ToFunc()
+}
+
+// ByPlatformTimestamp orders the results by the platform_timestamp field.
+func ByPlatformTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatformTimestamp, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,734 @@
+// Code generated by ent, DO NOT EDIT.
+
+package summary
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// Platform applies equality check predicate on the "platform" field. It's identical to PlatformEQ.
+func Platform(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldPlatform, v))
+}
+
+// InChatID applies equality check predicate on the "in_chat_id" field. It's identical to InChatIDEQ.
+func InChatID(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldInChatID, v))
+}
+
+// InChatType applies equality check predicate on the "in_chat_type" field. It's identical to InChatTypeEQ.
+func InChatType(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldInChatType, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldContent, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldModel, v))
+}
+
+// MessageCount applies equality check predicate on the "message_count" field. It's identical to MessageCountEQ.
+func MessageCount(v int) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// SpanStart applies equality check predicate on the "span_start" field. It's identical to SpanStartEQ.
+func SpanStart(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldSpanStart, v))
+}
+
+// SpanEnd applies equality check predicate on the "span_end" field. It's identical to SpanEndEQ.
+func SpanEnd(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldSpanEnd, v))
+}
+
+// PlatformTimestamp applies equality check predicate on the "platform_timestamp" field. It's identical to PlatformTimestampEQ.
+func PlatformTimestamp(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldPlatformTimestamp, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldPlatform, v))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldPlatform, v))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...string) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldPlatform, vs...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...string) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldPlatform, vs...))
+}
+
+// PlatformGT applies the GT predicate on the "platform" field.
+func PlatformGT(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldPlatform, v))
+}
+
+// PlatformGTE applies the GTE predicate on the "platform" field.
+func PlatformGTE(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldPlatform, v))
+}
+
+// PlatformLT applies the LT predicate on the "platform" field.
+func PlatformLT(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldPlatform, v))
+}
+
+// PlatformLTE applies the LTE predicate on the "platform" field.
+func PlatformLTE(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldPlatform, v))
+}
+
+// PlatformContains applies the Contains predicate on the "platform" field.
+func PlatformContains(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldContains(FieldPlatform, v))
+}
+
+// PlatformHasPrefix applies the HasPrefix predicate on the "platform" field.
+func PlatformHasPrefix(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldHasPrefix(FieldPlatform, v))
+}
+
+// PlatformHasSuffix applies the HasSuffix predicate on the "platform" field.
+func PlatformHasSuffix(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldHasSuffix(FieldPlatform, v))
+}
+
+// PlatformEqualFold applies the EqualFold predicate on the "platform" field.
+func PlatformEqualFold(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEqualFold(FieldPlatform, v))
+}
+
+// PlatformContainsFold applies the ContainsFold predicate on the "platform" field.
+func PlatformContainsFold(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldContainsFold(FieldPlatform, v))
+}
+
+// InChatIDEQ applies the EQ predicate on the "in_chat_id" field.
+func InChatIDEQ(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldInChatID, v))
+}
+
+// InChatIDNEQ applies the NEQ predicate on the "in_chat_id" field.
+func InChatIDNEQ(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldInChatID, v))
+}
+
+// InChatIDIn applies the In predicate on the "in_chat_id" field.
+func InChatIDIn(vs ...string) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldInChatID, vs...))
+}
+
+// InChatIDNotIn applies the NotIn predicate on the "in_chat_id" field.
+func InChatIDNotIn(vs ...string) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldInChatID, vs...))
+}
+
+// InChatIDGT applies the GT predicate on the "in_chat_id" field.
+func InChatIDGT(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldInChatID, v))
+}
+
+// InChatIDGTE applies the GTE predicate on the "in_chat_id" field.
+func InChatIDGTE(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldInChatID, v))
+}
+
+// InChatIDLT applies the LT predicate on the "in_chat_id" field.
+func InChatIDLT(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldInChatID, v))
+}
+
+// InChatIDLTE applies the LTE predicate on the "in_chat_id" field.
+func InChatIDLTE(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldInChatID, v))
+}
+
+// InChatIDContains applies the Contains predicate on the "in_chat_id" field.
+func InChatIDContains(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldContains(FieldInChatID, v))
+}
+
+// InChatIDHasPrefix applies the HasPrefix predicate on the "in_chat_id" field.
+func InChatIDHasPrefix(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldHasPrefix(FieldInChatID, v))
+}
+
+// InChatIDHasSuffix applies the HasSuffix predicate on the "in_chat_id" field.
+func InChatIDHasSuffix(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldHasSuffix(FieldInChatID, v))
+}
+
+// InChatIDEqualFold applies the EqualFold predicate on the "in_chat_id" field.
+func InChatIDEqualFold(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEqualFold(FieldInChatID, v))
+}
+
+// InChatIDContainsFold applies the ContainsFold predicate on the "in_chat_id" field.
+func InChatIDContainsFold(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldContainsFold(FieldInChatID, v))
+}
+
+// InChatTypeEQ applies the EQ predicate on the "in_chat_type" field.
+func InChatTypeEQ(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldInChatType, v))
+}
+
+// InChatTypeNEQ applies the NEQ predicate on the "in_chat_type" field.
+func InChatTypeNEQ(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldInChatType, v))
+}
+
+// InChatTypeIn applies the In predicate on the "in_chat_type" field.
+func InChatTypeIn(vs ...string) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldInChatType, vs...))
+}
+
+// InChatTypeNotIn applies the NotIn predicate on the "in_chat_type" field.
+func InChatTypeNotIn(vs ...string) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldInChatType, vs...))
+}
+
+// InChatTypeGT applies the GT predicate on the "in_chat_type" field.
+func InChatTypeGT(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldInChatType, v))
+}
+
+// InChatTypeGTE applies the GTE predicate on the "in_chat_type" field.
+func InChatTypeGTE(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldInChatType, v))
+}
+
+// InChatTypeLT applies the LT predicate on the "in_chat_type" field.
+func InChatTypeLT(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldInChatType, v))
+}
+
+// InChatTypeLTE applies the LTE predicate on the "in_chat_type" field.
+func InChatTypeLTE(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldInChatType, v))
+}
+
+// InChatTypeContains applies the Contains predicate on the "in_chat_type" field.
+func InChatTypeContains(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldContains(FieldInChatType, v))
+}
+
+// InChatTypeHasPrefix applies the HasPrefix predicate on the "in_chat_type" field.
+func InChatTypeHasPrefix(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldHasPrefix(FieldInChatType, v))
+}
+
+// InChatTypeHasSuffix applies the HasSuffix predicate on the "in_chat_type" field.
+func InChatTypeHasSuffix(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldHasSuffix(FieldInChatType, v))
+}
+
+// InChatTypeEqualFold applies the EqualFold predicate on the "in_chat_type" field.
+func InChatTypeEqualFold(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEqualFold(FieldInChatType, v))
+}
+
+// InChatTypeContainsFold applies the ContainsFold predicate on the "in_chat_type" field.
+func InChatTypeContainsFold(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldContainsFold(FieldInChatType, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldContainsFold(FieldContent, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.Summary {
+	return predicate.Summary(sql.FieldContainsFold(FieldModel, v))
+}
+
+// MessageCountEQ applies the EQ predicate on the "message_count" field.
+func MessageCountEQ(v int) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// MessageCountNEQ applies the NEQ predicate on the "message_count" field.
+func MessageCountNEQ(v int) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldMessageCount, v))
+}
+
+// MessageCountIn applies the In predicate on the "message_count" field.
+func MessageCountIn(vs ...int) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldMessageCount, vs...))
+}
+
+// MessageCountNotIn applies the NotIn predicate on the "message_count" field.
+func MessageCountNotIn(vs ...int) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldMessageCount, vs...))
+}
+
+// MessageCountGT applies the GT predicate on the "message_count" field.
+func MessageCountGT(v int) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldMessageCount, v))
+}
+
+// MessageCountGTE applies the GTE predicate on the "message_count" field.
+func MessageCountGTE(v int) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldMessageCount, v))
+}
+
+// MessageCountLT applies the LT predicate on the "message_count" field.
+func MessageCountLT(v int) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldMessageCount, v))
+}
+
+// MessageCountLTE applies the LTE predicate on the "message_count" field.
+func MessageCountLTE(v int) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldMessageCount, v))
+}
+
+// SpanStartEQ applies the EQ predicate on the "span_start" field.
+func SpanStartEQ(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldSpanStart, v))
+}
+
+// SpanStartNEQ applies the NEQ predicate on the "span_start" field.
+func SpanStartNEQ(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldSpanStart, v))
+}
+
+// SpanStartIn applies the In predicate on the "span_start" field.
+func SpanStartIn(vs ...int64) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldSpanStart, vs...))
+}
+
+// SpanStartNotIn applies the NotIn predicate on the "span_start" field.
+func SpanStartNotIn(vs ...int64) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldSpanStart, vs...))
+}
+
+// SpanStartGT applies the GT predicate on the "span_start" field.
+func SpanStartGT(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldSpanStart, v))
+}
+
+// SpanStartGTE applies the GTE predicate on the "span_start" field.
+func SpanStartGTE(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldSpanStart, v))
+}
+
+// SpanStartLT applies the LT predicate on the "span_start" field.
+func SpanStartLT(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldSpanStart, v))
+}
+
+// SpanStartLTE applies the LTE predicate on the "span_start" field.
+func SpanStartLTE(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldSpanStart, v))
+}
+
+// SpanEndEQ applies the EQ predicate on the "span_end" field.
+func SpanEndEQ(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldSpanEnd, v))
+}
+
+// SpanEndNEQ applies the NEQ predicate on the "span_end" field.
+func SpanEndNEQ(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldSpanEnd, v))
+}
+
+// SpanEndIn applies the In predicate on the "span_end" field.
+func SpanEndIn(vs ...int64) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldSpanEnd, vs...))
+}
+
+// SpanEndNotIn applies the NotIn predicate on the "span_end" field.
+func SpanEndNotIn(vs ...int64) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldSpanEnd, vs...))
+}
+
+// SpanEndGT applies the GT predicate on the "span_end" field.
+func SpanEndGT(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldSpanEnd, v))
+}
+
+// SpanEndGTE applies the GTE predicate on the "span_end" field.
+func SpanEndGTE(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldSpanEnd, v))
+}
+
+// SpanEndLT applies the LT predicate on the "span_end" field.
+func SpanEndLT(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldSpanEnd, v))
+}
+
+// SpanEndLTE applies the LTE predicate on the "span_end" field.
+func SpanEndLTE(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldSpanEnd, v))
+}
+
+// PlatformTimestampEQ applies the EQ predicate on the "platform_timestamp" field.
+func PlatformTimestampEQ(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldPlatformTimestamp, v))
+}
+
+// PlatformTimestampNEQ applies the NEQ predicate on the "platform_timestamp" field.
+func PlatformTimestampNEQ(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldPlatformTimestamp, v))
+}
+
+// PlatformTimestampIn applies the In predicate on the "platform_timestamp" field.
+func PlatformTimestampIn(vs ...int64) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldPlatformTimestamp, vs...))
+}
+
+// PlatformTimestampNotIn applies the NotIn predicate on the "platform_timestamp" field.
+func PlatformTimestampNotIn(vs ...int64) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldPlatformTimestamp, vs...))
+}
+
+// PlatformTimestampGT applies the GT predicate on the "platform_timestamp" field.
+func PlatformTimestampGT(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldPlatformTimestamp, v))
+}
+
+// PlatformTimestampGTE applies the GTE predicate on the "platform_timestamp" field.
+func PlatformTimestampGTE(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldPlatformTimestamp, v))
+}
+
+// PlatformTimestampLT applies the LT predicate on the "platform_timestamp" field.
+func PlatformTimestampLT(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldPlatformTimestamp, v))
+}
+
+// PlatformTimestampLTE applies the LTE predicate on the "platform_timestamp" field.
+func PlatformTimestampLTE(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldPlatformTimestamp, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.Summary {
+	return predicate.Summary(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.Summary {
+	return predicate.Summary(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.Summary {
+	return predicate.Summary(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Summary) predicate.Summary {
+	return predicate.Summary(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Summary) predicate.Summary {
+	return predicate.Summary(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Summary) predicate.Summary {
+	return predicate.Summary(sql.NotPredicates(p))
+}
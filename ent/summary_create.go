@@ -0,0 +1,1361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/summary"
+)
+
+// SummaryCreate is the builder for creating a Summary entity.
+type SummaryCreate struct {
+	config
+	mutation *SummaryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *SummaryCreate) SetWorkspaceID(v uuid.UUID) *SummaryCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableWorkspaceID(v *uuid.UUID) *SummaryCreate {
+	if v != nil {
+		_c.SetWorkspaceID(*v)
+	}
+	return _c
+}
+
+// SetPlatform sets the "platform" field.
+func (_c *SummaryCreate) SetPlatform(v string) *SummaryCreate {
+	_c.mutation.SetPlatform(v)
+	return _c
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillablePlatform(v *string) *SummaryCreate {
+	if v != nil {
+		_c.SetPlatform(*v)
+	}
+	return _c
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (_c *SummaryCreate) SetInChatID(v string) *SummaryCreate {
+	_c.mutation.SetInChatID(v)
+	return _c
+}
+
+// SetNillableInChatID sets the "in_chat_id" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableInChatID(v *string) *SummaryCreate {
+	if v != nil {
+		_c.SetInChatID(*v)
+	}
+	return _c
+}
+
+// SetInChatType sets the "in_chat_type" field.
+func (_c *SummaryCreate) SetInChatType(v string) *SummaryCreate {
+	_c.mutation.SetInChatType(v)
+	return _c
+}
+
+// SetNillableInChatType sets the "in_chat_type" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableInChatType(v *string) *SummaryCreate {
+	if v != nil {
+		_c.SetInChatType(*v)
+	}
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *SummaryCreate) SetContent(v string) *SummaryCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableContent(v *string) *SummaryCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *SummaryCreate) SetModel(v string) *SummaryCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableModel(v *string) *SummaryCreate {
+	if v != nil {
+		_c.SetModel(*v)
+	}
+	return _c
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_c *SummaryCreate) SetMessageCount(v int) *SummaryCreate {
+	_c.mutation.SetMessageCount(v)
+	return _c
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableMessageCount(v *int) *SummaryCreate {
+	if v != nil {
+		_c.SetMessageCount(*v)
+	}
+	return _c
+}
+
+// SetSpanStart sets the "span_start" field.
+func (_c *SummaryCreate) SetSpanStart(v int64) *SummaryCreate {
+	_c.mutation.SetSpanStart(v)
+	return _c
+}
+
+// SetNillableSpanStart sets the "span_start" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableSpanStart(v *int64) *SummaryCreate {
+	if v != nil {
+		_c.SetSpanStart(*v)
+	}
+	return _c
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (_c *SummaryCreate) SetSpanEnd(v int64) *SummaryCreate {
+	_c.mutation.SetSpanEnd(v)
+	return _c
+}
+
+// SetNillableSpanEnd sets the "span_end" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableSpanEnd(v *int64) *SummaryCreate {
+	if v != nil {
+		_c.SetSpanEnd(*v)
+	}
+	return _c
+}
+
+// SetPlatformTimestamp sets the "platform_timestamp" field.
+func (_c *SummaryCreate) SetPlatformTimestamp(v int64) *SummaryCreate {
+	_c.mutation.SetPlatformTimestamp(v)
+	return _c
+}
+
+// SetNillablePlatformTimestamp sets the "platform_timestamp" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillablePlatformTimestamp(v *int64) *SummaryCreate {
+	if v != nil {
+		_c.SetPlatformTimestamp(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SummaryCreate) SetCreatedAt(v int64) *SummaryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableCreatedAt(v *int64) *SummaryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SummaryCreate) SetUpdatedAt(v int64) *SummaryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableUpdatedAt(v *int64) *SummaryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SummaryCreate) SetID(v uuid.UUID) *SummaryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SummaryCreate) SetNillableID(v *uuid.UUID) *SummaryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SummaryMutation object of the builder.
+func (_c *SummaryCreate) Mutation() *SummaryMutation {
+	return _c.mutation
+}
+
+// Save creates the Summary in the database.
+func (_c *SummaryCreate) Save(ctx context.Context) (*Summary, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SummaryCreate) SaveX(ctx context.Context) *Summary {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SummaryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SummaryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SummaryCreate) defaults() {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		v := summary.DefaultWorkspaceID()
+		_c.mutation.SetWorkspaceID(v)
+	}
+	if _, ok := _c.mutation.Platform(); !ok {
+		v := summary.DefaultPlatform
+		_c.mutation.SetPlatform(v)
+	}
+	if _, ok := _c.mutation.InChatID(); !ok {
+		v := summary.DefaultInChatID
+		_c.mutation.SetInChatID(v)
+	}
+	if _, ok := _c.mutation.InChatType(); !ok {
+		v := summary.DefaultInChatType
+		_c.mutation.SetInChatType(v)
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		v := summary.DefaultContent
+		_c.mutation.SetContent(v)
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		v := summary.DefaultModel
+		_c.mutation.SetModel(v)
+	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		v := summary.DefaultMessageCount
+		_c.mutation.SetMessageCount(v)
+	}
+	if _, ok := _c.mutation.SpanStart(); !ok {
+		v := summary.DefaultSpanStart
+		_c.mutation.SetSpanStart(v)
+	}
+	if _, ok := _c.mutation.SpanEnd(); !ok {
+		v := summary.DefaultSpanEnd
+		_c.mutation.SetSpanEnd(v)
+	}
+	if _, ok := _c.mutation.PlatformTimestamp(); !ok {
+		v := summary.DefaultPlatformTimestamp
+		_c.mutation.SetPlatformTimestamp(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := summary.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := summary.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := summary.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SummaryCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Summary.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "Summary.platform"`)}
+	}
+	if _, ok := _c.mutation.InChatID(); !ok {
+		return &ValidationError{Name: "in_chat_id", err: errors.New(`ent: missing required field "Summary.in_chat_id"`)}
+	}
+	if v, ok := _c.mutation.InChatID(); ok {
+		if err := summary.InChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "in_chat_id", err: fmt.Errorf(`ent: validator failed for field "Summary.in_chat_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InChatType(); !ok {
+		return &ValidationError{Name: "in_chat_type", err: errors.New(`ent: missing required field "Summary.in_chat_type"`)}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Summary.content"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "Summary.model"`)}
+	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		return &ValidationError{Name: "message_count", err: errors.New(`ent: missing required field "Summary.message_count"`)}
+	}
+	if _, ok := _c.mutation.SpanStart(); !ok {
+		return &ValidationError{Name: "span_start", err: errors.New(`ent: missing required field "Summary.span_start"`)}
+	}
+	if _, ok := _c.mutation.SpanEnd(); !ok {
+		return &ValidationError{Name: "span_end", err: errors.New(`ent: missing required field "Summary.span_end"`)}
+	}
+	if _, ok := _c.mutation.PlatformTimestamp(); !ok {
+		return &ValidationError{Name: "platform_timestamp", err: errors.New(`ent: missing required field "Summary.platform_timestamp"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Summary.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Summary.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SummaryCreate) sqlSave(ctx context.Context) (*Summary, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SummaryCreate) createSpec() (*Summary, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Summary{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(summary.Table, sqlgraph.NewFieldSpec(summary.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.Summary
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.WorkspaceID(); ok {
+		_spec.SetField(summary.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = value
+	}
+	if value, ok := _c.mutation.Platform(); ok {
+		_spec.SetField(summary.FieldPlatform, field.TypeString, value)
+		_node.Platform = value
+	}
+	if value, ok := _c.mutation.InChatID(); ok {
+		_spec.SetField(summary.FieldInChatID, field.TypeString, value)
+		_node.InChatID = value
+	}
+	if value, ok := _c.mutation.InChatType(); ok {
+		_spec.SetField(summary.FieldInChatType, field.TypeString, value)
+		_node.InChatType = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(summary.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(summary.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.MessageCount(); ok {
+		_spec.SetField(summary.FieldMessageCount, field.TypeInt, value)
+		_node.MessageCount = value
+	}
+	if value, ok := _c.mutation.SpanStart(); ok {
+		_spec.SetField(summary.FieldSpanStart, field.TypeInt64, value)
+		_node.SpanStart = value
+	}
+	if value, ok := _c.mutation.SpanEnd(); ok {
+		_spec.SetField(summary.FieldSpanEnd, field.TypeInt64, value)
+		_node.SpanEnd = value
+	}
+	if value, ok := _c.mutation.PlatformTimestamp(); ok {
+		_spec.SetField(summary.FieldPlatformTimestamp, field.TypeInt64, value)
+		_node.PlatformTimestamp = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(summary.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(summary.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Summary.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SummaryUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SummaryCreate) OnConflict(opts ...sql.ConflictOption) *SummaryUpsertOne {
+	_c.conflict = opts
+	return &SummaryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Summary.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SummaryCreate) OnConflictColumns(columns ...string) *SummaryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SummaryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SummaryUpsertOne is the builder for "upsert"-ing
+	//  one Summary node.
+	SummaryUpsertOne struct {
+		create *SummaryCreate
+	}
+
+	// SummaryUpsert is the "OnConflict" setter.
+	SummaryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *SummaryUpsert) SetWorkspaceID(v uuid.UUID) *SummaryUpsert {
+	u.Set(summary.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdateWorkspaceID() *SummaryUpsert {
+	u.SetExcluded(summary.FieldWorkspaceID)
+	return u
+}
+
+// SetPlatform sets the "platform" field.
+func (u *SummaryUpsert) SetPlatform(v string) *SummaryUpsert {
+	u.Set(summary.FieldPlatform, v)
+	return u
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdatePlatform() *SummaryUpsert {
+	u.SetExcluded(summary.FieldPlatform)
+	return u
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (u *SummaryUpsert) SetInChatID(v string) *SummaryUpsert {
+	u.Set(summary.FieldInChatID, v)
+	return u
+}
+
+// UpdateInChatID sets the "in_chat_id" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdateInChatID() *SummaryUpsert {
+	u.SetExcluded(summary.FieldInChatID)
+	return u
+}
+
+// SetInChatType sets the "in_chat_type" field.
+func (u *SummaryUpsert) SetInChatType(v string) *SummaryUpsert {
+	u.Set(summary.FieldInChatType, v)
+	return u
+}
+
+// UpdateInChatType sets the "in_chat_type" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdateInChatType() *SummaryUpsert {
+	u.SetExcluded(summary.FieldInChatType)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *SummaryUpsert) SetContent(v string) *SummaryUpsert {
+	u.Set(summary.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdateContent() *SummaryUpsert {
+	u.SetExcluded(summary.FieldContent)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *SummaryUpsert) SetModel(v string) *SummaryUpsert {
+	u.Set(summary.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdateModel() *SummaryUpsert {
+	u.SetExcluded(summary.FieldModel)
+	return u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *SummaryUpsert) SetMessageCount(v int) *SummaryUpsert {
+	u.Set(summary.FieldMessageCount, v)
+	return u
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdateMessageCount() *SummaryUpsert {
+	u.SetExcluded(summary.FieldMessageCount)
+	return u
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *SummaryUpsert) AddMessageCount(v int) *SummaryUpsert {
+	u.Add(summary.FieldMessageCount, v)
+	return u
+}
+
+// SetSpanStart sets the "span_start" field.
+func (u *SummaryUpsert) SetSpanStart(v int64) *SummaryUpsert {
+	u.Set(summary.FieldSpanStart, v)
+	return u
+}
+
+// UpdateSpanStart sets the "span_start" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdateSpanStart() *SummaryUpsert {
+	u.SetExcluded(summary.FieldSpanStart)
+	return u
+}
+
+// AddSpanStart adds v to the "span_start" field.
+func (u *SummaryUpsert) AddSpanStart(v int64) *SummaryUpsert {
+	u.Add(summary.FieldSpanStart, v)
+	return u
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (u *SummaryUpsert) SetSpanEnd(v int64) *SummaryUpsert {
+	u.Set(summary.FieldSpanEnd, v)
+	return u
+}
+
+// UpdateSpanEnd sets the "span_end" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdateSpanEnd() *SummaryUpsert {
+	u.SetExcluded(summary.FieldSpanEnd)
+	return u
+}
+
+// AddSpanEnd adds v to the "span_end" field.
+func (u *SummaryUpsert) AddSpanEnd(v int64) *SummaryUpsert {
+	u.Add(summary.FieldSpanEnd, v)
+	return u
+}
+
+// SetPlatformTimestamp sets the "platform_timestamp" field.
+func (u *SummaryUpsert) SetPlatformTimestamp(v int64) *SummaryUpsert {
+	u.Set(summary.FieldPlatformTimestamp, v)
+	return u
+}
+
+// UpdatePlatformTimestamp sets the "platform_timestamp" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdatePlatformTimestamp() *SummaryUpsert {
+	u.SetExcluded(summary.FieldPlatformTimestamp)
+	return u
+}
+
+// AddPlatformTimestamp adds v to the "platform_timestamp" field.
+func (u *SummaryUpsert) AddPlatformTimestamp(v int64) *SummaryUpsert {
+	u.Add(summary.FieldPlatformTimestamp, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SummaryUpsert) SetCreatedAt(v int64) *SummaryUpsert {
+	u.Set(summary.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdateCreatedAt() *SummaryUpsert {
+	u.SetExcluded(summary.FieldCreatedAt)
+	return u
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *SummaryUpsert) AddCreatedAt(v int64) *SummaryUpsert {
+	u.Add(summary.FieldCreatedAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SummaryUpsert) SetUpdatedAt(v int64) *SummaryUpsert {
+	u.Set(summary.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SummaryUpsert) UpdateUpdatedAt() *SummaryUpsert {
+	u.SetExcluded(summary.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *SummaryUpsert) AddUpdatedAt(v int64) *SummaryUpsert {
+	u.Add(summary.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Summary.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(summary.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SummaryUpsertOne) UpdateNewValues() *SummaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(summary.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Summary.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SummaryUpsertOne) Ignore() *SummaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SummaryUpsertOne) DoNothing() *SummaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SummaryCreate.OnConflict
+// documentation for more info.
+func (u *SummaryUpsertOne) Update(set func(*SummaryUpsert)) *SummaryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SummaryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *SummaryUpsertOne) SetWorkspaceID(v uuid.UUID) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdateWorkspaceID() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetPlatform sets the "platform" field.
+func (u *SummaryUpsertOne) SetPlatform(v string) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdatePlatform() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (u *SummaryUpsertOne) SetInChatID(v string) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetInChatID(v)
+	})
+}
+
+// UpdateInChatID sets the "in_chat_id" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdateInChatID() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateInChatID()
+	})
+}
+
+// SetInChatType sets the "in_chat_type" field.
+func (u *SummaryUpsertOne) SetInChatType(v string) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetInChatType(v)
+	})
+}
+
+// UpdateInChatType sets the "in_chat_type" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdateInChatType() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateInChatType()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *SummaryUpsertOne) SetContent(v string) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdateContent() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *SummaryUpsertOne) SetModel(v string) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdateModel() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *SummaryUpsertOne) SetMessageCount(v int) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetMessageCount(v)
+	})
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *SummaryUpsertOne) AddMessageCount(v int) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddMessageCount(v)
+	})
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdateMessageCount() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateMessageCount()
+	})
+}
+
+// SetSpanStart sets the "span_start" field.
+func (u *SummaryUpsertOne) SetSpanStart(v int64) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetSpanStart(v)
+	})
+}
+
+// AddSpanStart adds v to the "span_start" field.
+func (u *SummaryUpsertOne) AddSpanStart(v int64) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddSpanStart(v)
+	})
+}
+
+// UpdateSpanStart sets the "span_start" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdateSpanStart() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateSpanStart()
+	})
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (u *SummaryUpsertOne) SetSpanEnd(v int64) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetSpanEnd(v)
+	})
+}
+
+// AddSpanEnd adds v to the "span_end" field.
+func (u *SummaryUpsertOne) AddSpanEnd(v int64) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddSpanEnd(v)
+	})
+}
+
+// UpdateSpanEnd sets the "span_end" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdateSpanEnd() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateSpanEnd()
+	})
+}
+
+// SetPlatformTimestamp sets the "platform_timestamp" field.
+func (u *SummaryUpsertOne) SetPlatformTimestamp(v int64) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetPlatformTimestamp(v)
+	})
+}
+
+// AddPlatformTimestamp adds v to the "platform_timestamp" field.
+func (u *SummaryUpsertOne) AddPlatformTimestamp(v int64) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddPlatformTimestamp(v)
+	})
+}
+
+// UpdatePlatformTimestamp sets the "platform_timestamp" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdatePlatformTimestamp() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdatePlatformTimestamp()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SummaryUpsertOne) SetCreatedAt(v int64) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *SummaryUpsertOne) AddCreatedAt(v int64) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdateCreatedAt() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SummaryUpsertOne) SetUpdatedAt(v int64) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *SummaryUpsertOne) AddUpdatedAt(v int64) *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SummaryUpsertOne) UpdateUpdatedAt() *SummaryUpsertOne {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SummaryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SummaryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SummaryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SummaryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SummaryUpsertOne.ID is not supported by MySQL driver. Use SummaryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SummaryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SummaryCreateBulk is the builder for creating many Summary entities in bulk.
+type SummaryCreateBulk struct {
+	config
+	err      error
+	builders []*SummaryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Summary entities in the database.
+func (_c *SummaryCreateBulk) Save(ctx context.Context) ([]*Summary, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Summary, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SummaryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SummaryCreateBulk) SaveX(ctx context.Context) []*Summary {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SummaryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SummaryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Summary.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SummaryUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SummaryCreateBulk) OnConflict(opts ...sql.ConflictOption) *SummaryUpsertBulk {
+	_c.conflict = opts
+	return &SummaryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Summary.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SummaryCreateBulk) OnConflictColumns(columns ...string) *SummaryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SummaryUpsertBulk{
+		create: _c,
+	}
+}
+
+// SummaryUpsertBulk is the builder for "upsert"-ing
+// a bulk of Summary nodes.
+type SummaryUpsertBulk struct {
+	create *SummaryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Summary.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(summary.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SummaryUpsertBulk) UpdateNewValues() *SummaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(summary.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Summary.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SummaryUpsertBulk) Ignore() *SummaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SummaryUpsertBulk) DoNothing() *SummaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SummaryCreateBulk.OnConflict
+// documentation for more info.
+func (u *SummaryUpsertBulk) Update(set func(*SummaryUpsert)) *SummaryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SummaryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *SummaryUpsertBulk) SetWorkspaceID(v uuid.UUID) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdateWorkspaceID() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// SetPlatform sets the "platform" field.
+func (u *SummaryUpsertBulk) SetPlatform(v string) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdatePlatform() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (u *SummaryUpsertBulk) SetInChatID(v string) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetInChatID(v)
+	})
+}
+
+// UpdateInChatID sets the "in_chat_id" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdateInChatID() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateInChatID()
+	})
+}
+
+// SetInChatType sets the "in_chat_type" field.
+func (u *SummaryUpsertBulk) SetInChatType(v string) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetInChatType(v)
+	})
+}
+
+// UpdateInChatType sets the "in_chat_type" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdateInChatType() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateInChatType()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *SummaryUpsertBulk) SetContent(v string) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdateContent() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *SummaryUpsertBulk) SetModel(v string) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdateModel() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *SummaryUpsertBulk) SetMessageCount(v int) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetMessageCount(v)
+	})
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *SummaryUpsertBulk) AddMessageCount(v int) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddMessageCount(v)
+	})
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdateMessageCount() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateMessageCount()
+	})
+}
+
+// SetSpanStart sets the "span_start" field.
+func (u *SummaryUpsertBulk) SetSpanStart(v int64) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetSpanStart(v)
+	})
+}
+
+// AddSpanStart adds v to the "span_start" field.
+func (u *SummaryUpsertBulk) AddSpanStart(v int64) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddSpanStart(v)
+	})
+}
+
+// UpdateSpanStart sets the "span_start" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdateSpanStart() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateSpanStart()
+	})
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (u *SummaryUpsertBulk) SetSpanEnd(v int64) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetSpanEnd(v)
+	})
+}
+
+// AddSpanEnd adds v to the "span_end" field.
+func (u *SummaryUpsertBulk) AddSpanEnd(v int64) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddSpanEnd(v)
+	})
+}
+
+// UpdateSpanEnd sets the "span_end" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdateSpanEnd() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateSpanEnd()
+	})
+}
+
+// SetPlatformTimestamp sets the "platform_timestamp" field.
+func (u *SummaryUpsertBulk) SetPlatformTimestamp(v int64) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetPlatformTimestamp(v)
+	})
+}
+
+// AddPlatformTimestamp adds v to the "platform_timestamp" field.
+func (u *SummaryUpsertBulk) AddPlatformTimestamp(v int64) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddPlatformTimestamp(v)
+	})
+}
+
+// UpdatePlatformTimestamp sets the "platform_timestamp" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdatePlatformTimestamp() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdatePlatformTimestamp()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SummaryUpsertBulk) SetCreatedAt(v int64) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// AddCreatedAt adds v to the "created_at" field.
+func (u *SummaryUpsertBulk) AddCreatedAt(v int64) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdateCreatedAt() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SummaryUpsertBulk) SetUpdatedAt(v int64) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *SummaryUpsertBulk) AddUpdatedAt(v int64) *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SummaryUpsertBulk) UpdateUpdatedAt() *SummaryUpsertBulk {
+	return u.Update(func(s *SummaryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SummaryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SummaryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SummaryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SummaryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
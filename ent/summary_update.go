@@ -0,0 +1,737 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+	"github.com/luoling8192/mindwave/ent/summary"
+)
+
+// SummaryUpdate is the builder for updating Summary entities.
+type SummaryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SummaryMutation
+}
+
+// Where appends a list predicates to the SummaryUpdate builder.
+func (_u *SummaryUpdate) Where(ps ...predicate.Summary) *SummaryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *SummaryUpdate) SetWorkspaceID(v uuid.UUID) *SummaryUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillableWorkspaceID(v *uuid.UUID) *SummaryUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *SummaryUpdate) SetPlatform(v string) *SummaryUpdate {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillablePlatform(v *string) *SummaryUpdate {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (_u *SummaryUpdate) SetInChatID(v string) *SummaryUpdate {
+	_u.mutation.SetInChatID(v)
+	return _u
+}
+
+// SetNillableInChatID sets the "in_chat_id" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillableInChatID(v *string) *SummaryUpdate {
+	if v != nil {
+		_u.SetInChatID(*v)
+	}
+	return _u
+}
+
+// SetInChatType sets the "in_chat_type" field.
+func (_u *SummaryUpdate) SetInChatType(v string) *SummaryUpdate {
+	_u.mutation.SetInChatType(v)
+	return _u
+}
+
+// SetNillableInChatType sets the "in_chat_type" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillableInChatType(v *string) *SummaryUpdate {
+	if v != nil {
+		_u.SetInChatType(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *SummaryUpdate) SetContent(v string) *SummaryUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillableContent(v *string) *SummaryUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *SummaryUpdate) SetModel(v string) *SummaryUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillableModel(v *string) *SummaryUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_u *SummaryUpdate) SetMessageCount(v int) *SummaryUpdate {
+	_u.mutation.ResetMessageCount()
+	_u.mutation.SetMessageCount(v)
+	return _u
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillableMessageCount(v *int) *SummaryUpdate {
+	if v != nil {
+		_u.SetMessageCount(*v)
+	}
+	return _u
+}
+
+// AddMessageCount adds value to the "message_count" field.
+func (_u *SummaryUpdate) AddMessageCount(v int) *SummaryUpdate {
+	_u.mutation.AddMessageCount(v)
+	return _u
+}
+
+// SetSpanStart sets the "span_start" field.
+func (_u *SummaryUpdate) SetSpanStart(v int64) *SummaryUpdate {
+	_u.mutation.ResetSpanStart()
+	_u.mutation.SetSpanStart(v)
+	return _u
+}
+
+// SetNillableSpanStart sets the "span_start" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillableSpanStart(v *int64) *SummaryUpdate {
+	if v != nil {
+		_u.SetSpanStart(*v)
+	}
+	return _u
+}
+
+// AddSpanStart adds value to the "span_start" field.
+func (_u *SummaryUpdate) AddSpanStart(v int64) *SummaryUpdate {
+	_u.mutation.AddSpanStart(v)
+	return _u
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (_u *SummaryUpdate) SetSpanEnd(v int64) *SummaryUpdate {
+	_u.mutation.ResetSpanEnd()
+	_u.mutation.SetSpanEnd(v)
+	return _u
+}
+
+// SetNillableSpanEnd sets the "span_end" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillableSpanEnd(v *int64) *SummaryUpdate {
+	if v != nil {
+		_u.SetSpanEnd(*v)
+	}
+	return _u
+}
+
+// AddSpanEnd adds value to the "span_end" field.
+func (_u *SummaryUpdate) AddSpanEnd(v int64) *SummaryUpdate {
+	_u.mutation.AddSpanEnd(v)
+	return _u
+}
+
+// SetPlatformTimestamp sets the "platform_timestamp" field.
+func (_u *SummaryUpdate) SetPlatformTimestamp(v int64) *SummaryUpdate {
+	_u.mutation.ResetPlatformTimestamp()
+	_u.mutation.SetPlatformTimestamp(v)
+	return _u
+}
+
+// SetNillablePlatformTimestamp sets the "platform_timestamp" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillablePlatformTimestamp(v *int64) *SummaryUpdate {
+	if v != nil {
+		_u.SetPlatformTimestamp(*v)
+	}
+	return _u
+}
+
+// AddPlatformTimestamp adds value to the "platform_timestamp" field.
+func (_u *SummaryUpdate) AddPlatformTimestamp(v int64) *SummaryUpdate {
+	_u.mutation.AddPlatformTimestamp(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SummaryUpdate) SetCreatedAt(v int64) *SummaryUpdate {
+	_u.mutation.ResetCreatedAt()
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SummaryUpdate) SetNillableCreatedAt(v *int64) *SummaryUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *SummaryUpdate) AddCreatedAt(v int64) *SummaryUpdate {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SummaryUpdate) SetUpdatedAt(v int64) *SummaryUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *SummaryUpdate) AddUpdatedAt(v int64) *SummaryUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SummaryMutation object of the builder.
+func (_u *SummaryUpdate) Mutation() *SummaryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SummaryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SummaryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SummaryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SummaryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SummaryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := summary.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SummaryUpdate) check() error {
+	if v, ok := _u.mutation.InChatID(); ok {
+		if err := summary.InChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "in_chat_id", err: fmt.Errorf(`ent: validator failed for field "Summary.in_chat_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SummaryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(summary.Table, summary.Columns, sqlgraph.NewFieldSpec(summary.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(summary.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(summary.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InChatID(); ok {
+		_spec.SetField(summary.FieldInChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InChatType(); ok {
+		_spec.SetField(summary.FieldInChatType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(summary.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(summary.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageCount(); ok {
+		_spec.SetField(summary.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageCount(); ok {
+		_spec.AddField(summary.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SpanStart(); ok {
+		_spec.SetField(summary.FieldSpanStart, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSpanStart(); ok {
+		_spec.AddField(summary.FieldSpanStart, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SpanEnd(); ok {
+		_spec.SetField(summary.FieldSpanEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSpanEnd(); ok {
+		_spec.AddField(summary.FieldSpanEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PlatformTimestamp(); ok {
+		_spec.SetField(summary.FieldPlatformTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlatformTimestamp(); ok {
+		_spec.AddField(summary.FieldPlatformTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(summary.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(summary.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(summary.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(summary.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Summary
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{summary.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SummaryUpdateOne is the builder for updating a single Summary entity.
+type SummaryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SummaryMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *SummaryUpdateOne) SetWorkspaceID(v uuid.UUID) *SummaryUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillableWorkspaceID(v *uuid.UUID) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *SummaryUpdateOne) SetPlatform(v string) *SummaryUpdateOne {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillablePlatform(v *string) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetInChatID sets the "in_chat_id" field.
+func (_u *SummaryUpdateOne) SetInChatID(v string) *SummaryUpdateOne {
+	_u.mutation.SetInChatID(v)
+	return _u
+}
+
+// SetNillableInChatID sets the "in_chat_id" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillableInChatID(v *string) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetInChatID(*v)
+	}
+	return _u
+}
+
+// SetInChatType sets the "in_chat_type" field.
+func (_u *SummaryUpdateOne) SetInChatType(v string) *SummaryUpdateOne {
+	_u.mutation.SetInChatType(v)
+	return _u
+}
+
+// SetNillableInChatType sets the "in_chat_type" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillableInChatType(v *string) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetInChatType(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *SummaryUpdateOne) SetContent(v string) *SummaryUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillableContent(v *string) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *SummaryUpdateOne) SetModel(v string) *SummaryUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillableModel(v *string) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_u *SummaryUpdateOne) SetMessageCount(v int) *SummaryUpdateOne {
+	_u.mutation.ResetMessageCount()
+	_u.mutation.SetMessageCount(v)
+	return _u
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillableMessageCount(v *int) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetMessageCount(*v)
+	}
+	return _u
+}
+
+// AddMessageCount adds value to the "message_count" field.
+func (_u *SummaryUpdateOne) AddMessageCount(v int) *SummaryUpdateOne {
+	_u.mutation.AddMessageCount(v)
+	return _u
+}
+
+// SetSpanStart sets the "span_start" field.
+func (_u *SummaryUpdateOne) SetSpanStart(v int64) *SummaryUpdateOne {
+	_u.mutation.ResetSpanStart()
+	_u.mutation.SetSpanStart(v)
+	return _u
+}
+
+// SetNillableSpanStart sets the "span_start" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillableSpanStart(v *int64) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetSpanStart(*v)
+	}
+	return _u
+}
+
+// AddSpanStart adds value to the "span_start" field.
+func (_u *SummaryUpdateOne) AddSpanStart(v int64) *SummaryUpdateOne {
+	_u.mutation.AddSpanStart(v)
+	return _u
+}
+
+// SetSpanEnd sets the "span_end" field.
+func (_u *SummaryUpdateOne) SetSpanEnd(v int64) *SummaryUpdateOne {
+	_u.mutation.ResetSpanEnd()
+	_u.mutation.SetSpanEnd(v)
+	return _u
+}
+
+// SetNillableSpanEnd sets the "span_end" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillableSpanEnd(v *int64) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetSpanEnd(*v)
+	}
+	return _u
+}
+
+// AddSpanEnd adds value to the "span_end" field.
+func (_u *SummaryUpdateOne) AddSpanEnd(v int64) *SummaryUpdateOne {
+	_u.mutation.AddSpanEnd(v)
+	return _u
+}
+
+// SetPlatformTimestamp sets the "platform_timestamp" field.
+func (_u *SummaryUpdateOne) SetPlatformTimestamp(v int64) *SummaryUpdateOne {
+	_u.mutation.ResetPlatformTimestamp()
+	_u.mutation.SetPlatformTimestamp(v)
+	return _u
+}
+
+// SetNillablePlatformTimestamp sets the "platform_timestamp" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillablePlatformTimestamp(v *int64) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetPlatformTimestamp(*v)
+	}
+	return _u
+}
+
+// AddPlatformTimestamp adds value to the "platform_timestamp" field.
+func (_u *SummaryUpdateOne) AddPlatformTimestamp(v int64) *SummaryUpdateOne {
+	_u.mutation.AddPlatformTimestamp(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SummaryUpdateOne) SetCreatedAt(v int64) *SummaryUpdateOne {
+	_u.mutation.ResetCreatedAt()
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SummaryUpdateOne) SetNillableCreatedAt(v *int64) *SummaryUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *SummaryUpdateOne) AddCreatedAt(v int64) *SummaryUpdateOne {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SummaryUpdateOne) SetUpdatedAt(v int64) *SummaryUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *SummaryUpdateOne) AddUpdatedAt(v int64) *SummaryUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SummaryMutation object of the builder.
+func (_u *SummaryUpdateOne) Mutation() *SummaryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SummaryUpdate builder.
+func (_u *SummaryUpdateOne) Where(ps ...predicate.Summary) *SummaryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SummaryUpdateOne) Select(field string, fields ...string) *SummaryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Summary entity.
+func (_u *SummaryUpdateOne) Save(ctx context.Context) (*Summary, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SummaryUpdateOne) SaveX(ctx context.Context) *Summary {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SummaryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SummaryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SummaryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := summary.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SummaryUpdateOne) check() error {
+	if v, ok := _u.mutation.InChatID(); ok {
+		if err := summary.InChatIDValidator(v); err != nil {
+			return &ValidationError{Name: "in_chat_id", err: fmt.Errorf(`ent: validator failed for field "Summary.in_chat_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SummaryUpdateOne) sqlSave(ctx context.Context) (_node *Summary, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(summary.Table, summary.Columns, sqlgraph.NewFieldSpec(summary.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Summary.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, summary.FieldID)
+		for _, f := range fields {
+			if !summary.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != summary.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkspaceID(); ok {
+		_spec.SetField(summary.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(summary.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InChatID(); ok {
+		_spec.SetField(summary.FieldInChatID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InChatType(); ok {
+		_spec.SetField(summary.FieldInChatType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(summary.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(summary.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageCount(); ok {
+		_spec.SetField(summary.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageCount(); ok {
+		_spec.AddField(summary.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SpanStart(); ok {
+		_spec.SetField(summary.FieldSpanStart, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSpanStart(); ok {
+		_spec.AddField(summary.FieldSpanStart, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SpanEnd(); ok {
+		_spec.SetField(summary.FieldSpanEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSpanEnd(); ok {
+		_spec.AddField(summary.FieldSpanEnd, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PlatformTimestamp(); ok {
+		_spec.SetField(summary.FieldPlatformTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlatformTimestamp(); ok {
+		_spec.AddField(summary.FieldPlatformTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(summary.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(summary.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(summary.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(summary.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Summary
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &Summary{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{summary.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,14 +14,46 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// AccessLog is the client for interacting with the AccessLog builders.
+	AccessLog *AccessLogClient
+	// AskTurn is the client for interacting with the AskTurn builders.
+	AskTurn *AskTurnClient
+	// ChatGrant is the client for interacting with the ChatGrant builders.
+	ChatGrant *ChatGrantClient
 	// ChatMessage is the client for interacting with the ChatMessage builders.
 	ChatMessage *ChatMessageClient
+	// ChatRetention is the client for interacting with the ChatRetention builders.
+	ChatRetention *ChatRetentionClient
+	// ChatSchedule is the client for interacting with the ChatSchedule builders.
+	ChatSchedule *ChatScheduleClient
+	// DigestDelivery is the client for interacting with the DigestDelivery builders.
+	DigestDelivery *DigestDeliveryClient
+	// DistillRun is the client for interacting with the DistillRun builders.
+	DistillRun *DistillRunClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
 	// Identity is the client for interacting with the Identity builders.
 	Identity *IdentityClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// JoinedChat is the client for interacting with the JoinedChat builders.
 	JoinedChat *JoinedChatClient
+	// LLMCacheEntry is the client for interacting with the LLMCacheEntry builders.
+	LLMCacheEntry *LLMCacheEntryClient
+	// OwnerAccountLink is the client for interacting with the OwnerAccountLink builders.
+	OwnerAccountLink *OwnerAccountLinkClient
+	// Person is the client for interacting with the Person builders.
+	Person *PersonClient
+	// PersonAuditLog is the client for interacting with the PersonAuditLog builders.
+	PersonAuditLog *PersonAuditLogClient
+	// Profile is the client for interacting with the Profile builders.
+	Profile *ProfileClient
+	// Summary is the client for interacting with the Summary builders.
+	Summary *SummaryClient
+	// Workspace is the client for interacting with the Workspace builders.
+	Workspace *WorkspaceClient
 
 	// lazily loaded.
 	client     *Client
@@ -153,10 +185,26 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.AccessLog = NewAccessLogClient(tx.config)
+	tx.AskTurn = NewAskTurnClient(tx.config)
+	tx.ChatGrant = NewChatGrantClient(tx.config)
 	tx.ChatMessage = NewChatMessageClient(tx.config)
+	tx.ChatRetention = NewChatRetentionClient(tx.config)
+	tx.ChatSchedule = NewChatScheduleClient(tx.config)
+	tx.DigestDelivery = NewDigestDeliveryClient(tx.config)
+	tx.DistillRun = NewDistillRunClient(tx.config)
 	tx.Event = NewEventClient(tx.config)
 	tx.Identity = NewIdentityClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
 	tx.JoinedChat = NewJoinedChatClient(tx.config)
+	tx.LLMCacheEntry = NewLLMCacheEntryClient(tx.config)
+	tx.OwnerAccountLink = NewOwnerAccountLinkClient(tx.config)
+	tx.Person = NewPersonClient(tx.config)
+	tx.PersonAuditLog = NewPersonAuditLogClient(tx.config)
+	tx.Profile = NewProfileClient(tx.config)
+	tx.Summary = NewSummaryClient(tx.config)
+	tx.Workspace = NewWorkspaceClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -166,7 +214,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ChatMessage.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
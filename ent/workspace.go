@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/workspace"
+)
+
+// Workspace is the model entity for the Workspace schema.
+type Workspace struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// GraphName holds the value of the "graph_name" field.
+	GraphName string `json:"graph_name,omitempty"`
+	// LlmProviders holds the value of the "llm_providers" field.
+	LlmProviders jsontext.Value `json:"llm_providers,omitempty"`
+	// LlmMonthlyBudget holds the value of the "llm_monthly_budget" field.
+	LlmMonthlyBudget float64 `json:"llm_monthly_budget,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Workspace) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workspace.FieldLlmProviders:
+			values[i] = new([]byte)
+		case workspace.FieldLlmMonthlyBudget:
+			values[i] = new(sql.NullFloat64)
+		case workspace.FieldCreatedAt, workspace.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case workspace.FieldSlug, workspace.FieldName, workspace.FieldGraphName:
+			values[i] = new(sql.NullString)
+		case workspace.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Workspace fields.
+func (_m *Workspace) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workspace.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case workspace.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = value.String
+			}
+		case workspace.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case workspace.FieldGraphName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field graph_name", values[i])
+			} else if value.Valid {
+				_m.GraphName = value.String
+			}
+		case workspace.FieldLlmProviders:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_providers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LlmProviders); err != nil {
+					return fmt.Errorf("unmarshal field llm_providers: %w", err)
+				}
+			}
+		case workspace.FieldLlmMonthlyBudget:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_monthly_budget", values[i])
+			} else if value.Valid {
+				_m.LlmMonthlyBudget = value.Float64
+			}
+		case workspace.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case workspace.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Workspace.
+// This includes values selected through modifiers, order, etc.
+func (_m *Workspace) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Workspace.
+// Note that you need to call Workspace.Unwrap() before calling this method if this Workspace
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Workspace) Update() *WorkspaceUpdateOne {
+	return NewWorkspaceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Workspace entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Workspace) Unwrap() *Workspace {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Workspace is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Workspace) String() string {
+	var builder strings.Builder
+	builder.WriteString("Workspace(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("graph_name=")
+	builder.WriteString(_m.GraphName)
+	builder.WriteString(", ")
+	builder.WriteString("llm_providers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LlmProviders))
+	builder.WriteString(", ")
+	builder.WriteString("llm_monthly_budget=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LlmMonthlyBudget))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Workspaces is a parsable slice of Workspace.
+type Workspaces []*Workspace
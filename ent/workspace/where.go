@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workspace
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Workspace {
+	return predicate.Workspace(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLTE(FieldID, id))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldSlug, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldName, v))
+}
+
+// GraphName applies equality check predicate on the "graph_name" field. It's identical to GraphNameEQ.
+func GraphName(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldGraphName, v))
+}
+
+// LlmMonthlyBudget applies equality check predicate on the "llm_monthly_budget" field. It's identical to LlmMonthlyBudgetEQ.
+func LlmMonthlyBudget(v float64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldLlmMonthlyBudget, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldContainsFold(FieldName, v))
+}
+
+// GraphNameEQ applies the EQ predicate on the "graph_name" field.
+func GraphNameEQ(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldGraphName, v))
+}
+
+// GraphNameNEQ applies the NEQ predicate on the "graph_name" field.
+func GraphNameNEQ(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNEQ(FieldGraphName, v))
+}
+
+// GraphNameIn applies the In predicate on the "graph_name" field.
+func GraphNameIn(vs ...string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldIn(FieldGraphName, vs...))
+}
+
+// GraphNameNotIn applies the NotIn predicate on the "graph_name" field.
+func GraphNameNotIn(vs ...string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNotIn(FieldGraphName, vs...))
+}
+
+// GraphNameGT applies the GT predicate on the "graph_name" field.
+func GraphNameGT(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGT(FieldGraphName, v))
+}
+
+// GraphNameGTE applies the GTE predicate on the "graph_name" field.
+func GraphNameGTE(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGTE(FieldGraphName, v))
+}
+
+// GraphNameLT applies the LT predicate on the "graph_name" field.
+func GraphNameLT(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLT(FieldGraphName, v))
+}
+
+// GraphNameLTE applies the LTE predicate on the "graph_name" field.
+func GraphNameLTE(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLTE(FieldGraphName, v))
+}
+
+// GraphNameContains applies the Contains predicate on the "graph_name" field.
+func GraphNameContains(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldContains(FieldGraphName, v))
+}
+
+// GraphNameHasPrefix applies the HasPrefix predicate on the "graph_name" field.
+func GraphNameHasPrefix(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldHasPrefix(FieldGraphName, v))
+}
+
+// GraphNameHasSuffix applies the HasSuffix predicate on the "graph_name" field.
+func GraphNameHasSuffix(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldHasSuffix(FieldGraphName, v))
+}
+
+// GraphNameEqualFold applies the EqualFold predicate on the "graph_name" field.
+func GraphNameEqualFold(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEqualFold(FieldGraphName, v))
+}
+
+// GraphNameContainsFold applies the ContainsFold predicate on the "graph_name" field.
+func GraphNameContainsFold(v string) predicate.Workspace {
+	return predicate.Workspace(sql.FieldContainsFold(FieldGraphName, v))
+}
+
+// LlmProvidersIsNil applies the IsNil predicate on the "llm_providers" field.
+func LlmProvidersIsNil() predicate.Workspace {
+	return predicate.Workspace(sql.FieldIsNull(FieldLlmProviders))
+}
+
+// LlmProvidersNotNil applies the NotNil predicate on the "llm_providers" field.
+func LlmProvidersNotNil() predicate.Workspace {
+	return predicate.Workspace(sql.FieldNotNull(FieldLlmProviders))
+}
+
+// LlmMonthlyBudgetEQ applies the EQ predicate on the "llm_monthly_budget" field.
+func LlmMonthlyBudgetEQ(v float64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldLlmMonthlyBudget, v))
+}
+
+// LlmMonthlyBudgetNEQ applies the NEQ predicate on the "llm_monthly_budget" field.
+func LlmMonthlyBudgetNEQ(v float64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNEQ(FieldLlmMonthlyBudget, v))
+}
+
+// LlmMonthlyBudgetIn applies the In predicate on the "llm_monthly_budget" field.
+func LlmMonthlyBudgetIn(vs ...float64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldIn(FieldLlmMonthlyBudget, vs...))
+}
+
+// LlmMonthlyBudgetNotIn applies the NotIn predicate on the "llm_monthly_budget" field.
+func LlmMonthlyBudgetNotIn(vs ...float64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNotIn(FieldLlmMonthlyBudget, vs...))
+}
+
+// LlmMonthlyBudgetGT applies the GT predicate on the "llm_monthly_budget" field.
+func LlmMonthlyBudgetGT(v float64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGT(FieldLlmMonthlyBudget, v))
+}
+
+// LlmMonthlyBudgetGTE applies the GTE predicate on the "llm_monthly_budget" field.
+func LlmMonthlyBudgetGTE(v float64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGTE(FieldLlmMonthlyBudget, v))
+}
+
+// LlmMonthlyBudgetLT applies the LT predicate on the "llm_monthly_budget" field.
+func LlmMonthlyBudgetLT(v float64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLT(FieldLlmMonthlyBudget, v))
+}
+
+// LlmMonthlyBudgetLTE applies the LTE predicate on the "llm_monthly_budget" field.
+func LlmMonthlyBudgetLTE(v float64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLTE(FieldLlmMonthlyBudget, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Workspace) predicate.Workspace {
+	return predicate.Workspace(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Workspace) predicate.Workspace {
+	return predicate.Workspace(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Workspace) predicate.Workspace {
+	return predicate.Workspace(sql.NotPredicates(p))
+}
@@ -0,0 +1,112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workspace
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the workspace type in the database.
+	Label = "workspace"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldGraphName holds the string denoting the graph_name field in the database.
+	FieldGraphName = "graph_name"
+	// FieldLlmProviders holds the string denoting the llm_providers field in the database.
+	FieldLlmProviders = "llm_providers"
+	// FieldLlmMonthlyBudget holds the string denoting the llm_monthly_budget field in the database.
+	FieldLlmMonthlyBudget = "llm_monthly_budget"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the workspace in the database.
+	Table = "workspaces"
+)
+
+// Columns holds all SQL columns for workspace fields.
+var Columns = []string{
+	FieldID,
+	FieldSlug,
+	FieldName,
+	FieldGraphName,
+	FieldLlmProviders,
+	FieldLlmMonthlyBudget,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	SlugValidator func(string) error
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// GraphNameValidator is a validator for the "graph_name" field. It is called by the builders before save.
+	GraphNameValidator func(string) error
+	// DefaultLlmMonthlyBudget holds the default value on creation for the "llm_monthly_budget" field.
+	DefaultLlmMonthlyBudget float64
+	// LlmMonthlyBudgetValidator is a validator for the "llm_monthly_budget" field. It is called by the builders before save.
+	LlmMonthlyBudgetValidator func(float64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() int64
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Workspace queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySlug orders the results by the slug field.
+func BySlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlug, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByGraphName orders the results by the graph_name field.
+func ByGraphName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGraphName, opts...).ToFunc()
+}
+
+// ByLlmMonthlyBudget orders the results by the llm_monthly_budget field.
+func ByLlmMonthlyBudget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmMonthlyBudget, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,914 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/workspace"
+)
+
+// WorkspaceCreate is the builder for creating a Workspace entity.
+type WorkspaceCreate struct {
+	config
+	mutation *WorkspaceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSlug sets the "slug" field.
+func (_c *WorkspaceCreate) SetSlug(v string) *WorkspaceCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *WorkspaceCreate) SetName(v string) *WorkspaceCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableName(v *string) *WorkspaceCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetGraphName sets the "graph_name" field.
+func (_c *WorkspaceCreate) SetGraphName(v string) *WorkspaceCreate {
+	_c.mutation.SetGraphName(v)
+	return _c
+}
+
+// SetLlmProviders sets the "llm_providers" field.
+func (_c *WorkspaceCreate) SetLlmProviders(v jsontext.Value) *WorkspaceCreate {
+	_c.mutation.SetLlmProviders(v)
+	return _c
+}
+
+// SetLlmMonthlyBudget sets the "llm_monthly_budget" field.
+func (_c *WorkspaceCreate) SetLlmMonthlyBudget(v float64) *WorkspaceCreate {
+	_c.mutation.SetLlmMonthlyBudget(v)
+	return _c
+}
+
+// SetNillableLlmMonthlyBudget sets the "llm_monthly_budget" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableLlmMonthlyBudget(v *float64) *WorkspaceCreate {
+	if v != nil {
+		_c.SetLlmMonthlyBudget(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WorkspaceCreate) SetCreatedAt(v int64) *WorkspaceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableCreatedAt(v *int64) *WorkspaceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WorkspaceCreate) SetUpdatedAt(v int64) *WorkspaceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableUpdatedAt(v *int64) *WorkspaceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WorkspaceCreate) SetID(v uuid.UUID) *WorkspaceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableID(v *uuid.UUID) *WorkspaceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WorkspaceMutation object of the builder.
+func (_c *WorkspaceCreate) Mutation() *WorkspaceMutation {
+	return _c.mutation
+}
+
+// Save creates the Workspace in the database.
+func (_c *WorkspaceCreate) Save(ctx context.Context) (*Workspace, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WorkspaceCreate) SaveX(ctx context.Context) *Workspace {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkspaceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkspaceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WorkspaceCreate) defaults() {
+	if _, ok := _c.mutation.Name(); !ok {
+		v := workspace.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.LlmMonthlyBudget(); !ok {
+		v := workspace.DefaultLlmMonthlyBudget
+		_c.mutation.SetLlmMonthlyBudget(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := workspace.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := workspace.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := workspace.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WorkspaceCreate) check() error {
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "Workspace.slug"`)}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := workspace.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Workspace.slug": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Workspace.name"`)}
+	}
+	if _, ok := _c.mutation.GraphName(); !ok {
+		return &ValidationError{Name: "graph_name", err: errors.New(`ent: missing required field "Workspace.graph_name"`)}
+	}
+	if v, ok := _c.mutation.GraphName(); ok {
+		if err := workspace.GraphNameValidator(v); err != nil {
+			return &ValidationError{Name: "graph_name", err: fmt.Errorf(`ent: validator failed for field "Workspace.graph_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LlmMonthlyBudget(); !ok {
+		return &ValidationError{Name: "llm_monthly_budget", err: errors.New(`ent: missing required field "Workspace.llm_monthly_budget"`)}
+	}
+	if v, ok := _c.mutation.LlmMonthlyBudget(); ok {
+		if err := workspace.LlmMonthlyBudgetValidator(v); err != nil {
+			return &ValidationError{Name: "llm_monthly_budget", err: fmt.Errorf(`ent: validator failed for field "Workspace.llm_monthly_budget": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Workspace.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Workspace.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *WorkspaceCreate) sqlSave(ctx context.Context) (*Workspace, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WorkspaceCreate) createSpec() (*Workspace, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Workspace{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(workspace.Table, sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.Workspace
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(workspace.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(workspace.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.GraphName(); ok {
+		_spec.SetField(workspace.FieldGraphName, field.TypeString, value)
+		_node.GraphName = value
+	}
+	if value, ok := _c.mutation.LlmProviders(); ok {
+		_spec.SetField(workspace.FieldLlmProviders, field.TypeJSON, value)
+		_node.LlmProviders = value
+	}
+	if value, ok := _c.mutation.LlmMonthlyBudget(); ok {
+		_spec.SetField(workspace.FieldLlmMonthlyBudget, field.TypeFloat64, value)
+		_node.LlmMonthlyBudget = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(workspace.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(workspace.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Workspace.Create().
+//		SetSlug(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkspaceUpsert) {
+//			SetSlug(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WorkspaceCreate) OnConflict(opts ...sql.ConflictOption) *WorkspaceUpsertOne {
+	_c.conflict = opts
+	return &WorkspaceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Workspace.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WorkspaceCreate) OnConflictColumns(columns ...string) *WorkspaceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WorkspaceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WorkspaceUpsertOne is the builder for "upsert"-ing
+	//  one Workspace node.
+	WorkspaceUpsertOne struct {
+		create *WorkspaceCreate
+	}
+
+	// WorkspaceUpsert is the "OnConflict" setter.
+	WorkspaceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSlug sets the "slug" field.
+func (u *WorkspaceUpsert) SetSlug(v string) *WorkspaceUpsert {
+	u.Set(workspace.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *WorkspaceUpsert) UpdateSlug() *WorkspaceUpsert {
+	u.SetExcluded(workspace.FieldSlug)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *WorkspaceUpsert) SetName(v string) *WorkspaceUpsert {
+	u.Set(workspace.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WorkspaceUpsert) UpdateName() *WorkspaceUpsert {
+	u.SetExcluded(workspace.FieldName)
+	return u
+}
+
+// SetGraphName sets the "graph_name" field.
+func (u *WorkspaceUpsert) SetGraphName(v string) *WorkspaceUpsert {
+	u.Set(workspace.FieldGraphName, v)
+	return u
+}
+
+// UpdateGraphName sets the "graph_name" field to the value that was provided on create.
+func (u *WorkspaceUpsert) UpdateGraphName() *WorkspaceUpsert {
+	u.SetExcluded(workspace.FieldGraphName)
+	return u
+}
+
+// SetLlmProviders sets the "llm_providers" field.
+func (u *WorkspaceUpsert) SetLlmProviders(v jsontext.Value) *WorkspaceUpsert {
+	u.Set(workspace.FieldLlmProviders, v)
+	return u
+}
+
+// UpdateLlmProviders sets the "llm_providers" field to the value that was provided on create.
+func (u *WorkspaceUpsert) UpdateLlmProviders() *WorkspaceUpsert {
+	u.SetExcluded(workspace.FieldLlmProviders)
+	return u
+}
+
+// ClearLlmProviders clears the value of the "llm_providers" field.
+func (u *WorkspaceUpsert) ClearLlmProviders() *WorkspaceUpsert {
+	u.SetNull(workspace.FieldLlmProviders)
+	return u
+}
+
+// SetLlmMonthlyBudget sets the "llm_monthly_budget" field.
+func (u *WorkspaceUpsert) SetLlmMonthlyBudget(v float64) *WorkspaceUpsert {
+	u.Set(workspace.FieldLlmMonthlyBudget, v)
+	return u
+}
+
+// UpdateLlmMonthlyBudget sets the "llm_monthly_budget" field to the value that was provided on create.
+func (u *WorkspaceUpsert) UpdateLlmMonthlyBudget() *WorkspaceUpsert {
+	u.SetExcluded(workspace.FieldLlmMonthlyBudget)
+	return u
+}
+
+// AddLlmMonthlyBudget adds v to the "llm_monthly_budget" field.
+func (u *WorkspaceUpsert) AddLlmMonthlyBudget(v float64) *WorkspaceUpsert {
+	u.Add(workspace.FieldLlmMonthlyBudget, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WorkspaceUpsert) SetUpdatedAt(v int64) *WorkspaceUpsert {
+	u.Set(workspace.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WorkspaceUpsert) UpdateUpdatedAt() *WorkspaceUpsert {
+	u.SetExcluded(workspace.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *WorkspaceUpsert) AddUpdatedAt(v int64) *WorkspaceUpsert {
+	u.Add(workspace.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Workspace.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(workspace.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WorkspaceUpsertOne) UpdateNewValues() *WorkspaceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(workspace.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(workspace.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Workspace.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WorkspaceUpsertOne) Ignore() *WorkspaceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkspaceUpsertOne) DoNothing() *WorkspaceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkspaceCreate.OnConflict
+// documentation for more info.
+func (u *WorkspaceUpsertOne) Update(set func(*WorkspaceUpsert)) *WorkspaceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkspaceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *WorkspaceUpsertOne) SetSlug(v string) *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *WorkspaceUpsertOne) UpdateSlug() *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WorkspaceUpsertOne) SetName(v string) *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WorkspaceUpsertOne) UpdateName() *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetGraphName sets the "graph_name" field.
+func (u *WorkspaceUpsertOne) SetGraphName(v string) *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetGraphName(v)
+	})
+}
+
+// UpdateGraphName sets the "graph_name" field to the value that was provided on create.
+func (u *WorkspaceUpsertOne) UpdateGraphName() *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateGraphName()
+	})
+}
+
+// SetLlmProviders sets the "llm_providers" field.
+func (u *WorkspaceUpsertOne) SetLlmProviders(v jsontext.Value) *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetLlmProviders(v)
+	})
+}
+
+// UpdateLlmProviders sets the "llm_providers" field to the value that was provided on create.
+func (u *WorkspaceUpsertOne) UpdateLlmProviders() *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateLlmProviders()
+	})
+}
+
+// ClearLlmProviders clears the value of the "llm_providers" field.
+func (u *WorkspaceUpsertOne) ClearLlmProviders() *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.ClearLlmProviders()
+	})
+}
+
+// SetLlmMonthlyBudget sets the "llm_monthly_budget" field.
+func (u *WorkspaceUpsertOne) SetLlmMonthlyBudget(v float64) *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetLlmMonthlyBudget(v)
+	})
+}
+
+// AddLlmMonthlyBudget adds v to the "llm_monthly_budget" field.
+func (u *WorkspaceUpsertOne) AddLlmMonthlyBudget(v float64) *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.AddLlmMonthlyBudget(v)
+	})
+}
+
+// UpdateLlmMonthlyBudget sets the "llm_monthly_budget" field to the value that was provided on create.
+func (u *WorkspaceUpsertOne) UpdateLlmMonthlyBudget() *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateLlmMonthlyBudget()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WorkspaceUpsertOne) SetUpdatedAt(v int64) *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *WorkspaceUpsertOne) AddUpdatedAt(v int64) *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WorkspaceUpsertOne) UpdateUpdatedAt() *WorkspaceUpsertOne {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkspaceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkspaceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkspaceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WorkspaceUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WorkspaceUpsertOne.ID is not supported by MySQL driver. Use WorkspaceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WorkspaceUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WorkspaceCreateBulk is the builder for creating many Workspace entities in bulk.
+type WorkspaceCreateBulk struct {
+	config
+	err      error
+	builders []*WorkspaceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Workspace entities in the database.
+func (_c *WorkspaceCreateBulk) Save(ctx context.Context) ([]*Workspace, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Workspace, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkspaceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WorkspaceCreateBulk) SaveX(ctx context.Context) []*Workspace {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkspaceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkspaceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Workspace.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkspaceUpsert) {
+//			SetSlug(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WorkspaceCreateBulk) OnConflict(opts ...sql.ConflictOption) *WorkspaceUpsertBulk {
+	_c.conflict = opts
+	return &WorkspaceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Workspace.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WorkspaceCreateBulk) OnConflictColumns(columns ...string) *WorkspaceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WorkspaceUpsertBulk{
+		create: _c,
+	}
+}
+
+// WorkspaceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Workspace nodes.
+type WorkspaceUpsertBulk struct {
+	create *WorkspaceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Workspace.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(workspace.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WorkspaceUpsertBulk) UpdateNewValues() *WorkspaceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(workspace.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(workspace.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Workspace.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WorkspaceUpsertBulk) Ignore() *WorkspaceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkspaceUpsertBulk) DoNothing() *WorkspaceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkspaceCreateBulk.OnConflict
+// documentation for more info.
+func (u *WorkspaceUpsertBulk) Update(set func(*WorkspaceUpsert)) *WorkspaceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkspaceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *WorkspaceUpsertBulk) SetSlug(v string) *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *WorkspaceUpsertBulk) UpdateSlug() *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WorkspaceUpsertBulk) SetName(v string) *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WorkspaceUpsertBulk) UpdateName() *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetGraphName sets the "graph_name" field.
+func (u *WorkspaceUpsertBulk) SetGraphName(v string) *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetGraphName(v)
+	})
+}
+
+// UpdateGraphName sets the "graph_name" field to the value that was provided on create.
+func (u *WorkspaceUpsertBulk) UpdateGraphName() *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateGraphName()
+	})
+}
+
+// SetLlmProviders sets the "llm_providers" field.
+func (u *WorkspaceUpsertBulk) SetLlmProviders(v jsontext.Value) *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetLlmProviders(v)
+	})
+}
+
+// UpdateLlmProviders sets the "llm_providers" field to the value that was provided on create.
+func (u *WorkspaceUpsertBulk) UpdateLlmProviders() *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateLlmProviders()
+	})
+}
+
+// ClearLlmProviders clears the value of the "llm_providers" field.
+func (u *WorkspaceUpsertBulk) ClearLlmProviders() *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.ClearLlmProviders()
+	})
+}
+
+// SetLlmMonthlyBudget sets the "llm_monthly_budget" field.
+func (u *WorkspaceUpsertBulk) SetLlmMonthlyBudget(v float64) *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetLlmMonthlyBudget(v)
+	})
+}
+
+// AddLlmMonthlyBudget adds v to the "llm_monthly_budget" field.
+func (u *WorkspaceUpsertBulk) AddLlmMonthlyBudget(v float64) *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.AddLlmMonthlyBudget(v)
+	})
+}
+
+// UpdateLlmMonthlyBudget sets the "llm_monthly_budget" field to the value that was provided on create.
+func (u *WorkspaceUpsertBulk) UpdateLlmMonthlyBudget() *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateLlmMonthlyBudget()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WorkspaceUpsertBulk) SetUpdatedAt(v int64) *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *WorkspaceUpsertBulk) AddUpdatedAt(v int64) *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WorkspaceUpsertBulk) UpdateUpdatedAt() *WorkspaceUpsertBulk {
+	return u.Update(func(s *WorkspaceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkspaceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WorkspaceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkspaceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkspaceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
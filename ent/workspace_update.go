@@ -0,0 +1,498 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/luoling8192/mindwave/ent/internal"
+	"github.com/luoling8192/mindwave/ent/predicate"
+	"github.com/luoling8192/mindwave/ent/workspace"
+)
+
+// WorkspaceUpdate is the builder for updating Workspace entities.
+type WorkspaceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkspaceMutation
+}
+
+// Where appends a list predicates to the WorkspaceUpdate builder.
+func (_u *WorkspaceUpdate) Where(ps ...predicate.Workspace) *WorkspaceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *WorkspaceUpdate) SetSlug(v string) *WorkspaceUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *WorkspaceUpdate) SetNillableSlug(v *string) *WorkspaceUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WorkspaceUpdate) SetName(v string) *WorkspaceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WorkspaceUpdate) SetNillableName(v *string) *WorkspaceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetGraphName sets the "graph_name" field.
+func (_u *WorkspaceUpdate) SetGraphName(v string) *WorkspaceUpdate {
+	_u.mutation.SetGraphName(v)
+	return _u
+}
+
+// SetNillableGraphName sets the "graph_name" field if the given value is not nil.
+func (_u *WorkspaceUpdate) SetNillableGraphName(v *string) *WorkspaceUpdate {
+	if v != nil {
+		_u.SetGraphName(*v)
+	}
+	return _u
+}
+
+// SetLlmProviders sets the "llm_providers" field.
+func (_u *WorkspaceUpdate) SetLlmProviders(v jsontext.Value) *WorkspaceUpdate {
+	_u.mutation.SetLlmProviders(v)
+	return _u
+}
+
+// AppendLlmProviders appends value to the "llm_providers" field.
+func (_u *WorkspaceUpdate) AppendLlmProviders(v jsontext.Value) *WorkspaceUpdate {
+	_u.mutation.AppendLlmProviders(v)
+	return _u
+}
+
+// ClearLlmProviders clears the value of the "llm_providers" field.
+func (_u *WorkspaceUpdate) ClearLlmProviders() *WorkspaceUpdate {
+	_u.mutation.ClearLlmProviders()
+	return _u
+}
+
+// SetLlmMonthlyBudget sets the "llm_monthly_budget" field.
+func (_u *WorkspaceUpdate) SetLlmMonthlyBudget(v float64) *WorkspaceUpdate {
+	_u.mutation.ResetLlmMonthlyBudget()
+	_u.mutation.SetLlmMonthlyBudget(v)
+	return _u
+}
+
+// SetNillableLlmMonthlyBudget sets the "llm_monthly_budget" field if the given value is not nil.
+func (_u *WorkspaceUpdate) SetNillableLlmMonthlyBudget(v *float64) *WorkspaceUpdate {
+	if v != nil {
+		_u.SetLlmMonthlyBudget(*v)
+	}
+	return _u
+}
+
+// AddLlmMonthlyBudget adds value to the "llm_monthly_budget" field.
+func (_u *WorkspaceUpdate) AddLlmMonthlyBudget(v float64) *WorkspaceUpdate {
+	_u.mutation.AddLlmMonthlyBudget(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WorkspaceUpdate) SetUpdatedAt(v int64) *WorkspaceUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *WorkspaceUpdate) AddUpdatedAt(v int64) *WorkspaceUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WorkspaceMutation object of the builder.
+func (_u *WorkspaceUpdate) Mutation() *WorkspaceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WorkspaceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkspaceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WorkspaceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkspaceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WorkspaceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := workspace.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkspaceUpdate) check() error {
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := workspace.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Workspace.slug": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GraphName(); ok {
+		if err := workspace.GraphNameValidator(v); err != nil {
+			return &ValidationError{Name: "graph_name", err: fmt.Errorf(`ent: validator failed for field "Workspace.graph_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LlmMonthlyBudget(); ok {
+		if err := workspace.LlmMonthlyBudgetValidator(v); err != nil {
+			return &ValidationError{Name: "llm_monthly_budget", err: fmt.Errorf(`ent: validator failed for field "Workspace.llm_monthly_budget": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WorkspaceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workspace.Table, workspace.Columns, sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(workspace.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(workspace.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GraphName(); ok {
+		_spec.SetField(workspace.FieldGraphName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LlmProviders(); ok {
+		_spec.SetField(workspace.FieldLlmProviders, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLlmProviders(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workspace.FieldLlmProviders, value)
+		})
+	}
+	if _u.mutation.LlmProvidersCleared() {
+		_spec.ClearField(workspace.FieldLlmProviders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LlmMonthlyBudget(); ok {
+		_spec.SetField(workspace.FieldLlmMonthlyBudget, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLlmMonthlyBudget(); ok {
+		_spec.AddField(workspace.FieldLlmMonthlyBudget, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(workspace.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(workspace.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Workspace
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workspace.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WorkspaceUpdateOne is the builder for updating a single Workspace entity.
+type WorkspaceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorkspaceMutation
+}
+
+// SetSlug sets the "slug" field.
+func (_u *WorkspaceUpdateOne) SetSlug(v string) *WorkspaceUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *WorkspaceUpdateOne) SetNillableSlug(v *string) *WorkspaceUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WorkspaceUpdateOne) SetName(v string) *WorkspaceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WorkspaceUpdateOne) SetNillableName(v *string) *WorkspaceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetGraphName sets the "graph_name" field.
+func (_u *WorkspaceUpdateOne) SetGraphName(v string) *WorkspaceUpdateOne {
+	_u.mutation.SetGraphName(v)
+	return _u
+}
+
+// SetNillableGraphName sets the "graph_name" field if the given value is not nil.
+func (_u *WorkspaceUpdateOne) SetNillableGraphName(v *string) *WorkspaceUpdateOne {
+	if v != nil {
+		_u.SetGraphName(*v)
+	}
+	return _u
+}
+
+// SetLlmProviders sets the "llm_providers" field.
+func (_u *WorkspaceUpdateOne) SetLlmProviders(v jsontext.Value) *WorkspaceUpdateOne {
+	_u.mutation.SetLlmProviders(v)
+	return _u
+}
+
+// AppendLlmProviders appends value to the "llm_providers" field.
+func (_u *WorkspaceUpdateOne) AppendLlmProviders(v jsontext.Value) *WorkspaceUpdateOne {
+	_u.mutation.AppendLlmProviders(v)
+	return _u
+}
+
+// ClearLlmProviders clears the value of the "llm_providers" field.
+func (_u *WorkspaceUpdateOne) ClearLlmProviders() *WorkspaceUpdateOne {
+	_u.mutation.ClearLlmProviders()
+	return _u
+}
+
+// SetLlmMonthlyBudget sets the "llm_monthly_budget" field.
+func (_u *WorkspaceUpdateOne) SetLlmMonthlyBudget(v float64) *WorkspaceUpdateOne {
+	_u.mutation.ResetLlmMonthlyBudget()
+	_u.mutation.SetLlmMonthlyBudget(v)
+	return _u
+}
+
+// SetNillableLlmMonthlyBudget sets the "llm_monthly_budget" field if the given value is not nil.
+func (_u *WorkspaceUpdateOne) SetNillableLlmMonthlyBudget(v *float64) *WorkspaceUpdateOne {
+	if v != nil {
+		_u.SetLlmMonthlyBudget(*v)
+	}
+	return _u
+}
+
+// AddLlmMonthlyBudget adds value to the "llm_monthly_budget" field.
+func (_u *WorkspaceUpdateOne) AddLlmMonthlyBudget(v float64) *WorkspaceUpdateOne {
+	_u.mutation.AddLlmMonthlyBudget(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WorkspaceUpdateOne) SetUpdatedAt(v int64) *WorkspaceUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *WorkspaceUpdateOne) AddUpdatedAt(v int64) *WorkspaceUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WorkspaceMutation object of the builder.
+func (_u *WorkspaceUpdateOne) Mutation() *WorkspaceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WorkspaceUpdate builder.
+func (_u *WorkspaceUpdateOne) Where(ps ...predicate.Workspace) *WorkspaceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WorkspaceUpdateOne) Select(field string, fields ...string) *WorkspaceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Workspace entity.
+func (_u *WorkspaceUpdateOne) Save(ctx context.Context) (*Workspace, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkspaceUpdateOne) SaveX(ctx context.Context) *Workspace {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WorkspaceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkspaceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WorkspaceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := workspace.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkspaceUpdateOne) check() error {
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := workspace.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Workspace.slug": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GraphName(); ok {
+		if err := workspace.GraphNameValidator(v); err != nil {
+			return &ValidationError{Name: "graph_name", err: fmt.Errorf(`ent: validator failed for field "Workspace.graph_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LlmMonthlyBudget(); ok {
+		if err := workspace.LlmMonthlyBudgetValidator(v); err != nil {
+			return &ValidationError{Name: "llm_monthly_budget", err: fmt.Errorf(`ent: validator failed for field "Workspace.llm_monthly_budget": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WorkspaceUpdateOne) sqlSave(ctx context.Context) (_node *Workspace, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workspace.Table, workspace.Columns, sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Workspace.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workspace.FieldID)
+		for _, f := range fields {
+			if !workspace.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workspace.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(workspace.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(workspace.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GraphName(); ok {
+		_spec.SetField(workspace.FieldGraphName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LlmProviders(); ok {
+		_spec.SetField(workspace.FieldLlmProviders, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLlmProviders(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workspace.FieldLlmProviders, value)
+		})
+	}
+	if _u.mutation.LlmProvidersCleared() {
+		_spec.ClearField(workspace.FieldLlmProviders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LlmMonthlyBudget(); ok {
+		_spec.SetField(workspace.FieldLlmMonthlyBudget, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLlmMonthlyBudget(); ok {
+		_spec.AddField(workspace.FieldLlmMonthlyBudget, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(workspace.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(workspace.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.Workspace
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &Workspace{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workspace.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,7 +3,9 @@ package agent
 import (
 	"context"
 	"errors"
+	"net/http"
 	"strings"
+	"time"
 
 	openai "github.com/sashabaranov/go-openai"
 )
@@ -38,21 +40,143 @@ Sukka,阿卡琳;性能,黑魔法;中文Web字体方案（cn-font-split Rust重
 请输出简明、去重的中文纯文本结构化列表，不需要解释，不要引用原文，专注于关键信息与人脉事件关联，方便后续数据库存储和查询。`
 )
 
+// SummarizerModel returns the model used by SummaryMessages.
+func SummarizerModel() string {
+	return summarizerModel
+}
+
 type LLMClient struct {
-	aiClient *openai.Client
+	// provider serves the stages without a route.
+	provider Provider
+	routes   map[string]route
+	limiter  *Limiter
+	prices   PriceTable
+
+	cache     Cache
+	cacheMode CacheMode
+	cacheTTL  time.Duration
+}
+
+type ClientOptions struct {
+	// Limiter is shared by the clients whose calls it bounds together, nil
+	// leaves calls unbounded.
+	Limiter *Limiter
+	// Prices accounts the cost of calls, nil accounts them at no cost.
+	Prices PriceTable
+	// Cache replays completions of identical requests, nil disables it.
+	Cache     Cache
+	CacheMode CacheMode
+	// CacheTTL defaults to 30 days.
+	CacheTTL time.Duration
+	// Providers chooses a provider per stage, nil runs every stage on the
+	// OpenAI compatible endpoint.
+	Providers *ProvidersConfig
 }
 
-func NewLLMClient(baseURL, apiKey string) (*LLMClient, error) {
-	if baseURL == "" || apiKey == "" {
-		return nil, errors.New("baseURL and apiKey are required")
+// NewLLMClient creates a client for an OpenAI compatible endpoint, or for the
+// default provider of opts.Providers when it names one.
+func NewLLMClient(baseURL, apiKey string, opts ClientOptions) (*LLMClient, error) {
+	limiter := opts.Limiter
+	if limiter == nil {
+		limiter = NewLimiter(LimiterOptions{})
+	}
+	httpClient := &http.Client{
+		Transport: &retryAfterTransport{base: http.DefaultTransport, limiter: limiter},
+	}
+
+	providers, routes, err := newRoutes(opts.Providers, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	provider, ok := providers[defaultProviderName]
+	if !ok {
+		if baseURL == "" || apiKey == "" {
+			return nil, errors.New("baseURL and apiKey are required")
+		}
+		provider = newOpenAIProvider(baseURL, apiKey, httpClient)
+	}
+
+	if opts.CacheTTL <= 0 {
+		opts.CacheTTL = defaultCacheTTL
 	}
 
-	config := openai.DefaultConfig(apiKey)
-	config.BaseURL = baseURL
+	return &LLMClient{
+		provider:  provider,
+		routes:    routes,
+		limiter:   limiter,
+		prices:    opts.Prices,
+		cache:     opts.Cache,
+		cacheMode: opts.CacheMode,
+		cacheTTL:  opts.CacheTTL,
+	}, nil
+}
+
+// createChatCompletion streams a completion within the limits of the client,
+// accounting its usage to stage. Identical requests are replayed from the
+// cache. An interrupted completion returns a PartialError with its output.
+func (c *LLMClient) createChatCompletion(ctx context.Context, stage string, request openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error) {
+	provider := c.route(stage, &request.Model)
 
-	client := openai.NewClientWithConfig(config)
+	entry := cacheKey(request)
+	if response, ok := c.cachedCompletion(ctx, stage, entry); ok {
+		return response, nil
+	}
 
-	return &LLMClient{aiClient: client}, nil
+	estimated := request.MaxTokens
+	for _, message := range request.Messages {
+		estimated += estimateTokens(message.Content)
+	}
+
+	var response openai.ChatCompletionResponse
+	err := c.limiter.do(ctx, "chat", estimated, func(ctx context.Context) (int, error) {
+		var err error
+		response, err = streamChatCompletion(ctx, provider, stage, request)
+		if err != nil {
+			return 0, err
+		}
+		c.recordUsage(ctx, stage, request.Model, response.Usage.PromptTokens, response.Usage.CompletionTokens)
+		return response.Usage.TotalTokens, nil
+	})
+	if err != nil {
+		if output := responseContent(response); output != "" {
+			err = &PartialError{Output: output, Err: err}
+		}
+		return response, err
+	}
+
+	c.cacheCompletion(ctx, stage, entry, response)
+	return response, nil
+}
+
+// createEmbeddings calls the embeddings endpoint within the limits of the
+// client, accounting its usage to the embed stage.
+func (c *LLMClient) createEmbeddings(ctx context.Context, request openai.EmbeddingRequestStrings) (openai.EmbeddingResponse, error) {
+	provider := c.route(stageEmbed, nil)
+
+	var response openai.EmbeddingResponse
+	err := c.limiter.do(ctx, "embedding", estimateTokens(request.Input...), func(ctx context.Context) (int, error) {
+		var err error
+		response, err = provider.CreateEmbeddings(ctx, request)
+		if err != nil {
+			return 0, err
+		}
+		c.recordUsage(ctx, stageEmbed, string(request.Model), response.Usage.PromptTokens, 0)
+		return response.Usage.TotalTokens, nil
+	})
+	return response, err
+}
+
+// route returns the provider of stage and replaces *model with the model the
+// route sets, if any.
+func (c *LLMClient) route(stage string, model *string) Provider {
+	r, ok := c.routes[stage]
+	if !ok {
+		return c.provider
+	}
+	if model != nil && r.model != "" {
+		*model = r.model
+	}
+	return r.provider
 }
 
 func SummaryMessages(ctx context.Context, llmClient *LLMClient, messages []string) (string, error) {
@@ -60,7 +184,7 @@ func SummaryMessages(ctx context.Context, llmClient *LLMClient, messages []strin
 		return "", errors.New("no messages to summarize")
 	}
 
-	response, err := llmClient.aiClient.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+	response, err := llmClient.createChatCompletion(ctx, stageSummarize, openai.ChatCompletionRequest{
 		Model: summarizerModel,
 		Messages: []openai.ChatCompletionMessage{
 			{
@@ -91,7 +215,7 @@ func ExtractSummary(ctx context.Context, llmClient *LLMClient, summary string) (
 		return []ExtractedItem{}, errors.New("no summary to extract")
 	}
 
-	response, err := llmClient.aiClient.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+	response, err := llmClient.createChatCompletion(ctx, stageExtract, openai.ChatCompletionRequest{
 		Model: extractorModel,
 		Messages: []openai.ChatCompletionMessage{
 			{
@@ -0,0 +1,336 @@
+package agent
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+const (
+	// DefaultAnthropicURL is the Messages API endpoint, tests point the
+	// adapter at a local stand-in instead.
+	DefaultAnthropicURL = "https://api.anthropic.com"
+
+	anthropicVersion = "2023-06-01"
+	// The Messages API requires max_tokens, used when a request sets none.
+	anthropicDefaultMaxTokens = 8192
+)
+
+// Anthropic calls the Anthropic Messages API. System messages become the
+// system prompt and OpenAI tool definitions, calls and results are mapped to
+// tool_use and tool_result blocks.
+type Anthropic struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewAnthropic builds the adapter, baseURL may be empty for
+// DefaultAnthropicURL.
+func NewAnthropic(baseURL, apiKey string, httpClient *http.Client) *Anthropic {
+	if baseURL == "" {
+		baseURL = DefaultAnthropicURL
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Anthropic{baseURL: strings.TrimSuffix(baseURL, "/"), apiKey: apiKey, httpClient: httpClient}
+}
+
+type anthropicRequest struct {
+	Model         string             `json:"model"`
+	MaxTokens     int                `json:"max_tokens"`
+	System        string             `json:"system,omitempty"`
+	Messages      []anthropicMessage `json:"messages"`
+	Temperature   *float32           `json:"temperature,omitempty"`
+	TopP          *float32           `json:"top_p,omitempty"`
+	StopSequences []string           `json:"stop_sequences,omitempty"`
+	Tools         []anthropicTool    `json:"tools,omitempty"`
+	ToolChoice    any                `json:"tool_choice,omitempty"`
+	Stream        bool               `json:"stream"`
+}
+
+type anthropicMessage struct {
+	Role    string           `json:"role"`
+	Content []anthropicBlock `json:"content"`
+}
+
+type anthropicBlock struct {
+	Type string `json:"type"`
+	// text
+	Text string `json:"text,omitempty"`
+	// tool_use
+	ID    string          `json:"id,omitempty"`
+	Name  string          `json:"name,omitempty"`
+	Input json.RawMessage `json:"input,omitempty"`
+	// tool_result
+	ToolUseID string `json:"tool_use_id,omitempty"`
+	Content   string `json:"content,omitempty"`
+}
+
+type anthropicTool struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	InputSchema any    `json:"input_schema"`
+}
+
+type anthropicUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+// anthropicEvent is any event of a streamed message, only the fields of its
+// type are set.
+type anthropicEvent struct {
+	Type    string `json:"type"`
+	Message struct {
+		ID    string         `json:"id"`
+		Model string         `json:"model"`
+		Usage anthropicUsage `json:"usage"`
+	} `json:"message"`
+	Index        int            `json:"index"`
+	ContentBlock anthropicBlock `json:"content_block"`
+	Delta        struct {
+		Type        string `json:"type"`
+		Text        string `json:"text"`
+		PartialJSON string `json:"partial_json"`
+		StopReason  string `json:"stop_reason"`
+	} `json:"delta"`
+	Usage anthropicUsage `json:"usage"`
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (a *Anthropic) ChatCompletion(ctx context.Context, request openai.ChatCompletionRequest, onDelta func(string)) (openai.ChatCompletionResponse, error) {
+	body, err := newAnthropicRequest(request)
+	if err != nil {
+		return openai.ChatCompletionResponse{}, err
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return openai.ChatCompletionResponse{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.baseURL+"/v1/messages", bytes.NewReader(data))
+	if err != nil {
+		return openai.ChatCompletionResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("X-Api-Key", a.apiKey)
+	req.Header.Set("Anthropic-Version", anthropicVersion)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return openai.ChatCompletionResponse{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return openai.ChatCompletionResponse{}, newHTTPError(ProviderAnthropic, resp)
+	}
+
+	var (
+		response openai.ChatCompletionResponse
+		content  strings.Builder
+		finish   openai.FinishReason
+		// Tool calls by content block index, in block order.
+		calls   []openai.ToolCall
+		callsAt = make(map[int]int)
+	)
+	assemble := func() openai.ChatCompletionResponse {
+		response.Object = "chat.completion"
+		response.Usage.TotalTokens = response.Usage.PromptTokens + response.Usage.CompletionTokens
+		response.Choices = []openai.ChatCompletionChoice{{
+			Message: openai.ChatCompletionMessage{
+				Role:      openai.ChatMessageRoleAssistant,
+				Content:   content.String(),
+				ToolCalls: calls,
+			},
+			FinishReason: finish,
+		}}
+		return response
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64<<10), 4<<20)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		var event anthropicEvent
+		if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data:"))), &event); err != nil {
+			return assemble(), fmt.Errorf("anthropic: invalid stream event: %w", err)
+		}
+
+		switch event.Type {
+		case "message_start":
+			response.ID = event.Message.ID
+			response.Model = event.Message.Model
+			response.Usage.PromptTokens = event.Message.Usage.InputTokens
+			response.Usage.CompletionTokens = event.Message.Usage.OutputTokens
+		case "content_block_start":
+			if event.ContentBlock.Type == "tool_use" {
+				callsAt[event.Index] = len(calls)
+				calls = append(calls, openai.ToolCall{
+					ID:       event.ContentBlock.ID,
+					Type:     openai.ToolTypeFunction,
+					Function: openai.FunctionCall{Name: event.ContentBlock.Name},
+				})
+			}
+		case "content_block_delta":
+			switch event.Delta.Type {
+			case "text_delta":
+				content.WriteString(event.Delta.Text)
+				onDelta(event.Delta.Text)
+			case "input_json_delta":
+				if i, ok := callsAt[event.Index]; ok {
+					calls[i].Function.Arguments += event.Delta.PartialJSON
+				}
+			}
+		case "message_delta":
+			if event.Delta.StopReason != "" {
+				finish = anthropicFinishReason(event.Delta.StopReason)
+			}
+			response.Usage.CompletionTokens = event.Usage.OutputTokens
+		case "message_stop":
+			return assemble(), nil
+		case "error":
+			status := http.StatusInternalServerError
+			if event.Error.Type == "overloaded_error" {
+				status = 529
+			} else if event.Error.Type == "rate_limit_error" {
+				status = http.StatusTooManyRequests
+			}
+			return assemble(), &HTTPError{Provider: ProviderAnthropic, StatusCode: status, Message: event.Error.Message}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return assemble(), err
+	}
+	return assemble(), errors.New("anthropic: stream ended before message_stop")
+}
+
+// newAnthropicRequest translates a chat completion request to the Messages
+// API.
+func newAnthropicRequest(request openai.ChatCompletionRequest) (anthropicRequest, error) {
+	body := anthropicRequest{
+		Model:         request.Model,
+		MaxTokens:     request.MaxTokens,
+		StopSequences: request.Stop,
+		Stream:        true,
+	}
+	if body.MaxTokens == 0 {
+		body.MaxTokens = request.MaxCompletionTokens
+	}
+	if body.MaxTokens == 0 {
+		body.MaxTokens = anthropicDefaultMaxTokens
+	}
+	if request.Temperature != 0 {
+		body.Temperature = &request.Temperature
+	}
+	if request.TopP != 0 {
+		body.TopP = &request.TopP
+	}
+
+	for _, tool := range request.Tools {
+		if tool.Function == nil {
+			continue
+		}
+		schema := tool.Function.Parameters
+		if schema == nil {
+			schema = map[string]any{"type": "object"}
+		}
+		body.Tools = append(body.Tools, anthropicTool{
+			Name:        tool.Function.Name,
+			Description: tool.Function.Description,
+			InputSchema: schema,
+		})
+	}
+	switch choice := request.ToolChoice.(type) {
+	case nil:
+	case string:
+		switch choice {
+		case "required":
+			body.ToolChoice = map[string]string{"type": "any"}
+		case "none":
+			body.ToolChoice = map[string]string{"type": "none"}
+		default:
+			body.ToolChoice = map[string]string{"type": "auto"}
+		}
+	case openai.ToolChoice:
+		body.ToolChoice = map[string]string{"type": "tool", "name": choice.Function.Name}
+	}
+
+	var system []string
+	for _, message := range request.Messages {
+		var (
+			role   string
+			blocks []anthropicBlock
+		)
+		switch message.Role {
+		case openai.ChatMessageRoleSystem, openai.ChatMessageRoleDeveloper:
+			system = append(system, message.Content)
+			continue
+		case openai.ChatMessageRoleUser:
+			role = "user"
+			blocks = []anthropicBlock{{Type: "text", Text: message.Content}}
+		case openai.ChatMessageRoleAssistant:
+			role = "assistant"
+			if message.Content != "" {
+				blocks = append(blocks, anthropicBlock{Type: "text", Text: message.Content})
+			}
+			for _, call := range message.ToolCalls {
+				input := json.RawMessage(call.Function.Arguments)
+				if !json.Valid(input) {
+					return body, fmt.Errorf("anthropic: tool call %s has invalid arguments", call.ID)
+				}
+				blocks = append(blocks, anthropicBlock{Type: "tool_use", ID: call.ID, Name: call.Function.Name, Input: input})
+			}
+		case openai.ChatMessageRoleTool:
+			role = "user"
+			blocks = []anthropicBlock{{Type: "tool_result", ToolUseID: message.ToolCallID, Content: message.Content}}
+		default:
+			return body, fmt.Errorf("anthropic: unsupported message role %q", message.Role)
+		}
+
+		// Tool results follow the assistant turn as one user turn, merge
+		// consecutive messages of a role.
+		if n := len(body.Messages); n > 0 && body.Messages[n-1].Role == role {
+			body.Messages[n-1].Content = append(body.Messages[n-1].Content, blocks...)
+			continue
+		}
+		body.Messages = append(body.Messages, anthropicMessage{Role: role, Content: blocks})
+	}
+	body.System = strings.Join(system, "\n\n")
+
+	if len(body.Messages) == 0 {
+		return body, errors.New("anthropic: at least one user message is required")
+	}
+	return body, nil
+}
+
+func anthropicFinishReason(stopReason string) openai.FinishReason {
+	switch stopReason {
+	case "max_tokens":
+		return openai.FinishReasonLength
+	case "tool_use":
+		return openai.FinishReasonToolCalls
+	default:
+		return openai.FinishReasonStop
+	}
+}
+
+func (a *Anthropic) CreateEmbeddings(context.Context, openai.EmbeddingRequestStrings) (openai.EmbeddingResponse, error) {
+	return openai.EmbeddingResponse{}, errors.New("anthropic has no embeddings API")
+}
@@ -0,0 +1,116 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// NoEvidenceMarker is the whole reply of the answer model when the evidence
+// does not support an answer.
+const NoEvidenceMarker = "[NO_EVIDENCE]"
+
+const (
+	answerModel  = "deepseek/deepseek-v3.2"
+	answerPrompt = `你是群聊知识库的问答助手。请只根据用户消息中“证据”部分给出的聊天记录和事件回答问题：
+
+1. 每个结论都必须在句末用方括号引用支撑它的消息 ID，例如 [msg:3f2b8c1e-0000-0000-0000-000000000000]，可以引用多条。事件只能作为线索，不能单独作为引用。
+2. 不得使用证据以外的知识，不得编造人名、结论或消息 ID。
+3. 如果证据不足以回答问题，只输出 ` + NoEvidenceMarker + `，不要输出任何其他内容。
+4. 使用与问题相同的语言作答，简洁准确。`
+
+	condenseModel  = "deepseek/deepseek-v3.2"
+	condensePrompt = `根据对话历史，把用户最后的追问改写成一个不依赖上下文、可以直接用于检索的独立问题。保留原文中的人名、项目名和技术术语。只输出改写后的问题。`
+)
+
+// AnswerModel returns the model used by AnswerQuestion.
+func AnswerModel() string {
+	return answerModel
+}
+
+// Turn is an earlier question and answer of a conversation.
+type Turn struct {
+	Question string
+	Answer   string
+}
+
+func historyMessages(history []Turn) []openai.ChatCompletionMessage {
+	messages := make([]openai.ChatCompletionMessage, 0, len(history)*2)
+	for _, turn := range history {
+		messages = append(messages,
+			openai.ChatCompletionMessage{Role: "user", Content: turn.Question},
+			openai.ChatCompletionMessage{Role: "assistant", Content: turn.Answer},
+		)
+	}
+	return messages
+}
+
+// CondenseQuestion rewrites a follow-up question into one that can be used for
+// retrieval without the conversation history.
+func CondenseQuestion(ctx context.Context, llmClient *LLMClient, history []Turn, question string) (string, error) {
+	if len(history) == 0 {
+		return question, nil
+	}
+
+	var transcript strings.Builder
+	for _, turn := range history {
+		fmt.Fprintf(&transcript, "问：%s\n答：%s\n", turn.Question, turn.Answer)
+	}
+	fmt.Fprintf(&transcript, "追问：%s", question)
+
+	response, err := llmClient.createChatCompletion(ctx, stageCondense, openai.ChatCompletionRequest{
+		Model: condenseModel,
+		Messages: []openai.ChatCompletionMessage{
+			{
+				Role:    "system",
+				Content: condensePrompt,
+			},
+			{
+				Role:    "user",
+				Content: transcript.String(),
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	condensed := strings.TrimSpace(response.Choices[0].Message.Content)
+	if condensed == "" {
+		return question, nil
+	}
+
+	return condensed, nil
+}
+
+// AnswerQuestion answers question from the given evidence lines, continuing
+// the conversation in history.
+func AnswerQuestion(ctx context.Context, llmClient *LLMClient, history []Turn, evidence []string, question string) (string, error) {
+	if len(evidence) == 0 {
+		return "", errors.New("no evidence to answer from")
+	}
+
+	messages := append([]openai.ChatCompletionMessage{
+		{
+			Role:    "system",
+			Content: answerPrompt,
+		},
+	}, historyMessages(history)...)
+	messages = append(messages, openai.ChatCompletionMessage{
+		Role:    "user",
+		Content: "证据：\n" + strings.Join(evidence, "\n") + "\n\n问题：" + question,
+	})
+
+	response, err := llmClient.createChatCompletion(ctx, stageAnswer, openai.ChatCompletionRequest{
+		Model:    answerModel,
+		Messages: messages,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(response.Choices[0].Message.Content), nil
+}
@@ -0,0 +1,129 @@
+package agent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/luoling8192/mindwave/internal/metrics"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+const defaultCacheTTL = 30 * 24 * time.Hour
+
+// CacheMode controls how completions use the response cache.
+type CacheMode string
+
+const (
+	// CacheModeUse replays cached completions and caches new ones.
+	CacheModeUse CacheMode = ""
+	// CacheModeRefresh skips cached completions but caches new ones.
+	CacheModeRefresh CacheMode = "refresh"
+	// CacheModeOff neither reads nor writes the cache.
+	CacheModeOff CacheMode = "off"
+)
+
+// CacheEntry is a cached completion with the parts of the request its key
+// was derived from.
+type CacheEntry struct {
+	Key           string
+	Stage         string
+	Model         string
+	PromptVersion string
+	InputHash     string
+	// Value is the completion response encoded as JSON, replayed as is.
+	Value     []byte
+	ExpiresAt time.Time
+}
+
+// Cache stores completions by content-addressed key. Get reports a miss for
+// expired entries.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Put(ctx context.Context, entry CacheEntry) error
+}
+
+// cacheKey derives the key of a completion request from its model, the
+// version of its system prompts, the hash of its input and its parameters.
+func cacheKey(request openai.ChatCompletionRequest) CacheEntry {
+	prompt := sha256.New()
+	input := sha256.New()
+	for _, message := range request.Messages {
+		h := input
+		if message.Role == openai.ChatMessageRoleSystem {
+			h = prompt
+		}
+		// Encoding each message keeps their boundaries unambiguous.
+		data, _ := json.Marshal([]string{message.Role, message.Name, message.Content})
+		h.Write(data)
+	}
+
+	params := request
+	params.Model = ""
+	params.Messages = nil
+	paramsData, _ := json.Marshal(params)
+
+	entry := CacheEntry{
+		Model:         request.Model,
+		PromptVersion: hex.EncodeToString(prompt.Sum(nil))[:16],
+		InputHash:     hex.EncodeToString(input.Sum(nil)),
+	}
+	keyData, _ := json.Marshal(struct {
+		Model         string          `json:"model"`
+		PromptVersion string          `json:"prompt_version"`
+		InputHash     string          `json:"input_hash"`
+		Params        json.RawMessage `json:"params"`
+	}{entry.Model, entry.PromptVersion, entry.InputHash, paramsData})
+	key := sha256.Sum256(keyData)
+	entry.Key = hex.EncodeToString(key[:])
+	return entry
+}
+
+// cachedCompletion replays a cached completion of request, if any.
+func (c *LLMClient) cachedCompletion(ctx context.Context, stage string, entry CacheEntry) (openai.ChatCompletionResponse, bool) {
+	var response openai.ChatCompletionResponse
+	if c.cache == nil || c.cacheMode != CacheModeUse {
+		return response, false
+	}
+
+	value, ok, err := c.cache.Get(ctx, entry.Key)
+	if err != nil {
+		slog.Warn("failed to read llm cache", "error", err, "stage", stage)
+		metrics.LLMCacheRequests.WithLabelValues(stage, "error").Inc()
+		return response, false
+	}
+	if !ok {
+		metrics.LLMCacheRequests.WithLabelValues(stage, "miss").Inc()
+		return response, false
+	}
+	if err := json.Unmarshal(value, &response); err != nil || len(response.Choices) == 0 {
+		slog.Warn("ignoring unreadable llm cache entry", "error", err, "stage", stage, "key", entry.Key)
+		metrics.LLMCacheRequests.WithLabelValues(stage, "error").Inc()
+		return response, false
+	}
+
+	metrics.LLMCacheRequests.WithLabelValues(stage, "hit").Inc()
+	return response, true
+}
+
+// cacheCompletion stores a completion for replays.
+func (c *LLMClient) cacheCompletion(ctx context.Context, stage string, entry CacheEntry, response openai.ChatCompletionResponse) {
+	if c.cache == nil || c.cacheMode == CacheModeOff {
+		return
+	}
+
+	value, err := json.Marshal(response)
+	if err != nil {
+		slog.Warn("failed to encode llm response for the cache", "error", err, "stage", stage)
+		return
+	}
+	entry.Stage = stage
+	entry.Value = value
+	entry.ExpiresAt = time.Now().Add(c.cacheTTL)
+	if err := c.cache.Put(ctx, entry); err != nil {
+		slog.Warn("failed to write llm cache", "error", err, "stage", stage)
+	}
+}
@@ -0,0 +1,57 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+const (
+	defaultEmbeddingModel      = "openai/text-embedding-3-small"
+	defaultEmbeddingDimensions = 1536
+)
+
+// EmbeddingModel names an embedding model together with the vector size it
+// produces, which decides the content_vector_* column it is stored in.
+type EmbeddingModel struct {
+	Name       string
+	Dimensions int
+}
+
+// DefaultEmbeddingModel returns the embedding model used when none is configured.
+func DefaultEmbeddingModel() EmbeddingModel {
+	return EmbeddingModel{Name: defaultEmbeddingModel, Dimensions: defaultEmbeddingDimensions}
+}
+
+func EmbedTexts(ctx context.Context, llmClient *LLMClient, model EmbeddingModel, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, errors.New("no texts to embed")
+	}
+
+	response, err := llmClient.createEmbeddings(ctx, openai.EmbeddingRequestStrings{
+		Input:      texts,
+		Model:      openai.EmbeddingModel(model.Name),
+		Dimensions: model.Dimensions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Data) != len(texts) {
+		return nil, fmt.Errorf("expected %d embeddings, got %d", len(texts), len(response.Data))
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, data := range response.Data {
+		if data.Index < 0 || data.Index >= len(texts) {
+			return nil, fmt.Errorf("embedding index out of range: %d", data.Index)
+		}
+		if len(data.Embedding) != model.Dimensions {
+			return nil, fmt.Errorf("expected %d dimensions, got %d", model.Dimensions, len(data.Embedding))
+		}
+		vectors[data.Index] = data.Embedding
+	}
+
+	return vectors, nil
+}
@@ -0,0 +1,277 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/luoling8192/mindwave/internal/metrics"
+	openai "github.com/sashabaranov/go-openai"
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultRetryBaseBackoff = time.Second
+	defaultRetryMaxBackoff  = time.Minute
+)
+
+type LimiterOptions struct {
+	// RequestsPerMinute and TokensPerMinute bound the calls and the prompt
+	// and completion tokens spent per minute, 0 leaves them unbounded.
+	RequestsPerMinute int
+	TokensPerMinute   int
+	// MaxInFlight bounds the calls waiting for a response at once, 0 leaves
+	// it unbounded.
+	MaxInFlight int
+	// MaxRetries bounds the retries of a call failing with 429 or 5xx.
+	MaxRetries int
+	// BaseBackoff and MaxBackoff bound the exponential delay between
+	// retries, a longer Retry-After from the endpoint wins.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Limiter is shared by the LLM clients of a process to keep their combined
+// calls within the limits of the endpoint.
+type Limiter struct {
+	opts     LimiterOptions
+	requests *rate.Limiter
+	tokens   *rate.Limiter
+	inFlight chan struct{}
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func NewLimiter(opts LimiterOptions) *Limiter {
+	if opts.BaseBackoff <= 0 {
+		opts.BaseBackoff = defaultRetryBaseBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaultRetryMaxBackoff
+	}
+
+	l := &Limiter{opts: opts}
+	if opts.RequestsPerMinute > 0 {
+		l.requests = rate.NewLimiter(rate.Limit(float64(opts.RequestsPerMinute)/60), opts.RequestsPerMinute)
+	}
+	if opts.TokensPerMinute > 0 {
+		l.tokens = rate.NewLimiter(rate.Limit(float64(opts.TokensPerMinute)/60), opts.TokensPerMinute)
+	}
+	if opts.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, opts.MaxInFlight)
+	}
+	return l
+}
+
+// do runs call within the limits, retrying transient failures. tokens is the
+// estimated cost of the call, call returns the tokens actually spent.
+func (l *Limiter) do(ctx context.Context, operation string, tokens int, call func(ctx context.Context) (int, error)) error {
+	for attempt := 0; ; attempt++ {
+		release, err := l.acquire(ctx, operation, tokens)
+		if err != nil {
+			return err
+		}
+		spent, err := call(ctx)
+		release()
+		l.settle(tokens, spent)
+
+		if err == nil {
+			return nil
+		}
+		reason, retryable := retryReason(err)
+		if !retryable || attempt >= l.opts.MaxRetries || ctx.Err() != nil {
+			return err
+		}
+
+		delay := l.backoff(attempt)
+		if paused := time.Until(l.pauseDeadline()); paused > delay {
+			delay = paused
+		}
+		metrics.LLMRetries.WithLabelValues(operation, reason).Inc()
+		slog.Warn("llm call failed, retrying", "operation", operation, "attempt", attempt+1, "delay", delay, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// acquire waits out a Retry-After pause, a free in-flight slot and the rate
+// budget for a call, and records how long that took.
+func (l *Limiter) acquire(ctx context.Context, operation string, tokens int) (func(), error) {
+	started := time.Now()
+
+	if err := sleepUntil(ctx, l.pauseDeadline()); err != nil {
+		return nil, err
+	}
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		metrics.LLMInFlight.Dec()
+		if l.inFlight != nil {
+			<-l.inFlight
+		}
+	}
+	metrics.LLMInFlight.Inc()
+
+	if l.requests != nil {
+		if err := l.requests.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	if l.tokens != nil && tokens > 0 {
+		if err := l.tokens.WaitN(ctx, min(tokens, l.tokens.Burst())); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	metrics.LLMQueueWait.WithLabelValues(operation).Observe(time.Since(started).Seconds())
+	return release, nil
+}
+
+// settle charges the tokens a call spent beyond its estimate to the budget of
+// the calls after it.
+func (l *Limiter) settle(estimated, spent int) {
+	if l.tokens == nil || spent <= estimated {
+		return
+	}
+	l.tokens.ReserveN(time.Now(), min(spent-estimated, l.tokens.Burst()))
+}
+
+// pause holds back every call until t, the endpoint asked for it with
+// Retry-After.
+func (l *Limiter) pause(t time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if t.After(l.pausedUntil) {
+		l.pausedUntil = t
+	}
+}
+
+func (l *Limiter) pauseDeadline() time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.pausedUntil
+}
+
+// backoff doubles with every attempt up to the maximum, with jitter over its
+// upper half so callers do not retry in lockstep.
+func (l *Limiter) backoff(attempt int) time.Duration {
+	d := l.opts.BaseBackoff
+	for i := 0; i < attempt && d < l.opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, l.opts.MaxBackoff)
+	return d/2 + rand.N(d/2+1)
+}
+
+// retryReason reports whether err is transient: rate limited, a server error
+// or a network failure.
+func retryReason(err error) (string, bool) {
+	status := 0
+	var apiErr *openai.APIError
+	var requestErr *openai.RequestError
+	var httpErr *HTTPError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &apiErr):
+		status = apiErr.HTTPStatusCode
+	case errors.As(err, &requestErr):
+		status = requestErr.HTTPStatusCode
+	case errors.As(err, &httpErr):
+		status = httpErr.StatusCode
+	case errors.As(err, &netErr):
+		return "network", true
+	}
+
+	switch {
+	case status == http.StatusTooManyRequests:
+		return "rate_limited", true
+	case status >= http.StatusInternalServerError:
+		return "server_error", true
+	default:
+		return "", false
+	}
+}
+
+func sleepUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// retryAfterTransport pauses the limiter for as long as a rate limited or
+// unavailable endpoint asks with Retry-After.
+type retryAfterTransport struct {
+	base    http.RoundTripper
+	limiter *Limiter
+}
+
+func (t *retryAfterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			t.limiter.pause(time.Now().Add(d))
+		}
+	}
+	return resp, nil
+}
+
+// parseRetryAfter accepts both forms of Retry-After, delay seconds and an
+// HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// estimateTokens roughly counts the tokens of texts before the call: a token
+// per non-ASCII character, CJK text mostly, and per four ASCII characters.
+func estimateTokens(texts ...string) int {
+	tokens := 0
+	for _, text := range texts {
+		ascii := 0
+		for _, r := range text {
+			if r < 0x80 {
+				ascii++
+			} else {
+				tokens++
+			}
+		}
+		tokens += (ascii + 3) / 4
+	}
+	return tokens
+}
@@ -0,0 +1,271 @@
+package agent
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// DefaultOllamaURL is where a local Ollama listens.
+const DefaultOllamaURL = "http://localhost:11434"
+
+// Ollama calls the Ollama chat and embeddings APIs.
+type Ollama struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewOllama builds the adapter, baseURL may be empty for DefaultOllamaURL.
+// apiKey is sent as a bearer token when set, for instances behind a proxy.
+func NewOllama(baseURL, apiKey string, httpClient *http.Client) *Ollama {
+	if baseURL == "" {
+		baseURL = DefaultOllamaURL
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Ollama{baseURL: strings.TrimSuffix(baseURL, "/"), apiKey: apiKey, httpClient: httpClient}
+}
+
+type ollamaChatRequest struct {
+	Model    string          `json:"model"`
+	Messages []ollamaMessage `json:"messages"`
+	Tools    []openai.Tool   `json:"tools,omitempty"`
+	Options  map[string]any  `json:"options,omitempty"`
+	Stream   bool            `json:"stream"`
+}
+
+type ollamaMessage struct {
+	Role      string           `json:"role"`
+	Content   string           `json:"content"`
+	ToolCalls []ollamaToolCall `json:"tool_calls,omitempty"`
+}
+
+type ollamaToolCall struct {
+	Function struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	} `json:"function"`
+}
+
+// ollamaChatChunk is one line of a streamed chat response.
+type ollamaChatChunk struct {
+	Model           string        `json:"model"`
+	Message         ollamaMessage `json:"message"`
+	Done            bool          `json:"done"`
+	DoneReason      string        `json:"done_reason"`
+	PromptEvalCount int           `json:"prompt_eval_count"`
+	EvalCount       int           `json:"eval_count"`
+	Error           string        `json:"error"`
+}
+
+func (o *Ollama) ChatCompletion(ctx context.Context, request openai.ChatCompletionRequest, onDelta func(string)) (openai.ChatCompletionResponse, error) {
+	body, err := newOllamaChatRequest(request)
+	if err != nil {
+		return openai.ChatCompletionResponse{}, err
+	}
+
+	resp, err := o.post(ctx, "/api/chat", body)
+	if err != nil {
+		return openai.ChatCompletionResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	var (
+		response openai.ChatCompletionResponse
+		content  strings.Builder
+		finish   openai.FinishReason
+		calls    []openai.ToolCall
+	)
+	assemble := func() openai.ChatCompletionResponse {
+		response.Object = "chat.completion"
+		response.Usage.TotalTokens = response.Usage.PromptTokens + response.Usage.CompletionTokens
+		response.Choices = []openai.ChatCompletionChoice{{
+			Message: openai.ChatCompletionMessage{
+				Role:      openai.ChatMessageRoleAssistant,
+				Content:   content.String(),
+				ToolCalls: calls,
+			},
+			FinishReason: finish,
+		}}
+		return response
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64<<10), 4<<20)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var chunk ollamaChatChunk
+		if err := json.Unmarshal(line, &chunk); err != nil {
+			return assemble(), fmt.Errorf("ollama: invalid stream chunk: %w", err)
+		}
+		if chunk.Error != "" {
+			return assemble(), &HTTPError{Provider: ProviderOllama, StatusCode: http.StatusInternalServerError, Message: chunk.Error}
+		}
+
+		response.Model = chunk.Model
+		if chunk.Message.Content != "" {
+			content.WriteString(chunk.Message.Content)
+			onDelta(chunk.Message.Content)
+		}
+		for _, call := range chunk.Message.ToolCalls {
+			// Ollama sends whole calls with the arguments as an object and
+			// without ids.
+			calls = append(calls, openai.ToolCall{
+				ID:   fmt.Sprintf("call_%d", len(calls)),
+				Type: openai.ToolTypeFunction,
+				Function: openai.FunctionCall{
+					Name:      call.Function.Name,
+					Arguments: string(call.Function.Arguments),
+				},
+			})
+		}
+
+		if chunk.Done {
+			response.Usage.PromptTokens = chunk.PromptEvalCount
+			response.Usage.CompletionTokens = chunk.EvalCount
+			switch {
+			case len(calls) > 0:
+				finish = openai.FinishReasonToolCalls
+			case chunk.DoneReason == "length":
+				finish = openai.FinishReasonLength
+			default:
+				finish = openai.FinishReasonStop
+			}
+			return assemble(), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return assemble(), err
+	}
+	return assemble(), errors.New("ollama: stream ended before done")
+}
+
+// newOllamaChatRequest translates a chat completion request to the Ollama
+// chat API, sampling parameters move to options.
+func newOllamaChatRequest(request openai.ChatCompletionRequest) (ollamaChatRequest, error) {
+	body := ollamaChatRequest{
+		Model:  request.Model,
+		Tools:  request.Tools,
+		Stream: true,
+	}
+
+	options := make(map[string]any)
+	if request.Temperature != 0 {
+		options["temperature"] = request.Temperature
+	}
+	if request.TopP != 0 {
+		options["top_p"] = request.TopP
+	}
+	if request.MaxTokens != 0 {
+		options["num_predict"] = request.MaxTokens
+	} else if request.MaxCompletionTokens != 0 {
+		options["num_predict"] = request.MaxCompletionTokens
+	}
+	if len(request.Stop) > 0 {
+		options["stop"] = request.Stop
+	}
+	if request.Seed != nil {
+		options["seed"] = *request.Seed
+	}
+	if len(options) > 0 {
+		body.Options = options
+	}
+
+	for _, message := range request.Messages {
+		m := ollamaMessage{Role: message.Role, Content: message.Content}
+		if m.Role == openai.ChatMessageRoleDeveloper {
+			m.Role = openai.ChatMessageRoleSystem
+		}
+		for _, call := range message.ToolCalls {
+			var c ollamaToolCall
+			c.Function.Name = call.Function.Name
+			c.Function.Arguments = json.RawMessage(call.Function.Arguments)
+			if !json.Valid(c.Function.Arguments) {
+				return body, fmt.Errorf("ollama: tool call %s has invalid arguments", call.ID)
+			}
+			m.ToolCalls = append(m.ToolCalls, c)
+		}
+		body.Messages = append(body.Messages, m)
+	}
+	return body, nil
+}
+
+// CreateEmbeddings calls /api/embeddings once per input, it takes a single
+// prompt.
+func (o *Ollama) CreateEmbeddings(ctx context.Context, request openai.EmbeddingRequestStrings) (openai.EmbeddingResponse, error) {
+	response := openai.EmbeddingResponse{
+		Object: "list",
+		Model:  request.Model,
+		Data:   make([]openai.Embedding, 0, len(request.Input)),
+	}
+
+	for i, input := range request.Input {
+		resp, err := o.post(ctx, "/api/embeddings", map[string]string{
+			"model":  string(request.Model),
+			"prompt": input,
+		})
+		if err != nil {
+			return response, err
+		}
+
+		var decoded struct {
+			Embedding []float64 `json:"embedding"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&decoded)
+		resp.Body.Close()
+		if err != nil {
+			return response, fmt.Errorf("ollama: invalid embeddings response: %w", err)
+		}
+
+		embedding := make([]float32, len(decoded.Embedding))
+		for j, v := range decoded.Embedding {
+			embedding[j] = float32(v)
+		}
+		response.Data = append(response.Data, openai.Embedding{Object: "embedding", Index: i, Embedding: embedding})
+
+		// The API reports no usage, estimate it for the accounting.
+		response.Usage.PromptTokens += estimateTokens(input)
+	}
+	response.Usage.TotalTokens = response.Usage.PromptTokens
+	return response, nil
+}
+
+// post sends body as JSON to path and returns the response when it succeeded.
+func (o *Ollama) post(ctx context.Context, path string, body any) (*http.Response, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.baseURL+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if o.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+o.apiKey)
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, newHTTPError(ProviderOllama, resp)
+	}
+	return resp, nil
+}
@@ -0,0 +1,111 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// openAIProvider calls an OpenAI compatible endpoint through go-openai.
+type openAIProvider struct {
+	client *openai.Client
+}
+
+func newOpenAIProvider(baseURL, apiKey string, httpClient *http.Client) *openAIProvider {
+	config := openai.DefaultConfig(apiKey)
+	config.BaseURL = baseURL
+	config.HTTPClient = httpClient
+	return &openAIProvider{client: openai.NewClientWithConfig(config)}
+}
+
+func (p *openAIProvider) ChatCompletion(ctx context.Context, request openai.ChatCompletionRequest, onDelta func(string)) (openai.ChatCompletionResponse, error) {
+	request.Stream = true
+	request.StreamOptions = &openai.StreamOptions{IncludeUsage: true}
+
+	var (
+		response openai.ChatCompletionResponse
+		message  = openai.ChatCompletionMessage{Role: openai.ChatMessageRoleAssistant}
+		content  []byte
+		finish   openai.FinishReason
+	)
+	assemble := func() openai.ChatCompletionResponse {
+		message.Content = string(content)
+		response.Object = "chat.completion"
+		response.Choices = []openai.ChatCompletionChoice{{Message: message, FinishReason: finish}}
+		return response
+	}
+
+	stream, err := p.client.CreateChatCompletionStream(ctx, request)
+	if err != nil {
+		return response, err
+	}
+	defer stream.Close()
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return assemble(), nil
+		}
+		if err != nil {
+			return assemble(), err
+		}
+
+		response.ID = chunk.ID
+		response.Created = chunk.Created
+		response.Model = chunk.Model
+		response.SystemFingerprint = chunk.SystemFingerprint
+		if chunk.Usage != nil {
+			response.Usage = *chunk.Usage
+		}
+		for _, choice := range chunk.Choices {
+			if choice.Index != 0 {
+				continue
+			}
+			if choice.Delta.Role != "" {
+				message.Role = choice.Delta.Role
+			}
+			if choice.FinishReason != "" {
+				finish = choice.FinishReason
+			}
+			message.ToolCalls = appendToolCallDeltas(message.ToolCalls, choice.Delta.ToolCalls)
+			if choice.Delta.Content != "" {
+				content = append(content, choice.Delta.Content...)
+				onDelta(choice.Delta.Content)
+			}
+		}
+	}
+}
+
+// appendToolCallDeltas folds streamed tool call fragments into whole calls,
+// the arguments of a call arrive in pieces under the same index.
+func appendToolCallDeltas(calls []openai.ToolCall, deltas []openai.ToolCall) []openai.ToolCall {
+	for _, delta := range deltas {
+		index := len(calls)
+		if delta.Index != nil {
+			index = *delta.Index
+		}
+		for len(calls) <= index {
+			calls = append(calls, openai.ToolCall{Type: openai.ToolTypeFunction})
+		}
+
+		call := &calls[index]
+		if delta.ID != "" {
+			call.ID = delta.ID
+		}
+		if delta.Type != "" {
+			call.Type = delta.Type
+		}
+		if delta.Function.Name != "" {
+			call.Function.Name = delta.Function.Name
+		}
+		call.Function.Arguments += delta.Function.Arguments
+	}
+	return calls
+}
+
+func (p *openAIProvider) CreateEmbeddings(ctx context.Context, request openai.EmbeddingRequestStrings) (openai.EmbeddingResponse, error) {
+	return p.client.CreateEmbeddings(ctx, request)
+}
@@ -0,0 +1,119 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+const (
+	profileModel  = "deepseek/deepseek-v3.2"
+	profilePrompt = `你负责为群聊新成员维护“成员名片”。用户消息中给出某位群友“现有名片”的条目（编号 P1、P2……）和与其有关的“新事件”（编号 E1、E2……），请输出更新后的完整名片，格式如下：
+
+[分类];[条目内容];[依据编号]
+
+要求：
+1. [分类] 只能是 expertise（专长/技术栈）、interests（反复关注的话题）、contributions（值得一提的贡献，如解决的问题、分享的工具或项目）之一。
+2. [依据编号]：列出支撑该条目的编号，用英文逗号分隔，可以引用现有条目的 P 编号和新事件的 E 编号，至少一个。没有依据的内容不要写。
+3. 保留仍然成立的现有条目，用新事件补充或合并；内容重复的条目要合并，并保留双方的依据编号。
+4. 只描述这位群友本人，不要把其他参与者的专长或贡献算到他身上；保留原文中的技术术语、项目名称和工具名。
+5. 每个分类最多 8 条，每条一句话。只输出条目，不要解释。
+
+例子输出：
+expertise;Rust 与 WebAssembly，熟悉 wasm-bindgen 和性能调优;P1,E3
+interests;持续关注中文 Web 字体子集化方案;E1,E4
+contributions;重构 cn-font-split 的构建流程并迁移到 nix;E2`
+)
+
+// Profile sections written by UpdateProfile.
+const (
+	ProfileSectionExpertise     = "expertise"
+	ProfileSectionInterests     = "interests"
+	ProfileSectionContributions = "contributions"
+)
+
+// ProfileModel returns the model used by UpdateProfile.
+func ProfileModel() string {
+	return profileModel
+}
+
+// ProfileLine is one claim of a profile, Refs are the P and E labels of the
+// prompt input it is based on.
+type ProfileLine struct {
+	Section string
+	Text    string
+	Refs    []string
+}
+
+// UpdateProfile merges new events into a profile and returns the updated
+// claims. claims and events are numbered P1.. and E1.. in the prompt, in the
+// order given.
+func UpdateProfile(ctx context.Context, llmClient *LLMClient, name string, claims []ProfileLine, events []string) ([]ProfileLine, error) {
+	if len(events) == 0 {
+		return nil, errors.New("no events to update the profile with")
+	}
+
+	var input strings.Builder
+	fmt.Fprintf(&input, "群友：%s\n\n现有名片：\n", name)
+	if len(claims) == 0 {
+		input.WriteString("（空）\n")
+	}
+	for i, claim := range claims {
+		fmt.Fprintf(&input, "P%d;%s;%s\n", i+1, claim.Section, claim.Text)
+	}
+	input.WriteString("\n新事件：\n")
+	for i, e := range events {
+		fmt.Fprintf(&input, "E%d %s\n", i+1, e)
+	}
+
+	response, err := llmClient.createChatCompletion(ctx, stageProfile, openai.ChatCompletionRequest{
+		Model: profileModel,
+		Messages: []openai.ChatCompletionMessage{
+			{
+				Role:    "system",
+				Content: profilePrompt,
+			},
+			{
+				Role:    "user",
+				Content: input.String(),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	const partsPerLine = 3
+	lines := make([]ProfileLine, 0)
+	for _, content := range strings.Split(response.Choices[0].Message.Content, "\n") {
+		parts := strings.Split(strings.TrimSpace(content), ";")
+		if len(parts) != partsPerLine {
+			continue
+		}
+
+		section := strings.ToLower(strings.TrimSpace(parts[0]))
+		switch section {
+		case ProfileSectionExpertise, ProfileSectionInterests, ProfileSectionContributions:
+		default:
+			continue
+		}
+
+		text := strings.TrimSpace(parts[1])
+		refs := make([]string, 0)
+		for _, ref := range strings.Split(parts[2], ",") {
+			if ref = strings.ToUpper(strings.TrimSpace(ref)); ref != "" {
+				refs = append(refs, ref)
+			}
+		}
+		if text == "" || len(refs) == 0 {
+			continue
+		}
+
+		lines = append(lines, ProfileLine{Section: section, Text: text, Refs: refs})
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,236 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// Provider types accepted in the providers config.
+const (
+	ProviderOpenAI    = "openai"
+	ProviderAnthropic = "anthropic"
+	ProviderOllama    = "ollama"
+)
+
+// Stages returns the pipeline stages a provider can be chosen for.
+func Stages() []string {
+	return []string{stageSummarize, stageExtract, stageCondense, stageAnswer, stageProfile, stageEmbed}
+}
+
+// Provider is an LLM backend. Requests and responses use the OpenAI types,
+// the native adapters translate them to and from their own APIs.
+type Provider interface {
+	// ChatCompletion streams a completion, calling onDelta with every piece
+	// of content received, and returns the assembled response. On failure
+	// the response holds the content received until then.
+	ChatCompletion(ctx context.Context, request openai.ChatCompletionRequest, onDelta func(string)) (openai.ChatCompletionResponse, error)
+	CreateEmbeddings(ctx context.Context, request openai.EmbeddingRequestStrings) (openai.EmbeddingResponse, error)
+}
+
+// HTTPError is an error response from a native provider API.
+type HTTPError struct {
+	Provider   string
+	StatusCode int
+	Message    string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("%s: status code %d: %s", e.Provider, e.StatusCode, e.Message)
+}
+
+// newHTTPError reads the error message from a failed response of provider.
+func newHTTPError(provider string, resp *http.Response) *HTTPError {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	message := strings.TrimSpace(string(body))
+
+	// Anthropic nests the message in an error object, Ollama sends a string.
+	var decoded struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if json.Unmarshal(body, &decoded) == nil && len(decoded.Error) > 0 {
+		var nested struct {
+			Message string `json:"message"`
+		}
+		var flat string
+		switch {
+		case json.Unmarshal(decoded.Error, &nested) == nil && nested.Message != "":
+			message = nested.Message
+		case json.Unmarshal(decoded.Error, &flat) == nil && flat != "":
+			message = flat
+		}
+	}
+	return &HTTPError{Provider: provider, StatusCode: resp.StatusCode, Message: message}
+}
+
+// ProviderConfig configures one backend.
+type ProviderConfig struct {
+	// Type is openai, anthropic or ollama.
+	Type    string `json:"type"`
+	BaseURL string `json:"base_url"`
+	APIKey  string `json:"api_key"`
+	// APIKeyEnv names an environment variable holding the API key, to keep
+	// keys out of the config file.
+	APIKeyEnv string `json:"api_key_env"`
+}
+
+// StageConfig routes a stage to a provider, optionally with another model.
+type StageConfig struct {
+	Provider string `json:"provider"`
+	Model    string `json:"model"`
+}
+
+// ProvidersConfig names the backends and chooses one per stage. Stages
+// without an entry use the default provider and their built-in model. A
+// provider named default replaces LLM_BASE_URL and LLM_API_KEY as the default.
+type ProvidersConfig struct {
+	Providers map[string]ProviderConfig `json:"providers"`
+	Stages    map[string]StageConfig    `json:"stages"`
+}
+
+// LoadProvidersConfig reads a providers config from a JSON file, e.g.
+//
+//	{
+//	  "providers": {
+//	    "claude": {"type": "anthropic", "api_key_env": "ANTHROPIC_API_KEY"},
+//	    "local": {"type": "ollama", "base_url": "http://localhost:11434"}
+//	  },
+//	  "stages": {
+//	    "summarize": {"provider": "claude", "model": "claude-sonnet-4-5"},
+//	    "extract": {"provider": "local", "model": "qwen2.5:14b"},
+//	    "embed": {"provider": "local"}
+//	  }
+//	}
+func LoadProvidersConfig(path string) (*ProvidersConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := ParseProvidersConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse providers config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// ParseProvidersConfig parses a providers config in the format of
+// LoadProvidersConfig and resolves the API keys named by api_key_env.
+func ParseProvidersConfig(data []byte) (*ProvidersConfig, error) {
+	var config ProvidersConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	for name, provider := range config.Providers {
+		if provider.APIKey == "" && provider.APIKeyEnv != "" {
+			provider.APIKey = os.Getenv(provider.APIKeyEnv)
+			config.Providers[name] = provider
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// validate requires a model of the stages that run on anthropic or ollama,
+// the built-in models are OpenRouter model names. Embed takes its model from
+// EMBEDDING_MODEL.
+func (c *ProvidersConfig) validate() error {
+	for _, stage := range Stages() {
+		if stage == stageEmbed {
+			continue
+		}
+		stageConfig, routed := c.Stages[stage]
+		providerName := lo.Ternary(routed, stageConfig.Provider, defaultProviderName)
+		provider, ok := c.Providers[providerName]
+		if !ok || provider.Type == ProviderOpenAI || stageConfig.Model != "" {
+			continue
+		}
+		if routed {
+			return fmt.Errorf("stage %s runs on %s provider %s and needs a model", stage, provider.Type, providerName)
+		}
+		return fmt.Errorf("stage %s runs on the default %s provider and needs a model", stage, provider.Type)
+	}
+	return nil
+}
+
+// route is the provider and model a stage runs on. An empty model keeps the
+// built-in one.
+type route struct {
+	provider Provider
+	model    string
+}
+
+// newProvider builds the adapter for a configured backend.
+func newProvider(name string, config ProviderConfig, httpClient *http.Client) (Provider, error) {
+	switch config.Type {
+	case ProviderOpenAI:
+		if config.BaseURL == "" || config.APIKey == "" {
+			return nil, fmt.Errorf("provider %s: base_url and api key are required", name)
+		}
+		return newOpenAIProvider(config.BaseURL, config.APIKey, httpClient), nil
+	case ProviderAnthropic:
+		if config.APIKey == "" {
+			return nil, fmt.Errorf("provider %s: api key is required", name)
+		}
+		return NewAnthropic(config.BaseURL, config.APIKey, httpClient), nil
+	case ProviderOllama:
+		return NewOllama(config.BaseURL, config.APIKey, httpClient), nil
+	default:
+		return nil, fmt.Errorf("provider %s: unknown type %q", name, config.Type)
+	}
+}
+
+// defaultProviderName names the provider stages without a route use.
+const defaultProviderName = "default"
+
+// newRoutes builds the configured providers and the routes of the stages
+// that use them.
+func newRoutes(config *ProvidersConfig, httpClient *http.Client) (map[string]Provider, map[string]route, error) {
+	providers := make(map[string]Provider)
+	routes := make(map[string]route)
+	if config == nil {
+		return providers, routes, nil
+	}
+
+	for name, providerConfig := range config.Providers {
+		provider, err := newProvider(name, providerConfig, httpClient)
+		if err != nil {
+			return nil, nil, err
+		}
+		providers[name] = provider
+	}
+
+	stages := Stages()
+	for stage, stageConfig := range config.Stages {
+		if !slices.Contains(stages, stage) {
+			return nil, nil, fmt.Errorf("unknown stage %q, expected one of %s", stage, strings.Join(stages, ", "))
+		}
+		provider, ok := providers[stageConfig.Provider]
+		if !ok {
+			return nil, nil, fmt.Errorf("stage %s uses unknown provider %q", stage, stageConfig.Provider)
+		}
+		if stage == stageEmbed {
+			if _, ok := provider.(*Anthropic); ok {
+				return nil, nil, errors.New("anthropic has no embeddings API, choose another provider for the embed stage")
+			}
+			// The vector size decides the column vectors are stored in, so
+			// the embedding model is configured together with it.
+			if stageConfig.Model != "" {
+				return nil, nil, errors.New("the embed stage takes its model from EMBEDDING_MODEL and EMBEDDING_DIMENSIONS")
+			}
+		}
+		routes[stage] = route{provider: provider, model: stageConfig.Model}
+	}
+	return providers, routes, nil
+}
@@ -0,0 +1,140 @@
+package agent_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/agent/providertest"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+func TestParseProvidersConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name: "routed stages with models",
+			config: `{
+				"providers": {"claude": {"type": "anthropic", "api_key": "k"}, "local": {"type": "ollama"}},
+				"stages": {"summarize": {"provider": "claude", "model": "claude-sonnet-4-5"}, "embed": {"provider": "local"}}
+			}`,
+		},
+		{
+			name:   "openai routes keep the built-in model",
+			config: `{"providers": {"router": {"type": "openai", "base_url": "http://x", "api_key": "k"}}, "stages": {"extract": {"provider": "router"}}}`,
+		},
+		{
+			name:    "anthropic route without a model",
+			config:  `{"providers": {"claude": {"type": "anthropic", "api_key": "k"}}, "stages": {"extract": {"provider": "claude"}}}`,
+			wantErr: "stage extract runs on anthropic provider claude and needs a model",
+		},
+		{
+			name:    "default ollama provider without models",
+			config:  `{"providers": {"default": {"type": "ollama"}}, "stages": {"summarize": {"provider": "default", "model": "qwen2.5:14b"}}}`,
+			wantErr: "stage extract runs on the default ollama provider and needs a model",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := agent.ParseProvidersConfig([]byte(tt.config))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStagesRunOnStandins(t *testing.T) {
+	server, handler := providertest.NewServer()
+	defer server.Close()
+	handler.Dimensions = 8
+
+	config, err := agent.ParseProvidersConfig(fmt.Appendf(nil, `{
+		"providers": {
+			"claude": {"type": "anthropic", "base_url": %[1]q, "api_key": "k"},
+			"local": {"type": "ollama", "base_url": %[1]q}
+		},
+		"stages": {
+			"summarize": {"provider": "claude", "model": "claude-test"},
+			"embed": {"provider": "local"}
+		}
+	}`, server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := agent.NewLLMClient("http://unused", "unused", agent.ClientOptions{Providers: config})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summary, err := agent.SummaryMessages(context.Background(), client, []string{"alice: hello", "bob: hi there"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary != "alice: hello\nbob: hi there" {
+		t.Errorf("summary = %q, want the echoed prompt", summary)
+	}
+
+	model := agent.EmbeddingModel{Name: "nomic-embed-text", Dimensions: 8}
+	vectors, err := agent.EmbedTexts(context.Background(), client, model, []string{"hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range providertest.Embedding("hello", 8) {
+		if float32(v) != vectors[0][i] {
+			t.Fatalf("vector = %v, want the stand-in embedding", vectors[0])
+		}
+	}
+
+	requests := handler.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("got %d requests, want 2", len(requests))
+	}
+	if requests[0].Path != "/v1/messages" || requests[0].Model != "claude-test" {
+		t.Errorf("summarize went to %s with model %s", requests[0].Path, requests[0].Model)
+	}
+	if requests[1].Path != "/api/embeddings" || requests[1].Model != "nomic-embed-text" {
+		t.Errorf("embed went to %s with model %s", requests[1].Path, requests[1].Model)
+	}
+}
+
+func TestProviderErrors(t *testing.T) {
+	server, handler := providertest.NewServer()
+	defer server.Close()
+
+	providers := map[string]agent.Provider{
+		"anthropic": agent.NewAnthropic(server.URL, "k", http.DefaultClient),
+		"ollama":    agent.NewOllama(server.URL, "", http.DefaultClient),
+	}
+	for name, provider := range providers {
+		t.Run(name, func(t *testing.T) {
+			handler.FailNext(http.StatusBadRequest)
+			_, err := provider.ChatCompletion(context.Background(), openai.ChatCompletionRequest{
+				Model:    "m",
+				Messages: []openai.ChatCompletionMessage{{Role: "user", Content: "hi"}},
+			}, nil)
+
+			var httpErr *agent.HTTPError
+			if !errors.As(err, &httpErr) {
+				t.Fatalf("got error %v, want an HTTPError", err)
+			}
+			if httpErr.StatusCode != http.StatusBadRequest || !strings.Contains(httpErr.Message, "Bad Request") {
+				t.Errorf("got %d %q", httpErr.StatusCode, httpErr.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+// Package providertest provides a local stand-in for the Anthropic Messages
+// API and the Ollama chat and embeddings APIs, answering with canned
+// completions and deterministic vectors instead of running a model.
+package providertest
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const defaultDimensions = 768
+
+// Request is a call received by the stand-in.
+type Request struct {
+	Path   string `json:"path"`
+	Model  string `json:"model"`
+	System string `json:"system"`
+	// Prompt is the last user message, or the text to embed.
+	Prompt string `json:"prompt"`
+	Tools  int    `json:"tools"`
+}
+
+// Handler serves POST /v1/messages as Anthropic, streaming server-sent
+// events, and POST /api/chat and /api/embeddings as Ollama, streaming
+// newline-delimited JSON.
+type Handler struct {
+	// Reply returns the completion of a request, it echoes the prompt when
+	// unset. Requests with tools get a call of the first tool instead.
+	Reply func(system, prompt string) string
+	// Dimensions is the size of embeddings, 768 when zero.
+	Dimensions int
+
+	mu       sync.Mutex
+	requests []Request
+	failures []int
+}
+
+// Requests returns the requests received so far, in order.
+func (h *Handler) Requests() []Request {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]Request(nil), h.requests...)
+}
+
+// FailNext makes the next requests fail with the given HTTP statuses, one
+// status per request.
+func (h *Handler) FailNext(statuses ...int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.failures = append(h.failures, statuses...)
+}
+
+// chatBody is the part of both chat APIs the stand-in reads.
+type chatBody struct {
+	Model    string          `json:"model"`
+	System   json.RawMessage `json:"system"`
+	Messages []struct {
+		Role    string          `json:"role"`
+		Content json.RawMessage `json:"content"`
+	} `json:"messages"`
+	Tools []struct {
+		Name     string `json:"name"`
+		Function struct {
+			Name string `json:"name"`
+		} `json:"function"`
+	} `json:"tools"`
+	Prompt string `json:"prompt"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	anthropic := r.URL.Path == "/v1/messages"
+	if !anthropic && r.URL.Path != "/api/chat" && r.URL.Path != "/api/embeddings" {
+		http.NotFound(w, r)
+		return
+	}
+
+	var body chatBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		fail(w, anthropic, http.StatusBadRequest, "invalid json")
+		return
+	}
+	if anthropic && r.Header.Get("X-Api-Key") == "" {
+		fail(w, anthropic, http.StatusUnauthorized, "missing x-api-key header")
+		return
+	}
+
+	request := Request{Path: r.URL.Path, Model: body.Model, Tools: len(body.Tools), Prompt: body.Prompt}
+	var system []string
+	if s := text(body.System); s != "" {
+		system = append(system, s)
+	}
+	for _, message := range body.Messages {
+		switch message.Role {
+		case "system":
+			system = append(system, text(message.Content))
+		case "user":
+			request.Prompt = text(message.Content)
+		}
+	}
+	request.System = strings.Join(system, "\n\n")
+
+	h.mu.Lock()
+	if len(h.failures) > 0 {
+		status := h.failures[0]
+		h.failures = h.failures[1:]
+		h.mu.Unlock()
+		message := http.StatusText(status)
+		if message == "" {
+			// Anthropic answers 529 when overloaded.
+			message = "Overloaded"
+		}
+		fail(w, anthropic, status, message)
+		return
+	}
+	h.requests = append(h.requests, request)
+	reply, dimensions := h.Reply, h.Dimensions
+	h.mu.Unlock()
+
+	if r.URL.Path == "/api/embeddings" {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"embedding": Embedding(body.Prompt, dimensions)})
+		return
+	}
+
+	content := request.Prompt
+	if reply != nil {
+		content = reply(request.System, request.Prompt)
+	}
+	var tool string
+	if len(body.Tools) > 0 {
+		tool = body.Tools[0].Name
+		if tool == "" {
+			tool = body.Tools[0].Function.Name
+		}
+	}
+
+	if anthropic {
+		streamAnthropic(w, request, content, tool)
+	} else {
+		streamOllama(w, request, content, tool)
+	}
+}
+
+// text returns a string content, or the text blocks of a content array.
+func text(content json.RawMessage) string {
+	var s string
+	if json.Unmarshal(content, &s) == nil {
+		return s
+	}
+	var blocks []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	_ = json.Unmarshal(content, &blocks)
+	var parts []string
+	for _, block := range blocks {
+		if block.Type == "text" {
+			parts = append(parts, block.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// words splits content into the pieces it is streamed in.
+func words(content string) []string {
+	var pieces []string
+	for content != "" {
+		i := strings.IndexByte(content, ' ')
+		if i < 0 {
+			return append(pieces, content)
+		}
+		pieces = append(pieces, content[:i+1])
+		content = content[i+1:]
+	}
+	return pieces
+}
+
+func streamAnthropic(w http.ResponseWriter, request Request, content, tool string) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	flusher, _ := w.(http.Flusher)
+	send := func(event string, data map[string]any) {
+		data["type"] = event
+		encoded, _ := json.Marshal(data)
+		fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, encoded)
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	send("message_start", map[string]any{"message": map[string]any{
+		"id":    "msg_standin",
+		"type":  "message",
+		"role":  "assistant",
+		"model": request.Model,
+		"usage": map[string]int{"input_tokens": len(strings.Fields(request.System + " " + request.Prompt)), "output_tokens": 1},
+	}})
+
+	stopReason := "end_turn"
+	if tool != "" {
+		stopReason = "tool_use"
+		input, _ := json.Marshal(map[string]string{"text": content})
+		send("content_block_start", map[string]any{"index": 0, "content_block": map[string]any{
+			"type": "tool_use", "id": "toolu_standin", "name": tool, "input": map[string]any{},
+		}})
+		half := len(input) / 2
+		for _, part := range []string{string(input[:half]), string(input[half:])} {
+			send("content_block_delta", map[string]any{"index": 0, "delta": map[string]any{"type": "input_json_delta", "partial_json": part}})
+		}
+	} else {
+		send("content_block_start", map[string]any{"index": 0, "content_block": map[string]any{"type": "text", "text": ""}})
+		for _, piece := range words(content) {
+			send("content_block_delta", map[string]any{"index": 0, "delta": map[string]any{"type": "text_delta", "text": piece}})
+		}
+	}
+	send("content_block_stop", map[string]any{"index": 0})
+	send("message_delta", map[string]any{
+		"delta": map[string]any{"stop_reason": stopReason},
+		"usage": map[string]int{"output_tokens": len(strings.Fields(content))},
+	})
+	send("message_stop", map[string]any{})
+}
+
+func streamOllama(w http.ResponseWriter, request Request, content, tool string) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+	send := func(chunk map[string]any) {
+		chunk["model"] = request.Model
+		_ = encoder.Encode(chunk)
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	if tool != "" {
+		send(map[string]any{"message": map[string]any{
+			"role":    "assistant",
+			"content": "",
+			"tool_calls": []any{map[string]any{"function": map[string]any{
+				"name": tool, "arguments": map[string]string{"text": content},
+			}}},
+		}, "done": false})
+	} else {
+		for _, piece := range words(content) {
+			send(map[string]any{"message": map[string]string{"role": "assistant", "content": piece}, "done": false})
+		}
+	}
+	send(map[string]any{
+		"message":           map[string]string{"role": "assistant", "content": ""},
+		"done":              true,
+		"done_reason":       "stop",
+		"prompt_eval_count": len(strings.Fields(request.System + " " + request.Prompt)),
+		"eval_count":        len(strings.Fields(content)),
+	})
+}
+
+func fail(w http.ResponseWriter, anthropic bool, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	if status == http.StatusTooManyRequests {
+		w.Header().Set("Retry-After", "1")
+	}
+	w.WriteHeader(status)
+	if anthropic {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"type":  "error",
+			"error": map[string]string{"type": "api_error", "message": message},
+		})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
+
+// Embedding returns the unit vector the stand-in embeds text as, derived
+// from its hash so equal texts get equal vectors.
+func Embedding(text string, dimensions int) []float64 {
+	if dimensions == 0 {
+		dimensions = defaultDimensions
+	}
+	vector := make([]float64, dimensions)
+	var norm float64
+	seed := sha256.Sum256([]byte(text))
+	for i := range vector {
+		if i%8 == 0 && i > 0 {
+			seed = sha256.Sum256(seed[:])
+		}
+		v := binary.BigEndian.Uint32(seed[(i%8)*4:])
+		vector[i] = float64(v)/math.MaxUint32*2 - 1
+		norm += vector[i] * vector[i]
+	}
+	norm = math.Sqrt(norm)
+	for i := range vector {
+		vector[i] /= norm
+	}
+	return vector
+}
+
+// NewServer starts a stand-in on a local port. Point the anthropic or ollama
+// provider at its URL and close it when done.
+func NewServer() (*httptest.Server, *Handler) {
+	h := &Handler{}
+	return httptest.NewServer(h), h
+}
@@ -0,0 +1,95 @@
+package agent
+
+import (
+	"context"
+	"sync/atomic"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// PartialError is returned by a completion interrupted after some output was
+// streamed, e.g. when its context was cancelled.
+type PartialError struct {
+	// Output is the content streamed before the interruption.
+	Output string
+	Err    error
+}
+
+func (e *PartialError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PartialError) Unwrap() error {
+	return e.Err
+}
+
+// ProgressEvent reports the progress of one streamed completion.
+type ProgressEvent struct {
+	// CallID tells concurrent completions apart.
+	CallID uint64
+	Stage  string
+	// Tokens is the estimated number of tokens received so far.
+	Tokens int
+	Done   bool
+	Err    error
+}
+
+// ProgressFunc receives progress events, it is called from the goroutines
+// of the completions and must not block.
+type ProgressFunc func(ProgressEvent)
+
+type progressKey struct{}
+
+// WithProgress returns a context under which streamed completions report
+// their progress to fn.
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+var callIDs atomic.Uint64
+
+// streamChatCompletion streams a completion from provider, reporting its
+// progress to the ProgressFunc of ctx. On failure the response holds the
+// content received until then.
+func streamChatCompletion(ctx context.Context, provider Provider, stage string, request openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error) {
+	progress, _ := ctx.Value(progressKey{}).(ProgressFunc)
+	event := ProgressEvent{CallID: callIDs.Add(1), Stage: stage}
+	report := func(done bool, err error) {
+		if progress != nil {
+			event.Done, event.Err = done, err
+			progress(event)
+		}
+	}
+
+	report(false, nil)
+	response, err := provider.ChatCompletion(ctx, request, func(delta string) {
+		event.Tokens += estimateTokens(delta)
+		report(false, nil)
+	})
+	if err != nil {
+		report(true, err)
+		return response, err
+	}
+
+	// Endpoints that ignore include_usage send none, estimate it for the
+	// accounting instead of recording nothing.
+	if response.Usage.TotalTokens == 0 {
+		for _, message := range request.Messages {
+			response.Usage.PromptTokens += estimateTokens(message.Content)
+		}
+		response.Usage.CompletionTokens = event.Tokens
+		response.Usage.TotalTokens = response.Usage.PromptTokens + response.Usage.CompletionTokens
+	}
+
+	report(true, nil)
+	return response, nil
+}
+
+// responseContent returns the content of the first choice of response, empty
+// when there is none.
+func responseContent(response openai.ChatCompletionResponse) string {
+	if len(response.Choices) == 0 {
+		return ""
+	}
+	return response.Choices[0].Message.Content
+}
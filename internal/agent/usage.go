@@ -0,0 +1,138 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/luoling8192/mindwave/internal/metrics"
+)
+
+// Stages of the pipeline LLM usage is accounted to.
+const (
+	stageSummarize = "summarize"
+	stageExtract   = "extract"
+	stageCondense  = "condense"
+	stageAnswer    = "answer"
+	stageProfile   = "profile"
+	stageEmbed     = "embed"
+)
+
+// ModelPrice is the price of a model in USD per million tokens.
+type ModelPrice struct {
+	Prompt     float64 `json:"prompt"`
+	Completion float64 `json:"completion"`
+}
+
+// PriceTable maps model names to their prices, models missing from it are
+// accounted at no cost.
+type PriceTable map[string]ModelPrice
+
+// LoadPriceTable reads a price table from a JSON object keyed by model name,
+// e.g. {"deepseek/deepseek-v3.2": {"prompt": 0.28, "completion": 0.42}}.
+func LoadPriceTable(path string) (PriceTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var prices PriceTable
+	if err := json.Unmarshal(data, &prices); err != nil {
+		return nil, fmt.Errorf("failed to parse price table %s: %w", path, err)
+	}
+	return prices, nil
+}
+
+// Cost returns the price in USD of the tokens spent on model.
+func (p PriceTable) Cost(model string, promptTokens, completionTokens int) float64 {
+	price, ok := p[model]
+	if !ok {
+		return 0
+	}
+	return (float64(promptTokens)*price.Prompt + float64(completionTokens)*price.Completion) / 1e6
+}
+
+// Usage is the tokens and cost of LLM calls.
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+	Cost             float64
+}
+
+func (u Usage) TotalTokens() int {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// UsageRecorder sums the usage of the LLM calls made with a context carrying
+// it, overall and by stage.
+type UsageRecorder struct {
+	mu      sync.Mutex
+	total   Usage
+	byStage map[string]Usage
+}
+
+func NewUsageRecorder() *UsageRecorder {
+	return &UsageRecorder{byStage: make(map[string]Usage)}
+}
+
+func (r *UsageRecorder) add(stage string, usage Usage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.total.PromptTokens += usage.PromptTokens
+	r.total.CompletionTokens += usage.CompletionTokens
+	r.total.Cost += usage.Cost
+
+	s := r.byStage[stage]
+	s.PromptTokens += usage.PromptTokens
+	s.CompletionTokens += usage.CompletionTokens
+	s.Cost += usage.Cost
+	r.byStage[stage] = s
+}
+
+// Total returns the usage recorded so far.
+func (r *UsageRecorder) Total() Usage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.total
+}
+
+// ByStage returns the usage recorded so far by stage.
+func (r *UsageRecorder) ByStage() map[string]Usage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	byStage := make(map[string]Usage, len(r.byStage))
+	for stage, usage := range r.byStage {
+		byStage[stage] = usage
+	}
+	return byStage
+}
+
+type usageRecorderKey struct{}
+
+// WithUsageRecorder returns a context under which LLM calls record their
+// usage to r.
+func WithUsageRecorder(ctx context.Context, r *UsageRecorder) context.Context {
+	return context.WithValue(ctx, usageRecorderKey{}, r)
+}
+
+// recordUsage accounts the tokens of a call to the metrics and to the
+// recorder of ctx, if any.
+func (c *LLMClient) recordUsage(ctx context.Context, stage, model string, promptTokens, completionTokens int) {
+	usage := Usage{
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		Cost:             c.prices.Cost(model, promptTokens, completionTokens),
+	}
+
+	metrics.LLMTokens.WithLabelValues(stage, model, "prompt").Add(float64(promptTokens))
+	metrics.LLMTokens.WithLabelValues(stage, model, "completion").Add(float64(completionTokens))
+	metrics.LLMCost.WithLabelValues(stage, model).Add(usage.Cost)
+
+	if r, ok := ctx.Value(usageRecorderKey{}).(*UsageRecorder); ok {
+		r.add(stage, usage)
+	}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// cursor points after the last row of a page in (timestamp, id) descending
+// order, so rows sharing a timestamp are neither skipped nor repeated.
+type cursor struct {
+	Timestamp int64
+	ID        uuid.UUID
+}
+
+func (c cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.Timestamp, 10) + "|" + c.ID.String()))
+}
+
+func parseCursor(value string) (*cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, errors.New("invalid cursor")
+	}
+
+	timestamp, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	return &cursor{Timestamp: timestamp, ID: parsedID}, nil
+}
+
+// after returns a predicate selecting rows that come after the cursor when
+// ordered by timestampField and id descending.
+func (c *cursor) after(timestampField string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			sql.LT(s.C(timestampField), c.Timestamp),
+			sql.And(
+				sql.EQ(s.C(timestampField), c.Timestamp),
+				sql.LT(s.C("id"), c.ID),
+			),
+		))
+	}
+}
+
+// paginate trims the extra row fetched to detect a next page and returns the
+// cursor for it.
+func paginate[T any](rows []T, limit int, key func(T) cursor) ([]T, string) {
+	if len(rows) <= limit {
+		return rows, ""
+	}
+
+	rows = rows[:limit]
+	return rows, key(rows[len(rows)-1]).String()
+}
@@ -0,0 +1,90 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/api"
+	"github.com/luoling8192/mindwave/internal/datastore/datastoretest"
+)
+
+func TestPaginationTies(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t, migrate.JoinedChatsTable)
+
+	// Most chats share a dialog date, so pages end inside a tie.
+	dates := []int64{300, 200, 200, 200, 200, 200, 100}
+	for i, date := range dates {
+		chatID := string(rune('a' + i))
+		err := client.JoinedChat.Create().SetPlatform("telegram").SetChatID(chatID).SetChatName(chatID).SetDialogDate(date).Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	all, err := client.JoinedChat.Query().All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.SortFunc(all, func(a, b *ent.JoinedChat) int {
+		if a.DialogDate != b.DialogDate {
+			return int(b.DialogDate - a.DialogDate)
+		}
+		return -strings.Compare(a.ID.String(), b.ID.String())
+	})
+
+	handler := api.NewServer(client, nil, nil, nil, api.Options{}).Handler()
+	for _, limit := range []string{"1", "2", "3"} {
+		var (
+			got  []uuid.UUID
+			next string
+		)
+		for pages := 0; ; pages++ {
+			if pages > len(dates) {
+				t.Fatalf("limit %s: pagination does not end", limit)
+			}
+			query := url.Values{"limit": {limit}}
+			if next != "" {
+				query.Set("cursor", next)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/chats?"+query.Encode(), nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("limit %s: got status %d: %s", limit, w.Code, w.Body)
+			}
+
+			var page api.Page[api.Chat]
+			if err := json.NewDecoder(w.Body).Decode(&page); err != nil {
+				t.Fatal(err)
+			}
+			for _, c := range page.Data {
+				got = append(got, c.ID)
+			}
+			if next = page.NextCursor; next == "" {
+				break
+			}
+		}
+
+		want := make([]uuid.UUID, 0, len(all))
+		for _, c := range all {
+			want = append(want, c.ID)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("limit %s: paged through %v, want every chat once in order %v", limit, got, want)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/chats?cursor=not-a-cursor", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("invalid cursor: got status %d, want 400", w.Code)
+	}
+}
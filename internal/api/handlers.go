@@ -0,0 +1,661 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/accesslog"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/ent/summary"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/services/ask"
+	"github.com/luoling8192/mindwave/internal/services/experts"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/luoling8192/mindwave/internal/services/search"
+	"github.com/samber/lo"
+)
+
+func (s *Server) handleListOwners(w http.ResponseWriter, r *http.Request) {
+	accounts, err := owners.Accounts(r.Context(), s.client)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	scope := scopeOf(r)
+	accounts = lo.Filter(accounts, func(a owners.Account, _ int) bool { return scope.AllowsOwner(a.ID) })
+	writeJSON(w, http.StatusOK, Page[Owner]{Data: lo.Map(accounts, func(a owners.Account, _ int) Owner { return newOwner(a) })})
+}
+
+func (s *Server) handleListChats(w http.ResponseWriter, r *http.Request) {
+	limit, after, err := pageParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	owner, err := ownerParam(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	query := s.client.JoinedChat.Query()
+	if chatIDs := scopeOf(r).Chats(); chatIDs != nil {
+		query = query.Where(joinedchat.ChatIDIn(chatIDs...))
+	}
+	if owner != uuid.Nil {
+		if !scopeOf(r).AllowsOwner(owner) {
+			writeError(w, http.StatusForbidden, owners.ErrNotLinked)
+			return
+		}
+		chatIDs, err := owners.Chats(r.Context(), s.client, []uuid.UUID{owner})
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		query = query.Where(joinedchat.ChatIDIn(chatIDs...))
+	}
+	if platform := r.URL.Query().Get("platform"); platform != "" {
+		query = query.Where(joinedchat.Platform(platform))
+	}
+	if chatType := r.URL.Query().Get("type"); chatType != "" {
+		query = query.Where(joinedchat.ChatType(chatType))
+	}
+	if after != nil {
+		query = query.Where(after.after(joinedchat.FieldDialogDate))
+	}
+
+	chats, err := query.
+		Order(joinedchat.ByDialogDate(sql.OrderDesc()), joinedchat.ByID(sql.OrderDesc())).
+		Limit(limit + 1).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	chats, next := paginate(chats, limit, func(c *ent.JoinedChat) cursor {
+		return cursor{Timestamp: c.DialogDate, ID: c.ID}
+	})
+	writeJSON(w, http.StatusOK, Page[Chat]{Data: lo.Map(chats, func(c *ent.JoinedChat, _ int) Chat { return newChat(c) }), NextCursor: next})
+}
+
+func (s *Server) handleListEvents(w http.ResponseWriter, r *http.Request) {
+	limit, after, err := pageParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	query := s.client.Event.Query().WithIdentities(func(q *ent.IdentityQuery) {
+		q.Select(identity.FieldID)
+	})
+
+	if chatIDs := scopeOf(r).Chats(); chatIDs != nil {
+		query = query.Where(event.InChatIDIn(chatIDs...))
+	}
+
+	params := r.URL.Query()
+	if chatID := params.Get("chat_id"); chatID != "" {
+		query = query.Where(event.InChatID(chatID))
+	}
+	if tag := params.Get("tag"); tag != "" {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(event.FieldTags, tag))
+		})
+	}
+	if participant := params.Get("participant"); participant != "" {
+		participantID, err := uuid.Parse(participant)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errors.New("participant must be an identity id"))
+			return
+		}
+		query = query.Where(event.HasIdentitiesWith(identity.ID(participantID)))
+	}
+	if person := params.Get("person"); person != "" {
+		personID, err := uuid.Parse(person)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errors.New("person must be a person id"))
+			return
+		}
+		query = query.Where(event.HasIdentitiesWith(identity.PersonID(personID)))
+	}
+
+	since, ok, err := timeParam(r, "since")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if ok {
+		query = query.Where(event.PlatformTimestampGTE(since))
+	}
+	until, ok, err := timeParam(r, "until")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if ok {
+		query = query.Where(event.PlatformTimestampLTE(until))
+	}
+	if after != nil {
+		query = query.Where(after.after(event.FieldPlatformTimestamp))
+	}
+
+	s.writeEvents(w, r, query, limit)
+}
+
+func (s *Server) writeEvents(w http.ResponseWriter, r *http.Request, query *ent.EventQuery, limit int) {
+	events, err := query.
+		Order(event.ByPlatformTimestamp(sql.OrderDesc()), event.ByID(sql.OrderDesc())).
+		Limit(limit + 1).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	events, next := paginate(events, limit, func(e *ent.Event) cursor {
+		return cursor{Timestamp: e.PlatformTimestamp, ID: e.ID}
+	})
+	writeJSON(w, http.StatusOK, Page[Event]{Data: lo.Map(events, func(e *ent.Event, _ int) Event { return newEvent(e) }), NextCursor: next})
+}
+
+func (s *Server) handleGetEvent(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("invalid event id"))
+		return
+	}
+
+	e, err := s.client.Event.Query().
+		Where(event.ID(id)).
+		WithIdentities(func(q *ent.IdentityQuery) {
+			q.Select(identity.FieldID)
+		}).
+		Only(r.Context())
+	if ent.IsNotFound(err) || (err == nil && !scopeOf(r).AllowsChat(e.InChatID)) {
+		writeError(w, http.StatusNotFound, errors.New("event not found"))
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newEvent(e))
+}
+
+func (s *Server) handleListIdentities(w http.ResponseWriter, r *http.Request) {
+	limit, after, err := pageParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	query := s.client.Identity.Query().Where(visibleIdentities(scopeOf(r))...)
+	params := r.URL.Query()
+	if platform := params.Get("platform"); platform != "" {
+		query = query.Where(identity.Platform(platform))
+	}
+	if q := params.Get("q"); q != "" {
+		query = query.Where(identity.Or(
+			identity.DisplayNameContainsFold(q),
+			identity.UsernameContainsFold(q),
+		))
+	}
+	if person := params.Get("person"); person != "" {
+		personID, err := uuid.Parse(person)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errors.New("person must be a person id"))
+			return
+		}
+		query = query.Where(identity.PersonID(personID))
+	}
+	if after != nil {
+		query = query.Where(after.after(identity.FieldCreatedAt))
+	}
+
+	identities, err := query.
+		Order(identity.ByCreatedAt(sql.OrderDesc()), identity.ByID(sql.OrderDesc())).
+		Limit(limit + 1).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	identities, next := paginate(identities, limit, func(i *ent.Identity) cursor {
+		return cursor{Timestamp: i.CreatedAt, ID: i.ID}
+	})
+	writeJSON(w, http.StatusOK, Page[Identity]{Data: lo.Map(identities, func(i *ent.Identity, _ int) Identity { return newIdentity(i) }), NextCursor: next})
+}
+
+// identityWithEvents is the detail view of an identity, with its latest events.
+type identityWithEvents struct {
+	Identity
+	Events []Event `json:"events"`
+}
+
+const identityDetailEvents = 20
+
+func (s *Server) handleGetIdentity(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("invalid identity id"))
+		return
+	}
+
+	scope := scopeOf(r)
+	i, err := s.client.Identity.Query().
+		Where(identity.ID(id)).
+		Where(visibleIdentities(scope)...).
+		WithEvents(func(q *ent.EventQuery) {
+			if chatIDs := scope.Chats(); chatIDs != nil {
+				q.Where(event.InChatIDIn(chatIDs...))
+			}
+			q.Order(event.ByPlatformTimestamp(sql.OrderDesc())).Limit(identityDetailEvents)
+		}).
+		Only(r.Context())
+	if ent.IsNotFound(err) {
+		writeError(w, http.StatusNotFound, errors.New("identity not found"))
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, identityWithEvents{
+		Identity: newIdentity(i),
+		Events:   lo.Map(i.Edges.Events, func(e *ent.Event, _ int) Event { return newEvent(e) }),
+	})
+}
+
+func (s *Server) handleListIdentityEvents(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("invalid identity id"))
+		return
+	}
+
+	limit, after, err := pageParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	query := s.client.Event.Query().Where(event.HasIdentitiesWith(identity.ID(id)))
+	if chatIDs := scopeOf(r).Chats(); chatIDs != nil {
+		query = query.Where(event.InChatIDIn(chatIDs...))
+	}
+	if after != nil {
+		query = query.Where(after.after(event.FieldPlatformTimestamp))
+	}
+
+	s.writeEvents(w, r, query, limit)
+}
+
+func (s *Server) handleGetIdentityProfile(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("invalid identity id"))
+		return
+	}
+
+	// A profile is written from the events of every chat, callers limited
+	// to some chats could read about the others through it.
+	if scopeOf(r).Chats() != nil {
+		writeError(w, http.StatusForbidden, errors.New("profiles are only served to callers who may read every chat"))
+		return
+	}
+
+	query := s.client.Profile.Query().
+		Where(
+			profile.IdentityID(id),
+			profile.HasIdentityWith(visibleIdentities(scopeOf(r))...),
+		).
+		Order(profile.ByVersion(sql.OrderDesc()))
+	if value := r.URL.Query().Get("version"); value != "" {
+		version, err := strconv.Atoi(value)
+		if err != nil || version <= 0 {
+			writeError(w, http.StatusBadRequest, errors.New("version must be a positive integer"))
+			return
+		}
+		query.Where(profile.Version(version))
+	}
+
+	p, err := query.First(r.Context())
+	if ent.IsNotFound(err) {
+		writeError(w, http.StatusNotFound, errors.New("profile not found"))
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newProfile(p))
+}
+
+func (s *Server) handleListSummaries(w http.ResponseWriter, r *http.Request) {
+	limit, after, err := pageParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	query := s.client.Summary.Query()
+	if chatIDs := scopeOf(r).Chats(); chatIDs != nil {
+		query = query.Where(summary.InChatIDIn(chatIDs...))
+	}
+	if chatID := r.URL.Query().Get("chat_id"); chatID != "" {
+		query = query.Where(summary.InChatID(chatID))
+	}
+
+	since, ok, err := timeParam(r, "since")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if ok {
+		query = query.Where(summary.PlatformTimestampGTE(since))
+	}
+	until, ok, err := timeParam(r, "until")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if ok {
+		query = query.Where(summary.PlatformTimestampLTE(until))
+	}
+	if after != nil {
+		query = query.Where(after.after(summary.FieldPlatformTimestamp))
+	}
+
+	summaries, err := query.
+		Order(summary.ByPlatformTimestamp(sql.OrderDesc()), summary.ByID(sql.OrderDesc())).
+		Limit(limit + 1).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	summaries, next := paginate(summaries, limit, func(s *ent.Summary) cursor {
+		return cursor{Timestamp: s.PlatformTimestamp, ID: s.ID}
+	})
+	writeJSON(w, http.StatusOK, Page[Summary]{Data: lo.Map(summaries, func(s *ent.Summary, _ int) Summary { return newSummary(s) }), NextCursor: next})
+}
+
+func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
+	if s.searcher == nil {
+		writeError(w, http.StatusServiceUnavailable, errors.New("search is not configured"))
+		return
+	}
+
+	params := r.URL.Query()
+	if params.Get("q") == "" {
+		writeError(w, http.StatusBadRequest, errors.New("q is required"))
+		return
+	}
+	limit, err := pageSize(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	mode, err := search.ParseMode(params.Get("mode"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	contextSize := 2
+	if value := params.Get("context"); value != "" {
+		contextSize, err = strconv.Atoi(value)
+		if err != nil || contextSize < 0 {
+			writeError(w, http.StatusBadRequest, errors.New("context must be a non-negative integer"))
+			return
+		}
+	}
+
+	owner, err := ownerParam(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if owner != uuid.Nil && !scopeOf(r).AllowsOwner(owner) {
+		writeError(w, http.StatusForbidden, owners.ErrNotLinked)
+		return
+	}
+
+	filter := search.Filter{
+		ChatID:         params.Get("chat_id"),
+		SenderID:       params.Get("sender"),
+		SenderName:     params.Get("sender_name"),
+		Platform:       params.Get("platform"),
+		ChatIDs:        scopeOf(r).ContentChats(),
+		OwnerAccountID: owner,
+	}
+	since, ok, err := timeParam(r, "since")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if ok {
+		filter.Since = time.Unix(since, 0)
+	}
+	until, ok, err := timeParam(r, "until")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if ok {
+		filter.Until = time.Unix(until, 0)
+	}
+
+	hits, err := s.searcher.Search(r.Context(), search.Options{
+		Query:       params.Get("q"),
+		Mode:        mode,
+		Filter:      filter,
+		Limit:       limit,
+		ContextSize: contextSize,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	read := make([]*ent.ChatMessage, 0, len(hits))
+	for _, h := range hits {
+		read = append(append(append(read, h.Message), h.Before...), h.After...)
+	}
+	if !s.recordRead(w, r, params.Get("q"), read) {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Page[SearchHit]{Data: lo.Map(hits, func(h search.Hit, _ int) SearchHit {
+		return SearchHit{
+			Message:     newMessage(h.Message),
+			Score:       h.Score,
+			VectorRank:  h.VectorRank,
+			KeywordRank: h.KeywordRank,
+			Before:      newMessages(h.Before),
+			After:       newMessages(h.After),
+		}
+	})})
+}
+
+func pageParams(r *http.Request) (int, *cursor, error) {
+	limit, err := pageSize(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	after, err := parseCursor(r.URL.Query().Get("cursor"))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return limit, after, nil
+}
+
+// ownerParam parses the owner query parameter, uuid.Nil when absent.
+func ownerParam(r *http.Request) (uuid.UUID, error) {
+	value := r.URL.Query().Get("owner")
+	if value == "" {
+		return uuid.Nil, nil
+	}
+	owner, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, errors.New("owner must be an owner account id")
+	}
+	return owner, nil
+}
+
+func (s *Server) handleAsk(w http.ResponseWriter, r *http.Request) {
+	if s.asker == nil {
+		writeError(w, http.StatusServiceUnavailable, errors.New("ask is not configured"))
+		return
+	}
+
+	var req AskRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+	if strings.TrimSpace(req.Question) == "" {
+		writeError(w, http.StatusBadRequest, errors.New("question is required"))
+		return
+	}
+	if req.Limit < 0 || req.Limit > maxPageSize {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("limit must be between 0 and %d", maxPageSize))
+		return
+	}
+
+	subject := ""
+	if p := auth.PrincipalFrom(r.Context()); p != nil {
+		subject = p.Subject
+	}
+	answer, err := s.asker.Ask(r.Context(), ask.Options{
+		ConversationID: req.ConversationID,
+		Subject:        subject,
+		Question:       req.Question,
+		ChatID:         req.ChatID,
+		ChatIDs:        scopeOf(r).Chats(),
+		MessageChatIDs: scopeOf(r).ContentChats(),
+		Limit:          req.Limit,
+	})
+	if errors.Is(err, ask.ErrUnknownConversation) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Every message shown to the model is read, cited or not. They only
+	// come from chats whose raw messages the caller reads.
+	if !s.recordRead(w, r, req.Question, answer.Evidence) {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newAnswer(answer))
+}
+
+func (s *Server) handleExperts(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	topic := strings.TrimSpace(params.Get("topic"))
+	if topic == "" {
+		writeError(w, http.StatusBadRequest, errors.New("topic is required"))
+		return
+	}
+	limit, err := pageSize(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	opts := experts.Options{Topic: topic, Limit: limit, ChatIDs: scopeOf(r).Chats()}
+	if value := params.Get("half_life_days"); value != "" {
+		days, err := strconv.ParseFloat(value, 64)
+		if err != nil || days <= 0 {
+			writeError(w, http.StatusBadRequest, errors.New("half_life_days must be a positive number"))
+			return
+		}
+		opts.HalfLife = time.Duration(days * float64(24*time.Hour))
+	}
+	if value := params.Get("evidence"); value != "" {
+		opts.EvidenceLimit, err = strconv.Atoi(value)
+		if err != nil || opts.EvidenceLimit <= 0 {
+			writeError(w, http.StatusBadRequest, errors.New("evidence must be a positive integer"))
+			return
+		}
+		opts.EvidenceLimit = min(opts.EvidenceLimit, maxPageSize)
+	}
+
+	found, err := s.finder.Find(r.Context(), opts)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Page[Expert]{Data: lo.Map(found, func(e experts.Expert, _ int) Expert { return newExpert(e) })})
+}
+
+func (s *Server) handleListAccessLog(w http.ResponseWriter, r *http.Request) {
+	limit, after, err := pageParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	params := r.URL.Query()
+	query := s.client.AccessLog.Query()
+	if subject := params.Get("subject"); subject != "" {
+		query = query.Where(accesslog.Subject(subject))
+	}
+	if chatID := params.Get("chat_id"); chatID != "" {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(accesslog.FieldChatIds, chatID))
+		})
+	}
+	since, ok, err := timeParam(r, "since")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if ok {
+		query = query.Where(accesslog.CreatedAtGTE(since * 1000))
+	}
+	if after != nil {
+		query = query.Where(after.after(accesslog.FieldCreatedAt))
+	}
+
+	entries, err := query.
+		Order(accesslog.ByCreatedAt(sql.OrderDesc()), accesslog.ByID(sql.OrderDesc())).
+		Limit(limit + 1).
+		All(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	entries, next := paginate(entries, limit, func(e *ent.AccessLog) cursor {
+		return cursor{Timestamp: e.CreatedAt, ID: e.ID}
+	})
+	writeJSON(w, http.StatusOK, Page[AccessLogEntry]{Data: lo.Map(entries, func(e *ent.AccessLog, _ int) AccessLogEntry { return newAccessLogEntry(e) }), NextCursor: next})
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/predicate"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/luoling8192/mindwave/internal/services/access"
+	"github.com/samber/lo"
+)
+
+type scopeKey struct{}
+
+// authenticated resolves the caller of a request and the chats they may read
+// before handing it on. The OpenAPI document stays public.
+func (s *Server) authenticated(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/openapi.json" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		principal, err := s.opts.Auth.Authenticate(r)
+		if errors.Is(err, auth.ErrMissingCredentials) || errors.Is(err, auth.ErrInvalidCredentials) {
+			metrics.AuthFailures.WithLabelValues("api").Inc()
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, err)
+			return
+		}
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		scope, err := access.NewScope(r.Context(), s.client, principal)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		ctx := auth.WithPrincipal(r.Context(), principal)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, scopeKey{}, scope)))
+	})
+}
+
+// requireRole refuses callers whose role does not include role.
+func requireRole(role auth.Role, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !scopeOf(r).Allows(role) {
+			writeError(w, http.StatusForbidden, errors.New(string(role)+" role required"))
+			return
+		}
+		next(w, r)
+	}
+}
+
+// scopeOf returns the scope of the caller, nil when every chat is served.
+func scopeOf(r *http.Request) *access.Scope {
+	scope, _ := r.Context().Value(scopeKey{}).(*access.Scope)
+	return scope
+}
+
+// recordRead logs a response carrying raw messages to the access log. The
+// response is refused when it cannot be logged.
+func (s *Server) recordRead(w http.ResponseWriter, r *http.Request, query string, messages []*ent.ChatMessage) bool {
+	err := access.Record(r.Context(), s.client, access.Read{
+		Endpoint: r.Pattern,
+		Query:    query,
+		Messages: messages,
+	})
+	if err != nil {
+		slog.Error("failed to record message read", "path", r.URL.Path, "error", err)
+		writeError(w, http.StatusInternalServerError, errors.New("failed to record access"))
+		return false
+	}
+	return true
+}
+
+// visibleIdentities limits identities to those that sent messages in the
+// chats of the scope. A nil scope sees all of them.
+func visibleIdentities(scope *access.Scope) []predicate.Identity {
+	chatIDs := scope.Chats()
+	if chatIDs == nil {
+		return nil
+	}
+	return []predicate.Identity{func(s *sql.Selector) {
+		messages := sql.Table(chatmessage.Table)
+		s.Where(sql.Exists(
+			sql.Select(messages.C(chatmessage.FieldID)).
+				From(messages).
+				Where(sql.And(
+					sql.ColumnsEQ(messages.C(chatmessage.FieldPlatform), s.C(identity.FieldPlatform)),
+					sql.ColumnsEQ(messages.C(chatmessage.FieldFromID), s.C(identity.FieldPlatformUserID)),
+					sql.In(messages.C(chatmessage.FieldInChatID), lo.ToAnySlice(chatIDs)...),
+					sql.EQ(messages.C(chatmessage.FieldDeletedAt), 0),
+				)),
+		))
+	}}
+}
@@ -0,0 +1,131 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/api"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/datastore/datastoretest"
+	"github.com/luoling8192/mindwave/internal/services/access"
+)
+
+func TestRoles(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t,
+		migrate.APIKeysTable,
+		migrate.JoinedChatsTable,
+		migrate.ChatMessagesTable,
+		migrate.OwnerAccountLinksTable,
+		migrate.ChatGrantsTable,
+		migrate.AccessLogsTable,
+		migrate.PersonsTable,
+		migrate.IdentitiesTable,
+		migrate.ProfilesTable,
+	)
+	for _, chatID := range []string{"granted", "other"} {
+		if err := client.JoinedChat.Create().SetPlatform("telegram").SetChatID(chatID).SetChatName(chatID).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	keys := make(map[auth.Role]string)
+	for _, role := range []auth.Role{auth.RoleViewer, auth.RoleCurator, auth.RoleAdmin} {
+		subject := string(role) + "-user"
+		key, _, err := auth.IssueKey(ctx, client, subject, "test", role)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[role] = key
+		if role != auth.RoleAdmin {
+			if err := access.Grant(ctx, client, subject, []string{"granted"}, auth.RoleCurator, "admin"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	server := api.NewServer(client, nil, nil, nil, api.Options{Auth: auth.NewAuthenticator(client, auth.Options{})})
+	handler := server.Handler()
+	serve := func(method, path, key string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, nil)
+		if key != "" {
+			r.Header.Set(auth.APIKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	profilePath := "/api/v1/identities/" + uuid.NewString() + "/profile"
+	tests := []struct {
+		path string
+		want map[auth.Role]int
+	}{
+		{"/api/v1/chats", map[auth.Role]int{
+			auth.RoleViewer:  http.StatusOK,
+			auth.RoleCurator: http.StatusOK,
+			auth.RoleAdmin:   http.StatusOK,
+		}},
+		// Search is not configured, a caller past the role check is told so.
+		{"/api/v1/search?q=hello", map[auth.Role]int{
+			auth.RoleViewer:  http.StatusForbidden,
+			auth.RoleCurator: http.StatusServiceUnavailable,
+			auth.RoleAdmin:   http.StatusServiceUnavailable,
+		}},
+		{"/api/v1/access-log", map[auth.Role]int{
+			auth.RoleViewer:  http.StatusForbidden,
+			auth.RoleCurator: http.StatusForbidden,
+			auth.RoleAdmin:   http.StatusOK,
+		}},
+		// Profiles are refused to callers limited to some chats.
+		{profilePath, map[auth.Role]int{
+			auth.RoleViewer:  http.StatusForbidden,
+			auth.RoleCurator: http.StatusForbidden,
+			auth.RoleAdmin:   http.StatusNotFound,
+		}},
+	}
+	for _, tt := range tests {
+		for role, want := range tt.want {
+			if w := serve(http.MethodGet, tt.path, keys[role]); w.Code != want {
+				t.Errorf("GET %s as %s: got status %d, want %d: %s", tt.path, role, w.Code, want, w.Body)
+			}
+		}
+	}
+
+	wantChats := map[auth.Role][]string{
+		auth.RoleViewer:  {"granted"},
+		auth.RoleCurator: {"granted"},
+		auth.RoleAdmin:   {"granted", "other"},
+	}
+	for role, want := range wantChats {
+		var page api.Page[api.Chat]
+		if err := json.NewDecoder(serve(http.MethodGet, "/api/v1/chats", keys[role]).Body).Decode(&page); err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, c := range page.Data {
+			got = append(got, c.ChatID)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("chats of %s = %v, want %v", role, got, want)
+		}
+	}
+
+	w := serve(http.MethodGet, "/api/v1/chats", "")
+	if w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") == "" {
+		t.Errorf("without credentials: got status %d, want 401 with a challenge", w.Code)
+	}
+	if w := serve(http.MethodGet, "/api/v1/chats", "mw_unknown"); w.Code != http.StatusUnauthorized {
+		t.Errorf("unknown key: got status %d, want 401", w.Code)
+	}
+	if w := serve(http.MethodGet, "/openapi.json", ""); w.Code != http.StatusOK {
+		t.Errorf("OpenAPI document: got status %d, want 200", w.Code)
+	}
+}
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/httpserver"
+	"github.com/luoling8192/mindwave/internal/services/ask"
+	"github.com/luoling8192/mindwave/internal/services/experts"
+	"github.com/luoling8192/mindwave/internal/services/search"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+
+	// maxRequestBodyBytes bounds JSON request bodies.
+	maxRequestBodyBytes = 1 << 20
+)
+
+//go:embed openapi.json
+var openAPISpec []byte
+
+type Options struct {
+	// Auth authenticates every request but the OpenAPI document, responses
+	// are then limited to the chats the caller may read. nil serves every
+	// chat to everyone.
+	Auth *auth.Authenticator
+}
+
+// Server exposes a read-only HTTP/JSON API over chats, events, identities and summaries.
+type Server struct {
+	client   *datastore.Client
+	searcher *search.Searcher
+	asker    *ask.Asker
+	finder   *experts.Finder
+	opts     Options
+	mux      *http.ServeMux
+}
+
+// NewServer builds the API server. searcher and asker may be nil, in which
+// case the search and ask endpoints respond with 503.
+func NewServer(client *datastore.Client, searcher *search.Searcher, asker *ask.Asker, finder *experts.Finder, opts Options) *Server {
+	s := &Server{
+		client:   client,
+		searcher: searcher,
+		asker:    asker,
+		finder:   finder,
+		opts:     opts,
+		mux:      http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /openapi.json", s.handleOpenAPI)
+	s.mux.HandleFunc("GET /api/v1/owners", requireRole(auth.RoleCurator, s.handleListOwners))
+	s.mux.HandleFunc("GET /api/v1/chats", requireRole(auth.RoleViewer, s.handleListChats))
+	s.mux.HandleFunc("GET /api/v1/events", requireRole(auth.RoleViewer, s.handleListEvents))
+	s.mux.HandleFunc("GET /api/v1/events/{id}", requireRole(auth.RoleViewer, s.handleGetEvent))
+	s.mux.HandleFunc("GET /api/v1/identities", requireRole(auth.RoleViewer, s.handleListIdentities))
+	s.mux.HandleFunc("GET /api/v1/identities/{id}", requireRole(auth.RoleViewer, s.handleGetIdentity))
+	s.mux.HandleFunc("GET /api/v1/identities/{id}/events", requireRole(auth.RoleViewer, s.handleListIdentityEvents))
+	s.mux.HandleFunc("GET /api/v1/identities/{id}/profile", requireRole(auth.RoleViewer, s.handleGetIdentityProfile))
+	s.mux.HandleFunc("GET /api/v1/summaries", requireRole(auth.RoleViewer, s.handleListSummaries))
+	s.mux.HandleFunc("GET /api/v1/search", requireRole(auth.RoleCurator, s.handleSearch))
+	s.mux.HandleFunc("POST /api/v1/ask", requireRole(auth.RoleViewer, s.handleAsk))
+	s.mux.HandleFunc("GET /api/v1/experts", requireRole(auth.RoleViewer, s.handleExperts))
+	s.mux.HandleFunc("GET /api/v1/access-log", requireRole(auth.RoleAdmin, s.handleListAccessLog))
+
+	return s
+}
+
+func (s *Server) Handler() http.Handler {
+	if s.opts.Auth == nil {
+		return s.mux
+	}
+	return s.authenticated(s.mux)
+}
+
+// ListenAndServe serves the API until ctx is done, then shuts down gracefully.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	return httpserver.ListenAndServe(ctx, addr, s.Handler(), "API server")
+}
+
+func (s *Server) handleOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(openAPISpec)
+}
+
+// Page is the envelope of every list response.
+type Page[T any] struct {
+	Data       []T    `json:"data"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Warn("failed to encode response", "error", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	if status >= http.StatusInternalServerError {
+		slog.Error("api request failed", "error", err)
+	}
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+// pageSize parses the limit query parameter, clamped to maxPageSize.
+func pageSize(r *http.Request) (int, error) {
+	value := r.URL.Query().Get("limit")
+	if value == "" {
+		return defaultPageSize, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return 0, errors.New("limit must be a positive integer")
+	}
+
+	return min(limit, maxPageSize), nil
+}
+
+// timeParam parses a query parameter given as unix seconds or RFC3339.
+func timeParam(r *http.Request, name string) (int64, bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, false, nil
+	}
+	if ts, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return ts, true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, false, errors.New(name + " must be unix seconds or RFC3339")
+	}
+
+	return t.Unix(), true, nil
+}
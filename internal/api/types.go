@@ -0,0 +1,309 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/internal/services/ask"
+	"github.com/luoling8192/mindwave/internal/services/experts"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/luoling8192/mindwave/schema"
+	"github.com/samber/lo"
+)
+
+type Owner struct {
+	ID       uuid.UUID `json:"id"`
+	Chats    int       `json:"chats"`
+	Messages int       `json:"messages"`
+}
+
+type Chat struct {
+	ID         uuid.UUID `json:"id"`
+	Platform   string    `json:"platform"`
+	ChatID     string    `json:"chat_id"`
+	ChatName   string    `json:"chat_name"`
+	ChatType   string    `json:"chat_type"`
+	DialogDate int64     `json:"dialog_date"`
+}
+
+type Event struct {
+	ID                 uuid.UUID   `json:"id"`
+	Platform           string      `json:"platform"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	Tags               []string    `json:"tags"`
+	FromName           string      `json:"from_name"`
+	UnmatchedNames     []string    `json:"unmatched_names"`
+	InChatID           string      `json:"in_chat_id"`
+	InChatType         string      `json:"in_chat_type"`
+	PlatformTimestamp  int64       `json:"platform_timestamp"`
+	SpanStart          int64       `json:"span_start"`
+	SpanEnd            int64       `json:"span_end"`
+	EvidenceMessageIDs []uuid.UUID `json:"evidence_message_ids"`
+	ContinuesID        *uuid.UUID  `json:"continues_id,omitempty"`
+	IdentityIDs        []uuid.UUID `json:"identity_ids,omitempty"`
+}
+
+type Identity struct {
+	ID             uuid.UUID  `json:"id"`
+	Platform       string     `json:"platform"`
+	PlatformUserID string     `json:"platform_user_id"`
+	Username       string     `json:"username"`
+	DisplayName    string     `json:"display_name"`
+	AltIDs         []string   `json:"alt_ids"`
+	PersonID       *uuid.UUID `json:"person_id,omitempty"`
+}
+
+type Summary struct {
+	ID                uuid.UUID `json:"id"`
+	Platform          string    `json:"platform"`
+	InChatID          string    `json:"in_chat_id"`
+	InChatType        string    `json:"in_chat_type"`
+	Content           string    `json:"content"`
+	Model             string    `json:"model"`
+	MessageCount      int       `json:"message_count"`
+	SpanStart         int64     `json:"span_start"`
+	SpanEnd           int64     `json:"span_end"`
+	PlatformTimestamp int64     `json:"platform_timestamp"`
+}
+
+type Message struct {
+	ID                uuid.UUID `json:"id"`
+	Platform          string    `json:"platform"`
+	InChatID          string    `json:"in_chat_id"`
+	FromID            string    `json:"from_id"`
+	FromName          string    `json:"from_name"`
+	Content           string    `json:"content"`
+	PlatformTimestamp int64     `json:"platform_timestamp"`
+	// OwnerAccountID is the crawling account that stored this copy.
+	OwnerAccountID *uuid.UUID `json:"owner_account_id,omitempty"`
+}
+
+type SearchHit struct {
+	Message     Message   `json:"message"`
+	Score       float64   `json:"score"`
+	VectorRank  int       `json:"vector_rank"`
+	KeywordRank int       `json:"keyword_rank"`
+	Before      []Message `json:"before"`
+	After       []Message `json:"after"`
+}
+
+type AskRequest struct {
+	Question       string    `json:"question"`
+	ConversationID uuid.UUID `json:"conversation_id"`
+	ChatID         string    `json:"chat_id"`
+	Limit          int       `json:"limit"`
+}
+
+type Answer struct {
+	ConversationID     uuid.UUID `json:"conversation_id"`
+	TurnID             uuid.UUID `json:"turn_id"`
+	Question           string    `json:"question"`
+	StandaloneQuestion string    `json:"standalone_question"`
+	Answer             string    `json:"answer"`
+	Refused            bool      `json:"refused"`
+	Citations          []Message `json:"citations"`
+	Events             []Event   `json:"events"`
+}
+
+type ExpertSignals struct {
+	Frequency  int     `json:"frequency"`
+	Recency    float64 `json:"recency"`
+	Centrality float64 `json:"centrality"`
+	Relevance  float64 `json:"relevance"`
+}
+
+type ExpertEvidence struct {
+	Event  Event   `json:"event"`
+	Weight float64 `json:"weight"`
+}
+
+type Expert struct {
+	PersonID    uuid.UUID        `json:"person_id"`
+	DisplayName string           `json:"display_name"`
+	Identities  []Identity       `json:"identities"`
+	Score       float64          `json:"score"`
+	LastSeen    int64            `json:"last_seen"`
+	Signals     ExpertSignals    `json:"signals"`
+	Evidence    []ExpertEvidence `json:"evidence"`
+}
+
+type ProfileClaim struct {
+	Section  string      `json:"section"`
+	Text     string      `json:"text"`
+	EventIDs []uuid.UUID `json:"event_ids"`
+}
+
+type Profile struct {
+	ID                 uuid.UUID      `json:"id"`
+	IdentityID         uuid.UUID      `json:"identity_id"`
+	Version            int            `json:"version"`
+	Claims             []ProfileClaim `json:"claims"`
+	Content            string         `json:"content"`
+	EventCount         int            `json:"event_count"`
+	EventsUpdatedUntil int64          `json:"events_updated_until"`
+	Model              string         `json:"model"`
+	CreatedAt          int64          `json:"created_at"`
+}
+
+type AccessLogEntry struct {
+	ID         uuid.UUID   `json:"id"`
+	Subject    string      `json:"subject"`
+	Method     string      `json:"method"`
+	Role       string      `json:"role"`
+	Endpoint   string      `json:"endpoint"`
+	Query      string      `json:"query"`
+	ChatIDs    []string    `json:"chat_ids"`
+	MessageIDs []uuid.UUID `json:"message_ids"`
+	CreatedAt  int64       `json:"created_at"`
+}
+
+func newOwner(a owners.Account) Owner {
+	return Owner{ID: a.ID, Chats: a.Chats, Messages: a.Messages}
+}
+
+func newChat(c *ent.JoinedChat) Chat {
+	return Chat{
+		ID:         c.ID,
+		Platform:   c.Platform,
+		ChatID:     c.ChatID,
+		ChatName:   c.ChatName,
+		ChatType:   c.ChatType,
+		DialogDate: c.DialogDate,
+	}
+}
+
+func newEvent(e *ent.Event) Event {
+	event := Event{
+		ID:                 e.ID,
+		Platform:           e.Platform,
+		Name:               e.Name,
+		Description:        e.Description,
+		Tags:               nonNil(e.Tags),
+		FromName:           e.FromName,
+		UnmatchedNames:     nonNil(e.UnmatchedNames),
+		InChatID:           e.InChatID,
+		InChatType:         e.InChatType,
+		PlatformTimestamp:  e.PlatformTimestamp,
+		SpanStart:          e.SpanStart,
+		SpanEnd:            e.SpanEnd,
+		EvidenceMessageIDs: nonNil(e.EvidenceMessageIds),
+		ContinuesID:        e.ContinuesID,
+	}
+	for _, identity := range e.Edges.Identities {
+		event.IdentityIDs = append(event.IdentityIDs, identity.ID)
+	}
+
+	return event
+}
+
+func newIdentity(i *ent.Identity) Identity {
+	return Identity{
+		ID:             i.ID,
+		Platform:       i.Platform,
+		PlatformUserID: i.PlatformUserID,
+		Username:       i.Username,
+		DisplayName:    i.DisplayName,
+		AltIDs:         nonNil(i.AltIds),
+		PersonID:       i.PersonID,
+	}
+}
+
+func newSummary(s *ent.Summary) Summary {
+	return Summary{
+		ID:                s.ID,
+		Platform:          s.Platform,
+		InChatID:          s.InChatID,
+		InChatType:        s.InChatType,
+		Content:           s.Content,
+		Model:             s.Model,
+		MessageCount:      s.MessageCount,
+		SpanStart:         s.SpanStart,
+		SpanEnd:           s.SpanEnd,
+		PlatformTimestamp: s.PlatformTimestamp,
+	}
+}
+
+func newMessage(m *ent.ChatMessage) Message {
+	return Message{
+		ID:                m.ID,
+		Platform:          m.Platform,
+		InChatID:          m.InChatID,
+		FromID:            m.FromID,
+		FromName:          m.FromName,
+		Content:           m.Content,
+		PlatformTimestamp: m.PlatformTimestamp,
+		OwnerAccountID:    m.OwnerAccountID,
+	}
+}
+
+func newMessages(messages []*ent.ChatMessage) []Message {
+	out := make([]Message, 0, len(messages))
+	for _, m := range messages {
+		out = append(out, newMessage(m))
+	}
+	return out
+}
+
+func newAnswer(a *ask.Answer) Answer {
+	return Answer{
+		ConversationID:     a.ConversationID,
+		TurnID:             a.TurnID,
+		Question:           a.Question,
+		StandaloneQuestion: a.StandaloneQuestion,
+		Answer:             a.Text,
+		Refused:            a.Refused,
+		Citations:          newMessages(a.Citations),
+		Events:             lo.Map(a.Events, func(e *ent.Event, _ int) Event { return newEvent(e) }),
+	}
+}
+
+func newExpert(e experts.Expert) Expert {
+	return Expert{
+		PersonID:    e.PersonID,
+		DisplayName: e.DisplayName,
+		Identities:  lo.Map(e.Identities, func(i *ent.Identity, _ int) Identity { return newIdentity(i) }),
+		Score:       e.Score,
+		LastSeen:    e.LastSeen,
+		Signals:     ExpertSignals(e.Signals),
+		Evidence: lo.Map(e.Evidence, func(evidence experts.Evidence, _ int) ExpertEvidence {
+			return ExpertEvidence{Event: newEvent(evidence.Event), Weight: evidence.Weight}
+		}),
+	}
+}
+
+func newProfile(p *ent.Profile) Profile {
+	return Profile{
+		ID:         p.ID,
+		IdentityID: p.IdentityID,
+		Version:    p.Version,
+		Claims: lo.Map(p.Claims, func(c schema.ProfileClaim, _ int) ProfileClaim {
+			return ProfileClaim{Section: c.Section, Text: c.Text, EventIDs: nonNil(c.EventIDs)}
+		}),
+		Content:            p.Content,
+		EventCount:         p.EventCount,
+		EventsUpdatedUntil: p.EventsUpdatedUntil,
+		Model:              p.Model,
+		CreatedAt:          p.CreatedAt,
+	}
+}
+
+func newAccessLogEntry(e *ent.AccessLog) AccessLogEntry {
+	return AccessLogEntry{
+		ID:         e.ID,
+		Subject:    e.Subject,
+		Method:     e.Method,
+		Role:       e.Role,
+		Endpoint:   e.Endpoint,
+		Query:      e.Query,
+		ChatIDs:    nonNil(e.ChatIds),
+		MessageIDs: nonNil(e.MessageIds),
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func nonNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
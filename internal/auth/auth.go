@@ -0,0 +1,152 @@
+// Package auth authenticates the callers of the served APIs, by API key, by
+// an OIDC or other JWT bearer token, or by a header set by a trusted proxy,
+// and gives each of them a role.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/luoling8192/mindwave/internal/datastore"
+)
+
+// Role is what a caller may do. Every role includes the ones below it.
+type Role string
+
+const (
+	// RoleViewer reads what was distilled from chats: events, summaries,
+	// identities, profiles and answers.
+	RoleViewer Role = "viewer"
+	// RoleCurator also reads the raw messages of chats.
+	RoleCurator Role = "curator"
+	// RoleAdmin reads every chat and the access log.
+	RoleAdmin Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer:  1,
+	RoleCurator: 2,
+	RoleAdmin:   3,
+}
+
+// ParseRole parses the name of a role.
+func ParseRole(name string) (Role, error) {
+	role := Role(strings.ToLower(strings.TrimSpace(name)))
+	if _, ok := roleRanks[role]; !ok {
+		return "", fmt.Errorf("unknown role %q, expected viewer, curator or admin", name)
+	}
+	return role, nil
+}
+
+// Includes tells whether r grants what other grants.
+func (r Role) Includes(other Role) bool {
+	return roleRanks[other] > 0 && roleRanks[r] >= roleRanks[other]
+}
+
+// Lower returns the lower of two roles.
+func Lower(a, b Role) Role {
+	if roleRanks[a] <= roleRanks[b] {
+		return a
+	}
+	return b
+}
+
+// Method is how a caller authenticated.
+type Method string
+
+const (
+	MethodAPIKey Method = "api_key"
+	MethodJWT    Method = "jwt"
+	MethodHeader Method = "header"
+)
+
+// Principal is an authenticated caller.
+type Principal struct {
+	// Subject is the API user, owner account links and chat grants are
+	// looked up by it.
+	Subject string
+	Role    Role
+	Method  Method
+	// ExpiresAt is when the credentials expire, zero when they do not.
+	ExpiresAt time.Time
+}
+
+var (
+	// ErrMissingCredentials is returned for requests without credentials.
+	ErrMissingCredentials = errors.New("missing credentials")
+	// ErrInvalidCredentials is returned for unknown, revoked, expired or
+	// otherwise invalid credentials.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Options configures how callers authenticate. API keys are always accepted.
+type Options struct {
+	// UserHeader names the request header carrying the API user, set by an
+	// authenticating proxy in front of the API. Empty ignores the header.
+	UserHeader string
+	// HeaderRole is the role of callers named by UserHeader, curator when
+	// empty.
+	HeaderRole Role
+	// Tokens validates bearer tokens that are not API keys, nil refuses
+	// them.
+	Tokens *TokenVerifier
+}
+
+// Authenticator resolves the principal of requests.
+type Authenticator struct {
+	client *datastore.Client
+	opts   Options
+}
+
+func NewAuthenticator(client *datastore.Client, opts Options) *Authenticator {
+	if opts.HeaderRole == "" {
+		opts.HeaderRole = RoleCurator
+	}
+	return &Authenticator{client: client, opts: opts}
+}
+
+// Authenticate returns the principal of a request. Bearer tokens and API
+// keys are checked before the proxy header.
+func (a *Authenticator) Authenticate(r *http.Request) (*Principal, error) {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return a.Verify(r.Context(), strings.TrimSpace(token))
+	}
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return lookupKey(r.Context(), a.client, key)
+	}
+	if a.opts.UserHeader != "" {
+		if subject := r.Header.Get(a.opts.UserHeader); subject != "" {
+			return &Principal{Subject: subject, Role: a.opts.HeaderRole, Method: MethodHeader}, nil
+		}
+	}
+	return nil, ErrMissingCredentials
+}
+
+// Verify returns the principal of a bearer token, an API key or a JWT.
+func (a *Authenticator) Verify(ctx context.Context, token string) (*Principal, error) {
+	if strings.HasPrefix(token, keyPrefix) {
+		return lookupKey(ctx, a.client, token)
+	}
+	if a.opts.Tokens == nil {
+		return nil, fmt.Errorf("%w: bearer tokens other than API keys are not accepted", ErrInvalidCredentials)
+	}
+	return a.opts.Tokens.Verify(ctx, token)
+}
+
+type principalKey struct{}
+
+// WithPrincipal attaches the principal of a request to ctx.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFrom returns the principal attached to ctx, nil when the API is
+// served without authentication.
+func PrincipalFrom(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
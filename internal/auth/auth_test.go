@@ -0,0 +1,143 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/apikey"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/datastore/datastoretest"
+)
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    auth.Role
+		wantErr bool
+	}{
+		{name: "viewer", want: auth.RoleViewer},
+		{name: " Curator ", want: auth.RoleCurator},
+		{name: "ADMIN", want: auth.RoleAdmin},
+		{name: "owner", wantErr: true},
+		{name: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		role, err := auth.ParseRole(tt.name)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseRole(%q) = %q, want an error", tt.name, role)
+			}
+			continue
+		}
+		if err != nil || role != tt.want {
+			t.Errorf("ParseRole(%q) = %q, %v, want %q", tt.name, role, err, tt.want)
+		}
+	}
+}
+
+func TestRoleRanking(t *testing.T) {
+	roles := []auth.Role{auth.RoleViewer, auth.RoleCurator, auth.RoleAdmin}
+	for i, r := range roles {
+		for j, other := range roles {
+			if got, want := r.Includes(other), i >= j; got != want {
+				t.Errorf("%s.Includes(%s) = %t, want %t", r, other, got, want)
+			}
+			want := roles[min(i, j)]
+			if got := auth.Lower(r, other); got != want {
+				t.Errorf("Lower(%s, %s) = %s, want %s", r, other, got, want)
+			}
+		}
+	}
+
+	if auth.RoleAdmin.Includes("owner") {
+		t.Error("admin includes an unknown role")
+	}
+	if auth.Role("owner").Includes(auth.RoleViewer) {
+		t.Error("an unknown role includes viewer")
+	}
+}
+
+func TestAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t, migrate.APIKeysTable)
+	authenticator := auth.NewAuthenticator(client, auth.Options{})
+
+	key, row, err := auth.IssueKey(ctx, client, "alice", "laptop", auth.RoleCurator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(key, row.Prefix) || len(row.Prefix) >= len(key) {
+		t.Errorf("prefix %q does not start key %q or holds all of it", row.Prefix, key)
+	}
+	if row.KeyHash == key || strings.Contains(row.KeyHash, key[len(row.Prefix):]) {
+		t.Error("the key is stored in clear")
+	}
+
+	requests := map[string]func(*http.Request){
+		"bearer":    func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+key) },
+		"x-api-key": func(r *http.Request) { r.Header.Set(auth.APIKeyHeader, key) },
+	}
+	for name, set := range requests {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			set(r)
+			p, err := authenticator.Authenticate(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Subject != "alice" || p.Role != auth.RoleCurator || p.Method != auth.MethodAPIKey {
+				t.Errorf("got principal %+v", p)
+			}
+		})
+	}
+
+	stored, err := client.APIKey.Query().Where(apikey.ID(row.ID)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.LastUsedAt == 0 {
+		t.Error("key use was not recorded")
+	}
+
+	if _, err := authenticator.Verify(ctx, key+"x"); !errors.Is(err, auth.ErrInvalidCredentials) {
+		t.Errorf("unknown key: got error %v, want ErrInvalidCredentials", err)
+	}
+
+	if n, err := auth.RevokeKeys(ctx, client, []uuid.UUID{row.ID}); err != nil || n != 1 {
+		t.Fatalf("RevokeKeys = %d, %v", n, err)
+	}
+	if _, err := authenticator.Verify(ctx, key); !errors.Is(err, auth.ErrInvalidCredentials) {
+		t.Errorf("revoked key: got error %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestAuthenticateHeader(t *testing.T) {
+	client := datastoretest.NewClient(t, migrate.APIKeysTable)
+	authenticator := auth.NewAuthenticator(client, auth.Options{UserHeader: "X-Forwarded-User"})
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if _, err := authenticator.Authenticate(r); !errors.Is(err, auth.ErrMissingCredentials) {
+		t.Errorf("got error %v, want ErrMissingCredentials", err)
+	}
+
+	r.Header.Set("X-Forwarded-User", "bob")
+	p, err := authenticator.Authenticate(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Subject != "bob" || p.Role != auth.RoleCurator || p.Method != auth.MethodHeader {
+		t.Errorf("got principal %+v", p)
+	}
+
+	r.Header.Set("Authorization", "Bearer not-a-key")
+	if _, err := authenticator.Authenticate(r); !errors.Is(err, auth.ErrInvalidCredentials) {
+		t.Errorf("bearer token without a verifier: got error %v, want ErrInvalidCredentials", err)
+	}
+}
@@ -0,0 +1,357 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultRoleClaim = "roles"
+
+	// tokenLeeway tolerates clocks drifting between the issuer and us.
+	tokenLeeway = 30 * time.Second
+	// refreshInterval bounds how often the keys are fetched again for a
+	// token signed by an unknown key.
+	refreshInterval = time.Minute
+	fetchTimeout    = 10 * time.Second
+	maxJWKSBytes    = 1 << 20
+)
+
+// signingMethods are the asymmetric algorithms tokens may be signed with.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// TokenOptions configures where the signing keys of bearer tokens come from
+// and which tokens are accepted.
+type TokenOptions struct {
+	// JWKSFile is a local JWKS file, for running offline and in tests.
+	JWKSFile string
+	// JWKSURL is fetched for the keys. It is discovered from the OpenID
+	// configuration of Issuer when both it and JWKSFile are empty.
+	JWKSURL string
+	// Issuer is required of the iss claim when set.
+	Issuer string
+	// Audience is required in the aud claim when set.
+	Audience string
+	// RoleClaim names the claim carrying the role, a string or a list of
+	// strings whose highest known role is taken. Tokens without one are
+	// viewers. Defaults to roles.
+	RoleClaim string
+
+	HTTPClient *http.Client
+}
+
+// TokenVerifier validates JWT bearer tokens against a JWKS.
+type TokenVerifier struct {
+	opts   TokenOptions
+	parser *jwt.Parser
+
+	mu        sync.Mutex
+	keys      map[string]any
+	fetchedAt time.Time
+}
+
+// NewTokenVerifier loads the signing keys, discovering them from the issuer
+// when no JWKS is configured.
+func NewTokenVerifier(ctx context.Context, opts TokenOptions) (*TokenVerifier, error) {
+	if opts.RoleClaim == "" {
+		opts.RoleClaim = defaultRoleClaim
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: fetchTimeout}
+	}
+	if opts.JWKSFile == "" && opts.JWKSURL == "" {
+		if opts.Issuer == "" {
+			return nil, errors.New("a JWKS file, a JWKS URL or an OIDC issuer is required")
+		}
+		jwksURL, err := discoverJWKSURL(ctx, opts.HTTPClient, opts.Issuer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover JWKS of %s: %w", opts.Issuer, err)
+		}
+		opts.JWKSURL = jwksURL
+	}
+
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(tokenLeeway),
+	}
+	if opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.Issuer))
+	}
+	if opts.Audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(opts.Audience))
+	}
+
+	v := &TokenVerifier{opts: opts, parser: jwt.NewParser(parserOpts...)}
+	if err := v.load(ctx); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Verify returns the principal of a valid token.
+func (v *TokenVerifier) Verify(ctx context.Context, token string) (*Principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, fmt.Errorf("%w: token has no subject", ErrInvalidCredentials)
+	}
+	p := &Principal{Subject: subject, Role: tokenRole(claims[v.opts.RoleClaim]), Method: MethodJWT}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		p.ExpiresAt = exp.Time
+	}
+	return p, nil
+}
+
+// tokenRole returns the highest known role of a role claim, viewer when it
+// names none.
+func tokenRole(claim any) Role {
+	var names []string
+	switch value := claim.(type) {
+	case string:
+		names = strings.Fields(value)
+	case []any:
+		for _, v := range value {
+			if name, ok := v.(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+
+	role := RoleViewer
+	for _, name := range names {
+		if parsed, err := ParseRole(name); err == nil && parsed.Includes(role) {
+			role = parsed
+		}
+	}
+	return role
+}
+
+// key returns the signing key with the given id, fetching the JWKS URL again
+// when the key is unknown, the issuer may have rotated its keys. A token
+// without a key id is accepted when the JWKS has a single key.
+func (v *TokenVerifier) key(ctx context.Context, kid string) (any, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key, ok := v.lookup(kid); ok {
+		return key, nil
+	}
+	if v.opts.JWKSURL == "" || time.Since(v.fetchedAt) < refreshInterval {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	keys, err := v.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v.keys, v.fetchedAt = keys, time.Now()
+	if key, ok := v.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (v *TokenVerifier) lookup(kid string) (any, bool) {
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, true
+		}
+	}
+	key, ok := v.keys[kid]
+	return key, ok
+}
+
+func (v *TokenVerifier) load(ctx context.Context) error {
+	keys, err := v.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return errors.New("JWKS has no usable signing keys")
+	}
+	v.keys, v.fetchedAt = keys, time.Now()
+	return nil
+}
+
+// fetch reads the JWKS file, or fetches the JWKS URL.
+func (v *TokenVerifier) fetch(ctx context.Context) (map[string]any, error) {
+	if v.opts.JWKSFile != "" {
+		data, err := os.ReadFile(v.opts.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		return parseJWKS(data)
+	}
+
+	data, err := getJSON(ctx, v.opts.HTTPClient, v.opts.JWKSURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	return parseJWKS(data)
+}
+
+// discoverJWKSURL reads the jwks_uri of an OIDC issuer.
+func discoverJWKSURL(ctx context.Context, client *http.Client, issuer string) (string, error) {
+	data, err := getJSON(ctx, client, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration")
+	if err != nil {
+		return "", err
+	}
+	var config struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return "", err
+	}
+	if config.JWKSURI == "" {
+		return "", errors.New("openid configuration has no jwks_uri")
+	}
+	return config.JWKSURI, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJWKSBytes))
+}
+
+// jwk is a JSON Web Key, only the members of RSA and EC public keys are
+// read.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS returns the signing keys of a JWKS by key id. Keys for
+// encryption and of unsupported types are skipped.
+func parseJWKS(data []byte) (map[string]any, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	keys := make(map[string]any, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		var (
+			key any
+			err error
+		)
+		switch k.Kty {
+		case "RSA":
+			key, err = k.rsaKey()
+		case "EC":
+			key, err = k.ecKey()
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWKS key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k jwk) rsaKey() (*rsa.PublicKey, error) {
+	n, err := decodeInt(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeInt(k.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() || e.Int64() < 2 || e.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid RSA exponent")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func (k jwk) ecKey() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+
+	x, err := decodeInt(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeInt(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	size := (curve.Params().BitSize + 7) / 8
+	if len(x.Bytes()) > size || len(y.Bytes()) > size {
+		return nil, errors.New("coordinates do not fit the curve")
+	}
+	point := make([]byte, 1+2*size)
+	point[0] = 4
+	x.FillBytes(point[1 : 1+size])
+	y.FillBytes(point[1+size:])
+	return ecdsa.ParseUncompressedPublicKey(curve, point)
+}
+
+func decodeInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("empty key parameter")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
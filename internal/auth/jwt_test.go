@@ -0,0 +1,221 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/luoling8192/mindwave/internal/auth"
+)
+
+const (
+	testIssuer   = "https://issuer.test"
+	testAudience = "mindwave"
+)
+
+// signingKeys are throwaway keys, the RSA and EC ones are in the JWKS file
+// and other is not.
+type signingKeys struct {
+	rsa   *rsa.PrivateKey
+	ec    *ecdsa.PrivateKey
+	other *rsa.PrivateKey
+}
+
+func newSigningKeys(t *testing.T) signingKeys {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signingKeys{rsa: rsaKey, ec: ecKey, other: other}
+}
+
+func encodeInt(n *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(n.Bytes())
+}
+
+// writeJWKS writes the public RSA and EC keys as a JWKS file, next to an
+// encryption key that must be ignored.
+func writeJWKS(t *testing.T, keys signingKeys) string {
+	t.Helper()
+
+	size := (keys.ec.Curve.Params().BitSize + 7) / 8
+	ecX, ecY := make([]byte, size), make([]byte, size)
+	keys.ec.X.FillBytes(ecX)
+	keys.ec.Y.FillBytes(ecY)
+
+	set := map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa", "use": "sig", "n": encodeInt(keys.rsa.N), "e": encodeInt(big.NewInt(int64(keys.rsa.E)))},
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": base64.RawURLEncoding.EncodeToString(ecX), "y": base64.RawURLEncoding.EncodeToString(ecY)},
+		{"kty": "RSA", "kid": "enc", "use": "enc", "n": encodeInt(keys.other.N), "e": encodeInt(big.NewInt(int64(keys.other.E)))},
+	}}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key crypto.Signer, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+// validClaims returns the claims of a token the verifier accepts, changed by
+// edit.
+func validClaims(edit func(jwt.MapClaims)) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"sub": "carol",
+		"iss": testIssuer,
+		"aud": testAudience,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	if edit != nil {
+		edit(claims)
+	}
+	return claims
+}
+
+func TestTokenVerifier(t *testing.T) {
+	ctx := context.Background()
+	keys := newSigningKeys(t)
+	verifier, err := auth.NewTokenVerifier(ctx, auth.TokenOptions{
+		JWKSFile: writeJWKS(t, keys),
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accepted := []struct {
+		name  string
+		token string
+		role  auth.Role
+	}{
+		{
+			name:  "rsa without a role",
+			token: sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, validClaims(nil)),
+			role:  auth.RoleViewer,
+		},
+		{
+			name: "ec with a list of roles",
+			token: sign(t, jwt.SigningMethodES256, "ec", keys.ec, validClaims(func(c jwt.MapClaims) {
+				c["roles"] = []string{"viewer", "curator", "unknown"}
+			})),
+			role: auth.RoleCurator,
+		},
+		{
+			name: "space separated roles",
+			token: sign(t, jwt.SigningMethodPS256, "rsa", keys.rsa, validClaims(func(c jwt.MapClaims) {
+				c["roles"] = "admin viewer"
+			})),
+			role: auth.RoleAdmin,
+		},
+	}
+	for _, tt := range accepted {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := verifier.Verify(ctx, tt.token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Subject != "carol" || p.Role != tt.role || p.Method != auth.MethodJWT {
+				t.Errorf("got principal %+v, want carol as %s", p, tt.role)
+			}
+			if p.ExpiresAt.IsZero() {
+				t.Error("expiry was not taken from the token")
+			}
+		})
+	}
+
+	rejected := []struct {
+		name  string
+		token string
+	}{
+		{"expired", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, validClaims(func(c jwt.MapClaims) {
+			c["exp"] = time.Now().Add(-time.Hour).Unix()
+		}))},
+		{"without expiry", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, validClaims(func(c jwt.MapClaims) {
+			delete(c, "exp")
+		}))},
+		{"other issuer", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, validClaims(func(c jwt.MapClaims) {
+			c["iss"] = "https://elsewhere.test"
+		}))},
+		{"other audience", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, validClaims(func(c jwt.MapClaims) {
+			c["aud"] = "another-app"
+		}))},
+		{"without subject", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, validClaims(func(c jwt.MapClaims) {
+			delete(c, "sub")
+		}))},
+		{"unknown key", sign(t, jwt.SigningMethodRS256, "other", keys.other, validClaims(nil))},
+		{"signed by another key", sign(t, jwt.SigningMethodRS256, "rsa", keys.other, validClaims(nil))},
+		{"encryption key", sign(t, jwt.SigningMethodRS256, "enc", keys.other, validClaims(nil))},
+		{"without key id among several keys", sign(t, jwt.SigningMethodRS256, "", keys.rsa, validClaims(nil))},
+		{"symmetric", func() string {
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims(nil))
+			token.Header["kid"] = "rsa"
+			signed, err := token.SignedString([]byte("secret"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			return signed
+		}()},
+	}
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			if p, err := verifier.Verify(ctx, tt.token); !errors.Is(err, auth.ErrInvalidCredentials) {
+				t.Errorf("got %+v, %v, want ErrInvalidCredentials", p, err)
+			}
+		})
+	}
+}
+
+func TestNewTokenVerifierRequiresKeys(t *testing.T) {
+	ctx := context.Background()
+	if _, err := auth.NewTokenVerifier(ctx, auth.TokenOptions{}); err == nil {
+		t.Error("verifier without a key source was created")
+	}
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, []byte(`{"keys": [{"kty": "oct", "kid": "k"}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := auth.NewTokenVerifier(ctx, auth.TokenOptions{JWKSFile: path}); err == nil {
+		t.Error("verifier without usable keys was created")
+	}
+}
@@ -0,0 +1,60 @@
+// Package datastoretest opens throwaway in-memory SQLite databases for tests
+// of code that stores through the datastore client.
+package datastoretest
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/datastore"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var databases atomic.Int64
+
+// NewClient opens a database with only the given tables, closed when the
+// test ends. Columns typed for Postgres only, such as vectors, are stored as
+// blobs. Raw SQL written for Postgres does not run on it.
+func NewClient(t testing.TB, tables ...*schema.Table) *datastore.Client {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:datastoretest%d?mode=memory&cache=shared&_fk=1", databases.Add(1))
+	driver, err := entsql.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := datastore.NewClient(driver)
+	t.Cleanup(func() { _ = client.Close() })
+
+	if err := migrate.Create(context.Background(), client.Schema, sqliteTables(tables), schema.WithForeignKeys(false)); err != nil {
+		t.Fatalf("failed to create tables: %v", err)
+	}
+	return client
+}
+
+// sqliteTables copies the tables, giving a SQLite type to the columns that
+// only have a Postgres one.
+func sqliteTables(tables []*schema.Table) []*schema.Table {
+	copies := make([]*schema.Table, 0, len(tables))
+	for _, t := range tables {
+		c := *t
+		c.Columns = make([]*schema.Column, 0, len(t.Columns))
+		for _, col := range t.Columns {
+			if col.SchemaType[dialect.Postgres] != "" && col.SchemaType[dialect.SQLite] == "" {
+				copied := *col
+				copied.SchemaType = map[string]string{dialect.SQLite: "blob"}
+				col = &copied
+			}
+			c.Columns = append(c.Columns, col)
+		}
+		copies = append(copies, &c)
+	}
+	return copies
+}
@@ -2,9 +2,12 @@ package datastore
 
 import (
 	"context"
+	"database/sql"
 
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/schema"
+	"github.com/google/uuid"
 	"github.com/luoling8192/mindwave/ent"
 	"github.com/luoling8192/mindwave/ent/migrate"
 
@@ -13,15 +16,24 @@ import (
 
 type Client struct {
 	*ent.Client
+	db *sql.DB
 }
 
 func NewEntClient(databaseURL string) (*Client, error) {
-	client, err := ent.Open(dialect.Postgres, databaseURL+"?sslmode=disable")
+	driver, err := entsql.Open(dialect.Postgres, databaseURL+"?sslmode=disable")
 	if err != nil {
 		return nil, err
 	}
 
-	return &Client{Client: client}, nil
+	return NewClient(driver), nil
+}
+
+// NewClient wraps an open driver, tests use it to run on SQLite.
+func NewClient(driver *entsql.Driver) *Client {
+	client := ent.NewClient(ent.Driver(driver))
+	scopeWorkspaces(client)
+
+	return &Client{Client: client, db: driver.DB()}
 }
 
 func (c *Client) Ping(ctx context.Context) error {
@@ -30,13 +42,228 @@ func (c *Client) Ping(ctx context.Context) error {
 }
 
 func (c *Client) Migrate(ctx context.Context) error {
-	return migrate.Create(
+	err := migrate.Create(
 		ctx,
 		c.Schema,
 		[]*schema.Table{
+			migrate.AccessLogsTable,
+			migrate.APIKeysTable,
+			migrate.AskTurnsTable,
+			migrate.ChatGrantsTable,
+			migrate.ChatRetentionsTable,
+			migrate.ChatSchedulesTable,
+			migrate.DigestDeliveriesTable,
+			migrate.DistillRunsTable,
 			migrate.EventsTable,
 			migrate.IdentitiesTable,
+			migrate.IdentityEventsTable,
+			migrate.JobsTable,
+			migrate.LlmCacheEntriesTable,
+			migrate.OwnerAccountLinksTable,
+			migrate.PersonsTable,
+			migrate.PersonAuditLogsTable,
+			migrate.ProfilesTable,
+			migrate.SummariesTable,
+			migrate.WorkspacesTable,
 		},
 		migrate.WithForeignKeys(true),
 	)
+	if err != nil {
+		return err
+	}
+
+	if err := c.ensureJiebaTokensIndex(ctx); err != nil {
+		return err
+	}
+	if err := c.ensureWorkspaceColumns(ctx); err != nil {
+		return err
+	}
+	return c.ensureTokenizeJobs(ctx)
+}
+
+// ensureJiebaTokensIndex makes sure chat_messages.jieba_tokens is indexed with GIN.
+// chat_messages is owned by the crawler and not migrated here, so an index it
+// created with the default btree method is replaced.
+func (c *Client) ensureJiebaTokensIndex(ctx context.Context) error {
+	const indexName = "chatmessage_jieba_tokens"
+
+	rows, err := c.QueryContext(ctx, `SELECT COALESCE((
+  SELECT am.amname
+  FROM pg_class i
+  JOIN pg_am am ON am.oid = i.relam
+  WHERE i.relkind = 'i' AND i.relname = $1
+), ''), to_regclass('chat_messages') IS NOT NULL`, indexName)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	method, tableExists := "", false
+	if rows.Next() {
+		if err := rows.Scan(&method, &tableExists); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if !tableExists || method == "gin" {
+		return nil
+	}
+	if method != "" {
+		if _, err := c.ExecContext(ctx, "DROP INDEX IF EXISTS "+indexName); err != nil {
+			return err
+		}
+	}
+
+	_, err = c.ExecContext(ctx, "CREATE INDEX IF NOT EXISTS "+indexName+" ON chat_messages USING GIN (jieba_tokens)")
+	return err
+}
+
+// ensureWorkspaceColumns adds workspace_id to the tables owned by the
+// crawler. The crawler does not know about workspaces, so a trigger files its
+// new messages under the workspace their chat was assigned to.
+func (c *Client) ensureWorkspaceColumns(ctx context.Context) error {
+	// The unique indexes from before workspaces would keep a platform user,
+	// an owner account link, a policy, a schedule, a delivery and a job key
+	// to one workspace.
+	err := c.dropIndexes(ctx,
+		"identity_platform_platform_user_id",
+		"owneraccountlink_subject_owner_account_id",
+		"chat_retentions_chat_id_key",
+		"chat_schedules_chat_id_key",
+		"digestdelivery_publisher_chat_id_digest_key",
+		"jobs_key_key",
+	)
+	if err != nil {
+		return err
+	}
+
+	// Index names follow the ones ent gives WorkspaceMixin's index.
+	tables := []struct{ name, index string }{
+		{"joined_chats", "joinedchat_workspace_id"},
+		{"chat_messages", "chatmessage_workspace_id"},
+	}
+	missing := false
+	for _, table := range tables {
+		var exists bool
+		if err := c.db.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", table.name).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			missing = true
+			continue
+		}
+
+		stmts := []string{
+			"ALTER TABLE " + table.name + " ADD COLUMN IF NOT EXISTS workspace_id uuid NOT NULL DEFAULT '" + uuid.Nil.String() + "'",
+			"CREATE INDEX IF NOT EXISTS " + table.index + " ON " + table.name + " (workspace_id)",
+		}
+		for _, stmt := range stmts {
+			if _, err := c.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The trigger reads joined_chats and fires on chat_messages, it waits
+	// until the crawler has created both.
+	if missing {
+		return nil
+	}
+
+	_, err = c.ExecContext(ctx, `CREATE OR REPLACE FUNCTION chat_messages_workspace() RETURNS trigger AS $$
+BEGIN
+  IF NEW.workspace_id = '`+uuid.Nil.String()+`' THEN
+    SELECT jc.workspace_id INTO NEW.workspace_id
+    FROM joined_chats AS jc
+    WHERE jc.platform = NEW.platform AND jc.chat_id = NEW.in_chat_id;
+    NEW.workspace_id := COALESCE(NEW.workspace_id, '`+uuid.Nil.String()+`');
+  END IF;
+  RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+DROP TRIGGER IF EXISTS chat_messages_workspace ON chat_messages;
+CREATE TRIGGER chat_messages_workspace BEFORE INSERT ON chat_messages
+  FOR EACH ROW EXECUTE FUNCTION chat_messages_workspace();`)
+	return err
+}
+
+// dropIndexes drops the indexes that still exist, along with the unique
+// constraints Postgres created them for.
+func (c *Client) dropIndexes(ctx context.Context, names ...string) error {
+	for _, name := range names {
+		var (
+			exists     bool
+			constraint string
+		)
+		err := c.db.QueryRowContext(ctx, `SELECT to_regclass($1) IS NOT NULL, COALESCE((
+  SELECT conrelid::regclass::text FROM pg_constraint WHERE conname = $1 LIMIT 1
+), '')`, name).Scan(&exists, &constraint)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case constraint != "":
+			_, err = c.ExecContext(ctx, "ALTER TABLE "+constraint+" DROP CONSTRAINT "+name)
+		case exists:
+			_, err = c.ExecContext(ctx, "DROP INDEX "+name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureTokenizeJobs adds tokenized_at to chat_messages and a trigger
+// enqueueing a tokenize job for every message the crawler writes, which
+// cannot tokenize them itself.
+func (c *Client) ensureTokenizeJobs(ctx context.Context) error {
+	var tableExists, columnExists bool
+	err := c.db.QueryRowContext(ctx, `SELECT to_regclass('chat_messages') IS NOT NULL, EXISTS (
+  SELECT 1 FROM information_schema.columns
+  WHERE table_name = 'chat_messages' AND column_name = 'tokenized_at' AND table_schema = current_schema()
+)`).Scan(&tableExists, &columnExists)
+	if err != nil || !tableExists {
+		return err
+	}
+
+	if !columnExists {
+		// Messages tokenized before the column existed carry their tokens,
+		// or a [""] marker when they had none.
+		stmts := []string{
+			"ALTER TABLE chat_messages ADD COLUMN tokenized_at bigint NOT NULL DEFAULT 0",
+			`UPDATE chat_messages SET tokenized_at = (extract(epoch FROM now()) * 1000)::bigint,
+  jieba_tokens = CASE WHEN jieba_tokens = '[""]'::jsonb THEN '[]'::jsonb ELSE jieba_tokens END
+WHERE jieba_tokens IS NOT NULL AND jieba_tokens <> '[]'::jsonb`,
+		}
+		for _, stmt := range stmts {
+			if _, err := c.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Job columns have no database defaults, the trigger sets those ent
+	// would.
+	_, err = c.ExecContext(ctx, `CREATE OR REPLACE FUNCTION chat_messages_tokenize() RETURNS trigger AS $$
+DECLARE
+  now_ms bigint := (extract(epoch FROM clock_timestamp()) * 1000)::bigint;
+BEGIN
+  IF NEW.content <> '' AND NEW.deleted_at = 0 AND NEW.tokenized_at = 0 THEN
+    INSERT INTO jobs (id, workspace_id, kind, payload, status, attempts, max_attempts, run_at,
+      locked_by, locked_until, last_error, finished_at, created_at, updated_at)
+    VALUES (gen_random_uuid(), NEW.workspace_id, 'tokenize', jsonb_build_object('message_ids', jsonb_build_array(NEW.id)),
+      'pending', 0, 5, now_ms, '', 0, '', 0, now_ms, now_ms);
+  END IF;
+  RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+DROP TRIGGER IF EXISTS chat_messages_tokenize ON chat_messages;
+CREATE TRIGGER chat_messages_tokenize AFTER INSERT ON chat_messages
+  FOR EACH ROW EXECUTE FUNCTION chat_messages_tokenize();`)
+	return err
 }
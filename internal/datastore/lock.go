@@ -0,0 +1,52 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+)
+
+// AdvisoryLock is a Postgres session-level advisory lock. It is held by one
+// pooled connection, and released when that connection closes, so a process
+// that dies or loses its database connection gives the lock up.
+type AdvisoryLock struct {
+	key  int64
+	conn *sql.Conn
+}
+
+// TryAdvisoryLock takes the advisory lock key without waiting. It returns nil
+// when another session holds it.
+func (c *Client) TryAdvisoryLock(ctx context.Context, key int64) (*AdvisoryLock, error) {
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&locked); err != nil {
+		return nil, errors.Join(err, conn.Close())
+	}
+	if !locked {
+		return nil, conn.Close()
+	}
+
+	return &AdvisoryLock{key: key, conn: conn}, nil
+}
+
+// Check verifies the session holding the lock is still alive.
+func (l *AdvisoryLock) Check(ctx context.Context) error {
+	_, err := l.conn.ExecContext(ctx, "SELECT 1")
+	return err
+}
+
+// Release unlocks and returns the connection to the pool. When the unlock
+// fails the connection is discarded instead, which ends the session and with
+// it the lock.
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	if _, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+		_ = l.conn.Raw(func(any) error { return driver.ErrBadConn })
+		return errors.Join(err, l.conn.Close())
+	}
+	return l.conn.Close()
+}
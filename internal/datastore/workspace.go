@@ -0,0 +1,100 @@
+package datastore
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/intercept"
+)
+
+// workspaceColumn is the column WorkspaceMixin adds to scoped tables.
+const workspaceColumn = "workspace_id"
+
+type (
+	workspaceKey struct{}
+	unscopedKey  struct{}
+)
+
+// WithWorkspace scopes the queries and mutations run with ctx to a
+// workspace, uuid.Nil being the default workspace.
+func WithWorkspace(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, workspaceKey{}, id)
+}
+
+// WorkspaceID returns the workspace ctx is scoped to, the default workspace
+// when none was set. Raw queries filter their scoped tables by it.
+func WorkspaceID(ctx context.Context) uuid.UUID {
+	id, _ := ctx.Value(workspaceKey{}).(uuid.UUID)
+	return id
+}
+
+// Unscoped lifts the workspace scope, for commands that move rows between
+// workspaces.
+func Unscoped(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unscopedKey{}, true)
+}
+
+func isUnscoped(ctx context.Context) bool {
+	unscoped, _ := ctx.Value(unscopedKey{}).(bool)
+	return unscoped
+}
+
+// scopedTypes are the entities carrying a workspace_id.
+var scopedTypes = map[string]bool{
+	ent.TypeAccessLog:        true,
+	ent.TypeAPIKey:           true,
+	ent.TypeAskTurn:          true,
+	ent.TypeChatGrant:        true,
+	ent.TypeChatMessage:      true,
+	ent.TypeChatRetention:    true,
+	ent.TypeChatSchedule:     true,
+	ent.TypeDigestDelivery:   true,
+	ent.TypeDistillRun:       true,
+	ent.TypeEvent:            true,
+	ent.TypeIdentity:         true,
+	ent.TypeJob:              true,
+	ent.TypeJoinedChat:       true,
+	ent.TypeOwnerAccountLink: true,
+	ent.TypePerson:           true,
+	ent.TypePersonAuditLog:   true,
+	ent.TypeProfile:          true,
+	ent.TypeSummary:          true,
+}
+
+// scopeWorkspaces keeps every read and write of a scoped entity inside the
+// workspace of its context. Reads and updates are filtered by workspace_id,
+// created rows are stamped with it.
+func scopeWorkspaces(client *ent.Client) {
+	client.Intercept(intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+		if scopedTypes[q.Type()] && !isUnscoped(ctx) {
+			q.WhereP(sql.FieldEQ(workspaceColumn, WorkspaceID(ctx)))
+		}
+		return nil
+	}))
+
+	client.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if !scopedTypes[m.Type()] || isUnscoped(ctx) {
+				return next.Mutate(ctx, m)
+			}
+
+			id := WorkspaceID(ctx)
+			if m.Op().Is(ent.OpCreate) {
+				// The default workspace_id is already set when hooks run,
+				// it is replaced. Rows are moved between workspaces
+				// unscoped.
+				if s, ok := m.(interface{ SetWorkspaceID(uuid.UUID) }); ok {
+					s.SetWorkspaceID(id)
+				}
+				return next.Mutate(ctx, m)
+			}
+
+			if w, ok := m.(interface{ WhereP(...func(*sql.Selector)) }); ok {
+				w.WhereP(sql.FieldEQ(workspaceColumn, id))
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+}
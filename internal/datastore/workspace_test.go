@@ -0,0 +1,75 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/chatretention"
+	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/datastore/datastoretest"
+	"github.com/luoling8192/mindwave/internal/services/retention"
+)
+
+func TestWorkspaceIsolation(t *testing.T) {
+	client := datastoretest.NewClient(t, migrate.JoinedChatsTable, migrate.ChatRetentionsTable)
+	a := datastore.WithWorkspace(context.Background(), uuid.New())
+	b := datastore.WithWorkspace(context.Background(), uuid.New())
+
+	chat, err := client.JoinedChat.Create().SetPlatform("telegram").SetChatID("shared").SetChatName("A's chat").Save(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chat.WorkspaceID != datastore.WorkspaceID(a) {
+		t.Errorf("created row was filed under workspace %s, want %s", chat.WorkspaceID, datastore.WorkspaceID(a))
+	}
+
+	if n, err := client.JoinedChat.Query().Count(b); err != nil || n != 0 {
+		t.Errorf("workspace B counts %d chats of A, %v", n, err)
+	}
+	if _, err := client.JoinedChat.Get(b, chat.ID); err == nil {
+		t.Error("workspace B read a chat of A by id")
+	}
+	if n, err := client.JoinedChat.Update().Where(joinedchat.ChatID("shared")).SetChatName("taken").Save(b); err != nil || n != 0 {
+		t.Errorf("workspace B updated %d chats of A, %v", n, err)
+	}
+	if err := client.JoinedChat.UpdateOneID(chat.ID).SetChatName("taken").Exec(b); err == nil {
+		t.Error("workspace B updated a chat of A by id")
+	}
+	if n, err := client.JoinedChat.Delete().Exec(b); err != nil || n != 0 {
+		t.Errorf("workspace B deleted %d chats of A, %v", n, err)
+	}
+
+	got, err := client.JoinedChat.Get(a, chat.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ChatName != "A's chat" {
+		t.Errorf("chat of A was renamed to %q from workspace B", got.ChatName)
+	}
+	if n, err := client.JoinedChat.Query().Count(datastore.Unscoped(b)); err != nil || n != 1 {
+		t.Errorf("an unscoped query counts %d chats, %v, want 1", n, err)
+	}
+
+	// Upserts conflict within a workspace only, B setting a policy for the
+	// same chat id keeps the policy of A.
+	if _, err := retention.Set(a, client, "shared", 30, chatretention.ModeContent, true); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := retention.Set(b, client, "shared", 7, chatretention.ModeAll, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policy.WorkspaceID != datastore.WorkspaceID(b) || policy.Days != 7 {
+		t.Errorf("workspace B got policy %+v", policy)
+	}
+	policyA, err := client.ChatRetention.Query().Where(chatretention.ChatID("shared")).Only(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policyA.Days != 30 || policyA.Mode != chatretention.ModeContent {
+		t.Errorf("workspace B rewrote the policy of A to %+v", policyA)
+	}
+}
@@ -41,13 +41,99 @@ END $$;`, w.graphName)
 	return err
 }
 
-func (w *Writer) UpsertPerson(ctx context.Context, platform, userID, displayName string) error {
+// UpsertPerson merges the Person node of a resolved person, keyed by the
+// persons table id so every identity of a person shares one node.
+func (w *Writer) UpsertPerson(ctx context.Context, personUUID, displayName string) error {
 	query := fmt.Sprintf(
-		`MERGE (p:Person {platform: '%s', platform_user_id: '%s'})
+		`MERGE (p:Person {uuid: '%s'})
 SET p.name = '%s'`,
+		escape(personUUID),
+		escape(displayName),
+	)
+	return w.execCypher(ctx, query)
+}
+
+// AbsorbIdentity moves the edges of a Person node written per identity, before
+// identity resolution existed, onto the person's node and removes it.
+func (w *Writer) AbsorbIdentity(ctx context.Context, personUUID, platform, userID string) error {
+	query := fmt.Sprintf(
+		`MATCH (old:Person {platform: '%s', platform_user_id: '%s'})-[:CONTRIBUTED_TO]->(e:Event), (p:Person {uuid: '%s'})
+MERGE (p)-[:CONTRIBUTED_TO]->(e)`,
+		escape(platform),
+		escape(userID),
+		escape(personUUID),
+	)
+	if err := w.execCypher(ctx, query); err != nil {
+		return err
+	}
+
+	query = fmt.Sprintf(
+		`MATCH (old:Person {platform: '%s', platform_user_id: '%s'})
+DETACH DELETE old`,
 		escape(platform),
 		escape(userID),
-		escape(displayName),
+	)
+	return w.execCypher(ctx, query)
+}
+
+// MergePersons collapses the source Person nodes into the target node.
+func (w *Writer) MergePersons(ctx context.Context, targetUUID string, sourceUUIDs []string) error {
+	for _, sourceUUID := range sourceUUIDs {
+		if sourceUUID == targetUUID {
+			continue
+		}
+
+		query := fmt.Sprintf(
+			`MATCH (s:Person {uuid: '%s'})-[:CONTRIBUTED_TO]->(e:Event), (t:Person {uuid: '%s'})
+MERGE (t)-[:CONTRIBUTED_TO]->(e)`,
+			escape(sourceUUID),
+			escape(targetUUID),
+		)
+		if err := w.execCypher(ctx, query); err != nil {
+			return err
+		}
+
+		query = fmt.Sprintf(
+			`MATCH (s:Person {uuid: '%s'})
+DETACH DELETE s`,
+			escape(sourceUUID),
+		)
+		if err := w.execCypher(ctx, query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeletePerson removes a Person node and its edges.
+func (w *Writer) DeletePerson(ctx context.Context, personUUID string) error {
+	query := fmt.Sprintf(
+		`MATCH (p:Person {uuid: '%s'})
+DETACH DELETE p`,
+		escape(personUUID),
+	)
+	return w.execCypher(ctx, query)
+}
+
+// DeleteIdentity removes the Person node written per identity before
+// identity resolution existed, if there still is one.
+func (w *Writer) DeleteIdentity(ctx context.Context, platform, userID string) error {
+	query := fmt.Sprintf(
+		`MATCH (p:Person {platform: '%s', platform_user_id: '%s'})
+DETACH DELETE p`,
+		escape(platform),
+		escape(userID),
+	)
+	return w.execCypher(ctx, query)
+}
+
+// DeleteEvent removes an Event node and its edges.
+func (w *Writer) DeleteEvent(ctx context.Context, eventUUID string) error {
+	query := fmt.Sprintf(
+		`MATCH (e:Event {uuid: '%s'})
+DETACH DELETE e`,
+		escape(eventUUID),
 	)
 	return w.execCypher(ctx, query)
 }
@@ -86,12 +172,21 @@ func (w *Writer) UpsertTopic(ctx context.Context, name string) error {
 	return w.execCypher(ctx, query)
 }
 
-func (w *Writer) LinkPersonEvent(ctx context.Context, platform, userID, eventUUID string) error {
+func (w *Writer) LinkPersonEvent(ctx context.Context, personUUID, eventUUID string) error {
 	query := fmt.Sprintf(
-		`MATCH (p:Person {platform: '%s', platform_user_id: '%s'}), (e:Event {uuid: '%s'})
+		`MATCH (p:Person {uuid: '%s'}), (e:Event {uuid: '%s'})
 MERGE (p)-[:CONTRIBUTED_TO]->(e)`,
-		escape(platform),
-		escape(userID),
+		escape(personUUID),
+		escape(eventUUID),
+	)
+	return w.execCypher(ctx, query)
+}
+
+func (w *Writer) UnlinkPersonEvent(ctx context.Context, personUUID, eventUUID string) error {
+	query := fmt.Sprintf(
+		`MATCH (p:Person {uuid: '%s'})-[r:CONTRIBUTED_TO]->(e:Event {uuid: '%s'})
+DELETE r`,
+		escape(personUUID),
 		escape(eventUUID),
 	)
 	return w.execCypher(ctx, query)
@@ -107,6 +202,16 @@ MERGE (e)-[:MENTIONS]->(t)`,
 	return w.execCypher(ctx, query)
 }
 
+func (w *Writer) LinkEventContinues(ctx context.Context, eventUUID, previousEventUUID string) error {
+	query := fmt.Sprintf(
+		`MATCH (e:Event {uuid: '%s'}), (p:Event {uuid: '%s'})
+MERGE (e)-[:CONTINUES]->(p)`,
+		escape(eventUUID),
+		escape(previousEventUUID),
+	)
+	return w.execCypher(ctx, query)
+}
+
 func (w *Writer) execCypher(ctx context.Context, query string) error {
 	stmt := fmt.Sprintf(
 		"SELECT * FROM ag_catalog.cypher('%s', $$%s$$) as (v agtype);",
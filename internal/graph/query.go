@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// EventNeighbours returns events within two hops of the given events, through
+// shared topics, shared persons or CONTINUES edges, most connected first.
+func (w *Writer) EventNeighbours(ctx context.Context, eventUUIDs []string, limit int) ([]string, error) {
+	if len(eventUUIDs) == 0 {
+		return []string{}, nil
+	}
+
+	seeds := formatList(eventUUIDs)
+	query := fmt.Sprintf(
+		`MATCH (e:Event)-[*1..2]-(n:Event)
+WHERE e.uuid IN %s AND NOT n.uuid IN %s
+RETURN n.uuid, count(*) AS weight
+ORDER BY weight DESC
+LIMIT %d`,
+		seeds,
+		seeds,
+		limit,
+	)
+
+	rows, err := w.queryCypher(ctx, query, "uuid", "weight")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	neighbours := make([]string, 0)
+	for rows.Next() {
+		var uuidValue, weight sql.NullString
+		if err := rows.Scan(&uuidValue, &weight); err != nil {
+			return nil, err
+		}
+
+		var id string
+		if err := decodeAgtype(uuidValue, &id); err != nil {
+			return nil, err
+		}
+		if id != "" {
+			neighbours = append(neighbours, id)
+		}
+	}
+
+	return neighbours, rows.Err()
+}
+
+// queryCypher runs a read query whose RETURN clause has the given columns.
+func (w *Writer) queryCypher(ctx context.Context, query string, columns ...string) (*sql.Rows, error) {
+	definitions := make([]string, 0, len(columns))
+	for _, column := range columns {
+		definitions = append(definitions, column+" agtype")
+	}
+
+	stmt := fmt.Sprintf(
+		"SELECT * FROM ag_catalog.cypher('%s', $$%s$$) as (%s);",
+		w.graphName,
+		query,
+		strings.Join(definitions, ", "),
+	)
+	return w.client.QueryContext(ctx, stmt)
+}
+
+// decodeAgtype decodes a scalar agtype value, whose text form is JSON.
+func decodeAgtype(raw sql.NullString, dest any) error {
+	if !raw.Valid || raw.String == "null" {
+		return nil
+	}
+	return json.Unmarshal([]byte(raw.String), dest)
+}
@@ -0,0 +1,50 @@
+// Package httpserver runs the HTTP servers of the API and the MCP transport.
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	shutdownTimeout   = 10 * time.Second
+	readHeaderTimeout = 10 * time.Second
+)
+
+// ListenAndServe serves handler on addr until ctx is done, then shuts down
+// gracefully. name and attrs are logged on start.
+func ListenAndServe(ctx context.Context, addr string, handler http.Handler, name string, attrs ...any) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+		// Requests carry the values of ctx, such as the workspace, and are
+		// cut off by the graceful shutdown rather than by ctx.
+		BaseContext: func(net.Listener) context.Context { return context.WithoutCancel(ctx) },
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		slog.Info("Starting "+name, append(attrs, "addr", addr)...)
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+		if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+}
@@ -0,0 +1,8 @@
+package jobs
+
+var (
+	Backoff        = backoff
+	NextStatus     = nextStatus
+	Truncate       = truncate
+	MaxErrorLength = maxErrorLength
+)
@@ -0,0 +1,119 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/job"
+	"github.com/luoling8192/mindwave/internal/datastore"
+)
+
+// Kind is the kind of work a job does, workers register a handler per kind.
+type Kind = job.Kind
+
+const (
+	KindDistill   = job.KindDistill
+	KindEmbed     = job.KindEmbed
+	KindGraphSync = job.KindGraphSync
+	KindProfile   = job.KindProfile
+	KindTokenize  = job.KindTokenize
+)
+
+// DistillPayload asks for the messages of a chat in [Start, End) to be
+// distilled, as Unix seconds.
+type DistillPayload struct {
+	ChatID string `json:"chat_id"`
+	Start  int64  `json:"start"`
+	End    int64  `json:"end"`
+}
+
+// EventsPayload names the events an embed or graph sync job works on.
+type EventsPayload struct {
+	EventIDs []uuid.UUID `json:"event_ids"`
+}
+
+// ProfilePayload names the identity a profile job updates the profile of.
+type ProfilePayload struct {
+	IdentityID uuid.UUID `json:"identity_id"`
+}
+
+// MessagesPayload names the chat messages a tokenize job fills the tokens
+// of. The database enqueues one for every message the crawler writes.
+type MessagesPayload struct {
+	MessageIDs []uuid.UUID `json:"message_ids"`
+}
+
+type EnqueueOptions struct {
+	// Key deduplicates jobs, enqueueing a job with the key of an existing
+	// one returns the existing job.
+	Key string
+	// MaxAttempts defaults to 5.
+	MaxAttempts int
+	// RunAt delays the first attempt.
+	RunAt time.Time
+}
+
+// Enqueue adds a job to the queue.
+func Enqueue(ctx context.Context, client *datastore.Client, kind Kind, payload any, opts EnqueueOptions) (*ent.Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	create := client.Job.Create().
+		SetKind(kind).
+		SetPayload(data)
+	if opts.MaxAttempts > 0 {
+		create.SetMaxAttempts(opts.MaxAttempts)
+	}
+	if !opts.RunAt.IsZero() {
+		create.SetRunAt(opts.RunAt.UnixMilli())
+	}
+	if opts.Key == "" {
+		return create.Save(ctx)
+	}
+
+	err = create.
+		SetKey(opts.Key).
+		OnConflictColumns(job.FieldWorkspaceID, job.FieldKey).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.Job.Query().Where(job.Key(opts.Key)).Only(ctx)
+}
+
+// Retry puts dead or cancelled jobs back in the queue with a fresh attempt
+// budget, and returns how many were requeued.
+func Retry(ctx context.Context, client *datastore.Client, ids ...uuid.UUID) (int, error) {
+	return client.Job.Update().
+		Where(
+			job.IDIn(ids...),
+			job.StatusIn(job.StatusDead, job.StatusCancelled),
+		).
+		SetStatus(job.StatusPending).
+		SetAttempts(0).
+		SetRunAt(time.Now().UnixMilli()).
+		SetLockedBy("").
+		SetLockedUntil(0).
+		SetFinishedAt(0).
+		Save(ctx)
+}
+
+// Cancel gives up on pending or running jobs and returns how many were
+// cancelled. A running attempt is not interrupted, but its outcome is
+// discarded.
+func Cancel(ctx context.Context, client *datastore.Client, ids ...uuid.UUID) (int, error) {
+	return client.Job.Update().
+		Where(
+			job.IDIn(ids...),
+			job.StatusIn(job.StatusPending, job.StatusRunning),
+		).
+		SetStatus(job.StatusCancelled).
+		SetFinishedAt(time.Now().UnixMilli()).
+		Save(ctx)
+}
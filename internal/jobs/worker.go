@@ -0,0 +1,371 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/job"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/metrics"
+)
+
+const (
+	defaultConcurrency  = 2
+	defaultPollInterval = 5 * time.Second
+	defaultLease        = 30 * time.Minute
+	defaultBaseBackoff  = 30 * time.Second
+	defaultMaxBackoff   = 30 * time.Minute
+
+	maxErrorLength = 4000
+)
+
+// Handler runs one attempt of a job.
+type Handler func(ctx context.Context, payload json.RawMessage) error
+
+type WorkerOptions struct {
+	// Concurrency bounds the jobs running at once in this worker.
+	Concurrency int
+	// PollInterval is how long an idle worker waits before looking for jobs
+	// again.
+	PollInterval time.Duration
+	// Lease bounds an attempt, a job still running after it is considered
+	// abandoned and claimed again.
+	Lease time.Duration
+	// BaseBackoff and MaxBackoff bound the exponential delay before the
+	// next attempt of a failed job.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Worker claims jobs from the queue and runs them with the handler registered
+// for their kind. Any number of workers can share a queue.
+type Worker struct {
+	client   *datastore.Client
+	handlers map[job.Kind]Handler
+	opts     WorkerOptions
+	id       string
+}
+
+func NewWorker(client *datastore.Client, handlers map[job.Kind]Handler, opts WorkerOptions) *Worker {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultConcurrency
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.Lease <= 0 {
+		opts.Lease = defaultLease
+	}
+	if opts.BaseBackoff <= 0 {
+		opts.BaseBackoff = defaultBaseBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaultMaxBackoff
+	}
+
+	hostname, _ := os.Hostname()
+	return &Worker{
+		client:   client,
+		handlers: handlers,
+		opts:     opts,
+		id:       fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.NewString()[:8]),
+	}
+}
+
+// Run processes jobs until ctx is done.
+func (w *Worker) Run(ctx context.Context) error {
+	return w.run(ctx, nil)
+}
+
+// Drain processes jobs until none of ids can run right now: each has
+// finished, died, been cancelled or is waiting out a retry backoff.
+func (w *Worker) Drain(ctx context.Context, ids []uuid.UUID) error {
+	return w.run(ctx, ids)
+}
+
+func (w *Worker) run(ctx context.Context, only []uuid.UUID) error {
+	slog.Info("Starting job worker", "worker", w.id, "concurrency", w.opts.Concurrency)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	slots := make(chan struct{}, w.opts.Concurrency)
+
+	for {
+		if only != nil {
+			done, err := w.settled(ctx, only)
+			if err != nil {
+				return err
+			}
+			if done {
+				return nil
+			}
+		}
+		w.updateDepth(ctx)
+
+		// Wait for a free slot, then claim as many jobs as there are slots.
+		select {
+		case slots <- struct{}{}:
+			<-slots
+		case <-ctx.Done():
+			return nil
+		}
+		claimed, err := w.claim(ctx, w.opts.Concurrency-len(slots), only)
+		if err != nil {
+			slog.Error("failed to claim jobs", "worker", w.id, "error", err)
+		}
+
+		for _, j := range claimed {
+			slots <- struct{}{}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-slots }()
+				w.execute(ctx, j)
+			}()
+		}
+
+		if len(claimed) > 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.opts.PollInterval):
+		}
+	}
+}
+
+// claim locks up to n runnable jobs, skipping rows other workers are
+// claiming, and leases them to this worker.
+func (w *Worker) claim(ctx context.Context, n int, only []uuid.UUID) ([]*ent.Job, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	tx, err := w.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	query := tx.Job.Query().
+		Where(
+			job.KindIn(w.kinds()...),
+			job.Or(
+				job.And(job.StatusEQ(job.StatusPending), job.RunAtLTE(now.UnixMilli())),
+				job.And(job.StatusEQ(job.StatusRunning), job.LockedUntilLT(now.UnixMilli())),
+			),
+		)
+	if only != nil {
+		query.Where(job.IDIn(only...))
+	}
+	claimed, err := query.
+		Order(job.ByRunAt()).
+		Limit(n).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	leased := make([]*ent.Job, 0, len(claimed))
+	for _, j := range claimed {
+		// A job whose worker keeps dying with it would be claimed forever.
+		if j.Status == job.StatusRunning && j.Attempts >= j.MaxAttempts {
+			err := j.Update().
+				SetStatus(job.StatusDead).
+				SetLastError(fmt.Sprintf("lease of %s expired on the last attempt", j.LockedBy)).
+				SetLockedBy("").
+				SetLockedUntil(0).
+				SetFinishedAt(now.UnixMilli()).
+				Exec(ctx)
+			if err != nil {
+				return nil, rollback(tx, err)
+			}
+			metrics.JobsProcessed.WithLabelValues(j.Kind.String(), job.StatusDead.String()).Inc()
+			continue
+		}
+
+		j, err = j.Update().
+			SetStatus(job.StatusRunning).
+			AddAttempts(1).
+			SetLockedBy(w.id).
+			SetLockedUntil(now.Add(w.opts.Lease).UnixMilli()).
+			Save(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		leased = append(leased, j)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	// Leased jobs are used outside the transaction.
+	for _, j := range leased {
+		j.Unwrap()
+	}
+	return leased, nil
+}
+
+func (w *Worker) execute(ctx context.Context, j *ent.Job) {
+	logger := slog.With("worker", w.id, "job_id", j.ID, "kind", j.Kind, "attempt", j.Attempts)
+	logger.Info("Running job")
+
+	runCtx, cancel := context.WithTimeout(ctx, w.opts.Lease)
+	started := time.Now()
+	err := w.handlers[j.Kind](runCtx, j.Payload)
+	cancel()
+	metrics.JobDuration.WithLabelValues(j.Kind.String()).Observe(time.Since(started).Seconds())
+
+	// The update only applies while the lease is ours, a cancelled job or one
+	// taken over after the lease expired keeps the state set by others.
+	update := w.client.Job.Update().
+		Where(
+			job.ID(j.ID),
+			job.StatusEQ(job.StatusRunning),
+			job.LockedBy(w.id),
+		).
+		SetLockedBy("").
+		SetLockedUntil(0)
+
+	stopping := ctx.Err() != nil
+	status := nextStatus(err, stopping, j.Attempts, j.MaxAttempts)
+	switch {
+	case status == job.StatusSucceeded:
+		update.SetStatus(status).SetLastError("").SetFinishedAt(time.Now().UnixMilli())
+	case status == job.StatusDead:
+		update.SetStatus(status).SetLastError(truncate(err.Error())).SetFinishedAt(time.Now().UnixMilli())
+	case stopping:
+		// The worker is shutting down, hand the attempt back untouched.
+		update.SetStatus(status).AddAttempts(-1)
+	default:
+		update.SetStatus(status).
+			SetLastError(truncate(err.Error())).
+			SetRunAt(time.Now().Add(backoff(j.Attempts, w.opts.BaseBackoff, w.opts.MaxBackoff)).UnixMilli())
+	}
+
+	// Record the outcome even when shutting down.
+	n, updateErr := update.Save(context.WithoutCancel(ctx))
+	switch {
+	case updateErr != nil:
+		logger.Error("failed to record job outcome", "error", updateErr)
+	case n == 0:
+		logger.Warn("job was cancelled or taken over while running, discarding its outcome")
+		return
+	}
+
+	outcome := status.String()
+	if err != nil && status == job.StatusPending {
+		outcome = "retry"
+	}
+	metrics.JobsProcessed.WithLabelValues(j.Kind.String(), outcome).Inc()
+
+	if err != nil {
+		logger.Error("job failed", "status", status, "error", err)
+		return
+	}
+	logger.Info("Job succeeded", "duration", time.Since(started))
+}
+
+// nextStatus is the status of a job after an attempt ending with err. An
+// attempt interrupted by the worker stopping is handed back, a failed last
+// attempt is dead-lettered.
+func nextStatus(err error, stopping bool, attempts, maxAttempts int) job.Status {
+	switch {
+	case err == nil:
+		return job.StatusSucceeded
+	case stopping:
+		return job.StatusPending
+	case attempts >= maxAttempts:
+		return job.StatusDead
+	default:
+		return job.StatusPending
+	}
+}
+
+// backoff doubles base with every attempt up to maxBackoff, with jitter over
+// its upper half so failed jobs do not retry in lockstep.
+func backoff(attempts int, base, maxBackoff time.Duration) time.Duration {
+	d := base
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, maxBackoff)
+	return d/2 + rand.N(d/2+1)
+}
+
+// settled reports whether none of ids can be run now.
+func (w *Worker) settled(ctx context.Context, ids []uuid.UUID) (bool, error) {
+	now := time.Now().UnixMilli()
+	runnable, err := w.client.Job.Query().
+		Where(
+			job.IDIn(ids...),
+			job.Or(
+				job.And(job.StatusEQ(job.StatusPending), job.RunAtLTE(now)),
+				job.StatusEQ(job.StatusRunning),
+			),
+		).
+		Exist(ctx)
+	return !runnable, err
+}
+
+// updateDepth refreshes the queue depth gauge by status.
+func (w *Worker) updateDepth(ctx context.Context) {
+	var counts []struct {
+		Status job.Status `json:"status"`
+		Count  int        `json:"count"`
+	}
+	err := w.client.Job.Query().
+		Where(job.StatusIn(job.StatusPending, job.StatusRunning, job.StatusDead)).
+		GroupBy(job.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return
+	}
+
+	metrics.JobQueueDepth.Reset()
+	for _, c := range counts {
+		metrics.JobQueueDepth.WithLabelValues(c.Status.String()).Set(float64(c.Count))
+	}
+}
+
+func (w *Worker) kinds() []job.Kind {
+	kinds := make([]job.Kind, 0, len(w.handlers))
+	for kind := range w.handlers {
+		kinds = append(kinds, kind)
+	}
+	return kinds
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return errors.Join(err, rerr)
+	}
+	return err
+}
+
+// truncate cuts s to maxErrorLength bytes on a rune boundary, Postgres
+// refuses text ending in half a rune.
+func truncate(s string) string {
+	if len(s) <= maxErrorLength {
+		return s
+	}
+	end := maxErrorLength
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	return s[:end]
+}
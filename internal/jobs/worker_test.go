@@ -0,0 +1,81 @@
+package jobs_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/luoling8192/mindwave/ent/job"
+	"github.com/luoling8192/mindwave/internal/jobs"
+)
+
+func TestBackoff(t *testing.T) {
+	base, maxBackoff := 10*time.Second, 60*time.Second
+	tests := []struct {
+		attempts int
+		// want is the backoff before jitter, the result lies in its upper
+		// half.
+		want time.Duration
+	}{
+		{attempts: 0, want: 10 * time.Second},
+		{attempts: 1, want: 10 * time.Second},
+		{attempts: 2, want: 20 * time.Second},
+		{attempts: 3, want: 40 * time.Second},
+		{attempts: 4, want: 60 * time.Second},
+		{attempts: 50, want: 60 * time.Second},
+	}
+	for _, tt := range tests {
+		seen := make(map[time.Duration]bool)
+		for range 100 {
+			d := jobs.Backoff(tt.attempts, base, maxBackoff)
+			if d < tt.want/2 || d > tt.want {
+				t.Fatalf("Backoff(%d) = %s, want within [%s, %s]", tt.attempts, d, tt.want/2, tt.want)
+			}
+			seen[d] = true
+		}
+		if len(seen) < 2 {
+			t.Errorf("Backoff(%d) has no jitter", tt.attempts)
+		}
+	}
+}
+
+func TestNextStatus(t *testing.T) {
+	failed := errors.New("failed")
+	tests := []struct {
+		name     string
+		err      error
+		stopping bool
+		attempts int
+		want     job.Status
+	}{
+		{name: "succeeded", attempts: 5, want: job.StatusSucceeded},
+		{name: "succeeded while stopping", stopping: true, attempts: 1, want: job.StatusSucceeded},
+		{name: "retried", err: failed, attempts: 4, want: job.StatusPending},
+		{name: "dead after the last attempt", err: failed, attempts: 5, want: job.StatusDead},
+		{name: "handed back while stopping", err: failed, stopping: true, attempts: 5, want: job.StatusPending},
+	}
+	for _, tt := range tests {
+		if got := jobs.NextStatus(tt.err, tt.stopping, tt.attempts, 5); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	short := "short error"
+	if got := jobs.Truncate(short); got != short {
+		t.Errorf("Truncate(%q) = %q", short, got)
+	}
+
+	// Three-byte runes never end exactly on the limit.
+	long := strings.Repeat("错", jobs.MaxErrorLength)
+	got := jobs.Truncate(long)
+	if len(got) > jobs.MaxErrorLength || len(got) < jobs.MaxErrorLength-utf8.UTFMax {
+		t.Errorf("Truncate kept %d bytes, want at most %d", len(got), jobs.MaxErrorLength)
+	}
+	if !utf8.ValidString(got) {
+		t.Error("Truncate cut a rune in half")
+	}
+}
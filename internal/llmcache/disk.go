@@ -0,0 +1,182 @@
+package llmcache
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/luoling8192/mindwave/internal/agent"
+)
+
+// Disk stores completions as files under a directory, one per key, for runs
+// without a shared database and for fixtures checked into tests.
+type Disk struct {
+	dir string
+}
+
+func NewDisk(dir string) (*Disk, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Disk{dir: dir}, nil
+}
+
+// diskEntry is the file format of an entry.
+type diskEntry struct {
+	Stage         string          `json:"stage"`
+	Model         string          `json:"model"`
+	PromptVersion string          `json:"prompt_version"`
+	InputHash     string          `json:"input_hash"`
+	ExpiresAt     int64           `json:"expires_at"`
+	Value         json.RawMessage `json:"value"`
+}
+
+func (d *Disk) Get(_ context.Context, key string) ([]byte, bool, error) {
+	data, err := os.ReadFile(d.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	var entry diskEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false, err
+	}
+	if entry.ExpiresAt <= time.Now().UnixMilli() {
+		return nil, false, nil
+	}
+	return entry.Value, true, nil
+}
+
+func (d *Disk) Put(_ context.Context, entry agent.CacheEntry) error {
+	// Value is compact JSON already, Marshal keeps it byte for byte.
+	data, err := json.Marshal(diskEntry{
+		Stage:         entry.Stage,
+		Model:         entry.Model,
+		PromptVersion: entry.PromptVersion,
+		InputHash:     entry.InputHash,
+		ExpiresAt:     entry.ExpiresAt.UnixMilli(),
+		Value:         entry.Value,
+	})
+	if err != nil {
+		return err
+	}
+
+	path := d.path(entry.Key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// Write through a temporary file so concurrent readers never see a
+	// partial entry.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Prune deletes expired entries and returns how many were deleted.
+func (d *Disk) Prune(ctx context.Context) (int, error) {
+	now := time.Now().UnixMilli()
+	pruned := 0
+	err := filepath.WalkDir(d.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || filepath.Ext(path) != ".json" {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var e diskEntry
+		if err := json.Unmarshal(data, &e); err != nil || e.ExpiresAt > now {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		pruned++
+		return nil
+	})
+	return pruned, err
+}
+
+// DeleteMentioning deletes the entries whose completion contains any of texts
+// and returns how many were deleted.
+func (d *Disk) DeleteMentioning(ctx context.Context, texts []string) (int, error) {
+	needles := encoded(texts)
+	if len(needles) == 0 {
+		return 0, nil
+	}
+
+	deleted := 0
+	err := filepath.WalkDir(d.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || filepath.Ext(path) != ".json" {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var e diskEntry
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil
+		}
+		if !slices.ContainsFunc(needles, func(needle []byte) bool { return bytes.Contains(e.Value, needle) }) {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		deleted++
+		return nil
+	})
+	return deleted, err
+}
+
+// encoded returns texts the way they appear inside the JSON of a
+// completion, without the quotes.
+func encoded(texts []string) [][]byte {
+	needles := make([][]byte, 0, len(texts))
+	for _, text := range texts {
+		if text == "" {
+			continue
+		}
+		data, err := json.Marshal(text)
+		if err != nil {
+			continue
+		}
+		needles = append(needles, data[1:len(data)-1])
+	}
+	return needles
+}
+
+// path spreads entries over subdirectories by the first byte of their key.
+func (d *Disk) path(key string) string {
+	return filepath.Join(d.dir, key[:2], key+".json")
+}
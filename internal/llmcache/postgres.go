@@ -0,0 +1,81 @@
+// Package llmcache stores LLM completions for replay, in Postgres or in a
+// directory on local disk.
+package llmcache
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/llmcacheentry"
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/samber/lo"
+)
+
+// Postgres stores completions in the llm_cache_entries table, shared by every
+// process using the database.
+type Postgres struct {
+	client *datastore.Client
+}
+
+func NewPostgres(client *datastore.Client) *Postgres {
+	return &Postgres{client: client}
+}
+
+func (p *Postgres) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	entry, err := p.client.LLMCacheEntry.Query().
+		Where(
+			llmcacheentry.Key(key),
+			llmcacheentry.ExpiresAtGT(time.Now().UnixMilli()),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return entry.Value, true, nil
+}
+
+func (p *Postgres) Put(ctx context.Context, entry agent.CacheEntry) error {
+	return p.client.LLMCacheEntry.Create().
+		SetKey(entry.Key).
+		SetStage(entry.Stage).
+		SetModel(entry.Model).
+		SetPromptVersion(entry.PromptVersion).
+		SetInputHash(entry.InputHash).
+		SetValue(entry.Value).
+		SetExpiresAt(entry.ExpiresAt.UnixMilli()).
+		OnConflictColumns(llmcacheentry.FieldKey).
+		UpdateNewValues().
+		Exec(ctx)
+}
+
+// Prune deletes expired entries and returns how many were deleted.
+func (p *Postgres) Prune(ctx context.Context) (int, error) {
+	return p.client.LLMCacheEntry.Delete().
+		Where(llmcacheentry.ExpiresAtLTE(time.Now().UnixMilli())).
+		Exec(ctx)
+}
+
+// DeleteMentioning deletes the entries whose completion contains any of texts
+// and returns how many were deleted.
+func (p *Postgres) DeleteMentioning(ctx context.Context, texts []string) (int, error) {
+	needles := encoded(texts)
+	if len(needles) == 0 {
+		return 0, nil
+	}
+
+	return p.client.LLMCacheEntry.Delete().
+		Where(func(s *sql.Selector) {
+			s.Where(sql.Or(lo.Map(needles, func(needle []byte, _ int) *sql.Predicate {
+				return sql.P(func(b *sql.Builder) {
+					b.WriteString("position(").Arg(needle).WriteString(" IN ").WriteString(s.C(llmcacheentry.FieldValue)).WriteString(") > 0")
+				})
+			})...))
+		}).
+		Exec(ctx)
+}
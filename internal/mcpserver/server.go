@@ -0,0 +1,200 @@
+package mcpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/httpserver"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/luoling8192/mindwave/internal/services/experts"
+	"github.com/luoling8192/mindwave/internal/services/search"
+	mcpauth "github.com/modelcontextprotocol/go-sdk/auth"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	serverName    = "mindwave"
+	serverVersion = "v1"
+
+	defaultResults = 10
+	maxResults     = 50
+	maxContextSize = 3
+
+	// maxContentRunes and maxSummaryRunes cap text per item so a single tool
+	// call stays well within a model's context window.
+	maxContentRunes = 500
+	maxSummaryRunes = 2000
+	maxEvidenceIDs  = 20
+	maxTopics       = 20
+
+	// principalKey holds the principal of a bearer token in its TokenInfo.
+	principalKey = "principal"
+)
+
+// Options configures the HTTP transport.
+type Options struct {
+	// Auth requires an admin bearer token, an API key or a JWT, of HTTP
+	// clients when set. The tools read every chat, so other roles are
+	// refused.
+	Auth *auth.Authenticator
+}
+
+// Server exposes the distilled chat history to MCP clients as read-only tools.
+type Server struct {
+	client   *datastore.Client
+	searcher *search.Searcher
+	finder   *experts.Finder
+	server   *mcp.Server
+	opts     Options
+}
+
+// NewServer builds the MCP server. searcher may be nil, in which case
+// search_messages reports an error.
+func NewServer(client *datastore.Client, searcher *search.Searcher, finder *experts.Finder, opts Options) *Server {
+	s := &Server{
+		client:   client,
+		searcher: searcher,
+		finder:   finder,
+		server:   mcp.NewServer(&mcp.Implementation{Name: serverName, Version: serverVersion}, nil),
+		opts:     opts,
+	}
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "search_messages",
+		Description: "Search raw chat messages by meaning and keywords. Returns matching messages with their surrounding context.",
+		InputSchema: inputSchema[SearchMessagesInput](func(props map[string]*jsonschema.Schema) {
+			props["query"].MinLength = jsonschema.Ptr(1)
+			props["mode"].Enum = []any{string(search.ModeHybrid), string(search.ModeSemantic), string(search.ModeKeyword)}
+			props["context"].Minimum, props["context"].Maximum = jsonschema.Ptr(0.0), jsonschema.Ptr(float64(maxContextSize))
+		}),
+		Annotations: readOnly(),
+	}, s.searchMessages)
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "list_events",
+		Description: "List events distilled from chats, newest first.",
+		InputSchema: inputSchema[ListEventsInput](nil),
+		Annotations: readOnly(),
+	}, s.listEvents)
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "who_knows_about",
+		Description: "Rank the people who know most about a topic, by how often, how recently and how centrally they took part in events about it.",
+		InputSchema: inputSchema[WhoKnowsAboutInput](func(props map[string]*jsonschema.Schema) {
+			props["topic"].MinLength = jsonschema.Ptr(1)
+		}),
+		Annotations: readOnly(),
+	}, s.whoKnowsAbout)
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "person_profile",
+		Description: "Look up a person by name and summarize their identities, topics, chats and recent events.",
+		InputSchema: inputSchema[PersonProfileInput](func(props map[string]*jsonschema.Schema) {
+			props["name"].MinLength = jsonschema.Ptr(1)
+		}),
+		Annotations: readOnly(),
+	}, s.personProfile)
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "chat_digest",
+		Description: "Summarize one day of a chat: summaries, events, topics and most active participants.",
+		InputSchema: inputSchema[ChatDigestInput](func(props map[string]*jsonschema.Schema) {
+			props["chat"].MinLength = jsonschema.Ptr(1)
+			props["date"].Pattern = `^\d{4}-\d{2}-\d{2}$`
+		}),
+		Annotations: readOnly(),
+	}, s.chatDigest)
+
+	return s
+}
+
+// inputSchema infers the schema of a tool input, which rejects unknown
+// properties, bounds the limit field and lets constrain tighten the rest.
+func inputSchema[T any](constrain func(props map[string]*jsonschema.Schema)) *jsonschema.Schema {
+	schema, err := jsonschema.For[T](nil)
+	if err != nil {
+		panic(err)
+	}
+
+	if limit, ok := schema.Properties["limit"]; ok {
+		limit.Minimum, limit.Maximum = jsonschema.Ptr(1.0), jsonschema.Ptr(float64(maxResults))
+	}
+	if constrain != nil {
+		constrain(schema.Properties)
+	}
+
+	return schema
+}
+
+func readOnly() *mcp.ToolAnnotations {
+	return &mcp.ToolAnnotations{ReadOnlyHint: true, IdempotentHint: true}
+}
+
+// ServeStdio serves a single client over stdin and stdout until it disconnects
+// or ctx is done.
+func (s *Server) ServeStdio(ctx context.Context) error {
+	slog.Info("Starting MCP server", "transport", "stdio")
+	return s.server.Run(ctx, &mcp.StdioTransport{})
+}
+
+func (s *Server) Handler() http.Handler {
+	handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server {
+		return s.server
+	}, nil)
+	if s.opts.Auth == nil {
+		return handler
+	}
+	return mcpauth.RequireBearerToken(s.verifyToken, &mcpauth.RequireBearerTokenOptions{
+		Scopes: []string{string(auth.RoleAdmin)},
+		// API keys do not expire, they are revoked.
+		AllowMissingExpiration: true,
+	})(handler)
+}
+
+// verifyToken maps the principal of a bearer token to the token info of the
+// SDK, with the role as its only scope.
+func (s *Server) verifyToken(ctx context.Context, token string, _ *http.Request) (*mcpauth.TokenInfo, error) {
+	p, err := s.opts.Auth.Verify(ctx, token)
+	if errors.Is(err, auth.ErrInvalidCredentials) {
+		metrics.AuthFailures.WithLabelValues("mcp").Inc()
+		return nil, fmt.Errorf("%w: %w", mcpauth.ErrInvalidToken, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &mcpauth.TokenInfo{
+		Scopes:     []string{string(p.Role)},
+		Expiration: p.ExpiresAt,
+		UserID:     p.Subject,
+		Extra:      map[string]any{principalKey: p},
+	}, nil
+}
+
+// principalOf returns the principal of the bearer token of a tool call, nil
+// over stdio and without authentication.
+func principalOf(req *mcp.CallToolRequest) *auth.Principal {
+	if req == nil || req.Extra == nil || req.Extra.TokenInfo == nil {
+		return nil
+	}
+	p, _ := req.Extra.TokenInfo.Extra[principalKey].(*auth.Principal)
+	return p
+}
+
+// ListenAndServe serves the streamable HTTP transport until ctx is done, then
+// shuts down gracefully.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	return httpserver.ListenAndServe(ctx, addr, s.Handler(), "MCP server", "transport", "http")
+}
+
+func limitOrDefault(limit int) int {
+	if limit <= 0 {
+		return defaultResults
+	}
+	return min(limit, maxResults)
+}
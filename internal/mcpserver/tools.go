@@ -0,0 +1,314 @@
+package mcpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/ent/summary"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/names"
+	"github.com/luoling8192/mindwave/internal/services/access"
+	"github.com/luoling8192/mindwave/internal/services/experts"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/luoling8192/mindwave/internal/services/search"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/samber/lo"
+)
+
+// maxProfileEvents bounds how many events are scanned for a person's topics and chats.
+const maxProfileEvents = 500
+
+func (s *Server) searchMessages(ctx context.Context, req *mcp.CallToolRequest, in SearchMessagesInput) (*mcp.CallToolResult, SearchMessagesOutput, error) {
+	if s.searcher == nil {
+		return nil, SearchMessagesOutput{}, errors.New("search is not configured")
+	}
+
+	mode, err := search.ParseMode(in.Mode)
+	if err != nil {
+		return nil, SearchMessagesOutput{}, err
+	}
+
+	filter := search.Filter{ChatID: in.ChatID, SenderName: in.SenderName}
+	if filter.Since, err = parseTime(in.Since); err != nil {
+		return nil, SearchMessagesOutput{}, err
+	}
+	if filter.Until, err = parseTime(in.Until); err != nil {
+		return nil, SearchMessagesOutput{}, err
+	}
+
+	hits, err := s.searcher.Search(ctx, search.Options{
+		Query:       in.Query,
+		Mode:        mode,
+		Filter:      filter,
+		Limit:       limitOrDefault(in.Limit),
+		ContextSize: min(max(in.Context, 0), maxContextSize),
+	})
+	if err != nil {
+		return nil, SearchMessagesOutput{}, err
+	}
+
+	var read []*ent.ChatMessage
+	for _, h := range hits {
+		read = append(append(append(read, h.Message), h.Before...), h.After...)
+	}
+	if p := principalOf(req); p != nil {
+		ctx = auth.WithPrincipal(ctx, p)
+	}
+	if err := access.Record(ctx, s.client, access.Read{Endpoint: "search_messages", Query: in.Query, Messages: read}); err != nil {
+		return nil, SearchMessagesOutput{}, fmt.Errorf("failed to record access: %w", err)
+	}
+
+	return nil, SearchMessagesOutput{Hits: lo.Map(hits, func(h search.Hit, _ int) SearchHit {
+		return SearchHit{
+			Message: newMessage(h.Message),
+			Score:   h.Score,
+			Before:  newMessages(h.Before),
+			After:   newMessages(h.After),
+		}
+	})}, nil
+}
+
+func (s *Server) listEvents(ctx context.Context, _ *mcp.CallToolRequest, in ListEventsInput) (*mcp.CallToolResult, ListEventsOutput, error) {
+	query := s.client.Event.Query()
+	if in.ChatID != "" {
+		query = query.Where(event.InChatID(in.ChatID))
+	}
+	if in.Tag != "" {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(event.FieldTags, in.Tag))
+		})
+	}
+
+	since, err := parseTime(in.Since)
+	if err != nil {
+		return nil, ListEventsOutput{}, err
+	}
+	if !since.IsZero() {
+		query = query.Where(event.PlatformTimestampGTE(since.Unix()))
+	}
+	until, err := parseTime(in.Until)
+	if err != nil {
+		return nil, ListEventsOutput{}, err
+	}
+	if !until.IsZero() {
+		query = query.Where(event.PlatformTimestampLTE(until.Unix()))
+	}
+
+	events, err := query.
+		Order(event.ByPlatformTimestamp(sql.OrderDesc()), event.ByID(sql.OrderDesc())).
+		Limit(limitOrDefault(in.Limit)).
+		All(ctx)
+	if err != nil {
+		return nil, ListEventsOutput{}, err
+	}
+
+	return nil, ListEventsOutput{Events: newEvents(events)}, nil
+}
+
+func (s *Server) whoKnowsAbout(ctx context.Context, _ *mcp.CallToolRequest, in WhoKnowsAboutInput) (*mcp.CallToolResult, WhoKnowsAboutOutput, error) {
+	found, err := s.finder.Find(ctx, experts.Options{
+		Topic: in.Topic,
+		Limit: limitOrDefault(in.Limit),
+	})
+	if err != nil {
+		return nil, WhoKnowsAboutOutput{}, err
+	}
+
+	return nil, WhoKnowsAboutOutput{Topic: in.Topic, People: lo.Map(found, func(e experts.Expert, _ int) Expert {
+		return newExpert(e)
+	})}, nil
+}
+
+func (s *Server) personProfile(ctx context.Context, _ *mcp.CallToolRequest, in PersonProfileInput) (*mcp.CallToolResult, PersonProfileOutput, error) {
+	identities, err := s.client.Identity.Query().
+		Where(identity.PersonIDNotNil()).
+		All(ctx)
+	if err != nil {
+		return nil, PersonProfileOutput{}, err
+	}
+
+	candidates := lo.Map(identities, func(i *ent.Identity, _ int) names.Candidate {
+		return names.Candidate{
+			ID:        i.PersonID.String(),
+			Names:     []string{i.DisplayName},
+			Usernames: lo.Compact([]string{i.Username}),
+			AltIDs:    i.AltIds,
+		}
+	})
+	personIDString, method, ok := names.NewMatcher(candidates, names.DefaultMatchThreshold).Match(in.Name)
+	if !ok {
+		return nil, PersonProfileOutput{}, fmt.Errorf("no person matches %q", in.Name)
+	}
+	personID := uuid.MustParse(personIDString)
+
+	p, err := s.client.Person.Get(ctx, personID)
+	if err != nil {
+		return nil, PersonProfileOutput{}, err
+	}
+
+	members := lo.Filter(identities, func(i *ent.Identity, _ int) bool {
+		return *i.PersonID == personID
+	})
+
+	eventQuery := s.client.Event.Query().
+		Where(event.HasIdentitiesWith(identity.PersonID(personID)))
+	eventCount, err := eventQuery.Clone().Count(ctx)
+	if err != nil {
+		return nil, PersonProfileOutput{}, err
+	}
+	events, err := eventQuery.
+		Order(event.ByPlatformTimestamp(sql.OrderDesc()), event.ByID(sql.OrderDesc())).
+		Limit(maxProfileEvents).
+		All(ctx)
+	if err != nil {
+		return nil, PersonProfileOutput{}, err
+	}
+
+	chatCounts := lo.CountValuesBy(events, func(e *ent.Event) string { return e.InChatID })
+	chats := lo.MapToSlice(chatCounts, func(chatID string, count int) ChatCount {
+		return ChatCount{ChatID: chatID, Count: count}
+	})
+	sort.Slice(chats, func(i, j int) bool {
+		if chats[i].Count != chats[j].Count {
+			return chats[i].Count > chats[j].Count
+		}
+		return chats[i].ChatID < chats[j].ChatID
+	})
+
+	return nil, PersonProfileOutput{
+		PersonID:     p.ID.String(),
+		DisplayName:  p.DisplayName,
+		MatchedBy:    string(method),
+		Identities:   lo.Map(members, func(i *ent.Identity, _ int) Identity { return newIdentity(i) }),
+		EventCount:   eventCount,
+		Topics:       topTopics(events),
+		Chats:        lo.Slice(chats, 0, maxTopics),
+		RecentEvents: newEvents(lo.Slice(events, 0, limitOrDefault(in.Limit))),
+	}, nil
+}
+
+func (s *Server) chatDigest(ctx context.Context, _ *mcp.CallToolRequest, in ChatDigestInput) (*mcp.CallToolResult, ChatDigestOutput, error) {
+	day, err := time.ParseInLocation(time.DateOnly, in.Date, time.Local)
+	if err != nil {
+		return nil, ChatDigestOutput{}, fmt.Errorf("date must be YYYY-MM-DD: %w", err)
+	}
+	start, end := day.Unix(), day.AddDate(0, 0, 1).Unix()
+
+	chatID, chatName := in.Chat, ""
+	chat, err := s.client.JoinedChat.Query().
+		Where(joinedchat.Or(joinedchat.ChatID(in.Chat), joinedchat.ChatNameEqualFold(in.Chat))).
+		Order(joinedchat.ByDialogDate(sql.OrderDesc())).
+		First(ctx)
+	switch {
+	case err == nil:
+		chatID, chatName = chat.ChatID, chat.ChatName
+	case !ent.IsNotFound(err):
+		return nil, ChatDigestOutput{}, err
+	}
+
+	summaries, err := s.client.Summary.Query().
+		Where(
+			summary.InChatID(chatID),
+			summary.SpanStartLT(end),
+			summary.SpanEndGTE(start),
+		).
+		Order(summary.BySpanStart()).
+		Limit(maxResults).
+		All(ctx)
+	if err != nil {
+		return nil, ChatDigestOutput{}, err
+	}
+
+	events, err := s.client.Event.Query().
+		Where(
+			event.InChatID(chatID),
+			event.PlatformTimestampGTE(start),
+			event.PlatformTimestampLT(end),
+		).
+		Order(event.ByPlatformTimestamp()).
+		Limit(maxResults).
+		All(ctx)
+	if err != nil {
+		return nil, ChatDigestOutput{}, err
+	}
+
+	var senders []SenderCount
+	err = s.client.ChatMessage.Query().
+		Where(
+			chatmessage.InChatID(chatID),
+			chatmessage.ContentNEQ(""),
+			chatmessage.DeletedAt(0),
+			owners.Canonical(),
+			chatmessage.PlatformTimestampGTE(start),
+			chatmessage.PlatformTimestampLT(end),
+		).
+		GroupBy(chatmessage.FieldFromName).
+		Aggregate(ent.Count()).
+		Scan(ctx, &senders)
+	if err != nil {
+		return nil, ChatDigestOutput{}, err
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return senders[i].Count > senders[j].Count
+	})
+
+	return nil, ChatDigestOutput{
+		ChatID:       chatID,
+		ChatName:     chatName,
+		Date:         in.Date,
+		MessageCount: lo.SumBy(senders, func(s SenderCount) int { return s.Count }),
+		Summaries:    lo.Map(summaries, func(s *ent.Summary, _ int) Summary { return newSummary(s) }),
+		Events:       newEvents(events),
+		Topics:       topTopics(events),
+		Participants: nonNil(lo.Slice(senders, 0, maxTopics)),
+	}, nil
+}
+
+// topTopics counts the tags of events, most frequent first.
+func topTopics(events []*ent.Event) []TopicCount {
+	counts := make(map[string]int)
+	for _, e := range events {
+		for _, tag := range e.Tags {
+			counts[tag]++
+		}
+	}
+
+	topics := lo.MapToSlice(counts, func(topic string, count int) TopicCount {
+		return TopicCount{Topic: topic, Count: count}
+	})
+	sort.Slice(topics, func(i, j int) bool {
+		if topics[i].Count != topics[j].Count {
+			return topics[i].Count > topics[j].Count
+		}
+		return topics[i].Topic < topics[j].Topic
+	})
+
+	return lo.Slice(topics, 0, maxTopics)
+}
+
+// parseTime accepts YYYY-MM-DD in the server time zone or RFC 3339, and
+// returns the zero time for an empty value.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected YYYY-MM-DD or RFC 3339", value)
+	}
+	return t, nil
+}
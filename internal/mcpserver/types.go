@@ -0,0 +1,235 @@
+package mcpserver
+
+import (
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/internal/services/experts"
+	"github.com/samber/lo"
+)
+
+type SearchMessagesInput struct {
+	Query      string `json:"query" jsonschema:"text to search for"`
+	Mode       string `json:"mode,omitempty" jsonschema:"hybrid (default), semantic or keyword"`
+	ChatID     string `json:"chat_id,omitempty" jsonschema:"only search this chat"`
+	SenderName string `json:"sender_name,omitempty" jsonschema:"only search messages from the sender with exactly this display name"`
+	Since      string `json:"since,omitempty" jsonschema:"earliest message time, as YYYY-MM-DD or RFC 3339"`
+	Until      string `json:"until,omitempty" jsonschema:"latest message time, as YYYY-MM-DD or RFC 3339"`
+	Limit      int    `json:"limit,omitempty" jsonschema:"maximum number of hits"`
+	Context    int    `json:"context,omitempty" jsonschema:"number of surrounding messages to include on each side of a hit"`
+}
+
+type SearchMessagesOutput struct {
+	Hits []SearchHit `json:"hits"`
+}
+
+type ListEventsInput struct {
+	ChatID string `json:"chat_id,omitempty" jsonschema:"only list events of this chat"`
+	Tag    string `json:"tag,omitempty" jsonschema:"only list events with this tag"`
+	Since  string `json:"since,omitempty" jsonschema:"earliest event time, as YYYY-MM-DD or RFC 3339"`
+	Until  string `json:"until,omitempty" jsonschema:"latest event time, as YYYY-MM-DD or RFC 3339"`
+	Limit  int    `json:"limit,omitempty" jsonschema:"maximum number of events"`
+}
+
+type ListEventsOutput struct {
+	Events []Event `json:"events"`
+}
+
+type WhoKnowsAboutInput struct {
+	Topic string `json:"topic" jsonschema:"topic or tag to look for"`
+	Limit int    `json:"limit,omitempty" jsonschema:"maximum number of people"`
+}
+
+type WhoKnowsAboutOutput struct {
+	Topic  string   `json:"topic"`
+	People []Expert `json:"people"`
+}
+
+type PersonProfileInput struct {
+	Name  string `json:"name" jsonschema:"display name, username or previous name of the person"`
+	Limit int    `json:"limit,omitempty" jsonschema:"maximum number of recent events"`
+}
+
+type PersonProfileOutput struct {
+	PersonID     string       `json:"person_id"`
+	DisplayName  string       `json:"display_name"`
+	MatchedBy    string       `json:"matched_by"`
+	Identities   []Identity   `json:"identities"`
+	EventCount   int          `json:"event_count"`
+	Topics       []TopicCount `json:"topics"`
+	Chats        []ChatCount  `json:"chats"`
+	RecentEvents []Event      `json:"recent_events"`
+}
+
+type ChatDigestInput struct {
+	Chat string `json:"chat" jsonschema:"chat id or chat name"`
+	Date string `json:"date" jsonschema:"day to digest, as YYYY-MM-DD in the server time zone"`
+}
+
+type ChatDigestOutput struct {
+	ChatID       string        `json:"chat_id"`
+	ChatName     string        `json:"chat_name"`
+	Date         string        `json:"date"`
+	MessageCount int           `json:"message_count"`
+	Summaries    []Summary     `json:"summaries"`
+	Events       []Event       `json:"events"`
+	Topics       []TopicCount  `json:"topics"`
+	Participants []SenderCount `json:"participants"`
+}
+
+type Message struct {
+	ID                string `json:"id"`
+	InChatID          string `json:"in_chat_id"`
+	FromID            string `json:"from_id"`
+	FromName          string `json:"from_name"`
+	Content           string `json:"content"`
+	PlatformTimestamp int64  `json:"platform_timestamp"`
+}
+
+type SearchHit struct {
+	Message Message   `json:"message"`
+	Score   float64   `json:"score"`
+	Before  []Message `json:"before"`
+	After   []Message `json:"after"`
+}
+
+type Event struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description"`
+	Tags               []string `json:"tags"`
+	FromName           string   `json:"from_name"`
+	InChatID           string   `json:"in_chat_id"`
+	PlatformTimestamp  int64    `json:"platform_timestamp"`
+	EvidenceMessageIDs []string `json:"evidence_message_ids"`
+}
+
+type Identity struct {
+	ID             string   `json:"id"`
+	Platform       string   `json:"platform"`
+	PlatformUserID string   `json:"platform_user_id"`
+	Username       string   `json:"username"`
+	DisplayName    string   `json:"display_name"`
+	AltIDs         []string `json:"alt_ids"`
+}
+
+type Summary struct {
+	ID           string `json:"id"`
+	Content      string `json:"content"`
+	MessageCount int    `json:"message_count"`
+	SpanStart    int64  `json:"span_start"`
+	SpanEnd      int64  `json:"span_end"`
+}
+
+type Expert struct {
+	PersonID    string  `json:"person_id"`
+	DisplayName string  `json:"display_name"`
+	Score       float64 `json:"score"`
+	EventCount  int     `json:"event_count"`
+	LastSeen    int64   `json:"last_seen"`
+	Evidence    []Event `json:"evidence"`
+}
+
+type TopicCount struct {
+	Topic string `json:"topic"`
+	Count int    `json:"count"`
+}
+
+type ChatCount struct {
+	ChatID string `json:"chat_id"`
+	Count  int    `json:"count"`
+}
+
+type SenderCount struct {
+	FromName string `json:"from_name"`
+	Count    int    `json:"count"`
+}
+
+func newMessage(m *ent.ChatMessage) Message {
+	return Message{
+		ID:                m.ID.String(),
+		InChatID:          m.InChatID,
+		FromID:            m.FromID,
+		FromName:          m.FromName,
+		Content:           truncateRunes(m.Content, maxContentRunes),
+		PlatformTimestamp: m.PlatformTimestamp,
+	}
+}
+
+func newMessages(messages []*ent.ChatMessage) []Message {
+	return lo.Map(messages, func(m *ent.ChatMessage, _ int) Message { return newMessage(m) })
+}
+
+// newEvent caps evidence IDs as well as text, busy days link hundreds of messages.
+func newEvent(e *ent.Event) Event {
+	evidence := make([]string, 0, min(len(e.EvidenceMessageIds), maxEvidenceIDs))
+	for _, id := range e.EvidenceMessageIds {
+		if len(evidence) == maxEvidenceIDs {
+			break
+		}
+		evidence = append(evidence, id.String())
+	}
+
+	return Event{
+		ID:                 e.ID.String(),
+		Name:               e.Name,
+		Description:        truncateRunes(e.Description, maxContentRunes),
+		Tags:               nonNil(e.Tags),
+		FromName:           e.FromName,
+		InChatID:           e.InChatID,
+		PlatformTimestamp:  e.PlatformTimestamp,
+		EvidenceMessageIDs: evidence,
+	}
+}
+
+func newEvents(events []*ent.Event) []Event {
+	return lo.Map(events, func(e *ent.Event, _ int) Event { return newEvent(e) })
+}
+
+func newIdentity(i *ent.Identity) Identity {
+	return Identity{
+		ID:             i.ID.String(),
+		Platform:       i.Platform,
+		PlatformUserID: i.PlatformUserID,
+		Username:       i.Username,
+		DisplayName:    i.DisplayName,
+		AltIDs:         nonNil(i.AltIds),
+	}
+}
+
+func newSummary(s *ent.Summary) Summary {
+	return Summary{
+		ID:           s.ID.String(),
+		Content:      truncateRunes(s.Content, maxSummaryRunes),
+		MessageCount: s.MessageCount,
+		SpanStart:    s.SpanStart,
+		SpanEnd:      s.SpanEnd,
+	}
+}
+
+func newExpert(e experts.Expert) Expert {
+	return Expert{
+		PersonID:    e.PersonID.String(),
+		DisplayName: e.DisplayName,
+		Score:       e.Score,
+		EventCount:  e.Signals.Frequency,
+		LastSeen:    e.LastSeen,
+		Evidence: lo.Map(e.Evidence, func(evidence experts.Evidence, _ int) Event {
+			return newEvent(evidence.Event)
+		}),
+	}
+}
+
+func truncateRunes(s string, n int) string {
+	rs := []rune(s)
+	if len(rs) <= n {
+		return s
+	}
+
+	return string(rs[:n]) + "..."
+}
+
+func nonNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
@@ -25,4 +25,278 @@ var (
 		Name:      "items_total",
 		Help:      "Total number of items processed or extracted",
 	}, []string{"type"})
+
+	// ParticipantMatchCount tracks how extracted participant names were
+	// resolved to identities, "unmatched" counts dropped person-event links.
+	ParticipantMatchCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "distill",
+		Name:      "participant_matches_total",
+		Help:      "Total number of extracted participant names by match method",
+	}, []string{"method"})
+
+	// RedactionsCount tracks the sensitive values replaced with placeholders
+	// before chat content is sent to the LLM.
+	RedactionsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "distill",
+		Name:      "redactions_total",
+		Help:      "Total number of values redacted from LLM input by detector",
+	}, []string{"detector"})
+)
+
+var (
+	// SearchDuration tracks the latency of message searches by mode.
+	SearchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "search",
+		Name:      "duration_seconds",
+		Help:      "Duration of message searches in seconds",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"mode", "status"})
+
+	// SearchResultsCount tracks how many hits a search returns.
+	SearchResultsCount = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "search",
+		Name:      "results",
+		Help:      "Number of hits returned per search",
+		Buckets:   []float64{0, 1, 5, 10, 20, 50, 100},
+	}, []string{"mode"})
+)
+
+var (
+	// TokenizeDuration tracks the latency of jieba tokenization batches.
+	TokenizeDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "tokenize",
+		Name:      "batch_duration_seconds",
+		Help:      "Duration of jieba tokenization batches in seconds",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"status"})
+
+	// TokenizedMessagesCount tracks how many messages had jieba_tokens filled.
+	TokenizedMessagesCount = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tokenize",
+		Name:      "messages_total",
+		Help:      "Total number of messages tokenized",
+	})
+)
+
+var (
+	// AskDuration tracks the latency of answering questions by outcome,
+	// one of answered, refused or error.
+	AskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ask",
+		Name:      "duration_seconds",
+		Help:      "Duration of answering questions in seconds",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"outcome"})
+
+	// AskEvidenceCount tracks how many messages and events are retrieved per question.
+	AskEvidenceCount = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ask",
+		Name:      "evidence",
+		Help:      "Number of evidence items retrieved per question",
+		Buckets:   []float64{0, 1, 5, 10, 20, 50},
+	}, []string{"type"})
+)
+
+var (
+	// ProfileUpdates counts profile updates by outcome, one of updated,
+	// unchanged, busy or error.
+	ProfileUpdates = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "profile",
+		Name:      "updates_total",
+		Help:      "Total number of profile updates",
+	}, []string{"outcome"})
+)
+
+var (
+	// DigestDeliveries counts digest posts by publisher and outcome, one of
+	// sent, skipped or error.
+	DigestDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "digest",
+		Name:      "deliveries_total",
+		Help:      "Total number of digest deliveries",
+	}, []string{"publisher", "outcome"})
+)
+
+var (
+	// SchedulerLeader is 1 while this replica holds the scheduler lock.
+	SchedulerLeader = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "leader",
+		Help:      "Whether this replica is the scheduler leader",
+	})
+
+	// SchedulerJobs counts distill jobs by status, one of enqueued,
+	// succeeded, failed or skipped.
+	SchedulerJobs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "jobs_total",
+		Help:      "Total number of scheduled distill jobs by status",
+	}, []string{"status"})
+
+	// SchedulerJobsRunning tracks the distill jobs currently running.
+	SchedulerJobsRunning = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "jobs_running",
+		Help:      "Number of scheduled distill jobs currently running",
+	})
+
+	// SchedulerJobDuration tracks the latency of scheduled distill jobs.
+	SchedulerJobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "job_duration_seconds",
+		Help:      "Duration of scheduled distill jobs in seconds",
+		Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800},
+	}, []string{"status"})
+
+	// SchedulerPendingWindows tracks the finished windows of a chat not yet
+	// distilled.
+	SchedulerPendingWindows = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "pending_windows",
+		Help:      "Number of finished windows waiting to be distilled",
+	}, []string{"chat_id"})
+
+	// SchedulerLastWindowEnd is the end of the last distilled window of a
+	// chat, as a Unix timestamp.
+	SchedulerLastWindowEnd = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "last_window_end_seconds",
+		Help:      "End of the last distilled window as a Unix timestamp",
+	}, []string{"chat_id"})
+)
+
+var (
+	// JobsProcessed counts job attempts by kind and outcome, one of
+	// succeeded, retry or dead.
+	JobsProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "jobs",
+		Name:      "processed_total",
+		Help:      "Total number of job attempts by kind and outcome",
+	}, []string{"kind", "outcome"})
+
+	// JobDuration tracks the latency of job attempts.
+	JobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "jobs",
+		Name:      "duration_seconds",
+		Help:      "Duration of job attempts in seconds",
+		Buckets:   []float64{0.1, 1, 5, 15, 30, 60, 120, 300, 600, 1800},
+	}, []string{"kind"})
+
+	// JobQueueDepth tracks the jobs in the queue by status.
+	JobQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "jobs",
+		Name:      "queue_depth",
+		Help:      "Number of pending, running and dead jobs",
+	}, []string{"status"})
+)
+
+var (
+	// LLMQueueWait tracks how long LLM calls wait for the shared limiter
+	// before being sent, by operation, chat or embedding.
+	LLMQueueWait = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "queue_wait_seconds",
+		Help:      "Time LLM calls waited for the rate limiter in seconds",
+		Buckets:   []float64{0.001, 0.01, 0.1, 0.5, 1, 5, 15, 30, 60, 120, 300},
+	}, []string{"operation"})
+
+	// LLMRetries counts retried LLM calls by operation and reason, one of
+	// rate_limited, server_error or network.
+	LLMRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "retries_total",
+		Help:      "Total number of retried LLM calls by operation and reason",
+	}, []string{"operation", "reason"})
+
+	// LLMInFlight tracks the LLM calls waiting for a response.
+	LLMInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "in_flight",
+		Help:      "Number of LLM calls waiting for a response",
+	})
+)
+
+var (
+	// LLMTokens counts the tokens spent on LLM calls by pipeline stage, model
+	// and type, prompt or completion.
+	LLMTokens = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "tokens_total",
+		Help:      "Total number of tokens spent on LLM calls by stage, model and type",
+	}, []string{"stage", "model", "type"})
+
+	// LLMCost counts the cost of LLM calls in USD from the configured price
+	// table.
+	LLMCost = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "cost_usd_total",
+		Help:      "Total cost of LLM calls in USD by stage and model",
+	}, []string{"stage", "model"})
+)
+
+var (
+	// LLMCacheRequests counts completion cache lookups by stage and result,
+	// one of hit, miss or error.
+	LLMCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "cache_requests_total",
+		Help:      "Total number of LLM completion cache lookups by stage and result",
+	}, []string{"stage", "result"})
+)
+
+var (
+	// RetentionPurged counts the rows the janitor purged by kind, one of
+	// messages_blanked, messages_deleted, events, summaries, ask_turns or
+	// profiles.
+	RetentionPurged = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "retention",
+		Name:      "purged_total",
+		Help:      "Total number of rows purged by retention policies by kind",
+	}, []string{"kind"})
+)
+
+var (
+	// AuthFailures counts the requests refused for missing or invalid
+	// credentials, by served API.
+	AuthFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "failures_total",
+		Help:      "Total number of requests refused for missing or invalid credentials",
+	}, []string{"server"})
+
+	// RawMessageReads counts the raw messages served, by endpoint. Every
+	// such read is recorded in the access log.
+	RawMessageReads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "access",
+		Name:      "raw_message_reads_total",
+		Help:      "Total number of raw chat messages served by endpoint",
+	}, []string{"endpoint"})
 )
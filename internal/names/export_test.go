@@ -0,0 +1,5 @@
+package names
+
+func Levenshtein(a, b string) int {
+	return levenshtein([]rune(a), []rune(b))
+}
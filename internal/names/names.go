@@ -0,0 +1,212 @@
+package names
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize folds a display name into a comparable form: NFKC (which also
+// maps full-width characters to their half-width forms), lower case, without
+// invisible characters and with whitespace collapsed.
+func Normalize(name string) string {
+	name = norm.NFKC.String(name)
+
+	var b strings.Builder
+	space := false
+	for _, r := range name {
+		switch {
+		case unicode.IsSpace(r):
+			space = b.Len() > 0
+			continue
+		case unicode.Is(unicode.Cf, r), unicode.IsControl(r):
+			continue
+		}
+		if space {
+			b.WriteRune(' ')
+			space = false
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// Similarity returns a score in [0, 1] for how alike two names are, based on
+// the rune-level edit distance of their normalized forms.
+func Similarity(a, b string) float64 {
+	ra, rb := []rune(Normalize(a)), []rune(Normalize(b))
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(max(len(ra), len(rb)))
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+const (
+	// DefaultMatchThreshold is the minimum fuzzy score accepted by a Matcher.
+	DefaultMatchThreshold = 0.8
+
+	// containmentScore is the fuzzy score given when one name contains the
+	// other, e.g. when the LLM drops a status suffix from a display name.
+	containmentScore = 0.85
+
+	// minContainedRunes avoids matching on single characters.
+	minContainedRunes = 2
+)
+
+// MatchMethod records how a name was resolved by a Matcher.
+type MatchMethod string
+
+const (
+	MatchExact      MatchMethod = "exact"
+	MatchNormalized MatchMethod = "normalized"
+	MatchUsername   MatchMethod = "username"
+	MatchAltID      MatchMethod = "alt_id"
+	MatchFuzzy      MatchMethod = "fuzzy"
+	MatchNone       MatchMethod = "unmatched"
+)
+
+// Candidate is something a name can resolve to, e.g. an identity in a chat roster.
+type Candidate struct {
+	ID        string
+	Names     []string
+	Usernames []string
+	AltIDs    []string
+}
+
+// Matcher resolves free-form names, such as participants written by the LLM,
+// against a roster of candidates.
+type Matcher struct {
+	candidates []Candidate
+	threshold  float64
+	exact      map[string]string
+	normalized map[string]string
+	usernames  map[string]string
+	altIDs     map[string]string
+}
+
+func NewMatcher(candidates []Candidate, threshold float64) *Matcher {
+	if threshold <= 0 {
+		threshold = DefaultMatchThreshold
+	}
+
+	m := &Matcher{
+		candidates: candidates,
+		threshold:  threshold,
+		exact:      make(map[string]string),
+		normalized: make(map[string]string),
+		usernames:  make(map[string]string),
+		altIDs:     make(map[string]string),
+	}
+	for _, c := range candidates {
+		for _, name := range c.Names {
+			addKey(m.exact, name, c.ID)
+			addKey(m.normalized, Normalize(name), c.ID)
+		}
+		for _, username := range c.Usernames {
+			addKey(m.usernames, normalizeHandle(username), c.ID)
+		}
+		for _, altID := range c.AltIDs {
+			addKey(m.altIDs, Normalize(altID), c.ID)
+		}
+	}
+
+	return m
+}
+
+// addKey maps key to id, the first candidate wins on collisions.
+func addKey(index map[string]string, key, id string) {
+	if key == "" {
+		return
+	}
+	if _, ok := index[key]; !ok {
+		index[key] = id
+	}
+}
+
+func normalizeHandle(name string) string {
+	return strings.TrimPrefix(Normalize(name), "@")
+}
+
+// Match resolves name to a candidate ID, trying exact, normalized, username,
+// alt ID and finally fuzzy matching. Fuzzy matches must reach the threshold
+// and be unambiguous.
+func (m *Matcher) Match(name string) (string, MatchMethod, bool) {
+	if id, ok := m.exact[name]; ok {
+		return id, MatchExact, true
+	}
+
+	normalized := Normalize(name)
+	if normalized == "" {
+		return "", MatchNone, false
+	}
+	if id, ok := m.normalized[normalized]; ok {
+		return id, MatchNormalized, true
+	}
+	if id, ok := m.usernames[normalizeHandle(name)]; ok {
+		return id, MatchUsername, true
+	}
+	if id, ok := m.altIDs[normalized]; ok {
+		return id, MatchAltID, true
+	}
+
+	bestID, bestScore, tied := "", 0.0, false
+	for _, c := range m.candidates {
+		score := 0.0
+		for _, candidateName := range append(append([]string{}, c.Names...), c.AltIDs...) {
+			score = max(score, fuzzyScore(normalized, Normalize(candidateName)))
+		}
+		switch {
+		case score > bestScore:
+			bestID, bestScore, tied = c.ID, score, false
+		case score == bestScore && score > 0 && c.ID != bestID:
+			tied = true
+		}
+	}
+	if bestScore >= m.threshold && !tied {
+		return bestID, MatchFuzzy, true
+	}
+
+	return "", MatchNone, false
+}
+
+func fuzzyScore(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+
+	score := Similarity(a, b)
+	shorter := min(len([]rune(a)), len([]rune(b)))
+	if shorter >= minContainedRunes && (strings.Contains(a, b) || strings.Contains(b, a)) {
+		score = max(score, containmentScore)
+	}
+
+	return score
+}
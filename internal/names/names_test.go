@@ -0,0 +1,121 @@
+package names_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/luoling8192/mindwave/internal/names"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"", ""},
+		{"Alice", "alice"},
+		{"ＡＬＩＣＥ１２３", "alice123"},
+		{"  Alice \t\n Wang  ", "alice wang"},
+		{"Ali\u200bce\u200d", "alice"},
+		{"Bob\x07", "bob"},
+		{"\u200b \u200b", ""},
+		{"张三（在线）", "张三(在线)"},
+	}
+	for _, tt := range tests {
+		if got := names.Normalize(tt.name); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"abc", "abc", 0},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		// Distances count runes, not bytes.
+		{"张三", "张四", 1},
+		{"张三丰", "张三", 1},
+	}
+	for _, tt := range tests {
+		if got := names.Levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"", "", 1},
+		{"Alice", "", 0},
+		{"Alice", "ＡＬＩＣＥ", 1},
+		{"kitten", "sitting", 1 - 3.0/7},
+	}
+	for _, tt := range tests {
+		if got := names.Similarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Similarity(%q, %q) = %g, want %g", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	candidates := []names.Candidate{
+		{ID: "alice", Names: []string{"Alice Wang"}, Usernames: []string{"@alicew"}, AltIDs: []string{"10001"}},
+		{ID: "bob", Names: []string{"Bob"}, Usernames: []string{"bobby"}},
+		{ID: "zhang", Names: []string{"张三丰"}},
+		{ID: "chris-a", Names: []string{"Chris A"}},
+		{ID: "chris-b", Names: []string{"Chris B"}},
+		{ID: "first", Names: []string{"Twin"}},
+		{ID: "second", Names: []string{"Twin"}},
+	}
+	m := names.NewMatcher(candidates, 0)
+
+	tests := []struct {
+		name       string
+		wantID     string
+		wantMethod names.MatchMethod
+	}{
+		{"Alice Wang", "alice", names.MatchExact},
+		{" alice   WANG ", "alice", names.MatchNormalized},
+		{"ＡＬＩＣＥ ＷＡＮＧ", "alice", names.MatchNormalized},
+		{"@alicew", "alice", names.MatchUsername},
+		{"alicew", "alice", names.MatchUsername},
+		{"@BOBBY", "bob", names.MatchUsername},
+		{"10001", "alice", names.MatchAltID},
+		{"Alice Wong", "alice", names.MatchFuzzy},
+		// The LLM dropped a character, the shorter name is contained.
+		{"张三", "zhang", names.MatchFuzzy},
+		// A single contained rune is not enough.
+		{"三", "", names.MatchNone},
+		// Both Chris contain the name equally, neither is picked.
+		{"Chris", "", names.MatchNone},
+		// Candidates sharing a name resolve to the first of them.
+		{"Twin", "first", names.MatchExact},
+		{"Carol", "", names.MatchNone},
+		{"", "", names.MatchNone},
+		{"\u200b", "", names.MatchNone},
+	}
+	for _, tt := range tests {
+		id, method, ok := m.Match(tt.name)
+		if id != tt.wantID || method != tt.wantMethod || ok != (tt.wantID != "") {
+			t.Errorf("Match(%q) = %q, %s, %t, want %q, %s", tt.name, id, method, ok, tt.wantID, tt.wantMethod)
+		}
+	}
+
+	strict := names.NewMatcher(candidates, 0.95)
+	if id, method, ok := strict.Match("Alice Wong"); ok {
+		t.Errorf("a fuzzy match below the threshold resolved to %q by %s", id, method)
+	}
+	if _, method, _ := strict.Match("Alice Wang"); method != names.MatchExact {
+		t.Errorf("the threshold changed an exact match to %s", method)
+	}
+}
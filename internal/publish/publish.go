@@ -0,0 +1,193 @@
+package publish
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/luoling8192/mindwave/ent/digestdelivery"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/luoling8192/mindwave/internal/services/digest"
+)
+
+// partMarkerReserve is left free in every part for the "(i/n) " prefix of
+// split messages.
+const partMarkerReserve = 12
+
+// Publisher posts text messages into a chat.
+type Publisher interface {
+	// Name identifies the publisher in the delivery log.
+	Name() string
+	// MaxMessageLength is the longest message the platform accepts, in
+	// UTF-16 code units.
+	MaxMessageLength() int
+	Send(ctx context.Context, chatID, text string) error
+}
+
+// Deliver posts a digest through publisher unless it was already posted
+// there for the same chat and period. A delivery interrupted part way resumes
+// after the last part that went through, or starts over when the digest was
+// rebuilt into another number of parts since. It reports whether anything
+// was sent.
+//
+// Deliveries of the same digest must not run concurrently, the scheduler
+// ensures that by running on one leader.
+func Deliver(ctx context.Context, client *datastore.Client, publisher Publisher, d *digest.Digest) (sent bool, err error) {
+	outcome := "sent"
+	defer func() {
+		if err != nil {
+			outcome = "error"
+		}
+		metrics.DigestDeliveries.WithLabelValues(publisher.Name(), outcome).Inc()
+	}()
+
+	err = client.DigestDelivery.Create().
+		SetPublisher(publisher.Name()).
+		SetChatID(d.ChatID).
+		SetDigestKey(d.Key()).
+		OnConflictColumns(digestdelivery.FieldWorkspaceID, digestdelivery.FieldPublisher, digestdelivery.FieldChatID, digestdelivery.FieldDigestKey).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	delivery, err := client.DigestDelivery.Query().
+		Where(
+			digestdelivery.Publisher(publisher.Name()),
+			digestdelivery.ChatID(d.ChatID),
+			digestdelivery.DigestKey(d.Key()),
+		).
+		Only(ctx)
+	if err != nil {
+		return false, err
+	}
+	if delivery.Status == digestdelivery.StatusSent {
+		outcome = "skipped"
+		return false, nil
+	}
+
+	text, err := d.Text()
+	if err != nil {
+		return false, err
+	}
+	parts := Split(text, publisher.MaxMessageLength())
+	// Resuming only makes sense when the digest splits the same way it did
+	// before, a rebuilt digest may have grown or shrunk in between. Its parts
+	// are numbered, so it is posted again in full.
+	if delivery.PartsTotal > 0 && delivery.PartsTotal != len(parts) {
+		slog.Warn("Digest changed since the interrupted delivery, starting over",
+			"publisher", publisher.Name(), "chat_id", d.ChatID, "digest", d.Key(),
+			"parts_sent", delivery.PartsSent, "parts_total", delivery.PartsTotal, "parts", len(parts))
+		delivery, err = delivery.Update().SetPartsTotal(len(parts)).SetPartsSent(0).Save(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	for i := delivery.PartsSent; i < len(parts); i++ {
+		if err := publisher.Send(ctx, d.ChatID, parts[i]); err != nil {
+			if updateErr := delivery.Update().SetPartsTotal(len(parts)).SetError(err.Error()).Exec(ctx); updateErr != nil {
+				err = fmt.Errorf("%w, and failed to record it: %w", err, updateErr)
+			}
+			return i > delivery.PartsSent, err
+		}
+		if err := delivery.Update().SetPartsTotal(len(parts)).SetPartsSent(i + 1).Exec(ctx); err != nil {
+			return true, err
+		}
+	}
+
+	return true, delivery.Update().
+		SetStatus(digestdelivery.StatusSent).
+		SetPartsTotal(len(parts)).
+		SetPartsSent(len(parts)).
+		SetError("").
+		SetSentAt(time.Now().UnixMilli()).
+		Exec(ctx)
+}
+
+// Split breaks text into messages of at most limit UTF-16 code units,
+// preferring paragraph breaks, then line breaks, and cutting inside a line
+// only when it does not fit on its own. Parts are numbered when there is more
+// than one.
+func Split(text string, limit int) []string {
+	text = strings.TrimSpace(text)
+	if length(text) <= limit {
+		return []string{text}
+	}
+
+	budget := max(limit-partMarkerReserve, 1)
+	parts := make([]string, 0)
+	var current strings.Builder
+	flush := func() {
+		if s := strings.TrimSpace(current.String()); s != "" {
+			parts = append(parts, s)
+		}
+		current.Reset()
+	}
+	add := func(piece, separator string) {
+		if current.Len() > 0 && length(current.String())+length(separator)+length(piece) > budget {
+			flush()
+		}
+		if current.Len() > 0 {
+			current.WriteString(separator)
+		}
+		current.WriteString(piece)
+	}
+
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		if length(paragraph) <= budget {
+			add(paragraph, "\n\n")
+			continue
+		}
+		for _, line := range strings.Split(paragraph, "\n") {
+			for _, chunk := range cut(line, budget) {
+				add(chunk, "\n")
+			}
+		}
+		flush()
+	}
+	flush()
+
+	if len(parts) > 1 {
+		for i := range parts {
+			parts[i] = fmt.Sprintf("(%d/%d) %s", i+1, len(parts), parts[i])
+		}
+	}
+	return parts
+}
+
+// cut splits a line into pieces of at most limit UTF-16 code units, without
+// breaking runes.
+func cut(line string, limit int) []string {
+	if length(line) <= limit {
+		return []string{line}
+	}
+
+	pieces := make([]string, 0)
+	var piece []rune
+	n := 0
+	for _, r := range line {
+		w := len(utf16.Encode([]rune{r}))
+		if n+w > limit {
+			pieces = append(pieces, string(piece))
+			piece, n = nil, 0
+		}
+		piece = append(piece, r)
+		n += w
+	}
+	if len(piece) > 0 {
+		pieces = append(pieces, string(piece))
+	}
+	return pieces
+}
+
+// length counts UTF-16 code units, which is how Telegram measures messages
+// and an upper bound for platforms counting code points.
+func length(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
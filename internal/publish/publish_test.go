@@ -0,0 +1,155 @@
+package publish_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luoling8192/mindwave/ent/digestdelivery"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/datastore/datastoretest"
+	"github.com/luoling8192/mindwave/internal/publish"
+	"github.com/luoling8192/mindwave/internal/publish/publishtest"
+	"github.com/luoling8192/mindwave/internal/services/digest"
+)
+
+// newDigest builds a digest whose text is long enough to take several
+// Discord messages, more events take more messages.
+func newDigest(events int) *digest.Digest {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	d := &digest.Digest{
+		ChatID:     "chat-1",
+		ChatName:   "Team",
+		Period:     digest.PeriodDay,
+		Start:      start,
+		End:        start.AddDate(0, 0, 1),
+		EventCount: events,
+	}
+	topic := digest.Topic{Name: "release"}
+	for i := range events {
+		topic.Events = append(topic.Events, digest.Event{
+			Name:        fmt.Sprintf("event %d", i),
+			Description: strings.Repeat(fmt.Sprintf("detail %d ", i), 40),
+			Time:        start.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	d.Topics = []digest.Topic{topic}
+	return d
+}
+
+// newPublisher returns a Discord webhook on a stand-in that fails the
+// request after the first failAfter messages when failAfter is positive.
+func newPublisher(t *testing.T, failAfter int) (publish.Publisher, *publishtest.Handler) {
+	t.Helper()
+
+	server, handler := publishtest.NewServer()
+	t.Cleanup(server.Close)
+	received := 0
+	handler.OnMessage = func(publishtest.Message) {
+		received++
+		if received == failAfter {
+			handler.FailNext(http.StatusBadRequest)
+		}
+	}
+
+	publisher, err := publish.NewWebhook(server.URL+"/hook", publish.WebhookFormatDiscord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return publisher, handler
+}
+
+func parts(t *testing.T, d *digest.Digest, publisher publish.Publisher) []string {
+	t.Helper()
+
+	text, err := d.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return publish.Split(text, publisher.MaxMessageLength())
+}
+
+func texts(messages []publishtest.Message) []string {
+	out := make([]string, len(messages))
+	for i, m := range messages {
+		out[i] = m.Text
+	}
+	return out
+}
+
+func delivery(t *testing.T, client *datastore.Client) (status digestdelivery.Status, sent, total int) {
+	t.Helper()
+
+	row, err := client.DigestDelivery.Query().Only(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return row.Status, row.PartsSent, row.PartsTotal
+}
+
+func TestDeliverResumesInterruptedDelivery(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t, migrate.DigestDeliveriesTable)
+	publisher, handler := newPublisher(t, 1)
+	d := newDigest(12)
+	want := parts(t, d, publisher)
+	if len(want) < 3 {
+		t.Fatalf("digest splits into %d parts, the test needs at least 3", len(want))
+	}
+
+	sent, err := publish.Deliver(ctx, client, publisher, d)
+	if err == nil || !sent {
+		t.Fatalf("first delivery: sent=%t err=%v, want a partial delivery", sent, err)
+	}
+	if status, partsSent, total := delivery(t, client); status != digestdelivery.StatusPending || partsSent != 1 || total != len(want) {
+		t.Fatalf("after interruption: status=%s parts %d/%d", status, partsSent, total)
+	}
+
+	sent, err = publish.Deliver(ctx, client, publisher, d)
+	if err != nil || !sent {
+		t.Fatalf("resumed delivery: sent=%t err=%v", sent, err)
+	}
+	if got := texts(handler.Messages()); strings.Join(got, "\x00") != strings.Join(want, "\x00") {
+		t.Fatalf("posted %d messages, want each of the %d parts once", len(got), len(want))
+	}
+	if status, partsSent, _ := delivery(t, client); status != digestdelivery.StatusSent || partsSent != len(want) {
+		t.Fatalf("after resuming: status=%s parts_sent=%d", status, partsSent)
+	}
+
+	sent, err = publish.Deliver(ctx, client, publisher, d)
+	if err != nil || sent {
+		t.Fatalf("repeated delivery: sent=%t err=%v, want it skipped", sent, err)
+	}
+	if n := len(handler.Messages()); n != len(want) {
+		t.Fatalf("repeated delivery posted again, %d messages", n)
+	}
+}
+
+func TestDeliverStartsOverWhenPartsChange(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t, migrate.DigestDeliveriesTable)
+	publisher, handler := newPublisher(t, 1)
+
+	if _, err := publish.Deliver(ctx, client, publisher, newDigest(12)); err == nil {
+		t.Fatal("first delivery succeeded, want it interrupted")
+	}
+
+	rebuilt := newDigest(24)
+	want := parts(t, rebuilt, publisher)
+	sent, err := publish.Deliver(ctx, client, publisher, rebuilt)
+	if err != nil || !sent {
+		t.Fatalf("delivery of the rebuilt digest: sent=%t err=%v", sent, err)
+	}
+
+	got := texts(handler.Messages())[1:]
+	if strings.Join(got, "\x00") != strings.Join(want, "\x00") {
+		t.Fatalf("posted %d messages after the interruption, want all %d parts of the rebuilt digest", len(got), len(want))
+	}
+	if status, partsSent, total := delivery(t, client); status != digestdelivery.StatusSent || partsSent != len(want) || total != len(want) {
+		t.Fatalf("status=%s parts %d/%d", status, partsSent, total)
+	}
+}
@@ -0,0 +1,119 @@
+// Package publishtest provides a local stand-in for the Telegram Bot API and
+// incoming webhooks, recording what publishers post instead of delivering it.
+package publishtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// Message is a post received by the stand-in. ChatID is empty for webhooks.
+type Message struct {
+	Path   string `json:"path"`
+	ChatID string `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+// Handler accepts Telegram sendMessage calls on /bot<token>/sendMessage and
+// Slack or Discord webhook posts on any other path.
+type Handler struct {
+	mu       sync.Mutex
+	messages []Message
+	failures []int
+	// OnMessage is called for every accepted message when set.
+	OnMessage func(Message)
+}
+
+// Messages returns the messages received so far, in order.
+func (h *Handler) Messages() []Message {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]Message(nil), h.messages...)
+}
+
+// FailNext makes the next requests fail with the given HTTP statuses, one
+// status per request.
+func (h *Handler) FailNext(statuses ...int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.failures = append(h.failures, statuses...)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	telegram := strings.HasPrefix(r.URL.Path, "/bot") && strings.HasSuffix(r.URL.Path, "/sendMessage")
+
+	var body struct {
+		ChatID  any    `json:"chat_id"`
+		Text    string `json:"text"`
+		Content string `json:"content"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		reply(w, telegram, http.StatusBadRequest, "invalid json")
+		return
+	}
+
+	h.mu.Lock()
+	if len(h.failures) > 0 {
+		status := h.failures[0]
+		h.failures = h.failures[1:]
+		h.mu.Unlock()
+		reply(w, telegram, status, http.StatusText(status))
+		return
+	}
+
+	m := Message{Path: r.URL.Path, Text: body.Text}
+	if telegram {
+		chatID, _ := json.Marshal(body.ChatID)
+		m.ChatID = strings.Trim(string(chatID), `"`)
+	}
+	if body.Content != "" {
+		m.Text = body.Content
+	}
+	h.messages = append(h.messages, m)
+	onMessage := h.OnMessage
+	h.mu.Unlock()
+
+	if onMessage != nil {
+		onMessage(m)
+	}
+	reply(w, telegram, http.StatusOK, "")
+}
+
+func reply(w http.ResponseWriter, telegram bool, status int, description string) {
+	if !telegram {
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte("ok"))
+			return
+		}
+		http.Error(w, description, status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	response := map[string]any{"ok": status == http.StatusOK}
+	if status != http.StatusOK {
+		response["error_code"] = status
+		response["description"] = description
+		if status == http.StatusTooManyRequests {
+			response["parameters"] = map[string]any{"retry_after": 1}
+		}
+	} else {
+		response["result"] = map[string]any{"message_id": 1}
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// NewServer starts a stand-in on a local port. Point publishers at its URL
+// and close it when done.
+func NewServer() (*httptest.Server, *Handler) {
+	h := &Handler{}
+	return httptest.NewServer(h), h
+}
@@ -0,0 +1,101 @@
+package publish
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTelegramAPIURL is the Bot API endpoint, tests point the adapter
+	// at a local stand-in instead.
+	DefaultTelegramAPIURL = "https://api.telegram.org"
+
+	telegramMaxMessageLength = 4096
+	defaultHTTPTimeout       = 30 * time.Second
+)
+
+// Telegram posts into chats through the Telegram Bot API. The bot has to be a
+// member of every chat it posts to.
+type Telegram struct {
+	apiURL     string
+	token      string
+	httpClient *http.Client
+}
+
+// NewTelegram builds a Telegram publisher. apiURL may be empty for
+// DefaultTelegramAPIURL.
+func NewTelegram(apiURL, token string) (*Telegram, error) {
+	if token == "" {
+		return nil, errors.New("telegram bot token is required")
+	}
+	if apiURL == "" {
+		apiURL = DefaultTelegramAPIURL
+	}
+
+	return &Telegram{
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+	}, nil
+}
+
+func (t *Telegram) Name() string {
+	return "telegram"
+}
+
+func (t *Telegram) MaxMessageLength() int {
+	return telegramMaxMessageLength
+}
+
+type telegramResponse struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+	Parameters  struct {
+		RetryAfter int `json:"retry_after"`
+	} `json:"parameters"`
+}
+
+// Send posts text as a plain message without link previews, so evidence
+// links do not flood the chat.
+func (t *Telegram) Send(ctx context.Context, chatID, text string) error {
+	body, err := json.Marshal(map[string]any{
+		"chat_id":                  chatID,
+		"text":                     text,
+		"disable_web_page_preview": true,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.apiURL+"/bot"+t.token+"/sendMessage", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		// The request URL carries the token, keep it out of logs.
+		return errors.New(strings.ReplaceAll(err.Error(), t.token, "<token>"))
+	}
+	defer resp.Body.Close()
+
+	var result telegramResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("telegram returned status %d: %w", resp.StatusCode, err)
+	}
+	if !result.OK {
+		if result.Parameters.RetryAfter > 0 {
+			return fmt.Errorf("telegram returned status %d: %s, retry after %ds", resp.StatusCode, result.Description, result.Parameters.RetryAfter)
+		}
+		return fmt.Errorf("telegram returned status %d: %s", resp.StatusCode, result.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package publish
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type WebhookFormat string
+
+const (
+	WebhookFormatSlack   WebhookFormat = "slack"
+	WebhookFormatDiscord WebhookFormat = "discord"
+)
+
+// Slack truncates long message text, Discord rejects content over 2000
+// characters.
+const (
+	slackMaxMessageLength   = 4000
+	discordMaxMessageLength = 2000
+
+	maxErrorBodyBytes = 512
+)
+
+// Webhook posts into a chat through an incoming webhook. The webhook URL
+// decides the channel, so every digest sent through one Webhook lands in the
+// same place.
+type Webhook struct {
+	url        string
+	format     WebhookFormat
+	httpClient *http.Client
+}
+
+func NewWebhook(url string, format WebhookFormat) (*Webhook, error) {
+	if url == "" {
+		return nil, errors.New("webhook url is required")
+	}
+	switch format {
+	case WebhookFormatSlack, WebhookFormatDiscord:
+	default:
+		return nil, fmt.Errorf("unknown webhook format %q, expected slack or discord", format)
+	}
+
+	return &Webhook{
+		url:        url,
+		format:     format,
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+	}, nil
+}
+
+func (w *Webhook) Name() string {
+	return "webhook-" + string(w.format)
+}
+
+func (w *Webhook) MaxMessageLength() int {
+	if w.format == WebhookFormatDiscord {
+		return discordMaxMessageLength
+	}
+	return slackMaxMessageLength
+}
+
+// Send posts text to the webhook, chatID is only used by the delivery log.
+func (w *Webhook) Send(ctx context.Context, _ string, text string) error {
+	payload := map[string]any{"text": text}
+	if w.format == WebhookFormatDiscord {
+		// Digests mention members by name, they should not ping anyone.
+		payload = map[string]any{
+			"content":          text,
+			"allowed_mentions": map[string]any{"parse": []string{}},
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return fmt.Errorf("webhook returned status %d: %s", resp.StatusCode, bytes.TrimSpace(detail))
+	}
+
+	return nil
+}
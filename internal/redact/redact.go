@@ -0,0 +1,271 @@
+// Package redact replaces personal data and secrets in chat content with
+// placeholders before it is sent to an LLM, and restores them in the output
+// where the policy allows.
+package redact
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/luoling8192/mindwave/internal/metrics"
+)
+
+// Built-in detectors, in the order they run. Secrets go first so their digits
+// are not taken for phone or card numbers.
+const (
+	DetectorAPIKey     = "api_key"
+	DetectorJWT        = "jwt"
+	DetectorEmail      = "email"
+	DetectorIDNumber   = "id_number"
+	DetectorCreditCard = "credit_card"
+	DetectorPhone      = "phone"
+)
+
+// Detector finds one kind of sensitive value.
+type Detector struct {
+	Name    string
+	Pattern *regexp.Regexp
+	// Valid rejects matches the pattern alone cannot, e.g. by checksum. Nil
+	// accepts every match.
+	Valid func(match string) bool
+	// Restore puts the original value back into the stored output.
+	Restore bool
+}
+
+// DefaultDetectors returns the built-in detectors, none of them restored.
+func DefaultDetectors() []Detector {
+	return []Detector{
+		{
+			Name: DetectorAPIKey,
+			Pattern: regexp.MustCompile(`\b(?:sk-(?:ant-|proj-)?[A-Za-z0-9_-]{20,}` +
+				`|gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,}` +
+				`|glpat-[A-Za-z0-9_-]{20,}|xox[abprs]-[A-Za-z0-9-]{10,}` +
+				`|AKIA[0-9A-Z]{16}|AIza[0-9A-Za-z_-]{35}` +
+				`|\d{8,10}:AA[A-Za-z0-9_-]{33})`),
+		},
+		{
+			Name:    DetectorJWT,
+			Pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{5,}\.eyJ[A-Za-z0-9_-]{5,}\.[A-Za-z0-9_-]+`),
+		},
+		{
+			Name:    DetectorEmail,
+			Pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`),
+		},
+		{
+			// Resident identity card numbers of mainland China.
+			Name:    DetectorIDNumber,
+			Pattern: regexp.MustCompile(`\b\d{17}[\dXx]\b`),
+			Valid:   validIDNumber,
+		},
+		{
+			// Card numbers written in groups of four or as one run of digits.
+			Name:    DetectorCreditCard,
+			Pattern: regexp.MustCompile(`\b(?:\d{4}[ -]){3}\d{1,7}\b|\b\d{13,19}\b`),
+			Valid:   validCardNumber,
+		},
+		{
+			// Mainland mobile numbers, and international numbers written with
+			// a leading +.
+			Name:    DetectorPhone,
+			Pattern: regexp.MustCompile(`(?:\+?86[ -]?)?\b1[3-9]\d(?:[ -]?\d{4}){2}\b|\+\d{1,3}(?:[ -]?\d{2,4}){2,5}\b`),
+		},
+	}
+}
+
+// Pattern is a user-defined detector in the redaction config.
+type Pattern struct {
+	Name    string `json:"name"`
+	Pattern string `json:"pattern"`
+	Restore bool   `json:"restore"`
+}
+
+// Config adjusts the built-in detectors and adds user-defined ones, e.g.
+//
+//	{
+//	  "disable": ["phone"],
+//	  "restore": ["email"],
+//	  "patterns": [{"name": "ticket", "pattern": "OPS-\\d+", "restore": true}]
+//	}
+type Config struct {
+	// Disable lists built-in detectors to turn off.
+	Disable []string `json:"disable"`
+	// Restore lists built-in detectors whose values are restored in the
+	// stored output.
+	Restore  []string  `json:"restore"`
+	Patterns []Pattern `json:"patterns"`
+}
+
+// LoadConfig reads a redaction config from a JSON file.
+func LoadConfig(path string) (Config, error) {
+	var config Config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("failed to parse redaction config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// Redactor replaces the matches of its detectors with placeholders.
+type Redactor struct {
+	detectors []Detector
+}
+
+// New builds a redactor from the built-in detectors adjusted by config.
+func New(config Config) (*Redactor, error) {
+	builtin := DefaultDetectors()
+	names := make([]string, 0, len(builtin))
+	for _, d := range builtin {
+		names = append(names, d.Name)
+	}
+	for _, name := range slices.Concat(config.Disable, config.Restore) {
+		if !slices.Contains(names, name) {
+			return nil, fmt.Errorf("unknown detector %q, expected one of %s", name, strings.Join(names, ", "))
+		}
+	}
+
+	var detectors []Detector
+	for _, d := range builtin {
+		if slices.Contains(config.Disable, d.Name) {
+			continue
+		}
+		d.Restore = slices.Contains(config.Restore, d.Name)
+		detectors = append(detectors, d)
+	}
+	for _, p := range config.Patterns {
+		if p.Name == "" || slices.Contains(names, p.Name) {
+			return nil, fmt.Errorf("pattern %q needs a name of its own", p.Pattern)
+		}
+		pattern, err := regexp.Compile(p.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %s: %w", p.Name, err)
+		}
+		names = append(names, p.Name)
+		detectors = append(detectors, Detector{Name: p.Name, Pattern: pattern, Restore: p.Restore})
+	}
+
+	return &Redactor{detectors: detectors}, nil
+}
+
+// Session redacts the texts of one LLM exchange. The same value gets the same
+// placeholder in every text of a session, so the model can still tell values
+// apart and the output can be restored.
+type Session struct {
+	redactor *Redactor
+	// originals maps placeholders to values, placeholders maps back.
+	originals    map[string]string
+	placeholders map[string]string
+	restore      map[string]bool
+	counts       map[string]int
+}
+
+// NewSession starts a session, a nil redactor passes texts through.
+func (r *Redactor) NewSession() *Session {
+	return &Session{
+		redactor:     r,
+		originals:    make(map[string]string),
+		placeholders: make(map[string]string),
+		restore:      make(map[string]bool),
+		counts:       make(map[string]int),
+	}
+}
+
+// Redact replaces the sensitive values in text with placeholders such as
+// [EMAIL_1] and counts them in metrics.
+func (s *Session) Redact(text string) string {
+	if s.redactor == nil {
+		return text
+	}
+
+	for _, d := range s.redactor.detectors {
+		text = d.Pattern.ReplaceAllStringFunc(text, func(match string) string {
+			if d.Valid != nil && !d.Valid(match) {
+				return match
+			}
+			metrics.RedactionsCount.WithLabelValues(d.Name).Inc()
+
+			if placeholder, ok := s.placeholders[match]; ok {
+				return placeholder
+			}
+			s.counts[d.Name]++
+			placeholder := "[" + strings.ToUpper(d.Name) + "_" + strconv.Itoa(s.counts[d.Name]) + "]"
+			s.placeholders[match] = placeholder
+			s.originals[placeholder] = match
+			s.restore[placeholder] = d.Restore
+			return placeholder
+		})
+	}
+	return text
+}
+
+// Restore puts back the values of the detectors the policy restores.
+// Placeholders of the other detectors stay in place.
+func (s *Session) Restore(text string) string {
+	if len(s.originals) == 0 {
+		return text
+	}
+
+	pairs := make([]string, 0, 2*len(s.originals))
+	for placeholder, original := range s.originals {
+		if s.restore[placeholder] {
+			pairs = append(pairs, placeholder, original)
+		}
+	}
+	if len(pairs) == 0 {
+		return text
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
+
+// Counts returns the number of distinct values redacted per detector.
+func (s *Session) Counts() map[string]int {
+	return s.counts
+}
+
+// validCardNumber accepts card numbers of the payment networks, which start
+// with 2 to 6, so long platform ids such as -100… chat ids are not taken for
+// cards whenever their checksum happens to hold.
+func validCardNumber(number string) bool {
+	return number[0] >= '2' && number[0] <= '6' && validLuhn(number)
+}
+
+// validLuhn checks the Luhn checksum of a card number.
+func validLuhn(number string) bool {
+	sum, n := 0, 0
+	for i := len(number) - 1; i >= 0; i-- {
+		c := rune(number[i])
+		if !unicode.IsDigit(c) {
+			continue
+		}
+		digit := int(c - '0')
+		if n%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		n++
+	}
+	return n >= 13 && sum%10 == 0
+}
+
+// validIDNumber checks the ISO 7064 check character of a resident identity
+// card number.
+func validIDNumber(number string) bool {
+	weights := [17]int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+	sum := 0
+	for i, w := range weights {
+		sum += int(number[i]-'0') * w
+	}
+	check := "10X98765432"[sum%11]
+	return check == number[17] || (check == 'X' && number[17] == 'x')
+}
@@ -0,0 +1,204 @@
+// Package access decides which chats API users may read and how much of
+// them, and records every read of raw messages. An API user reads the joined
+// chats of the owner accounts linked to them and the joined chats granted to
+// them, never more than their role allows. Admins read every chat.
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/chatgrant"
+	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/samber/lo"
+)
+
+// ErrForbidden is returned when the role of a caller does not allow a read.
+var ErrForbidden = errors.New("forbidden")
+
+// Scope is what an API user may read. A nil scope reads everything, it is
+// used when the API is served without authentication.
+type Scope struct {
+	Principal *auth.Principal
+	// OwnerIDs are the owner accounts linked to the user.
+	OwnerIDs []uuid.UUID
+	// Roles maps the chats the user may read to their role in each, nil for
+	// admins who read every chat.
+	Roles map[string]auth.Role
+}
+
+// NewScope resolves the chats a principal may read. The chats of the linked
+// owner accounts are read as curator and granted chats with the role of
+// their grant, both capped by the principal's role. Chats that are not
+// joined chats are left out.
+func NewScope(ctx context.Context, client *datastore.Client, p *auth.Principal) (*Scope, error) {
+	ownerIDs, err := owners.Linked(ctx, client, p.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load owner accounts of %s: %w", p.Subject, err)
+	}
+	scope := &Scope{Principal: p, OwnerIDs: ownerIDs}
+	if p.Role.Includes(auth.RoleAdmin) {
+		return scope, nil
+	}
+
+	roles := make(map[string]auth.Role)
+	grant := func(chatID string, role auth.Role) {
+		role = auth.Lower(role, p.Role)
+		if current, ok := roles[chatID]; !ok || role.Includes(current) {
+			roles[chatID] = role
+		}
+	}
+
+	ownerChats, err := owners.Chats(ctx, client, ownerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chats of %s: %w", p.Subject, err)
+	}
+	for _, chatID := range ownerChats {
+		grant(chatID, auth.RoleCurator)
+	}
+
+	grants, err := client.ChatGrant.Query().
+		Where(chatgrant.Subject(p.Subject)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chat grants of %s: %w", p.Subject, err)
+	}
+	for _, g := range grants {
+		grant(g.ChatID, auth.Role(g.Role))
+	}
+
+	joined, err := joinedChats(ctx, client, lo.Keys(roles))
+	if err != nil {
+		return nil, err
+	}
+	for chatID := range roles {
+		if !joined[chatID] {
+			delete(roles, chatID)
+		}
+	}
+	scope.Roles = roles
+	return scope, nil
+}
+
+// Chats returns the chats the scope reads, nil when it reads every chat. A
+// scope without chats returns an empty slice, which matches nothing.
+func (s *Scope) Chats() []string {
+	return s.chats(auth.RoleViewer)
+}
+
+// ContentChats returns the chats whose raw messages the scope reads, nil
+// when it reads them all.
+func (s *Scope) ContentChats() []string {
+	return s.chats(auth.RoleCurator)
+}
+
+func (s *Scope) chats(role auth.Role) []string {
+	if s == nil || s.Roles == nil {
+		return nil
+	}
+	chatIDs := make([]string, 0, len(s.Roles))
+	for chatID, r := range s.Roles {
+		if r.Includes(role) {
+			chatIDs = append(chatIDs, chatID)
+		}
+	}
+	slices.Sort(chatIDs)
+	return chatIDs
+}
+
+// Allows tells whether the caller's role allows what role allows.
+func (s *Scope) Allows(role auth.Role) bool {
+	return s == nil || s.Principal.Role.Includes(role)
+}
+
+// AllowsChat tells whether the scope reads chatID.
+func (s *Scope) AllowsChat(chatID string) bool {
+	if s == nil || s.Roles == nil {
+		return true
+	}
+	_, ok := s.Roles[chatID]
+	return ok
+}
+
+// AllowsContent tells whether the scope reads the raw messages of chatID.
+func (s *Scope) AllowsContent(chatID string) bool {
+	if s == nil || s.Roles == nil {
+		return true
+	}
+	return s.Roles[chatID].Includes(auth.RoleCurator)
+}
+
+// AllowsOwner tells whether the scope allows the view of an owner account.
+func (s *Scope) AllowsOwner(owner uuid.UUID) bool {
+	return s == nil || s.Roles == nil || slices.Contains(s.OwnerIDs, owner)
+}
+
+// Grant lets an API user read joined chats with a role, replacing the role
+// of grants that exist. Chats that are not joined chats are refused.
+func Grant(ctx context.Context, client *datastore.Client, subject string, chatIDs []string, role auth.Role, grantedBy string) error {
+	if subject == "" {
+		return errors.New("subject is required")
+	}
+	if err := chatgrant.RoleValidator(chatgrant.Role(role)); err != nil {
+		return fmt.Errorf("chats are granted to viewers or curators: %w", err)
+	}
+
+	joined, err := joinedChats(ctx, client, chatIDs)
+	if err != nil {
+		return err
+	}
+	if unknown := lo.Filter(chatIDs, func(id string, _ int) bool { return !joined[id] }); len(unknown) > 0 {
+		return fmt.Errorf("not joined chats: %s", strings.Join(unknown, ", "))
+	}
+
+	builders := make([]*ent.ChatGrantCreate, 0, len(chatIDs))
+	for _, chatID := range lo.Uniq(chatIDs) {
+		builders = append(builders, client.ChatGrant.Create().
+			SetSubject(subject).
+			SetChatID(chatID).
+			SetRole(chatgrant.Role(role)).
+			SetGrantedBy(grantedBy))
+	}
+	return client.ChatGrant.CreateBulk(builders...).
+		OnConflictColumns(chatgrant.FieldWorkspaceID, chatgrant.FieldSubject, chatgrant.FieldChatID).
+		Update(func(u *ent.ChatGrantUpsert) {
+			u.UpdateRole()
+			u.UpdateGrantedBy()
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+}
+
+// Revoke removes grants of an API user and returns how many there were.
+func Revoke(ctx context.Context, client *datastore.Client, subject string, chatIDs []string) (int, error) {
+	return client.ChatGrant.Delete().
+		Where(
+			chatgrant.Subject(subject),
+			chatgrant.ChatIDIn(chatIDs...),
+		).
+		Exec(ctx)
+}
+
+// joinedChats tells which of the chats are joined chats.
+func joinedChats(ctx context.Context, client *datastore.Client, chatIDs []string) (map[string]bool, error) {
+	if len(chatIDs) == 0 {
+		return map[string]bool{}, nil
+	}
+	ids, err := client.JoinedChat.Query().
+		Where(joinedchat.ChatIDIn(chatIDs...)).
+		Unique(true).
+		Select(joinedchat.FieldChatID).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load joined chats: %w", err)
+	}
+	return lo.SliceToMap(ids, func(id string) (string, bool) { return id, true }), nil
+}
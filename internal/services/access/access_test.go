@@ -0,0 +1,200 @@
+package access_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/chatgrant"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/datastore/datastoretest"
+	"github.com/luoling8192/mindwave/internal/services/access"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/pgvector/pgvector-go"
+)
+
+// seed stores the chats of alice: her owner account has messages in owned,
+// both and left, she is granted curated, viewed, both and unjoined, and left
+// and unjoined are not joined chats.
+func seed(t *testing.T, ctx context.Context, client *datastore.Client) uuid.UUID {
+	t.Helper()
+
+	for _, chatID := range []string{"owned", "curated", "viewed", "both", "deleted"} {
+		if err := client.JoinedChat.Create().SetPlatform("telegram").SetChatID(chatID).SetChatName(chatID).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ownerID := uuid.New()
+	if err := owners.Link(ctx, client, "alice", []uuid.UUID{ownerID}); err != nil {
+		t.Fatal(err)
+	}
+	for i, chatID := range []string{"owned", "both", "left", "deleted"} {
+		create := client.ChatMessage.Create().
+			SetPlatform("telegram").
+			SetPlatformMessageID(chatID).
+			SetFromID("alice").
+			SetFromName("Alice").
+			SetOwnerAccountID(ownerID).
+			SetInChatID(chatID).
+			SetInChatType("group").
+			SetContent("hello").
+			SetReplyToName("-").
+			SetReplyToID("-").
+			SetContentVector1536(pgvector.NewVector(nil)).
+			SetContentVector1024(pgvector.NewVector(nil)).
+			SetContentVector768(pgvector.NewVector(nil))
+		if chatID == "deleted" {
+			create.SetDeletedAt(int64(i + 1))
+		}
+		if err := create.Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	grants := map[string]auth.Role{
+		"curated":  auth.RoleCurator,
+		"viewed":   auth.RoleViewer,
+		"both":     auth.RoleViewer,
+		"unjoined": auth.RoleCurator,
+	}
+	for chatID, role := range grants {
+		err := client.ChatGrant.Create().
+			SetSubject("alice").
+			SetChatID(chatID).
+			SetRole(chatgrant.Role(role)).
+			SetGrantedBy("admin").
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return ownerID
+}
+
+func TestNewScope(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t,
+		migrate.JoinedChatsTable,
+		migrate.ChatMessagesTable,
+		migrate.OwnerAccountLinksTable,
+		migrate.ChatGrantsTable,
+	)
+	ownerID := seed(t, ctx, client)
+
+	tests := []struct {
+		name         string
+		principal    auth.Principal
+		chats        []string
+		contentChats []string
+	}{
+		{
+			name:         "curator",
+			principal:    auth.Principal{Subject: "alice", Role: auth.RoleCurator},
+			chats:        []string{"both", "curated", "owned", "viewed"},
+			contentChats: []string{"both", "curated", "owned"},
+		},
+		{
+			name:         "viewer",
+			principal:    auth.Principal{Subject: "alice", Role: auth.RoleViewer},
+			chats:        []string{"both", "curated", "owned", "viewed"},
+			contentChats: []string{},
+		},
+		{
+			name:         "admin",
+			principal:    auth.Principal{Subject: "alice", Role: auth.RoleAdmin},
+			chats:        nil,
+			contentChats: nil,
+		},
+		{
+			name:         "without links or grants",
+			principal:    auth.Principal{Subject: "bob", Role: auth.RoleCurator},
+			chats:        []string{},
+			contentChats: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := access.NewScope(ctx, client, &tt.principal)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := scope.Chats(); !slices.Equal(got, tt.chats) || (got == nil) != (tt.chats == nil) {
+				t.Errorf("Chats() = %#v, want %#v", got, tt.chats)
+			}
+			if got := scope.ContentChats(); !slices.Equal(got, tt.contentChats) || (got == nil) != (tt.contentChats == nil) {
+				t.Errorf("ContentChats() = %#v, want %#v", got, tt.contentChats)
+			}
+
+			everything := tt.chats == nil
+			for _, chatID := range []string{"owned", "curated", "viewed", "both", "left", "unjoined", "deleted"} {
+				if got, want := scope.AllowsChat(chatID), everything || slices.Contains(tt.chats, chatID); got != want {
+					t.Errorf("AllowsChat(%q) = %t, want %t", chatID, got, want)
+				}
+				if got, want := scope.AllowsContent(chatID), everything || slices.Contains(tt.contentChats, chatID); got != want {
+					t.Errorf("AllowsContent(%q) = %t, want %t", chatID, got, want)
+				}
+			}
+
+			linked := tt.principal.Subject == "alice"
+			if got := scope.AllowsOwner(ownerID); got != linked {
+				t.Errorf("AllowsOwner(linked account) = %t, want %t", got, linked)
+			}
+			if got := scope.AllowsOwner(uuid.New()); got != everything {
+				t.Errorf("AllowsOwner(other account) = %t, want %t", got, everything)
+			}
+			if got, want := scope.Allows(auth.RoleAdmin), tt.principal.Role == auth.RoleAdmin; got != want {
+				t.Errorf("Allows(admin) = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestNilScope(t *testing.T) {
+	var scope *access.Scope
+	if scope.Chats() != nil || scope.ContentChats() != nil {
+		t.Error("a nil scope restricts chats")
+	}
+	if !scope.Allows(auth.RoleAdmin) || !scope.AllowsChat("any") || !scope.AllowsContent("any") || !scope.AllowsOwner(uuid.New()) {
+		t.Error("a nil scope refuses a read")
+	}
+}
+
+func TestGrant(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t, migrate.JoinedChatsTable, migrate.ChatGrantsTable)
+	for _, chatID := range []string{"one", "two"} {
+		if err := client.JoinedChat.Create().SetPlatform("telegram").SetChatID(chatID).SetChatName(chatID).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := access.Grant(ctx, client, "carol", []string{"one", "unjoined"}, auth.RoleViewer, "admin"); err == nil {
+		t.Error("a chat that is not joined was granted")
+	}
+	if err := access.Grant(ctx, client, "carol", []string{"one"}, auth.RoleAdmin, "admin"); err == nil {
+		t.Error("a chat was granted as admin")
+	}
+
+	if err := access.Grant(ctx, client, "carol", []string{"one", "two"}, auth.RoleViewer, "admin"); err != nil {
+		t.Fatal(err)
+	}
+	if err := access.Grant(ctx, client, "carol", []string{"one"}, auth.RoleCurator, "admin"); err != nil {
+		t.Fatal(err)
+	}
+	g, err := client.ChatGrant.Query().Where(chatgrant.Subject("carol"), chatgrant.ChatID("one")).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Role != chatgrant.RoleCurator {
+		t.Errorf("granting again left role %s, want curator", g.Role)
+	}
+
+	if n, err := access.Revoke(ctx, client, "carol", []string{"one", "two"}); err != nil || n != 2 {
+		t.Errorf("Revoke = %d, %v, want 2", n, err)
+	}
+}
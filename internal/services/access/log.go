@@ -0,0 +1,84 @@
+package access
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/accesslog"
+	"github.com/luoling8192/mindwave/internal/auth"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/samber/lo"
+)
+
+// methodNone records reads of an API served without authentication.
+const methodNone = "none"
+
+// Read is a response that carried raw messages.
+type Read struct {
+	// Endpoint is what was called, e.g. GET /api/v1/search.
+	Endpoint string
+	Query    string
+	Messages []*ent.ChatMessage
+}
+
+// Record stores a read of raw messages by the principal of ctx. Reads
+// without messages are not recorded.
+func Record(ctx context.Context, client *datastore.Client, read Read) error {
+	if len(read.Messages) == 0 {
+		return nil
+	}
+
+	create := client.AccessLog.Create().
+		SetEndpoint(read.Endpoint).
+		SetQuery(read.Query).
+		SetMethod(methodNone).
+		SetChatIds(lo.Uniq(lo.Map(read.Messages, func(m *ent.ChatMessage, _ int) string { return m.InChatID }))).
+		SetMessageIds(lo.Uniq(lo.Map(read.Messages, func(m *ent.ChatMessage, _ int) uuid.UUID { return m.ID })))
+	if p := auth.PrincipalFrom(ctx); p != nil {
+		create.
+			SetSubject(p.Subject).
+			SetMethod(string(p.Method)).
+			SetRole(string(p.Role))
+	}
+	if err := create.Exec(ctx); err != nil {
+		return err
+	}
+
+	metrics.RawMessageReads.WithLabelValues(read.Endpoint).Add(float64(len(read.Messages)))
+	return nil
+}
+
+// LogFilter narrows the access log, zero fields match everything.
+type LogFilter struct {
+	Subject string
+	ChatID  string
+	Since   time.Time
+	Limit   int
+}
+
+// Log returns the recorded reads, newest first.
+func Log(ctx context.Context, client *datastore.Client, filter LogFilter) ([]*ent.AccessLog, error) {
+	query := client.AccessLog.Query()
+	if filter.Subject != "" {
+		query = query.Where(accesslog.Subject(filter.Subject))
+	}
+	if filter.ChatID != "" {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(accesslog.FieldChatIds, filter.ChatID))
+		})
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where(accesslog.CreatedAtGTE(filter.Since.UnixMilli()))
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	return query.
+		Order(accesslog.ByCreatedAt(sql.OrderDesc()), accesslog.ByID(sql.OrderDesc())).
+		All(ctx)
+}
@@ -0,0 +1,503 @@
+package ask
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/askturn"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/graph"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/luoling8192/mindwave/internal/services/distill"
+	"github.com/luoling8192/mindwave/internal/services/search"
+	"github.com/pgvector/pgvector-go"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultMessageLimit = 8
+	messageContextSize  = 1
+
+	// eventLimit and neighbourLimit bound the events retrieved directly and
+	// through the graph, messagesPerEvent the messages pulled from each.
+	eventLimit       = 5
+	neighbourLimit   = 5
+	messagesPerEvent = 2
+
+	maxEvidenceMessages = 40
+	maxEvidenceRunes    = 300
+	historyTurns        = 5
+)
+
+// RefusalText is the answer given when the retrieved evidence does not
+// support one.
+const RefusalText = "Not enough evidence in the chat history to answer this question."
+
+var citationPattern = regexp.MustCompile(`\[msg:([0-9a-fA-F-]{36})\]`)
+
+// ErrUnknownConversation is returned when continuing a conversation another
+// subject started, its answers may rest on chats the caller cannot read.
+var ErrUnknownConversation = errors.New("conversation not found")
+
+type Options struct {
+	// ConversationID continues an earlier conversation, uuid.Nil starts a new one.
+	ConversationID uuid.UUID
+	// Subject is the API user asking, empty for the command line. Only the
+	// subject that started a conversation continues it.
+	Subject  string
+	Question string
+	// ChatID restricts retrieval to one chat when set.
+	ChatID string
+	// ChatIDs restricts the events retrieved to these chats unless nil,
+	// e.g. to those an API user may read.
+	ChatIDs []string
+	// MessageChatIDs restricts the messages retrieved, whose raw content
+	// goes to the model, to these chats unless nil, e.g. to those whose
+	// messages an API user may read.
+	MessageChatIDs []string
+	// Limit is the number of messages retrieved for the question itself.
+	Limit int
+}
+
+// Answer is the reply to one question. Citations are the messages the answer
+// refers to, in order of first citation, Evidence every message retrieved
+// and shown to the model.
+type Answer struct {
+	ConversationID     uuid.UUID          `json:"conversation_id"`
+	TurnID             uuid.UUID          `json:"turn_id"`
+	Question           string             `json:"question"`
+	StandaloneQuestion string             `json:"standalone_question"`
+	Text               string             `json:"answer"`
+	Refused            bool               `json:"refused"`
+	Citations          []*ent.ChatMessage `json:"citations"`
+	Events             []*ent.Event       `json:"events"`
+	Evidence           []*ent.ChatMessage `json:"-"`
+}
+
+// Asker answers questions from the chat history, retrieving events by vector
+// and keyword similarity and their graph neighbourhood, and messages with a
+// hybrid search.
+type Asker struct {
+	client         *datastore.Client
+	llmClient      *agent.LLMClient
+	searcher       *search.Searcher
+	embeddingModel agent.EmbeddingModel
+	graphWriter    *graph.Writer
+}
+
+// NewAsker builds an asker that embeds and tokenizes like searcher.
+// graphWriter may be nil, in which case events are not expanded through the
+// graph.
+func NewAsker(client *datastore.Client, llmClient *agent.LLMClient, searcher *search.Searcher, graphWriter *graph.Writer) (*Asker, error) {
+	if searcher == nil {
+		return nil, errors.New("searcher is required")
+	}
+	embeddingModel := searcher.EmbeddingModel()
+	if _, err := distill.EventVectorColumn(embeddingModel.Dimensions); err != nil {
+		return nil, err
+	}
+
+	return &Asker{
+		client:         client,
+		llmClient:      llmClient,
+		searcher:       searcher,
+		embeddingModel: embeddingModel,
+		graphWriter:    graphWriter,
+	}, nil
+}
+
+// Ask answers a question, or refuses when no retrieved message supports an
+// answer. The turn is stored so later questions in the conversation can refer
+// back to it.
+func (a *Asker) Ask(ctx context.Context, opts Options) (answer *Answer, err error) {
+	startTotal := time.Now()
+	defer func() {
+		outcome := "answered"
+		switch {
+		case err != nil:
+			outcome = "error"
+		case answer.Refused:
+			outcome = "refused"
+		}
+		metrics.AskDuration.WithLabelValues(outcome).Observe(time.Since(startTotal).Seconds())
+	}()
+
+	question := strings.TrimSpace(opts.Question)
+	if question == "" {
+		return nil, errors.New("question is required")
+	}
+	conversationID := opts.ConversationID
+	if conversationID == uuid.Nil {
+		conversationID = uuid.New()
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = defaultMessageLimit
+	}
+
+	history, err := a.history(ctx, conversationID, opts.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	standalone, err := agent.CondenseQuestion(ctx, a.llmClient, history, question)
+	if err != nil {
+		slog.Warn("failed to condense follow-up question, retrieving with it as is", "error", err)
+		standalone = question
+	}
+
+	events, err := a.retrieveEvents(ctx, standalone, opts)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := a.retrieveMessages(ctx, standalone, opts, events)
+	if err != nil {
+		return nil, err
+	}
+	metrics.AskEvidenceCount.WithLabelValues("events").Observe(float64(len(events)))
+	metrics.AskEvidenceCount.WithLabelValues("messages").Observe(float64(len(messages)))
+
+	answer = &Answer{
+		ConversationID:     conversationID,
+		Question:           question,
+		StandaloneQuestion: standalone,
+		Text:               RefusalText,
+		Refused:            true,
+		Citations:          []*ent.ChatMessage{},
+		Events:             events,
+		Evidence:           messages,
+	}
+
+	if len(messages) > 0 {
+		reply, err := agent.AnswerQuestion(ctx, a.llmClient, history, evidence(events, messages), question)
+		if err != nil {
+			return nil, err
+		}
+
+		text, cited := resolveCitations(reply, lo.KeyBy(messages, func(m *ent.ChatMessage) uuid.UUID { return m.ID }))
+		if len(cited) > 0 {
+			answer.Text, answer.Refused, answer.Citations = text, false, cited
+		}
+	}
+
+	turn, err := a.client.AskTurn.Create().
+		SetConversationID(conversationID).
+		SetSubject(opts.Subject).
+		SetQuestion(question).
+		SetStandaloneQuestion(standalone).
+		SetAnswer(answer.Text).
+		SetRefused(answer.Refused).
+		SetCitedMessageIds(lo.Map(answer.Citations, func(m *ent.ChatMessage, _ int) uuid.UUID { return m.ID })).
+		SetEventIds(lo.Map(events, func(e *ent.Event, _ int) uuid.UUID { return e.ID })).
+		SetModel(agent.AnswerModel()).
+		Save(ctx)
+	if err != nil {
+		slog.Warn("failed to store ask turn", "error", err, "conversation_id", conversationID)
+	} else {
+		answer.TurnID = turn.ID
+	}
+
+	return answer, nil
+}
+
+// history returns the latest turns of a conversation, oldest first. It
+// returns ErrUnknownConversation when another subject asked in it.
+func (a *Asker) history(ctx context.Context, conversationID uuid.UUID, subject string) ([]agent.Turn, error) {
+	foreign, err := a.client.AskTurn.Query().
+		Where(askturn.ConversationID(conversationID), askturn.SubjectNEQ(subject)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if foreign {
+		return nil, ErrUnknownConversation
+	}
+
+	turns, err := a.client.AskTurn.Query().
+		Where(askturn.ConversationID(conversationID)).
+		Order(askturn.ByCreatedAt(sql.OrderDesc())).
+		Limit(historyTurns).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(lo.Reverse(turns), func(t *ent.AskTurn, _ int) agent.Turn {
+		return agent.Turn{Question: t.Question, Answer: t.Answer}
+	}), nil
+}
+
+// retrieveEvents fuses vector and keyword matches over event descriptions and
+// adds their neighbours in the graph.
+func (a *Asker) retrieveEvents(ctx context.Context, query string, opts Options) ([]*ent.Event, error) {
+	vectorIDs, err := a.vectorEvents(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	keywordIDs, err := a.keywordEvents(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := lo.Map(lo.Slice(search.FuseRanks(vectorIDs, keywordIDs), 0, eventLimit), func(r search.Ranked, _ int) uuid.UUID {
+		return r.ID
+	})
+	if a.graphWriter != nil && len(ids) > 0 {
+		neighbours, err := a.graphWriter.EventNeighbours(ctx, lo.Map(ids, func(id uuid.UUID, _ int) string { return id.String() }), neighbourLimit)
+		if err != nil {
+			slog.Warn("failed to expand events through the graph", "error", err)
+		}
+		for _, neighbour := range neighbours {
+			if id, err := uuid.Parse(neighbour); err == nil {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return []*ent.Event{}, nil
+	}
+
+	eventQuery := a.client.Event.Query().Where(event.IDIn(ids...))
+	if opts.ChatID != "" {
+		eventQuery = eventQuery.Where(event.InChatID(opts.ChatID))
+	}
+	if opts.ChatIDs != nil {
+		// Graph neighbours may lie in chats the caller cannot read.
+		eventQuery = eventQuery.Where(event.InChatIDIn(opts.ChatIDs...))
+	}
+	events, err := eventQuery.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make(map[uuid.UUID]int, len(ids))
+	for i, id := range ids {
+		if _, ok := order[id]; !ok {
+			order[id] = i
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return order[events[i].ID] < order[events[j].ID]
+	})
+
+	return events, nil
+}
+
+func (a *Asker) vectorEvents(ctx context.Context, query string, opts Options) ([]uuid.UUID, error) {
+	column, err := distill.EventVectorColumn(a.embeddingModel.Dimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	vectors, err := agent.EmbedTexts(ctx, a.llmClient, a.embeddingModel, []string{query})
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed question: %w", err)
+	}
+
+	where, args := scopeConditions(ctx, opts, 1)
+	args = append([]any{pgvector.NewVector(vectors[0])}, args...)
+	where = append(where, column+" IS NOT NULL")
+
+	stmt := fmt.Sprintf(
+		`SELECT id FROM events WHERE %s ORDER BY %s <=> $1 LIMIT %d`,
+		strings.Join(where, " AND "),
+		column,
+		eventLimit*2,
+	)
+
+	return a.queryIDs(ctx, stmt, args...)
+}
+
+func (a *Asker) keywordEvents(ctx context.Context, query string, opts Options) ([]uuid.UUID, error) {
+	escaper := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	patterns := lo.Uniq(lo.FilterMap(a.searcher.Tokenize(query), func(t string, _ int) (string, bool) {
+		t = strings.TrimSpace(t)
+		return "%" + escaper.Replace(t) + "%", t != ""
+	}))
+	if len(patterns) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	where, args := scopeConditions(ctx, opts, 1)
+	args = append([]any{pq.Array(patterns)}, args...)
+	where = append(where, "(name ILIKE ANY($1) OR description ILIKE ANY($1))")
+
+	stmt := fmt.Sprintf(
+		`SELECT id FROM events
+WHERE %s
+ORDER BY (SELECT count(*) FROM unnest($1::text[]) AS p(pattern) WHERE description ILIKE p.pattern) DESC,
+         platform_timestamp DESC
+LIMIT %d`,
+		strings.Join(where, " AND "),
+		eventLimit*2,
+	)
+
+	return a.queryIDs(ctx, stmt, args...)
+}
+
+// scopeConditions renders the workspace of ctx and the chat restrictions of
+// opts as SQL conditions whose placeholders start after offset.
+func scopeConditions(ctx context.Context, opts Options, offset int) ([]string, []any) {
+	args := []any{datastore.WorkspaceID(ctx)}
+	conditions := []string{fmt.Sprintf("workspace_id = $%d", offset+len(args))}
+	if opts.ChatID != "" {
+		args = append(args, opts.ChatID)
+		conditions = append(conditions, fmt.Sprintf("in_chat_id = $%d", offset+len(args)))
+	}
+	if opts.ChatIDs != nil {
+		args = append(args, pq.Array(opts.ChatIDs))
+		conditions = append(conditions, fmt.Sprintf("in_chat_id = ANY($%d)", offset+len(args)))
+	}
+	return conditions, args
+}
+
+func (a *Asker) queryIDs(ctx context.Context, stmt string, args ...any) ([]uuid.UUID, error) {
+	rows, err := a.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// retrieveMessages combines a hybrid search for the question with keyword
+// matches inside the span of each retrieved event, in chronological order.
+func (a *Asker) retrieveMessages(ctx context.Context, query string, opts Options, events []*ent.Event) ([]*ent.ChatMessage, error) {
+	hits, err := a.searcher.Search(ctx, search.Options{
+		Query:       query,
+		Mode:        search.ModeHybrid,
+		Filter:      search.Filter{ChatID: opts.ChatID, ChatIDs: opts.MessageChatIDs},
+		Limit:       opts.Limit,
+		ContextSize: messageContextSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range events {
+		filter := search.Filter{ChatID: e.InChatID, ChatIDs: opts.MessageChatIDs, Until: time.Unix(max(e.SpanEnd, e.PlatformTimestamp), 0)}
+		if e.SpanStart > 0 {
+			filter.Since = time.Unix(e.SpanStart, 0)
+		}
+
+		eventHits, err := a.searcher.Search(ctx, search.Options{
+			Query:       query,
+			Mode:        search.ModeKeyword,
+			Filter:      filter,
+			Limit:       messagesPerEvent,
+			ContextSize: 0,
+		})
+		if err != nil {
+			slog.Warn("failed to search messages of event", "error", err, "event_id", e.ID)
+			continue
+		}
+		hits = append(hits, eventHits...)
+	}
+
+	seen := make(map[uuid.UUID]struct{})
+	messages := make([]*ent.ChatMessage, 0)
+	add := func(m *ent.ChatMessage) {
+		if _, ok := seen[m.ID]; ok || len(messages) >= maxEvidenceMessages {
+			return
+		}
+		seen[m.ID] = struct{}{}
+		messages = append(messages, m)
+	}
+	for _, hit := range hits {
+		add(hit.Message)
+		lo.ForEach(hit.Before, func(m *ent.ChatMessage, _ int) { add(m) })
+		lo.ForEach(hit.After, func(m *ent.ChatMessage, _ int) { add(m) })
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		if messages[i].InChatID != messages[j].InChatID {
+			return messages[i].InChatID < messages[j].InChatID
+		}
+		return messages[i].PlatformTimestamp < messages[j].PlatformTimestamp
+	})
+
+	return messages, nil
+}
+
+// evidence renders events and messages as the lines the answer model cites from.
+func evidence(events []*ent.Event, messages []*ent.ChatMessage) []string {
+	lines := make([]string, 0, len(events)+len(messages))
+	for _, e := range events {
+		lines = append(lines, fmt.Sprintf("[event:%s] %s %s: %s (%s)",
+			e.ID,
+			time.Unix(e.PlatformTimestamp, 0).Format("2006-01-02"),
+			e.Name,
+			truncateRunes(e.Description, maxEvidenceRunes),
+			strings.Join(e.Tags, ","),
+		))
+	}
+	for _, m := range messages {
+		lines = append(lines, fmt.Sprintf("[msg:%s] [%s] %s: %s",
+			m.ID,
+			time.Unix(m.PlatformTimestamp, 0).Format("2006-01-02 15:04:05"),
+			m.FromName,
+			truncateRunes(m.Content, maxEvidenceRunes),
+		))
+	}
+
+	return lines
+}
+
+// resolveCitations drops citations of messages that were not part of the
+// evidence and returns the cited messages. A reply without any valid
+// citation is treated as a refusal by the caller.
+func resolveCitations(reply string, evidence map[uuid.UUID]*ent.ChatMessage) (string, []*ent.ChatMessage) {
+	if strings.Contains(reply, agent.NoEvidenceMarker) {
+		return "", nil
+	}
+
+	cited := make([]*ent.ChatMessage, 0)
+	seen := make(map[uuid.UUID]struct{})
+	text := citationPattern.ReplaceAllStringFunc(reply, func(match string) string {
+		id, err := uuid.Parse(citationPattern.FindStringSubmatch(match)[1])
+		if err != nil {
+			return ""
+		}
+		m, ok := evidence[id]
+		if !ok {
+			return ""
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			cited = append(cited, m)
+		}
+		return match
+	})
+
+	return strings.TrimSpace(text), cited
+}
+
+func truncateRunes(s string, n int) string {
+	rs := []rune(s)
+	if len(rs) <= n {
+		return s
+	}
+
+	return string(rs[:n]) + "..."
+}
@@ -0,0 +1,332 @@
+package digest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/samber/lo"
+)
+
+type Period string
+
+const (
+	PeriodDay  Period = "day"
+	PeriodWeek Period = "week"
+)
+
+const (
+	// DefaultMessageURL links evidence to Telegram, where links to messages
+	// of supergroups use the chat ID without its -100 prefix.
+	DefaultMessageURL = "https://t.me/c/{chat_id}/{message_id}"
+
+	// otherTopic collects events without tags.
+	otherTopic = "other"
+
+	maxEvents           = 500
+	maxEvidencePerEvent = 5
+	maxParticipants     = 10
+	maxSnippetRunes     = 120
+)
+
+type Options struct {
+	// Chat is a chat ID or chat name.
+	Chat   string
+	Period Period
+	// Date is any time within the period, in the location the period is
+	// aligned to.
+	Date time.Time
+	// MessageURL is the evidence link template, {chat_id}, {message_id} and
+	// {id} are replaced with the chat ID, platform message ID and message
+	// UUID. Empty means DefaultMessageURL.
+	MessageURL string
+}
+
+// Digest is the rendered view of a chat's events over a day or a week.
+type Digest struct {
+	ChatID       string        `json:"chat_id"`
+	ChatName     string        `json:"chat_name"`
+	Period       Period        `json:"period"`
+	Start        time.Time     `json:"start"`
+	End          time.Time     `json:"end"`
+	MessageCount int           `json:"message_count"`
+	EventCount   int           `json:"event_count"`
+	Participants []Participant `json:"participants"`
+	Topics       []Topic       `json:"topics"`
+	GeneratedAt  time.Time     `json:"generated_at"`
+}
+
+type Participant struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type Topic struct {
+	Name   string  `json:"name"`
+	Events []Event `json:"events"`
+}
+
+type Event struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Tags        []string   `json:"tags"`
+	Time        time.Time  `json:"time"`
+	Mentions    []string   `json:"mentions"`
+	Evidence    []Evidence `json:"evidence"`
+}
+
+// Evidence is a message an event was extracted from.
+type Evidence struct {
+	ID       uuid.UUID `json:"id"`
+	FromName string    `json:"from_name"`
+	Snippet  string    `json:"snippet"`
+	URL      string    `json:"url"`
+}
+
+// Key identifies a digest of a chat, it is stable across rebuilds so feeds
+// and publishers can replace earlier versions.
+func (d *Digest) Key() string {
+	return fmt.Sprintf("%s-%s", d.Period, d.Start.Format(time.DateOnly))
+}
+
+// Title is the human-readable title of the digest.
+func (d *Digest) Title() string {
+	name := lo.Ternary(d.ChatName != "", d.ChatName, d.ChatID)
+	if d.Period == PeriodWeek {
+		return fmt.Sprintf("%s weekly digest, %s to %s", name, d.Start.Format(time.DateOnly), d.End.AddDate(0, 0, -1).Format(time.DateOnly))
+	}
+	return fmt.Sprintf("%s daily digest, %s", name, d.Start.Format(time.DateOnly))
+}
+
+// Bounds returns the start and end of the period containing date, days start
+// at midnight and weeks on Monday in date's location.
+func Bounds(period Period, date time.Time) (time.Time, time.Time, error) {
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	switch period {
+	case PeriodDay:
+		return start, start.AddDate(0, 0, 1), nil
+	case PeriodWeek:
+		start = start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+		return start, start.AddDate(0, 0, 7), nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("unknown period %q, expected day or week", period)
+	}
+}
+
+// Build collects a chat's events in the period, grouped by topic, with the
+// evidence messages they link to.
+func Build(ctx context.Context, client *datastore.Client, opts Options) (*Digest, error) {
+	if opts.Chat == "" {
+		return nil, errors.New("chat is required")
+	}
+	start, end, err := Bounds(opts.Period, opts.Date)
+	if err != nil {
+		return nil, err
+	}
+	messageURL := lo.Ternary(opts.MessageURL != "", opts.MessageURL, DefaultMessageURL)
+
+	d := &Digest{
+		ChatID:      opts.Chat,
+		Period:      opts.Period,
+		Start:       start,
+		End:         end,
+		GeneratedAt: time.Now(),
+	}
+	chat, err := client.JoinedChat.Query().
+		Where(joinedchat.Or(joinedchat.ChatID(opts.Chat), joinedchat.ChatNameEqualFold(opts.Chat))).
+		Order(joinedchat.ByDialogDate(sql.OrderDesc())).
+		First(ctx)
+	switch {
+	case err == nil:
+		d.ChatID, d.ChatName = chat.ChatID, chat.ChatName
+	case !ent.IsNotFound(err):
+		return nil, err
+	}
+
+	events, err := client.Event.Query().
+		Where(
+			event.InChatID(d.ChatID),
+			event.PlatformTimestampGTE(start.Unix()),
+			event.PlatformTimestampLT(end.Unix()),
+		).
+		WithIdentities().
+		Order(event.ByPlatformTimestamp(), event.ByID()).
+		Limit(maxEvents).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.EventCount = len(events)
+
+	var senders []struct {
+		FromName string `json:"from_name"`
+		Count    int    `json:"count"`
+	}
+	err = client.ChatMessage.Query().
+		Where(
+			chatmessage.InChatID(d.ChatID),
+			chatmessage.ContentNEQ(""),
+			chatmessage.DeletedAt(0),
+			owners.Canonical(),
+			chatmessage.PlatformTimestampGTE(start.Unix()),
+			chatmessage.PlatformTimestampLT(end.Unix()),
+		).
+		GroupBy(chatmessage.FieldFromName).
+		Aggregate(ent.Count()).
+		Scan(ctx, &senders)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		if senders[i].Count != senders[j].Count {
+			return senders[i].Count > senders[j].Count
+		}
+		return senders[i].FromName < senders[j].FromName
+	})
+	for _, s := range senders {
+		d.MessageCount += s.Count
+	}
+	d.Participants = make([]Participant, 0, min(len(senders), maxParticipants))
+	for _, s := range lo.Slice(senders, 0, maxParticipants) {
+		d.Participants = append(d.Participants, Participant{Name: s.FromName, Count: s.Count})
+	}
+
+	evidence, err := loadEvidence(ctx, client, events)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Topics = groupByTopic(lo.Map(events, func(e *ent.Event, _ int) Event {
+		return Event{
+			ID:          e.ID,
+			Name:        e.Name,
+			Description: e.Description,
+			Tags:        e.Tags,
+			Time:        time.Unix(e.PlatformTimestamp, 0).In(start.Location()),
+			Mentions:    mentions(e),
+			Evidence: lo.FilterMap(lo.Slice(e.EvidenceMessageIds, 0, maxEvidencePerEvent), func(id uuid.UUID, _ int) (Evidence, bool) {
+				m, ok := evidence[id]
+				if !ok {
+					return Evidence{}, false
+				}
+				return Evidence{
+					ID:       m.ID,
+					FromName: m.FromName,
+					Snippet:  snippet(m.Content),
+					URL:      expandMessageURL(messageURL, m),
+				}, true
+			}),
+		}
+	}))
+
+	return d, nil
+}
+
+func loadEvidence(ctx context.Context, client *datastore.Client, events []*ent.Event) (map[uuid.UUID]*ent.ChatMessage, error) {
+	ids := lo.Uniq(lo.FlatMap(events, func(e *ent.Event, _ int) []uuid.UUID {
+		return lo.Slice(e.EvidenceMessageIds, 0, maxEvidencePerEvent)
+	}))
+	if len(ids) == 0 {
+		return map[uuid.UUID]*ent.ChatMessage{}, nil
+	}
+
+	messages, err := client.ChatMessage.Query().
+		Where(chatmessage.IDIn(ids...), chatmessage.ContentNEQ(""), chatmessage.DeletedAt(0)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.KeyBy(messages, func(m *ent.ChatMessage) uuid.UUID { return m.ID }), nil
+}
+
+// groupByTopic files every event under its tag that is most common in the
+// period, so related events end up together. Topics with more events come
+// first.
+func groupByTopic(events []Event) []Topic {
+	counts := make(map[string]int)
+	for _, e := range events {
+		for _, tag := range lo.Uniq(lo.Map(e.Tags, func(t string, _ int) string { return topicName(t) })) {
+			if tag != "" {
+				counts[tag]++
+			}
+		}
+	}
+
+	byTopic := make(map[string][]Event)
+	for _, e := range events {
+		topic := otherTopic
+		for _, tag := range e.Tags {
+			tag = topicName(tag)
+			if tag == "" {
+				continue
+			}
+			if topic == otherTopic || counts[tag] > counts[topic] || (counts[tag] == counts[topic] && tag < topic) {
+				topic = tag
+			}
+		}
+		byTopic[topic] = append(byTopic[topic], e)
+	}
+
+	topics := lo.MapToSlice(byTopic, func(name string, events []Event) Topic {
+		return Topic{Name: name, Events: events}
+	})
+	sort.Slice(topics, func(i, j int) bool {
+		if (topics[i].Name == otherTopic) != (topics[j].Name == otherTopic) {
+			return topics[j].Name == otherTopic
+		}
+		if len(topics[i].Events) != len(topics[j].Events) {
+			return len(topics[i].Events) > len(topics[j].Events)
+		}
+		return topics[i].Name < topics[j].Name
+	})
+	return topics
+}
+
+func topicName(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// mentions are the participants of an event, by username when they have one.
+// Names the extractor could not match to an identity are kept as written.
+func mentions(e *ent.Event) []string {
+	names := lo.Map(e.Edges.Identities, func(i *ent.Identity, _ int) string {
+		if i.Username != "" {
+			return "@" + i.Username
+		}
+		return i.DisplayName
+	})
+	names = append(names, e.UnmatchedNames...)
+	if len(names) == 0 {
+		names = lo.Map(strings.Split(e.FromName, ","), func(n string, _ int) string { return strings.TrimSpace(n) })
+	}
+	return lo.Uniq(lo.Compact(names))
+}
+
+func expandMessageURL(template string, m *ent.ChatMessage) string {
+	return strings.NewReplacer(
+		"{chat_id}", strings.TrimPrefix(m.InChatID, "-100"),
+		"{message_id}", m.PlatformMessageID,
+		"{id}", m.ID.String(),
+	).Replace(template)
+}
+
+func snippet(content string) string {
+	content = strings.Join(strings.Fields(content), " ")
+	rs := []rune(content)
+	if len(rs) <= maxSnippetRunes {
+		return content
+	}
+	return string(rs[:maxSnippetRunes]) + "..."
+}
@@ -0,0 +1,193 @@
+package digest
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxFeedEntries bounds the digests kept in a chat's feed, newest first.
+const maxFeedEntries = 50
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Links     []atomLink  `xml:"link"`
+	Summary   string      `xml:"summary"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// UpdateFeed adds the digest to the Atom feed at path, replacing an earlier
+// build of the same period, and rewrites the file atomically. pageURL is
+// where the HTML digest is published, it may be relative.
+func UpdateFeed(path string, d *Digest, pageURL, feedURL string) error {
+	feed := atomFeed{}
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := xml.Unmarshal(data, &feed); err != nil {
+			return fmt.Errorf("failed to parse feed %s: %w", path, err)
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return err
+	}
+
+	content, err := d.htmlFragment()
+	if err != nil {
+		return err
+	}
+
+	entry := atomEntry{
+		ID:        fmt.Sprintf("urn:mindwave:digest:%s:%s", d.ChatID, d.Key()),
+		Title:     d.Title(),
+		Published: d.Start.UTC().Format(time.RFC3339),
+		Updated:   d.GeneratedAt.UTC().Format(time.RFC3339),
+		Links:     []atomLink{{Href: pageURL, Rel: "alternate", Type: "text/html"}},
+		Summary:   fmt.Sprintf("%d messages, %d events", d.MessageCount, d.EventCount),
+		Content:   atomContent{Type: "html", Body: content},
+	}
+
+	entries := []atomEntry{entry}
+	for _, e := range feed.Entries {
+		if e.ID != entry.ID {
+			entries = append(entries, e)
+		}
+	}
+	// Entries are ordered by period start rather than build time, so
+	// rebuilding an old digest does not move it to the top.
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Published > entries[j].Published })
+	if len(entries) > maxFeedEntries {
+		entries = entries[:maxFeedEntries]
+	}
+
+	name := d.ChatName
+	if name == "" {
+		name = d.ChatID
+	}
+	feed = atomFeed{
+		ID:      "urn:mindwave:digest:" + d.ChatID,
+		Title:   name + " digests",
+		Updated: entry.Updated,
+		Entries: entries,
+	}
+	if feedURL != "" {
+		feed.Links = []atomLink{{Href: feedURL, Rel: "self", Type: "application/atom+xml"}}
+	}
+
+	out, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteFile(path, append([]byte(xml.Header), append(out, '\n')...))
+}
+
+// WriteFile replaces the file at path through a temporary file in the same
+// directory, so readers never see a partial write.
+func WriteFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// WriteFiles writes the Markdown and HTML digest into a directory per chat
+// under dir and updates the chat's feed.atom next to them. baseURL is where
+// dir is served from, empty leaves the feed links relative. It returns the
+// paths written.
+func WriteFiles(dir, baseURL string, d *Digest) ([]string, error) {
+	chatDir := safeName(d.ChatID)
+	markdown, err := d.Markdown()
+	if err != nil {
+		return nil, err
+	}
+	page, err := d.HTML()
+	if err != nil {
+		return nil, err
+	}
+
+	mdPath := filepath.Join(dir, chatDir, d.Key()+".md")
+	htmlPath := filepath.Join(dir, chatDir, d.Key()+".html")
+	feedPath := filepath.Join(dir, chatDir, "feed.atom")
+
+	if err := WriteFile(mdPath, []byte(markdown)); err != nil {
+		return nil, err
+	}
+	if err := WriteFile(htmlPath, []byte(page)); err != nil {
+		return nil, err
+	}
+
+	pageURL, feedURL := d.Key()+".html", ""
+	if baseURL != "" {
+		base := strings.TrimSuffix(baseURL, "/") + "/" + url.PathEscape(chatDir) + "/"
+		pageURL, feedURL = base+pageURL, base+"feed.atom"
+	}
+	if err := UpdateFeed(feedPath, d, pageURL, feedURL); err != nil {
+		return nil, err
+	}
+
+	return []string{mdPath, htmlPath, feedPath}, nil
+}
+
+// ChatDir is the directory under dir WriteFiles writes the digests of a chat
+// to.
+func ChatDir(dir, chatID string) string {
+	return filepath.Join(dir, safeName(chatID))
+}
+
+// safeName turns a chat ID into a directory name.
+func safeName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, s)
+}
@@ -0,0 +1,167 @@
+package digest
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+)
+
+var funcs = map[string]any{
+	"md":   escapeMarkdown,
+	"join": strings.Join,
+}
+
+const markdownTemplate = `# {{md .Title}}
+
+{{.MessageCount}} messages, {{.EventCount}} events.
+{{- if .Participants}}
+Most active: {{range $i, $p := .Participants}}{{if $i}}, {{end}}{{md $p.Name}} ({{$p.Count}}){{end}}.
+{{- end}}
+{{range .Topics}}
+## {{md .Name}}
+{{range .Events}}
+### {{md .Name}}
+
+_{{.Time.Format "2006-01-02 15:04"}}_{{if .Mentions}} · {{md (join .Mentions ", ")}}{{end}}
+{{if .Description}}
+{{md .Description}}
+{{end}}
+{{- if .Evidence}}{{range .Evidence}}
+- [{{md .FromName}}: {{md .Snippet}}]({{.URL}})
+{{- end}}
+{{end}}
+{{- end}}
+{{- else}}
+No events were distilled in this period.
+{{end}}`
+
+// textTemplate is for chat platforms, which link bare URLs but disagree on
+// markup.
+const textTemplate = `{{.Title}}
+{{.MessageCount}} messages, {{.EventCount}} events.
+{{- range .Topics}}
+
+# {{.Name}}
+{{- range .Events}}
+
+• {{.Name}} ({{.Time.Format "01-02 15:04"}}){{if .Mentions}} · {{join .Mentions ", "}}{{end}}
+{{- if .Description}}
+{{.Description}}
+{{- end}}
+{{- range .Evidence}}
+↳ {{.FromName}}: {{.URL}}
+{{- end}}
+{{- end}}
+{{- else}}
+
+No events were distilled in this period.
+{{- end}}
+`
+
+// htmlBodyTemplate is shared by the HTML page and feed entries.
+const htmlBodyTemplate = `{{define "body"}}<p class="stats">{{.MessageCount}} messages, {{.EventCount}} events.
+{{- if .Participants}} Most active: {{range $i, $p := .Participants}}{{if $i}}, {{end}}{{$p.Name}} ({{$p.Count}}){{end}}.{{end}}</p>
+{{- range .Topics}}
+<section>
+<h2>{{.Name}}</h2>
+{{- range .Events}}
+<article>
+<h3>{{.Name}}</h3>
+<p class="meta"><time datetime="{{.Time.Format "2006-01-02T15:04:05Z07:00"}}">{{.Time.Format "2006-01-02 15:04"}}</time>{{if .Mentions}} · {{range $i, $m := .Mentions}}{{if $i}}, {{end}}<span class="mention">{{$m}}</span>{{end}}{{end}}</p>
+{{- if .Description}}
+<p>{{.Description}}</p>
+{{- end}}
+{{- if .Evidence}}
+<ul class="evidence">
+{{- range .Evidence}}
+<li><a href="{{.URL}}">{{.FromName}}</a>: {{.Snippet}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</article>
+{{- end}}
+</section>
+{{- else}}
+<p>No events were distilled in this period.</p>
+{{- end}}
+{{end}}`
+
+const htmlPageTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", "PingFang SC", "Noto Sans CJK SC", sans-serif; max-width: 48rem; margin: 2rem auto; padding: 0 1rem; line-height: 1.6; color: #222; }
+h1 { font-size: 1.6rem; }
+h2 { border-bottom: 1px solid #ddd; padding-bottom: .25rem; margin-top: 2rem; }
+h3 { font-size: 1.05rem; margin-bottom: .25rem; }
+.stats, .meta, footer { color: #666; font-size: .9rem; }
+.mention { color: #0a58ca; }
+.evidence { font-size: .9rem; color: #444; }
+a { color: #0a58ca; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{template "body" .}}
+<footer>Generated {{.GeneratedAt.Format "2006-01-02 15:04"}}</footer>
+</body>
+</html>
+`
+
+var (
+	markdown = template.Must(template.New("markdown").Funcs(funcs).Parse(markdownTemplate))
+	text     = template.Must(template.New("text").Funcs(funcs).Parse(textTemplate))
+	htmlBody = htmltemplate.Must(htmltemplate.New("digest").Parse(htmlBodyTemplate))
+	htmlPage = htmltemplate.Must(htmltemplate.Must(htmlBody.Clone()).New("page").Parse(htmlPageTemplate))
+)
+
+// Markdown renders the digest as a Markdown document.
+func (d *Digest) Markdown() (string, error) {
+	var b bytes.Buffer
+	if err := markdown.Execute(&b, d); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// Text renders the digest as plain text for posting into chats.
+func (d *Digest) Text() (string, error) {
+	var b bytes.Buffer
+	if err := text.Execute(&b, d); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// HTML renders the digest as a self-contained HTML page, with styles inlined
+// and no external resources.
+func (d *Digest) HTML() (string, error) {
+	var b bytes.Buffer
+	if err := htmlPage.ExecuteTemplate(&b, "page", d); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// htmlFragment renders the digest body without the page around it, for feed
+// entries.
+func (d *Digest) htmlFragment() (string, error) {
+	var b bytes.Buffer
+	if err := htmlBody.ExecuteTemplate(&b, "body", d); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`",
+	"[", `\[`, "]", `\]`, "#", `\#`, "<", `\<`, ">", `\>`,
+)
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
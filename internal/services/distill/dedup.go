@@ -0,0 +1,192 @@
+package distill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/pgvector/pgvector-go"
+	"github.com/samber/lo"
+)
+
+type DedupMode string
+
+const (
+	// DedupModeMerge folds a near-duplicate into the earlier canonical event.
+	DedupModeMerge DedupMode = "merge"
+	// DedupModeLink keeps both events and records a CONTINUES relation.
+	DedupModeLink DedupMode = "link"
+)
+
+// Deduplicator embeds extracted events and finds near-duplicates among the
+// earlier events of the same chat within a sliding time window.
+type Deduplicator struct {
+	client         *datastore.Client
+	llmClient      *agent.LLMClient
+	embeddingModel agent.EmbeddingModel
+	mode           DedupMode
+	threshold      float64
+	window         time.Duration
+}
+
+func NewDeduplicator(
+	client *datastore.Client,
+	llmClient *agent.LLMClient,
+	embeddingModel agent.EmbeddingModel,
+	mode DedupMode,
+	threshold float64,
+	window time.Duration,
+) (*Deduplicator, error) {
+	if mode != DedupModeMerge && mode != DedupModeLink {
+		return nil, fmt.Errorf("invalid dedup mode: %s", mode)
+	}
+	if threshold <= 0 || threshold > 1 {
+		return nil, fmt.Errorf("dedup threshold must be in (0, 1], got %f", threshold)
+	}
+	if window <= 0 {
+		return nil, errors.New("dedup window must be positive")
+	}
+	if _, err := EventVectorColumn(embeddingModel.Dimensions); err != nil {
+		return nil, err
+	}
+
+	return &Deduplicator{
+		client:         client,
+		llmClient:      llmClient,
+		embeddingModel: embeddingModel,
+		mode:           mode,
+		threshold:      threshold,
+		window:         window,
+	}, nil
+}
+
+func (d *Deduplicator) Mode() DedupMode {
+	return d.mode
+}
+
+// EventVectorColumn returns the events column holding vectors of the given size.
+func EventVectorColumn(dimensions int) (string, error) {
+	switch dimensions {
+	case 1536:
+		return event.FieldDescriptionVector1536, nil
+	case 1024:
+		return event.FieldDescriptionVector1024, nil
+	case 768:
+		return event.FieldDescriptionVector768, nil
+	default:
+		return "", fmt.Errorf("unsupported embedding dimensions: %d", dimensions)
+	}
+}
+
+func (d *Deduplicator) Embed(ctx context.Context, item agent.ExtractedItem) (pgvector.Vector, error) {
+	vectors, err := agent.EmbedTexts(ctx, d.llmClient, d.embeddingModel, []string{item.Description})
+	if err != nil {
+		return pgvector.Vector{}, err
+	}
+
+	return pgvector.NewVector(vectors[0]), nil
+}
+
+// SetVector stores vector in the description column matching the embedding model.
+func (d *Deduplicator) SetVector(create *ent.EventCreate, vector pgvector.Vector) *ent.EventCreate {
+	switch d.embeddingModel.Dimensions {
+	case 1536:
+		return create.SetDescriptionVector1536(vector)
+	case 1024:
+		return create.SetDescriptionVector1024(vector)
+	case 768:
+		return create.SetDescriptionVector768(vector)
+	default:
+		return create
+	}
+}
+
+// FindDuplicate returns the most similar earlier event in the chat, one that
+// starts no later than spanStart and ends within the window before it, if its
+// cosine similarity reaches the threshold. It returns nil when there is no
+// such event. Later events are left out, windows are distilled concurrently
+// and again, and an event must not fold into one that follows it.
+func (d *Deduplicator) FindDuplicate(ctx context.Context, inChatID string, spanStart int64, vector pgvector.Vector) (*ent.Event, float64, error) {
+	column, err := EventVectorColumn(d.embeddingModel.Dimensions)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT id, 1 - (%[1]s <=> $1) AS similarity
+FROM events
+WHERE workspace_id = $4
+  AND in_chat_id = $2
+  AND GREATEST(span_end, platform_timestamp) >= $3
+  AND COALESCE(NULLIF(span_start, 0), platform_timestamp) <= $5
+  AND %[1]s IS NOT NULL
+ORDER BY %[1]s <=> $1
+LIMIT 1`, column)
+
+	rows, err := d.client.QueryContext(ctx, stmt, vector, inChatID, spanStart-int64(d.window.Seconds()), datastore.WorkspaceID(ctx), spanStart)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var (
+		id         uuid.UUID
+		similarity float64
+	)
+	if !rows.Next() {
+		return nil, 0, rows.Err()
+	}
+	if err := rows.Scan(&id, &similarity); err != nil {
+		return nil, 0, err
+	}
+	if err := rows.Close(); err != nil {
+		return nil, 0, err
+	}
+	if similarity < d.threshold {
+		return nil, similarity, nil
+	}
+
+	duplicate, err := d.client.Event.Get(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return duplicate, similarity, nil
+}
+
+// Merge folds a newly extracted item into the canonical event, widening its
+// time span and combining evidence, tags and participants.
+func (d *Deduplicator) Merge(
+	ctx context.Context,
+	canonical *ent.Event,
+	item agent.ExtractedItem,
+	participants []string,
+	evidenceMessageIDs []uuid.UUID,
+	spanStart, spanEnd int64,
+) (*ent.Event, error) {
+	canonicalStart := lo.Ternary(canonical.SpanStart == 0, canonical.PlatformTimestamp, canonical.SpanStart)
+	canonicalEnd := max(canonical.SpanEnd, canonical.PlatformTimestamp)
+
+	names := lo.Uniq(append(strings.Split(canonical.FromName, ","), participants...))
+
+	return canonical.Update().
+		SetSpanStart(min(canonicalStart, spanStart)).
+		SetSpanEnd(max(canonicalEnd, spanEnd)).
+		SetPlatformTimestamp(max(canonical.PlatformTimestamp, spanEnd)).
+		SetEvidenceMessageIds(lo.Uniq(append(canonical.EvidenceMessageIds, evidenceMessageIDs...))).
+		SetTags(lo.Uniq(append(canonical.Tags, item.Tags...))).
+		SetFromName(strings.Join(names, ",")).
+		Save(ctx)
+}
+
+// Link makes the event built by create continue the canonical event, which
+// keeps its span, evidence and tags.
+func (d *Deduplicator) Link(create *ent.EventCreate, canonical *ent.Event) *ent.EventCreate {
+	return create.SetContinuesID(canonical.ID)
+}
@@ -0,0 +1,150 @@
+package distill_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/datastore/datastoretest"
+	"github.com/luoling8192/mindwave/internal/services/distill"
+)
+
+var a, b, c = uuid.New(), uuid.New(), uuid.New()
+
+func newDeduplicator(t *testing.T, client *datastore.Client, mode distill.DedupMode) *distill.Deduplicator {
+	t.Helper()
+	d, err := distill.NewDeduplicator(client, nil, agent.EmbeddingModel{Name: "test", Dimensions: 768}, mode, 0.9, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// canonical stores the event later windows are folded into.
+func canonical(t *testing.T, ctx context.Context, client *datastore.Client, spanStart, spanEnd, timestamp int64) *ent.Event {
+	t.Helper()
+	e, err := client.Event.Create().
+		SetPlatform("telegram").
+		SetName("release").
+		SetDescription("Alice ships the release").
+		SetFromName("Alice,Bob").
+		SetInChatID("chat").
+		SetInChatType("group").
+		SetTags([]string{"release", "go"}).
+		SetEvidenceMessageIds([]uuid.UUID{a, b}).
+		SetPlatformTimestamp(timestamp).
+		SetSpanStart(spanStart).
+		SetSpanEnd(spanEnd).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name                         string
+		spanStart, spanEnd           int64
+		itemStart, itemEnd           int64
+		wantStart, wantEnd, wantTime int64
+	}{
+		{name: "widened on both sides", spanStart: 100, spanEnd: 200, itemStart: 50, itemEnd: 250, wantStart: 50, wantEnd: 250, wantTime: 250},
+		{name: "widened to a later window", spanStart: 100, spanEnd: 200, itemStart: 150, itemEnd: 300, wantStart: 100, wantEnd: 300, wantTime: 300},
+		{name: "within the span", spanStart: 100, spanEnd: 200, itemStart: 120, itemEnd: 180, wantStart: 100, wantEnd: 200, wantTime: 200},
+		// Events stored before spans were recorded only have a timestamp.
+		{name: "without a span", spanStart: 0, spanEnd: 0, itemStart: 150, itemEnd: 180, wantStart: 150, wantEnd: 200, wantTime: 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := datastoretest.NewClient(t, migrate.EventsTable)
+			d := newDeduplicator(t, client, distill.DedupModeMerge)
+
+			e := canonical(t, ctx, client, tt.spanStart, tt.spanEnd, 200)
+			item := agent.ExtractedItem{Description: "Alice released it", Tags: []string{"go", "deploy"}}
+			merged, err := d.Merge(ctx, e, item, []string{"Bob", "Carol"}, []uuid.UUID{b, c}, tt.itemStart, tt.itemEnd)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if merged.ID != e.ID {
+				t.Errorf("merge stored a new event %s", merged.ID)
+			}
+			if merged.SpanStart != tt.wantStart || merged.SpanEnd != tt.wantEnd || merged.PlatformTimestamp != tt.wantTime {
+				t.Errorf("span = [%d, %d] at %d, want [%d, %d] at %d",
+					merged.SpanStart, merged.SpanEnd, merged.PlatformTimestamp, tt.wantStart, tt.wantEnd, tt.wantTime)
+			}
+			if want := []uuid.UUID{a, b, c}; !slices.Equal(merged.EvidenceMessageIds, want) {
+				t.Errorf("evidence = %v, want %v", merged.EvidenceMessageIds, want)
+			}
+			if want := []string{"release", "go", "deploy"}; !slices.Equal(merged.Tags, want) {
+				t.Errorf("tags = %v, want %v", merged.Tags, want)
+			}
+			if want := "Alice,Bob,Carol"; merged.FromName != want {
+				t.Errorf("from name = %q, want %q", merged.FromName, want)
+			}
+			if merged.Description != e.Description {
+				t.Errorf("description changed to %q", merged.Description)
+			}
+
+			stored, err := client.Event.Get(ctx, e.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stored.SpanStart != tt.wantStart || !slices.Equal(stored.EvidenceMessageIds, merged.EvidenceMessageIds) {
+				t.Errorf("the merge was not stored: %+v", stored)
+			}
+		})
+	}
+}
+
+func TestLink(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t, migrate.EventsTable)
+	d := newDeduplicator(t, client, distill.DedupModeLink)
+	if d.Mode() != distill.DedupModeLink {
+		t.Fatalf("mode = %s", d.Mode())
+	}
+
+	e := canonical(t, ctx, client, 100, 200, 200)
+	create := client.Event.Create().
+		SetPlatform("telegram").
+		SetName("release").
+		SetDescription("Alice released it").
+		SetFromName("Bob,Carol").
+		SetInChatID("chat").
+		SetInChatType("group").
+		SetTags([]string{"go", "deploy"}).
+		SetEvidenceMessageIds([]uuid.UUID{b, c}).
+		SetPlatformTimestamp(300).
+		SetSpanStart(250).
+		SetSpanEnd(300)
+	linked, err := d.Link(create, e).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if linked.ID == e.ID || linked.ContinuesID == nil || *linked.ContinuesID != e.ID {
+		t.Errorf("linked event %s continues %v, want a new event continuing %s", linked.ID, linked.ContinuesID, e.ID)
+	}
+	if linked.SpanStart != 250 || linked.SpanEnd != 300 || !slices.Equal(linked.EvidenceMessageIds, []uuid.UUID{b, c}) || !slices.Equal(linked.Tags, []string{"go", "deploy"}) {
+		t.Errorf("the linked event took over the canonical one: %+v", linked)
+	}
+
+	stored, err := client.Event.Get(ctx, e.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.SpanStart != 100 || stored.SpanEnd != 200 || stored.PlatformTimestamp != 200 ||
+		!slices.Equal(stored.EvidenceMessageIds, []uuid.UUID{a, b}) || !slices.Equal(stored.Tags, []string{"release", "go"}) ||
+		stored.FromName != "Alice,Bob" {
+		t.Errorf("linking changed the canonical event: %+v", stored)
+	}
+}
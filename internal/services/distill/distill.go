@@ -2,6 +2,7 @@ package distill
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -12,12 +13,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/luoling8192/mindwave/ent"
 	"github.com/luoling8192/mindwave/ent/chatmessage"
-	"github.com/luoling8192/mindwave/ent/identity"
 	"github.com/luoling8192/mindwave/ent/joinedchat"
 	"github.com/luoling8192/mindwave/internal/agent"
 	"github.com/luoling8192/mindwave/internal/datastore"
 	"github.com/luoling8192/mindwave/internal/graph"
+	"github.com/luoling8192/mindwave/internal/jobs"
 	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/luoling8192/mindwave/internal/names"
+	"github.com/luoling8192/mindwave/internal/redact"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/luoling8192/mindwave/internal/services/persons"
+	"github.com/pgvector/pgvector-go"
 	"github.com/samber/lo"
 )
 
@@ -32,6 +38,51 @@ func truncateRunes(s string, n int) string {
 	return string(rs[:n]) + "..."
 }
 
+// Options carries the optional stages and tunables of a distill round.
+type Options struct {
+	// Deduplicator embeds events and folds near-duplicates, nil disables it.
+	Deduplicator *Deduplicator
+	// MatchThreshold is the minimum fuzzy score for linking an extracted
+	// participant name to an identity, 0 uses names.DefaultMatchThreshold.
+	MatchThreshold float64
+	// DeferFailures enqueues embed and graph sync jobs for events whose
+	// embedding or graph writes failed, so a worker retries them.
+	DeferFailures bool
+	// UpdateProfiles enqueues a profile job for every identity linked to a
+	// stored event, so a worker folds the event into its profile.
+	UpdateProfiles bool
+	// MonthlyBudget refuses new runs with ErrBudgetExceeded once the runs of
+	// the month cost this much in USD, 0 is unlimited.
+	MonthlyBudget float64
+	// Redactor replaces sensitive values in message content before it is
+	// sent to the LLM, nil sends it as is.
+	Redactor *redact.Redactor
+}
+
+// anonymousName replaces the names of members who opted out in the messages
+// of others.
+const anonymousName = "[anonymous]"
+
+// anonymize replaces the given names in content. Single-rune names are left,
+// they would match inside unrelated words.
+func anonymize(content string, hidden []string) string {
+	for _, name := range hidden {
+		if len([]rune(name)) < 2 {
+			continue
+		}
+		content = strings.ReplaceAll(content, name, anonymousName)
+	}
+	return content
+}
+
+// restoreItem puts the values redaction allows back into an extracted item.
+func restoreItem(redaction *redact.Session, item agent.ExtractedItem) agent.ExtractedItem {
+	item.FromName = lo.Map(item.FromName, func(name string, _ int) string { return redaction.Restore(name) })
+	item.Tags = lo.Map(item.Tags, func(tag string, _ int) string { return redaction.Restore(tag) })
+	item.Description = redaction.Restore(item.Description)
+	return item
+}
+
 func DistillOneRound(
 	ctx context.Context,
 	client *datastore.Client,
@@ -43,6 +94,7 @@ func DistillOneRound(
 	start, end time.Time,
 	llmClient *agent.LLMClient,
 	graphWriter *graph.Writer,
+	opts Options,
 ) (extractedItems []agent.ExtractedItem, err error) {
 	startTotal := time.Now()
 	defer func() {
@@ -60,8 +112,11 @@ func DistillOneRound(
 		Where(
 			chatmessage.InChatID(grouped[selectedIdx].InChatID),
 			chatmessage.ContentNEQ(""),
+			chatmessage.DeletedAt(0),
+			// A message stored by several crawling accounts is distilled once.
+			owners.Canonical(),
 			chatmessage.PlatformTimestampGTE(start.Unix()),
-			chatmessage.PlatformTimestampLTE(end.Unix()),
+			chatmessage.PlatformTimestampLT(end.Unix()),
 		).
 		Select(
 			chatmessage.FieldID,
@@ -85,54 +140,118 @@ func DistillOneRound(
 
 	slog.Info("Chat messages fetched", "count", len(messages), "query_duration", time.Since(queryDurationStart))
 
+	// Quiet windows are normal for scheduled runs, there is nothing to do.
+	if len(messages) == 0 {
+		return []agent.ExtractedItem{}, nil
+	}
+
+	// Runs already under way when the budget runs out still finish, so the
+	// spend can overshoot it by their cost.
+	if err := checkBudget(ctx, client, opts.MonthlyBudget); err != nil {
+		return nil, err
+	}
+
+	run, err := client.DistillRun.Create().
+		SetInChatID(grouped[selectedIdx].InChatID).
+		SetSpanStart(start.Unix()).
+		SetSpanEnd(end.Unix()).
+		SetMessageCount(len(messages)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record distill run: %w", err)
+	}
+	recorder := agent.NewUsageRecorder()
+	ctx = agent.WithUsageRecorder(ctx, recorder)
+	var outcome runOutcome
+	defer func() {
+		outcome.err = err
+		var partial *agent.PartialError
+		if errors.As(err, &partial) {
+			outcome.partialOutput = partial.Output
+		}
+		if finishErr := finishRun(ctx, run, recorder, outcome); finishErr != nil {
+			slog.Warn("failed to record distill run outcome", "error", finishErr, "run_id", run.ID)
+		}
+		usage := recorder.Total()
+		slog.Info("Distill run finished",
+			"run_id", run.ID,
+			"prompt_tokens", usage.PromptTokens,
+			"completion_tokens", usage.CompletionTokens,
+			"cost", usage.Cost,
+		)
+	}()
+
+	// Resolve identities first so messages of members who opted out are left
+	// out and their names are taken out of the messages of the others.
+	identities := make(map[string]*ent.Identity)
+	identityNames := make(map[string][]string)
+	optedOut := make(map[string]bool)
+	var optedOutNames []string
+	for _, message := range messages {
+		identityKey := message.Platform + ":" + message.FromID
+		if optedOut[identityKey] {
+			optedOutNames = append(optedOutNames, message.FromName)
+			continue
+		}
+		ident, ok := identities[identityKey]
+		if !ok {
+			// Messages are ordered newest first, so the first name seen is the current one.
+			var err error
+			ident, err = persons.EnsureIdentity(ctx, client, message.Platform, message.FromID, message.FromName)
+			if err != nil {
+				slog.Error("failed to create identity", "error", err, "from_id", message.FromID, "from_name", message.FromName)
+				continue
+			}
+			if ident.OptedOut {
+				optedOut[identityKey] = true
+				optedOutNames = append(optedOutNames, message.FromName, ident.DisplayName, ident.Username)
+				optedOutNames = append(optedOutNames, ident.AltIds...)
+				continue
+			}
+			identities[identityKey] = ident
+		}
+		if !lo.Contains(identityNames[identityKey], message.FromName) {
+			identityNames[identityKey] = append(identityNames[identityKey], message.FromName)
+		}
+	}
+	optedOutNames = lo.Uniq(lo.Compact(optedOutNames))
+
+	redaction := opts.Redactor.NewSession()
 	formattedMsgs := make([]string, 0, len(messages))
-	nameToIdentity := make(map[string]struct {
-		platform string
-		userID   string
-	})
 	messageIDs := make([]uuid.UUID, 0, len(messages))
 	var inChatType string
 	for _, message := range messages {
-		messageIDs = append(messageIDs, message.ID)
-		if _, ok := nameToIdentity[message.FromName]; !ok {
-			nameToIdentity[message.FromName] = struct {
-				platform string
-				userID   string
-			}{platform: message.Platform, userID: message.FromID}
+		if optedOut[message.Platform+":"+message.FromID] {
+			metrics.DistillItemsCount.WithLabelValues("messages_opted_out").Inc()
+			continue
 		}
+		messageIDs = append(messageIDs, message.ID)
 
 		replyMsg := ""
 		if message.ReplyToID != "" {
 			replyContent, ok := lo.Find(messages, func(m *ent.ChatMessage) bool {
 				return m.PlatformMessageID == message.ReplyToID
 			})
-			if ok {
-				replyMsg = fmt.Sprintf("(reply to: %s)", truncateRunes(replyContent.Content, defaultMaxReplyLength))
+			if ok && !optedOut[replyContent.Platform+":"+replyContent.FromID] {
+				replyMsg = fmt.Sprintf("(reply to: %s)", truncateRunes(redaction.Redact(anonymize(replyContent.Content, optedOutNames)), defaultMaxReplyLength))
 			}
 		}
 
 		formattedMsgs = append(formattedMsgs, fmt.Sprintf("[%s] %s: %s %s",
 			time.Unix(message.PlatformTimestamp, 0).Format("2006-01-02 15:04:05"),
 			message.FromName,
-			message.Content,
+			redaction.Redact(anonymize(message.Content, optedOutNames)),
 			replyMsg,
 		))
+	}
 
-		err := client.Identity.Create().
-			SetPlatform(message.Platform).
-			SetPlatformUserID(message.FromID).
-			SetDisplayName(message.FromName).
-			OnConflictColumns(identity.FieldPlatform, identity.FieldPlatformUserID).
-			Update(func(u *ent.IdentityUpsert) {
-				u.SetDisplayName(message.FromName)
-			}).
-			Exec(ctx)
-		if err != nil {
-			slog.Error("failed to create identity", "error", err, "from_id", message.FromID, "from_name", message.FromName)
-			continue
-		}
+	// Nothing is left when only members who opted out spoke.
+	if len(formattedMsgs) == 0 {
+		return []agent.ExtractedItem{}, nil
 	}
 
+	matcher := newParticipantMatcher(identities, identityNames, opts.MatchThreshold)
+
 	if inChatType == "" {
 		joined, err := client.JoinedChat.Query().
 			Where(joinedchat.ChatIDEQ(grouped[selectedIdx].InChatID)).
@@ -145,7 +264,7 @@ func DistillOneRound(
 		}
 	}
 
-	slog.Info("Messages processed", "count", len(formattedMsgs), "duration", time.Since(startTotal))
+	slog.Info("Messages processed", "count", len(formattedMsgs), "redacted", redaction.Counts(), "duration", time.Since(startTotal))
 
 	summaryDurationStart := time.Now()
 	summary, err := agent.SummaryMessages(ctx, llmClient, formattedMsgs)
@@ -157,6 +276,22 @@ func DistillOneRound(
 	metrics.DistillDuration.WithLabelValues("summarize", "success").Observe(time.Since(summaryDurationStart).Seconds())
 	slog.Info("Summary generated", "summary", summary, "duration", time.Since(summaryDurationStart))
 
+	platform := messages[0].Platform
+	err = client.Summary.Create().
+		SetPlatform(platform).
+		SetInChatID(grouped[selectedIdx].InChatID).
+		SetInChatType(inChatType).
+		SetContent(redaction.Restore(summary)).
+		SetModel(agent.SummarizerModel()).
+		SetMessageCount(len(messages)).
+		SetSpanStart(start.Unix()).
+		SetSpanEnd(end.Unix()).
+		SetPlatformTimestamp(end.Unix()).
+		Exec(ctx)
+	if err != nil {
+		slog.Warn("failed to store summary", "error", err, "in_chat_id", grouped[selectedIdx].InChatID)
+	}
+
 	extractedItemsDurationStart := time.Now()
 	extractedItems, err = agent.ExtractSummary(ctx, llmClient, summary)
 	if err != nil {
@@ -165,82 +300,127 @@ func DistillOneRound(
 		return nil, err
 	}
 	metrics.DistillDuration.WithLabelValues("extract", "success").Observe(time.Since(extractedItemsDurationStart).Seconds())
+	for i := range extractedItems {
+		extractedItems[i] = restoreItem(redaction, extractedItems[i])
+		// Names of members who opted out are never linked to an event, even
+		// when the model recovers them from context.
+		extractedItems[i].FromName = lo.Reject(extractedItems[i].FromName, func(name string, _ int) bool {
+			return lo.ContainsBy(optedOutNames, func(n string) bool { return names.Normalize(n) == names.Normalize(name) })
+		})
+	}
 	metrics.DistillItemsCount.WithLabelValues("items_extracted").Add(float64(len(extractedItems)))
+	outcome.items = len(extractedItems)
 
 	slog.Info("Extracted items", "count", len(extractedItems), "duration", time.Since(extractedItemsDurationStart))
 
+	stored := make([]uuid.UUID, 0, len(extractedItems))
+	defer func() {
+		if opts.UpdateProfiles {
+			enqueueProfiles(context.WithoutCancel(ctx), client, stored)
+		}
+	}()
+
 	for _, item := range extractedItems {
+		// Stop storing events once interrupted, the run is recorded as failed
+		// with the events stored so far.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		participants := item.FromName
 		if len(participants) == 0 {
 			participants = []string{"unknown"}
 		}
 		name := truncateRunes(item.Description, 64)
 		fromName := strings.Join(participants, ",")
-		platform := ""
-		if len(messages) > 0 {
-			platform = messages[0].Platform
-		}
-
-		eventEntity, err := client.Event.Create().
-			SetPlatform(platform).
-			SetName(name).
-			SetTags(item.Tags).
-			SetDescription(item.Description).
-			SetFromName(fromName).
-			SetInChatID(grouped[selectedIdx].InChatID).
-			SetInChatType(inChatType).
-			SetPlatformTimestamp(end.Unix()).
-			SetEvidenceMessageIDs(messageIDs).
-			Save(ctx)
-		if err != nil {
-			slog.Error("failed to create event", "error", err, "name", name)
-			continue
+		matched, unmatched := matcher.resolve(item.FromName)
+
+		var (
+			vector    *pgvector.Vector
+			duplicate *ent.Event
+		)
+		deduplicator := opts.Deduplicator
+		if deduplicator != nil {
+			v, err := deduplicator.Embed(ctx, item)
+			if err != nil {
+				slog.Warn("failed to embed event", "error", err, "name", name)
+			} else {
+				vector = &v
+				duplicate, _, err = deduplicator.FindDuplicate(ctx, grouped[selectedIdx].InChatID, start.Unix(), v)
+				if err != nil {
+					slog.Warn("failed to look up duplicate events", "error", err, "name", name)
+				}
+			}
 		}
 
-		for _, pname := range participants {
-			ident, ok := nameToIdentity[pname]
-			if !ok {
+		var eventEntity *ent.Event
+		if duplicate != nil && deduplicator.Mode() == DedupModeMerge {
+			eventEntity, err = deduplicator.Merge(ctx, duplicate, item, participants, messageIDs, start.Unix(), end.Unix())
+			if err != nil {
+				slog.Error("failed to merge event", "error", err, "name", name, "event_id", duplicate.ID)
 				continue
 			}
-			identityEntity, err := client.Identity.Query().
-				Where(
-					identity.PlatformEQ(ident.platform),
-					identity.PlatformUserIDEQ(ident.userID),
-				).
-				Only(ctx)
+			if len(unmatched) > 0 {
+				eventEntity, err = eventEntity.Update().AppendUnmatchedNames(unmatched).Save(ctx)
+				if err != nil {
+					slog.Error("failed to store unmatched names", "error", err, "event_id", duplicate.ID)
+					continue
+				}
+			}
+			metrics.DistillItemsCount.WithLabelValues("events_merged").Inc()
+			slog.Info("Merged duplicate event", "name", name, "event_id", eventEntity.ID)
+		} else {
+			create := client.Event.Create().
+				SetPlatform(platform).
+				SetName(name).
+				SetTags(item.Tags).
+				SetDescription(item.Description).
+				SetFromName(fromName).
+				SetInChatID(grouped[selectedIdx].InChatID).
+				SetInChatType(inChatType).
+				SetPlatformTimestamp(end.Unix()).
+				SetSpanStart(start.Unix()).
+				SetSpanEnd(end.Unix()).
+				SetEvidenceMessageIds(messageIDs).
+				SetUnmatchedNames(unmatched)
+			if vector != nil {
+				create = deduplicator.SetVector(create, *vector)
+			}
+			if duplicate != nil {
+				create = deduplicator.Link(create, duplicate)
+			}
+
+			eventEntity, err = create.Save(ctx)
 			if err != nil {
-				slog.Warn("failed to resolve identity for event", "error", err, "name", pname)
+				slog.Error("failed to create event", "error", err, "name", name)
 				continue
 			}
-			if err := eventEntity.Update().AddIdentities(identityEntity).Exec(ctx); err != nil {
-				slog.Warn("failed to link identity to event", "error", err, "event_id", eventEntity.ID, "identity_id", identityEntity.ID)
+			if duplicate != nil {
+				metrics.DistillItemsCount.WithLabelValues("events_linked").Inc()
 			}
 		}
+		outcome.events++
+		stored = append(stored, eventEntity.ID)
 
-		if graphWriter != nil {
-			if err := graphWriter.UpsertEvent(ctx, eventEntity, item.Tags, messageIDs); err != nil {
-				slog.Warn("failed to write event to graph", "error", err, "event_id", eventEntity.ID)
+		if len(matched) > 0 {
+			if err := eventEntity.Update().AddIdentities(matched...).Exec(ctx); err != nil {
+				slog.Warn("failed to link identities to event", "error", err, "event_id", eventEntity.ID)
 			}
-			for _, pname := range participants {
-				ident, ok := nameToIdentity[pname]
-				if !ok {
-					continue
-				}
-				if err := graphWriter.UpsertPerson(ctx, ident.platform, ident.userID, pname); err != nil {
-					slog.Warn("failed to write person to graph", "error", err, "name", pname)
-					continue
-				}
-				if err := graphWriter.LinkPersonEvent(ctx, ident.platform, ident.userID, eventEntity.ID.String()); err != nil {
-					slog.Warn("failed to link person to event in graph", "error", err, "name", pname, "event_id", eventEntity.ID)
-				}
+		}
+
+		if deduplicator != nil && vector == nil && opts.DeferFailures {
+			if err := deferJob(ctx, client, jobs.KindEmbed, eventEntity.ID); err != nil {
+				slog.Warn("failed to enqueue event embedding", "error", err, "event_id", eventEntity.ID)
 			}
-			for _, tag := range item.Tags {
-				if err := graphWriter.UpsertTopic(ctx, tag); err != nil {
-					slog.Warn("failed to write topic to graph", "error", err, "tag", tag)
-					continue
-				}
-				if err := graphWriter.LinkEventTopic(ctx, eventEntity.ID.String(), tag); err != nil {
-					slog.Warn("failed to link event to topic in graph", "error", err, "event_id", eventEntity.ID, "tag", tag)
+		}
+
+		if graphWriter != nil {
+			if err := SyncEvent(ctx, graphWriter, eventEntity, matched); err != nil {
+				slog.Warn("failed to write event to graph", "error", err, "event_id", eventEntity.ID)
+				if opts.DeferFailures {
+					if err := deferJob(ctx, client, jobs.KindGraphSync, eventEntity.ID); err != nil {
+						slog.Warn("failed to enqueue graph sync", "error", err, "event_id", eventEntity.ID)
+					}
 				}
 			}
 		}
@@ -0,0 +1,70 @@
+package distill
+
+import (
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/luoling8192/mindwave/internal/names"
+	"github.com/samber/lo"
+)
+
+// participantMatcher links participant names written by the extractor to the
+// identities that posted in the distilled window.
+type participantMatcher struct {
+	matcher    *names.Matcher
+	identities map[string]*ent.Identity
+}
+
+// newParticipantMatcher indexes identities by key together with every name
+// they used in the window, their username and their alt_ids. Candidates are
+// sorted by key, the first wins when identities share a name, so the same
+// window always resolves to the same identities.
+func newParticipantMatcher(identities map[string]*ent.Identity, identityNames map[string][]string, threshold float64) *participantMatcher {
+	candidates := make([]names.Candidate, 0, len(identities))
+	for _, key := range slices.Sorted(maps.Keys(identities)) {
+		ident := identities[key]
+		candidates = append(candidates, names.Candidate{
+			ID:        key,
+			Names:     lo.Uniq(append(append([]string{}, identityNames[key]...), ident.DisplayName)),
+			Usernames: []string{ident.Username},
+			AltIDs:    ident.AltIds,
+		})
+	}
+
+	return &participantMatcher{
+		matcher:    names.NewMatcher(candidates, threshold),
+		identities: identities,
+	}
+}
+
+// resolve returns the distinct identities the participants refer to and the
+// names that could not be matched.
+func (m *participantMatcher) resolve(participants []string) ([]*ent.Identity, []string) {
+	matched := make([]*ent.Identity, 0, len(participants))
+	unmatched := make([]string, 0)
+	for _, participant := range participants {
+		participant = strings.TrimSpace(participant)
+		if participant == "" {
+			continue
+		}
+
+		key, method, ok := m.matcher.Match(participant)
+		metrics.ParticipantMatchCount.WithLabelValues(string(method)).Inc()
+		if !ok {
+			slog.Warn("failed to match participant to identity", "name", participant)
+			unmatched = append(unmatched, participant)
+			continue
+		}
+		if method == names.MatchFuzzy {
+			slog.Info("Participant matched fuzzily", "name", participant, "identity", m.identities[key].DisplayName)
+		}
+
+		matched = append(matched, m.identities[key])
+	}
+
+	return lo.UniqBy(matched, func(i *ent.Identity) string { return i.ID.String() }), lo.Uniq(unmatched)
+}
@@ -0,0 +1,101 @@
+package distill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/distillrun"
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/schema"
+)
+
+// ErrBudgetExceeded refuses a distill run once the LLM spend of the month
+// reached the budget.
+var ErrBudgetExceeded = errors.New("monthly llm budget exceeded")
+
+// MonthStart returns the start of the calendar month of t, in UTC.
+func MonthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// MonthlySpend returns the cost of the workspace's distill runs started in
+// the calendar month of now, in UTC.
+func MonthlySpend(ctx context.Context, client *datastore.Client, now time.Time) (float64, error) {
+	rows, err := client.QueryContext(ctx,
+		"SELECT COALESCE(SUM(cost), 0) FROM distill_runs WHERE workspace_id = $1 AND created_at >= $2",
+		datastore.WorkspaceID(ctx),
+		MonthStart(now).UnixMilli(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var spend float64
+	if rows.Next() {
+		if err := rows.Scan(&spend); err != nil {
+			return 0, err
+		}
+	}
+	return spend, rows.Err()
+}
+
+// checkBudget returns ErrBudgetExceeded when the spend of the month reached
+// budget, a budget of 0 is unlimited.
+func checkBudget(ctx context.Context, client *datastore.Client, budget float64) error {
+	if budget <= 0 {
+		return nil
+	}
+
+	spend, err := MonthlySpend(ctx, client, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to check llm budget: %w", err)
+	}
+	if spend >= budget {
+		return fmt.Errorf("%w: spent %.4f of %.4f USD", ErrBudgetExceeded, spend, budget)
+	}
+	return nil
+}
+
+// runOutcome is what a run got done before it finished or was interrupted.
+type runOutcome struct {
+	items         int
+	events        int
+	partialOutput string
+	err           error
+}
+
+// finishRun records the outcome and usage of a run. It runs even when ctx was
+// cancelled, so interrupted runs are recorded as failed with their partial
+// results.
+func finishRun(ctx context.Context, run *ent.DistillRun, recorder *agent.UsageRecorder, outcome runOutcome) error {
+	usage := recorder.Total()
+	stageUsage := make(map[string]schema.StageUsage)
+	for stage, u := range recorder.ByStage() {
+		stageUsage[stage] = schema.StageUsage{
+			PromptTokens:     u.PromptTokens,
+			CompletionTokens: u.CompletionTokens,
+			Cost:             u.Cost,
+		}
+	}
+
+	update := run.Update().
+		SetStatus(distillrun.StatusSucceeded).
+		SetItemCount(outcome.items).
+		SetEventCount(outcome.events).
+		SetPromptTokens(usage.PromptTokens).
+		SetCompletionTokens(usage.CompletionTokens).
+		SetCost(usage.Cost).
+		SetStageUsage(stageUsage).
+		SetPartialOutput(outcome.partialOutput).
+		SetFinishedAt(time.Now().UnixMilli())
+	if outcome.err != nil {
+		update.SetStatus(distillrun.StatusFailed).SetError(outcome.err.Error())
+	}
+	return update.Exec(context.WithoutCancel(ctx))
+}
@@ -0,0 +1,157 @@
+package distill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/graph"
+	"github.com/luoling8192/mindwave/internal/jobs"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/pgvector/pgvector-go"
+)
+
+// SyncEvent writes an event with its tags, continued event and the persons of
+// its identities to the graph. Identities without a person are logged,
+// counted and skipped. Every write is attempted, the failures are returned
+// joined.
+func SyncEvent(ctx context.Context, graphWriter *graph.Writer, eventEntity *ent.Event, identities []*ent.Identity) error {
+	if err := graphWriter.UpsertEvent(ctx, eventEntity, eventEntity.Tags, eventEntity.EvidenceMessageIds); err != nil {
+		// Nothing can be linked to a missing event node.
+		return fmt.Errorf("failed to write event to graph: %w", err)
+	}
+
+	var errs []error
+	if eventEntity.ContinuesID != nil {
+		if err := graphWriter.LinkEventContinues(ctx, eventEntity.ID.String(), eventEntity.ContinuesID.String()); err != nil {
+			errs = append(errs, fmt.Errorf("failed to link continued event in graph: %w", err))
+		}
+	}
+	for _, ident := range identities {
+		// EnsureIdentity gives every identity it tracks a person, one without
+		// has no person node to link.
+		if ident.PersonID == nil {
+			slog.Warn("skipping identity without a person in graph", "identity_id", ident.ID, "event_id", eventEntity.ID)
+			metrics.DistillItemsCount.WithLabelValues("graph_identities_skipped").Inc()
+			continue
+		}
+		personUUID := ident.PersonID.String()
+		if err := graphWriter.UpsertPerson(ctx, personUUID, ident.DisplayName); err != nil {
+			errs = append(errs, fmt.Errorf("failed to write person %s to graph: %w", personUUID, err))
+			continue
+		}
+		if err := graphWriter.AbsorbIdentity(ctx, personUUID, ident.Platform, ident.PlatformUserID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to absorb identity %s in graph: %w", ident.ID, err))
+		}
+		if err := graphWriter.LinkPersonEvent(ctx, personUUID, eventEntity.ID.String()); err != nil {
+			errs = append(errs, fmt.Errorf("failed to link person %s to event in graph: %w", personUUID, err))
+		}
+	}
+	for _, tag := range eventEntity.Tags {
+		if err := graphWriter.UpsertTopic(ctx, tag); err != nil {
+			errs = append(errs, fmt.Errorf("failed to write topic %q to graph: %w", tag, err))
+			continue
+		}
+		if err := graphWriter.LinkEventTopic(ctx, eventEntity.ID.String(), tag); err != nil {
+			errs = append(errs, fmt.Errorf("failed to link event to topic %q in graph: %w", tag, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SyncEvents loads events with their identities and writes them to the graph,
+// as SyncEvent. Events deleted since are skipped.
+func SyncEvents(ctx context.Context, client *datastore.Client, graphWriter *graph.Writer, ids []uuid.UUID) error {
+	events, err := client.Event.Query().
+		Where(event.IDIn(ids...)).
+		WithIdentities().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, e := range events {
+		if err := SyncEvent(ctx, graphWriter, e, e.Edges.Identities); err != nil {
+			errs = append(errs, fmt.Errorf("event %s: %w", e.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// EmbedEvents stores description vectors for the events that lack one.
+func (d *Deduplicator) EmbedEvents(ctx context.Context, ids []uuid.UUID) error {
+	column, err := EventVectorColumn(d.embeddingModel.Dimensions)
+	if err != nil {
+		return err
+	}
+
+	events, err := d.client.Event.Query().
+		Where(
+			event.IDIn(ids...),
+			func(s *sql.Selector) { s.Where(sql.IsNull(s.C(column))) },
+		).
+		Select(event.FieldID, event.FieldDescription).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	texts := make([]string, len(events))
+	for i, e := range events {
+		texts[i] = e.Description
+	}
+	vectors, err := agent.EmbedTexts(ctx, d.llmClient, d.embeddingModel, texts)
+	if err != nil {
+		return err
+	}
+
+	stmt := fmt.Sprintf("UPDATE events SET %s = $1 WHERE id = $2", column)
+	for i, e := range events {
+		if _, err := d.client.ExecContext(ctx, stmt, pgvector.NewVector(vectors[i]), e.ID); err != nil {
+			return fmt.Errorf("failed to store vector of event %s: %w", e.ID, err)
+		}
+	}
+	return nil
+}
+
+// deferJob hands a failed step of an event to the job queue, so a worker
+// retries it later.
+func deferJob(ctx context.Context, client *datastore.Client, kind jobs.Kind, eventID uuid.UUID) error {
+	_, err := jobs.Enqueue(ctx, client, kind, jobs.EventsPayload{EventIDs: []uuid.UUID{eventID}}, jobs.EnqueueOptions{})
+	return err
+}
+
+// enqueueProfiles enqueues a profile job for every identity linked to the
+// events. Merged events keep the identities linked before, their profiles
+// fold the merge in as well.
+func enqueueProfiles(ctx context.Context, client *datastore.Client, eventIDs []uuid.UUID) {
+	if len(eventIDs) == 0 {
+		return
+	}
+
+	ids, err := client.Identity.Query().
+		Where(identity.HasEventsWith(event.IDIn(eventIDs...)), identity.OptedOut(false)).
+		IDs(ctx)
+	if err != nil {
+		slog.Warn("failed to query identities of events", "error", err)
+		return
+	}
+	for _, id := range ids {
+		if _, err := jobs.Enqueue(ctx, client, jobs.KindProfile, jobs.ProfilePayload{IdentityID: id}, jobs.EnqueueOptions{}); err != nil {
+			slog.Warn("failed to enqueue profile update", "error", err, "identity_id", id)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package experts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/ent/person"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/names"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultLimit         = 10
+	defaultEvidenceLimit = 3
+	// DefaultHalfLife is how long it takes an event to lose half its weight.
+	DefaultHalfLife = 90 * 24 * time.Hour
+
+	// maxCandidateEvents bounds the events scored per query, newest first.
+	maxCandidateEvents = 2000
+
+	// tagMatchWeight and textMatchWeight score a query term found in an
+	// event's tags or only in its name or description.
+	tagMatchWeight  = 1.0
+	textMatchWeight = 0.5
+
+	// supportingRoleWeight scales the centrality of participants other than
+	// the first one listed by the extractor, who is usually the one driving
+	// the topic.
+	supportingRoleWeight = 0.5
+)
+
+type Options struct {
+	Topic         string
+	Limit         int
+	EvidenceLimit int
+	HalfLife      time.Duration
+	// Now is the reference time for recency, zero means time.Now.
+	Now time.Time
+	// ChatIDs limits the events considered to these chats unless nil.
+	ChatIDs []string
+}
+
+// Signals break an expert's score down. Frequency counts matching events,
+// Recency and Centrality are averages in [0, 1] over them.
+type Signals struct {
+	Frequency  int     `json:"frequency"`
+	Recency    float64 `json:"recency"`
+	Centrality float64 `json:"centrality"`
+	Relevance  float64 `json:"relevance"`
+}
+
+// Evidence is an event that contributed to an expert's score.
+type Evidence struct {
+	Event  *ent.Event `json:"event"`
+	Weight float64    `json:"weight"`
+}
+
+// Expert is a person ranked for a topic, together with the identities that
+// contributed and the strongest evidence events.
+type Expert struct {
+	PersonID    uuid.UUID       `json:"person_id"`
+	DisplayName string          `json:"display_name"`
+	Identities  []*ent.Identity `json:"identities"`
+	Score       float64         `json:"score"`
+	LastSeen    int64           `json:"last_seen"`
+	Signals     Signals         `json:"signals"`
+	Evidence    []Evidence      `json:"evidence"`
+}
+
+// Tokenizer splits a topic into the terms matched against events.
+type Tokenizer func(text string) []string
+
+type Finder struct {
+	client   *datastore.Client
+	tokenize Tokenizer
+}
+
+// NewFinder builds an expert finder. tokenize may be nil to split topics on
+// whitespace.
+func NewFinder(client *datastore.Client, tokenize Tokenizer) *Finder {
+	if tokenize == nil {
+		tokenize = strings.Fields
+	}
+
+	return &Finder{client: client, tokenize: tokenize}
+}
+
+type contribution struct {
+	expert     *Expert
+	identities map[uuid.UUID]*ent.Identity
+	events     map[uuid.UUID]scoredEvent
+}
+
+type scoredEvent struct {
+	Evidence
+	recency    float64
+	centrality float64
+	relevance  float64
+}
+
+// Find ranks the people who contributed to events about a topic. Each event
+// adds relevance × recency × centrality to every linked identity, where
+// relevance is the share of topic terms found in the event, recency decays
+// with the half-life and centrality favours the lead participant of small
+// events. Identities are rolled up into their persons.
+func (f *Finder) Find(ctx context.Context, opts Options) ([]Expert, error) {
+	terms := f.terms(opts.Topic)
+	if len(terms) == 0 {
+		return nil, errors.New("topic is required")
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = defaultLimit
+	}
+	if opts.EvidenceLimit <= 0 {
+		opts.EvidenceLimit = defaultEvidenceLimit
+	}
+	if opts.HalfLife <= 0 {
+		opts.HalfLife = DefaultHalfLife
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	events, err := f.candidates(ctx, terms, opts.ChatIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	contributions := make(map[uuid.UUID]*contribution)
+	for _, e := range events {
+		relevance := relevance(e, terms)
+		if relevance == 0 || len(e.Edges.Identities) == 0 {
+			continue
+		}
+		recency := recency(e.PlatformTimestamp, opts.Now, opts.HalfLife)
+		lead := names.Normalize(strings.Split(e.FromName, ",")[0])
+
+		for _, ident := range e.Edges.Identities {
+			centrality := centrality(len(e.Edges.Identities), isLead(ident, lead))
+			weight := relevance * recency * centrality
+
+			key := lo.FromPtr(ident.PersonID)
+			if ident.PersonID == nil {
+				key = ident.ID
+			}
+			c, ok := contributions[key]
+			if !ok {
+				c = &contribution{
+					expert:     &Expert{PersonID: key, DisplayName: ident.DisplayName},
+					identities: make(map[uuid.UUID]*ent.Identity),
+					events:     make(map[uuid.UUID]scoredEvent),
+				}
+				contributions[key] = c
+			}
+			c.identities[ident.ID] = ident
+
+			// An event counts once per person, through its most central identity.
+			if previous, ok := c.events[e.ID]; ok && previous.Weight >= weight {
+				continue
+			}
+			c.events[e.ID] = scoredEvent{
+				Evidence:   Evidence{Event: e, Weight: weight},
+				recency:    recency,
+				centrality: centrality,
+				relevance:  relevance,
+			}
+		}
+	}
+
+	experts := make([]Expert, 0, len(contributions))
+	for _, c := range contributions {
+		expert := c.expert
+		expert.Evidence = make([]Evidence, 0, len(c.events))
+		for _, scored := range c.events {
+			expert.Score += scored.Weight
+			expert.LastSeen = max(expert.LastSeen, scored.Event.PlatformTimestamp)
+			expert.Signals.Recency += scored.recency
+			expert.Signals.Centrality += scored.centrality
+			expert.Signals.Relevance += scored.relevance
+			expert.Evidence = append(expert.Evidence, scored.Evidence)
+		}
+		n := float64(len(c.events))
+		expert.Signals.Frequency = len(c.events)
+		expert.Signals.Recency /= n
+		expert.Signals.Centrality /= n
+		expert.Signals.Relevance /= n
+
+		expert.Identities = lo.Values(c.identities)
+		sort.Slice(expert.Identities, func(i, j int) bool {
+			return expert.Identities[i].DisplayName < expert.Identities[j].DisplayName
+		})
+
+		sort.Slice(expert.Evidence, func(i, j int) bool {
+			if expert.Evidence[i].Weight != expert.Evidence[j].Weight {
+				return expert.Evidence[i].Weight > expert.Evidence[j].Weight
+			}
+			return expert.Evidence[i].Event.PlatformTimestamp > expert.Evidence[j].Event.PlatformTimestamp
+		})
+		expert.Evidence = lo.Slice(expert.Evidence, 0, opts.EvidenceLimit)
+
+		experts = append(experts, *expert)
+	}
+
+	sort.Slice(experts, func(i, j int) bool {
+		if experts[i].Score != experts[j].Score {
+			return experts[i].Score > experts[j].Score
+		}
+		return experts[i].LastSeen > experts[j].LastSeen
+	})
+	experts = lo.Slice(experts, 0, opts.Limit)
+
+	return experts, f.resolvePersonNames(ctx, experts)
+}
+
+// terms are the normalized, distinct topic terms. A multi-term topic also
+// keeps the whole phrase, so an exact tag match is not diluted.
+func (f *Finder) terms(topic string) []string {
+	terms := lo.Uniq(lo.FilterMap(f.tokenize(topic), func(t string, _ int) (string, bool) {
+		t = names.Normalize(t)
+		return t, t != ""
+	}))
+	if phrase := names.Normalize(topic); len(terms) > 1 && phrase != "" {
+		terms = append(terms, phrase)
+	}
+	return terms
+}
+
+// candidates loads recent events mentioning any term in their tags, name or
+// description, with their identities. chatIDs limits them to these chats
+// unless nil.
+func (f *Finder) candidates(ctx context.Context, terms, chatIDs []string) ([]*ent.Event, error) {
+	escaper := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	patterns := lo.Map(terms, func(t string, _ int) string { return "%" + escaper.Replace(t) + "%" })
+
+	where := "workspace_id = $2 AND (tags::text ILIKE ANY($1) OR name ILIKE ANY($1) OR description ILIKE ANY($1))"
+	args := []any{pq.Array(patterns), datastore.WorkspaceID(ctx)}
+	if chatIDs != nil {
+		where += " AND in_chat_id = ANY($3)"
+		args = append(args, pq.Array(chatIDs))
+	}
+
+	rows, err := f.client.QueryContext(ctx, fmt.Sprintf(`SELECT id FROM events
+WHERE %s
+ORDER BY platform_timestamp DESC
+LIMIT %d`, where, maxCandidateEvents), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []*ent.Event{}, nil
+	}
+
+	return f.client.Event.Query().
+		Where(event.IDIn(ids...)).
+		WithIdentities().
+		All(ctx)
+}
+
+func (f *Finder) resolvePersonNames(ctx context.Context, experts []Expert) error {
+	persons, err := f.client.Person.Query().
+		Where(person.IDIn(lo.Map(experts, func(e Expert, _ int) uuid.UUID { return e.PersonID })...)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	byID := lo.KeyBy(persons, func(p *ent.Person) uuid.UUID { return p.ID })
+	for i := range experts {
+		if p, ok := byID[experts[i].PersonID]; ok && p.DisplayName != "" {
+			experts[i].DisplayName = p.DisplayName
+		}
+	}
+
+	return nil
+}
+
+// relevance is the share of terms found in the event, tags weighing more than
+// free text.
+func relevance(e *ent.Event, terms []string) float64 {
+	tags := lo.Map(e.Tags, func(t string, _ int) string { return names.Normalize(t) })
+	text := names.Normalize(e.Name + " " + e.Description)
+
+	total := 0.0
+	for _, term := range terms {
+		switch {
+		case lo.ContainsBy(tags, func(tag string) bool { return strings.Contains(tag, term) }):
+			total += tagMatchWeight
+		case strings.Contains(text, term):
+			total += textMatchWeight
+		}
+	}
+
+	return total / float64(len(terms))
+}
+
+func recency(timestamp int64, now time.Time, halfLife time.Duration) float64 {
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, age.Hours()/halfLife.Hours())
+}
+
+// centrality shrinks with the number of participants, so being one of two
+// people in an event counts more than being one of ten.
+func centrality(participants int, lead bool) float64 {
+	c := 1 / math.Sqrt(float64(max(participants, 1)))
+	if !lead {
+		c *= supportingRoleWeight
+	}
+	return c
+}
+
+func isLead(ident *ent.Identity, lead string) bool {
+	if lead == "" {
+		return false
+	}
+	for _, name := range append([]string{ident.DisplayName, ident.Username}, ident.AltIds...) {
+		if names.Normalize(name) == lead {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+// Package owners makes reads aware of the crawling account that stored each
+// message. Two accounts in the same chat store the same message twice, once
+// per owner_account_id. Reads either take one copy of every message or the
+// copies of one owner, and API users read the chats of the owner accounts
+// linked to them.
+package owners
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/owneraccountlink"
+	"github.com/luoling8192/mindwave/ent/predicate"
+	"github.com/luoling8192/mindwave/internal/datastore"
+)
+
+// CanonicalCondition holds for the one copy of a message that is read when
+// several owner accounts stored it, the live copy with the smallest id. It
+// is written against the unaliased chat_messages table for raw queries.
+const CanonicalCondition = `NOT EXISTS (SELECT 1 FROM chat_messages AS dup
+WHERE dup.platform = chat_messages.platform
+  AND dup.in_chat_id = chat_messages.in_chat_id
+  AND dup.platform_message_id = chat_messages.platform_message_id
+  AND dup.deleted_at = 0
+  AND dup.id < chat_messages.id)`
+
+// ErrNotLinked is returned when an owner account is not linked to the user
+// asking for its view.
+var ErrNotLinked = errors.New("owner account is not linked")
+
+// Canonical keeps one copy of every message, see CanonicalCondition.
+func Canonical() predicate.ChatMessage {
+	return func(s *sql.Selector) {
+		copies := sql.Table(chatmessage.Table).As("dup")
+		s.Where(sql.NotExists(
+			sql.Select(copies.C(chatmessage.FieldID)).
+				From(copies).
+				Where(sql.And(
+					sql.ColumnsEQ(copies.C(chatmessage.FieldPlatform), s.C(chatmessage.FieldPlatform)),
+					sql.ColumnsEQ(copies.C(chatmessage.FieldInChatID), s.C(chatmessage.FieldInChatID)),
+					sql.ColumnsEQ(copies.C(chatmessage.FieldPlatformMessageID), s.C(chatmessage.FieldPlatformMessageID)),
+					sql.EQ(copies.C(chatmessage.FieldDeletedAt), 0),
+					sql.ColumnsLT(copies.C(chatmessage.FieldID), s.C(chatmessage.FieldID)),
+				)),
+		))
+	}
+}
+
+// View selects the copies read: those stored by owner, or one copy of every
+// message when owner is uuid.Nil.
+func View(owner uuid.UUID) predicate.ChatMessage {
+	if owner == uuid.Nil {
+		return Canonical()
+	}
+	return chatmessage.OwnerAccountID(owner)
+}
+
+// Account is an owner account with what it has stored.
+type Account struct {
+	ID       uuid.UUID `json:"id"`
+	Chats    int       `json:"chats"`
+	Messages int       `json:"messages"`
+}
+
+// Accounts lists the owner accounts that stored messages, messages stored
+// without an owner are left out.
+func Accounts(ctx context.Context, client *datastore.Client) ([]Account, error) {
+	rows, err := client.QueryContext(ctx, `SELECT owner_account_id, count(DISTINCT in_chat_id), count(*)
+FROM chat_messages
+WHERE workspace_id = $1 AND owner_account_id IS NOT NULL AND deleted_at = 0
+GROUP BY 1
+ORDER BY 3 DESC`, datastore.WorkspaceID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := make([]Account, 0)
+	for rows.Next() {
+		var a Account
+		if err := rows.Scan(&a.ID, &a.Chats, &a.Messages); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, a)
+	}
+	return accounts, rows.Err()
+}
+
+// Chats returns the chats the owner accounts have joined, those they stored
+// messages of.
+func Chats(ctx context.Context, client *datastore.Client, ownerIDs []uuid.UUID) ([]string, error) {
+	if len(ownerIDs) == 0 {
+		return []string{}, nil
+	}
+	return client.ChatMessage.Query().
+		Where(
+			chatmessage.OwnerAccountIDIn(ownerIDs...),
+			chatmessage.DeletedAt(0),
+		).
+		Unique(true).
+		Select(chatmessage.FieldInChatID).
+		Strings(ctx)
+}
+
+// Linked returns the owner accounts linked to an API user.
+func Linked(ctx context.Context, client *datastore.Client, subject string) ([]uuid.UUID, error) {
+	links, err := client.OwnerAccountLink.Query().
+		Where(owneraccountlink.Subject(subject)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(links))
+	for _, l := range links {
+		ids = append(ids, l.OwnerAccountID)
+	}
+	return ids, nil
+}
+
+// Link lets an API user read the chats of the owner accounts, links that
+// already exist are kept.
+func Link(ctx context.Context, client *datastore.Client, subject string, ownerIDs []uuid.UUID) error {
+	if subject == "" {
+		return errors.New("subject is required")
+	}
+
+	builders := make([]*ent.OwnerAccountLinkCreate, 0, len(ownerIDs))
+	for _, id := range ownerIDs {
+		builders = append(builders, client.OwnerAccountLink.Create().
+			SetSubject(subject).
+			SetOwnerAccountID(id))
+	}
+	return client.OwnerAccountLink.CreateBulk(builders...).
+		OnConflictColumns(owneraccountlink.FieldWorkspaceID, owneraccountlink.FieldSubject, owneraccountlink.FieldOwnerAccountID).
+		DoNothing().
+		Exec(ctx)
+}
+
+// Unlink removes links of an API user and returns how many there were.
+func Unlink(ctx context.Context, client *datastore.Client, subject string, ownerIDs []uuid.UUID) (int, error) {
+	return client.OwnerAccountLink.Delete().
+		Where(
+			owneraccountlink.Subject(subject),
+			owneraccountlink.OwnerAccountIDIn(ownerIDs...),
+		).
+		Exec(ctx)
+}
@@ -0,0 +1,728 @@
+package persons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/askturn"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/distillrun"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/personauditlog"
+	"github.com/luoling8192/mindwave/ent/predicate"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/ent/summary"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/graph"
+	"github.com/luoling8192/mindwave/internal/jobs"
+	"github.com/luoling8192/mindwave/internal/names"
+	"github.com/luoling8192/mindwave/internal/services/digest"
+	"github.com/samber/lo"
+)
+
+// Forgotten replaces the names and content of forgotten identities.
+const Forgotten = "[forgotten]"
+
+// Names shorter than this are not replaced in free text, they would match
+// inside unrelated words.
+const minScrubNameLength = 2
+
+// ForgetOptions controls what Forget does with the messages of the identity.
+type ForgetOptions struct {
+	// DeleteMessages deletes the messages instead of anonymizing them.
+	DeleteMessages bool
+	// DryRun reports what would be forgotten and changes nothing.
+	DryRun bool
+	Actor  string
+	Reason string
+	// Cache is the LLM completion cache, its entries mentioning the names
+	// are deleted. Nil leaves it.
+	Cache CachePurger
+	// DigestDir is where digests are written, the names are replaced in the
+	// digests of the chats. Empty leaves them.
+	DigestDir string
+}
+
+// CachePurger deletes cached completions containing any of texts.
+type CachePurger interface {
+	DeleteMentioning(ctx context.Context, texts []string) (int, error)
+}
+
+// ForgetReport lists what Forget removed or anonymized.
+type ForgetReport struct {
+	IdentityID     uuid.UUID
+	Platform       string
+	PlatformUserID string
+	// Names are the names the identity was known by, replaced everywhere
+	// below.
+	Names []string
+	Chats []string
+
+	PersonID      *uuid.UUID
+	PersonDeleted bool
+
+	EventsUnlinked     int
+	EventsScrubbed     int
+	ProfilesDeleted    int
+	MessagesDeleted    int
+	MessagesAnonymized int
+	RepliesAnonymized  int
+	SummariesScrubbed  int
+	AskTurnsDeleted    int
+	AskTurnsScrubbed   int
+	// EventVectorsCleared counts the scrubbed events whose description
+	// vectors were cleared, embed jobs compute them again from the new text.
+	EventVectorsCleared int
+	DistillRunsCleared  int
+	// CacheEntriesDeleted and DigestFilesScrubbed are left at 0 on a dry
+	// run, the cache and the digest files are only read when purged.
+	CacheEntriesDeleted int
+	DigestFilesScrubbed int
+
+	// GraphErrors are the graph writes that failed after the database was
+	// updated, PurgeErrors the purges of the cache, the digest files and
+	// the vectors.
+	GraphErrors []string
+	PurgeErrors []string
+	AuditLogID  uuid.UUID
+	DryRun      bool
+}
+
+// Counts returns the number of records touched by kind, as recorded in the
+// audit log.
+func (r *ForgetReport) Counts() map[string]int {
+	return map[string]int{
+		"events_unlinked":       r.EventsUnlinked,
+		"events_scrubbed":       r.EventsScrubbed,
+		"profiles_deleted":      r.ProfilesDeleted,
+		"messages_deleted":      r.MessagesDeleted,
+		"messages_anonymized":   r.MessagesAnonymized,
+		"replies_anonymized":    r.RepliesAnonymized,
+		"summaries_scrubbed":    r.SummariesScrubbed,
+		"ask_turns_deleted":     r.AskTurnsDeleted,
+		"ask_turns_scrubbed":    r.AskTurnsScrubbed,
+		"event_vectors_cleared": r.EventVectorsCleared,
+		"distill_runs_cleared":  r.DistillRunsCleared,
+		"cache_entries_deleted": r.CacheEntriesDeleted,
+		"digest_files_scrubbed": r.DigestFilesScrubbed,
+		"person_deleted":        lo.Ternary(r.PersonDeleted, 1, 0),
+	}
+}
+
+// SetOptOut marks an identity as opted out of profiling, or back in. It does
+// not touch what was distilled before, Forget removes that.
+func SetOptOut(ctx context.Context, client *datastore.Client, identityID uuid.UUID, optedOut bool) (*ent.Identity, error) {
+	ident, err := client.Identity.Get(ctx, identityID)
+	if err != nil {
+		return nil, err
+	}
+	if !optedOut && ident.ForgottenAt != 0 {
+		return nil, errors.New("forgotten identities cannot opt back in")
+	}
+	return ident.Update().SetOptedOut(optedOut).Save(ctx)
+}
+
+// Forget removes an identity from everything derived from its messages: its
+// event links and profiles, its name in events and summaries of its chats,
+// the ask turns citing its messages or events, the partial outputs of distill
+// runs in its chats, and its person unless other identities share it. Its
+// messages are deleted or anonymized along with their vectors, the vectors of
+// scrubbed events are computed again. The names are also replaced in the
+// digest files and cached completions mentioning them are deleted. The
+// identity is kept as an opted out tombstone without names, so later messages
+// of it are left out as well. Its chats are recorded in the audit log, a
+// later Forget of the identity scrubs them again once the messages are gone.
+func Forget(
+	ctx context.Context,
+	client *datastore.Client,
+	graphWriter *graph.Writer,
+	identityID uuid.UUID,
+	opts ForgetOptions,
+) (*ForgetReport, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ident, err := tx.Identity.Query().
+		Where(identity.ID(identityID)).
+		WithEvents().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	report := &ForgetReport{
+		IdentityID:     ident.ID,
+		Platform:       ident.Platform,
+		PlatformUserID: ident.PlatformUserID,
+		Names:          knownNames(ident),
+		PersonID:       ident.PersonID,
+		DryRun:         opts.DryRun,
+	}
+	if ident.Username != "" && !lo.Contains(report.Names, ident.Username) {
+		report.Names = append(report.Names, ident.Username)
+	}
+	report.Names = lo.Compact(report.Names)
+
+	ownMessages := chatmessage.And(
+		chatmessage.PlatformEQ(ident.Platform),
+		chatmessage.FromIDEQ(ident.PlatformUserID),
+	)
+	report.Chats, err = tx.ChatMessage.Query().
+		Where(ownMessages).
+		Unique(true).
+		Select(chatmessage.FieldInChatID).
+		Strings(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	earlier, err := tx.PersonAuditLog.Query().
+		Where(personauditlog.ActionEQ(personauditlog.ActionForget)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	for _, l := range earlier {
+		if lo.Contains(l.IdentityIds, ident.ID) {
+			report.Chats = append(report.Chats, l.ChatIds...)
+		}
+	}
+	report.Chats = lo.Uniq(report.Chats)
+
+	linkedEvents := lo.Map(ident.Edges.Events, func(e *ent.Event, _ int) uuid.UUID { return e.ID })
+	report.EventsUnlinked = len(linkedEvents)
+
+	scrubbed, err := scrubEvents(ctx, tx, report)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	report.EventsScrubbed = len(scrubbed)
+
+	reembed, err := clearEventVectors(ctx, tx, scrubbed)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	report.EventVectorsCleared = len(reembed)
+
+	report.ProfilesDeleted, err = tx.Profile.Delete().
+		Where(profile.IdentityID(ident.ID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	report.SummariesScrubbed, err = scrubSummaries(ctx, tx, report)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Ask turns are found through the messages and event links, before
+	// those go.
+	report.AskTurnsDeleted, err = deleteAskTurns(ctx, tx, ident)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	scrubbable := lo.Filter(report.Names, func(name string, _ int) bool {
+		return len([]rune(name)) >= minScrubNameLength
+	})
+	report.AskTurnsScrubbed, err = scrubAskTurns(ctx, tx, scrubbable)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if len(report.Chats) > 0 {
+		report.DistillRunsCleared, err = tx.DistillRun.Update().
+			Where(
+				distillrun.InChatIDIn(report.Chats...),
+				distillrun.PartialOutputNEQ(""),
+			).
+			SetPartialOutput("").
+			Save(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if len(scrubbable) > 0 && len(report.Chats) > 0 {
+		report.RepliesAnonymized, err = tx.ChatMessage.Update().
+			Where(
+				chatmessage.PlatformEQ(ident.Platform),
+				chatmessage.InChatIDIn(report.Chats...),
+				chatmessage.ReplyToNameIn(scrubbable...),
+			).
+			SetReplyToName(Forgotten).
+			Save(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if opts.DeleteMessages {
+		report.MessagesDeleted, err = tx.ChatMessage.Delete().Where(ownMessages).Exec(ctx)
+	} else {
+		report.MessagesAnonymized, err = anonymizeMessages(ctx, tx, ident)
+	}
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	var keptPerson *ent.Person
+	if ident.PersonID != nil {
+		keptPerson, err = detachPerson(ctx, tx, ident, report)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	err = tx.Identity.UpdateOneID(ident.ID).
+		ClearEvents().
+		ClearPersonID().
+		SetDisplayName("").
+		SetUsername("").
+		SetProfilePhotoURL("").
+		ClearAltIds().
+		SetOptedOut(true).
+		SetForgottenAt(time.Now().UnixMilli()).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	audit, err := tx.PersonAuditLog.Create().
+		SetAction(personauditlog.ActionForget).
+		SetPersonID(lo.FromPtr(ident.PersonID)).
+		SetIdentityIds([]uuid.UUID{ident.ID}).
+		SetChatIds(report.Chats).
+		SetCounts(report.Counts()).
+		SetActor(opts.Actor).
+		SetReason(opts.Reason).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	report.AuditLogID = audit.ID
+
+	if opts.DryRun {
+		return report, tx.Rollback()
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if graphWriter != nil {
+		report.GraphErrors = forgetInGraph(ctx, client, graphWriter, ident, keptPerson, linkedEvents, scrubbed)
+	}
+
+	report.PurgeErrors = purgeOutside(ctx, client, report, scrubbable, reembed, opts)
+	if err := client.PersonAuditLog.UpdateOneID(audit.ID).SetCounts(report.Counts()).Exec(ctx); err != nil {
+		slog.Warn("failed to update forget counts in the audit log", "audit_log_id", audit.ID, "error", err)
+	}
+
+	return report, nil
+}
+
+// clearEventVectors clears the description vectors of the events that have
+// one, they were embedded from the text before scrubbing, and returns the
+// events cleared.
+func clearEventVectors(ctx context.Context, tx *ent.Tx, events []*ent.Event) ([]uuid.UUID, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	ids, err := tx.Event.Query().
+		Where(
+			event.IDIn(lo.Map(events, func(e *ent.Event, _ int) uuid.UUID { return e.ID })...),
+			event.Or(
+				event.DescriptionVector1536NotNil(),
+				event.DescriptionVector1024NotNil(),
+				event.DescriptionVector768NotNil(),
+			),
+		).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	err = tx.Event.Update().
+		Where(event.IDIn(ids...)).
+		ClearDescriptionVector1536().
+		ClearDescriptionVector1024().
+		ClearDescriptionVector768().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// deleteAskTurns deletes the ask turns citing a message of the identity or
+// an event linked to it, their answers were written from them.
+func deleteAskTurns(ctx context.Context, tx *ent.Tx, ident *ent.Identity) (int, error) {
+	stmt := fmt.Sprintf(`DELETE FROM %[1]s AS t
+WHERE t.workspace_id = $1 AND (
+  EXISTS (
+    SELECT 1 FROM jsonb_array_elements_text(t.%[2]s) AS c(id)
+    JOIN %[4]s AS m ON m.id::text = c.id
+    WHERE m.platform = $2 AND m.from_id = $3
+  ) OR EXISTS (
+    SELECT 1 FROM jsonb_array_elements_text(t.%[3]s) AS c(id)
+    JOIN %[5]s AS ie ON ie.event_id::text = c.id
+    WHERE ie.identity_id = $4
+  )
+)`, askturn.Table, askturn.FieldCitedMessageIds, askturn.FieldEventIds, chatmessage.Table, identity.EventsTable)
+	result, err := tx.ExecContext(ctx, stmt, ident.WorkspaceID, ident.Platform, ident.PlatformUserID, ident.ID)
+	if err != nil {
+		return 0, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+// scrubAskTurns replaces the names in the questions and answers of the ask
+// turns left and returns how many changed.
+func scrubAskTurns(ctx context.Context, tx *ent.Tx, scrubbable []string) (int, error) {
+	if len(scrubbable) == 0 {
+		return 0, nil
+	}
+
+	predicates := make([]predicate.AskTurn, 0, 3*len(scrubbable))
+	for _, name := range scrubbable {
+		predicates = append(predicates,
+			askturn.QuestionContains(name),
+			askturn.StandaloneQuestionContains(name),
+			askturn.AnswerContains(name),
+		)
+	}
+	turns, err := tx.AskTurn.Query().Where(askturn.Or(predicates...)).All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, t := range turns {
+		err := t.Update().
+			SetQuestion(scrubNames(t.Question, scrubbable)).
+			SetStandaloneQuestion(scrubNames(t.StandaloneQuestion, scrubbable)).
+			SetAnswer(scrubNames(t.Answer, scrubbable)).
+			Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(turns), nil
+}
+
+// purgeOutside re-embeds the scrubbed events, deletes the cached completions
+// mentioning the names and scrubs them from the digest files of the chats,
+// after the database was updated. It returns the steps that failed.
+func purgeOutside(
+	ctx context.Context,
+	client *datastore.Client,
+	report *ForgetReport,
+	scrubbable []string,
+	reembed []uuid.UUID,
+	opts ForgetOptions,
+) []string {
+	var failures []string
+	fail := func(step string, err error) {
+		slog.Warn("failed to purge forgotten identity", "step", step, "error", err, "identity_id", report.IdentityID)
+		failures = append(failures, fmt.Sprintf("%s: %v", step, err))
+	}
+
+	if len(reembed) > 0 {
+		if _, err := jobs.Enqueue(ctx, client, jobs.KindEmbed, jobs.EventsPayload{EventIDs: reembed}, jobs.EnqueueOptions{}); err != nil {
+			fail("enqueue event embedding", err)
+		}
+	}
+
+	if opts.Cache != nil && len(scrubbable) > 0 {
+		n, err := opts.Cache.DeleteMentioning(ctx, scrubbable)
+		report.CacheEntriesDeleted = n
+		if err != nil {
+			fail("delete cached completions", err)
+		}
+	}
+
+	if opts.DigestDir != "" && len(scrubbable) > 0 {
+		for _, chatID := range report.Chats {
+			n, err := scrubDigestFiles(digest.ChatDir(opts.DigestDir, chatID), scrubbable)
+			report.DigestFilesScrubbed += n
+			if err != nil {
+				fail("scrub digests of chat "+chatID, err)
+			}
+		}
+	}
+
+	return failures
+}
+
+// scrubDigestFiles replaces the names in the Markdown, HTML and feed files of
+// a chat's digest directory and returns how many changed. The HTML and the
+// feed carry the names escaped.
+func scrubDigestFiles(dir string, scrubbable []string) (int, error) {
+	escaped := lo.Uniq(append(append([]string{}, scrubbable...), lo.Map(scrubbable, func(name string, _ int) string {
+		return html.EscapeString(name)
+	})...))
+
+	changed := 0
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil || entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		scrubbed := scrubNames(string(data), escaped)
+		if scrubbed == string(data) {
+			return nil
+		}
+		if err := digest.WriteFile(path, []byte(scrubbed)); err != nil {
+			return err
+		}
+		changed++
+		return nil
+	})
+	return changed, err
+}
+
+// scrubEvents removes the names of the identity from the participants and
+// text of the events of its chats and returns the events changed.
+func scrubEvents(ctx context.Context, tx *ent.Tx, report *ForgetReport) ([]*ent.Event, error) {
+	if len(report.Names) == 0 || len(report.Chats) == 0 {
+		return nil, nil
+	}
+
+	predicates := make([]predicate.Event, 0, 3*len(report.Names))
+	for _, name := range report.Names {
+		predicates = append(predicates, event.FromNameContains(name))
+		if len([]rune(name)) >= minScrubNameLength {
+			predicates = append(predicates, event.NameContains(name), event.DescriptionContains(name))
+		}
+	}
+	events, err := tx.Event.Query().
+		Where(
+			event.PlatformEQ(report.Platform),
+			event.InChatIDIn(report.Chats...),
+			event.Or(predicates...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	forgotten := lo.SliceToMap(report.Names, func(name string) (string, struct{}) {
+		return names.Normalize(name), struct{}{}
+	})
+	isForgotten := func(name string, _ int) bool {
+		_, ok := forgotten[names.Normalize(name)]
+		return ok
+	}
+
+	changed := make([]*ent.Event, 0, len(events))
+	for _, e := range events {
+		participants := lo.Reject(strings.Split(e.FromName, ","), isForgotten)
+		if len(participants) == 0 {
+			participants = []string{"unknown"}
+		}
+		fromName := strings.Join(participants, ",")
+		name := scrubNames(e.Name, report.Names)
+		description := scrubNames(e.Description, report.Names)
+		unmatched := lo.Reject(e.UnmatchedNames, isForgotten)
+		if fromName == e.FromName && name == e.Name && description == e.Description && len(unmatched) == len(e.UnmatchedNames) {
+			continue
+		}
+
+		updated, err := e.Update().
+			SetFromName(fromName).
+			SetName(name).
+			SetDescription(description).
+			SetUnmatchedNames(unmatched).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, updated)
+	}
+	return changed, nil
+}
+
+// scrubSummaries replaces the names of the identity in the summaries of its
+// chats and returns how many changed.
+func scrubSummaries(ctx context.Context, tx *ent.Tx, report *ForgetReport) (int, error) {
+	scrubbable := lo.Filter(report.Names, func(name string, _ int) bool {
+		return len([]rune(name)) >= minScrubNameLength
+	})
+	if len(scrubbable) == 0 || len(report.Chats) == 0 {
+		return 0, nil
+	}
+
+	summaries, err := tx.Summary.Query().
+		Where(
+			summary.PlatformEQ(report.Platform),
+			summary.InChatIDIn(report.Chats...),
+			summary.Or(lo.Map(scrubbable, func(name string, _ int) predicate.Summary {
+				return summary.ContentContains(name)
+			})...),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, s := range summaries {
+		if err := s.Update().SetContent(scrubNames(s.Content, scrubbable)).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return len(summaries), nil
+}
+
+// anonymizeMessages blanks the name and content of the messages of the
+// identity and drops their tokens and vectors, keeping the rows so threads
+// stay intact.
+func anonymizeMessages(ctx context.Context, tx *ent.Tx, ident *ent.Identity) (int, error) {
+	n, err := tx.ChatMessage.Update().
+		Where(
+			chatmessage.PlatformEQ(ident.Platform),
+			chatmessage.FromIDEQ(ident.PlatformUserID),
+		).
+		SetFromName(Forgotten).
+		SetContent(Forgotten).
+		SetJiebaTokens([]string{}).
+		SetTokenizedAt(time.Now().UnixMilli()).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	stmt := fmt.Sprintf(
+		"UPDATE %s SET content_vector_1536 = NULL, content_vector_1024 = NULL, content_vector_768 = NULL WHERE workspace_id = $1 AND platform = $2 AND from_id = $3",
+		chatmessage.Table,
+	)
+	if _, err := tx.ExecContext(ctx, stmt, ident.WorkspaceID, ident.Platform, ident.PlatformUserID); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// detachPerson deletes the person of the identity when no other identity
+// shares it, and returns the person otherwise, renamed if it carried a name
+// of the identity.
+func detachPerson(ctx context.Context, tx *ent.Tx, ident *ent.Identity, report *ForgetReport) (*ent.Person, error) {
+	others, err := tx.Identity.Query().
+		Where(identity.PersonID(*ident.PersonID), identity.IDNEQ(ident.ID)).
+		Order(identity.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Identity.UpdateOneID(ident.ID).ClearPersonID().Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(others) == 0 {
+		if err := tx.Person.DeleteOneID(*ident.PersonID).Exec(ctx); err != nil {
+			return nil, err
+		}
+		report.PersonDeleted = true
+		return nil, nil
+	}
+
+	p, err := tx.Person.Get(ctx, *ident.PersonID)
+	if err != nil {
+		return nil, err
+	}
+	if lo.Contains(report.Names, p.DisplayName) {
+		p, err = p.Update().SetDisplayName(others[0].DisplayName).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// forgetInGraph mirrors Forget in the graph and returns the writes that
+// failed.
+func forgetInGraph(
+	ctx context.Context,
+	client *datastore.Client,
+	graphWriter *graph.Writer,
+	ident *ent.Identity,
+	keptPerson *ent.Person,
+	linkedEvents []uuid.UUID,
+	scrubbed []*ent.Event,
+) []string {
+	var failures []string
+	fail := func(step string, err error) {
+		slog.Warn("failed to forget identity in graph", "step", step, "error", err, "identity_id", ident.ID)
+		failures = append(failures, fmt.Sprintf("%s: %v", step, err))
+	}
+
+	if err := graphWriter.DeleteIdentity(ctx, ident.Platform, ident.PlatformUserID); err != nil {
+		fail("delete identity node", err)
+	}
+
+	switch {
+	case keptPerson != nil:
+		if err := syncGraph(ctx, client, graphWriter, keptPerson.ID); err != nil {
+			fail("sync person", err)
+		}
+		kept, err := client.Identity.Query().
+			Where(identity.PersonID(keptPerson.ID)).
+			QueryEvents().
+			IDs(ctx)
+		if err != nil {
+			fail("query kept events", err)
+			break
+		}
+		for _, eventID := range lo.Without(linkedEvents, kept...) {
+			if err := graphWriter.UnlinkPersonEvent(ctx, keptPerson.ID.String(), eventID.String()); err != nil {
+				fail("unlink event "+eventID.String(), err)
+			}
+		}
+	case ident.PersonID != nil:
+		if err := graphWriter.DeletePerson(ctx, ident.PersonID.String()); err != nil {
+			fail("delete person node", err)
+		}
+	}
+
+	for _, e := range scrubbed {
+		if err := graphWriter.UpsertEvent(ctx, e, e.Tags, e.EvidenceMessageIds); err != nil {
+			fail("rewrite event "+e.ID.String(), err)
+		}
+	}
+
+	return failures
+}
+
+// scrubNames replaces every occurrence of the names in text.
+func scrubNames(text string, names []string) string {
+	for _, name := range names {
+		if len([]rune(name)) < minScrubNameLength {
+			continue
+		}
+		text = strings.ReplaceAll(text, name, Forgotten)
+	}
+	return text
+}
@@ -0,0 +1,330 @@
+package persons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/person"
+	"github.com/luoling8192/mindwave/ent/personauditlog"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/graph"
+	"github.com/samber/lo"
+)
+
+const minMergeIdentities = 2
+
+// EnsureIdentity upserts the identity seen in a chat message and makes sure it
+// belongs to a person. When the display name changed, the previous one is kept
+// in alt_ids so renamed members can still be matched. Opted out identities are
+// returned as they are, their names are not tracked.
+func EnsureIdentity(ctx context.Context, client *datastore.Client, platform, userID, displayName string) (*ent.Identity, error) {
+	err := client.Identity.Create().
+		SetPlatform(platform).
+		SetPlatformUserID(userID).
+		SetDisplayName(displayName).
+		OnConflictColumns(identity.FieldWorkspaceID, identity.FieldPlatform, identity.FieldPlatformUserID).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ident, err := client.Identity.Query().
+		Where(
+			identity.PlatformEQ(platform),
+			identity.PlatformUserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ident.OptedOut {
+		return ident, nil
+	}
+
+	if ident.DisplayName != displayName && displayName != "" {
+		altIDs := ident.AltIds
+		if ident.DisplayName != "" && !lo.Contains(altIDs, ident.DisplayName) {
+			altIDs = append(altIDs, ident.DisplayName)
+		}
+		ident, err = ident.Update().
+			SetDisplayName(displayName).
+			SetAltIds(altIDs).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if ident.PersonID != nil {
+		return ident, nil
+	}
+
+	p, err := client.Person.Create().
+		SetDisplayName(ident.DisplayName).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Another round may have attached a person concurrently, only claim the
+	// identity while it is still unassigned.
+	n, err := client.Identity.Update().
+		Where(identity.ID(ident.ID), identity.PersonIDIsNil()).
+		SetPersonID(p.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		if err := client.Person.DeleteOneID(p.ID).Exec(ctx); err != nil {
+			slog.Warn("failed to delete unused person", "error", err, "person_id", p.ID)
+		}
+	}
+
+	return client.Identity.Get(ctx, ident.ID)
+}
+
+// Merge moves the given identities, and every identity sharing a person with
+// them, into a single person. The oldest existing person is kept. The
+// operation is recorded in the audit log and mirrored in the graph.
+func Merge(
+	ctx context.Context,
+	client *datastore.Client,
+	graphWriter *graph.Writer,
+	identityIDs []uuid.UUID,
+	actor, reason string,
+	score float64,
+) (*ent.Person, error) {
+	identityIDs = lo.Uniq(identityIDs)
+	if len(identityIDs) < minMergeIdentities {
+		return nil, errors.New("at least two identities are required to merge")
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := tx.Identity.Query().
+		Where(identity.IDIn(identityIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if len(identities) != len(identityIDs) {
+		return nil, rollback(tx, fmt.Errorf("expected %d identities, found %d", len(identityIDs), len(identities)))
+	}
+
+	personIDs := lo.Uniq(lo.FilterMap(identities, func(i *ent.Identity, _ int) (uuid.UUID, bool) {
+		return lo.FromPtr(i.PersonID), i.PersonID != nil
+	}))
+
+	var target *ent.Person
+	if len(personIDs) > 0 {
+		target, err = tx.Person.Query().
+			Where(person.IDIn(personIDs...)).
+			Order(person.ByCreatedAt()).
+			First(ctx)
+	} else {
+		target, err = tx.Person.Create().
+			SetDisplayName(identities[0].DisplayName).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	sourcePersonIDs := lo.Without(personIDs, target.ID)
+
+	_, err = tx.Identity.Update().
+		Where(identity.Or(
+			identity.IDIn(identityIDs...),
+			identity.PersonIDIn(sourcePersonIDs...),
+		)).
+		SetPersonID(target.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if len(sourcePersonIDs) > 0 {
+		if _, err := tx.Person.Delete().Where(person.IDIn(sourcePersonIDs...)).Exec(ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	err = tx.PersonAuditLog.Create().
+		SetAction(personauditlog.ActionMerge).
+		SetPersonID(target.ID).
+		SetSourcePersonIds(sourcePersonIDs).
+		SetIdentityIds(identityIDs).
+		SetActor(actor).
+		SetReason(reason).
+		SetScore(score).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if graphWriter != nil {
+		if err := syncGraph(ctx, client, graphWriter, target.ID); err != nil {
+			slog.Warn("failed to sync person to graph", "error", err, "person_id", target.ID)
+		}
+		sources := lo.Map(sourcePersonIDs, func(id uuid.UUID, _ int) string { return id.String() })
+		if err := graphWriter.MergePersons(ctx, target.ID.String(), sources); err != nil {
+			slog.Warn("failed to merge persons in graph", "error", err, "person_id", target.ID)
+		}
+	}
+
+	return target, nil
+}
+
+// Split detaches an identity from its person into a new person of its own.
+// The operation is recorded in the audit log and mirrored in the graph.
+func Split(
+	ctx context.Context,
+	client *datastore.Client,
+	graphWriter *graph.Writer,
+	identityID uuid.UUID,
+	actor, reason string,
+) (*ent.Person, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ident, err := tx.Identity.Get(ctx, identityID)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if ident.PersonID == nil {
+		return nil, rollback(tx, errors.New("identity does not belong to a person"))
+	}
+	previousPersonID := *ident.PersonID
+
+	remaining, err := tx.Identity.Query().
+		Where(identity.PersonID(previousPersonID), identity.IDNEQ(identityID)).
+		Count(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if remaining == 0 {
+		return nil, rollback(tx, errors.New("identity is the only member of its person"))
+	}
+
+	target, err := tx.Person.Create().
+		SetDisplayName(ident.DisplayName).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Identity.UpdateOneID(identityID).SetPersonID(target.ID).Exec(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	err = tx.PersonAuditLog.Create().
+		SetAction(personauditlog.ActionSplit).
+		SetPersonID(target.ID).
+		SetSourcePersonIds([]uuid.UUID{previousPersonID}).
+		SetIdentityIds([]uuid.UUID{identityID}).
+		SetActor(actor).
+		SetReason(reason).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if graphWriter != nil {
+		for _, personID := range []uuid.UUID{target.ID, previousPersonID} {
+			if err := syncGraph(ctx, client, graphWriter, personID); err != nil {
+				slog.Warn("failed to sync person to graph", "error", err, "person_id", personID)
+			}
+		}
+		if err := unlinkSplitEvents(ctx, client, graphWriter, identityID, previousPersonID); err != nil {
+			slog.Warn("failed to unlink split events in graph", "error", err, "person_id", previousPersonID)
+		}
+	}
+
+	return target, nil
+}
+
+// syncGraph rewrites the CONTRIBUTED_TO edges of a person node from the
+// events linked to its identities.
+func syncGraph(ctx context.Context, client *datastore.Client, graphWriter *graph.Writer, personID uuid.UUID) error {
+	p, err := client.Person.Query().
+		Where(person.ID(personID)).
+		WithIdentities(func(q *ent.IdentityQuery) {
+			q.WithEvents()
+		}).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := graphWriter.UpsertPerson(ctx, p.ID.String(), p.DisplayName); err != nil {
+		return err
+	}
+
+	for _, ident := range p.Edges.Identities {
+		if err := graphWriter.AbsorbIdentity(ctx, p.ID.String(), ident.Platform, ident.PlatformUserID); err != nil {
+			return err
+		}
+		for _, e := range ident.Edges.Events {
+			if err := graphWriter.LinkPersonEvent(ctx, p.ID.String(), e.ID.String()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// unlinkSplitEvents drops the edges from the previous person to events that
+// only the split-off identity contributed to.
+func unlinkSplitEvents(ctx context.Context, client *datastore.Client, graphWriter *graph.Writer, identityID, previousPersonID uuid.UUID) error {
+	splitEvents, err := client.Identity.Query().
+		Where(identity.ID(identityID)).
+		QueryEvents().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	keptEvents, err := client.Identity.Query().
+		Where(identity.PersonID(previousPersonID)).
+		QueryEvents().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, eventID := range lo.Without(splitEvents, keptEvents...) {
+		if err := graphWriter.UnlinkPersonEvent(ctx, previousPersonID.String(), eventID.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %w", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,224 @@
+package persons
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/names"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/samber/lo"
+)
+
+const (
+	nameWeight       = 0.5
+	usernameWeight   = 0.3
+	coActivityWeight = 0.2
+
+	// minNameSimilarity prunes pairs before the more expensive signals are
+	// combined; co-activity alone never proposes a merge.
+	minNameSimilarity = 0.5
+
+	hoursPerDay = 24
+)
+
+// Signals are the individual scores, each in [0, 1], behind a merge proposal.
+type Signals struct {
+	NameSimilarity float64 `json:"name_similarity"`
+	UsernameMatch  float64 `json:"username_match"`
+	CoActivity     float64 `json:"co_activity"`
+}
+
+// Proposal suggests that two identities belong to the same person.
+type Proposal struct {
+	A       *ent.Identity `json:"a"`
+	B       *ent.Identity `json:"b"`
+	Score   float64       `json:"score"`
+	Signals Signals       `json:"signals"`
+}
+
+type ReviewOptions struct {
+	Threshold float64
+	Limit     int
+}
+
+type activity struct {
+	chats map[string]struct{}
+	hours [hoursPerDay]float64
+}
+
+// ProposeMerges scores pairs of identities that are not yet in the same person
+// by display-name similarity, username matches and co-activity, and returns
+// those reaching the threshold, best first. Members who opted out are never
+// proposed. Every pair is compared, the review is run by hand over the
+// identities of one workspace.
+func ProposeMerges(ctx context.Context, client *datastore.Client, opts ReviewOptions) ([]Proposal, error) {
+	identities, err := client.Identity.Query().
+		Where(identity.OptedOut(false)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := loadActivities(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]Proposal, 0)
+	for i := range identities {
+		for j := i + 1; j < len(identities); j++ {
+			a, b := identities[i], identities[j]
+			if a.PersonID != nil && b.PersonID != nil && *a.PersonID == *b.PersonID {
+				continue
+			}
+
+			signals := Signals{
+				NameSimilarity: nameSimilarity(a, b),
+				UsernameMatch:  usernameMatch(a, b),
+			}
+			if signals.NameSimilarity < minNameSimilarity && signals.UsernameMatch == 0 {
+				continue
+			}
+			signals.CoActivity = coActivity(activities[identityKey(a)], activities[identityKey(b)], a.Platform == b.Platform)
+
+			score := nameWeight*signals.NameSimilarity +
+				usernameWeight*signals.UsernameMatch +
+				coActivityWeight*signals.CoActivity
+			if score < opts.Threshold {
+				continue
+			}
+
+			proposals = append(proposals, Proposal{A: a, B: b, Score: score, Signals: signals})
+		}
+	}
+
+	sort.Slice(proposals, func(i, j int) bool {
+		return proposals[i].Score > proposals[j].Score
+	})
+	if opts.Limit > 0 && len(proposals) > opts.Limit {
+		proposals = proposals[:opts.Limit]
+	}
+
+	return proposals, nil
+}
+
+func identityKey(i *ent.Identity) string {
+	return i.Platform + ":" + i.PlatformUserID
+}
+
+// knownNames returns the current and previous display names of an identity.
+func knownNames(i *ent.Identity) []string {
+	return lo.Filter(append([]string{i.DisplayName}, i.AltIds...), func(name string, _ int) bool {
+		return names.Normalize(name) != ""
+	})
+}
+
+func nameSimilarity(a, b *ent.Identity) float64 {
+	best := 0.0
+	for _, na := range knownNames(a) {
+		for _, nb := range knownNames(b) {
+			best = max(best, names.Similarity(na, nb))
+		}
+	}
+
+	return best
+}
+
+func usernameMatch(a, b *ent.Identity) float64 {
+	ua, ub := names.Normalize(a.Username), names.Normalize(b.Username)
+	if ua != "" && ua == ub {
+		return 1
+	}
+
+	matches := func(username string, other *ent.Identity) bool {
+		if username == "" {
+			return false
+		}
+		return lo.ContainsBy(knownNames(other), func(name string) bool {
+			return names.Normalize(name) == username
+		})
+	}
+	if matches(ua, b) || matches(ub, a) {
+		return 1
+	}
+
+	return 0
+}
+
+// coActivity compares hour-of-day activity profiles, and for identities on the
+// same platform also the overlap of chats they post in.
+func coActivity(a, b *activity, samePlatform bool) float64 {
+	if a == nil || b == nil {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for h := range hoursPerDay {
+		dot += a.hours[h] * b.hours[h]
+		normA += a.hours[h] * a.hours[h]
+		normB += b.hours[h] * b.hours[h]
+	}
+	hourSimilarity := 0.0
+	if normA > 0 && normB > 0 {
+		hourSimilarity = dot / (math.Sqrt(normA) * math.Sqrt(normB))
+	}
+	if !samePlatform {
+		return hourSimilarity
+	}
+
+	shared := 0
+	for chat := range a.chats {
+		if _, ok := b.chats[chat]; ok {
+			shared++
+		}
+	}
+	union := len(a.chats) + len(b.chats) - shared
+	chatOverlap := 0.0
+	if union > 0 {
+		chatOverlap = float64(shared) / float64(union)
+	}
+
+	return (hourSimilarity + chatOverlap) / 2
+}
+
+func loadActivities(ctx context.Context, client *datastore.Client) (map[string]*activity, error) {
+	// The hour is taken in UTC, for every identity alike.
+	rows, err := client.QueryContext(ctx, `SELECT platform, from_id, in_chat_id,
+       platform_timestamp / 3600 % 24 AS hour,
+       count(*)
+FROM chat_messages
+WHERE workspace_id = $1 AND content <> '' AND deleted_at = 0 AND `+owners.CanonicalCondition+`
+GROUP BY 1, 2, 3, 4`, datastore.WorkspaceID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	activities := make(map[string]*activity)
+	for rows.Next() {
+		var (
+			platform, fromID, chatID string
+			hour, count              int
+		)
+		if err := rows.Scan(&platform, &fromID, &chatID, &hour, &count); err != nil {
+			return nil, err
+		}
+
+		key := platform + ":" + fromID
+		a, ok := activities[key]
+		if !ok {
+			a = &activity{chats: make(map[string]struct{})}
+			activities[key] = a
+		}
+		a.chats[chatID] = struct{}{}
+		if hour >= 0 && hour < hoursPerDay {
+			a.hours[hour] += float64(count)
+		}
+	}
+
+	return activities, rows.Err()
+}
@@ -0,0 +1,134 @@
+package persons_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/datastore/datastoretest"
+	"github.com/luoling8192/mindwave/internal/services/persons"
+	"github.com/pgvector/pgvector-go"
+)
+
+func TestProposeMerges(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t,
+		migrate.PersonsTable,
+		migrate.IdentitiesTable,
+		migrate.ChatMessagesTable,
+	)
+
+	identity := func(platform, userID, displayName, username string) *ent.Identity {
+		t.Helper()
+		i, err := client.Identity.Create().
+			SetPlatform(platform).
+			SetPlatformUserID(userID).
+			SetDisplayName(displayName).
+			SetUsername(username).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return i
+	}
+
+	// Same name and username on two platforms.
+	alice := identity("telegram", "1", "Alice Wang", "alicew")
+	aliceElsewhere := identity("discord", "a", "alice  wang", "alicew")
+	// Same username, different names, active at the same hours.
+	bob := identity("telegram", "2", "Bob", "bobby")
+	robert := identity("discord", "b", "Robert", "bobby")
+	for _, i := range []*ent.Identity{bob, robert} {
+		postAt(t, ctx, client, i, 9)
+		postAt(t, ctx, client, i, 21)
+	}
+	// Alike but opted out, they are never proposed.
+	carol := identity("telegram", "3", "Carol", "carol")
+	if err := carol.Update().SetOptedOut(true).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	identity("discord", "c", "Carol", "carol")
+	// Already in one person.
+	person, err := client.Person.Create().SetDisplayName("Dave").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, userID := range []string{"4", "5"} {
+		if err := identity("telegram", userID, "Dave", "dave").Update().SetPersonID(person.ID).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Nothing alike.
+	identity("telegram", "6", "Eve", "")
+	identity("discord", "e", "Zed", "")
+
+	proposals, err := persons.ProposeMerges(ctx, client, persons.ReviewOptions{Threshold: 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][2]uuid.UUID{{alice.ID, aliceElsewhere.ID}, {bob.ID, robert.ID}}
+	if len(proposals) != len(want) {
+		for _, p := range proposals {
+			t.Logf("proposed %s and %s at %.3f %+v", p.A.DisplayName, p.B.DisplayName, p.Score, p.Signals)
+		}
+		t.Fatalf("got %d proposals, want %d", len(proposals), len(want))
+	}
+	for i, p := range proposals {
+		if p.A.ID != want[i][0] || p.B.ID != want[i][1] {
+			t.Errorf("proposal %d pairs %s and %s, want %s and %s", i, p.A.DisplayName, p.B.DisplayName, want[i][0], want[i][1])
+		}
+	}
+
+	if s := proposals[0].Signals; s.NameSimilarity != 1 || s.UsernameMatch != 1 || s.CoActivity != 0 {
+		t.Errorf("signals of alice = %+v", s)
+	}
+	if s := proposals[1].Signals; s.UsernameMatch != 1 || s.NameSimilarity >= 0.5 || s.CoActivity < 0.999 {
+		t.Errorf("signals of bob = %+v", s)
+	}
+	if proposals[0].Score <= proposals[1].Score {
+		t.Errorf("proposals are not best first: %.3f, %.3f", proposals[0].Score, proposals[1].Score)
+	}
+
+	limited, err := persons.ProposeMerges(ctx, client, persons.ReviewOptions{Threshold: 0.5, Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limited) != 1 || limited[0].A.ID != alice.ID {
+		t.Errorf("limited to one, got %d proposals", len(limited))
+	}
+
+	strict, err := persons.ProposeMerges(ctx, client, persons.ReviewOptions{Threshold: 0.9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(strict) != 0 {
+		t.Errorf("got %d proposals above 0.9", len(strict))
+	}
+}
+
+// postAt stores a message of identity sent at the given UTC hour.
+func postAt(t *testing.T, ctx context.Context, client *datastore.Client, i *ent.Identity, hour int) {
+	t.Helper()
+	err := client.ChatMessage.Create().
+		SetPlatform(i.Platform).
+		SetPlatformMessageID(uuid.NewString()).
+		SetFromID(i.PlatformUserID).
+		SetFromName(i.DisplayName).
+		SetInChatID("chat").
+		SetInChatType("group").
+		SetContent("hello").
+		SetReplyToName("-").
+		SetReplyToID("-").
+		SetPlatformTimestamp(time.Date(2024, 1, 1, hour, 30, 0, 0, time.UTC).Unix()).
+		SetContentVector1536(pgvector.NewVector(nil)).
+		SetContentVector1024(pgvector.NewVector(nil)).
+		SetContentVector768(pgvector.NewVector(nil)).
+		Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,406 @@
+package profiles
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/luoling8192/mindwave/schema"
+	"github.com/samber/lo"
+)
+
+const (
+	// eventsPerPrompt bounds the new events merged into a profile per LLM
+	// call, maxEventsPerUpdate the events folded into one new version. The
+	// rest are picked up by the next update.
+	eventsPerPrompt    = 40
+	maxEventsPerUpdate = 400
+
+	maxEventRunes  = 300
+	maxClaimEvents = 20
+
+	// activeChats is the number of chats listed on a profile, each with up
+	// to chatEvidence of the most recent events seen there.
+	activeChats  = 5
+	chatEvidence = 5
+
+	// lockNamespace is mixed into the advisory lock key of every identity,
+	// to keep the profile locks apart from the other advisory locks.
+	lockNamespace int64 = 0x70726f66696c65
+)
+
+// sections are the profile sections in display order, with their headings.
+var sections = []struct {
+	name    string
+	heading string
+}{
+	{schema.ProfileSectionExpertise, "Expertise"},
+	{schema.ProfileSectionInterests, "Recurring interests"},
+	{schema.ProfileSectionContributions, "Notable contributions"},
+	{schema.ProfileSectionChats, "Active chats"},
+}
+
+// Builder keeps one versioned, LLM-written profile per identity. Each update
+// merges only the events linked or changed since the previous version into
+// its claims.
+type Builder struct {
+	client    *datastore.Client
+	llmClient *agent.LLMClient
+}
+
+var (
+	// ErrOptedOut is returned for identities that opted out of profiling.
+	ErrOptedOut = errors.New("identity opted out of profiling")
+	// ErrBusy is returned when another update of the same profile is
+	// running, the events it misses are picked up by the next update.
+	ErrBusy = errors.New("profile is being updated")
+)
+
+func NewBuilder(client *datastore.Client, llmClient *agent.LLMClient) *Builder {
+	return &Builder{client: client, llmClient: llmClient}
+}
+
+// Latest returns the newest profile version of an identity, or nil when it
+// has none yet.
+func Latest(ctx context.Context, client *datastore.Client, identityID uuid.UUID) (*ent.Profile, error) {
+	p, err := client.Profile.Query().
+		Where(profile.IdentityID(identityID)).
+		Order(profile.ByVersion(sql.OrderDesc())).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return p, err
+}
+
+// lockKey is the advisory lock held while the profile of an identity is
+// updated.
+func lockKey(identityID uuid.UUID) int64 {
+	return int64(binary.BigEndian.Uint64(identityID[:8])) ^ lockNamespace
+}
+
+// Update folds the events of an identity updated after its latest profile
+// version into a new version. It returns the latest version unchanged, or nil
+// when there is none, if no event is new, and ErrBusy while another update of
+// the identity runs.
+func (b *Builder) Update(ctx context.Context, identityID uuid.UUID) (result *ent.Profile, err error) {
+	outcome := "updated"
+	defer func() {
+		switch {
+		case errors.Is(err, ErrBusy):
+			outcome = "busy"
+		case err != nil:
+			outcome = "error"
+		}
+		metrics.ProfileUpdates.WithLabelValues(outcome).Inc()
+	}()
+
+	ident, err := b.client.Identity.Get(ctx, identityID)
+	if err != nil {
+		return nil, err
+	}
+	if ident.OptedOut {
+		return nil, ErrOptedOut
+	}
+
+	// Updates of one identity run one at a time, two would write the same
+	// version.
+	lock, err := b.client.TryAdvisoryLock(ctx, lockKey(identityID))
+	if err != nil {
+		return nil, err
+	}
+	if lock == nil {
+		return nil, ErrBusy
+	}
+	defer func() {
+		if releaseErr := lock.Release(context.WithoutCancel(ctx)); releaseErr != nil {
+			slog.Warn("failed to release profile lock", "identity_id", identityID, "error", releaseErr)
+		}
+	}()
+
+	previous, err := Latest(ctx, b.client, identityID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		claims    []schema.ProfileClaim
+		version   = 1
+		count     int
+		watermark int64
+		lastID    uuid.UUID
+	)
+	if previous != nil {
+		claims = previous.Claims
+		version = previous.Version + 1
+		count = previous.EventCount
+		watermark = previous.EventsUpdatedUntil
+		lastID = previous.EventsUpdatedUntilID
+	}
+
+	// Events are read in (updated_at, id) order from after the last one
+	// folded in, so events sharing an updated_at are not skipped when a
+	// version stops between them.
+	events, err := b.client.Event.Query().
+		Where(
+			event.HasIdentitiesWith(identity.ID(identityID)),
+			event.Or(
+				event.UpdatedAtGT(watermark),
+				event.And(event.UpdatedAt(watermark), event.IDGT(lastID)),
+			),
+		).
+		Order(event.ByUpdatedAt(), event.ByID()).
+		Limit(maxEventsPerUpdate).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		outcome = "unchanged"
+		return previous, nil
+	}
+
+	// The chats section is counted rather than written by the model, it is
+	// rebuilt after the other sections are merged.
+	claims = lo.Reject(claims, func(c schema.ProfileClaim, _ int) bool { return c.Section == schema.ProfileSectionChats })
+
+	for _, chunk := range lo.Chunk(events, eventsPerPrompt) {
+		claims, err = b.merge(ctx, ident, claims, chunk)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	chats, err := b.activeChats(ctx, identityID)
+	if err != nil {
+		return nil, err
+	}
+	claims = append(claims, chats...)
+
+	result, err = b.client.Profile.Create().
+		SetIdentityID(identityID).
+		SetVersion(version).
+		SetClaims(claims).
+		SetContent(Render(claims)).
+		SetEventCount(count + len(events)).
+		SetEventsUpdatedUntil(events[len(events)-1].UpdatedAt).
+		SetEventsUpdatedUntilID(events[len(events)-1].ID).
+		SetModel(agent.ProfileModel()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("profile updated",
+		"identity_id", identityID,
+		"version", result.Version,
+		"new_events", len(events),
+		"claims", len(claims),
+	)
+
+	return result, nil
+}
+
+// UpdateAll updates the profile of every identity linked to an event and
+// returns how many got a new version. Failures are logged and skipped so one
+// identity does not hold up the others.
+func (b *Builder) UpdateAll(ctx context.Context) (int, error) {
+	ids, err := b.client.Identity.Query().
+		Where(identity.HasEvents(), identity.OptedOut(false)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return updated, err
+		}
+
+		before, err := Latest(ctx, b.client, id)
+		if err != nil {
+			slog.Error("failed to load profile", "identity_id", id, "error", err)
+			continue
+		}
+		after, err := b.Update(ctx, id)
+		if errors.Is(err, ErrBusy) {
+			slog.Info("profile is being updated elsewhere, skipping", "identity_id", id)
+			continue
+		}
+		if err != nil {
+			slog.Error("failed to update profile", "identity_id", id, "error", err)
+			continue
+		}
+		if after != nil && (before == nil || after.ID != before.ID) {
+			updated++
+		}
+	}
+
+	return updated, nil
+}
+
+// merge asks the model to fold a chunk of events into claims, and maps the
+// P and E labels it cites back to event IDs.
+func (b *Builder) merge(ctx context.Context, ident *ent.Identity, claims []schema.ProfileClaim, events []*ent.Event) ([]schema.ProfileClaim, error) {
+	lines := lo.Map(claims, func(c schema.ProfileClaim, _ int) agent.ProfileLine {
+		return agent.ProfileLine{Section: c.Section, Text: c.Text}
+	})
+	eventLines := lo.Map(events, func(e *ent.Event, _ int) string { return formatEvent(e) })
+
+	merged, err := agent.UpdateProfile(ctx, b.llmClient, ident.DisplayName, lines, eventLines)
+	if err != nil {
+		return nil, err
+	}
+	if len(merged) == 0 && len(claims) > 0 {
+		return nil, errors.New("profile model returned no claims")
+	}
+
+	result := make([]schema.ProfileClaim, 0, len(merged))
+	for _, line := range merged {
+		// Newer events come first so capping keeps the freshest evidence.
+		fromEvents := make([]uuid.UUID, 0)
+		fromClaims := make([]uuid.UUID, 0)
+		for _, ref := range line.Refs {
+			if len(ref) < 2 {
+				continue
+			}
+			n, err := strconv.Atoi(ref[1:])
+			if err != nil || n < 1 {
+				continue
+			}
+			switch {
+			case ref[0] == 'E' && n <= len(events):
+				fromEvents = append(fromEvents, events[n-1].ID)
+			case ref[0] == 'P' && n <= len(claims):
+				fromClaims = append(fromClaims, claims[n-1].EventIDs...)
+			}
+		}
+
+		eventIDs := lo.Slice(lo.Uniq(append(fromEvents, fromClaims...)), 0, maxClaimEvents)
+		if len(eventIDs) == 0 {
+			slog.Warn("dropping profile claim without valid sources", "identity_id", ident.ID, "claim", line.Text)
+			continue
+		}
+
+		result = append(result, schema.ProfileClaim{
+			Section:  line.Section,
+			Text:     line.Text,
+			EventIDs: eventIDs,
+		})
+	}
+
+	return result, nil
+}
+
+type chatCount struct {
+	InChatID string `json:"in_chat_id"`
+	Count    int    `json:"count"`
+}
+
+// activeChats lists the chats with the most events of an identity, with the
+// newest events seen in each as evidence.
+func (b *Builder) activeChats(ctx context.Context, identityID uuid.UUID) ([]schema.ProfileClaim, error) {
+	counts := []chatCount{}
+	err := b.client.Event.Query().
+		Where(event.HasIdentitiesWith(identity.ID(identityID))).
+		GroupBy(event.FieldInChatID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].InChatID < counts[j].InChatID
+	})
+	counts = lo.Slice(counts, 0, activeChats)
+
+	joined, err := b.client.JoinedChat.Query().
+		Where(joinedchat.ChatIDIn(lo.Map(counts, func(c chatCount, _ int) string { return c.InChatID })...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chatNames := lo.SliceToMap(joined, func(jc *ent.JoinedChat) (string, string) { return jc.ChatID, jc.ChatName })
+
+	claims := make([]schema.ProfileClaim, 0, len(counts))
+	for _, c := range counts {
+		evidence, err := b.client.Event.Query().
+			Where(
+				event.HasIdentitiesWith(identity.ID(identityID)),
+				event.InChatID(c.InChatID),
+			).
+			Order(event.ByPlatformTimestamp(sql.OrderDesc())).
+			Limit(chatEvidence).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		name := chatNames[c.InChatID]
+		if name == "" {
+			name = c.InChatID
+		}
+		claims = append(claims, schema.ProfileClaim{
+			Section:  schema.ProfileSectionChats,
+			Text:     fmt.Sprintf("%s (%d events)", name, c.Count),
+			EventIDs: evidence,
+		})
+	}
+
+	return claims, nil
+}
+
+// Render formats claims as a Markdown profile card.
+func Render(claims []schema.ProfileClaim) string {
+	var b strings.Builder
+	for _, section := range sections {
+		inSection := lo.Filter(claims, func(c schema.ProfileClaim, _ int) bool { return c.Section == section.name })
+		if len(inSection) == 0 {
+			continue
+		}
+
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "## %s\n\n", section.heading)
+		for _, c := range inSection {
+			fmt.Fprintf(&b, "- %s\n", c.Text)
+		}
+	}
+	return b.String()
+}
+
+func formatEvent(e *ent.Event) string {
+	description := []rune(e.Description)
+	if len(description) > maxEventRunes {
+		description = append(description[:maxEventRunes], []rune("...")...)
+	}
+
+	return fmt.Sprintf("[%s] %s：%s（标签：%s；参与者：%s）",
+		time.Unix(e.PlatformTimestamp, 0).Format("2006-01-02"),
+		e.Name,
+		string(description),
+		strings.Join(e.Tags, ","),
+		e.FromName,
+	)
+}
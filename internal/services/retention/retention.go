@@ -0,0 +1,342 @@
+// Package retention purges the messages of chats once they are older than
+// the chat's retention policy, and what was distilled from them when the
+// policy asks for it.
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/askturn"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/chatretention"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/ent/identity"
+	"github.com/luoling8192/mindwave/ent/profile"
+	"github.com/luoling8192/mindwave/ent/summary"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/graph"
+	"github.com/luoling8192/mindwave/internal/jobs"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/samber/lo"
+)
+
+// citationsPerQuery bounds the ids looked up at once when matching the
+// citations of ask turns.
+const citationsPerQuery = 1000
+
+// Report tells what a policy purged, or would purge on a dry run.
+type Report struct {
+	ChatID string
+	Mode   chatretention.Mode
+	// Cutoff is the platform time before which rows are purged.
+	Cutoff time.Time
+	DryRun bool
+
+	MessagesBlanked  int
+	MessagesDeleted  int
+	EventsDeleted    int
+	SummariesDeleted int
+	AskTurnsDeleted  int
+	ProfilesDeleted  int
+	// GraphErrors lists the graph writes that failed after the database
+	// was purged.
+	GraphErrors []string
+}
+
+// Set stores the retention policy of a chat, replacing the previous one.
+func Set(ctx context.Context, client *datastore.Client, chatID string, days int, mode chatretention.Mode, enabled bool) (*ent.ChatRetention, error) {
+	if chatID == "" {
+		return nil, errors.New("chat id is required")
+	}
+	if days <= 0 {
+		return nil, fmt.Errorf("retention days must be positive, got %d", days)
+	}
+	if err := chatretention.ModeValidator(mode); err != nil {
+		return nil, err
+	}
+
+	id, err := client.ChatRetention.Create().
+		SetChatID(chatID).
+		SetDays(days).
+		SetMode(mode).
+		SetEnabled(enabled).
+		OnConflictColumns(chatretention.FieldWorkspaceID, chatretention.FieldChatID).
+		Update(func(u *ent.ChatRetentionUpsert) {
+			u.UpdateDays()
+			u.UpdateMode()
+			u.UpdateEnabled()
+			u.UpdateUpdatedAt()
+		}).
+		ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.ChatRetention.Get(ctx, id)
+}
+
+// Run applies every enabled policy and returns a report per chat. A failing
+// policy is logged and the others still run.
+func Run(ctx context.Context, client *datastore.Client, graphWriter *graph.Writer, now time.Time, dryRun bool) ([]*Report, error) {
+	policies, err := client.ChatRetention.Query().
+		Where(chatretention.Enabled(true)).
+		Order(chatretention.ByChatID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*Report, 0, len(policies))
+	var errs []error
+	for _, policy := range policies {
+		report, err := Apply(ctx, client, graphWriter, policy, now, dryRun)
+		if err != nil {
+			slog.Error("failed to apply retention policy", "chat_id", policy.ChatID, "error", err)
+			errs = append(errs, fmt.Errorf("chat %s: %w", policy.ChatID, err))
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports, errors.Join(errs...)
+}
+
+// Apply purges the rows of the policy's chat older than its cutoff. In
+// content mode the content and tokens of the messages are blanked, their
+// vectors, the events and the summaries are kept. Blanked messages keep
+// deleted_at at 0, it is set by the crawler when a message is deleted on the
+// platform, and every read path leaves out empty content as well. In all mode the messages
+// are deleted together with the events and summaries of the same period, the
+// ask turns citing them and the profiles of the identities linked to the
+// events. Profiles are rebuilt from the remaining events by profile jobs. The
+// LLM cache is kept, its entries do not tell which chat they came from and
+// expire after LLM_CACHE_TTL. A dry run rolls the purge back and only reports
+// it.
+func Apply(
+	ctx context.Context,
+	client *datastore.Client,
+	graphWriter *graph.Writer,
+	policy *ent.ChatRetention,
+	now time.Time,
+	dryRun bool,
+) (*Report, error) {
+	report := &Report{
+		ChatID: policy.ChatID,
+		Mode:   policy.Mode,
+		Cutoff: now.AddDate(0, 0, -policy.Days),
+		DryRun: dryRun,
+	}
+	cutoff := report.Cutoff.Unix()
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedEvents, rebuiltProfiles []uuid.UUID
+	switch policy.Mode {
+	case chatretention.ModeContent:
+		report.MessagesBlanked, err = blankMessages(ctx, tx, policy.ChatID, cutoff)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	case chatretention.ModeAll:
+		// Ask turns and profiles are found through the messages and events
+		// they were written from, before those go.
+		report.AskTurnsDeleted, err = deleteAskTurns(ctx, tx, policy.ChatID, cutoff)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		rebuiltProfiles, err = tx.Identity.Query().
+			Where(identity.HasEventsWith(
+				event.InChatID(policy.ChatID),
+				event.PlatformTimestampLT(cutoff),
+			)).
+			IDs(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		if len(rebuiltProfiles) > 0 {
+			report.ProfilesDeleted, err = tx.Profile.Delete().
+				Where(profile.IdentityIDIn(rebuiltProfiles...)).
+				Exec(ctx)
+			if err != nil {
+				return nil, rollback(tx, err)
+			}
+		}
+
+		report.MessagesDeleted, err = tx.ChatMessage.Delete().
+			Where(
+				chatmessage.InChatID(policy.ChatID),
+				chatmessage.PlatformTimestampLT(cutoff),
+			).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		// Events and summaries carry the end of their window as platform
+		// timestamp, only those over entirely before the cutoff go.
+		deletedEvents, err = tx.Event.Query().
+			Where(
+				event.InChatID(policy.ChatID),
+				event.PlatformTimestampLT(cutoff),
+			).
+			IDs(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		if len(deletedEvents) > 0 {
+			report.EventsDeleted, err = tx.Event.Delete().
+				Where(event.IDIn(deletedEvents...)).
+				Exec(ctx)
+			if err != nil {
+				return nil, rollback(tx, err)
+			}
+		}
+
+		report.SummariesDeleted, err = tx.Summary.Delete().
+			Where(
+				summary.InChatID(policy.ChatID),
+				summary.PlatformTimestampLT(cutoff),
+			).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	default:
+		return nil, rollback(tx, fmt.Errorf("unknown retention mode %q", policy.Mode))
+	}
+
+	if dryRun {
+		return report, tx.Rollback()
+	}
+
+	err = tx.ChatRetention.UpdateOneID(policy.ID).
+		SetLastRunAt(now.UnixMilli()).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	metrics.RetentionPurged.WithLabelValues("messages_blanked").Add(float64(report.MessagesBlanked))
+	metrics.RetentionPurged.WithLabelValues("messages_deleted").Add(float64(report.MessagesDeleted))
+	metrics.RetentionPurged.WithLabelValues("events").Add(float64(report.EventsDeleted))
+	metrics.RetentionPurged.WithLabelValues("summaries").Add(float64(report.SummariesDeleted))
+	metrics.RetentionPurged.WithLabelValues("ask_turns").Add(float64(report.AskTurnsDeleted))
+	metrics.RetentionPurged.WithLabelValues("profiles").Add(float64(report.ProfilesDeleted))
+
+	for _, identityID := range rebuiltProfiles {
+		if _, err := jobs.Enqueue(ctx, client, jobs.KindProfile, jobs.ProfilePayload{IdentityID: identityID}, jobs.EnqueueOptions{}); err != nil {
+			slog.Warn("failed to enqueue profile rebuild", "identity_id", identityID, "error", err)
+		}
+	}
+
+	if graphWriter != nil {
+		for _, eventID := range deletedEvents {
+			if err := graphWriter.DeleteEvent(ctx, eventID.String()); err != nil {
+				slog.Warn("failed to delete event from graph", "event_id", eventID, "error", err)
+				report.GraphErrors = append(report.GraphErrors, fmt.Sprintf("delete event %s: %v", eventID, err))
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// blankMessages empties the content and tokens of the chat's messages sent
+// before cutoff. It bypasses the builder, whose validators refuse empty
+// content, the read paths already leave such messages out.
+func blankMessages(ctx context.Context, tx *ent.Tx, chatID string, cutoff int64) (int, error) {
+	stmt := fmt.Sprintf(
+		"UPDATE %s SET content = '', jieba_tokens = '[]' WHERE workspace_id = $1 AND in_chat_id = $2 AND platform_timestamp < $3 AND content <> ''",
+		chatmessage.Table,
+	)
+	result, err := tx.ExecContext(ctx, stmt, datastore.WorkspaceID(ctx), chatID, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+// deleteAskTurns deletes the ask turns citing a message or event of the chat
+// from before cutoff, their answers were written from them. Citations are
+// matched here rather than in SQL, a workspace holds few ask turns next to
+// the messages they cite.
+func deleteAskTurns(ctx context.Context, tx *ent.Tx, chatID string, cutoff int64) (int, error) {
+	turns, err := tx.AskTurn.Query().
+		Select(askturn.FieldCitedMessageIds, askturn.FieldEventIds).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var messageIDs, eventIDs []uuid.UUID
+	for _, t := range turns {
+		messageIDs = append(messageIDs, t.CitedMessageIds...)
+		eventIDs = append(eventIDs, t.EventIds...)
+	}
+
+	purged := make(map[uuid.UUID]bool)
+	for _, chunk := range lo.Chunk(lo.Uniq(messageIDs), citationsPerQuery) {
+		ids, err := tx.ChatMessage.Query().
+			Where(
+				chatmessage.IDIn(chunk...),
+				chatmessage.InChatID(chatID),
+				chatmessage.PlatformTimestampLT(cutoff),
+			).
+			IDs(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, id := range ids {
+			purged[id] = true
+		}
+	}
+	for _, chunk := range lo.Chunk(lo.Uniq(eventIDs), citationsPerQuery) {
+		ids, err := tx.Event.Query().
+			Where(
+				event.IDIn(chunk...),
+				event.InChatID(chatID),
+				event.PlatformTimestampLT(cutoff),
+			).
+			IDs(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, id := range ids {
+			purged[id] = true
+		}
+	}
+
+	cites := func(id uuid.UUID) bool { return purged[id] }
+	doomed := make([]uuid.UUID, 0)
+	for _, t := range turns {
+		if lo.ContainsBy(t.CitedMessageIds, cites) || lo.ContainsBy(t.EventIds, cites) {
+			doomed = append(doomed, t.ID)
+		}
+	}
+	if len(doomed) == 0 {
+		return 0, nil
+	}
+	return tx.AskTurn.Delete().Where(askturn.IDIn(doomed...)).Exec(ctx)
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %w", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,309 @@
+package retention_test
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/askturn"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/chatretention"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/datastore/datastoretest"
+	"github.com/luoling8192/mindwave/internal/jobs"
+	"github.com/luoling8192/mindwave/internal/services/retention"
+	"github.com/pgvector/pgvector-go"
+)
+
+var now = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// fixture holds the ids of the rows seeded in chat "purged", one of each kind
+// before the 30 day cutoff and one after it, and the rows of chat "kept"
+// which no policy touches.
+type fixture struct {
+	oldMessage, newMessage, keptMessage uuid.UUID
+	oldEvent, newEvent                  uuid.UUID
+	oldIdentity, newIdentity            uuid.UUID
+}
+
+func seed(t *testing.T, ctx context.Context, client *datastore.Client) fixture {
+	t.Helper()
+
+	cutoff := now.AddDate(0, 0, -30)
+	before, after := cutoff.Add(-time.Hour).Unix(), cutoff.Add(time.Hour).Unix()
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	message := func(chatID string, timestamp int64) uuid.UUID {
+		m, err := client.ChatMessage.Create().
+			SetPlatform("telegram").
+			SetPlatformMessageID(uuid.NewString()).
+			SetFromID("alice").
+			SetFromName("Alice").
+			SetInChatID(chatID).
+			SetInChatType("group").
+			SetContent("hello from " + chatID).
+			SetJiebaTokens([]string{"hello"}).
+			SetReplyToName("-").
+			SetReplyToID("-").
+			SetPlatformTimestamp(timestamp).
+			SetContentVector1536(pgvector.NewVector([]float32{0})).
+			SetContentVector1024(pgvector.NewVector([]float32{0})).
+			SetContentVector768(pgvector.NewVector([]float32{0})).
+			Save(ctx)
+		must(err)
+		return m.ID
+	}
+	identity := func(name string) uuid.UUID {
+		i, err := client.Identity.Create().SetPlatform("telegram").SetPlatformUserID(name).SetDisplayName(name).Save(ctx)
+		must(err)
+		must(client.Profile.Create().SetIdentityID(i.ID).SetVersion(1).SetContent(name + " likes Go").Exec(ctx))
+		return i.ID
+	}
+	event := func(timestamp int64, identityID uuid.UUID) uuid.UUID {
+		e, err := client.Event.Create().
+			SetPlatform("telegram").
+			SetName("talk").
+			SetDescription("a talk").
+			SetFromName("Alice").
+			SetInChatID("purged").
+			SetInChatType("group").
+			SetPlatformTimestamp(timestamp).
+			AddIdentityIDs(identityID).
+			Save(ctx)
+		must(err)
+		return e.ID
+	}
+	summary := func(chatID string, timestamp int64) {
+		must(client.Summary.Create().SetInChatID(chatID).SetContent("summary").SetPlatformTimestamp(timestamp).Exec(ctx))
+	}
+	askTurn := func(question string, messageIDs, eventIDs []uuid.UUID) {
+		must(client.AskTurn.Create().
+			SetConversationID(uuid.New()).
+			SetQuestion(question).
+			SetCitedMessageIds(messageIDs).
+			SetEventIds(eventIDs).
+			Exec(ctx))
+	}
+
+	f := fixture{
+		oldMessage:  message("purged", before),
+		newMessage:  message("purged", after),
+		keptMessage: message("kept", before),
+		oldIdentity: identity("old"),
+		newIdentity: identity("new"),
+	}
+	f.oldEvent = event(before, f.oldIdentity)
+	f.newEvent = event(after, f.newIdentity)
+	summary("purged", before)
+	summary("purged", after)
+	summary("kept", before)
+
+	askTurn("cites the old message", []uuid.UUID{f.newMessage, f.oldMessage}, nil)
+	askTurn("cites the old event", nil, []uuid.UUID{f.oldEvent})
+	askTurn("cites recent rows", []uuid.UUID{f.newMessage}, []uuid.UUID{f.newEvent})
+	askTurn("cites another chat", []uuid.UUID{f.keptMessage}, nil)
+	askTurn("cites nothing", nil, nil)
+
+	return f
+}
+
+func newClient(t *testing.T) *datastore.Client {
+	return datastoretest.NewClient(t,
+		migrate.ChatRetentionsTable,
+		migrate.ChatMessagesTable,
+		migrate.EventsTable,
+		migrate.SummariesTable,
+		migrate.AskTurnsTable,
+		migrate.PersonsTable,
+		migrate.IdentitiesTable,
+		migrate.IdentityEventsTable,
+		migrate.ProfilesTable,
+		migrate.JobsTable,
+	)
+}
+
+// counts returns the number of rows of each purged kind.
+func counts(t *testing.T, ctx context.Context, client *datastore.Client) map[string]int {
+	t.Helper()
+
+	result := make(map[string]int)
+	for name, count := range map[string]func(context.Context) (int, error){
+		"messages":  client.ChatMessage.Query().Count,
+		"blanked":   client.ChatMessage.Query().Where(chatmessage.Content("")).Count,
+		"events":    client.Event.Query().Count,
+		"summaries": client.Summary.Query().Count,
+		"ask_turns": client.AskTurn.Query().Count,
+		"profiles":  client.Profile.Query().Count,
+		"jobs":      client.Job.Query().Count,
+	} {
+		n, err := count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result[name] = n
+	}
+	return result
+}
+
+func equalCounts(t *testing.T, got, want map[string]int) {
+	t.Helper()
+	for name, n := range want {
+		if got[name] != n {
+			t.Errorf("%d %s left, want %d", got[name], name, n)
+		}
+	}
+}
+
+func TestContentMode(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t)
+	f := seed(t, ctx, client)
+	seeded := counts(t, ctx, client)
+
+	if _, err := retention.Set(ctx, client, "purged", 30, chatretention.ModeContent, true); err != nil {
+		t.Fatal(err)
+	}
+	reports, err := retention.Run(ctx, client, nil, now, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 || reports[0].MessagesBlanked != 1 || reports[0].MessagesDeleted != 0 || reports[0].EventsDeleted != 0 {
+		t.Fatalf("reports = %+v, want one message blanked", reports)
+	}
+
+	want := maps.Clone(seeded)
+	want["blanked"] = 1
+	equalCounts(t, counts(t, ctx, client), want)
+
+	old, err := client.ChatMessage.Get(ctx, f.oldMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old.Content != "" || len(old.JiebaTokens) != 0 {
+		t.Errorf("old message kept %q with tokens %v", old.Content, old.JiebaTokens)
+	}
+	if old.DeletedAt != 0 {
+		t.Errorf("blanking set deleted_at to %d, it is left for messages deleted on the platform", old.DeletedAt)
+	}
+	for _, id := range []uuid.UUID{f.newMessage, f.keptMessage} {
+		m, err := client.ChatMessage.Get(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Content == "" {
+			t.Errorf("message of %s at %d was blanked", m.InChatID, m.PlatformTimestamp)
+		}
+	}
+
+	policy, err := client.ChatRetention.Query().Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policy.LastRunAt != now.UnixMilli() {
+		t.Errorf("last run at %d, want %d", policy.LastRunAt, now.UnixMilli())
+	}
+}
+
+func TestAllMode(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(t)
+	f := seed(t, ctx, client)
+	seeded := counts(t, ctx, client)
+
+	if _, err := retention.Set(ctx, client, "purged", 30, chatretention.ModeAll, true); err != nil {
+		t.Fatal(err)
+	}
+	// A disabled policy is not run.
+	if _, err := retention.Set(ctx, client, "kept", 1, chatretention.ModeAll, false); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(report *retention.Report) {
+		t.Helper()
+		if report.ChatID != "purged" || report.MessagesDeleted != 1 || report.EventsDeleted != 1 || report.SummariesDeleted != 1 ||
+			report.AskTurnsDeleted != 2 || report.ProfilesDeleted != 1 || report.MessagesBlanked != 0 {
+			t.Errorf("report = %+v", report)
+		}
+	}
+
+	reports, err := retention.Run(ctx, client, nil, now, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 || !reports[0].DryRun {
+		t.Fatalf("dry run reports = %+v", reports)
+	}
+	check(reports[0])
+	equalCounts(t, counts(t, ctx, client), seeded)
+	policies, err := client.ChatRetention.Query().All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range policies {
+		if p.LastRunAt != 0 {
+			t.Errorf("the dry run recorded a run of %s", p.ChatID)
+		}
+	}
+
+	reports, err = retention.Run(ctx, client, nil, now, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("reports = %+v", reports)
+	}
+	check(reports[0])
+	equalCounts(t, counts(t, ctx, client), map[string]int{
+		"messages":  seeded["messages"] - 1,
+		"events":    seeded["events"] - 1,
+		"summaries": seeded["summaries"] - 1,
+		"ask_turns": seeded["ask_turns"] - 2,
+		"profiles":  seeded["profiles"] - 1,
+		"jobs":      1,
+	})
+
+	if exists, _ := client.ChatMessage.Query().Where(chatmessage.ID(f.oldMessage)).Exist(ctx); exists {
+		t.Error("the old message was kept")
+	}
+	if _, err := client.Event.Get(ctx, f.oldEvent); !ent.IsNotFound(err) {
+		t.Errorf("the old event was kept, %v", err)
+	}
+	if _, err := client.Event.Get(ctx, f.newEvent); err != nil {
+		t.Errorf("the new event was deleted, %v", err)
+	}
+	questions, err := client.AskTurn.Query().Select(askturn.FieldQuestion).Strings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(questions) != 3 {
+		t.Errorf("ask turns left: %q", questions)
+	}
+	for _, q := range questions {
+		if q == "cites the old message" || q == "cites the old event" {
+			t.Errorf("ask turn %q was kept", q)
+		}
+	}
+
+	// The profile drawn from the deleted event is rebuilt by a job.
+	job, err := client.Job.Query().Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload jobs.ProfilePayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if job.Kind != jobs.KindProfile || payload.IdentityID != f.oldIdentity {
+		t.Errorf("enqueued %s for %s, want a profile rebuild of %s", job.Kind, payload.IdentityID, f.oldIdentity)
+	}
+}
@@ -0,0 +1,361 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/chatschedule"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// DefaultLockKey is the advisory lock the scheduler replicas compete
+	// for, only the holder runs jobs.
+	DefaultLockKey int64 = 0x6d696e6477617665
+
+	defaultConcurrency = 2
+	defaultMaxCatchUp  = 48
+	defaultTick        = time.Minute
+
+	// initialLookback bounds the search for the last finished window of a
+	// schedule that never ran.
+	initialLookback = 31 * 24 * time.Hour
+)
+
+// DistillFunc distills the messages of a chat in [start, end).
+type DistillFunc func(ctx context.Context, chatID string, start, end time.Time) error
+
+type Options struct {
+	// Concurrency bounds the distill jobs running at once.
+	Concurrency int
+	// MaxCatchUp bounds the missed windows distilled per chat after
+	// downtime, older ones are skipped.
+	MaxCatchUp int
+	// Tick is how often schedules are checked for finished windows, and how
+	// often a standby replica retries the leader lock.
+	Tick    time.Duration
+	LockKey int64
+}
+
+// ScheduleConfig is a schedule declared in the config file.
+type ScheduleConfig struct {
+	ChatID  string `json:"chat_id"`
+	Cron    string `json:"cron"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+type Config struct {
+	Schedules []ScheduleConfig `json:"schedules"`
+}
+
+// LoadConfig reads schedules from a JSON file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse scheduler config %s: %w", path, err)
+	}
+	return &config, nil
+}
+
+// ParseCron parses a standard five-field cron expression, descriptors like
+// @daily and a CRON_TZ= prefix are accepted.
+func ParseCron(expr string) (cron.Schedule, error) {
+	return cron.ParseStandard(expr)
+}
+
+// Sync stores configured schedules in the database, where the scheduler reads
+// them from. Schedules only in the database are left alone. A schedule whose
+// cron changes starts over at the last finished window of the new cron, its
+// windows so far followed the old cadence.
+func Sync(ctx context.Context, client *datastore.Client, schedules []ScheduleConfig) error {
+	for _, s := range schedules {
+		if s.ChatID == "" {
+			return errors.New("schedule chat_id is required")
+		}
+		if _, err := ParseCron(s.Cron); err != nil {
+			return fmt.Errorf("invalid cron for chat %s: %w", s.ChatID, err)
+		}
+
+		current, err := client.ChatSchedule.Query().
+			Where(chatschedule.ChatID(s.ChatID)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		cronChanged := current != nil && current.Cron != s.Cron
+
+		enabled := s.Enabled == nil || *s.Enabled
+		err = client.ChatSchedule.Create().
+			SetChatID(s.ChatID).
+			SetCron(s.Cron).
+			SetEnabled(enabled).
+			OnConflictColumns(chatschedule.FieldWorkspaceID, chatschedule.FieldChatID).
+			Update(func(u *ent.ChatScheduleUpsert) {
+				u.UpdateCron()
+				u.UpdateEnabled()
+				u.UpdateUpdatedAt()
+				if cronChanged {
+					u.SetLastWindowEnd(0)
+				}
+			}).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Scheduler distills chats on their cron schedules. Replicas elect a leader
+// through a Postgres advisory lock, the others stand by until it goes away.
+type Scheduler struct {
+	client  *datastore.Client
+	distill DistillFunc
+	opts    Options
+
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+func New(client *datastore.Client, distill DistillFunc, opts Options) *Scheduler {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultConcurrency
+	}
+	if opts.MaxCatchUp <= 0 {
+		opts.MaxCatchUp = defaultMaxCatchUp
+	}
+	if opts.Tick <= 0 {
+		opts.Tick = defaultTick
+	}
+	if opts.LockKey == 0 {
+		opts.LockKey = DefaultLockKey
+	}
+
+	return &Scheduler{
+		client:  client,
+		distill: distill,
+		opts:    opts,
+		running: make(map[string]bool),
+	}
+}
+
+// Run schedules jobs until ctx is done, as leader or waiting to become one.
+func (s *Scheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.opts.Tick)
+	defer ticker.Stop()
+
+	for {
+		lock, err := s.client.TryAdvisoryLock(ctx, s.opts.LockKey)
+		switch {
+		case err != nil:
+			slog.Error("failed to take scheduler lock", "error", err)
+		case lock == nil:
+			slog.Debug("Another scheduler is leading, standing by")
+		default:
+			s.lead(ctx, lock, ticker.C)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// lead runs jobs while the lock is held. Jobs are cancelled when leadership
+// is lost, the next leader picks their windows up again.
+func (s *Scheduler) lead(ctx context.Context, lock *datastore.AdvisoryLock, tick <-chan time.Time) {
+	slog.Info("Became scheduler leader")
+	metrics.SchedulerLeader.Set(1)
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+		metrics.SchedulerLeader.Set(0)
+		releaseCtx, releaseCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer releaseCancel()
+		if err := lock.Release(releaseCtx); err != nil {
+			slog.Warn("failed to release scheduler lock", "error", err)
+		}
+	}()
+
+	semaphore := make(chan struct{}, s.opts.Concurrency)
+	for {
+		if err := s.schedule(leaderCtx, &wg, semaphore); err != nil {
+			slog.Error("failed to schedule jobs", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+		}
+
+		if err := lock.Check(ctx); err != nil {
+			slog.Warn("lost scheduler lock", "error", err)
+			return
+		}
+	}
+}
+
+// schedule starts a job for every chat with finished windows that is not
+// already being distilled. A chat's windows are distilled in order, one at a
+// time.
+func (s *Scheduler) schedule(ctx context.Context, wg *sync.WaitGroup, semaphore chan struct{}) error {
+	schedules, err := s.client.ChatSchedule.Query().
+		Where(chatschedule.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, cs := range schedules {
+		if s.isRunning(cs.ChatID) {
+			continue
+		}
+
+		sched, err := ParseCron(cs.Cron)
+		if err != nil {
+			slog.Error("invalid schedule", "chat_id", cs.ChatID, "cron", cs.Cron, "error", err)
+			continue
+		}
+
+		cs, err = s.initialize(ctx, cs, sched, now)
+		if err != nil {
+			slog.Error("failed to initialize schedule", "chat_id", cs.ChatID, "error", err)
+			continue
+		}
+
+		windows := finishedWindows(sched, time.UnixMilli(cs.LastWindowEnd), now)
+		metrics.SchedulerPendingWindows.WithLabelValues(cs.ChatID).Set(float64(len(windows)))
+		if len(windows) == 0 {
+			continue
+		}
+		if skipped := len(windows) - s.opts.MaxCatchUp; skipped > 0 {
+			slog.Warn("skipping missed windows beyond the catch-up limit", "chat_id", cs.ChatID, "skipped", skipped)
+			metrics.SchedulerJobs.WithLabelValues("skipped").Add(float64(skipped))
+			windows = windows[skipped:]
+		}
+
+		metrics.SchedulerJobs.WithLabelValues("enqueued").Add(float64(len(windows)))
+		s.setRunning(cs.ChatID, true)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer s.setRunning(cs.ChatID, false)
+			s.runWindows(ctx, cs, windows, semaphore)
+		}()
+	}
+
+	return nil
+}
+
+// runWindows distills windows in order and records each as done, stopping at
+// the first failure so it is retried on the next tick.
+func (s *Scheduler) runWindows(ctx context.Context, cs *ent.ChatSchedule, windows []window, semaphore chan struct{}) {
+	for i, w := range windows {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+
+		metrics.SchedulerJobsRunning.Inc()
+		started := time.Now()
+		err := s.distill(ctx, cs.ChatID, w.start, w.end)
+		metrics.SchedulerJobsRunning.Dec()
+		<-semaphore
+
+		status := "succeeded"
+		if err != nil {
+			status = "failed"
+		}
+		metrics.SchedulerJobs.WithLabelValues(status).Inc()
+		metrics.SchedulerJobDuration.WithLabelValues(status).Observe(time.Since(started).Seconds())
+
+		if err != nil {
+			slog.Error("scheduled distill failed", "chat_id", cs.ChatID, "start", w.start, "end", w.end, "error", err)
+			return
+		}
+
+		err = s.client.ChatSchedule.UpdateOneID(cs.ID).
+			SetLastWindowEnd(w.end.UnixMilli()).
+			Exec(ctx)
+		if err != nil {
+			slog.Error("failed to record distilled window", "chat_id", cs.ChatID, "error", err)
+			return
+		}
+		metrics.SchedulerLastWindowEnd.WithLabelValues(cs.ChatID).Set(float64(w.end.Unix()))
+		metrics.SchedulerPendingWindows.WithLabelValues(cs.ChatID).Set(float64(len(windows) - i - 1))
+		slog.Info("Scheduled distill finished", "chat_id", cs.ChatID, "start", w.start, "end", w.end, "duration", time.Since(started))
+	}
+}
+
+// initialize starts a schedule that never ran at the beginning of its last
+// finished window, rather than at the beginning of time.
+func (s *Scheduler) initialize(ctx context.Context, cs *ent.ChatSchedule, sched cron.Schedule, now time.Time) (*ent.ChatSchedule, error) {
+	if cs.LastWindowEnd > 0 {
+		return cs, nil
+	}
+
+	start := now
+	var previous, last time.Time
+	for t := sched.Next(now.Add(-initialLookback)); !t.IsZero() && !t.After(now); t = sched.Next(t) {
+		previous, last = last, t
+	}
+	if !previous.IsZero() {
+		start = previous
+	}
+
+	return cs.Update().SetLastWindowEnd(start.UnixMilli()).Save(ctx)
+}
+
+func (s *Scheduler) isRunning(chatID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running[chatID]
+}
+
+func (s *Scheduler) setRunning(chatID string, running bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if running {
+		s.running[chatID] = true
+	} else {
+		delete(s.running, chatID)
+	}
+}
+
+type window struct {
+	start, end time.Time
+}
+
+// finishedWindows are the windows between consecutive fire times after since
+// that ended by now.
+func finishedWindows(sched cron.Schedule, since, now time.Time) []window {
+	windows := make([]window, 0)
+	start := since
+	for end := sched.Next(since); !end.IsZero() && !end.After(now); end = sched.Next(end) {
+		windows = append(windows, window{start: start, end: end})
+		start = end
+	}
+	return windows
+}
@@ -0,0 +1,7 @@
+package search
+
+import "context"
+
+func Where(ctx context.Context, f Filter, offset int) ([]string, []any) {
+	return f.where(ctx, offset)
+}
@@ -0,0 +1,431 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/pgvector/pgvector-go"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultLimit = 20
+	// rrfK dampens the contribution of top ranks in reciprocal rank fusion,
+	// 60 is the value used in the original paper.
+	rrfK = 60
+	// candidateFactor controls how many candidates each retriever returns
+	// before fusion, relative to the requested limit.
+	candidateFactor = 3
+)
+
+type Mode string
+
+const (
+	ModeHybrid   Mode = "hybrid"
+	ModeSemantic Mode = "semantic"
+	ModeKeyword  Mode = "keyword"
+)
+
+// ParseMode returns the mode named by name, hybrid when it is empty.
+func ParseMode(name string) (Mode, error) {
+	switch mode := Mode(name); mode {
+	case "":
+		return ModeHybrid, nil
+	case ModeHybrid, ModeSemantic, ModeKeyword:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown search mode %q, expected hybrid, semantic or keyword", name)
+	}
+}
+
+// Filter narrows the set of messages considered by a search. Zero values are ignored.
+type Filter struct {
+	ChatID     string
+	SenderID   string
+	SenderName string
+	Platform   string
+	Since      time.Time
+	Until      time.Time
+	// ChatIDs limits the search to these chats unless nil, an empty slice
+	// matches nothing.
+	ChatIDs []string
+	// OwnerAccountID searches the copies stored by one crawling account
+	// instead of one copy of every message.
+	OwnerAccountID uuid.UUID
+}
+
+type Options struct {
+	Query  string
+	Mode   Mode
+	Filter Filter
+	Limit  int
+	// ContextSize is the number of messages returned on each side of a hit,
+	// zero or less returns none.
+	ContextSize int
+}
+
+// Hit is a single matched message together with its neighbours in the same chat.
+type Hit struct {
+	Message     *ent.ChatMessage   `json:"message"`
+	Score       float64            `json:"score"`
+	VectorRank  int                `json:"vector_rank,omitempty"`
+	KeywordRank int                `json:"keyword_rank,omitempty"`
+	Before      []*ent.ChatMessage `json:"before"`
+	After       []*ent.ChatMessage `json:"after"`
+}
+
+// Tokenizer splits a query into the same kind of tokens stored in jieba_tokens.
+type Tokenizer func(text string) []string
+
+type Searcher struct {
+	client         *datastore.Client
+	llmClient      *agent.LLMClient
+	embeddingModel agent.EmbeddingModel
+	tokenize       Tokenizer
+}
+
+func NewSearcher(client *datastore.Client, llmClient *agent.LLMClient, embeddingModel agent.EmbeddingModel, tokenize Tokenizer) (*Searcher, error) {
+	if _, err := VectorColumn(embeddingModel.Dimensions); err != nil {
+		return nil, err
+	}
+	if tokenize == nil {
+		tokenize = strings.Fields
+	}
+
+	return &Searcher{
+		client:         client,
+		llmClient:      llmClient,
+		embeddingModel: embeddingModel,
+		tokenize:       tokenize,
+	}, nil
+}
+
+// Tokenize splits text the same way queries are split for keyword search.
+func (s *Searcher) Tokenize(text string) []string {
+	return s.tokenize(text)
+}
+
+// EmbeddingModel returns the model queries are embedded with.
+func (s *Searcher) EmbeddingModel() agent.EmbeddingModel {
+	return s.embeddingModel
+}
+
+// VectorColumn returns the chat_messages column holding vectors of the given size.
+func VectorColumn(dimensions int) (string, error) {
+	switch dimensions {
+	case 1536:
+		return chatmessage.FieldContentVector1536, nil
+	case 1024:
+		return chatmessage.FieldContentVector1024, nil
+	case 768:
+		return chatmessage.FieldContentVector768, nil
+	default:
+		return "", fmt.Errorf("unsupported embedding dimensions: %d", dimensions)
+	}
+}
+
+func (s *Searcher) Search(ctx context.Context, opts Options) (hits []Hit, err error) {
+	startTotal := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.SearchDuration.WithLabelValues(string(opts.Mode), status).Observe(time.Since(startTotal).Seconds())
+	}()
+
+	opts.Query = strings.TrimSpace(opts.Query)
+	if opts.Query == "" {
+		return nil, errors.New("query is required")
+	}
+	if opts.Mode == "" {
+		opts.Mode = ModeHybrid
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = defaultLimit
+	}
+
+	candidates := opts.Limit * candidateFactor
+
+	var vectorIDs, keywordIDs []uuid.UUID
+	switch opts.Mode {
+	case ModeSemantic:
+		vectorIDs, err = s.vectorSearch(ctx, opts.Query, opts.Filter, candidates)
+	case ModeKeyword:
+		keywordIDs, err = s.keywordSearch(ctx, opts.Query, opts.Filter, candidates)
+	case ModeHybrid:
+		vectorIDs, err = s.vectorSearch(ctx, opts.Query, opts.Filter, candidates)
+		if err == nil {
+			keywordIDs, err = s.keywordSearch(ctx, opts.Query, opts.Filter, candidates)
+		}
+	default:
+		return nil, fmt.Errorf("unknown search mode: %s", opts.Mode)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fused := FuseRanks(vectorIDs, keywordIDs)
+	if len(fused) > opts.Limit {
+		fused = fused[:opts.Limit]
+	}
+	if len(fused) == 0 {
+		return []Hit{}, nil
+	}
+
+	messages, err := s.client.ChatMessage.Query().
+		Where(
+			chatmessage.IDIn(lo.Map(fused, func(r Ranked, _ int) uuid.UUID { return r.ID })...),
+			chatmessage.DeletedAt(0),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := lo.KeyBy(messages, func(m *ent.ChatMessage) uuid.UUID { return m.ID })
+
+	hits = make([]Hit, 0, len(fused))
+	for _, ranked := range fused {
+		message, ok := byID[ranked.ID]
+		if !ok {
+			continue
+		}
+
+		before, after, err := s.surrounding(ctx, message, opts.Filter.OwnerAccountID, opts.ContextSize)
+		if err != nil {
+			slog.Warn("failed to fetch context messages", "error", err, "message_id", message.ID)
+		}
+
+		hits = append(hits, Hit{
+			Message:     message,
+			Score:       ranked.Score,
+			VectorRank:  ranked.VectorRank,
+			KeywordRank: ranked.KeywordRank,
+			Before:      before,
+			After:       after,
+		})
+	}
+
+	metrics.SearchResultsCount.WithLabelValues(string(opts.Mode)).Observe(float64(len(hits)))
+
+	return hits, nil
+}
+
+func (s *Searcher) vectorSearch(ctx context.Context, query string, filter Filter, limit int) ([]uuid.UUID, error) {
+	column, err := VectorColumn(s.embeddingModel.Dimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	vectors, err := agent.EmbedTexts(ctx, s.llmClient, s.embeddingModel, []string{query})
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+
+	where, args := filter.where(ctx, 1)
+	args = append([]any{pgvector.NewVector(vectors[0])}, args...)
+	where = append(where, column+" IS NOT NULL")
+
+	stmt := fmt.Sprintf(
+		`SELECT id FROM chat_messages WHERE %s ORDER BY %s <-> $1 LIMIT %d`,
+		strings.Join(where, " AND "),
+		column,
+		limit,
+	)
+
+	return s.queryIDs(ctx, stmt, args...)
+}
+
+func (s *Searcher) keywordSearch(ctx context.Context, query string, filter Filter, limit int) ([]uuid.UUID, error) {
+	tokens := lo.Uniq(lo.Filter(s.tokenize(query), func(t string, _ int) bool {
+		return strings.TrimSpace(t) != ""
+	}))
+	if len(tokens) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	where, args := filter.where(ctx, 1)
+	args = append([]any{pq.Array(tokens)}, args...)
+	where = append(where, "jieba_tokens ?| $1")
+
+	stmt := fmt.Sprintf(
+		`SELECT id FROM chat_messages
+WHERE %s
+ORDER BY (SELECT count(*) FROM jsonb_array_elements_text(jieba_tokens) AS t(token) WHERE t.token = ANY($1)) DESC,
+         platform_timestamp DESC
+LIMIT %d`,
+		strings.Join(where, " AND "),
+		limit,
+	)
+
+	return s.queryIDs(ctx, stmt, args...)
+}
+
+func (s *Searcher) queryIDs(ctx context.Context, stmt string, args ...any) ([]uuid.UUID, error) {
+	rows, err := s.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// surrounding returns up to n messages before and after message in the same chat,
+// both in chronological order, as seen by owner.
+func (s *Searcher) surrounding(ctx context.Context, message *ent.ChatMessage, owner uuid.UUID, n int) ([]*ent.ChatMessage, []*ent.ChatMessage, error) {
+	if n <= 0 {
+		return []*ent.ChatMessage{}, []*ent.ChatMessage{}, nil
+	}
+
+	before, err := s.client.ChatMessage.Query().
+		Where(
+			chatmessage.InChatID(message.InChatID),
+			chatmessage.Platform(message.Platform),
+			chatmessage.PlatformTimestampLTE(message.PlatformTimestamp),
+			chatmessage.IDNEQ(message.ID),
+			chatmessage.ContentNEQ(""),
+			chatmessage.DeletedAt(0),
+			owners.View(owner),
+		).
+		Order(chatmessage.ByPlatformTimestamp(entsql.OrderDesc())).
+		Limit(n).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	before = lo.Reverse(before)
+
+	after, err := s.client.ChatMessage.Query().
+		Where(
+			chatmessage.InChatID(message.InChatID),
+			chatmessage.Platform(message.Platform),
+			chatmessage.PlatformTimestampGTE(message.PlatformTimestamp),
+			chatmessage.IDNEQ(message.ID),
+			chatmessage.ContentNEQ(""),
+			chatmessage.DeletedAt(0),
+			owners.View(owner),
+			chatmessage.IDNotIn(lo.Map(before, func(m *ent.ChatMessage, _ int) uuid.UUID { return m.ID })...),
+		).
+		Order(chatmessage.ByPlatformTimestamp()).
+		Limit(n).
+		All(ctx)
+	if err != nil {
+		return before, nil, err
+	}
+
+	return before, after, nil
+}
+
+// where renders the filter as SQL conditions whose placeholders start after offset.
+func (f Filter) where(ctx context.Context, offset int) ([]string, []any) {
+	// Soft deleted messages and messages whose content retention purged are
+	// never returned.
+	conditions := []string{"content <> ''", "deleted_at = 0"}
+	args := make([]any, 0)
+
+	add := func(condition string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, offset+len(args)))
+	}
+
+	add("workspace_id = $%d", datastore.WorkspaceID(ctx))
+	if f.OwnerAccountID != uuid.Nil {
+		add("owner_account_id = $%d", f.OwnerAccountID)
+	} else {
+		// A message stored by several crawling accounts is returned once.
+		conditions = append(conditions, owners.CanonicalCondition)
+	}
+	if f.ChatID != "" {
+		add("in_chat_id = $%d", f.ChatID)
+	}
+	if f.ChatIDs != nil {
+		add("in_chat_id = ANY($%d)", pq.Array(f.ChatIDs))
+	}
+	if f.SenderID != "" {
+		add("from_id = $%d", f.SenderID)
+	}
+	if f.SenderName != "" {
+		add("from_name = $%d", f.SenderName)
+	}
+	if f.Platform != "" {
+		add("platform = $%d", f.Platform)
+	}
+	if !f.Since.IsZero() {
+		add("platform_timestamp >= $%d", f.Since.Unix())
+	}
+	if !f.Until.IsZero() {
+		add("platform_timestamp <= $%d", f.Until.Unix())
+	}
+
+	return conditions, args
+}
+
+// Ranked is a message ID with its fused score and the 1-based rank it had in
+// each retriever (0 when the retriever did not return it).
+type Ranked struct {
+	ID          uuid.UUID
+	Score       float64
+	VectorRank  int
+	KeywordRank int
+}
+
+// FuseRanks merges ranked ID lists with reciprocal rank fusion.
+func FuseRanks(vectorIDs, keywordIDs []uuid.UUID) []Ranked {
+	byID := make(map[uuid.UUID]*Ranked)
+	order := make([]uuid.UUID, 0, len(vectorIDs)+len(keywordIDs))
+
+	get := func(id uuid.UUID) *Ranked {
+		r, ok := byID[id]
+		if !ok {
+			r = &Ranked{ID: id}
+			byID[id] = r
+			order = append(order, id)
+		}
+		return r
+	}
+
+	for i, id := range vectorIDs {
+		r := get(id)
+		r.VectorRank = i + 1
+		r.Score += 1.0 / float64(rrfK+i+1)
+	}
+	for i, id := range keywordIDs {
+		r := get(id)
+		r.KeywordRank = i + 1
+		r.Score += 1.0 / float64(rrfK+i+1)
+	}
+
+	fused := make([]Ranked, 0, len(order))
+	for _, id := range order {
+		fused = append(fused, *byID[id])
+	}
+	sort.SliceStable(fused, func(i, j int) bool {
+		return fused[i].Score > fused[j].Score
+	})
+
+	return fused
+}
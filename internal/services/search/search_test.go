@@ -0,0 +1,124 @@
+package search_test
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/services/owners"
+	"github.com/luoling8192/mindwave/internal/services/search"
+)
+
+func TestFuseRanks(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	fused := search.FuseRanks([]uuid.UUID{a, b, c}, []uuid.UUID{c, d, a})
+	want := []search.Ranked{
+		// a and c tie on 1/61 + 1/63, a was returned first.
+		{ID: a, Score: 1.0/61 + 1.0/63, VectorRank: 1, KeywordRank: 3},
+		{ID: c, Score: 1.0/63 + 1.0/61, VectorRank: 3, KeywordRank: 1},
+		{ID: b, Score: 1.0 / 62, VectorRank: 2},
+		{ID: d, Score: 1.0 / 62, KeywordRank: 2},
+	}
+	if len(fused) != len(want) {
+		t.Fatalf("FuseRanks returned %d results, want %d", len(fused), len(want))
+	}
+	for i := range want {
+		got := fused[i]
+		if got.ID != want[i].ID || got.VectorRank != want[i].VectorRank || got.KeywordRank != want[i].KeywordRank {
+			t.Errorf("result %d = %+v, want %+v", i, got, want[i])
+		}
+		if diff := got.Score - want[i].Score; diff > 1e-12 || diff < -1e-12 {
+			t.Errorf("result %d has score %g, want %g", i, got.Score, want[i].Score)
+		}
+	}
+
+	// Equal scores keep the order the retrievers were read in, vector first.
+	if tied := search.FuseRanks([]uuid.UUID{a}, []uuid.UUID{b}); tied[0].ID != a || tied[1].ID != b {
+		t.Error("a tie between retrievers is not broken in favour of the vector hit")
+	}
+
+	if fused := search.FuseRanks(nil, nil); len(fused) != 0 {
+		t.Errorf("FuseRanks(nil, nil) = %v, want nothing", fused)
+	}
+	if fused := search.FuseRanks(nil, []uuid.UUID{d, b}); fused[0].ID != d || fused[0].Score <= fused[1].Score {
+		t.Errorf("keyword only results are out of rank order: %+v", fused)
+	}
+}
+
+func TestFilterWhere(t *testing.T) {
+	workspace := uuid.New()
+	ctx := datastore.WithWorkspace(context.Background(), workspace)
+	owner := uuid.New()
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+	base := []string{"content <> ''", "deleted_at = 0"}
+
+	tests := []struct {
+		name           string
+		filter         search.Filter
+		offset         int
+		wantConditions []string
+		wantArgs       []any
+	}{
+		{
+			name:           "empty",
+			offset:         1,
+			wantConditions: append(slices.Clone(base), "workspace_id = $2", owners.CanonicalCondition),
+			wantArgs:       []any{workspace},
+		},
+		{
+			name:           "owner replaces the canonical copy",
+			filter:         search.Filter{OwnerAccountID: owner},
+			wantConditions: append(slices.Clone(base), "workspace_id = $1", "owner_account_id = $2"),
+			wantArgs:       []any{workspace, owner},
+		},
+		{
+			name: "every field",
+			filter: search.Filter{
+				ChatID:     "chat",
+				SenderID:   "sender",
+				SenderName: "Alice",
+				Platform:   "telegram",
+				Since:      since,
+				Until:      until,
+				ChatIDs:    []string{"chat", "other"},
+			},
+			offset: 1,
+			wantConditions: append(slices.Clone(base),
+				"workspace_id = $2",
+				owners.CanonicalCondition,
+				"in_chat_id = $3",
+				"in_chat_id = ANY($4)",
+				"from_id = $5",
+				"from_name = $6",
+				"platform = $7",
+				"platform_timestamp >= $8",
+				"platform_timestamp <= $9",
+			),
+			wantArgs: []any{workspace, "chat", pq.Array([]string{"chat", "other"}), "sender", "Alice", "telegram", since.Unix(), until.Unix()},
+		},
+		{
+			name:           "empty chat ids match nothing",
+			filter:         search.Filter{ChatIDs: []string{}},
+			wantConditions: append(slices.Clone(base), "workspace_id = $1", owners.CanonicalCondition, "in_chat_id = ANY($2)"),
+			wantArgs:       []any{workspace, pq.Array([]string{})},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions, args := search.Where(ctx, tt.filter, tt.offset)
+			if !slices.Equal(conditions, tt.wantConditions) {
+				t.Errorf("conditions = %q, want %q", conditions, tt.wantConditions)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
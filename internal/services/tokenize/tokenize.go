@@ -0,0 +1,234 @@
+package tokenize
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-ego/gse"
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/luoling8192/mindwave/internal/metrics"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultBatchSize = 500
+	// userWordFrequency is used for user dictionary entries without an explicit
+	// frequency, high enough for jargon to win over the built-in dictionary.
+	userWordFrequency = 100000
+	// posField is the index of the optional part-of-speech column in a user dictionary line.
+	posField = 2
+)
+
+type Tokenizer struct {
+	seg gse.Segmenter
+}
+
+// NewTokenizer loads the embedded Chinese dictionary and stop words, then the
+// optional user dictionary and stop word files. The user dictionary uses the
+// jieba format: one "word [frequency] [pos]" entry per line.
+func NewTokenizer(userDictPath, stopWordsPath string) (*Tokenizer, error) {
+	t := &Tokenizer{}
+	t.seg.SkipLog = true
+
+	if err := t.seg.LoadDictEmbed(); err != nil {
+		return nil, fmt.Errorf("failed to load dictionary: %w", err)
+	}
+	if err := t.seg.LoadStopEmbed(); err != nil {
+		return nil, fmt.Errorf("failed to load stop words: %w", err)
+	}
+
+	if userDictPath != "" {
+		if err := t.loadUserDict(userDictPath); err != nil {
+			return nil, fmt.Errorf("failed to load user dictionary: %w", err)
+		}
+	}
+
+	if stopWordsPath != "" {
+		content, err := os.ReadFile(stopWordsPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load stop words: %w", err)
+		}
+		if err := t.seg.LoadStopStr(strings.ToLower(string(content))); err != nil {
+			return nil, fmt.Errorf("failed to load stop words: %w", err)
+		}
+	}
+
+	return t, nil
+}
+
+func (t *Tokenizer) loadUserDict(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		freq := float64(userWordFrequency)
+		if len(fields) > 1 {
+			if parsed, err := strconv.ParseFloat(fields[1], 64); err == nil {
+				freq = parsed
+			}
+		}
+		pos := []string{}
+		if len(fields) > posField {
+			pos = append(pos, fields[posField])
+		}
+
+		if err := t.seg.AddTokenForce(fields[0], freq, pos...); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// Tokenize segments text in search mode and returns unique, lower-cased tokens
+// with punctuation and stop words removed.
+func (t *Tokenizer) Tokenize(text string) []string {
+	if strings.TrimSpace(text) == "" {
+		return []string{}
+	}
+
+	words := t.seg.Trim(t.seg.CutSearch(text, true))
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word == "" || t.seg.IsStop(word) {
+			continue
+		}
+		tokens = append(tokens, word)
+	}
+
+	return lo.Uniq(tokens)
+}
+
+// Backfill tokenizes messages that have content but were not tokenized yet,
+// in batches ordered by id. New messages are tokenized by the tokenize jobs
+// the database enqueues for them, Backfill catches up on older ones. It
+// returns the number of messages updated.
+func Backfill(ctx context.Context, client *datastore.Client, tokenizer *Tokenizer, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	total := 0
+	var after uuid.UUID
+	for {
+		startBatch := time.Now()
+
+		query := client.ChatMessage.Query().
+			Where(
+				chatmessage.ContentNEQ(""),
+				chatmessage.DeletedAt(0),
+				chatmessage.TokenizedAt(0),
+			).
+			Select(chatmessage.FieldID, chatmessage.FieldContent).
+			Order(chatmessage.ByID()).
+			Limit(batchSize)
+		if after != uuid.Nil {
+			query = query.Where(chatmessage.IDGT(after))
+		}
+
+		messages, err := query.All(ctx)
+		if err != nil {
+			metrics.TokenizeDuration.WithLabelValues("error").Observe(time.Since(startBatch).Seconds())
+			return total, err
+		}
+		if len(messages) == 0 {
+			return total, nil
+		}
+
+		total += store(ctx, client, tokenizer, messages)
+		after = messages[len(messages)-1].ID
+
+		metrics.TokenizeDuration.WithLabelValues("success").Observe(time.Since(startBatch).Seconds())
+		slog.Info("Tokenized batch", "count", len(messages), "total", total, "duration", time.Since(startBatch))
+
+		if len(messages) < batchSize {
+			return total, nil
+		}
+	}
+}
+
+// Messages tokenizes the messages with the given ids, the work of a tokenize
+// job. Messages without content or deleted since are skipped.
+func Messages(ctx context.Context, client *datastore.Client, tokenizer *Tokenizer, ids []uuid.UUID) error {
+	startBatch := time.Now()
+
+	messages, err := client.ChatMessage.Query().
+		Where(
+			chatmessage.IDIn(ids...),
+			chatmessage.ContentNEQ(""),
+			chatmessage.DeletedAt(0),
+		).
+		Select(chatmessage.FieldID, chatmessage.FieldContent).
+		All(ctx)
+	if err != nil {
+		metrics.TokenizeDuration.WithLabelValues("error").Observe(time.Since(startBatch).Seconds())
+		return err
+	}
+
+	if stored := store(ctx, client, tokenizer, messages); stored < len(messages) {
+		metrics.TokenizeDuration.WithLabelValues("error").Observe(time.Since(startBatch).Seconds())
+		return fmt.Errorf("stored the tokens of %d of %d messages", stored, len(messages))
+	}
+	metrics.TokenizeDuration.WithLabelValues("success").Observe(time.Since(startBatch).Seconds())
+	return nil
+}
+
+// store tokenizes the content of the messages and stores the tokens, and
+// returns how many were stored. Failed messages are logged and left for the
+// next pass.
+func store(ctx context.Context, client *datastore.Client, tokenizer *Tokenizer, messages []*ent.ChatMessage) int {
+	stored := 0
+	for _, message := range messages {
+		err := client.ChatMessage.UpdateOneID(message.ID).
+			SetJiebaTokens(tokenizer.Tokenize(message.Content)).
+			SetTokenizedAt(time.Now().UnixMilli()).
+			Exec(ctx)
+		if err != nil {
+			slog.Warn("failed to store jieba tokens", "error", err, "message_id", message.ID)
+			continue
+		}
+		stored++
+	}
+	metrics.TokenizedMessagesCount.Add(float64(stored))
+	return stored
+}
+
+// Follow runs Backfill every interval until ctx is done, picking up messages
+// written by the crawler since the last pass where no job worker runs the
+// tokenize jobs.
+func Follow(ctx context.Context, client *datastore.Client, tokenizer *Tokenizer, batchSize int, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := Backfill(ctx, client, tokenizer, batchSize); err != nil {
+			slog.Error("failed to tokenize new messages", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package tokenize_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/migrate"
+	"github.com/luoling8192/mindwave/internal/datastore/datastoretest"
+	"github.com/luoling8192/mindwave/internal/services/tokenize"
+	"github.com/pgvector/pgvector-go"
+)
+
+// defaultTokenizer is shared by the tests, loading the dictionary takes
+// seconds.
+var defaultTokenizer = sync.OnceValues(func() (*tokenize.Tokenizer, error) {
+	return tokenize.NewTokenizer("", "")
+})
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestTokenize(t *testing.T) {
+	tokenizer, err := defaultTokenizer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		text string
+		want []string
+	}{
+		// Search mode keeps the words inside longer ones.
+		{"我们明天去北京大学开会", []string{"明天", "北京", "大学", "北京大学", "开会"}},
+		// Stop words and punctuation are dropped, tokens are lower-cased.
+		{"Mindwave 的 向量检索 很快！", []string{"mindwave", "向量", "检索", "很快"}},
+		{"Hello, World! hello", []string{"hello", "world"}},
+		{"的了吗", []string{}},
+		{"  ", []string{}},
+	}
+	for _, tt := range tests {
+		if got := tokenizer.Tokenize(tt.text); !slices.Equal(got, tt.want) {
+			t.Errorf("Tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestUserDictionaryAndStopWords(t *testing.T) {
+	dict := writeFile(t, "dict.txt", "# community jargon\n灵感波动\n上线了 1000 v\n\n")
+	stop := writeFile(t, "stop.txt", "项目\nMindWave\n")
+	tokenizer, err := tokenize.NewTokenizer(dict, stop)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"灵感波动项目上线了", []string{"灵感", "波动", "灵感波动", "上线", "上线了"}},
+		// Stop words match whatever their case.
+		{"mindwave 向量", []string{"向量"}},
+	}
+	for _, tt := range tests {
+		if got := tokenizer.Tokenize(tt.text); !slices.Equal(got, tt.want) {
+			t.Errorf("Tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+
+	if _, err := tokenize.NewTokenizer(filepath.Join(t.TempDir(), "missing.txt"), ""); err == nil {
+		t.Error("a missing user dictionary was accepted")
+	}
+}
+
+func TestBackfill(t *testing.T) {
+	ctx := context.Background()
+	client := datastoretest.NewClient(t, migrate.ChatMessagesTable)
+	tokenizer, err := defaultTokenizer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	create := func(id, content string) {
+		t.Helper()
+		err := client.ChatMessage.Create().
+			SetPlatform("telegram").
+			SetPlatformMessageID(id).
+			SetFromID("alice").
+			SetFromName("Alice").
+			SetInChatID("chat").
+			SetInChatType("group").
+			SetContent(content).
+			SetReplyToName("-").
+			SetReplyToID("-").
+			SetContentVector1536(pgvector.NewVector([]float32{0})).
+			SetContentVector1024(pgvector.NewVector([]float32{0})).
+			SetContentVector768(pgvector.NewVector([]float32{0})).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	create("1", "明天开会")
+	create("2", "的了吗")
+	create("3", "北京大学")
+
+	if n, err := tokenize.Backfill(ctx, client, tokenizer, 2); err != nil || n != 3 {
+		t.Fatalf("Backfill = %d, %v, want 3", n, err)
+	}
+	// Content without tokens is not tokenized again.
+	if n, err := tokenize.Backfill(ctx, client, tokenizer, 2); err != nil || n != 0 {
+		t.Errorf("second Backfill = %d, %v, want 0", n, err)
+	}
+
+	stop, err := client.ChatMessage.Query().Where(chatmessage.PlatformMessageID("2")).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stop.JiebaTokens) != 0 || stop.TokenizedAt == 0 {
+		t.Errorf("message of stop words got tokens %q, tokenized at %d", stop.JiebaTokens, stop.TokenizedAt)
+	}
+
+	// A tokenize job fills the tokens of a new message.
+	create("4", "向量检索")
+	fresh, err := client.ChatMessage.Query().Where(chatmessage.PlatformMessageID("4")).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tokenize.Messages(ctx, client, tokenizer, []uuid.UUID{fresh.ID}); err != nil {
+		t.Fatal(err)
+	}
+	fresh, err = client.ChatMessage.Get(ctx, fresh.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(fresh.JiebaTokens, []string{"向量", "检索"}) || fresh.TokenizedAt == 0 {
+		t.Errorf("new message got tokens %q, tokenized at %d", fresh.JiebaTokens, fresh.TokenizedAt)
+	}
+}
@@ -0,0 +1,214 @@
+// Package workspaces manages the workspaces that isolate communities from
+// each other. Every chat belongs to one workspace, and what is distilled from
+// it, the people seen in it and its graph stay in that workspace.
+package workspaces
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/luoling8192/mindwave/ent"
+	"github.com/luoling8192/mindwave/ent/chatmessage"
+	"github.com/luoling8192/mindwave/ent/event"
+	"github.com/luoling8192/mindwave/ent/joinedchat"
+	"github.com/luoling8192/mindwave/ent/summary"
+	"github.com/luoling8192/mindwave/ent/workspace"
+	"github.com/luoling8192/mindwave/internal/agent"
+	"github.com/luoling8192/mindwave/internal/datastore"
+	"github.com/samber/lo"
+)
+
+// DefaultSlug selects the default workspace, which has no row.
+const DefaultSlug = "default"
+
+// Settings are the settings of a new workspace.
+type Settings struct {
+	Slug string
+	Name string
+	// GraphName defaults to GraphName(Slug).
+	GraphName string
+	// LLMProviders is a providers config, empty uses the environment.
+	LLMProviders json.RawMessage
+	// MonthlyBudget in USD, 0 uses LLM_MONTHLY_BUDGET.
+	MonthlyBudget float64
+}
+
+// Changes are the settings Update replaces, nil fields are kept.
+type Changes struct {
+	Name          *string
+	LLMProviders  json.RawMessage
+	ClearLLM      bool
+	MonthlyBudget *float64
+}
+
+// Assignment tells what Assign moved.
+type Assignment struct {
+	Chats    int
+	Messages int
+}
+
+// GraphName is the graph a workspace gets unless another is chosen.
+func GraphName(slug string) string {
+	return "mindwave_" + slug
+}
+
+// Create adds a workspace.
+func Create(ctx context.Context, client *datastore.Client, settings Settings) (*ent.Workspace, error) {
+	if settings.Slug == DefaultSlug {
+		return nil, fmt.Errorf("%s is the default workspace", DefaultSlug)
+	}
+	if err := validateProviders(settings.LLMProviders); err != nil {
+		return nil, err
+	}
+
+	graphName := strings.TrimSpace(settings.GraphName)
+	if graphName == "" {
+		graphName = GraphName(settings.Slug)
+	}
+
+	create := client.Workspace.Create().
+		SetSlug(settings.Slug).
+		SetName(settings.Name).
+		SetGraphName(graphName).
+		SetLlmMonthlyBudget(settings.MonthlyBudget)
+	if len(settings.LLMProviders) > 0 {
+		create.SetLlmProviders(settings.LLMProviders)
+	}
+	return create.Save(ctx)
+}
+
+// Get returns the workspace of a slug, nil for the default workspace.
+func Get(ctx context.Context, client *datastore.Client, slug string) (*ent.Workspace, error) {
+	if slug == "" || slug == DefaultSlug {
+		return nil, nil
+	}
+	w, err := client.Workspace.Query().
+		Where(workspace.Slug(slug)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("unknown workspace %q", slug)
+	}
+	return w, err
+}
+
+// List returns the workspaces by slug.
+func List(ctx context.Context, client *datastore.Client) ([]*ent.Workspace, error) {
+	return client.Workspace.Query().
+		Order(workspace.BySlug()).
+		All(ctx)
+}
+
+// Update changes the settings of a workspace.
+func Update(ctx context.Context, client *datastore.Client, id uuid.UUID, changes Changes) (*ent.Workspace, error) {
+	if err := validateProviders(changes.LLMProviders); err != nil {
+		return nil, err
+	}
+
+	update := client.Workspace.UpdateOneID(id)
+	if changes.Name != nil {
+		update.SetName(*changes.Name)
+	}
+	switch {
+	case changes.ClearLLM:
+		update.ClearLlmProviders()
+	case len(changes.LLMProviders) > 0:
+		update.SetLlmProviders(changes.LLMProviders)
+	}
+	if changes.MonthlyBudget != nil {
+		update.SetLlmMonthlyBudget(*changes.MonthlyBudget)
+	}
+	return update.Save(ctx)
+}
+
+// Providers returns the providers config of a workspace, nil when it uses
+// the environment.
+func Providers(w *ent.Workspace) (*agent.ProvidersConfig, error) {
+	if w == nil || len(w.LlmProviders) == 0 {
+		return nil, nil
+	}
+	config, err := agent.ParseProvidersConfig(w.LlmProviders)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse providers config of workspace %s: %w", w.Slug, err)
+	}
+	return config, nil
+}
+
+// Assign moves chats with their messages to a workspace, uuid.Nil being the
+// default one. Chats already distilled in another workspace are refused,
+// their events and people would be left behind.
+func Assign(ctx context.Context, client *datastore.Client, workspaceID uuid.UUID, chatIDs []string) (*Assignment, error) {
+	if len(chatIDs) == 0 {
+		return nil, errors.New("no chats given")
+	}
+	ctx = datastore.Unscoped(ctx)
+
+	distilled, err := client.Event.Query().
+		Where(
+			event.InChatIDIn(chatIDs...),
+			event.WorkspaceIDNEQ(workspaceID),
+		).
+		Unique(true).
+		Select(event.FieldInChatID).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summarized, err := client.Summary.Query().
+		Where(
+			summary.InChatIDIn(chatIDs...),
+			summary.WorkspaceIDNEQ(workspaceID),
+		).
+		Unique(true).
+		Select(summary.FieldInChatID).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if refused := lo.Uniq(append(distilled, summarized...)); len(refused) > 0 {
+		return nil, fmt.Errorf("chats already distilled in another workspace: %s", strings.Join(refused, ", "))
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment := &Assignment{}
+	assignment.Chats, err = tx.JoinedChat.Update().
+		Where(joinedchat.ChatIDIn(chatIDs...)).
+		SetWorkspaceID(workspaceID).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	assignment.Messages, err = tx.ChatMessage.Update().
+		Where(chatmessage.InChatIDIn(chatIDs...)).
+		SetWorkspaceID(workspaceID).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	return assignment, tx.Commit()
+}
+
+func validateProviders(data json.RawMessage) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if _, err := agent.ParseProvidersConfig(data); err != nil {
+		return fmt.Errorf("invalid providers config: %w", err)
+	}
+	return nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %w", err, rerr)
+	}
+	return err
+}
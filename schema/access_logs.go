@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AccessLog defines the Ent schema for the access_logs table, recording every
+// response that carried the content of raw chat messages.
+type AccessLog struct {
+	ent.Schema
+}
+
+// Mixin scopes the AccessLog rows to a workspace.
+func (AccessLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the access_logs table columns.
+func (AccessLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		// API user who read the messages, empty when the API is served
+		// without authentication.
+		field.String("subject").
+			Default(""),
+
+		// How the reader authenticated: api_key, jwt, header or none.
+		field.String("method").
+			Default(""),
+
+		field.String("role").
+			Default(""),
+
+		// What was called, e.g. GET /api/v1/search or mcp search_messages.
+		field.String("endpoint"),
+
+		// The request's query, for search the terms looked for.
+		field.String("query").
+			Default(""),
+
+		field.JSON("chat_ids", []string{}).
+			Default([]string{}),
+
+		field.JSON("message_ids", []uuid.UUID{}).
+			Default([]uuid.UUID{}),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+	}
+}
+
+// Indexes defines unique and other indexes for access_logs.
+func (AccessLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject", "created_at"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// APIKey defines the Ent schema for the api_keys table. A key lets a client
+// call the API as a subject with a role, only its hash is stored.
+type APIKey struct {
+	ent.Schema
+}
+
+// Mixin scopes the APIKey rows to a workspace.
+func (APIKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the api_keys table columns.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.String("name").
+			Default(""),
+
+		// API user the key acts as, owner account links and chat grants
+		// are looked up by it.
+		field.String("subject").
+			NotEmpty(),
+
+		// viewer reads what was distilled, curator also reads raw messages
+		// and admin reads every chat.
+		field.Enum("role").
+			Values("viewer", "curator", "admin"),
+
+		// Hex SHA-256 of the key.
+		field.String("key_hash").
+			Unique().
+			Sensitive().
+			Immutable(),
+
+		// Start of the key, shown to tell keys apart.
+		field.String("prefix").
+			Immutable(),
+
+		// When the key was last used, in Unix milliseconds.
+		field.Int64("last_used_at").
+			Default(0),
+
+		// When the key was revoked, in Unix milliseconds, 0 while it is valid.
+		field.Int64("revoked_at").
+			Default(0),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+	}
+}
+
+// Indexes defines unique and other indexes for api_keys.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject"),
+	}
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AskTurn defines the Ent schema for the ask_turns table, one question and
+// answer of an ask conversation, kept as memory for follow-up questions.
+type AskTurn struct {
+	ent.Schema
+}
+
+// Mixin scopes the AskTurn rows to a workspace.
+func (AskTurn) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the ask_turns table columns.
+func (AskTurn) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.UUID("conversation_id", uuid.UUID{}),
+
+		// Subject of the API user who asked, empty for the command line. A
+		// conversation is only continued by the subject that started it.
+		field.String("subject").
+			Default(""),
+
+		field.Text("question").
+			Default(""),
+
+		// The question rewritten without references to earlier turns, used for retrieval.
+		field.Text("standalone_question").
+			Default(""),
+
+		field.Text("answer").
+			Default(""),
+
+		// Whether the answer was refused for lack of evidence.
+		field.Bool("refused").
+			Default(false),
+
+		field.JSON("cited_message_ids", []uuid.UUID{}).
+			Default([]uuid.UUID{}),
+
+		field.JSON("event_ids", []uuid.UUID{}).
+			Default([]uuid.UUID{}),
+
+		field.String("model").
+			Default(""),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+	}
+}
+
+// Indexes defines lookup indexes for ask turns.
+func (AskTurn) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("conversation_id", "created_at"),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ChatGrant defines the Ent schema for the chat_grants table. A grant lets an
+// API user read one joined chat with a role, on top of the chats of the
+// owner accounts linked to them.
+type ChatGrant struct {
+	ent.Schema
+}
+
+// Mixin scopes the ChatGrant rows to a workspace.
+func (ChatGrant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the chat_grants table columns.
+func (ChatGrant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.String("subject").
+			NotEmpty(),
+
+		// chat_id of the joined chat.
+		field.String("chat_id").
+			NotEmpty(),
+
+		// viewer reads what was distilled from the chat, curator also its
+		// raw messages.
+		field.Enum("role").
+			Values("viewer", "curator").
+			Default("viewer"),
+
+		field.String("granted_by").
+			Default(""),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			UpdateDefault(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Indexes defines unique and other indexes for chat_grants.
+func (ChatGrant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "subject", "chat_id").
+			Unique(),
+	}
+}
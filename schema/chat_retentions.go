@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ChatRetention defines the Ent schema for the chat_retentions table. Each row
+// limits how long the messages of one chat are kept, the janitor purges them
+// once they are older.
+type ChatRetention struct {
+	ent.Schema
+}
+
+// Mixin scopes the ChatRetention rows to a workspace.
+func (ChatRetention) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the chat_retentions table columns.
+func (ChatRetention) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.String("chat_id"),
+
+		// Messages older than this many days are purged.
+		field.Int("days").
+			Positive(),
+
+		// content blanks the content and tokens of old messages and keeps
+		// their vectors, the events and the summaries. all deletes the
+		// messages and the events and summaries of the same period.
+		field.Enum("mode").
+			Values("content", "all").
+			Default("content"),
+
+		field.Bool("enabled").
+			Default(true),
+
+		// When the janitor last applied the policy, in Unix milliseconds.
+		field.Int64("last_run_at").
+			Default(0),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			UpdateDefault(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Indexes defines unique and other indexes for chat_retentions.
+func (ChatRetention) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "chat_id").
+			Unique(),
+	}
+}
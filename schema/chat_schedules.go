@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ChatSchedule defines the Ent schema for the chat_schedules table. Each row
+// distills one chat on a cron schedule, every window between two consecutive
+// fire times being distilled once it has finished.
+type ChatSchedule struct {
+	ent.Schema
+}
+
+// Mixin scopes the ChatSchedule rows to a workspace.
+func (ChatSchedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the chat_schedules table columns.
+func (ChatSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.String("chat_id"),
+
+		// Standard five-field cron expression, optionally prefixed with
+		// CRON_TZ=<zone>.
+		field.String("cron"),
+
+		field.Bool("enabled").
+			Default(true),
+
+		// End of the last window handed to a distill job, in Unix
+		// milliseconds. Windows after it are caught up on start.
+		field.Int64("last_window_end").
+			Default(0),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			UpdateDefault(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Indexes defines unique and other indexes for chat_schedules.
+func (ChatSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "chat_id").
+			Unique(),
+	}
+}
@@ -23,6 +23,13 @@ type JoinedChat struct {
 	ent.Schema
 }
 
+// Mixin scopes the JoinedChat rows to a workspace.
+func (JoinedChat) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
 // Fields provides the schema definition for the joined_chats table columns.
 func (JoinedChat) Fields() []ent.Field {
 	return []ent.Field{
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DigestDelivery defines the Ent schema for the digest_deliveries table. It
+// records which digests were posted where, so a digest is posted at most once
+// per publisher, chat and period, and an interrupted post resumes at the part
+// it stopped at.
+type DigestDelivery struct {
+	ent.Schema
+}
+
+// Mixin scopes the DigestDelivery rows to a workspace.
+func (DigestDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the digest_deliveries table columns.
+func (DigestDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.String("publisher").
+			Immutable(),
+
+		field.String("chat_id").
+			Immutable(),
+
+		// Period of the digest, like day-2025-01-02 or week-2024-12-30.
+		field.String("digest_key").
+			Immutable(),
+
+		field.Enum("status").
+			Values("pending", "sent").
+			Default("pending"),
+
+		field.Int("parts_total").
+			Default(0),
+
+		field.Int("parts_sent").
+			Default(0),
+
+		field.String("error").
+			Default(""),
+
+		field.Int64("sent_at").
+			Default(0),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			UpdateDefault(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Indexes defines the uniqueness of deliveries.
+func (DigestDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "publisher", "chat_id", "digest_key").Unique(),
+	}
+}
@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// StageUsage is the LLM usage of one pipeline stage of a distill run.
+type StageUsage struct {
+	PromptTokens     int     `json:"prompt_tokens"`
+	CompletionTokens int     `json:"completion_tokens"`
+	Cost             float64 `json:"cost"`
+}
+
+// DistillRun defines the Ent schema for the distill_runs table, one distill
+// round over a chat window with the LLM tokens it spent and what they cost.
+type DistillRun struct {
+	ent.Schema
+}
+
+// Mixin scopes the DistillRun rows to a workspace.
+func (DistillRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the distill_runs table columns.
+func (DistillRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.String("in_chat_id").
+			NotEmpty().
+			Immutable(),
+
+		// Window of messages distilled, as platform timestamps.
+		field.Int64("span_start").
+			Immutable(),
+
+		field.Int64("span_end").
+			Immutable(),
+
+		field.Enum("status").
+			Values("running", "succeeded", "failed").
+			Default("running"),
+
+		field.Int("message_count").
+			Default(0),
+
+		field.Int("item_count").
+			Default(0),
+
+		// Events stored before the run finished or was interrupted.
+		field.Int("event_count").
+			Default(0),
+
+		field.Int("prompt_tokens").
+			Default(0),
+
+		field.Int("completion_tokens").
+			Default(0),
+
+		// Cost in USD from the price table configured at the time of the run.
+		field.Float("cost").
+			Default(0),
+
+		// Usage by stage, e.g. summarize, extract and embed.
+		field.JSON("stage_usage", map[string]StageUsage{}).
+			Default(map[string]StageUsage{}),
+
+		field.Text("error").
+			Default(""),
+
+		// Output of an LLM call interrupted mid-stream, kept for inspection.
+		field.Text("partial_output").
+			Default(""),
+
+		field.Int64("finished_at").
+			Default(0),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			UpdateDefault(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Indexes defines lookup indexes for distill runs, by chat and by month for
+// the budget guard.
+func (DistillRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("in_chat_id", "span_start"),
+		index.Fields("created_at"),
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
 )
 
 // Event defines the Ent schema for the events table.
@@ -14,6 +18,13 @@ type Event struct {
 	ent.Schema
 }
 
+// Mixin scopes the Event rows to a workspace.
+func (Event) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
 // Fields provides the schema definition for the events table columns.
 func (Event) Fields() []ent.Field {
 	return []ent.Field{
@@ -41,6 +52,11 @@ func (Event) Fields() []ent.Field {
 			Default("").
 			NotEmpty(),
 
+		// Participant names from the extractor that could not be matched to
+		// an identity in the chat.
+		field.Strings("unmatched_names").
+			Optional(),
+
 		field.String("in_chat_id").
 			Default("").
 			NotEmpty(),
@@ -55,6 +71,43 @@ func (Event) Fields() []ent.Field {
 		field.JSON("evidence_message_ids", []uuid.UUID{}).
 			Default([]uuid.UUID{}),
 
+		// Time span covered by the event, as platform timestamps. Merged
+		// duplicates widen the span of the canonical event.
+		field.Int64("span_start").
+			Default(0),
+
+		field.Int64("span_end").
+			Default(0),
+
+		// Vector fields for the event description, only the one matching the
+		// configured embedding model is filled.
+		field.Other("description_vector_1536", pgvector.Vector{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "vector(1536)",
+			}).
+			Optional().
+			Nillable(),
+
+		field.Other("description_vector_1024", pgvector.Vector{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "vector(1024)",
+			}).
+			Optional().
+			Nillable(),
+
+		field.Other("description_vector_768", pgvector.Vector{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "vector(768)",
+			}).
+			Optional().
+			Nillable(),
+
+		// The earlier event this one continues, when deduplication links
+		// instead of merging.
+		field.UUID("continues_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+
 		field.Int64("created_at").
 			DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
 
@@ -64,7 +117,7 @@ func (Event) Fields() []ent.Field {
 	}
 }
 
-// Edges defines the many-to-many relation between Events and Identities.
+// Edges defines the relations of Events to Identities and to other Events.
 func (Event) Edges() []ent.Edge {
 	return []ent.Edge{
 		// Many-to-many relation: events <-> identities
@@ -72,5 +125,34 @@ func (Event) Edges() []ent.Edge {
 		// This assumes there is also an Events edge in the Identity schema for symmetry.
 		edge.From("identities", Identity.Type).
 			Ref("events"),
+
+		// Self-reference for CONTINUES relations between near-duplicate events.
+		edge.To("continuations", Event.Type).
+			From("continues").
+			Field("continues_id").
+			Unique(),
+	}
+}
+
+// Indexes defines the vector and lookup indexes for events.
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("in_chat_id", "platform_timestamp"),
+
+		index.Fields("description_vector_1536").
+			Annotations(
+				entsql.IndexType("hnsw"),
+				entsql.OpClass("vector_cosine_ops"),
+			),
+		index.Fields("description_vector_1024").
+			Annotations(
+				entsql.IndexType("hnsw"),
+				entsql.OpClass("vector_cosine_ops"),
+			),
+		index.Fields("description_vector_768").
+			Annotations(
+				entsql.IndexType("hnsw"),
+				entsql.OpClass("vector_cosine_ops"),
+			),
 	}
 }
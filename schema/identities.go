@@ -15,6 +15,13 @@ type Identity struct {
 	ent.Schema
 }
 
+// Mixin scopes the Identity rows to a workspace.
+func (Identity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
 // Fields provides the schema definition for the identities table columns.
 func (Identity) Fields() []ent.Field {
 	return []ent.Field{
@@ -38,9 +45,24 @@ func (Identity) Fields() []ent.Field {
 		field.String("profile_photo_url").
 			Default(""),
 
+		// Previous display names and other handles this identity was seen with.
 		field.Strings("alt_ids").
 			Optional(),
 
+		field.UUID("person_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+
+		// Members who asked not to be profiled. Their messages are left out
+		// of distill and their names are never linked to events.
+		field.Bool("opted_out").
+			Default(false),
+
+		// When the identity was forgotten, 0 if never. Forgotten identities
+		// stay as opted out tombstones so later messages are left out too.
+		field.Int64("forgotten_at").
+			Default(0),
+
 		field.Int64("created_at").
 			DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
 
@@ -50,15 +72,25 @@ func (Identity) Fields() []ent.Field {
 	}
 }
 
-// Edges defines the many-to-many relation between Identities and Events.
+// Edges defines the relations of Identities to Events, their Person and
+// their Profile versions.
 func (Identity) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("events", Event.Type),
+
+		edge.To("profiles", Profile.Type),
+
+		edge.From("person", Person.Type).
+			Ref("identities").
+			Field("person_id").
+			Unique(),
 	}
 }
 
 func (Identity) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("platform", "platform_user_id").Unique(),
+		// A platform user is a separate identity in every workspace.
+		index.Fields("workspace_id", "platform", "platform_user_id").Unique(),
+		index.Fields("person_id"),
 	}
 }
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Job defines the Ent schema for the jobs table, a durable queue of
+// background work claimed by workers with SELECT ... FOR UPDATE SKIP LOCKED.
+type Job struct {
+	ent.Schema
+}
+
+// Mixin scopes the Job rows to a workspace.
+func (Job) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the jobs table columns.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.Enum("kind").
+			Values("distill", "embed", "graph_sync", "profile", "tokenize").
+			Immutable(),
+
+		field.JSON("payload", json.RawMessage{}).
+			Immutable(),
+
+		// Optional deduplication key, a job is not enqueued twice with the
+		// same key in a workspace.
+		field.String("key").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// dead is terminal after the last attempt failed, cancelled after an
+		// operator gave up on the job.
+		field.Enum("status").
+			Values("pending", "running", "succeeded", "dead", "cancelled").
+			Default("pending"),
+
+		field.Int("attempts").
+			Default(0),
+
+		field.Int("max_attempts").
+			Default(5),
+
+		// Earliest time to run the job, in Unix milliseconds. Failed attempts
+		// push it back.
+		field.Int64("run_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+
+		field.String("locked_by").
+			Default(""),
+
+		// A running job whose lease expired belongs to a worker that died,
+		// it is claimed again.
+		field.Int64("locked_until").
+			Default(0),
+
+		field.Text("last_error").
+			Default(""),
+
+		field.Int64("finished_at").
+			Default(0),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			UpdateDefault(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Indexes defines the deduplication key and the lookup index workers claim
+// jobs through.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "key").
+			Unique(),
+		index.Fields("status", "run_at"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LLMCacheEntry defines the Ent schema for the llm_cache_entries table,
+// completions replayed for identical requests.
+type LLMCacheEntry struct {
+	ent.Schema
+}
+
+// Fields provides the schema definition for the llm_cache_entries table
+// columns.
+func (LLMCacheEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		// SHA-256 of the model, prompt version, input hash and parameters.
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		field.String("stage").
+			Default(""),
+
+		field.String("model").
+			Default(""),
+
+		// Hash of the system prompts, changes whenever a prompt is edited.
+		field.String("prompt_version").
+			Default(""),
+
+		field.String("input_hash").
+			Default(""),
+
+		// Completion response as JSON, replayed byte for byte.
+		field.Bytes("value"),
+
+		field.Int64("expires_at"),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Indexes defines the index expired entries are pruned by.
+func (LLMCacheEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
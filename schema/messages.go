@@ -16,6 +16,13 @@ type ChatMessage struct {
 	ent.Schema
 }
 
+// Mixin scopes the ChatMessage rows to a workspace.
+func (ChatMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
 // Fields returns the fields of the ChatMessage schema.
 func (ChatMessage) Fields() []ent.Field {
 	return []ent.Field{
@@ -61,6 +68,11 @@ func (ChatMessage) Fields() []ent.Field {
 
 		field.JSON("jieba_tokens", []string{}).Default([]string{}),
 
+		// When content was tokenized into jieba_tokens, in Unix
+		// milliseconds, 0 until it is. Content without any token is told
+		// apart from content not tokenized yet by it.
+		field.Int64("tokenized_at").Default(0),
+
 		field.Int64("created_at").
 			DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
 
@@ -68,6 +80,8 @@ func (ChatMessage) Fields() []ent.Field {
 			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
 			UpdateDefault(func() int64 { return time.Now().UnixMilli() }),
 
+		// Set by the crawler when the message is deleted on the platform.
+		// Retention in content mode blanks the content and leaves it at 0.
 		field.Int64("deleted_at").Default(0),
 	}
 }
@@ -94,8 +108,11 @@ func (ChatMessage) Indexes() []ent.Index {
 				entsql.OpClass("vector_l2_ops"),
 			),
 
-		// Gin index for jieba_tokens (annotate in SQL/migration scripts as needed)
-		index.Fields("jieba_tokens"),
+		// GIN index for jieba_tokens, the default jsonb_ops class serves the ?| keyword lookups.
+		index.Fields("jieba_tokens").
+			Annotations(
+				entsql.IndexType("GIN"),
+			),
 
 		index.Fields("from_user_uuid"),
 	}
@@ -4,7 +4,9 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -58,3 +60,26 @@ func (StringIDMixin) Fields() []ent.Field {
 		field.String("id").NotEmpty().Unique().Immutable(),
 	}
 }
+
+// WorkspaceMixin scopes a table to a workspace. Rows of the default
+// workspace carry uuid.Nil, which is also the column default so rows written
+// by the crawler land there until their chat is assigned.
+type WorkspaceMixin struct {
+	// We embed the `mixin.Schema` to avoid
+	// implementing the rest of the methods.
+	mixin.Schema
+}
+
+func (WorkspaceMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("workspace_id", uuid.UUID{}).
+			Default(func() uuid.UUID { return uuid.Nil }).
+			Annotations(entsql.Default(uuid.Nil.String())),
+	}
+}
+
+func (WorkspaceMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id"),
+	}
+}
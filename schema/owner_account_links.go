@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OwnerAccountLink defines the Ent schema for the owner_account_links table.
+// Each row lets an API user read the chats one crawling account has joined.
+type OwnerAccountLink struct {
+	ent.Schema
+}
+
+// Mixin scopes the OwnerAccountLink rows to a workspace.
+func (OwnerAccountLink) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the owner_account_links table
+// columns.
+func (OwnerAccountLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		// The API user, as named by the header the API trusts.
+		field.String("subject").
+			NotEmpty(),
+
+		// The crawling account, as stored in chat_messages.owner_account_id.
+		field.UUID("owner_account_id", uuid.UUID{}),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+	}
+}
+
+// Indexes defines unique and other indexes for owner_account_links.
+func (OwnerAccountLink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "subject", "owner_account_id").
+			Unique(),
+	}
+}
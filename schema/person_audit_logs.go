@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PersonAuditLog defines the Ent schema for the person_audit_logs table,
+// recording every manual merge and split of persons and every identity
+// forgotten.
+type PersonAuditLog struct {
+	ent.Schema
+}
+
+// Mixin scopes the PersonAuditLog rows to a workspace.
+func (PersonAuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the person_audit_logs table columns.
+func (PersonAuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.Enum("action").
+			Values("merge", "split", "forget"),
+
+		// The person the identities ended up in, or the person a forgotten
+		// identity belonged to.
+		field.UUID("person_id", uuid.UUID{}),
+
+		// Persons that were absorbed by a merge or left behind by a split.
+		field.JSON("source_person_ids", []uuid.UUID{}).
+			Default([]uuid.UUID{}),
+
+		field.JSON("identity_ids", []uuid.UUID{}).
+			Default([]uuid.UUID{}),
+
+		// Chats a forget scrubbed, a later forget of the same identity finds
+		// them here once its messages are gone.
+		field.JSON("chat_ids", []string{}).
+			Default([]string{}),
+
+		field.String("actor").
+			Default(""),
+
+		field.String("reason").
+			Default(""),
+
+		// What a forget removed or anonymized, by kind of record.
+		field.JSON("counts", map[string]int{}).
+			Optional(),
+
+		// Score of the proposal that led to a merge, 0 for manual operations.
+		field.Float("score").
+			Default(0),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Person defines the Ent schema for the persons table. A person groups the
+// identities one human uses across platforms and accounts.
+type Person struct {
+	ent.Schema
+}
+
+// Mixin scopes the Person rows to a workspace.
+func (Person) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the persons table columns.
+func (Person) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.String("display_name").
+			Default(""),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			UpdateDefault(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Edges defines the one-to-many relation between Persons and Identities.
+func (Person) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("identities", Identity.Type),
+	}
+}
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Profile sections, in the order they are shown on a profile card.
+const (
+	ProfileSectionExpertise     = "expertise"
+	ProfileSectionInterests     = "interests"
+	ProfileSectionContributions = "contributions"
+	ProfileSectionChats         = "chats"
+)
+
+// ProfileClaim is one statement of a profile together with the events it was
+// drawn from.
+type ProfileClaim struct {
+	Section  string      `json:"section"`
+	Text     string      `json:"text"`
+	EventIDs []uuid.UUID `json:"event_ids"`
+}
+
+// Profile defines the Ent schema for the profiles table. Every update of an
+// identity's profile is stored as a new version.
+type Profile struct {
+	ent.Schema
+}
+
+// Mixin scopes the Profile rows to a workspace.
+func (Profile) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the profiles table columns.
+func (Profile) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.UUID("identity_id", uuid.UUID{}).
+			Immutable(),
+
+		field.Int("version").
+			Positive().
+			Immutable(),
+
+		field.JSON("claims", []ProfileClaim{}).
+			Default([]ProfileClaim{}),
+
+		// Markdown rendering of the claims, for display.
+		field.Text("content").
+			Default(""),
+
+		field.Int("event_count").
+			Default(0),
+
+		// Largest updated_at of the events folded into this version and the
+		// largest id among the events updated then. Events after the pair are
+		// new to the next version.
+		field.Int64("events_updated_until").
+			Default(0),
+		field.UUID("events_updated_until_id", uuid.UUID{}).
+			Default(func() uuid.UUID { return uuid.Nil }).
+			Annotations(entsql.Default(uuid.Nil.String())),
+
+		field.String("model").
+			Default(""),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+	}
+}
+
+// Edges defines the relation of Profiles to their Identity.
+func (Profile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("identity", Identity.Type).
+			Ref("profiles").
+			Field("identity_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes defines lookup indexes for profiles.
+func (Profile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("identity_id", "version").Unique(),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Summary defines the Ent schema for the summaries table, the summarizer
+// output of one distill round over a chat.
+type Summary struct {
+	ent.Schema
+}
+
+// Mixin scopes the Summary rows to a workspace.
+func (Summary) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceMixin{},
+	}
+}
+
+// Fields provides the schema definition for the summaries table columns.
+func (Summary) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		field.String("platform").
+			Default(""),
+
+		field.String("in_chat_id").
+			Default("").
+			NotEmpty(),
+
+		field.String("in_chat_type").
+			Default(""),
+
+		field.Text("content").
+			Default(""),
+
+		field.String("model").
+			Default(""),
+
+		field.Int("message_count").
+			Default(0),
+
+		// Window of messages summarized, as platform timestamps.
+		field.Int64("span_start").
+			Default(0),
+
+		field.Int64("span_end").
+			Default(0),
+
+		// Same as span_end, kept for cursor pagination alongside events.
+		field.Int64("platform_timestamp").
+			Default(0),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			UpdateDefault(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Indexes defines lookup indexes for summaries.
+func (Summary) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("in_chat_id", "platform_timestamp"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// slugPattern keeps workspace slugs and graph names usable as AGE graph names.
+var slugPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Workspace defines the Ent schema for the workspaces table. A workspace
+// isolates the chats of one community with everything distilled from them,
+// its own graph and its own LLM settings. Rows scoped to no workspace belong
+// to the default one, which has no row and is configured by the environment.
+type Workspace struct {
+	ent.Schema
+}
+
+// Fields provides the schema definition for the workspaces table columns.
+func (Workspace) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Unique(),
+
+		// Short name commands select the workspace by.
+		field.String("slug").
+			Match(slugPattern).
+			Unique(),
+
+		field.String("name").
+			Default(""),
+
+		// AGE graph the events and people of the workspace are written to.
+		field.String("graph_name").
+			Match(slugPattern).
+			Unique(),
+
+		// Providers config in the format of LLM_PROVIDERS, empty uses the
+		// environment.
+		field.JSON("llm_providers", json.RawMessage{}).
+			Optional(),
+
+		// Distill runs are refused once the month's runs of the workspace
+		// cost this much in USD, 0 uses LLM_MONTHLY_BUDGET.
+		field.Float("llm_monthly_budget").
+			Default(0).
+			Min(0),
+
+		field.Int64("created_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Immutable(),
+
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			UpdateDefault(func() int64 { return time.Now().UnixMilli() }),
+	}
+}